@@ -8,9 +8,11 @@ import (
 )
 
 type Token struct {
-	Subject  uuid.UUID `json:"sub"`
-	IsArtist bool      `json:"is_artist"`
-	Exp      int64     `json:"exp"`
+	Subject      uuid.UUID `json:"sub"`
+	IsArtist     bool      `json:"is_artist"`
+	IsAdmin      bool      `json:"is_admin,omitempty"`
+	HideExplicit bool      `json:"hide_explicit,omitempty"`
+	Exp          int64     `json:"exp"`
 }
 
 func (t *Token) GetAudience() (jwt.ClaimStrings, error) {
@@ -103,6 +103,8 @@ func (m *PlaylistMetadata) validate(all bool) error {
 
 	// no validation rules for IsPublic
 
+	// no validation rules for Explicit
+
 	if m.UpdatedAt != nil {
 
 		if all {
@@ -461,6 +463,8 @@ func (m *CreatePlaylistRequest) validate(all bool) error {
 
 	// no validation rules for CoverUrl
 
+	// no validation rules for Explicit
+
 	if len(errors) > 0 {
 		return CreatePlaylistRequestMultiError(errors)
 	}
@@ -1007,6 +1011,10 @@ func (m *UpdatePlaylistRequest) validate(all bool) error {
 		// no validation rules for IsPublic
 	}
 
+	if m.Explicit != nil {
+		// no validation rules for Explicit
+	}
+
 	if len(errors) > 0 {
 		return UpdatePlaylistRequestMultiError(errors)
 	}
@@ -0,0 +1,99 @@
+// Reconcile compares the cover objects with the playlists once and prints the report.
+// It exits with 1 if orphans or dangling references are left.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service/covers"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service/reconcile"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/objects"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+const serviceName = "playlists-reconcile"
+
+func main() {
+	deleteOrphans := flag.Bool("delete", false, "delete orphans older than the grace period")
+	grace := flag.Duration("grace", 24*time.Hour, "orphans modified in this time are not deleted")
+
+	flag.Parse()
+
+	cfg, err := config.Load("./configs/service.yaml", "/etc/app/service.yaml", "service.yaml")
+	if cfg == nil || err != nil {
+		panic(fmt.Sprintf("failed to read config: %s", err))
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	log := logger.New(serviceName, cfg.ENV)
+	ctx = context.WithValue(ctx, logger.LoggerKey, log)
+
+	report, err := run(ctx, cfg, reconcile.Config{
+		GracePeriod:   *grace,
+		DeleteOrphans: *deleteOrphans,
+	})
+
+	cancel()
+
+	if err != nil {
+		log.Error(ctx, "failed to reconcile covers", zap.Error(err))
+	}
+
+	left := 0
+
+	for _, orphan := range report.Orphans {
+		if orphan.Deleted {
+			_, _ = fmt.Printf("deleted  %s\n", orphan.ObjectID) //nolint:forbidigo
+			continue
+		}
+
+		left++
+
+		_, _ = fmt.Printf("orphan   %s %d bytes, modified %s\n", //nolint:forbidigo
+			orphan.ObjectID, orphan.Size, orphan.ModifiedAt.Format("2006-01-02 15:04:05"))
+	}
+
+	for _, ref := range report.Dangling {
+		_, _ = fmt.Printf("dangling %s of playlist %s\n", ref.ObjectID, ref.PlaylistID) //nolint:forbidigo
+	}
+
+	_, _ = fmt.Printf("%d objects, %d orphans (%d bytes left), %d dangling references\n", //nolint:forbidigo
+		report.Objects, len(report.Orphans), report.OrphanedBytes(), len(report.Dangling))
+
+	if err != nil || left > 0 || len(report.Dangling) > 0 {
+		os.Exit(1)
+	}
+}
+
+// run connects only to the storages holding the covers and their references.
+func run(ctx context.Context, cfg *config.Config, reconcileCfg reconcile.Config) (reconcile.Report, error) {
+	db, err := postgres.Connect(cfg.Connections.PGConfig)
+	if err != nil {
+		return reconcile.Report{}, err //nolint:wrapcheck
+	}
+	defer db.Close()
+
+	objStorage, err := objects.New(ctx, cfg.Connections.S3Config)
+	if err != nil {
+		return reconcile.Report{}, err //nolint:wrapcheck
+	}
+
+	// Covers are parsed only, the service needs no storages for it
+	coversService := covers.New(nil, nil, covers.Config{
+		HostUsesTLS: false,
+		Host:        cfg.Servers.HTTP.Host + ":" + strconv.Itoa(cfg.Servers.HTTP.Port),
+	})
+
+	return reconcile.New(db, objStorage, coversService, reconcileCfg).Reconcile(ctx) //nolint:wrapcheck
+}
@@ -2,29 +2,50 @@ package app
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/config"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage"
 	grpcserver "github.com/Benzogang-Tape/audio-hosting/playlists/internal/transport/grpc"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/metrics"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/tracing"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
 
+const serviceName = "playlists"
+
 type App struct {
-	server *grpcserver.Server
-	db     *storage.Storage
+	server      *grpcserver.Server
+	db          *storage.Storage
+	stopTracing func(context.Context) error
 }
 
 // New creates a new App instance.
 func New(ctx context.Context, cfg *config.Config) (*App, error) {
 	log := logger.GetLoggerFromCtx(ctx)
 
-	db, err := storage.New(cfg.Connections.PGConfig, cfg.Connections.RedisConfig, cfg.Connections.S3Config) //nolint:contextcheck
+	stopTracing, err := tracing.Setup(ctx, tracing.Config{
+		ServiceName: serviceName,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		log.Error(ctx, "failed to set up tracing", zap.Error(err))
+		return nil, err //nolint:wrapcheck
+	}
+
+	db, err := storage.New(cfg.Connections.PGConfig, cfg.Connections.RedisConfig, cfg.Connections.S3Config,
+		cfg.Connections.KafkaConfig) //nolint:contextcheck
 	if err != nil {
 		return nil, err
 	}
 
+	prometheus.MustRegister(metrics.NewPoolCollector(db))
+
 	server, err := grpcserver.New(ctx, cfg.Servers, cfg.Secrets.Public, db, cfg.Connections.SongsConn)
 	if err != nil {
 		log.Error(ctx, "failed to create server", zap.Error(err))
@@ -32,8 +53,9 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 	}
 
 	return &App{
-		server: server,
-		db:     db,
+		server:      server,
+		db:          db,
+		stopTracing: stopTracing,
 	}, nil
 }
 
@@ -42,8 +64,11 @@ func (a *App) Run(ctx context.Context) error {
 	return a.server.Run(ctx)
 }
 
-// Stop closes the database connection and stops the GRPC server.
+// Stop closes the database connection, stops the GRPC server and flushes the spans left.
 func (a *App) Stop(ctx context.Context) error {
 	a.db.Close()
-	return a.server.Stop(ctx)
+
+	err := a.server.Stop(ctx)
+
+	return errors.Join(err, a.stopTracing(ctx))
 }
@@ -12,10 +12,12 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/transport"
 	retryer "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -41,6 +43,7 @@ func New(cfg Config) (*Client, error) {
 	conn, err := grpc.NewClient(
 		cfg.Host+":"+strconv.Itoa(cfg.Port),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithChainUnaryInterceptor(
 			retryer.UnaryClientInterceptor(retryOpts...),
 		),
@@ -55,6 +58,17 @@ func New(cfg Config) (*Client, error) {
 	}, nil
 }
 
+// Ping checks that the songs service answers its grpc.health.v1 service.
+// Its status isn't checked, so the readiness of songs doesn't make playlists unready.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := healthpb.NewHealthClient(c.conn).Check(ctx, &healthpb.HealthCheckRequest{}) //nolint:exhaustruct
+	if err != nil {
+		return e.NewFrom("checking songs health", err)
+	}
+
+	return nil
+}
+
 func (c *Client) Close() error {
 	err := c.conn.Close()
 	if err != nil {
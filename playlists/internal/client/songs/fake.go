@@ -46,6 +46,7 @@ func (c *FakeClient) randomSong(id string) Song {
 		Duration:    time.Duration(gofakeit.Int()),
 		WeightBytes: gofakeit.Int32(),
 		ReleasedAt:  gofakeit.Date(),
+		Explicit:    false,
 	}
 	c.memo.Store(id, song)
 
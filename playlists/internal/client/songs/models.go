@@ -16,6 +16,7 @@ type Song struct {
 	Duration    time.Duration
 	WeightBytes int32
 	ReleasedAt  time.Time
+	Explicit    bool
 }
 
 func convSong(songs *songs.Song) Song {
@@ -34,6 +35,7 @@ func convSong(songs *songs.Song) Song {
 		Duration:    songs.GetDuration().AsDuration(),
 		WeightBytes: songs.GetWeightBytes(),
 		ReleasedAt:  songs.GetReleasedAt().AsTime(),
+		Explicit:    songs.GetExplicit(),
 	}
 }
 
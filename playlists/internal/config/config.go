@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/client/songs"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/broker"
 	s3 "github.com/Benzogang-Tape/audio-hosting/playlists/pkg/db/minio"
 	"time"
 
@@ -14,11 +15,15 @@ type Config struct {
 	Servers     Servers     `yaml:"servers"`
 	Connections Connections `yaml:"connections"`
 	Secrets     Secrets     `yaml:"secrets"`
+	Tracing     Tracing     `yaml:"tracing"`
 }
 
 type Servers struct {
 	GRPC GRPCConfig `yaml:"grpc"`
 	HTTP HTTPConfig `yaml:"http"`
+	// Internal, it is not proxied by the gateway
+	Metrics MetricsConfig `yaml:"metrics"`
+	Health  HealthConfig  `yaml:"health"`
 }
 
 type GRPCConfig struct {
@@ -33,13 +38,32 @@ type HTTPConfig struct {
 	Timeout time.Duration `env:"HTTP_TIMEOUT" env-default:"5s" yaml:"timeout"`
 }
 
+type MetricsConfig struct {
+	Host string `env:"METRICS_HOST" env-default:"" yaml:"host"`
+	// 0 disables the metrics server
+	Port int `env:"METRICS_PORT" env-default:"2112" yaml:"port"`
+}
+
+type HealthConfig struct {
+	// Every probe of a dependency in the readiness checks is canceled after it
+	Timeout time.Duration `env:"HEALTH_TIMEOUT" env-default:"2s" yaml:"timeout"`
+}
+
 type Connections struct {
-	PGConfig    pg.Config    `yaml:"postgres"`
-	RedisConfig redis.Config `yaml:"redis"`
-	SongsConn   songs.Config `yaml:"songs"`
-	S3Config    s3.Config    `yaml:"s3"`
+	PGConfig    pg.Config     `yaml:"postgres"`
+	RedisConfig redis.Config  `yaml:"redis"`
+	SongsConn   songs.Config  `yaml:"songs"`
+	S3Config    s3.Config     `yaml:"s3"`
+	KafkaConfig broker.Config `yaml:"kafka"`
 }
 
 type Secrets struct {
 	Public string `env:"PUBLIC_KEY" env-default:"" yaml:"public"`
 }
+
+type Tracing struct {
+	// OTLP gRPC endpoint of the collector, empty keeps the traces only in the traceId of the logs
+	Endpoint    string  `env:"TRACING_ENDPOINT" env-default:"" yaml:"endpoint"`
+	Insecure    bool    `env:"TRACING_INSECURE" env-default:"true" yaml:"insecure"`
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO" env-default:"1" yaml:"sampleRatio"`
+}
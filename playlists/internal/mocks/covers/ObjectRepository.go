@@ -7,9 +7,9 @@ import (
 
 	io "io"
 
-	minio "github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/minio"
-
 	mock "github.com/stretchr/testify/mock"
+
+	objects "github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/objects"
 )
 
 // ObjectRepository is an autogenerated mock type for the ObjectRepository type
@@ -85,7 +85,7 @@ func (_c *ObjectRepository_GetCoverObject_Call) RunAndReturn(run func(context.Co
 }
 
 // PutCoverObject provides a mock function with given fields: ctx, image
-func (_m *ObjectRepository) PutCoverObject(ctx context.Context, image minio.CoverObject) error {
+func (_m *ObjectRepository) PutCoverObject(ctx context.Context, image objects.CoverObject) error {
 	ret := _m.Called(ctx, image)
 
 	if len(ret) == 0 {
@@ -93,7 +93,7 @@ func (_m *ObjectRepository) PutCoverObject(ctx context.Context, image minio.Cove
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, minio.CoverObject) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, objects.CoverObject) error); ok {
 		r0 = rf(ctx, image)
 	} else {
 		r0 = ret.Error(0)
@@ -109,14 +109,14 @@ type ObjectRepository_PutCoverObject_Call struct {
 
 // PutCoverObject is a helper method to define mock.On call
 //   - ctx context.Context
-//   - image minio.CoverObject
+//   - image objects.CoverObject
 func (_e *ObjectRepository_Expecter) PutCoverObject(ctx interface{}, image interface{}) *ObjectRepository_PutCoverObject_Call {
 	return &ObjectRepository_PutCoverObject_Call{Call: _e.mock.On("PutCoverObject", ctx, image)}
 }
 
-func (_c *ObjectRepository_PutCoverObject_Call) Run(run func(ctx context.Context, image minio.CoverObject)) *ObjectRepository_PutCoverObject_Call {
+func (_c *ObjectRepository_PutCoverObject_Call) Run(run func(ctx context.Context, image objects.CoverObject)) *ObjectRepository_PutCoverObject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(minio.CoverObject))
+		run(args[0].(context.Context), args[1].(objects.CoverObject))
 	})
 	return _c
 }
@@ -126,7 +126,7 @@ func (_c *ObjectRepository_PutCoverObject_Call) Return(_a0 error) *ObjectReposit
 	return _c
 }
 
-func (_c *ObjectRepository_PutCoverObject_Call) RunAndReturn(run func(context.Context, minio.CoverObject) error) *ObjectRepository_PutCoverObject_Call {
+func (_c *ObjectRepository_PutCoverObject_Call) RunAndReturn(run func(context.Context, objects.CoverObject) error) *ObjectRepository_PutCoverObject_Call {
 	_c.Call.Return(run)
 	return _c
 }
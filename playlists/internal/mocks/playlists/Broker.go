@@ -0,0 +1,85 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package playlistsmocks
+
+import (
+	context "context"
+
+	broker "github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/broker"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Broker is an autogenerated mock type for the Broker type
+type Broker struct {
+	mock.Mock
+}
+
+type Broker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Broker) EXPECT() *Broker_Expecter {
+	return &Broker_Expecter{mock: &_m.Mock}
+}
+
+// SendMessages provides a mock function with given fields: ctx, messages
+func (_m *Broker) SendMessages(ctx context.Context, messages []broker.Message) error {
+	ret := _m.Called(ctx, messages)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []broker.Message) error); ok {
+		r0 = rf(ctx, messages)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Broker_SendMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessages'
+type Broker_SendMessages_Call struct {
+	*mock.Call
+}
+
+// SendMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messages []broker.Message
+func (_e *Broker_Expecter) SendMessages(ctx interface{}, messages interface{}) *Broker_SendMessages_Call {
+	return &Broker_SendMessages_Call{Call: _e.mock.On("SendMessages", ctx, messages)}
+}
+
+func (_c *Broker_SendMessages_Call) Run(run func(ctx context.Context, messages []broker.Message)) *Broker_SendMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]broker.Message))
+	})
+	return _c
+}
+
+func (_c *Broker_SendMessages_Call) Return(_a0 error) *Broker_SendMessages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Broker_SendMessages_Call) RunAndReturn(run func(context.Context, []broker.Message) error) *Broker_SendMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBroker creates a new instance of Broker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBroker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Broker {
+	mock := &Broker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
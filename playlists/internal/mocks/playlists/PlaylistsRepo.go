@@ -131,24 +131,22 @@ func (_c *PlaylistsRepo_Commit_Call) RunAndReturn(run func(context.Context) erro
 }
 
 // CopyPlaylist provides a mock function with given fields: ctx, arg
-func (_m *PlaylistsRepo) CopyPlaylist(ctx context.Context, arg postgres.CopyPlaylistParams) (uuid.UUID, error) {
+func (_m *PlaylistsRepo) CopyPlaylist(ctx context.Context, arg postgres.CopyPlaylistParams) (postgres.CopyPlaylistRow, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CopyPlaylist")
 	}
 
-	var r0 uuid.UUID
+	var r0 postgres.CopyPlaylistRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, postgres.CopyPlaylistParams) (uuid.UUID, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CopyPlaylistParams) (postgres.CopyPlaylistRow, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, postgres.CopyPlaylistParams) uuid.UUID); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CopyPlaylistParams) postgres.CopyPlaylistRow); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
-		}
+		r0 = ret.Get(0).(postgres.CopyPlaylistRow)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, postgres.CopyPlaylistParams) error); ok {
@@ -179,12 +177,12 @@ func (_c *PlaylistsRepo_CopyPlaylist_Call) Run(run func(ctx context.Context, arg
 	return _c
 }
 
-func (_c *PlaylistsRepo_CopyPlaylist_Call) Return(_a0 uuid.UUID, _a1 error) *PlaylistsRepo_CopyPlaylist_Call {
+func (_c *PlaylistsRepo_CopyPlaylist_Call) Return(_a0 postgres.CopyPlaylistRow, _a1 error) *PlaylistsRepo_CopyPlaylist_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PlaylistsRepo_CopyPlaylist_Call) RunAndReturn(run func(context.Context, postgres.CopyPlaylistParams) (uuid.UUID, error)) *PlaylistsRepo_CopyPlaylist_Call {
+func (_c *PlaylistsRepo_CopyPlaylist_Call) RunAndReturn(run func(context.Context, postgres.CopyPlaylistParams) (postgres.CopyPlaylistRow, error)) *PlaylistsRepo_CopyPlaylist_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -284,21 +282,31 @@ func (_c *PlaylistsRepo_DislikePlaylist_Call) RunAndReturn(run func(context.Cont
 }
 
 // DislikeTrack provides a mock function with given fields: ctx, arg
-func (_m *PlaylistsRepo) DislikeTrack(ctx context.Context, arg postgres.DislikeTrackParams) error {
+func (_m *PlaylistsRepo) DislikeTrack(ctx context.Context, arg postgres.DislikeTrackParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DislikeTrack")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, postgres.DislikeTrackParams) error); ok {
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.DislikeTrackParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.DislikeTrackParams) int64); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.DislikeTrackParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // PlaylistsRepo_DislikeTrack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DislikeTrack'
@@ -320,12 +328,12 @@ func (_c *PlaylistsRepo_DislikeTrack_Call) Run(run func(ctx context.Context, arg
 	return _c
 }
 
-func (_c *PlaylistsRepo_DislikeTrack_Call) Return(_a0 error) *PlaylistsRepo_DislikeTrack_Call {
-	_c.Call.Return(_a0)
+func (_c *PlaylistsRepo_DislikeTrack_Call) Return(_a0 int64, _a1 error) *PlaylistsRepo_DislikeTrack_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PlaylistsRepo_DislikeTrack_Call) RunAndReturn(run func(context.Context, postgres.DislikeTrackParams) error) *PlaylistsRepo_DislikeTrack_Call {
+func (_c *PlaylistsRepo_DislikeTrack_Call) RunAndReturn(run func(context.Context, postgres.DislikeTrackParams) (int64, error)) *PlaylistsRepo_DislikeTrack_Call {
 	_c.Call.Return(run)
 	return _c
 }
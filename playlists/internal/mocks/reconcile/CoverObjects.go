@@ -0,0 +1,88 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package reconcilemocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// CoverObjects is an autogenerated mock type for the CoverObjects type
+type CoverObjects struct {
+	mock.Mock
+}
+
+type CoverObjects_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CoverObjects) EXPECT() *CoverObjects_Expecter {
+	return &CoverObjects_Expecter{mock: &_m.Mock}
+}
+
+// CoverObjectID provides a mock function with given fields: coverURL
+func (_m *CoverObjects) CoverObjectID(coverURL string) (string, bool) {
+	ret := _m.Called(coverURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CoverObjectID")
+	}
+
+	var r0 string
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (string, bool)); ok {
+		return rf(coverURL)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(coverURL)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(coverURL)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// CoverObjects_CoverObjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CoverObjectID'
+type CoverObjects_CoverObjectID_Call struct {
+	*mock.Call
+}
+
+// CoverObjectID is a helper method to define mock.On call
+//   - coverURL string
+func (_e *CoverObjects_Expecter) CoverObjectID(coverURL interface{}) *CoverObjects_CoverObjectID_Call {
+	return &CoverObjects_CoverObjectID_Call{Call: _e.mock.On("CoverObjectID", coverURL)}
+}
+
+func (_c *CoverObjects_CoverObjectID_Call) Run(run func(coverURL string)) *CoverObjects_CoverObjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CoverObjects_CoverObjectID_Call) Return(_a0 string, _a1 bool) *CoverObjects_CoverObjectID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CoverObjects_CoverObjectID_Call) RunAndReturn(run func(string) (string, bool)) *CoverObjects_CoverObjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCoverObjects creates a new instance of CoverObjects. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCoverObjects(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CoverObjects {
+	mock := &CoverObjects{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,131 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package reconcilemocks
+
+import (
+	context "context"
+
+	objstore "github.com/Benzogang-Tape/audio-hosting/playlists/pkg/objstore"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ObjectRepository is an autogenerated mock type for the ObjectRepository type
+type ObjectRepository struct {
+	mock.Mock
+}
+
+type ObjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ObjectRepository) EXPECT() *ObjectRepository_Expecter {
+	return &ObjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteCoverObject provides a mock function with given fields: ctx, id
+func (_m *ObjectRepository) DeleteCoverObject(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCoverObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectRepository_DeleteCoverObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCoverObject'
+type ObjectRepository_DeleteCoverObject_Call struct {
+	*mock.Call
+}
+
+// DeleteCoverObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectRepository_Expecter) DeleteCoverObject(ctx interface{}, id interface{}) *ObjectRepository_DeleteCoverObject_Call {
+	return &ObjectRepository_DeleteCoverObject_Call{Call: _e.mock.On("DeleteCoverObject", ctx, id)}
+}
+
+func (_c *ObjectRepository_DeleteCoverObject_Call) Run(run func(ctx context.Context, id string)) *ObjectRepository_DeleteCoverObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectRepository_DeleteCoverObject_Call) Return(_a0 error) *ObjectRepository_DeleteCoverObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectRepository_DeleteCoverObject_Call) RunAndReturn(run func(context.Context, string) error) *ObjectRepository_DeleteCoverObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCoverObjects provides a mock function with given fields: ctx, fn
+func (_m *ObjectRepository) ListCoverObjects(ctx context.Context, fn func(objstore.ObjectInfo) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCoverObjects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(objstore.ObjectInfo) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectRepository_ListCoverObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCoverObjects'
+type ObjectRepository_ListCoverObjects_Call struct {
+	*mock.Call
+}
+
+// ListCoverObjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(objstore.ObjectInfo) error
+func (_e *ObjectRepository_Expecter) ListCoverObjects(ctx interface{}, fn interface{}) *ObjectRepository_ListCoverObjects_Call {
+	return &ObjectRepository_ListCoverObjects_Call{Call: _e.mock.On("ListCoverObjects", ctx, fn)}
+}
+
+func (_c *ObjectRepository_ListCoverObjects_Call) Run(run func(ctx context.Context, fn func(objstore.ObjectInfo) error)) *ObjectRepository_ListCoverObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(objstore.ObjectInfo) error))
+	})
+	return _c
+}
+
+func (_c *ObjectRepository_ListCoverObjects_Call) Return(_a0 error) *ObjectRepository_ListCoverObjects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectRepository_ListCoverObjects_Call) RunAndReturn(run func(context.Context, func(objstore.ObjectInfo) error) error) *ObjectRepository_ListCoverObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewObjectRepository creates a new instance of ObjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewObjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ObjectRepository {
+	mock := &ObjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package reconcilemocks
+
+import (
+	context "context"
+
+	postgres "github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+type Repository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repository) EXPECT() *Repository_Expecter {
+	return &Repository_Expecter{mock: &_m.Mock}
+}
+
+// PlaylistCovers provides a mock function with given fields: ctx, arg
+func (_m *Repository) PlaylistCovers(ctx context.Context, arg postgres.PlaylistCoversParams) ([]postgres.PlaylistCoversRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaylistCovers")
+	}
+
+	var r0 []postgres.PlaylistCoversRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.PlaylistCoversParams) ([]postgres.PlaylistCoversRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.PlaylistCoversParams) []postgres.PlaylistCoversRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.PlaylistCoversRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.PlaylistCoversParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_PlaylistCovers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaylistCovers'
+type Repository_PlaylistCovers_Call struct {
+	*mock.Call
+}
+
+// PlaylistCovers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg postgres.PlaylistCoversParams
+func (_e *Repository_Expecter) PlaylistCovers(ctx interface{}, arg interface{}) *Repository_PlaylistCovers_Call {
+	return &Repository_PlaylistCovers_Call{Call: _e.mock.On("PlaylistCovers", ctx, arg)}
+}
+
+func (_c *Repository_PlaylistCovers_Call) Run(run func(ctx context.Context, arg postgres.PlaylistCoversParams)) *Repository_PlaylistCovers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.PlaylistCoversParams))
+	})
+	return _c
+}
+
+func (_c *Repository_PlaylistCovers_Call) Return(_a0 []postgres.PlaylistCoversRow, _a1 error) *Repository_PlaylistCovers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_PlaylistCovers_Call) RunAndReturn(run func(context.Context, postgres.PlaylistCoversParams) ([]postgres.PlaylistCoversRow, error)) *Repository_PlaylistCovers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
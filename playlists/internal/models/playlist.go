@@ -16,6 +16,7 @@ type PlaylistMetadata struct {
 	IsAlbum        bool      `json:"is_album" db:"is_album"`
 	IsMyCollection bool      `json:"is_my_collection" db:"-"`
 	IsPublic       bool      `json:"is_public" db:"is_public"`
+	Explicit       bool      `json:"explicit" db:"explicit"`
 }
 
 type Playlist struct {
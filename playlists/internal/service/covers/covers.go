@@ -6,9 +6,10 @@ import (
 	"dev.gaijin.team/go/golib/fields"
 	"errors"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service"
-	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/minio"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/objects"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/objstore"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/pgconv"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -99,7 +100,7 @@ func (s *ServiceCovers) UploadRawCover(
 		zap.String("playlist_id", input.PlaylistId.String()),
 	)
 
-	err = s.objRepo.PutCoverObject(ctx, minio.CoverObject{
+	err = s.objRepo.PutCoverObject(ctx, objects.CoverObject{
 		ID:          objectID,
 		Extension:   input.Extension,
 		WeightBytes: input.WeightBytes,
@@ -126,7 +127,12 @@ type GetRawCoverOutput struct {
 
 func (s *ServiceCovers) GetRawCover(ctx context.Context, coverID string) (GetRawCoverOutput, error) {
 	reader, err := s.objRepo.GetCoverObject(ctx, coverID)
-	if err != nil {
+
+	switch {
+	case errors.Is(err, objstore.ErrNotFound):
+		return GetRawCoverOutput{}, service.ErrCoverNotFound.Wrap(err, fields.F("cover_id", coverID))
+
+	case err != nil:
 		return GetRawCoverOutput{}, e.NewFrom("getting cover object", err, fields.F("cover_id", coverID))
 	}
 
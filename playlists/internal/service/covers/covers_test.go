@@ -5,9 +5,11 @@ import (
 	client "github.com/Benzogang-Tape/audio-hosting/playlists/internal/client/songs"
 	coversmocks "github.com/Benzogang-Tape/audio-hosting/playlists/internal/mocks/covers"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/models"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service/covers"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/objstore"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/pgconv"
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/google/uuid"
@@ -65,6 +67,13 @@ func TestGetRawCover(t *testing.T) {
 	suite.Run(t, new(GetRawCoverSuite))
 }
 
+func (s *GetRawCoverSuite) TestNotFound() {
+	s.or.EXPECT().GetCoverObject(mock.Anything, mock.Anything).Return(nil, objstore.ErrNotFound).Once()
+
+	_, err := s.s.GetRawCover(s.ctx, s.input)
+	s.ErrorIs(err, service.ErrCoverNotFound)
+}
+
 type FakeReader struct{}
 
 func (f FakeReader) Read(p []byte) (n int, err error) {
@@ -17,3 +17,17 @@ func (s *ServiceCovers) CoverURL(playlistID, rawCoverId string) string {
 
 	return tmpl[0] + playlistID + tmpl[1] + rawCoverId
 }
+
+// CoverObjectID returns the object of the cover, covers hosted elsewhere have none.
+func (s *ServiceCovers) CoverObjectID(coverURL string) (string, bool) {
+	tmpl := strings.Split(s.coverURLTmpl, "{playlist_id}")
+
+	rest, ok := strings.CutPrefix(coverURL, tmpl[0])
+	if !ok {
+		return "", false
+	}
+
+	_, objectID, ok := strings.Cut(rest, tmpl[1])
+
+	return objectID, ok && objectID != ""
+}
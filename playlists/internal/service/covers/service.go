@@ -3,7 +3,7 @@ package covers
 import (
 	"context"
 	"fmt"
-	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/minio"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/objects"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
 	"github.com/google/uuid"
 	"io"
@@ -24,7 +24,7 @@ type ObjectRepository interface {
 
 	PutCoverObject(
 		ctx context.Context,
-		image minio.CoverObject,
+		image objects.CoverObject,
 	) error
 }
 
@@ -14,6 +14,7 @@ var (
 	)
 	ErrNoPlaylistToLike      = erix.NewStatus("no playlist to like", erix.CodeBadRequest)
 	ErrInvalidCoverExtension = erix.NewStatus("invalid extension, only jpg, png, jpeg supported", erix.CodeBadRequest)
+	ErrCoverNotFound         = erix.NewStatus("cover not found", erix.CodeNotFound)
 
 	ErrNoFilters       = erix.NewStatus("no filters", erix.CodeBadRequest)
 	ErrMultipleFilters = erix.NewStatus("multiple filters", erix.CodeBadRequest)
@@ -4,6 +4,7 @@ import (
 	"context"
 	client "github.com/Benzogang-Tape/audio-hosting/playlists/internal/client/songs"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/broker"
 	"time"
 
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/models"
@@ -21,6 +22,8 @@ type CreatePlaylistInput struct {
 	AuthorID uuid.UUID
 	CoverURL string
 	IsAlbum  bool
+	// Explicit is ignored for playlists, only albums can be explicit.
+	Explicit bool
 }
 
 func (s *ServicePlaylists) CreatePlaylist(ctx context.Context, in CreatePlaylistInput) (models.Playlist, error) {
@@ -35,6 +38,7 @@ func (s *ServicePlaylists) CreatePlaylist(ctx context.Context, in CreatePlaylist
 		CoverUrl:  pgconv.Text(in.CoverURL),
 		CreatedAt: time.Now(),
 		IsAlbum:   in.IsAlbum,
+		Explicit:  in.IsAlbum && in.Explicit,
 	}
 
 	err := s.repo.SavePlaylist(ctx, playlistParam)
@@ -43,6 +47,16 @@ func (s *ServicePlaylists) CreatePlaylist(ctx context.Context, in CreatePlaylist
 		return models.Playlist{}, service.ErrSavingPlaylist
 	}
 
+	if added := addedTracks(nil, in.TrackIDs); len(added) > 0 {
+		s.publish(ctx, broker.PlaylistTracksAddedMessage{
+			PlaylistId: playlistParam.ID,
+			AuthorId:   in.AuthorID,
+			TrackIds:   added,
+			IsAlbum:    in.IsAlbum,
+			AddedAt:    playlistParam.CreatedAt,
+		})
+	}
+
 	playlistMetadata := models.PlaylistMetadata{ //nolint:exhaustruct
 		ID:             playlistParam.ID.String(),
 		Title:          playlistParam.Title,
@@ -52,6 +66,7 @@ func (s *ServicePlaylists) CreatePlaylist(ctx context.Context, in CreatePlaylist
 		IsAlbum:        in.IsAlbum,
 		IsMyCollection: false,
 		IsPublic:       false,
+		Explicit:       playlistParam.Explicit,
 	}
 
 	songs, err := s.getSongs(ctx, playlistParam.TrackIds)
@@ -9,6 +9,7 @@ import (
 
 	playlistsmocks "github.com/Benzogang-Tape/audio-hosting/playlists/internal/mocks/playlists"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service/playlists"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/broker"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -19,6 +20,7 @@ type CreatePlaylistSuite struct {
 
 	pr *playlistsmocks.PlaylistsRepo
 	sr *playlistsmocks.SongsRepo
+	br *playlistsmocks.Broker
 
 	s     *playlists.ServicePlaylists
 	ctx   context.Context
@@ -28,8 +30,9 @@ type CreatePlaylistSuite struct {
 func (s *CreatePlaylistSuite) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 	s.sr = playlistsmocks.NewSongsRepo(s.T())
+	s.br = playlistsmocks.NewBroker(s.T())
 
-	s.s = playlists.New(s.pr, s.sr)
+	s.s = playlists.New(s.pr, s.sr, s.br)
 
 	log := logger.New("test", "prod")
 
@@ -40,6 +43,10 @@ func (s *CreatePlaylistSuite) SetupTest() {
 func (s *CreatePlaylistSuite) TestHappyPath() {
 	s.pr.EXPECT().SavePlaylist(mock.Anything, mock.Anything).Return(nil).Once()
 	s.sr.EXPECT().GetSongs(mock.Anything, mock.Anything).Return([]songs.Song{}, nil).Maybe()
+	s.br.EXPECT().SendMessages(mock.Anything, mock.MatchedBy(func(msgs []broker.Message) bool {
+		added, ok := msgs[0].(broker.PlaylistTracksAddedMessage)
+		return len(msgs) == 1 && ok && added.AuthorId == s.input.AuthorID && len(added.TrackIds) == 1
+	})).Return(nil).Once()
 
 	output, err := s.s.CreatePlaylist(s.ctx, s.input)
 	s.NoError(err)
@@ -58,12 +65,24 @@ func (s *CreatePlaylistSuite) TestDbError() {
 func (s *CreatePlaylistSuite) TestSongsServiceError() {
 	s.pr.EXPECT().SavePlaylist(mock.Anything, mock.Anything).Return(nil).Once()
 	s.sr.EXPECT().GetSongs(mock.Anything, mock.Anything).Return(nil, gofakeit.ErrorDatabase()).Maybe()
+	s.br.EXPECT().SendMessages(mock.Anything, mock.Anything).Return(nil).Once()
 
 	output, err := s.s.CreatePlaylist(s.ctx, s.input)
 	s.Error(err)
 	s.NotEmpty(output)
 }
 
+func (s *CreatePlaylistSuite) TestWithoutTracks() {
+	s.input.TrackIDs = nil
+
+	s.pr.EXPECT().SavePlaylist(mock.Anything, mock.Anything).Return(nil).Once()
+	s.sr.EXPECT().GetSongs(mock.Anything, mock.Anything).Return([]songs.Song{}, nil).Maybe()
+
+	output, err := s.s.CreatePlaylist(s.ctx, s.input)
+	s.NoError(err)
+	s.NotEmpty(output)
+}
+
 func TestCreatePlaylist(t *testing.T) {
 	suite.Run(t, new(CreatePlaylistSuite))
 }
@@ -18,6 +18,7 @@ type DeletePlaylistSuit struct {
 
 	pr *playlistsmocks.PlaylistsRepo
 	sr *playlistsmocks.SongsRepo
+	br *playlistsmocks.Broker
 
 	s     *playlists.ServicePlaylists
 	ctx   context.Context
@@ -27,8 +28,9 @@ type DeletePlaylistSuit struct {
 func (s *DeletePlaylistSuit) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 	s.sr = playlistsmocks.NewSongsRepo(s.T())
+	s.br = playlistsmocks.NewBroker(s.T())
 
-	s.s = playlists.New(s.pr, s.sr)
+	s.s = playlists.New(s.pr, s.sr, s.br)
 
 	log := logger.New("test", "prod")
 
@@ -0,0 +1,38 @@
+package playlists
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// publish sends the events after the change is stored.
+// A failed publish is logged and does not fail the request, the change is already made.
+func (s *ServicePlaylists) publish(ctx context.Context, messages ...broker.Message) {
+	err := s.broker.SendMessages(ctx, messages)
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).Error(
+			ctx, "failed to publish events",
+			zap.String("layout", "service/playlists"),
+			zap.Int("count", len(messages)),
+			zap.Error(err))
+	}
+}
+
+// addedTracks returns the tracks of the new list which the old one doesn't have.
+func addedTracks(old, updated []uuid.UUID) []uuid.UUID {
+	var added []uuid.UUID
+
+	for _, id := range updated {
+		if !slices.Contains(old, id) && !slices.Contains(added, id) {
+			added = append(added, id)
+		}
+	}
+
+	return added
+}
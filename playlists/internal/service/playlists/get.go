@@ -69,6 +69,7 @@ func (s *ServicePlaylists) GetPlaylist(ctx context.Context, playlistID string) (
 		IsAlbum:        pl.IsAlbum,
 		IsPublic:       pl.IsPublic,
 		IsMyCollection: false,
+		Explicit:       pl.Explicit,
 	}
 
 	songs, err := s.getSongs(ctx, pl.TrackIds)
@@ -26,6 +26,7 @@ type GetPlaylistSuite struct {
 
 	pr *playlistsmocks.PlaylistsRepo
 	sr *playlistsmocks.SongsRepo
+	br *playlistsmocks.Broker
 
 	s     *playlists.ServicePlaylists
 	ctx   context.Context
@@ -36,8 +37,9 @@ type GetPlaylistSuite struct {
 func (s *GetPlaylistSuite) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 	s.sr = playlistsmocks.NewSongsRepo(s.T())
+	s.br = playlistsmocks.NewBroker(s.T())
 
-	s.s = playlists.New(s.pr, s.sr)
+	s.s = playlists.New(s.pr, s.sr, s.br)
 
 	log := logger.New("test", "prod")
 
@@ -126,6 +128,30 @@ func (s *GetPlaylistSuite) TestSongsServiceError() {
 	s.NotEmpty(output)
 }
 
+func (s *GetPlaylistSuite) TestHideExplicit() {
+	out := validPostgresRow(s.input)
+	out.Playlist.IsPublic = true
+
+	s.ctx = uniceptors.CtxWithToken(s.ctx, auth.Token{ //nolint:exhaustruct
+		Subject:      uuid.New(),
+		HideExplicit: true,
+		Exp:          time.Now().Add(time.Minute).Unix(),
+	})
+
+	songs := []client.Song{
+		{ID: out.Playlist.TrackIds[0].String(), Explicit: true},
+		{ID: out.Playlist.TrackIds[1].String(), Explicit: false},
+	}
+
+	s.pr.EXPECT().Playlist(mock.Anything, mock.Anything).Return(out, nil).Once()
+	s.sr.EXPECT().GetSongs(mock.Anything, mock.Anything).Return(songs, nil).Once()
+
+	output, err := s.s.GetPlaylist(s.ctx, s.input)
+	s.Require().NoError(err)
+	s.Require().Len(output.Songs, 1)
+	s.Equal(out.Playlist.TrackIds[1].String(), output.Songs[0].ID)
+}
+
 func TestGetPlaylist(t *testing.T) {
 	suite.Run(t, new(GetPlaylistSuite))
 }
@@ -184,7 +210,7 @@ type GetPlaylistsSuite struct {
 func (s *GetPlaylistsSuite) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 
-	s.s = playlists.New(s.pr, nil)
+	s.s = playlists.New(s.pr, nil, nil)
 
 	log := logger.New("test", "prod")
 	s.ctx = context.WithValue(context.Background(), logger.LoggerKey, log)
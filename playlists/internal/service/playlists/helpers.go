@@ -2,6 +2,8 @@ package playlists
 
 import (
 	"context"
+	"slices"
+
 	"dev.gaijin.team/go/golib/e"
 	"dev.gaijin.team/go/golib/fields"
 	"github.com/AlekSi/pointer"
@@ -9,6 +11,7 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/models"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/transport/grpc/uniceptors"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/pgconv"
 	"github.com/google/uuid"
@@ -28,6 +31,12 @@ func (s *ServicePlaylists) getSongs(ctx context.Context, trackIDs []uuid.UUID) (
 		return nil, e.From(err, fields.F("GetSongs; getting songs:", err))
 	}
 
+	// The songs service skips explicit songs for such listeners too,
+	// but the playlist must not depend on which songs client is plugged in.
+	if token, ok := uniceptors.TokenFromCtx(ctx); ok && token.HideExplicit {
+		songs = slices.DeleteFunc(songs, func(song client.Song) bool { return song.Explicit })
+	}
+
 	return songs, nil
 }
 
@@ -43,6 +52,7 @@ func (*ServicePlaylists) convToPlaylistMetadata(row postgres.Playlist) models.Pl
 		IsAlbum:        row.IsAlbum,
 		IsMyCollection: false,
 		IsPublic:       row.IsPublic,
+		Explicit:       row.Explicit,
 	}
 }
 
@@ -3,6 +3,7 @@ package playlists
 import (
 	"context"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/client/songs"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/broker"
 
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
 
@@ -10,8 +11,9 @@ import (
 )
 
 type ServicePlaylists struct {
-	repo  PlaylistsRepo
-	songs SongsRepo
+	repo   PlaylistsRepo
+	songs  SongsRepo
+	broker Broker
 }
 
 type PlaylistsRepo interface {
@@ -26,10 +28,10 @@ type PlaylistsRepo interface {
 	// user's actions
 	LikePlaylist(ctx context.Context, arg postgres.LikePlaylistParams) (uuid.UUID, error)
 	DislikePlaylist(ctx context.Context, arg postgres.DislikePlaylistParams) error
-	CopyPlaylist(ctx context.Context, arg postgres.CopyPlaylistParams) (uuid.UUID, error)
+	CopyPlaylist(ctx context.Context, arg postgres.CopyPlaylistParams) (postgres.CopyPlaylistRow, error)
 	UserPlaylists(ctx context.Context, userID uuid.UUID) ([]postgres.UserPlaylistsRow, error)
 	MyCollection(ctx context.Context, userID uuid.UUID) ([]postgres.MyCollectionRow, error)
-	DislikeTrack(ctx context.Context, arg postgres.DislikeTrackParams) error
+	DislikeTrack(ctx context.Context, arg postgres.DislikeTrackParams) (int64, error)
 	LikeTrack(ctx context.Context, arg postgres.LikeTrackParams) (uuid.UUID, error)
 
 	// Transactions
@@ -44,9 +46,15 @@ type SongsRepo interface {
 	ReleaseSongs(ctx context.Context, ids []string) error
 }
 
-func New(repo PlaylistsRepo, songsRepo SongsRepo) *ServicePlaylists {
+// Broker publishes likes and playlist additions of tracks, artists get their analytics from them.
+type Broker interface {
+	SendMessages(ctx context.Context, messages []broker.Message) error
+}
+
+func New(repo PlaylistsRepo, songsRepo SongsRepo, broker Broker) *ServicePlaylists {
 	return &ServicePlaylists{
-		repo:  repo,
-		songs: songsRepo,
+		repo:   repo,
+		songs:  songsRepo,
+		broker: broker,
 	}
 }
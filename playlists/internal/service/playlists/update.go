@@ -7,6 +7,7 @@ import (
 	"github.com/AlekSi/pointer"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/models"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/broker"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/pgconv"
@@ -23,6 +24,8 @@ type UpdatePlaylistInput struct {
 	CoverURL   *string
 	IsPublic   *bool
 	TrackIDs   []string
+	// Explicit is ignored for playlists, only albums can be explicit.
+	Explicit *bool
 }
 
 func (s *ServicePlaylists) UpdatePlaylist(
@@ -56,6 +59,29 @@ func (s *ServicePlaylists) UpdatePlaylist(
 		params.IsPublic = pgconv.Bool(*in.IsPublic)
 	}
 
+	if in.Explicit != nil {
+		params.Explicit = pgconv.Bool(*in.Explicit)
+	}
+
+	// The old tracks tell which ones are added to the playlist
+	var oldTracks []uuid.UUID
+
+	if in.TrackIDs != nil {
+		params.TrackIds = convertToUUID(in.TrackIDs)
+
+		current, err := s.repo.Playlist(ctx, params.ID)
+
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return playlistMetadata, service.ErrUpdatePlaylist
+
+		case err != nil:
+			return playlistMetadata, e.NewFrom("failed to get playlist", err)
+		}
+
+		oldTracks = current.Playlist.TrackIds
+	}
+
 	playlist, err := s.repo.PatchPlaylist(ctx, params)
 
 	switch {
@@ -72,6 +98,16 @@ func (s *ServicePlaylists) UpdatePlaylist(
 		return playlistMetadata, e.NewFrom("failed to update playlist", err)
 	}
 
+	if added := addedTracks(oldTracks, params.TrackIds); len(added) > 0 {
+		s.publish(ctx, broker.PlaylistTracksAddedMessage{
+			PlaylistId: playlist.ID,
+			AuthorId:   playlist.AuthorID,
+			TrackIds:   added,
+			IsAlbum:    playlist.IsAlbum,
+			AddedAt:    params.UpdatedAt,
+		})
+	}
+
 	playlistMetadata = models.PlaylistMetadata{
 		ID:             playlist.ID.String(),
 		Title:          playlist.Title,
@@ -83,6 +119,7 @@ func (s *ServicePlaylists) UpdatePlaylist(
 		IsAlbum:        playlist.IsAlbum,
 		IsMyCollection: false,
 		IsPublic:       playlist.IsPublic,
+		Explicit:       playlist.Explicit,
 	}
 
 	return playlistMetadata, nil
@@ -22,6 +22,7 @@ type UpdatePlaylistSuite struct {
 
 	pr *playlistsmocks.PlaylistsRepo
 	sr *playlistsmocks.SongsRepo
+	br *playlistsmocks.Broker
 
 	s     *playlists.ServicePlaylists
 	ctx   context.Context
@@ -31,8 +32,9 @@ type UpdatePlaylistSuite struct {
 func (s *UpdatePlaylistSuite) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 	s.sr = playlistsmocks.NewSongsRepo(s.T())
+	s.br = playlistsmocks.NewBroker(s.T())
 
-	s.s = playlists.New(s.pr, s.sr)
+	s.s = playlists.New(s.pr, s.sr, s.br)
 
 	log := logger.New("test", "prod")
 
@@ -103,6 +105,7 @@ type ReleaseAlbumSuite struct {
 
 	pr *playlistsmocks.PlaylistsRepo
 	sr *playlistsmocks.SongsRepo
+	br *playlistsmocks.Broker
 
 	s     *playlists.ServicePlaylists
 	ctx   context.Context
@@ -112,8 +115,9 @@ type ReleaseAlbumSuite struct {
 func (s *ReleaseAlbumSuite) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 	s.sr = playlistsmocks.NewSongsRepo(s.T())
+	s.br = playlistsmocks.NewBroker(s.T())
 
-	s.s = playlists.New(s.pr, s.sr)
+	s.s = playlists.New(s.pr, s.sr, s.br)
 
 	log := logger.New("test", "prod")
 
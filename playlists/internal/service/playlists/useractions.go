@@ -8,6 +8,7 @@ import (
 	client "github.com/Benzogang-Tape/audio-hosting/playlists/internal/client/songs"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/models"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/broker"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/erix"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
@@ -101,7 +102,7 @@ func (s *ServicePlaylists) CopyPlaylist(ctx context.Context, in CopyPlaylistInpu
 
 	id := uuid.New()
 
-	_, err := s.repo.CopyPlaylist(ctx, postgres.CopyPlaylistParams{
+	playlist, err := s.repo.CopyPlaylist(ctx, postgres.CopyPlaylistParams{
 		UserID:        uuid.MustParse(in.UserID),
 		PlaylistID:    uuid.MustParse(in.PlaylistID),
 		NewPlaylistID: id,
@@ -122,6 +123,16 @@ func (s *ServicePlaylists) CopyPlaylist(ctx context.Context, in CopyPlaylistInpu
 		return "", erix.NewStatus("failed to copy playlist", erix.CodeInternalServerError)
 	}
 
+	if added := addedTracks(nil, playlist.TrackIds); len(added) > 0 {
+		s.publish(ctx, broker.PlaylistTracksAddedMessage{
+			PlaylistId: id,
+			AuthorId:   uuid.MustParse(in.UserID),
+			TrackIds:   added,
+			IsAlbum:    false,
+			AddedAt:    time.Now(),
+		})
+	}
+
 	return id.String(), nil
 }
 
@@ -156,6 +167,7 @@ func (s *ServicePlaylists) GetMyPlaylists(ctx context.Context, userID string) ([
 			IsAlbum:        row.IsAlbum,
 			IsMyCollection: false,
 			IsPublic:       row.IsPublic,
+			Explicit:       row.Explicit,
 		})
 	}
 
@@ -249,25 +261,44 @@ func (s *ServicePlaylists) LikeTrack(ctx context.Context, in LikeDislikeTrackInp
 		return e.NewFrom("getting song", err)
 	}
 
-	_, err = s.repo.LikeTrack(ctx, postgres.LikeTrackParams{
+	params := postgres.LikeTrackParams{
 		TrackID: uuid.MustParse(in.TrackID),
 		UserID:  uuid.MustParse(in.UserID),
-	})
+	}
+
+	_, err = s.repo.LikeTrack(ctx, params)
 	if err != nil {
 		return e.NewFrom("liking track", err)
 	}
 
+	s.publish(ctx, broker.TrackLikedMessage{
+		TrackId: params.TrackID,
+		UserId:  params.UserID,
+		LikedAt: time.Now(),
+	})
+
 	return nil
 }
 
 func (s *ServicePlaylists) DislikeTrack(ctx context.Context, in LikeDislikeTrackInput) error {
-	err := s.repo.DislikeTrack(ctx, postgres.DislikeTrackParams{
+	params := postgres.DislikeTrackParams{
 		TrackID: uuid.MustParse(in.TrackID),
 		UserID:  uuid.MustParse(in.UserID),
-	})
+	}
+
+	removed, err := s.repo.DislikeTrack(ctx, params)
 	if err != nil {
 		return e.NewFrom("disliking track", err)
 	}
 
+	// Disliking a track which is not liked changes nothing
+	if removed > 0 {
+		s.publish(ctx, broker.TrackUnlikedMessage{
+			TrackId:   params.TrackID,
+			UserId:    params.UserID,
+			UnlikedAt: time.Now(),
+		})
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	client "github.com/Benzogang-Tape/audio-hosting/playlists/internal/client/songs"
 	playlistsmocks "github.com/Benzogang-Tape/audio-hosting/playlists/internal/mocks/playlists"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service/playlists"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/broker"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/pgconv"
@@ -25,6 +26,7 @@ type LikeDislikeSuit struct {
 
 	pr *playlistsmocks.PlaylistsRepo
 	sr *playlistsmocks.SongsRepo
+	br *playlistsmocks.Broker
 
 	input playlists.LikeDislikePlaylistInput
 	s     *playlists.ServicePlaylists
@@ -34,8 +36,9 @@ type LikeDislikeSuit struct {
 func (s *LikeDislikeSuit) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 	s.sr = playlistsmocks.NewSongsRepo(s.T())
+	s.br = playlistsmocks.NewBroker(s.T())
 
-	s.s = playlists.New(s.pr, s.sr)
+	s.s = playlists.New(s.pr, s.sr, s.br)
 
 	log := logger.New("test", "prod")
 
@@ -87,6 +90,7 @@ type CopyPlaylistSuit struct {
 
 	pr *playlistsmocks.PlaylistsRepo
 	sr *playlistsmocks.SongsRepo
+	br *playlistsmocks.Broker
 
 	input playlists.CopyPlaylistInput
 	s     *playlists.ServicePlaylists
@@ -96,8 +100,9 @@ type CopyPlaylistSuit struct {
 func (s *CopyPlaylistSuit) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 	s.sr = playlistsmocks.NewSongsRepo(s.T())
+	s.br = playlistsmocks.NewBroker(s.T())
 
-	s.s = playlists.New(s.pr, s.sr)
+	s.s = playlists.New(s.pr, s.sr, s.br)
 
 	log := logger.New("test", "prod")
 
@@ -109,8 +114,24 @@ func (s *CopyPlaylistSuit) SetupTest() {
 }
 
 func (s *CopyPlaylistSuit) TestHappyPath() {
-	s.pr.EXPECT().CopyPlaylist(mock.Anything, mock.Anything).Return(uuid.New(), nil).Once()
+	tracks := []uuid.UUID{uuid.New(), uuid.New()}
+
+	s.pr.EXPECT().CopyPlaylist(mock.Anything, mock.Anything).
+		Return(postgres.CopyPlaylistRow{ID: uuid.New(), TrackIds: tracks}, nil).Once()
 	s.sr.EXPECT().GetSongs(mock.Anything, mock.Anything).Return([]client.Song{}, nil).Maybe()
+	s.br.EXPECT().SendMessages(mock.Anything, mock.MatchedBy(func(msgs []broker.Message) bool {
+		added, ok := msgs[0].(broker.PlaylistTracksAddedMessage)
+		return len(msgs) == 1 && ok && added.AuthorId.String() == s.input.UserID && len(added.TrackIds) == 2
+	})).Return(nil).Once()
+
+	id, err := s.s.CopyPlaylist(s.ctx, s.input)
+	s.NoError(err)
+	s.NotEmpty(id)
+}
+
+func (s *CopyPlaylistSuit) TestEmptyPlaylist() {
+	s.pr.EXPECT().CopyPlaylist(mock.Anything, mock.Anything).
+		Return(postgres.CopyPlaylistRow{ID: uuid.New(), TrackIds: nil}, nil).Once()
 
 	id, err := s.s.CopyPlaylist(s.ctx, s.input)
 	s.NoError(err)
@@ -119,7 +140,7 @@ func (s *CopyPlaylistSuit) TestHappyPath() {
 
 func (s *CopyPlaylistSuit) TestCopyOwnPlaylist() {
 	// Why pgx.ErrNoRows: because it won't insert any row
-	s.pr.EXPECT().CopyPlaylist(mock.Anything, mock.Anything).Return(uuid.Nil, pgx.ErrNoRows).Once()
+	s.pr.EXPECT().CopyPlaylist(mock.Anything, mock.Anything).Return(postgres.CopyPlaylistRow{}, pgx.ErrNoRows).Once()
 	s.sr.EXPECT().GetSongs(mock.Anything, mock.Anything).Return([]client.Song{}, nil).Maybe()
 
 	id, err := s.s.CopyPlaylist(s.ctx, s.input)
@@ -128,7 +149,7 @@ func (s *CopyPlaylistSuit) TestCopyOwnPlaylist() {
 }
 
 func (s *CopyPlaylistSuit) TestCopyPrivatePlaylist() {
-	s.pr.EXPECT().CopyPlaylist(mock.Anything, mock.Anything).Return(uuid.Nil, pgx.ErrNoRows).Once()
+	s.pr.EXPECT().CopyPlaylist(mock.Anything, mock.Anything).Return(postgres.CopyPlaylistRow{}, pgx.ErrNoRows).Once()
 	s.sr.EXPECT().GetSongs(mock.Anything, mock.Anything).Return([]client.Song{}, nil).Maybe()
 
 	id, err := s.s.CopyPlaylist(s.ctx, s.input)
@@ -137,7 +158,7 @@ func (s *CopyPlaylistSuit) TestCopyPrivatePlaylist() {
 }
 
 func (s *CopyPlaylistSuit) TestDbError() {
-	s.pr.EXPECT().CopyPlaylist(mock.Anything, mock.Anything).Return(uuid.Nil, gofakeit.ErrorDatabase()).Once()
+	s.pr.EXPECT().CopyPlaylist(mock.Anything, mock.Anything).Return(postgres.CopyPlaylistRow{}, gofakeit.ErrorDatabase()).Once()
 	s.sr.EXPECT().GetSongs(mock.Anything, mock.Anything).Return([]client.Song{}, nil).Maybe()
 
 	id, err := s.s.CopyPlaylist(s.ctx, s.input)
@@ -154,6 +175,7 @@ type GetMyPlaylistsSuit struct {
 
 	pr *playlistsmocks.PlaylistsRepo
 	sr *playlistsmocks.SongsRepo
+	br *playlistsmocks.Broker
 
 	s     *playlists.ServicePlaylists
 	ctx   context.Context
@@ -163,8 +185,9 @@ type GetMyPlaylistsSuit struct {
 func (s *GetMyPlaylistsSuit) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 	s.sr = playlistsmocks.NewSongsRepo(s.T())
+	s.br = playlistsmocks.NewBroker(s.T())
 
-	s.s = playlists.New(s.pr, s.sr)
+	s.s = playlists.New(s.pr, s.sr, s.br)
 
 	log := logger.New("test", "prod")
 
@@ -223,6 +246,7 @@ type GetMyCollectionSuit struct {
 
 	pr *playlistsmocks.PlaylistsRepo
 	sr *playlistsmocks.SongsRepo
+	br *playlistsmocks.Broker
 
 	s     *playlists.ServicePlaylists
 	ctx   context.Context
@@ -232,8 +256,9 @@ type GetMyCollectionSuit struct {
 func (s *GetMyCollectionSuit) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 	s.sr = playlistsmocks.NewSongsRepo(s.T())
+	s.br = playlistsmocks.NewBroker(s.T())
 
-	s.s = playlists.New(s.pr, s.sr)
+	s.s = playlists.New(s.pr, s.sr, s.br)
 
 	log := logger.New("test", "prod")
 
@@ -309,6 +334,7 @@ type LikeDislikeSuite struct {
 
 	pr *playlistsmocks.PlaylistsRepo
 	sr *playlistsmocks.SongsRepo
+	br *playlistsmocks.Broker
 
 	s     *playlists.ServicePlaylists
 	ctx   context.Context
@@ -318,8 +344,9 @@ type LikeDislikeSuite struct {
 func (s *LikeDislikeSuite) SetupTest() {
 	s.pr = playlistsmocks.NewPlaylistsRepo(s.T())
 	s.sr = playlistsmocks.NewSongsRepo(s.T())
+	s.br = playlistsmocks.NewBroker(s.T())
 
-	s.s = playlists.New(s.pr, s.sr)
+	s.s = playlists.New(s.pr, s.sr, s.br)
 
 	log := logger.New("test", "prod")
 
@@ -333,6 +360,10 @@ func (s *LikeDislikeSuite) SetupTest() {
 func (s *LikeDislikeSuite) TestHappyPathForLike() {
 	s.sr.EXPECT().GetSong(mock.Anything, mock.Anything).Return(client.Song{}, nil).Once()
 	s.pr.EXPECT().LikeTrack(mock.Anything, mock.Anything).Return(uuid.MustParse(s.input.TrackID), nil).Once()
+	s.br.EXPECT().SendMessages(mock.Anything, mock.MatchedBy(func(msgs []broker.Message) bool {
+		liked, ok := msgs[0].(broker.TrackLikedMessage)
+		return len(msgs) == 1 && ok && liked.TrackId.String() == s.input.TrackID
+	})).Return(nil).Once()
 
 	err := s.s.LikeTrack(s.ctx, s.input)
 	s.NoError(err)
@@ -361,14 +392,34 @@ func (s *LikeDislikeSuite) TestSongsServiceUnavailableForLike() {
 }
 
 func (s *LikeDislikeSuite) TestHappyPathForDislike() {
-	s.pr.EXPECT().DislikeTrack(mock.Anything, mock.Anything).Return(nil).Once()
+	s.pr.EXPECT().DislikeTrack(mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.br.EXPECT().SendMessages(mock.Anything, mock.MatchedBy(func(msgs []broker.Message) bool {
+		unliked, ok := msgs[0].(broker.TrackUnlikedMessage)
+		return len(msgs) == 1 && ok && unliked.TrackId.String() == s.input.TrackID
+	})).Return(nil).Once()
 
 	err := s.s.DislikeTrack(s.ctx, s.input)
 	s.NoError(err)
 }
 
+func (s *LikeDislikeSuite) TestDislikeNotLiked() {
+	s.pr.EXPECT().DislikeTrack(mock.Anything, mock.Anything).Return(0, nil).Once()
+
+	err := s.s.DislikeTrack(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *LikeDislikeSuite) TestPublishErrorForLike() {
+	s.sr.EXPECT().GetSong(mock.Anything, mock.Anything).Return(client.Song{}, nil).Once()
+	s.pr.EXPECT().LikeTrack(mock.Anything, mock.Anything).Return(uuid.MustParse(s.input.TrackID), nil).Once()
+	s.br.EXPECT().SendMessages(mock.Anything, mock.Anything).Return(gofakeit.ErrorHTTPServer()).Once()
+
+	err := s.s.LikeTrack(s.ctx, s.input)
+	s.NoError(err)
+}
+
 func (s *LikeDislikeSuite) TestDbErrorForDislike() {
-	s.pr.EXPECT().DislikeTrack(mock.Anything, mock.Anything).Return(gofakeit.ErrorDatabase()).Once()
+	s.pr.EXPECT().DislikeTrack(mock.Anything, mock.Anything).Return(0, gofakeit.ErrorDatabase()).Once()
 
 	err := s.s.DislikeTrack(s.ctx, s.input)
 	s.Error(err)
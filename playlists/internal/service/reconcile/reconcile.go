@@ -0,0 +1,205 @@
+// Package reconcile compares the cover objects with the playlists referencing them.
+//
+// Orphans are objects no playlist references, replaced covers and uploads that failed
+// after the object was stored. Dangling references are playlists whose covers are missing.
+// Orphans older than the grace period may be deleted, younger ones may belong
+// to uploads still in progress.
+package reconcile
+
+import (
+	"context"
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/objstore"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"time"
+)
+
+// coversBatchSize is how many playlists are read at once.
+const coversBatchSize = 1000
+
+type ServiceReconcile struct {
+	repo    Repository
+	objRepo ObjectRepository
+	covers  CoverObjects
+
+	cfg Config
+}
+
+type Repository interface {
+	PlaylistCovers(ctx context.Context, arg postgres.PlaylistCoversParams) ([]postgres.PlaylistCoversRow, error)
+}
+
+type ObjectRepository interface {
+	ListCoverObjects(ctx context.Context, fn func(objstore.ObjectInfo) error) error
+	DeleteCoverObject(ctx context.Context, id string) error
+}
+
+// CoverObjects tells the objects of covers hosted by us from covers hosted elsewhere.
+type CoverObjects interface {
+	CoverObjectID(coverURL string) (string, bool)
+}
+
+type Config struct {
+	// Orphans modified in this time are reported only
+	GracePeriod   time.Duration
+	DeleteOrphans bool
+}
+
+func New(repo Repository, objRepo ObjectRepository, covers CoverObjects, cfg Config) *ServiceReconcile {
+	return &ServiceReconcile{
+		repo:    repo,
+		objRepo: objRepo,
+		covers:  covers,
+		cfg:     cfg,
+	}
+}
+
+type Orphan struct {
+	ObjectID   string
+	Size       int64
+	ModifiedAt time.Time
+	Deleted    bool
+}
+
+type Dangling struct {
+	PlaylistID uuid.UUID
+	ObjectID   string
+}
+
+type Report struct {
+	// Objects is how many objects were compared
+	Objects  int
+	Orphans  []Orphan
+	Dangling []Dangling
+}
+
+// OrphanedBytes is the size of the orphans that were not deleted.
+func (r Report) OrphanedBytes() int64 {
+	var size int64
+
+	for _, orphan := range r.Orphans {
+		if !orphan.Deleted {
+			size += orphan.Size
+		}
+	}
+
+	return size
+}
+
+type ref struct {
+	playlistID uuid.UUID
+	seen       bool
+}
+
+// Reconcile compares the objects with the playlists and deletes the orphans if it is configured to.
+// Orphans that failed to be deleted are kept in the report and the first error is returned with it.
+func (s *ServiceReconcile) Reconcile(ctx context.Context) (Report, error) {
+	var report Report
+
+	refs, err := s.refs(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	// Objects are listed after the references, so an object stored in between is an orphan
+	// younger than the grace period instead of a dangling reference
+	now := time.Now()
+
+	err = s.objRepo.ListCoverObjects(ctx, func(info objstore.ObjectInfo) error {
+		report.Objects++
+
+		if r, ok := refs[info.Key]; ok {
+			r.seen = true
+			return nil
+		}
+
+		report.Orphans = append(report.Orphans, Orphan{
+			ObjectID:   info.Key,
+			Size:       info.Size,
+			ModifiedAt: info.ModifiedAt,
+			Deleted:    false,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return report, e.NewFrom("listing cover objects", err)
+	}
+
+	for objectID, r := range refs {
+		if !r.seen {
+			report.Dangling = append(report.Dangling, Dangling{PlaylistID: r.playlistID, ObjectID: objectID})
+		}
+	}
+
+	if s.cfg.DeleteOrphans {
+		err = s.deleteOrphans(ctx, report.Orphans, now.Add(-s.cfg.GracePeriod))
+	}
+
+	return report, err
+}
+
+// refs returns the cover objects referenced by playlists.
+func (s *ServiceReconcile) refs(ctx context.Context) (map[string]*ref, error) {
+	refs := map[string]*ref{}
+	after := uuid.Nil
+
+	for {
+		rows, err := s.repo.PlaylistCovers(ctx, postgres.PlaylistCoversParams{
+			AfterID: after,
+			Limitv:  coversBatchSize,
+		})
+		if err != nil {
+			return nil, e.NewFrom("getting playlist covers", err, fields.F("after_id", after))
+		}
+
+		for _, row := range rows {
+			if objectID, ok := s.covers.CoverObjectID(row.CoverUrl.String); ok {
+				refs[objectID] = &ref{playlistID: row.ID, seen: false}
+			}
+
+			after = row.ID
+		}
+
+		if len(rows) < coversBatchSize {
+			return refs, nil
+		}
+	}
+}
+
+// deleteOrphans deletes the orphans modified before the time, it goes on after failures.
+func (s *ServiceReconcile) deleteOrphans(ctx context.Context, orphans []Orphan, modifiedBefore time.Time) error {
+	log := logger.GetLoggerFromCtx(ctx)
+
+	var firstErr error
+
+	for i, orphan := range orphans {
+		if !orphan.ModifiedAt.Before(modifiedBefore) {
+			continue
+		}
+
+		err := s.objRepo.DeleteCoverObject(ctx, orphan.ObjectID)
+		if err != nil {
+			log.Error(
+				ctx, "failed to delete orphaned cover",
+				zap.String("layout", "service/reconcile"),
+				zap.String("object_id", orphan.ObjectID),
+				zap.Error(err),
+			)
+
+			if firstErr == nil {
+				firstErr = e.NewFrom("deleting orphaned cover", err, fields.F("object_id", orphan.ObjectID))
+			}
+
+			continue
+		}
+
+		orphans[i].Deleted = true
+	}
+
+	return firstErr
+}
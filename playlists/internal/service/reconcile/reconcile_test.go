@@ -0,0 +1,138 @@
+package reconcile_test
+
+import (
+	"context"
+	"errors"
+	reconcilemocks "github.com/Benzogang-Tape/audio-hosting/playlists/internal/mocks/reconcile"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service/covers"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/service/reconcile"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/objstore"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/pgconv"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type ReconcileSuite struct {
+	suite.Suite
+
+	r  *reconcilemocks.Repository
+	or *reconcilemocks.ObjectRepository
+
+	covers *covers.ServiceCovers
+	ctx    context.Context
+}
+
+func (s *ReconcileSuite) SetupTest() {
+	s.r = reconcilemocks.NewRepository(s.T())
+	s.or = reconcilemocks.NewObjectRepository(s.T())
+	s.covers = covers.New(nil, nil, covers.Config{
+		HostUsesTLS: false,
+		Host:        "localhost:8080",
+	})
+
+	log := logger.New("test", "prod")
+
+	s.ctx = context.WithValue(context.Background(), logger.LoggerKey, log)
+}
+
+func (s *ReconcileSuite) service(deleteOrphans bool) *reconcile.ServiceReconcile {
+	return reconcile.New(s.r, s.or, s.covers, reconcile.Config{
+		GracePeriod:   time.Hour,
+		DeleteOrphans: deleteOrphans,
+	})
+}
+
+func (s *ReconcileSuite) expectObjects(objects ...objstore.ObjectInfo) {
+	s.or.EXPECT().ListCoverObjects(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, fn func(objstore.ObjectInfo) error) error {
+			for _, object := range objects {
+				if err := fn(object); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}).Once()
+}
+
+func (s *ReconcileSuite) TestReport() {
+	playlist, missing := uuid.New(), uuid.New()
+	old := time.Now().Add(-2 * time.Hour)
+
+	s.r.EXPECT().PlaylistCovers(mock.Anything, postgres.PlaylistCoversParams{AfterID: uuid.Nil, Limitv: 1000}).
+		Return([]postgres.PlaylistCoversRow{
+			{ID: playlist, CoverUrl: pgconv.Text(s.covers.CoverURL(playlist.String(), "cover.png"))},
+			{ID: missing, CoverUrl: pgconv.Text(s.covers.CoverURL(missing.String(), "missing.png"))},
+			// Covers hosted elsewhere have no objects
+			{ID: uuid.New(), CoverUrl: pgconv.Text("https://cdn.example.com/cover.png")},
+		}, nil).Once()
+	s.expectObjects(
+		objstore.ObjectInfo{Key: "cover.png", Size: 10, ModifiedAt: old},
+		objstore.ObjectInfo{Key: "replaced.png", Size: 20, ModifiedAt: old},
+	)
+
+	report, err := s.service(false).Reconcile(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(2, report.Objects)
+	s.Equal([]reconcile.Orphan{{ObjectID: "replaced.png", Size: 20, ModifiedAt: old, Deleted: false}}, report.Orphans)
+	s.Equal([]reconcile.Dangling{{PlaylistID: missing, ObjectID: "missing.png"}}, report.Dangling)
+	s.Equal(int64(20), report.OrphanedBytes())
+}
+
+func (s *ReconcileSuite) TestDeleteOrphans() {
+	old := time.Now().Add(-2 * time.Hour)
+	recent := time.Now().Add(-time.Minute)
+
+	s.r.EXPECT().PlaylistCovers(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.expectObjects(
+		objstore.ObjectInfo{Key: "old.png", Size: 10, ModifiedAt: old},
+		objstore.ObjectInfo{Key: "uploading.png", Size: 20, ModifiedAt: recent},
+	)
+	s.or.EXPECT().DeleteCoverObject(mock.Anything, "old.png").Return(nil).Once()
+
+	report, err := s.service(true).Reconcile(s.ctx)
+
+	s.Require().NoError(err)
+	s.True(report.Orphans[0].Deleted)
+	s.False(report.Orphans[1].Deleted)
+	s.Equal(int64(20), report.OrphanedBytes())
+}
+
+func (s *ReconcileSuite) TestDeleteOrphansError() {
+	old := time.Now().Add(-2 * time.Hour)
+	errStorage := errors.New("storage is down")
+
+	s.r.EXPECT().PlaylistCovers(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.expectObjects(
+		objstore.ObjectInfo{Key: "a.png", Size: 10, ModifiedAt: old},
+		objstore.ObjectInfo{Key: "b.png", Size: 20, ModifiedAt: old},
+	)
+	s.or.EXPECT().DeleteCoverObject(mock.Anything, "a.png").Return(errStorage).Once()
+	s.or.EXPECT().DeleteCoverObject(mock.Anything, "b.png").Return(nil).Once()
+
+	report, err := s.service(true).Reconcile(s.ctx)
+
+	s.Require().ErrorIs(err, errStorage)
+	s.False(report.Orphans[0].Deleted)
+	s.True(report.Orphans[1].Deleted)
+}
+
+func (s *ReconcileSuite) TestRepoError() {
+	errDb := errors.New("bad connection")
+
+	s.r.EXPECT().PlaylistCovers(mock.Anything, mock.Anything).Return(nil, errDb).Once()
+
+	_, err := s.service(true).Reconcile(s.ctx)
+
+	s.Require().ErrorIs(err, errDb)
+}
+
+func TestReconcile(t *testing.T) {
+	suite.Run(t, new(ReconcileSuite))
+}
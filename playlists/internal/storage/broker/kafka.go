@@ -0,0 +1,149 @@
+package broker
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/events"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/metrics"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/segmentio/kafka-go"
+)
+
+type Config struct {
+	Brokers           []string `env:"KAFKA_BROKERS" env-default:"kafka:9092" yaml:"brokers"`
+	Topic             string   `env:"KAFKA_TOPIC" env-default:"playlists-activity" yaml:"topic"`
+	Partitions        int      `env:"KAFKA_PARTITIONS" env-default:"1" yaml:"partitions"`
+	ReplicationFactor int      `env:"KAFKA_REPLICATION_FACTOR" env-default:"1" yaml:"replicationFactor"`
+}
+
+type KafkaProducer struct {
+	w    *kafka.Writer
+	conf Config
+}
+
+func Connect(conf Config) (*KafkaProducer, error) {
+	err := createTopic(conf)
+	if err != nil {
+		return nil, e.NewFrom("creating topic", err)
+	}
+
+	writer := &kafka.Writer{ //nolint:exhaustruct
+		Addr:     kafka.TCP(conf.Brokers...),
+		Topic:    conf.Topic,
+		Balancer: &kafka.Hash{}, //nolint:exhaustruct
+	}
+
+	return &KafkaProducer{
+		w:    writer,
+		conf: conf,
+	}, nil
+}
+
+// Ping checks that one of the brokers answers with the metadata of the cluster.
+func (k *KafkaProducer) Ping(ctx context.Context) error {
+	var err error
+
+	for _, broker := range k.conf.Brokers {
+		err = pingBroker(ctx, broker)
+		if err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+func pingBroker(ctx context.Context, broker string) error {
+	conn, err := kafka.DialContext(ctx, "tcp", broker)
+	if err != nil {
+		return e.NewFrom("connecting to kafka", err, fields.F("broker", broker))
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	_, err = conn.Brokers()
+	if err != nil {
+		return e.NewFrom("getting brokers", err, fields.F("broker", broker))
+	}
+
+	return nil
+}
+
+func createTopic(conf Config) error {
+	conn, err := kafka.Dial("tcp", conf.Brokers[0])
+	if err != nil {
+		return e.NewFrom("connecting to kafka", err)
+	}
+	defer conn.Close()
+
+	controller, err := conn.Controller()
+	if err != nil {
+		return e.NewFrom("getting controller", err)
+	}
+
+	controllerConn, err := kafka.Dial("tcp", net.JoinHostPort(controller.Host, strconv.Itoa(controller.Port)))
+	if err != nil {
+		return e.NewFrom("connecting to controller", err)
+	}
+	defer controllerConn.Close()
+
+	// An existing topic is left as is.
+	err = controllerConn.CreateTopics(kafka.TopicConfig{ //nolint:exhaustruct
+		Topic:             conf.Topic,
+		NumPartitions:     conf.Partitions,
+		ReplicationFactor: conf.ReplicationFactor,
+	})
+	if err != nil {
+		return e.NewFrom("creating topic", err, fields.F("topic", conf.Topic))
+	}
+
+	return nil
+}
+
+// SendMessages publishes the events to the activity topic.
+// Messages are keyed by the track or the playlist, so the events of one track keep their order.
+func (k *KafkaProducer) SendMessages(ctx context.Context, messages []Message) (err error) {
+	ctx, span := events.StartPublish(ctx, k.conf.Topic, len(messages))
+	defer func() { events.EndSpan(span, err) }()
+
+	traceId := logger.TraceIDFromContext(ctx)
+
+	msgs := make([]kafka.Message, len(messages))
+	for i := range messages {
+		env, err := events.New(messages[i].Type(), messages[i].Key(), traceId, messages[i].Payload())
+		if err != nil {
+			return e.NewFrom("creating event", err, fields.F("type", messages[i].Type()))
+		}
+
+		msgs[i] = env.WithTrace(ctx).Message()
+	}
+
+	start := time.Now()
+	err = k.w.WriteMessages(ctx, msgs...)
+
+	metrics.ObserveProduce(k.conf.Topic, time.Since(start), err)
+
+	if err != nil {
+		return e.NewFrom("sending messages to kafka", err)
+	}
+
+	return nil
+}
+
+func (k *KafkaProducer) Close() error {
+	err := k.w.Close()
+	if err != nil {
+		return e.NewFrom("closing kafka writer", err)
+	}
+
+	return nil
+}
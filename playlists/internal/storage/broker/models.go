@@ -0,0 +1,79 @@
+package broker
+
+import (
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/playlists/api/protogen"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/events"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Message is an event of the playlists service.
+type Message interface {
+	Type() string
+	Key() string
+	Payload() proto.Message
+}
+
+type TrackLikedMessage struct {
+	TrackId uuid.UUID
+	UserId  uuid.UUID
+	LikedAt time.Time
+}
+
+func (m TrackLikedMessage) Type() string { return events.TypeTrackLiked }
+func (m TrackLikedMessage) Key() string  { return m.TrackId.String() }
+
+func (m TrackLikedMessage) Payload() proto.Message {
+	return &protogen.TrackLikedEvent{
+		TrackId: m.TrackId.String(),
+		UserId:  m.UserId.String(),
+		LikedAt: timestamppb.New(m.LikedAt),
+	}
+}
+
+type TrackUnlikedMessage struct {
+	TrackId   uuid.UUID
+	UserId    uuid.UUID
+	UnlikedAt time.Time
+}
+
+func (m TrackUnlikedMessage) Type() string { return events.TypeTrackUnliked }
+func (m TrackUnlikedMessage) Key() string  { return m.TrackId.String() }
+
+func (m TrackUnlikedMessage) Payload() proto.Message {
+	return &protogen.TrackUnlikedEvent{
+		TrackId:   m.TrackId.String(),
+		UserId:    m.UserId.String(),
+		UnlikedAt: timestamppb.New(m.UnlikedAt),
+	}
+}
+
+type PlaylistTracksAddedMessage struct {
+	PlaylistId uuid.UUID
+	AuthorId   uuid.UUID
+	TrackIds   []uuid.UUID
+	IsAlbum    bool
+	AddedAt    time.Time
+}
+
+func (m PlaylistTracksAddedMessage) Type() string { return events.TypePlaylistTracksAdded }
+func (m PlaylistTracksAddedMessage) Key() string  { return m.PlaylistId.String() }
+
+func (m PlaylistTracksAddedMessage) Payload() proto.Message {
+	ids := make([]string, len(m.TrackIds))
+	for i := range m.TrackIds {
+		ids[i] = m.TrackIds[i].String()
+	}
+
+	return &protogen.PlaylistTracksAddedEvent{
+		PlaylistId: m.PlaylistId.String(),
+		AuthorId:   m.AuthorId.String(),
+		TrackIds:   ids,
+		IsAlbum:    m.IsAlbum,
+		AddedAt:    timestamppb.New(m.AddedAt),
+	}
+}
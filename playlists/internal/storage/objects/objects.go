@@ -0,0 +1,89 @@
+package objects
+
+import (
+	"context"
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	s3 "github.com/Benzogang-Tape/audio-hosting/playlists/pkg/db/minio"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/objstore"
+	"io"
+)
+
+type ObjStorage struct {
+	store        objstore.Store
+	coversBucket string
+}
+
+func New(ctx context.Context, conf s3.Config) (*ObjStorage, error) {
+	store, err := s3.Connect(conf)
+	if err != nil {
+		return nil, e.NewFrom("connecting to object storage", err, fields.F("backend", conf.Backend))
+	}
+
+	s := &ObjStorage{
+		store:        store,
+		coversBucket: conf.CoversBucket,
+	}
+
+	err = store.MakeBucket(ctx, s.coversBucket)
+	if err != nil {
+		return nil, e.NewFrom("creating buckets", err)
+	}
+
+	return s, nil
+}
+
+// Ping checks that the storage is reachable and has the covers bucket.
+func (s *ObjStorage) Ping(ctx context.Context) error {
+	ok, err := s.store.BucketExists(ctx, s.coversBucket)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if !ok {
+		return e.New("bucket is missing", fields.F("bucket", s.coversBucket))
+	}
+
+	return nil
+}
+
+func (s *ObjStorage) PutCoverObject(ctx context.Context, image CoverObject) error {
+	_, err := s.store.Put(ctx, s.coversBucket, image.ID,
+		image.Content, int64(image.WeightBytes), objstore.PutOptions{}) //nolint:exhaustruct
+	if err != nil {
+		return e.NewFrom("saving cover object", err,
+			fields.F("image_id", image.ID), fields.F("weight", image.WeightBytes))
+	}
+
+	return nil
+}
+
+// GetCoverObject returns the cover, the caller closes it. A missing one is objstore.ErrNotFound.
+func (s *ObjStorage) GetCoverObject(ctx context.Context, id string) (io.Reader, error) {
+	object, err := s.store.Get(ctx, s.coversBucket, id)
+	if err != nil {
+		return nil, e.NewFrom("getting cover object", err, fields.F("image_id", id))
+	}
+
+	return object, nil
+}
+
+// DeleteCoverObject removes the cover object, removing a missing object is not an error.
+func (s *ObjStorage) DeleteCoverObject(ctx context.Context, id string) error {
+	err := s.store.Delete(ctx, s.coversBucket, id)
+	if err != nil {
+		return e.NewFrom("removing cover object", err, fields.F("image_id", id))
+	}
+
+	return nil
+}
+
+// ListCoverObjects calls fn for every cover object until fn returns an error.
+func (s *ObjStorage) ListCoverObjects(ctx context.Context, fn func(objstore.ObjectInfo) error) error {
+	err := s.store.List(ctx, s.coversBucket, "", fn)
+	if err != nil {
+		return e.NewFrom("listing cover objects", err)
+	}
+
+	return nil
+}
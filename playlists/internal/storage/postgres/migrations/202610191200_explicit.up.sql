@@ -0,0 +1 @@
+ALTER TABLE playlists ADD COLUMN explicit BOOLEAN NOT NULL DEFAULT False;
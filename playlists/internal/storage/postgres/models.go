@@ -34,4 +34,5 @@ type Playlist struct {
 	ReleasedAt pgtype.Timestamptz
 	IsAlbum    bool
 	IsPublic   bool
+	Explicit   bool
 }
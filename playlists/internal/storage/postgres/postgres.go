@@ -1,11 +1,13 @@
 package postgres
 
 import (
+	"context"
 	"embed"
 
 	pg "github.com/Benzogang-Tape/audio-hosting/playlists/pkg/db/postgres"
 
 	"dev.gaijin.team/go/golib/e"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type PGStorage struct {
@@ -28,6 +30,20 @@ func Connect(conf pg.Config) (*PGStorage, error) {
 	}, nil
 }
 
+func (s *PGStorage) Ping(ctx context.Context) error {
+	err := s.db.Pool.Ping(ctx)
+	if err != nil {
+		return e.NewFrom("pinging postgres", err)
+	}
+
+	return nil
+}
+
+// Stat returns the stats of the connection pool.
+func (s *PGStorage) Stat() *pgxpool.Stat {
+	return s.db.Pool.Stat()
+}
+
 func (s *PGStorage) Close() {
 	s.db.Pool.Close()
 }
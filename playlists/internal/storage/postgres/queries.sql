@@ -6,8 +6,9 @@ INSERT INTO playlists (
     track_ids,
     cover_url,
     is_album,
+    explicit,
     created_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7);
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
 
 -- name: DeletePlaylists :exec
 DELETE FROM playlists WHERE id = ANY(@ids::UUID[]) and author_id = @user_id::UUID;
@@ -28,7 +29,8 @@ SELECT
     updated_at,
     released_at,
     is_album,
-    is_public
+    is_public,
+    explicit
 FROM playlists
 WHERE playlists.author_id = @user_id::UUID
 UNION
@@ -41,7 +43,8 @@ SELECT
     updated_at,
     released_at,
     is_album,
-    is_public
+    is_public,
+    explicit
 FROM playlists
 LEFT JOIN liked_playlists ON playlists.id = liked_playlists.liked_playlist
 WHERE liked_playlists.user_id = @user_id::UUID AND (playlists.is_public)
@@ -87,6 +90,7 @@ UPDATE playlists SET
                  released_at = COALESCE(sqlc.narg('released_at'), released_at),
                  is_album = COALESCE(sqlc.narg('is_album'), is_album),
                  is_public = COALESCE(sqlc.narg('is_public'), is_public),
+                 explicit = CASE WHEN is_album THEN COALESCE(sqlc.narg('explicit'), explicit) ELSE explicit END,
                  updated_at = @updated_at::TIMESTAMPTZ
 WHERE playlists.id = @id::UUID AND author_id = @user_id::UUID
 RETURNING *;
@@ -119,7 +123,7 @@ INSERT INTO playlists (
     FALSE,
     NOW()
 FROM row_for_copy
-RETURNING id;
+RETURNING id, track_ids;
 
 -- name: LikeTrack :one
 WITH inserted_row AS (
@@ -128,7 +132,7 @@ WITH inserted_row AS (
     RETURNING track_id)
 SELECT track_id FROM inserted_row;
 
--- name: DislikeTrack :exec
+-- name: DislikeTrack :execrows
 DELETE FROM liked_tracks WHERE track_id = @track_id::UUID AND user_id = @user_id::UUID;
 
 -- name: PublicPlaylists :many
@@ -147,4 +151,10 @@ WHERE
     )
 ORDER BY created_at DESC, title
 LIMIT @limitv
-OFFSET @offsetv;
\ No newline at end of file
+OFFSET @offsetv;
+-- name: PlaylistCovers :many
+SELECT id, cover_url
+FROM playlists
+WHERE id > @after_id::UUID AND cover_url IS NOT NULL
+ORDER BY id
+LIMIT @limitv;
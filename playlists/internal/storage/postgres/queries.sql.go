@@ -15,7 +15,7 @@ import (
 
 const copyPlaylist = `-- name: CopyPlaylist :one
 WITH row_for_copy AS (
-    SELECT id, title, author_id, cover_url, track_ids, created_at, updated_at, released_at, is_album, is_public
+    SELECT id, title, author_id, cover_url, track_ids, created_at, updated_at, released_at, is_album, is_public, explicit
     FROM playlists
     WHERE playlists.id = $3::UUID AND
         playlists.is_public AND
@@ -41,7 +41,7 @@ INSERT INTO playlists (
     FALSE,
     NOW()
 FROM row_for_copy
-RETURNING id
+RETURNING id, track_ids
 `
 
 type CopyPlaylistParams struct {
@@ -50,11 +50,16 @@ type CopyPlaylistParams struct {
 	PlaylistID    uuid.UUID
 }
 
-func (q *Queries) CopyPlaylist(ctx context.Context, arg CopyPlaylistParams) (uuid.UUID, error) {
+type CopyPlaylistRow struct {
+	ID       uuid.UUID
+	TrackIds []uuid.UUID
+}
+
+func (q *Queries) CopyPlaylist(ctx context.Context, arg CopyPlaylistParams) (CopyPlaylistRow, error) {
 	row := q.db.QueryRow(ctx, copyPlaylist, arg.NewPlaylistID, arg.UserID, arg.PlaylistID)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+	var i CopyPlaylistRow
+	err := row.Scan(&i.ID, &i.TrackIds)
+	return i, err
 }
 
 const deletePlaylists = `-- name: DeletePlaylists :exec
@@ -85,7 +90,7 @@ func (q *Queries) DislikePlaylist(ctx context.Context, arg DislikePlaylistParams
 	return err
 }
 
-const dislikeTrack = `-- name: DislikeTrack :exec
+const dislikeTrack = `-- name: DislikeTrack :execrows
 DELETE FROM liked_tracks WHERE track_id = $1::UUID AND user_id = $2::UUID
 `
 
@@ -94,9 +99,12 @@ type DislikeTrackParams struct {
 	UserID  uuid.UUID
 }
 
-func (q *Queries) DislikeTrack(ctx context.Context, arg DislikeTrackParams) error {
-	_, err := q.db.Exec(ctx, dislikeTrack, arg.TrackID, arg.UserID)
-	return err
+func (q *Queries) DislikeTrack(ctx context.Context, arg DislikeTrackParams) (int64, error) {
+	result, err := q.db.Exec(ctx, dislikeTrack, arg.TrackID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const likePlaylist = `-- name: LikePlaylist :one
@@ -183,9 +191,10 @@ UPDATE playlists SET
                  released_at = COALESCE($4, released_at),
                  is_album = COALESCE($5, is_album),
                  is_public = COALESCE($6, is_public),
-                 updated_at = $7::TIMESTAMPTZ
-WHERE playlists.id = $8::UUID AND author_id = $9::UUID
-RETURNING id, title, author_id, cover_url, track_ids, created_at, updated_at, released_at, is_album, is_public
+                 explicit = CASE WHEN is_album THEN COALESCE($7, explicit) ELSE explicit END,
+                 updated_at = $8::TIMESTAMPTZ
+WHERE playlists.id = $9::UUID AND author_id = $10::UUID
+RETURNING id, title, author_id, cover_url, track_ids, created_at, updated_at, released_at, is_album, is_public, explicit
 `
 
 type PatchPlaylistParams struct {
@@ -195,6 +204,7 @@ type PatchPlaylistParams struct {
 	ReleasedAt pgtype.Timestamptz
 	IsAlbum    pgtype.Bool
 	IsPublic   pgtype.Bool
+	Explicit   pgtype.Bool
 	UpdatedAt  time.Time
 	ID         uuid.UUID
 	UserID     uuid.UUID
@@ -208,6 +218,7 @@ func (q *Queries) PatchPlaylist(ctx context.Context, arg PatchPlaylistParams) (P
 		arg.ReleasedAt,
 		arg.IsAlbum,
 		arg.IsPublic,
+		arg.Explicit,
 		arg.UpdatedAt,
 		arg.ID,
 		arg.UserID,
@@ -224,13 +235,14 @@ func (q *Queries) PatchPlaylist(ctx context.Context, arg PatchPlaylistParams) (P
 		&i.ReleasedAt,
 		&i.IsAlbum,
 		&i.IsPublic,
+		&i.Explicit,
 	)
 	return i, err
 }
 
 const playlist = `-- name: Playlist :one
 SELECT
-    playlists.id, playlists.title, playlists.author_id, playlists.cover_url, playlists.track_ids, playlists.created_at, playlists.updated_at, playlists.released_at, playlists.is_album, playlists.is_public
+    playlists.id, playlists.title, playlists.author_id, playlists.cover_url, playlists.track_ids, playlists.created_at, playlists.updated_at, playlists.released_at, playlists.is_album, playlists.is_public, playlists.explicit
 FROM playlists
 WHERE id = $1
 `
@@ -253,13 +265,52 @@ func (q *Queries) Playlist(ctx context.Context, id uuid.UUID) (PlaylistRow, erro
 		&i.Playlist.ReleasedAt,
 		&i.Playlist.IsAlbum,
 		&i.Playlist.IsPublic,
+		&i.Playlist.Explicit,
 	)
 	return i, err
 }
 
+const playlistCovers = `-- name: PlaylistCovers :many
+SELECT id, cover_url
+FROM playlists
+WHERE id > $1::UUID AND cover_url IS NOT NULL
+ORDER BY id
+LIMIT $2
+`
+
+type PlaylistCoversParams struct {
+	AfterID uuid.UUID
+	Limitv  int32
+}
+
+type PlaylistCoversRow struct {
+	ID       uuid.UUID
+	CoverUrl pgtype.Text
+}
+
+func (q *Queries) PlaylistCovers(ctx context.Context, arg PlaylistCoversParams) ([]PlaylistCoversRow, error) {
+	rows, err := q.db.Query(ctx, playlistCovers, arg.AfterID, arg.Limitv)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlaylistCoversRow
+	for rows.Next() {
+		var i PlaylistCoversRow
+		if err := rows.Scan(&i.ID, &i.CoverUrl); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const publicPlaylists = `-- name: PublicPlaylists :many
 SELECT
-    playlists.id, playlists.title, playlists.author_id, playlists.cover_url, playlists.track_ids, playlists.created_at, playlists.updated_at, playlists.released_at, playlists.is_album, playlists.is_public
+    playlists.id, playlists.title, playlists.author_id, playlists.cover_url, playlists.track_ids, playlists.created_at, playlists.updated_at, playlists.released_at, playlists.is_album, playlists.is_public, playlists.explicit
 FROM playlists
 WHERE
   -- Only public playlists!
@@ -320,6 +371,7 @@ func (q *Queries) PublicPlaylists(ctx context.Context, arg PublicPlaylistsParams
 			&i.Playlist.ReleasedAt,
 			&i.Playlist.IsAlbum,
 			&i.Playlist.IsPublic,
+			&i.Playlist.Explicit,
 		); err != nil {
 			return nil, err
 		}
@@ -339,8 +391,9 @@ INSERT INTO playlists (
     track_ids,
     cover_url,
     is_album,
+    explicit,
     created_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7)
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type SavePlaylistParams struct {
@@ -350,6 +403,7 @@ type SavePlaylistParams struct {
 	TrackIds  []uuid.UUID
 	CoverUrl  pgtype.Text
 	IsAlbum   bool
+	Explicit  bool
 	CreatedAt time.Time
 }
 
@@ -361,6 +415,7 @@ func (q *Queries) SavePlaylist(ctx context.Context, arg SavePlaylistParams) erro
 		arg.TrackIds,
 		arg.CoverUrl,
 		arg.IsAlbum,
+		arg.Explicit,
 		arg.CreatedAt,
 	)
 	return err
@@ -376,7 +431,7 @@ UPDATE playlists SET
                  released_at = $7,
                  updated_at = $8
 WHERE id = $1 AND author_id = $9::UUID
-RETURNING id, title, author_id, cover_url, track_ids, created_at, updated_at, released_at, is_album, is_public
+RETURNING id, title, author_id, cover_url, track_ids, created_at, updated_at, released_at, is_album, is_public, explicit
 `
 
 type UpdatePlaylistParams struct {
@@ -415,6 +470,7 @@ func (q *Queries) UpdatePlaylist(ctx context.Context, arg UpdatePlaylistParams)
 		&i.ReleasedAt,
 		&i.IsAlbum,
 		&i.IsPublic,
+		&i.Explicit,
 	)
 	return i, err
 }
@@ -429,7 +485,8 @@ SELECT
     updated_at,
     released_at,
     is_album,
-    is_public
+    is_public,
+    explicit
 FROM playlists
 WHERE playlists.author_id = $1::UUID
 UNION
@@ -442,7 +499,8 @@ SELECT
     updated_at,
     released_at,
     is_album,
-    is_public
+    is_public,
+    explicit
 FROM playlists
 LEFT JOIN liked_playlists ON playlists.id = liked_playlists.liked_playlist
 WHERE liked_playlists.user_id = $1::UUID AND (playlists.is_public)
@@ -459,6 +517,7 @@ type UserPlaylistsRow struct {
 	ReleasedAt pgtype.Timestamptz
 	IsAlbum    bool
 	IsPublic   bool
+	Explicit   bool
 }
 
 func (q *Queries) UserPlaylists(ctx context.Context, userID uuid.UUID) ([]UserPlaylistsRow, error) {
@@ -480,6 +539,7 @@ func (q *Queries) UserPlaylists(ctx context.Context, userID uuid.UUID) ([]UserPl
 			&i.ReleasedAt,
 			&i.IsAlbum,
 			&i.IsPublic,
+			&i.Explicit,
 		); err != nil {
 			return nil, err
 		}
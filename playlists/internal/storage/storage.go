@@ -3,7 +3,8 @@ package storage
 import (
 	"context"
 	"dev.gaijin.team/go/golib/e"
-	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/minio"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/objects"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/storage/postgres"
 	s3 "github.com/Benzogang-Tape/audio-hosting/playlists/pkg/db/minio"
 	pg "github.com/Benzogang-Tape/audio-hosting/playlists/pkg/db/postgres"
@@ -12,28 +13,35 @@ import (
 
 type Storage struct {
 	*postgres.PGStorage
-	*minio.S3Storage
+	*objects.ObjStorage
+	*broker.KafkaProducer
 	// TODO: do redis storage
 }
 
-func New(pgConfig pg.Config, _ redis.Config, s3Config s3.Config) (*Storage, error) {
+func New(pgConfig pg.Config, _ redis.Config, s3Config s3.Config, kafkaConfig broker.Config) (*Storage, error) {
 	db, err := postgres.Connect(pgConfig)
 	if err != nil {
 		return nil, e.NewFrom("connecting to postgres", err)
 	}
 
-	minioClient, err := minio.New(context.Background(), s3Config)
+	objStorage, err := objects.New(context.Background(), s3Config)
 	if err != nil {
-		return nil, e.NewFrom("connecting to minio", err)
+		return nil, e.NewFrom("connecting to object storage", err)
+	}
+
+	producer, err := broker.Connect(kafkaConfig)
+	if err != nil {
+		return nil, e.NewFrom("connecting to kafka", err)
 	}
 
 	return &Storage{
-		PGStorage: db,
-		S3Storage: minioClient,
+		PGStorage:     db,
+		ObjStorage:    objStorage,
+		KafkaProducer: producer,
 	}, nil
 }
 
 func (s *Storage) Close() error {
 	s.PGStorage.Close()
-	return nil
+	return s.KafkaProducer.Close()
 }
@@ -54,6 +54,10 @@ func (s CoversHandlers) GetRawCoverHandler() HandlerErrFunc {
 			return err
 		}
 
+		if closer, ok := output.Content.(io.Closer); ok {
+			defer closer.Close()
+		}
+
 		_, err = io.Copy(w, output.Content)
 		if err != nil {
 			return ErrFileNotFound.Wrap(err)
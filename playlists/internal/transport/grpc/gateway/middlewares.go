@@ -11,6 +11,8 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/lib/auth"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/erix"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/metrics"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/tracing"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/transport"
 
 	"dev.gaijin.team/go/golib/stacktrace"
@@ -28,16 +30,20 @@ var (
 
 func ContextWithLogger(log logger.Logger, next gateway.HandlerFunc) gateway.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
-		var traceID string
+		traceID := tracing.TraceId(r.Context())
 
-		traceID = r.Header.Get(transport.TraceIdKey)
 		if traceID == "" {
-			traceID = uuid.NewString()
+			traceID = r.Header.Get(transport.TraceIdKey)
+		}
 
-			r.Header.Set(transport.TraceIdKey, traceID)
+		if traceID == "" {
+			traceID = uuid.NewString()
 		}
 
+		r.Header.Set(transport.TraceIdKey, traceID)
+
 		ctx := context.WithValue(r.Context(), logger.LoggerKey, log)
+		ctx = context.WithValue(ctx, transport.TraceIdLogKey, traceID)
 		r = r.WithContext(ctx)
 
 		next(w, r, pathParams)
@@ -85,6 +91,14 @@ func LoggingMw(next HandlerErrFunc) gateway.HandlerFunc {
 		handlerErr := next(w, r, pathParams)
 		elapsed := time.Since(start)
 
+		// Labeled by the route, the path params would make a series per playlist
+		method := r.Method
+		if pattern, ok := gateway.HTTPPattern(ctx); ok {
+			method += " " + pattern.String()
+		}
+
+		metrics.ObserveRequest(metrics.TransportHttp, method, elapsed, handlerErr)
+
 		if handlerErr != nil {
 			log.Error(
 				ctx, "incoming request",
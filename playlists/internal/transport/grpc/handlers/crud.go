@@ -57,6 +57,7 @@ func (s *PlaylistsService) createPlaylistImpl(
 		AuthorID: userToken.Subject,
 		CoverURL: coverURL,
 		IsAlbum:  isAlbum,
+		Explicit: req.GetExplicit(),
 	})
 
 	switch {
@@ -147,6 +148,7 @@ func (s *PlaylistsService) updatePlaylistImpl(
 		CoverURL:   req.CoverUrl,
 		IsPublic:   req.IsPublic,
 		TrackIDs:   req.GetTrackIds(),
+		Explicit:   req.Explicit,
 	}
 
 	playlistMetadata, err := s.service.UpdatePlaylist(ctx, params)
@@ -29,6 +29,7 @@ func convSongs(songs []client.Song) []*protosongs.Song {
 			Duration:    durationpb.New(song.Duration),
 			WeightBytes: song.WeightBytes,
 			ReleasedAt:  timestamppb.New(song.ReleasedAt),
+			Explicit:    song.Explicit,
 		})
 	}
 
@@ -60,5 +61,6 @@ func convPlaylistMetadata(md models.PlaylistMetadata) *protogen.PlaylistMetadata
 		IsAlbum:        md.IsAlbum,
 		IsMyCollection: md.IsMyCollection,
 		IsPublic:       md.IsPublic,
+		Explicit:       md.Explicit,
 	}
 }
@@ -159,6 +159,7 @@ func (s *PlaylistsService) getMyPlaylistsImpl(
 			IsAlbum:        p.IsAlbum,
 			IsPublic:       p.IsPublic,
 			IsMyCollection: p.IsMyCollection,
+			Explicit:       p.Explicit,
 		}
 	}
 
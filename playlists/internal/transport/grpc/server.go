@@ -12,21 +12,29 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/playlists/api/protogen"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/config"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/internal/transport/grpc/handlers"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/health"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/metrics"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/transport"
 
 	"dev.gaijin.team/go/golib/e"
 	"dev.gaijin.team/go/golib/fields"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type Server struct {
-	grpcServer  *grpc.Server
-	restServer  *http.Server
-	clientSongs *client.Client
-	listener    net.Listener
+	grpcServer *grpc.Server
+	restServer *http.Server
+	// Nil if the metrics are disabled
+	metricsServer *http.Server
+	clientSongs   *client.Client
+	listener      net.Listener
 }
 
 // New returns a new gRPC server with a unary interceptor that logs the
@@ -63,6 +71,7 @@ func New(
 
 	opts := []grpc.ServerOption{
 		grpc.ConnectionTimeout(cfg.GRPC.Timeout),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	}
 
 	playlistService, err := handlers.NewPlaylistsService(service, publicKey, logger.GetLoggerFromCtx(ctx))
@@ -70,11 +79,16 @@ func New(
 		return nil, e.From(err, fields.F("creating service", "playlists"))
 	}
 
+	checker := newHealthChecker(cfg.Health, storage, clSongs)
+
 	grpcServer := grpc.NewServer(opts...)
 	protogen.RegisterPlaylistsServiceServer(grpcServer, playlistService)
+	healthpb.RegisterHealthServer(grpcServer,
+		health.NewServer(checker, protogen.PlaylistsService_ServiceDesc.ServiceName))
 
 	restSrv := runtime.NewServeMux(
 		runtime.SetQueryParameterParser(&QueryParser{}),
+		transport.MuxWithSpanNames(),
 	)
 	if err = protogen.RegisterPlaylistsServiceHandlerServer(ctx, restSrv, playlistService); err != nil { //nolint:revive
 		return nil, err //nolint:wrapcheck
@@ -85,22 +99,67 @@ func New(
 		return nil, err //nolint:wrapcheck
 	}
 
+	// The probes of the orchestrator, they are out of the /playlists/api prefix proxied to the gateway
+	err = restSrv.HandlePath(http.MethodGet, "/healthz", withoutParams(checker.Live))
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	err = restSrv.HandlePath(http.MethodGet, "/readyz", withoutParams(checker.Ready))
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
 	restServer := &http.Server{
 		Addr:              fmt.Sprintf("%v:%d", cfg.HTTP.Host, cfg.HTTP.Port),
-		Handler:           restSrv,
+		Handler:           otelhttp.NewHandler(restSrv, "playlists-gateway"),
 		ReadHeaderTimeout: cfg.HTTP.Timeout,
 		ReadTimeout:       cfg.HTTP.Timeout,
 		WriteTimeout:      cfg.HTTP.Timeout,
 	}
 
 	return &Server{
-		grpcServer:  grpcServer,
-		restServer:  restServer,
-		clientSongs: clSongs,
-		listener:    lis,
+		grpcServer:    grpcServer,
+		restServer:    restServer,
+		metricsServer: newMetricsServer(cfg),
+		clientSongs:   clSongs,
+		listener:      lis,
 	}, nil
 }
 
+// newHealthChecker probes the storages and the songs service, redis isn't used yet.
+func newHealthChecker(cfg config.HealthConfig, storage *storage.Storage, clSongs *client.Client) *health.Checker {
+	checker := health.NewChecker(cfg.Timeout)
+
+	checker.Add("postgres", storage.PGStorage.Ping)
+	checker.Add("objects", storage.ObjStorage.Ping)
+	checker.Add("kafka", storage.KafkaProducer.Ping)
+	checker.Add("songs", clSongs.Ping)
+
+	return checker
+}
+
+func withoutParams(handler http.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		handler(w, r)
+	}
+}
+
+func newMetricsServer(cfg config.Servers) *http.Server {
+	if cfg.Metrics.Port == 0 {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	return &http.Server{
+		Addr:              fmt.Sprintf("%v:%d", cfg.Metrics.Host, cfg.Metrics.Port),
+		Handler:           mux,
+		ReadHeaderTimeout: cfg.HTTP.Timeout,
+	}
+}
+
 // Run starts both the gRPC and REST servers concurrently.
 // It listens for incoming connections and serves requests using the provided context.
 // The method returns an error if either server fails to start or encounters an issue during execution.
@@ -118,6 +177,13 @@ func (s *Server) Run(ctx context.Context) error {
 		return s.restServer.ListenAndServe()
 	})
 
+	if s.metricsServer != nil {
+		eg.Go(func() error {
+			logger.GetLoggerFromCtx(ctx).Info(ctx, "starting metrics server", zap.String("addr", s.metricsServer.Addr))
+			return s.metricsServer.ListenAndServe()
+		})
+	}
+
 	return eg.Wait() //nolint:wrapcheck
 }
 
@@ -137,6 +203,15 @@ func (s *Server) Stop(ctx context.Context) error {
 		l.Error(ctx, "failed to shutdown gateway server", zap.Error(err))
 	}
 
+	if s.metricsServer != nil {
+		l.Info(ctx, "stopping metrics server")
+
+		err = s.metricsServer.Shutdown(ctx)
+		if err != nil {
+			l.Error(ctx, "failed to shutdown metrics server", zap.Error(err))
+		}
+	}
+
 	l.Info(ctx, "stopping gRPC server")
 	s.grpcServer.GracefulStop()
 	l.Info(ctx, "gRPC server stopped")
@@ -30,7 +30,7 @@ func NewService(strg *storage.Storage, cfg config.HTTPConfig, clientSongs *clien
 		Host:        cfg.Host + ":" + strconv.Itoa(cfg.Port),
 	})
 
-	playlistsService := playlists.New(playlistsRepo{strg}, clientSongs)
+	playlistsService := playlists.New(playlistsRepo{strg}, clientSongs, strg)
 
 	return Service{
 		ServicePlaylists: playlistsService,
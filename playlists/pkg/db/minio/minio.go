@@ -1,24 +1,50 @@
 package minio
 
 import (
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/objstore"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 type Config struct {
+	// minio or local, the local one keeps objects in localDir and needs no running services
+	Backend      string `env:"MINIO_BACKEND" env-default:"minio" yaml:"backend"`
 	Endpoint     string `env:"MINIO_ENDPOINT" env-default:"minio:9000" yaml:"endpoint"`
 	AccessKey    string `env:"MINIO_ACCESS_KEY" env-default:"" yaml:"accessKey"`
 	SecretKey    string `env:"MINIO_SECRET_KEY" env-default:"" yaml:"secretKey"`
 	UseSsl       bool   `env:"MINIO_USE_SSL" env-default:"false" yaml:"useSsl"`
+	LocalDir     string `env:"MINIO_LOCAL_DIR" env-default:"data/objects" yaml:"localDir"`
 	CoversBucket string `env:"MINIO_COVERS_BUCKET" env-default:"covers" yaml:"coversBucket"`
 }
 
-func Connect(conf Config) (*minio.Client, error) {
-	return minio.New( //nolint:wrapcheck
-		conf.Endpoint,
-		&minio.Options{ //nolint:exhaustruct
-			Creds:  credentials.NewStaticV2(conf.AccessKey, conf.SecretKey, ""),
-			Secure: conf.UseSsl,
-		},
-	)
+func Connect(conf Config) (objstore.Store, error) {
+	switch conf.Backend {
+	case objstore.BackendMinio:
+		transport, err := minio.DefaultTransport(conf.UseSsl)
+		if err != nil {
+			return nil, e.NewFrom("creating minio transport", err)
+		}
+
+		client, err := minio.New(
+			conf.Endpoint,
+			&minio.Options{ //nolint:exhaustruct
+				Creds:     credentials.NewStaticV2(conf.AccessKey, conf.SecretKey, ""),
+				Secure:    conf.UseSsl,
+				Transport: otelhttp.NewTransport(transport),
+			},
+		)
+		if err != nil {
+			return nil, e.NewFrom("creating minio client", err)
+		}
+
+		return objstore.NewMinio(client), nil
+
+	case objstore.BackendLocal:
+		return objstore.NewLocal(conf.LocalDir) //nolint:wrapcheck
+	}
+
+	return nil, e.New("unknown object storage backend", fields.F("backend", conf.Backend))
 }
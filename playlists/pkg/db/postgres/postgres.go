@@ -9,6 +9,7 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/database/postgres" // it is needed for migrations to work
 
 	"dev.gaijin.team/go/golib/e"
+	"github.com/exaring/otelpgx"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/jackc/pgx/v5"
@@ -47,7 +48,15 @@ func New(config Config, migrations fs.FS) (*DB, error) {
 		return nil, e.NewFrom("applying migrations", err)
 	}
 
-	p, err := pgxpool.New(context.Background(), conn)
+	poolConfig, err := pgxpool.ParseConfig(conn)
+	if err != nil {
+		return nil, e.NewFrom("parsing pool config", err)
+	}
+
+	// Every query gets a span, the arguments are left out
+	poolConfig.ConnConfig.Tracer = otelpgx.NewTracer(otelpgx.WithDisableQuerySpanNamePrefix())
+
+	p, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
 	if err != nil {
 		return nil, e.NewFrom("creating pool", err)
 	}
@@ -0,0 +1,115 @@
+// Package events describes the events the playlists service publishes to Kafka.
+//
+// Events follow the CloudEvents 1.0 spec in the Kafka binary content mode,
+// the same way the songs service publishes its events: the attributes are stored
+// in the `ce_*` headers and the message value is a protobuf payload from api/events.proto.
+// So consumers of songs events read these ones with the same code.
+package events
+
+import (
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/transport"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	SpecVersion     = "1.0"
+	ContentType     = "application/protobuf"
+	Source          = "/audio-hosting/playlists"
+	dataSchemaTpl   = "type.googleapis.com/"
+	headerPrefix    = "ce_"
+	headerContentTp = "content-type"
+)
+
+// Event types. The version suffix is bumped on every breaking change of a payload.
+const (
+	TypeTrackLiked          = "com.audio-hosting.playlists.track.liked.v1"
+	TypeTrackUnliked        = "com.audio-hosting.playlists.track.unliked.v1"
+	TypePlaylistTracksAdded = "com.audio-hosting.playlists.playlist.tracks_added.v1"
+)
+
+// Envelope is a CloudEvent carrying a protobuf payload.
+type Envelope struct {
+	Id         string
+	Source     string
+	Type       string
+	Subject    string
+	Time       time.Time
+	DataSchema string
+	// TraceId is propagated through the [transport.TraceIdKey] header,
+	// it is left for the consumers that don't support the trace context.
+	TraceId string
+	// W3C trace context of the producer, see [Envelope.WithTrace].
+	TraceParent string
+	TraceState  string
+	// Key is the Kafka message key, it defines the partition
+	// and so the ordering of the events.
+	Key  string
+	Data []byte
+}
+
+// New wraps the payload into an envelope of the given type.
+func New(eventType, key, traceId string, payload proto.Message) (Envelope, error) {
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return Envelope{}, e.NewFrom("marshalling event payload", err, fields.F("type", eventType))
+	}
+
+	return Envelope{
+		Id:          uuid.NewString(),
+		Source:      Source,
+		Type:        eventType,
+		Subject:     key,
+		Time:        time.Now().UTC(),
+		DataSchema:  dataSchemaTpl + string(proto.MessageName(payload)),
+		TraceId:     traceId,
+		TraceParent: "",
+		TraceState:  "",
+		Key:         key,
+		Data:        data,
+	}, nil
+}
+
+// Message converts the envelope into a Kafka message.
+func (env Envelope) Message() kafka.Message {
+	headers := []kafka.Header{
+		{Key: headerPrefix + "specversion", Value: []byte(SpecVersion)},
+		{Key: headerPrefix + "id", Value: []byte(env.Id)},
+		{Key: headerPrefix + "source", Value: []byte(env.Source)},
+		{Key: headerPrefix + "type", Value: []byte(env.Type)},
+		{Key: headerPrefix + "time", Value: []byte(env.Time.Format(time.RFC3339Nano))},
+		{Key: headerContentTp, Value: []byte(ContentType)},
+	}
+
+	if env.Subject != "" {
+		headers = append(headers, kafka.Header{Key: headerPrefix + "subject", Value: []byte(env.Subject)})
+	}
+
+	if env.DataSchema != "" {
+		headers = append(headers, kafka.Header{Key: headerPrefix + "dataschema", Value: []byte(env.DataSchema)})
+	}
+
+	if env.TraceId != "" {
+		headers = append(headers, kafka.Header{Key: transport.TraceIdKey, Value: []byte(env.TraceId)})
+	}
+
+	if env.TraceParent != "" {
+		headers = append(headers, kafka.Header{Key: headerPrefix + attrTraceParent, Value: []byte(env.TraceParent)})
+	}
+
+	if env.TraceState != "" {
+		headers = append(headers, kafka.Header{Key: headerPrefix + attrTraceState, Value: []byte(env.TraceState)})
+	}
+
+	return kafka.Message{ //nolint:exhaustruct
+		Key:     []byte(env.Key),
+		Value:   env.Data,
+		Headers: headers,
+	}
+}
@@ -0,0 +1,61 @@
+// Package metrics holds the Prometheus metrics of the service, they are served by [Handler].
+//
+// The metrics are registered in the default registry, together with the Go runtime and process ones.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/erix"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Transports of the requests.
+const (
+	TransportGrpc = "grpc"
+	TransportHttp = "http"
+)
+
+var (
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{ //nolint:exhaustruct
+		Name:    "request_duration_seconds",
+		Help:    "Duration of the handled requests by the method and the code of the error, OK for the successful ones.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"transport", "method", "code"})
+
+	produceDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{ //nolint:exhaustruct
+		Name:    "kafka_produce_duration_seconds",
+		Help:    "Duration of the writes of the events to Kafka by the topic.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"topic"})
+
+	produceErrors = promauto.NewCounterVec(prometheus.CounterOpts{ //nolint:exhaustruct
+		Name: "kafka_produce_errors_total",
+		Help: "Failed writes of the events to Kafka by the topic.",
+	}, []string{"topic"})
+)
+
+// Handler serves the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveRequest records the request of the method, the error is labeled by its erix code.
+func ObserveRequest(transport, method string, elapsed time.Duration, err error) {
+	requestDuration.
+		WithLabelValues(transport, method, erix.GrpcCode(err).String()).
+		Observe(elapsed.Seconds())
+}
+
+// ObserveProduce records the write of the events to the topic.
+func ObserveProduce(topic string, elapsed time.Duration, err error) {
+	produceDuration.WithLabelValues(topic).Observe(elapsed.Seconds())
+
+	if err != nil {
+		produceErrors.WithLabelValues(topic).Inc()
+	}
+}
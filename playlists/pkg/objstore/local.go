@@ -0,0 +1,290 @@
+package objstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+)
+
+const (
+	dirPerm = 0o750
+	// Objects being put are written to temporary files which are renamed when complete
+	tmpPrefix = ".put-"
+)
+
+// Local keeps objects as files of a directory, buckets are its subdirectories.
+// Keys with slashes are stored in nested directories.
+type Local struct {
+	root string
+}
+
+func NewLocal(root string) (*Local, error) {
+	err := os.MkdirAll(root, dirPerm)
+	if err != nil {
+		return nil, e.NewFrom("creating root directory", err, fields.F("root", root))
+	}
+
+	return &Local{root: root}, nil
+}
+
+func (s *Local) MakeBucket(_ context.Context, bucket string) error {
+	dir, err := s.bucketDir(bucket)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(dir, dirPerm)
+	if err != nil {
+		return e.NewFrom("creating bucket", err, fields.F("bucket", bucket))
+	}
+
+	return nil
+}
+
+func (s *Local) BucketExists(_ context.Context, bucket string) (bool, error) {
+	dir, err := s.bucketDir(bucket)
+	if err != nil {
+		return false, err
+	}
+
+	info, err := os.Stat(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, e.NewFrom("checking if bucket exists", err, fields.F("bucket", bucket))
+	}
+
+	return info.IsDir(), nil
+}
+
+func (s *Local) Put(
+	ctx context.Context, bucket, key string, content io.Reader, size int64, _ PutOptions,
+) (int64, error) {
+	name, err := s.objectPath(bucket, key)
+	if err != nil {
+		return 0, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(name), dirPerm)
+	if err != nil {
+		return 0, e.NewFrom("creating object directory", err, fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), tmpPrefix+"*")
+	if err != nil {
+		return 0, e.NewFrom("creating object file", err, fields.F("bucket", bucket), fields.F("key", key))
+	}
+	// Removing fails after the rename, the object stays then
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+	defer tmp.Close()           //nolint:errcheck
+
+	written, err := io.Copy(tmp, contextReader{ctx: ctx, r: content})
+	if err != nil {
+		return 0, e.NewFrom("writing object", err, fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	if size >= 0 && written != size {
+		return 0, e.New("object size mismatch",
+			fields.F("bucket", bucket), fields.F("key", key), fields.F("size", size), fields.F("written", written))
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return 0, e.NewFrom("closing object file", err, fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	err = os.Rename(tmp.Name(), name)
+	if err != nil {
+		return 0, e.NewFrom("renaming object file", err, fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	return written, nil
+}
+
+func (s *Local) Get(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	return s.GetRange(ctx, bucket, key, 0, 0)
+}
+
+func (s *Local) GetRange(_ context.Context, bucket, key string, offset, length int64) (io.ReadCloser, error) {
+	name, err := s.objectPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, e.NewFrom("opening object", mapFsError(err), fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	stat, err := file.Stat()
+	if err == nil && stat.IsDir() {
+		err = mapFsError(fs.ErrNotExist)
+	}
+
+	if err != nil {
+		file.Close() //nolint:errcheck,gosec
+		return nil, e.NewFrom("getting object info", err, fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	if offset == 0 && length <= 0 {
+		return file, nil
+	}
+
+	if offset < 0 || offset >= stat.Size() {
+		file.Close() //nolint:errcheck,gosec
+		return nil, e.NewFrom("getting object range", ErrInvalidRange,
+			fields.F("bucket", bucket), fields.F("key", key), fields.F("offset", offset), fields.F("size", stat.Size()))
+	}
+
+	if length <= 0 {
+		length = stat.Size() - offset
+	}
+
+	return rangeReader{
+		Reader: io.NewSectionReader(file, offset, length),
+		Closer: file,
+	}, nil
+}
+
+func (s *Local) Stat(_ context.Context, bucket, key string) (ObjectInfo, error) {
+	name, err := s.objectPath(bucket, key)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	stat, err := os.Stat(name)
+	if err == nil && stat.IsDir() {
+		err = fs.ErrNotExist
+	}
+
+	if err != nil {
+		return ObjectInfo{}, e.NewFrom("getting object info", mapFsError(err),
+			fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	return ObjectInfo{Key: key, Size: stat.Size(), ModifiedAt: stat.ModTime()}, nil
+}
+
+func (s *Local) Delete(_ context.Context, bucket, key string) error {
+	name, err := s.objectPath(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(name)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return e.NewFrom("removing object", err, fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	return nil
+}
+
+func (s *Local) List(ctx context.Context, bucket, prefix string, fn func(ObjectInfo) error) error {
+	dir, err := s.bucketDir(bucket)
+	if err != nil {
+		return err
+	}
+
+	err = filepath.WalkDir(dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), tmpPrefix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			// Removed while listing
+			return nil
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		return fn(ObjectInfo{Key: key, Size: info.Size(), ModifiedAt: info.ModTime()})
+	})
+	if err != nil {
+		return e.NewFrom("listing objects", err, fields.F("bucket", bucket), fields.F("prefix", prefix))
+	}
+
+	return nil
+}
+
+func (s *Local) bucketDir(bucket string) (string, error) {
+	if bucket == "" || bucket == "." || bucket == ".." || strings.ContainsAny(bucket, `/\`) {
+		return "", e.NewFrom("checking bucket", ErrInvalidKey, fields.F("bucket", bucket))
+	}
+
+	return filepath.Join(s.root, bucket), nil
+}
+
+// objectPath keeps the object within its bucket, keys are clean relative slash separated paths.
+func (s *Local) objectPath(bucket, key string) (string, error) {
+	dir, err := s.bucketDir(bucket)
+	if err != nil {
+		return "", err
+	}
+
+	base := path.Base(key)
+
+	if key == "" || path.Clean(key) != key || path.IsAbs(key) || strings.HasPrefix(key, "../") ||
+		key == ".." || strings.Contains(key, `\`) || strings.HasPrefix(base, tmpPrefix) {
+		return "", e.NewFrom("checking key", ErrInvalidKey, fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	return filepath.Join(dir, filepath.FromSlash(key)), nil
+}
+
+func mapFsError(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return errors.Join(ErrNotFound, err)
+	}
+
+	return err
+}
+
+type rangeReader struct {
+	io.Reader
+	io.Closer
+}
+
+// contextReader stops long copies when the context is done.
+type contextReader struct {
+	ctx context.Context //nolint:containedctx
+	r   io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	return r.r.Read(p) //nolint:wrapcheck
+}
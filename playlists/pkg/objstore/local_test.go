@@ -0,0 +1,242 @@
+package objstore_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/objstore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLocal(t *testing.T) *objstore.Local {
+	t.Helper()
+
+	store, err := objstore.NewLocal(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, store.MakeBucket(context.Background(), "songs"))
+
+	return store
+}
+
+func put(t *testing.T, store objstore.Store, key, content string) {
+	t.Helper()
+
+	size, err := store.Put(context.Background(), "songs", key, strings.NewReader(content), int64(len(content)),
+		objstore.PutOptions{}) //nolint:exhaustruct
+	require.NoError(t, err)
+	require.Equal(t, int64(len(content)), size)
+}
+
+func read(t *testing.T, r io.ReadCloser) string {
+	t.Helper()
+
+	defer r.Close()
+
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	return string(b)
+}
+
+func TestLocal_PutGet(t *testing.T) {
+	ctx := context.Background()
+	store := newLocal(t)
+
+	put(t, store, "song.mp3", "audio")
+	put(t, store, "nested/song.mp3", "nested audio")
+	// Putting again replaces the object
+	put(t, store, "song.mp3", "new audio")
+
+	object, err := store.Get(ctx, "songs", "song.mp3")
+	require.NoError(t, err)
+	assert.Equal(t, "new audio", read(t, object))
+
+	object, err = store.Get(ctx, "songs", "nested/song.mp3")
+	require.NoError(t, err)
+	assert.Equal(t, "nested audio", read(t, object))
+
+	info, err := store.Stat(ctx, "songs", "nested/song.mp3")
+	require.NoError(t, err)
+	assert.Equal(t, "nested/song.mp3", info.Key)
+	assert.Equal(t, int64(12), info.Size)
+}
+
+func TestLocal_PutUnknownSize(t *testing.T) {
+	store := newLocal(t)
+
+	size, err := store.Put(context.Background(), "songs", "export.zip", strings.NewReader("zip"), -1,
+		objstore.PutOptions{}) //nolint:exhaustruct
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), size)
+}
+
+func TestLocal_PutSizeMismatch(t *testing.T) {
+	ctx := context.Background()
+	store := newLocal(t)
+
+	_, err := store.Put(ctx, "songs", "song.mp3", strings.NewReader("short"), 100,
+		objstore.PutOptions{}) //nolint:exhaustruct
+	require.Error(t, err)
+
+	// Incomplete objects never appear
+	_, err = store.Get(ctx, "songs", "song.mp3")
+	require.ErrorIs(t, err, objstore.ErrNotFound)
+}
+
+func TestLocal_PutFailedContent(t *testing.T) {
+	ctx := context.Background()
+	store := newLocal(t)
+	put(t, store, "song.mp3", "audio")
+
+	content := io.MultiReader(strings.NewReader("new"), iotestErrReader{})
+
+	_, err := store.Put(ctx, "songs", "song.mp3", content, -1, objstore.PutOptions{}) //nolint:exhaustruct
+	require.Error(t, err)
+
+	// The previous object is kept
+	object, err := store.Get(ctx, "songs", "song.mp3")
+	require.NoError(t, err)
+	assert.Equal(t, "audio", read(t, object))
+}
+
+func TestLocal_GetRange(t *testing.T) {
+	ctx := context.Background()
+	store := newLocal(t)
+	put(t, store, "song.mp3", "0123456789")
+
+	tests := []struct {
+		name           string
+		offset, length int64
+		want           string
+	}{
+		{name: "head", offset: 0, length: 3, want: "012"},
+		{name: "middle", offset: 4, length: 2, want: "45"},
+		{name: "rest", offset: 7, length: 0, want: "789"},
+		{name: "past the end", offset: 8, length: 10, want: "89"},
+		{name: "whole", offset: 0, length: 0, want: "0123456789"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			object, err := store.GetRange(ctx, "songs", "song.mp3", tt.offset, tt.length)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, read(t, object))
+		})
+	}
+
+	_, err := store.GetRange(ctx, "songs", "song.mp3", 10, 1)
+	require.ErrorIs(t, err, objstore.ErrInvalidRange)
+}
+
+func TestLocal_NotFound(t *testing.T) {
+	ctx := context.Background()
+	store := newLocal(t)
+	put(t, store, "nested/song.mp3", "audio")
+
+	_, err := store.Get(ctx, "songs", "missing.mp3")
+	require.ErrorIs(t, err, objstore.ErrNotFound)
+
+	_, err = store.Stat(ctx, "songs", "missing.mp3")
+	require.ErrorIs(t, err, objstore.ErrNotFound)
+
+	// Directories are not objects
+	_, err = store.Get(ctx, "songs", "nested")
+	require.ErrorIs(t, err, objstore.ErrNotFound)
+
+	_, err = store.Get(ctx, "missing", "song.mp3")
+	require.ErrorIs(t, err, objstore.ErrNotFound)
+}
+
+func TestLocal_Delete(t *testing.T) {
+	ctx := context.Background()
+	store := newLocal(t)
+	put(t, store, "song.mp3", "audio")
+
+	require.NoError(t, store.Delete(ctx, "songs", "song.mp3"))
+	require.NoError(t, store.Delete(ctx, "songs", "song.mp3"))
+
+	_, err := store.Get(ctx, "songs", "song.mp3")
+	require.ErrorIs(t, err, objstore.ErrNotFound)
+}
+
+func TestLocal_List(t *testing.T) {
+	ctx := context.Background()
+	store := newLocal(t)
+	put(t, store, "a.mp3", "a")
+	put(t, store, "b.mp3", "bb")
+	put(t, store, "images/c.png", "ccc")
+
+	var keys []string
+
+	err := store.List(ctx, "songs", "", func(info objstore.ObjectInfo) error {
+		keys = append(keys, info.Key)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a.mp3", "b.mp3", "images/c.png"}, keys)
+
+	keys = nil
+
+	err = store.List(ctx, "songs", "images/", func(info objstore.ObjectInfo) error {
+		keys = append(keys, info.Key)
+		assert.Equal(t, int64(3), info.Size)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"images/c.png"}, keys)
+
+	stop := errors.New("stop")
+	calls := 0
+
+	err = store.List(ctx, "songs", "", func(objstore.ObjectInfo) error {
+		calls++
+		return stop
+	})
+	require.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, calls)
+}
+
+func TestLocal_InvalidKeys(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+
+	store, err := objstore.NewLocal(filepath.Join(root, "objects"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(root, "secret"), []byte("secret"), 0o600))
+
+	for _, key := range []string{"", "../secret", "../../secret", "/etc/passwd", "a/../b", "a/", `a\b`, ".put-1"} {
+		_, err := store.Get(ctx, "songs", key)
+		assert.ErrorIs(t, err, objstore.ErrInvalidKey, key)
+	}
+
+	for _, bucket := range []string{"", "..", "a/b"} {
+		_, err := store.Get(ctx, bucket, "song.mp3")
+		assert.ErrorIs(t, err, objstore.ErrInvalidKey, bucket)
+	}
+}
+
+type iotestErrReader struct{}
+
+func (iotestErrReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestLocalBucketExists(t *testing.T) {
+	store := newLocal(t)
+
+	ok, err := store.BucketExists(context.Background(), "songs")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = store.BucketExists(context.Background(), "images")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -1,6 +1,11 @@
 package transport
 
-import gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+import (
+	"net/http"
+
+	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel/trace"
+)
 
 func MuxWithAuthAndTraceHeaders() gateway.ServeMuxOption {
 	return gateway.WithIncomingHeaderMatcher(func(key string) (string, bool) {
@@ -15,3 +20,17 @@ func MuxWithAuthAndTraceHeaders() gateway.ServeMuxOption {
 		return key, false
 	})
 }
+
+// MuxWithSpanNames names the spans of the otel http handler by the route,
+// so the requests of the same route are grouped no matter the path params.
+func MuxWithSpanNames() gateway.ServeMuxOption {
+	return gateway.WithMiddlewares(func(next gateway.HandlerFunc) gateway.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			if pattern, ok := gateway.HTTPPattern(r.Context()); ok {
+				trace.SpanFromContext(r.Context()).SetName(r.Method + " " + pattern.String())
+			}
+
+			next(w, r, pathParams)
+		}
+	})
+}
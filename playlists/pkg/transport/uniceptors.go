@@ -8,6 +8,8 @@ import (
 
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/erix"
 	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/metrics"
+	"github.com/Benzogang-Tape/audio-hosting/playlists/pkg/tracing"
 
 	"dev.gaijin.team/go/golib/stacktrace"
 	"github.com/google/uuid"
@@ -27,14 +29,17 @@ var (
 func ContextWithLogger[T any, T2 any](log logger.Logger) Uniceptor[T, T2] {
 	return func(next GrpcHandler[T, T2]) GrpcHandler[T, T2] {
 		return func(ctx context.Context, req T) (resp T2, err error) {
-			var traceId string
-
-			md, ok := metadata.FromIncomingContext(ctx)
-			if ok && len(md.Get(TraceIdKey)) > 0 {
-				traceId = md.Get(TraceIdKey)[0]
-			} else {
-				traceId = uuid.NewString()
-				ctx = metadata.AppendToOutgoingContext(ctx, TraceIdKey, traceId)
+			// The trace of the span started by the otel handler, the header is left for callers without tracing
+			traceId := tracing.TraceId(ctx)
+
+			if traceId == "" {
+				md, ok := metadata.FromIncomingContext(ctx)
+				if ok && len(md.Get(TraceIdKey)) > 0 {
+					traceId = md.Get(TraceIdKey)[0]
+				} else {
+					traceId = uuid.NewString()
+					ctx = metadata.AppendToOutgoingContext(ctx, TraceIdKey, traceId)
+				}
 			}
 
 			ctx = context.WithValue(ctx, logger.LoggerKey, log)
@@ -56,6 +61,8 @@ func Logging[T any, T2 any](method string) Uniceptor[T, T2] {
 			res, handlerErr := next(ctx, req)
 			elapsed := time.Since(start)
 
+			metrics.ObserveRequest(metrics.TransportGrpc, method, elapsed, handlerErr)
+
 			if handlerErr != nil {
 				code := erix.GrpcCode(handlerErr)
 				err = status.Error(code, erix.LastReason(handlerErr))
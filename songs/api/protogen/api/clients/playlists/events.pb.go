@@ -0,0 +1,332 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: api/clients/playlists/events.proto
+
+package playlists
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Event type: com.audio-hosting.playlists.track.liked.v1
+type TrackLikedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId string                 `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LikedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=liked_at,json=likedAt,proto3" json:"liked_at,omitempty"`
+}
+
+func (x *TrackLikedEvent) Reset() {
+	*x = TrackLikedEvent{}
+	mi := &file_api_clients_playlists_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackLikedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackLikedEvent) ProtoMessage() {}
+
+func (x *TrackLikedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clients_playlists_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackLikedEvent.ProtoReflect.Descriptor instead.
+func (*TrackLikedEvent) Descriptor() ([]byte, []int) {
+	return file_api_clients_playlists_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrackLikedEvent) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *TrackLikedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TrackLikedEvent) GetLikedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LikedAt
+	}
+	return nil
+}
+
+// Event type: com.audio-hosting.playlists.track.unliked.v1
+type TrackUnlikedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId   string                 `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UnlikedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unliked_at,json=unlikedAt,proto3" json:"unliked_at,omitempty"`
+}
+
+func (x *TrackUnlikedEvent) Reset() {
+	*x = TrackUnlikedEvent{}
+	mi := &file_api_clients_playlists_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackUnlikedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackUnlikedEvent) ProtoMessage() {}
+
+func (x *TrackUnlikedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clients_playlists_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackUnlikedEvent.ProtoReflect.Descriptor instead.
+func (*TrackUnlikedEvent) Descriptor() ([]byte, []int) {
+	return file_api_clients_playlists_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrackUnlikedEvent) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *TrackUnlikedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TrackUnlikedEvent) GetUnlikedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlikedAt
+	}
+	return nil
+}
+
+// Event type: com.audio-hosting.playlists.playlist.tracks_added.v1
+// Published for the tracks a playlist gets on creation, copy or update.
+type PlaylistTracksAddedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaylistId string                 `protobuf:"bytes,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	AuthorId   string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	TrackIds   []string               `protobuf:"bytes,3,rep,name=track_ids,json=trackIds,proto3" json:"track_ids,omitempty"`
+	IsAlbum    bool                   `protobuf:"varint,4,opt,name=is_album,json=isAlbum,proto3" json:"is_album,omitempty"`
+	AddedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+}
+
+func (x *PlaylistTracksAddedEvent) Reset() {
+	*x = PlaylistTracksAddedEvent{}
+	mi := &file_api_clients_playlists_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaylistTracksAddedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistTracksAddedEvent) ProtoMessage() {}
+
+func (x *PlaylistTracksAddedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_clients_playlists_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistTracksAddedEvent.ProtoReflect.Descriptor instead.
+func (*PlaylistTracksAddedEvent) Descriptor() ([]byte, []int) {
+	return file_api_clients_playlists_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlaylistTracksAddedEvent) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *PlaylistTracksAddedEvent) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PlaylistTracksAddedEvent) GetTrackIds() []string {
+	if x != nil {
+		return x.TrackIds
+	}
+	return nil
+}
+
+func (x *PlaylistTracksAddedEvent) GetIsAlbum() bool {
+	if x != nil {
+		return x.IsAlbum
+	}
+	return false
+}
+
+func (x *PlaylistTracksAddedEvent) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+var File_api_clients_playlists_events_proto protoreflect.FileDescriptor
+
+var file_api_clients_playlists_events_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x5f,
+	0x61, 0x70, 0x69, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7c, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x6b,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x6c,
+	0x69, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6c, 0x69, 0x6b, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x55, 0x6e, 0x6c, 0x69,
+	0x6b, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x6e,
+	0x6c, 0x69, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x18, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x35, 0x0a, 0x08, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x42, 0xb9, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
+	0x72, 0x6a, 0x61, 0x37, 0x32, 0x2e, 0x72, 0x75, 0x2f, 0x67, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x2f,
+	0x74, 0x65, 0x61, 0x6d, 0x30, 0x32, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0xa2,
+	0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x73, 0x41, 0x70, 0x69, 0xca, 0x02, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73,
+	0x41, 0x70, 0x69, 0xe2, 0x02, 0x18, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x41,
+	0x70, 0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0c, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_clients_playlists_events_proto_rawDescOnce sync.Once
+	file_api_clients_playlists_events_proto_rawDescData = file_api_clients_playlists_events_proto_rawDesc
+)
+
+func file_api_clients_playlists_events_proto_rawDescGZIP() []byte {
+	file_api_clients_playlists_events_proto_rawDescOnce.Do(func() {
+		file_api_clients_playlists_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_clients_playlists_events_proto_rawDescData)
+	})
+	return file_api_clients_playlists_events_proto_rawDescData
+}
+
+var file_api_clients_playlists_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_clients_playlists_events_proto_goTypes = []any{
+	(*TrackLikedEvent)(nil),          // 0: playlists_api.TrackLikedEvent
+	(*TrackUnlikedEvent)(nil),        // 1: playlists_api.TrackUnlikedEvent
+	(*PlaylistTracksAddedEvent)(nil), // 2: playlists_api.PlaylistTracksAddedEvent
+	(*timestamppb.Timestamp)(nil),    // 3: google.protobuf.Timestamp
+}
+var file_api_clients_playlists_events_proto_depIdxs = []int32{
+	3, // 0: playlists_api.TrackLikedEvent.liked_at:type_name -> google.protobuf.Timestamp
+	3, // 1: playlists_api.TrackUnlikedEvent.unliked_at:type_name -> google.protobuf.Timestamp
+	3, // 2: playlists_api.PlaylistTracksAddedEvent.added_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_clients_playlists_events_proto_init() }
+func file_api_clients_playlists_events_proto_init() {
+	if File_api_clients_playlists_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_clients_playlists_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_clients_playlists_events_proto_goTypes,
+		DependencyIndexes: file_api_clients_playlists_events_proto_depIdxs,
+		MessageInfos:      file_api_clients_playlists_events_proto_msgTypes,
+	}.Build()
+	File_api_clients_playlists_events_proto = out.File
+	file_api_clients_playlists_events_proto_rawDesc = nil
+	file_api_clients_playlists_events_proto_goTypes = nil
+	file_api_clients_playlists_events_proto_depIdxs = nil
+}
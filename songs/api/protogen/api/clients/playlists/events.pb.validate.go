@@ -0,0 +1,441 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/clients/playlists/events.proto
+
+package playlists
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on TrackLikedEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TrackLikedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrackLikedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TrackLikedEventMultiError, or nil if none found.
+func (m *TrackLikedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrackLikedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TrackId
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetLikedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrackLikedEventValidationError{
+					field:  "LikedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrackLikedEventValidationError{
+					field:  "LikedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLikedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrackLikedEventValidationError{
+				field:  "LikedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TrackLikedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrackLikedEventMultiError is an error wrapping multiple validation errors
+// returned by TrackLikedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type TrackLikedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrackLikedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrackLikedEventMultiError) AllErrors() []error { return m }
+
+// TrackLikedEventValidationError is the validation error returned by
+// TrackLikedEvent.Validate if the designated constraints aren't met.
+type TrackLikedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrackLikedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrackLikedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrackLikedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrackLikedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrackLikedEventValidationError) ErrorName() string { return "TrackLikedEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrackLikedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrackLikedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrackLikedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrackLikedEventValidationError{}
+
+// Validate checks the field values on TrackUnlikedEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TrackUnlikedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrackUnlikedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TrackUnlikedEventMultiError, or nil if none found.
+func (m *TrackUnlikedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrackUnlikedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TrackId
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetUnlikedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrackUnlikedEventValidationError{
+					field:  "UnlikedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrackUnlikedEventValidationError{
+					field:  "UnlikedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUnlikedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrackUnlikedEventValidationError{
+				field:  "UnlikedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TrackUnlikedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrackUnlikedEventMultiError is an error wrapping multiple validation errors
+// returned by TrackUnlikedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type TrackUnlikedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrackUnlikedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrackUnlikedEventMultiError) AllErrors() []error { return m }
+
+// TrackUnlikedEventValidationError is the validation error returned by
+// TrackUnlikedEvent.Validate if the designated constraints aren't met.
+type TrackUnlikedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrackUnlikedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrackUnlikedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrackUnlikedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrackUnlikedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrackUnlikedEventValidationError) ErrorName() string {
+	return "TrackUnlikedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrackUnlikedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrackUnlikedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrackUnlikedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrackUnlikedEventValidationError{}
+
+// Validate checks the field values on PlaylistTracksAddedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlaylistTracksAddedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaylistTracksAddedEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlaylistTracksAddedEventMultiError, or nil if none found.
+func (m *PlaylistTracksAddedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaylistTracksAddedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlaylistId
+
+	// no validation rules for AuthorId
+
+	// no validation rules for IsAlbum
+
+	if all {
+		switch v := interface{}(m.GetAddedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlaylistTracksAddedEventValidationError{
+					field:  "AddedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlaylistTracksAddedEventValidationError{
+					field:  "AddedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlaylistTracksAddedEventValidationError{
+				field:  "AddedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PlaylistTracksAddedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaylistTracksAddedEventMultiError is an error wrapping multiple validation
+// errors returned by PlaylistTracksAddedEvent.ValidateAll() if the designated
+// constraints aren't met.
+type PlaylistTracksAddedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaylistTracksAddedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaylistTracksAddedEventMultiError) AllErrors() []error { return m }
+
+// PlaylistTracksAddedEventValidationError is the validation error returned by
+// PlaylistTracksAddedEvent.Validate if the designated constraints aren't met.
+type PlaylistTracksAddedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaylistTracksAddedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaylistTracksAddedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaylistTracksAddedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaylistTracksAddedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaylistTracksAddedEventValidationError) ErrorName() string {
+	return "PlaylistTracksAddedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaylistTracksAddedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaylistTracksAddedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaylistTracksAddedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaylistTracksAddedEventValidationError{}
@@ -0,0 +1,1576 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/events.proto
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SongReleasedEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongReleasedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongReleasedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongReleasedEventMultiError, or nil if none found.
+func (m *SongReleasedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongReleasedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetReleasedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongReleasedEventValidationError{
+					field:  "ReleasedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongReleasedEventValidationError{
+					field:  "ReleasedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleasedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongReleasedEventValidationError{
+				field:  "ReleasedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SongReleasedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongReleasedEventMultiError is an error wrapping multiple validation errors
+// returned by SongReleasedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SongReleasedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongReleasedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongReleasedEventMultiError) AllErrors() []error { return m }
+
+// SongReleasedEventValidationError is the validation error returned by
+// SongReleasedEvent.Validate if the designated constraints aren't met.
+type SongReleasedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongReleasedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongReleasedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongReleasedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongReleasedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongReleasedEventValidationError) ErrorName() string {
+	return "SongReleasedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SongReleasedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongReleasedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongReleasedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongReleasedEventValidationError{}
+
+// Validate checks the field values on SongCreatedEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongCreatedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongCreatedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongCreatedEventMultiError, or nil if none found.
+func (m *SongCreatedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongCreatedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongCreatedEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongCreatedEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongCreatedEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Explicit
+
+	if len(errors) > 0 {
+		return SongCreatedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongCreatedEventMultiError is an error wrapping multiple validation errors
+// returned by SongCreatedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SongCreatedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongCreatedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongCreatedEventMultiError) AllErrors() []error { return m }
+
+// SongCreatedEventValidationError is the validation error returned by
+// SongCreatedEvent.Validate if the designated constraints aren't met.
+type SongCreatedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongCreatedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongCreatedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongCreatedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongCreatedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongCreatedEventValidationError) ErrorName() string { return "SongCreatedEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongCreatedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongCreatedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongCreatedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongCreatedEventValidationError{}
+
+// Validate checks the field values on SongUploadedEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongUploadedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongUploadedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongUploadedEventMultiError, or nil if none found.
+func (m *SongUploadedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongUploadedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	// no validation rules for SongUrl
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongUploadedEventValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongUploadedEventValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongUploadedEventValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUploadedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongUploadedEventValidationError{
+					field:  "UploadedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongUploadedEventValidationError{
+					field:  "UploadedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUploadedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongUploadedEventValidationError{
+				field:  "UploadedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SongUploadedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongUploadedEventMultiError is an error wrapping multiple validation errors
+// returned by SongUploadedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SongUploadedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongUploadedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongUploadedEventMultiError) AllErrors() []error { return m }
+
+// SongUploadedEventValidationError is the validation error returned by
+// SongUploadedEvent.Validate if the designated constraints aren't met.
+type SongUploadedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongUploadedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongUploadedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongUploadedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongUploadedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongUploadedEventValidationError) ErrorName() string {
+	return "SongUploadedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SongUploadedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongUploadedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongUploadedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongUploadedEventValidationError{}
+
+// Validate checks the field values on SongUpdatedEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongUpdatedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongUpdatedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongUpdatedEventMultiError, or nil if none found.
+func (m *SongUpdatedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongUpdatedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongUpdatedEventValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongUpdatedEventValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongUpdatedEventValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Explicit != nil {
+		// no validation rules for Explicit
+	}
+
+	if len(errors) > 0 {
+		return SongUpdatedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongUpdatedEventMultiError is an error wrapping multiple validation errors
+// returned by SongUpdatedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SongUpdatedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongUpdatedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongUpdatedEventMultiError) AllErrors() []error { return m }
+
+// SongUpdatedEventValidationError is the validation error returned by
+// SongUpdatedEvent.Validate if the designated constraints aren't met.
+type SongUpdatedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongUpdatedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongUpdatedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongUpdatedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongUpdatedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongUpdatedEventValidationError) ErrorName() string { return "SongUpdatedEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongUpdatedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongUpdatedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongUpdatedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongUpdatedEventValidationError{}
+
+// Validate checks the field values on SongImageChangedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SongImageChangedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongImageChangedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongImageChangedEventMultiError, or nil if none found.
+func (m *SongImageChangedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongImageChangedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	// no validation rules for ImageUrl
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongImageChangedEventValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongImageChangedEventValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongImageChangedEventValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SongImageChangedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongImageChangedEventMultiError is an error wrapping multiple validation
+// errors returned by SongImageChangedEvent.ValidateAll() if the designated
+// constraints aren't met.
+type SongImageChangedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongImageChangedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongImageChangedEventMultiError) AllErrors() []error { return m }
+
+// SongImageChangedEventValidationError is the validation error returned by
+// SongImageChangedEvent.Validate if the designated constraints aren't met.
+type SongImageChangedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongImageChangedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongImageChangedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongImageChangedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongImageChangedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongImageChangedEventValidationError) ErrorName() string {
+	return "SongImageChangedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SongImageChangedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongImageChangedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongImageChangedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongImageChangedEventValidationError{}
+
+// Validate checks the field values on SongDeletedEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongDeletedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongDeletedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongDeletedEventMultiError, or nil if none found.
+func (m *SongDeletedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongDeletedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongDeletedEventValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongDeletedEventValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongDeletedEventValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SongDeletedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongDeletedEventMultiError is an error wrapping multiple validation errors
+// returned by SongDeletedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SongDeletedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongDeletedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongDeletedEventMultiError) AllErrors() []error { return m }
+
+// SongDeletedEventValidationError is the validation error returned by
+// SongDeletedEvent.Validate if the designated constraints aren't met.
+type SongDeletedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongDeletedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongDeletedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongDeletedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongDeletedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongDeletedEventValidationError) ErrorName() string { return "SongDeletedEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongDeletedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongDeletedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongDeletedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongDeletedEventValidationError{}
+
+// Validate checks the field values on SongRestoredEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongRestoredEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongRestoredEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongRestoredEventMultiError, or nil if none found.
+func (m *SongRestoredEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongRestoredEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	if all {
+		switch v := interface{}(m.GetRestoredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongRestoredEventValidationError{
+					field:  "RestoredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongRestoredEventValidationError{
+					field:  "RestoredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRestoredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongRestoredEventValidationError{
+				field:  "RestoredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SongRestoredEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongRestoredEventMultiError is an error wrapping multiple validation errors
+// returned by SongRestoredEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SongRestoredEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongRestoredEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongRestoredEventMultiError) AllErrors() []error { return m }
+
+// SongRestoredEventValidationError is the validation error returned by
+// SongRestoredEvent.Validate if the designated constraints aren't met.
+type SongRestoredEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongRestoredEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongRestoredEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongRestoredEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongRestoredEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongRestoredEventValidationError) ErrorName() string {
+	return "SongRestoredEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SongRestoredEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongRestoredEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongRestoredEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongRestoredEventValidationError{}
+
+// Validate checks the field values on SongPlayedEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongPlayedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongPlayedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongPlayedEventMultiError, or nil if none found.
+func (m *SongPlayedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongPlayedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	// no validation rules for ListenerId
+
+	if all {
+		switch v := interface{}(m.GetPlayedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongPlayedEventValidationError{
+					field:  "PlayedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongPlayedEventValidationError{
+					field:  "PlayedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlayedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongPlayedEventValidationError{
+				field:  "PlayedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SongPlayedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongPlayedEventMultiError is an error wrapping multiple validation errors
+// returned by SongPlayedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SongPlayedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongPlayedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongPlayedEventMultiError) AllErrors() []error { return m }
+
+// SongPlayedEventValidationError is the validation error returned by
+// SongPlayedEvent.Validate if the designated constraints aren't met.
+type SongPlayedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongPlayedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongPlayedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongPlayedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongPlayedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongPlayedEventValidationError) ErrorName() string { return "SongPlayedEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongPlayedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongPlayedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongPlayedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongPlayedEventValidationError{}
+
+// Validate checks the field values on SongModeratedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SongModeratedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongModeratedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongModeratedEventMultiError, or nil if none found.
+func (m *SongModeratedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongModeratedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetModeratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongModeratedEventValidationError{
+					field:  "ModeratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongModeratedEventValidationError{
+					field:  "ModeratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetModeratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongModeratedEventValidationError{
+				field:  "ModeratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if len(errors) > 0 {
+		return SongModeratedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongModeratedEventMultiError is an error wrapping multiple validation errors
+// returned by SongModeratedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SongModeratedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongModeratedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongModeratedEventMultiError) AllErrors() []error { return m }
+
+// SongModeratedEventValidationError is the validation error returned by
+// SongModeratedEvent.Validate if the designated constraints aren't met.
+type SongModeratedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongModeratedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongModeratedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongModeratedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongModeratedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongModeratedEventValidationError) ErrorName() string {
+	return "SongModeratedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SongModeratedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongModeratedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongModeratedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongModeratedEventValidationError{}
+
+// Validate checks the field values on SongClaimEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SongClaimEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongClaimEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SongClaimEventMultiError,
+// or nil if none found.
+func (m *SongClaimEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongClaimEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClaimId
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	// no validation rules for ClaimantId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongClaimEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongClaimEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongClaimEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SongClaimEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongClaimEventMultiError is an error wrapping multiple validation errors
+// returned by SongClaimEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SongClaimEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongClaimEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongClaimEventMultiError) AllErrors() []error { return m }
+
+// SongClaimEventValidationError is the validation error returned by
+// SongClaimEvent.Validate if the designated constraints aren't met.
+type SongClaimEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongClaimEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongClaimEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongClaimEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongClaimEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongClaimEventValidationError) ErrorName() string { return "SongClaimEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongClaimEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongClaimEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongClaimEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongClaimEventValidationError{}
+
+// Validate checks the field values on SongCreditEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongCreditEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongCreditEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongCreditEventMultiError, or nil if none found.
+func (m *SongCreditEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongCreditEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for ArtistId
+
+	// no validation rules for CreditedArtistId
+
+	// no validation rules for Role
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongCreditEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongCreditEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongCreditEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SongCreditEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongCreditEventMultiError is an error wrapping multiple validation errors
+// returned by SongCreditEvent.ValidateAll() if the designated constraints
+// aren't met.
+type SongCreditEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongCreditEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongCreditEventMultiError) AllErrors() []error { return m }
+
+// SongCreditEventValidationError is the validation error returned by
+// SongCreditEvent.Validate if the designated constraints aren't met.
+type SongCreditEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongCreditEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongCreditEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongCreditEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongCreditEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongCreditEventValidationError) ErrorName() string { return "SongCreditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongCreditEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongCreditEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongCreditEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongCreditEventValidationError{}
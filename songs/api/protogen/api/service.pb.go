@@ -30,7 +30,7 @@ var file_api_service_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xb9, 0x08, 0x0a, 0x0c, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x53, 0x65,
+	0x72, 0x6f, 0x74, 0x6f, 0x32, 0x89, 0x1e, 0x0a, 0x0c, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
@@ -79,25 +79,198 @@ var file_api_service_proto_rawDesc = []byte{
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
 	0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x73, 0x6f, 0x6e, 0x67,
-	0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x54,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12,
-	0x13, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x6f, 0x6e, 0x67, 0x73, 0x12, 0x5d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x53, 0x6f, 0x6e,
-	0x67, 0x73, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x53, 0x6f,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x73, 0x6f,
+	0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x6f,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67,
+	0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53,
+	0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x12,
+	0x86, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x6f, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x7a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f,
+	0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x54, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x73, 0x6f,
 	0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73,
-	0x2f, 0x6d, 0x79, 0x12, 0x6b, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x6f,
-	0x6e, 0x67, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
-	0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x5d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x16,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x79, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x6d, 0x79, 0x12,
+	0x63, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x6d, 0x79, 0x2f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x6f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x6d, 0x79, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x68, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x71, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x73, 0x6f,
+	0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x6b, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x6f, 0x6e,
+	0x67, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a,
+	0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x60, 0x0a, 0x08, 0x46, 0x6c, 0x61, 0x67, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x14, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x53, 0x6f, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
+	0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x6c, 0x61,
+	0x67, 0x12, 0x70, 0x0a, 0x0c, 0x54, 0x61, 0x6b, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x53, 0x6f, 0x6e,
+	0x67, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x44, 0x6f, 0x77, 0x6e,
+	0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01,
+	0x2a, 0x22, 0x20, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x61, 0x6b, 0x65, 0x64,
+	0x6f, 0x77, 0x6e, 0x12, 0x6c, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6f,
+	0x6e, 0x67, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a,
+	0x22, 0x1f, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x6f, 0x6e, 0x67, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0x61, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4a, 0x6f, 0x62, 0x73,
+	0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x73, 0x6f,
+	0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f,
+	0x64, 0x65, 0x61, 0x64, 0x12, 0x61, 0x0a, 0x08, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62,
+	0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x69, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x73, 0x6f, 0x6e,
+	0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0x60, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x73,
+	0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x6b, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f,
+	0x7b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x35, 0x3a, 0x01, 0x2a, 0x22, 0x30, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x70, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
+	0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x2f, 0x7b, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x5a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x6f, 0x6e, 0x67,
+	0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x58, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x87,
+	0x01, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28,
+	0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x2d, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x0e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x12, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a,
+	0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
 	0x42, 0x7a, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x42, 0x0c, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74,
 	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x72, 0x6a, 0x61, 0x37, 0x32, 0x2e, 0x72, 0x75, 0x2f, 0x67, 0x6f,
@@ -110,29 +283,77 @@ var file_api_service_proto_rawDesc = []byte{
 }
 
 var file_api_service_proto_goTypes = []any{
-	(*emptypb.Empty)(nil),              // 0: google.protobuf.Empty
-	(*UploadRawSongRequest)(nil),       // 1: api.UploadRawSongRequest
-	(*GetRawSongRequest)(nil),          // 2: api.GetRawSongRequest
-	(*UploadRawSongImageRequest)(nil),  // 3: api.UploadRawSongImageRequest
-	(*GetRawSongImageRequest)(nil),     // 4: api.GetRawSongImageRequest
-	(*CreateSongRequest)(nil),          // 5: api.CreateSongRequest
-	(*GetSongRequest)(nil),             // 6: api.GetSongRequest
-	(*UpdateSongRequest)(nil),          // 7: api.UpdateSongRequest
-	(*DeleteSongsRequest)(nil),         // 8: api.DeleteSongsRequest
-	(*GetSongsRequest)(nil),            // 9: api.GetSongsRequest
-	(*GetMySongsRequest)(nil),          // 10: api.GetMySongsRequest
-	(*ReleaseSongsRequest)(nil),        // 11: api.ReleaseSongsRequest
-	(*UploadRawSongResponse)(nil),      // 12: api.UploadRawSongResponse
-	(*GetRawSongResponse)(nil),         // 13: api.GetRawSongResponse
-	(*UploadRawSongImageResponse)(nil), // 14: api.UploadRawSongImageResponse
-	(*GetRawSongImageResponse)(nil),    // 15: api.GetRawSongImageResponse
-	(*CreateSongResponse)(nil),         // 16: api.CreateSongResponse
-	(*GetSongResponse)(nil),            // 17: api.GetSongResponse
-	(*UpdateSongResponse)(nil),         // 18: api.UpdateSongResponse
-	(*DeleteSongsResponse)(nil),        // 19: api.DeleteSongsResponse
-	(*GetSongsResponse)(nil),           // 20: api.GetSongsResponse
-	(*GetMySongsResponse)(nil),         // 21: api.GetMySongsResponse
-	(*ReleaseSongsResponse)(nil),       // 22: api.ReleaseSongsResponse
+	(*emptypb.Empty)(nil),                // 0: google.protobuf.Empty
+	(*UploadRawSongRequest)(nil),         // 1: api.UploadRawSongRequest
+	(*GetRawSongRequest)(nil),            // 2: api.GetRawSongRequest
+	(*UploadRawSongImageRequest)(nil),    // 3: api.UploadRawSongImageRequest
+	(*GetRawSongImageRequest)(nil),       // 4: api.GetRawSongImageRequest
+	(*CreateSongRequest)(nil),            // 5: api.CreateSongRequest
+	(*GetSongRequest)(nil),               // 6: api.GetSongRequest
+	(*UpdateSongRequest)(nil),            // 7: api.UpdateSongRequest
+	(*DeleteSongsRequest)(nil),           // 8: api.DeleteSongsRequest
+	(*GetTrashedSongsRequest)(nil),       // 9: api.GetTrashedSongsRequest
+	(*RestoreTrashedSongsRequest)(nil),   // 10: api.RestoreTrashedSongsRequest
+	(*GetCreditRequestsRequest)(nil),     // 11: api.GetCreditRequestsRequest
+	(*ResolveCreditRequestRequest)(nil),  // 12: api.ResolveCreditRequestRequest
+	(*GetSongsRequest)(nil),              // 13: api.GetSongsRequest
+	(*GetMySongsRequest)(nil),            // 14: api.GetMySongsRequest
+	(*GetMyUsageRequest)(nil),            // 15: api.GetMyUsageRequest
+	(*GetArtistStatsRequest)(nil),        // 16: api.GetArtistStatsRequest
+	(*ExportCatalogRequest)(nil),         // 17: api.ExportCatalogRequest
+	(*GetCatalogExportsRequest)(nil),     // 18: api.GetCatalogExportsRequest
+	(*ReleaseSongsRequest)(nil),          // 19: api.ReleaseSongsRequest
+	(*FlagSongRequest)(nil),              // 20: api.FlagSongRequest
+	(*TakeDownSongRequest)(nil),          // 21: api.TakeDownSongRequest
+	(*RestoreSongRequest)(nil),           // 22: api.RestoreSongRequest
+	(*GetDeadJobsRequest)(nil),           // 23: api.GetDeadJobsRequest
+	(*RetryJobRequest)(nil),              // 24: api.RetryJobRequest
+	(*CreateWebhookRequest)(nil),         // 25: api.CreateWebhookRequest
+	(*GetWebhooksRequest)(nil),           // 26: api.GetWebhooksRequest
+	(*DeleteWebhookRequest)(nil),         // 27: api.DeleteWebhookRequest
+	(*GetWebhookDeliveriesRequest)(nil),  // 28: api.GetWebhookDeliveriesRequest
+	(*RedeliverWebhookRequest)(nil),      // 29: api.RedeliverWebhookRequest
+	(*SubmitClaimRequest)(nil),           // 30: api.SubmitClaimRequest
+	(*GetClaimRequest)(nil),              // 31: api.GetClaimRequest
+	(*GetClaimsRequest)(nil),             // 32: api.GetClaimsRequest
+	(*FileCounterNoticeRequest)(nil),     // 33: api.FileCounterNoticeRequest
+	(*ResolveClaimRequest)(nil),          // 34: api.ResolveClaimRequest
+	(*VerifyClaimantRequest)(nil),        // 35: api.VerifyClaimantRequest
+	(*UploadRawSongResponse)(nil),        // 36: api.UploadRawSongResponse
+	(*GetRawSongResponse)(nil),           // 37: api.GetRawSongResponse
+	(*UploadRawSongImageResponse)(nil),   // 38: api.UploadRawSongImageResponse
+	(*GetRawSongImageResponse)(nil),      // 39: api.GetRawSongImageResponse
+	(*CreateSongResponse)(nil),           // 40: api.CreateSongResponse
+	(*GetSongResponse)(nil),              // 41: api.GetSongResponse
+	(*UpdateSongResponse)(nil),           // 42: api.UpdateSongResponse
+	(*DeleteSongsResponse)(nil),          // 43: api.DeleteSongsResponse
+	(*GetTrashedSongsResponse)(nil),      // 44: api.GetTrashedSongsResponse
+	(*RestoreTrashedSongsResponse)(nil),  // 45: api.RestoreTrashedSongsResponse
+	(*GetCreditRequestsResponse)(nil),    // 46: api.GetCreditRequestsResponse
+	(*ResolveCreditRequestResponse)(nil), // 47: api.ResolveCreditRequestResponse
+	(*GetSongsResponse)(nil),             // 48: api.GetSongsResponse
+	(*GetMySongsResponse)(nil),           // 49: api.GetMySongsResponse
+	(*GetMyUsageResponse)(nil),           // 50: api.GetMyUsageResponse
+	(*GetArtistStatsResponse)(nil),       // 51: api.GetArtistStatsResponse
+	(*ExportCatalogResponse)(nil),        // 52: api.ExportCatalogResponse
+	(*GetCatalogExportsResponse)(nil),    // 53: api.GetCatalogExportsResponse
+	(*ReleaseSongsResponse)(nil),         // 54: api.ReleaseSongsResponse
+	(*FlagSongResponse)(nil),             // 55: api.FlagSongResponse
+	(*TakeDownSongResponse)(nil),         // 56: api.TakeDownSongResponse
+	(*RestoreSongResponse)(nil),          // 57: api.RestoreSongResponse
+	(*GetDeadJobsResponse)(nil),          // 58: api.GetDeadJobsResponse
+	(*RetryJobResponse)(nil),             // 59: api.RetryJobResponse
+	(*CreateWebhookResponse)(nil),        // 60: api.CreateWebhookResponse
+	(*GetWebhooksResponse)(nil),          // 61: api.GetWebhooksResponse
+	(*DeleteWebhookResponse)(nil),        // 62: api.DeleteWebhookResponse
+	(*GetWebhookDeliveriesResponse)(nil), // 63: api.GetWebhookDeliveriesResponse
+	(*RedeliverWebhookResponse)(nil),     // 64: api.RedeliverWebhookResponse
+	(*SubmitClaimResponse)(nil),          // 65: api.SubmitClaimResponse
+	(*GetClaimResponse)(nil),             // 66: api.GetClaimResponse
+	(*GetClaimsResponse)(nil),            // 67: api.GetClaimsResponse
+	(*FileCounterNoticeResponse)(nil),    // 68: api.FileCounterNoticeResponse
+	(*ResolveClaimResponse)(nil),         // 69: api.ResolveClaimResponse
+	(*VerifyClaimantResponse)(nil),       // 70: api.VerifyClaimantResponse
 }
 var file_api_service_proto_depIdxs = []int32{
 	0,  // 0: api.SongsService.Health:input_type -> google.protobuf.Empty
@@ -144,23 +365,71 @@ var file_api_service_proto_depIdxs = []int32{
 	6,  // 6: api.SongsService.GetSong:input_type -> api.GetSongRequest
 	7,  // 7: api.SongsService.UpdateSong:input_type -> api.UpdateSongRequest
 	8,  // 8: api.SongsService.DeleteSongs:input_type -> api.DeleteSongsRequest
-	9,  // 9: api.SongsService.GetSongs:input_type -> api.GetSongsRequest
-	10, // 10: api.SongsService.GetMySongs:input_type -> api.GetMySongsRequest
-	11, // 11: api.SongsService.ReleaseSongs:input_type -> api.ReleaseSongsRequest
-	0,  // 12: api.SongsService.Health:output_type -> google.protobuf.Empty
-	12, // 13: api.SongsService.UploadRawSong:output_type -> api.UploadRawSongResponse
-	13, // 14: api.SongsService.GetRawSong:output_type -> api.GetRawSongResponse
-	14, // 15: api.SongsService.UploadRawSongImage:output_type -> api.UploadRawSongImageResponse
-	15, // 16: api.SongsService.GetRawSongImage:output_type -> api.GetRawSongImageResponse
-	16, // 17: api.SongsService.CreateSong:output_type -> api.CreateSongResponse
-	17, // 18: api.SongsService.GetSong:output_type -> api.GetSongResponse
-	18, // 19: api.SongsService.UpdateSong:output_type -> api.UpdateSongResponse
-	19, // 20: api.SongsService.DeleteSongs:output_type -> api.DeleteSongsResponse
-	20, // 21: api.SongsService.GetSongs:output_type -> api.GetSongsResponse
-	21, // 22: api.SongsService.GetMySongs:output_type -> api.GetMySongsResponse
-	22, // 23: api.SongsService.ReleaseSongs:output_type -> api.ReleaseSongsResponse
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
+	9,  // 9: api.SongsService.GetTrashedSongs:input_type -> api.GetTrashedSongsRequest
+	10, // 10: api.SongsService.RestoreTrashedSongs:input_type -> api.RestoreTrashedSongsRequest
+	11, // 11: api.SongsService.GetCreditRequests:input_type -> api.GetCreditRequestsRequest
+	12, // 12: api.SongsService.ResolveCreditRequest:input_type -> api.ResolveCreditRequestRequest
+	13, // 13: api.SongsService.GetSongs:input_type -> api.GetSongsRequest
+	14, // 14: api.SongsService.GetMySongs:input_type -> api.GetMySongsRequest
+	15, // 15: api.SongsService.GetMyUsage:input_type -> api.GetMyUsageRequest
+	16, // 16: api.SongsService.GetArtistStats:input_type -> api.GetArtistStatsRequest
+	17, // 17: api.SongsService.ExportCatalog:input_type -> api.ExportCatalogRequest
+	18, // 18: api.SongsService.GetCatalogExports:input_type -> api.GetCatalogExportsRequest
+	19, // 19: api.SongsService.ReleaseSongs:input_type -> api.ReleaseSongsRequest
+	20, // 20: api.SongsService.FlagSong:input_type -> api.FlagSongRequest
+	21, // 21: api.SongsService.TakeDownSong:input_type -> api.TakeDownSongRequest
+	22, // 22: api.SongsService.RestoreSong:input_type -> api.RestoreSongRequest
+	23, // 23: api.SongsService.GetDeadJobs:input_type -> api.GetDeadJobsRequest
+	24, // 24: api.SongsService.RetryJob:input_type -> api.RetryJobRequest
+	25, // 25: api.SongsService.CreateWebhook:input_type -> api.CreateWebhookRequest
+	26, // 26: api.SongsService.GetWebhooks:input_type -> api.GetWebhooksRequest
+	27, // 27: api.SongsService.DeleteWebhook:input_type -> api.DeleteWebhookRequest
+	28, // 28: api.SongsService.GetWebhookDeliveries:input_type -> api.GetWebhookDeliveriesRequest
+	29, // 29: api.SongsService.RedeliverWebhook:input_type -> api.RedeliverWebhookRequest
+	30, // 30: api.SongsService.SubmitClaim:input_type -> api.SubmitClaimRequest
+	31, // 31: api.SongsService.GetClaim:input_type -> api.GetClaimRequest
+	32, // 32: api.SongsService.GetClaims:input_type -> api.GetClaimsRequest
+	33, // 33: api.SongsService.FileCounterNotice:input_type -> api.FileCounterNoticeRequest
+	34, // 34: api.SongsService.ResolveClaim:input_type -> api.ResolveClaimRequest
+	35, // 35: api.SongsService.VerifyClaimant:input_type -> api.VerifyClaimantRequest
+	0,  // 36: api.SongsService.Health:output_type -> google.protobuf.Empty
+	36, // 37: api.SongsService.UploadRawSong:output_type -> api.UploadRawSongResponse
+	37, // 38: api.SongsService.GetRawSong:output_type -> api.GetRawSongResponse
+	38, // 39: api.SongsService.UploadRawSongImage:output_type -> api.UploadRawSongImageResponse
+	39, // 40: api.SongsService.GetRawSongImage:output_type -> api.GetRawSongImageResponse
+	40, // 41: api.SongsService.CreateSong:output_type -> api.CreateSongResponse
+	41, // 42: api.SongsService.GetSong:output_type -> api.GetSongResponse
+	42, // 43: api.SongsService.UpdateSong:output_type -> api.UpdateSongResponse
+	43, // 44: api.SongsService.DeleteSongs:output_type -> api.DeleteSongsResponse
+	44, // 45: api.SongsService.GetTrashedSongs:output_type -> api.GetTrashedSongsResponse
+	45, // 46: api.SongsService.RestoreTrashedSongs:output_type -> api.RestoreTrashedSongsResponse
+	46, // 47: api.SongsService.GetCreditRequests:output_type -> api.GetCreditRequestsResponse
+	47, // 48: api.SongsService.ResolveCreditRequest:output_type -> api.ResolveCreditRequestResponse
+	48, // 49: api.SongsService.GetSongs:output_type -> api.GetSongsResponse
+	49, // 50: api.SongsService.GetMySongs:output_type -> api.GetMySongsResponse
+	50, // 51: api.SongsService.GetMyUsage:output_type -> api.GetMyUsageResponse
+	51, // 52: api.SongsService.GetArtistStats:output_type -> api.GetArtistStatsResponse
+	52, // 53: api.SongsService.ExportCatalog:output_type -> api.ExportCatalogResponse
+	53, // 54: api.SongsService.GetCatalogExports:output_type -> api.GetCatalogExportsResponse
+	54, // 55: api.SongsService.ReleaseSongs:output_type -> api.ReleaseSongsResponse
+	55, // 56: api.SongsService.FlagSong:output_type -> api.FlagSongResponse
+	56, // 57: api.SongsService.TakeDownSong:output_type -> api.TakeDownSongResponse
+	57, // 58: api.SongsService.RestoreSong:output_type -> api.RestoreSongResponse
+	58, // 59: api.SongsService.GetDeadJobs:output_type -> api.GetDeadJobsResponse
+	59, // 60: api.SongsService.RetryJob:output_type -> api.RetryJobResponse
+	60, // 61: api.SongsService.CreateWebhook:output_type -> api.CreateWebhookResponse
+	61, // 62: api.SongsService.GetWebhooks:output_type -> api.GetWebhooksResponse
+	62, // 63: api.SongsService.DeleteWebhook:output_type -> api.DeleteWebhookResponse
+	63, // 64: api.SongsService.GetWebhookDeliveries:output_type -> api.GetWebhookDeliveriesResponse
+	64, // 65: api.SongsService.RedeliverWebhook:output_type -> api.RedeliverWebhookResponse
+	65, // 66: api.SongsService.SubmitClaim:output_type -> api.SubmitClaimResponse
+	66, // 67: api.SongsService.GetClaim:output_type -> api.GetClaimResponse
+	67, // 68: api.SongsService.GetClaims:output_type -> api.GetClaimsResponse
+	68, // 69: api.SongsService.FileCounterNotice:output_type -> api.FileCounterNoticeResponse
+	69, // 70: api.SongsService.ResolveClaim:output_type -> api.ResolveClaimResponse
+	70, // 71: api.SongsService.VerifyClaimant:output_type -> api.VerifyClaimantResponse
+	36, // [36:72] is the sub-list for method output_type
+	0,  // [0:36] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
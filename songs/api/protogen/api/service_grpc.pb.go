@@ -20,18 +20,42 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SongsService_Health_FullMethodName             = "/api.SongsService/Health"
-	SongsService_UploadRawSong_FullMethodName      = "/api.SongsService/UploadRawSong"
-	SongsService_GetRawSong_FullMethodName         = "/api.SongsService/GetRawSong"
-	SongsService_UploadRawSongImage_FullMethodName = "/api.SongsService/UploadRawSongImage"
-	SongsService_GetRawSongImage_FullMethodName    = "/api.SongsService/GetRawSongImage"
-	SongsService_CreateSong_FullMethodName         = "/api.SongsService/CreateSong"
-	SongsService_GetSong_FullMethodName            = "/api.SongsService/GetSong"
-	SongsService_UpdateSong_FullMethodName         = "/api.SongsService/UpdateSong"
-	SongsService_DeleteSongs_FullMethodName        = "/api.SongsService/DeleteSongs"
-	SongsService_GetSongs_FullMethodName           = "/api.SongsService/GetSongs"
-	SongsService_GetMySongs_FullMethodName         = "/api.SongsService/GetMySongs"
-	SongsService_ReleaseSongs_FullMethodName       = "/api.SongsService/ReleaseSongs"
+	SongsService_Health_FullMethodName               = "/api.SongsService/Health"
+	SongsService_UploadRawSong_FullMethodName        = "/api.SongsService/UploadRawSong"
+	SongsService_GetRawSong_FullMethodName           = "/api.SongsService/GetRawSong"
+	SongsService_UploadRawSongImage_FullMethodName   = "/api.SongsService/UploadRawSongImage"
+	SongsService_GetRawSongImage_FullMethodName      = "/api.SongsService/GetRawSongImage"
+	SongsService_CreateSong_FullMethodName           = "/api.SongsService/CreateSong"
+	SongsService_GetSong_FullMethodName              = "/api.SongsService/GetSong"
+	SongsService_UpdateSong_FullMethodName           = "/api.SongsService/UpdateSong"
+	SongsService_DeleteSongs_FullMethodName          = "/api.SongsService/DeleteSongs"
+	SongsService_GetTrashedSongs_FullMethodName      = "/api.SongsService/GetTrashedSongs"
+	SongsService_RestoreTrashedSongs_FullMethodName  = "/api.SongsService/RestoreTrashedSongs"
+	SongsService_GetCreditRequests_FullMethodName    = "/api.SongsService/GetCreditRequests"
+	SongsService_ResolveCreditRequest_FullMethodName = "/api.SongsService/ResolveCreditRequest"
+	SongsService_GetSongs_FullMethodName             = "/api.SongsService/GetSongs"
+	SongsService_GetMySongs_FullMethodName           = "/api.SongsService/GetMySongs"
+	SongsService_GetMyUsage_FullMethodName           = "/api.SongsService/GetMyUsage"
+	SongsService_GetArtistStats_FullMethodName       = "/api.SongsService/GetArtistStats"
+	SongsService_ExportCatalog_FullMethodName        = "/api.SongsService/ExportCatalog"
+	SongsService_GetCatalogExports_FullMethodName    = "/api.SongsService/GetCatalogExports"
+	SongsService_ReleaseSongs_FullMethodName         = "/api.SongsService/ReleaseSongs"
+	SongsService_FlagSong_FullMethodName             = "/api.SongsService/FlagSong"
+	SongsService_TakeDownSong_FullMethodName         = "/api.SongsService/TakeDownSong"
+	SongsService_RestoreSong_FullMethodName          = "/api.SongsService/RestoreSong"
+	SongsService_GetDeadJobs_FullMethodName          = "/api.SongsService/GetDeadJobs"
+	SongsService_RetryJob_FullMethodName             = "/api.SongsService/RetryJob"
+	SongsService_CreateWebhook_FullMethodName        = "/api.SongsService/CreateWebhook"
+	SongsService_GetWebhooks_FullMethodName          = "/api.SongsService/GetWebhooks"
+	SongsService_DeleteWebhook_FullMethodName        = "/api.SongsService/DeleteWebhook"
+	SongsService_GetWebhookDeliveries_FullMethodName = "/api.SongsService/GetWebhookDeliveries"
+	SongsService_RedeliverWebhook_FullMethodName     = "/api.SongsService/RedeliverWebhook"
+	SongsService_SubmitClaim_FullMethodName          = "/api.SongsService/SubmitClaim"
+	SongsService_GetClaim_FullMethodName             = "/api.SongsService/GetClaim"
+	SongsService_GetClaims_FullMethodName            = "/api.SongsService/GetClaims"
+	SongsService_FileCounterNotice_FullMethodName    = "/api.SongsService/FileCounterNotice"
+	SongsService_ResolveClaim_FullMethodName         = "/api.SongsService/ResolveClaim"
+	SongsService_VerifyClaimant_FullMethodName       = "/api.SongsService/VerifyClaimant"
 )
 
 // SongsServiceClient is the client API for SongsService service.
@@ -76,18 +100,103 @@ type SongsServiceClient interface {
 	// Updates an existing song.
 	// For artists only.
 	UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*UpdateSongResponse, error)
-	// Deletes songs with its image and binary data.
+	// Moves songs to the trash. They are deleted with their image and binary data
+	// when the restore window is over.
 	// For artists only.
 	DeleteSongs(ctx context.Context, in *DeleteSongsRequest, opts ...grpc.CallOption) (*DeleteSongsResponse, error)
+	// Retrieves your deleted songs that can still be restored.
+	// For artists only.
+	GetTrashedSongs(ctx context.Context, in *GetTrashedSongsRequest, opts ...grpc.CallOption) (*GetTrashedSongsResponse, error)
+	// Restores deleted songs from the trash.
+	// For artists only.
+	RestoreTrashedSongs(ctx context.Context, in *RestoreTrashedSongsRequest, opts ...grpc.CallOption) (*RestoreTrashedSongsResponse, error)
+	// Retrieves the credits other artists gave you that wait for your approval.
+	// For artists only.
+	GetCreditRequests(ctx context.Context, in *GetCreditRequestsRequest, opts ...grpc.CallOption) (*GetCreditRequestsResponse, error)
+	// Approves or declines a credit another artist gave you.
+	// Songs can't be released while they have pending credits.
+	// For artists only.
+	ResolveCreditRequest(ctx context.Context, in *ResolveCreditRequestRequest, opts ...grpc.CallOption) (*ResolveCreditRequestResponse, error)
 	// Retrieves released songs, optionally filtered and paginated.
 	GetSongs(ctx context.Context, in *GetSongsRequest, opts ...grpc.CallOption) (*GetSongsResponse, error)
 	// Retrieves your uploaded songs.
 	// For artists only.
 	GetMySongs(ctx context.Context, in *GetMySongsRequest, opts ...grpc.CallOption) (*GetMySongsResponse, error)
+	// Retrieves how much of your quotas you have used.
+	// For artists only.
+	GetMyUsage(ctx context.Context, in *GetMyUsageRequest, opts ...grpc.CallOption) (*GetMyUsageResponse, error)
+	// Retrieves plays, unique listeners, likes, playlist additions and follower growth
+	// of your songs by days or weeks, in total and per song.
+	// The counters are aggregated from events, so the last minutes may be missing.
+	// For artists only.
+	GetArtistStats(ctx context.Context, in *GetArtistStatsRequest, opts ...grpc.CallOption) (*GetArtistStatsResponse, error)
+	// Queues an export of all your songs with their files, images and credits.
+	// Returns the unfinished export instead if there is one.
+	// For artists only.
+	ExportCatalog(ctx context.Context, in *ExportCatalogRequest, opts ...grpc.CallOption) (*ExportCatalogResponse, error)
+	// Retrieves your latest exports with the download links of the ready ones.
+	// The links expire, export the catalog again to get a new one.
+	// For artists only.
+	GetCatalogExports(ctx context.Context, in *GetCatalogExportsRequest, opts ...grpc.CallOption) (*GetCatalogExportsResponse, error)
 	// Releases songs and notifies the followers if needed.
 	// Idempotent.
 	// For artists only.
 	ReleaseSongs(ctx context.Context, in *ReleaseSongsRequest, opts ...grpc.CallOption) (*ReleaseSongsResponse, error)
+	// Marks a song as under review after an abuse report.
+	// The song stays available until it is taken down.
+	// For admins only.
+	FlagSong(ctx context.Context, in *FlagSongRequest, opts ...grpc.CallOption) (*FlagSongResponse, error)
+	// Takes a song down, so it is hidden from everyone but its artist.
+	// For admins only.
+	TakeDownSong(ctx context.Context, in *TakeDownSongRequest, opts ...grpc.CallOption) (*TakeDownSongResponse, error)
+	// Restores a flagged or taken down song.
+	// For admins only.
+	RestoreSong(ctx context.Context, in *RestoreSongRequest, opts ...grpc.CallOption) (*RestoreSongResponse, error)
+	// Retrieves the background jobs out of attempts, recently failed first.
+	// For admins only.
+	GetDeadJobs(ctx context.Context, in *GetDeadJobsRequest, opts ...grpc.CallOption) (*GetDeadJobsResponse, error)
+	// Queues a dead job again with all its attempts.
+	// For admins only.
+	RetryJob(ctx context.Context, in *RetryJobRequest, opts ...grpc.CallOption) (*RetryJobResponse, error)
+	// Registers your HTTPS endpoint for the events of your songs:
+	// song.released, song.uploaded and claim.submitted.
+	// The secret signing the deliveries is returned only here.
+	// For artists only.
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	// Retrieves your webhooks.
+	// For artists only.
+	GetWebhooks(ctx context.Context, in *GetWebhooksRequest, opts ...grpc.CallOption) (*GetWebhooksResponse, error)
+	// Deletes your webhook with its deliveries, the pending ones are not sent.
+	// For artists only.
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// Retrieves the delivery log of your webhook, newest first.
+	// For artists only.
+	GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*GetWebhookDeliveriesResponse, error)
+	// Sends a delivery of your webhook again with all its attempts.
+	// For artists only.
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error)
+	// Submits a copyright claim against a song.
+	// The song is taken down until the claim is resolved if the claimant is verified,
+	// otherwise it is put under review. Its artist is notified.
+	// Claimants may have up to features.claims.maxOpenPerClaimant open claims.
+	SubmitClaim(ctx context.Context, in *SubmitClaimRequest, opts ...grpc.CallOption) (*SubmitClaimResponse, error)
+	// Retrieves a claim with the history of its status changes.
+	// For the claimant, the artist of the song and admins only.
+	GetClaim(ctx context.Context, in *GetClaimRequest, opts ...grpc.CallOption) (*GetClaimResponse, error)
+	// Retrieves your claims and the claims against your songs.
+	// Admins retrieve all claims.
+	GetClaims(ctx context.Context, in *GetClaimsRequest, opts ...grpc.CallOption) (*GetClaimsResponse, error)
+	// Disputes a pending claim.
+	// For the artist of the song only.
+	FileCounterNotice(ctx context.Context, in *FileCounterNoticeRequest, opts ...grpc.CallOption) (*FileCounterNoticeResponse, error)
+	// Upholds or rejects a claim.
+	// An upheld claim takes the song down, a rejected claim restores the song
+	// unless there are other open claims against it.
+	// For admins only.
+	ResolveClaim(ctx context.Context, in *ResolveClaimRequest, opts ...grpc.CallOption) (*ResolveClaimResponse, error)
+	// Verifies a claimant, claims of verified claimants take the songs down at once.
+	// For admins only.
+	VerifyClaimant(ctx context.Context, in *VerifyClaimantRequest, opts ...grpc.CallOption) (*VerifyClaimantResponse, error)
 }
 
 type songsServiceClient struct {
@@ -212,6 +321,46 @@ func (c *songsServiceClient) DeleteSongs(ctx context.Context, in *DeleteSongsReq
 	return out, nil
 }
 
+func (c *songsServiceClient) GetTrashedSongs(ctx context.Context, in *GetTrashedSongsRequest, opts ...grpc.CallOption) (*GetTrashedSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrashedSongsResponse)
+	err := c.cc.Invoke(ctx, SongsService_GetTrashedSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) RestoreTrashedSongs(ctx context.Context, in *RestoreTrashedSongsRequest, opts ...grpc.CallOption) (*RestoreTrashedSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTrashedSongsResponse)
+	err := c.cc.Invoke(ctx, SongsService_RestoreTrashedSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) GetCreditRequests(ctx context.Context, in *GetCreditRequestsRequest, opts ...grpc.CallOption) (*GetCreditRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCreditRequestsResponse)
+	err := c.cc.Invoke(ctx, SongsService_GetCreditRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) ResolveCreditRequest(ctx context.Context, in *ResolveCreditRequestRequest, opts ...grpc.CallOption) (*ResolveCreditRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveCreditRequestResponse)
+	err := c.cc.Invoke(ctx, SongsService_ResolveCreditRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *songsServiceClient) GetSongs(ctx context.Context, in *GetSongsRequest, opts ...grpc.CallOption) (*GetSongsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSongsResponse)
@@ -232,6 +381,46 @@ func (c *songsServiceClient) GetMySongs(ctx context.Context, in *GetMySongsReque
 	return out, nil
 }
 
+func (c *songsServiceClient) GetMyUsage(ctx context.Context, in *GetMyUsageRequest, opts ...grpc.CallOption) (*GetMyUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyUsageResponse)
+	err := c.cc.Invoke(ctx, SongsService_GetMyUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) GetArtistStats(ctx context.Context, in *GetArtistStatsRequest, opts ...grpc.CallOption) (*GetArtistStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArtistStatsResponse)
+	err := c.cc.Invoke(ctx, SongsService_GetArtistStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) ExportCatalog(ctx context.Context, in *ExportCatalogRequest, opts ...grpc.CallOption) (*ExportCatalogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCatalogResponse)
+	err := c.cc.Invoke(ctx, SongsService_ExportCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) GetCatalogExports(ctx context.Context, in *GetCatalogExportsRequest, opts ...grpc.CallOption) (*GetCatalogExportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCatalogExportsResponse)
+	err := c.cc.Invoke(ctx, SongsService_GetCatalogExports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *songsServiceClient) ReleaseSongs(ctx context.Context, in *ReleaseSongsRequest, opts ...grpc.CallOption) (*ReleaseSongsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReleaseSongsResponse)
@@ -242,6 +431,166 @@ func (c *songsServiceClient) ReleaseSongs(ctx context.Context, in *ReleaseSongsR
 	return out, nil
 }
 
+func (c *songsServiceClient) FlagSong(ctx context.Context, in *FlagSongRequest, opts ...grpc.CallOption) (*FlagSongResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlagSongResponse)
+	err := c.cc.Invoke(ctx, SongsService_FlagSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) TakeDownSong(ctx context.Context, in *TakeDownSongRequest, opts ...grpc.CallOption) (*TakeDownSongResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TakeDownSongResponse)
+	err := c.cc.Invoke(ctx, SongsService_TakeDownSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) RestoreSong(ctx context.Context, in *RestoreSongRequest, opts ...grpc.CallOption) (*RestoreSongResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreSongResponse)
+	err := c.cc.Invoke(ctx, SongsService_RestoreSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) GetDeadJobs(ctx context.Context, in *GetDeadJobsRequest, opts ...grpc.CallOption) (*GetDeadJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeadJobsResponse)
+	err := c.cc.Invoke(ctx, SongsService_GetDeadJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) RetryJob(ctx context.Context, in *RetryJobRequest, opts ...grpc.CallOption) (*RetryJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryJobResponse)
+	err := c.cc.Invoke(ctx, SongsService_RetryJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, SongsService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) GetWebhooks(ctx context.Context, in *GetWebhooksRequest, opts ...grpc.CallOption) (*GetWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhooksResponse)
+	err := c.cc.Invoke(ctx, SongsService_GetWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, SongsService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*GetWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, SongsService_GetWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeliverWebhookResponse)
+	err := c.cc.Invoke(ctx, SongsService_RedeliverWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) SubmitClaim(ctx context.Context, in *SubmitClaimRequest, opts ...grpc.CallOption) (*SubmitClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitClaimResponse)
+	err := c.cc.Invoke(ctx, SongsService_SubmitClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) GetClaim(ctx context.Context, in *GetClaimRequest, opts ...grpc.CallOption) (*GetClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClaimResponse)
+	err := c.cc.Invoke(ctx, SongsService_GetClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) GetClaims(ctx context.Context, in *GetClaimsRequest, opts ...grpc.CallOption) (*GetClaimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClaimsResponse)
+	err := c.cc.Invoke(ctx, SongsService_GetClaims_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) FileCounterNotice(ctx context.Context, in *FileCounterNoticeRequest, opts ...grpc.CallOption) (*FileCounterNoticeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileCounterNoticeResponse)
+	err := c.cc.Invoke(ctx, SongsService_FileCounterNotice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) ResolveClaim(ctx context.Context, in *ResolveClaimRequest, opts ...grpc.CallOption) (*ResolveClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveClaimResponse)
+	err := c.cc.Invoke(ctx, SongsService_ResolveClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songsServiceClient) VerifyClaimant(ctx context.Context, in *VerifyClaimantRequest, opts ...grpc.CallOption) (*VerifyClaimantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyClaimantResponse)
+	err := c.cc.Invoke(ctx, SongsService_VerifyClaimant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SongsServiceServer is the server API for SongsService service.
 // All implementations must embed UnimplementedSongsServiceServer
 // for forward compatibility.
@@ -284,18 +633,103 @@ type SongsServiceServer interface {
 	// Updates an existing song.
 	// For artists only.
 	UpdateSong(context.Context, *UpdateSongRequest) (*UpdateSongResponse, error)
-	// Deletes songs with its image and binary data.
+	// Moves songs to the trash. They are deleted with their image and binary data
+	// when the restore window is over.
 	// For artists only.
 	DeleteSongs(context.Context, *DeleteSongsRequest) (*DeleteSongsResponse, error)
+	// Retrieves your deleted songs that can still be restored.
+	// For artists only.
+	GetTrashedSongs(context.Context, *GetTrashedSongsRequest) (*GetTrashedSongsResponse, error)
+	// Restores deleted songs from the trash.
+	// For artists only.
+	RestoreTrashedSongs(context.Context, *RestoreTrashedSongsRequest) (*RestoreTrashedSongsResponse, error)
+	// Retrieves the credits other artists gave you that wait for your approval.
+	// For artists only.
+	GetCreditRequests(context.Context, *GetCreditRequestsRequest) (*GetCreditRequestsResponse, error)
+	// Approves or declines a credit another artist gave you.
+	// Songs can't be released while they have pending credits.
+	// For artists only.
+	ResolveCreditRequest(context.Context, *ResolveCreditRequestRequest) (*ResolveCreditRequestResponse, error)
 	// Retrieves released songs, optionally filtered and paginated.
 	GetSongs(context.Context, *GetSongsRequest) (*GetSongsResponse, error)
 	// Retrieves your uploaded songs.
 	// For artists only.
 	GetMySongs(context.Context, *GetMySongsRequest) (*GetMySongsResponse, error)
+	// Retrieves how much of your quotas you have used.
+	// For artists only.
+	GetMyUsage(context.Context, *GetMyUsageRequest) (*GetMyUsageResponse, error)
+	// Retrieves plays, unique listeners, likes, playlist additions and follower growth
+	// of your songs by days or weeks, in total and per song.
+	// The counters are aggregated from events, so the last minutes may be missing.
+	// For artists only.
+	GetArtistStats(context.Context, *GetArtistStatsRequest) (*GetArtistStatsResponse, error)
+	// Queues an export of all your songs with their files, images and credits.
+	// Returns the unfinished export instead if there is one.
+	// For artists only.
+	ExportCatalog(context.Context, *ExportCatalogRequest) (*ExportCatalogResponse, error)
+	// Retrieves your latest exports with the download links of the ready ones.
+	// The links expire, export the catalog again to get a new one.
+	// For artists only.
+	GetCatalogExports(context.Context, *GetCatalogExportsRequest) (*GetCatalogExportsResponse, error)
 	// Releases songs and notifies the followers if needed.
 	// Idempotent.
 	// For artists only.
 	ReleaseSongs(context.Context, *ReleaseSongsRequest) (*ReleaseSongsResponse, error)
+	// Marks a song as under review after an abuse report.
+	// The song stays available until it is taken down.
+	// For admins only.
+	FlagSong(context.Context, *FlagSongRequest) (*FlagSongResponse, error)
+	// Takes a song down, so it is hidden from everyone but its artist.
+	// For admins only.
+	TakeDownSong(context.Context, *TakeDownSongRequest) (*TakeDownSongResponse, error)
+	// Restores a flagged or taken down song.
+	// For admins only.
+	RestoreSong(context.Context, *RestoreSongRequest) (*RestoreSongResponse, error)
+	// Retrieves the background jobs out of attempts, recently failed first.
+	// For admins only.
+	GetDeadJobs(context.Context, *GetDeadJobsRequest) (*GetDeadJobsResponse, error)
+	// Queues a dead job again with all its attempts.
+	// For admins only.
+	RetryJob(context.Context, *RetryJobRequest) (*RetryJobResponse, error)
+	// Registers your HTTPS endpoint for the events of your songs:
+	// song.released, song.uploaded and claim.submitted.
+	// The secret signing the deliveries is returned only here.
+	// For artists only.
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	// Retrieves your webhooks.
+	// For artists only.
+	GetWebhooks(context.Context, *GetWebhooksRequest) (*GetWebhooksResponse, error)
+	// Deletes your webhook with its deliveries, the pending ones are not sent.
+	// For artists only.
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// Retrieves the delivery log of your webhook, newest first.
+	// For artists only.
+	GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*GetWebhookDeliveriesResponse, error)
+	// Sends a delivery of your webhook again with all its attempts.
+	// For artists only.
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error)
+	// Submits a copyright claim against a song.
+	// The song is taken down until the claim is resolved if the claimant is verified,
+	// otherwise it is put under review. Its artist is notified.
+	// Claimants may have up to features.claims.maxOpenPerClaimant open claims.
+	SubmitClaim(context.Context, *SubmitClaimRequest) (*SubmitClaimResponse, error)
+	// Retrieves a claim with the history of its status changes.
+	// For the claimant, the artist of the song and admins only.
+	GetClaim(context.Context, *GetClaimRequest) (*GetClaimResponse, error)
+	// Retrieves your claims and the claims against your songs.
+	// Admins retrieve all claims.
+	GetClaims(context.Context, *GetClaimsRequest) (*GetClaimsResponse, error)
+	// Disputes a pending claim.
+	// For the artist of the song only.
+	FileCounterNotice(context.Context, *FileCounterNoticeRequest) (*FileCounterNoticeResponse, error)
+	// Upholds or rejects a claim.
+	// An upheld claim takes the song down, a rejected claim restores the song
+	// unless there are other open claims against it.
+	// For admins only.
+	ResolveClaim(context.Context, *ResolveClaimRequest) (*ResolveClaimResponse, error)
+	// Verifies a claimant, claims of verified claimants take the songs down at once.
+	// For admins only.
+	VerifyClaimant(context.Context, *VerifyClaimantRequest) (*VerifyClaimantResponse, error)
 	mustEmbedUnimplementedSongsServiceServer()
 }
 
@@ -333,15 +767,87 @@ func (UnimplementedSongsServiceServer) UpdateSong(context.Context, *UpdateSongRe
 func (UnimplementedSongsServiceServer) DeleteSongs(context.Context, *DeleteSongsRequest) (*DeleteSongsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSongs not implemented")
 }
+func (UnimplementedSongsServiceServer) GetTrashedSongs(context.Context, *GetTrashedSongsRequest) (*GetTrashedSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrashedSongs not implemented")
+}
+func (UnimplementedSongsServiceServer) RestoreTrashedSongs(context.Context, *RestoreTrashedSongsRequest) (*RestoreTrashedSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTrashedSongs not implemented")
+}
+func (UnimplementedSongsServiceServer) GetCreditRequests(context.Context, *GetCreditRequestsRequest) (*GetCreditRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditRequests not implemented")
+}
+func (UnimplementedSongsServiceServer) ResolveCreditRequest(context.Context, *ResolveCreditRequestRequest) (*ResolveCreditRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCreditRequest not implemented")
+}
 func (UnimplementedSongsServiceServer) GetSongs(context.Context, *GetSongsRequest) (*GetSongsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSongs not implemented")
 }
 func (UnimplementedSongsServiceServer) GetMySongs(context.Context, *GetMySongsRequest) (*GetMySongsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMySongs not implemented")
 }
+func (UnimplementedSongsServiceServer) GetMyUsage(context.Context, *GetMyUsageRequest) (*GetMyUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyUsage not implemented")
+}
+func (UnimplementedSongsServiceServer) GetArtistStats(context.Context, *GetArtistStatsRequest) (*GetArtistStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtistStats not implemented")
+}
+func (UnimplementedSongsServiceServer) ExportCatalog(context.Context, *ExportCatalogRequest) (*ExportCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCatalog not implemented")
+}
+func (UnimplementedSongsServiceServer) GetCatalogExports(context.Context, *GetCatalogExportsRequest) (*GetCatalogExportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogExports not implemented")
+}
 func (UnimplementedSongsServiceServer) ReleaseSongs(context.Context, *ReleaseSongsRequest) (*ReleaseSongsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSongs not implemented")
 }
+func (UnimplementedSongsServiceServer) FlagSong(context.Context, *FlagSongRequest) (*FlagSongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagSong not implemented")
+}
+func (UnimplementedSongsServiceServer) TakeDownSong(context.Context, *TakeDownSongRequest) (*TakeDownSongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeDownSong not implemented")
+}
+func (UnimplementedSongsServiceServer) RestoreSong(context.Context, *RestoreSongRequest) (*RestoreSongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSong not implemented")
+}
+func (UnimplementedSongsServiceServer) GetDeadJobs(context.Context, *GetDeadJobsRequest) (*GetDeadJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadJobs not implemented")
+}
+func (UnimplementedSongsServiceServer) RetryJob(context.Context, *RetryJobRequest) (*RetryJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryJob not implemented")
+}
+func (UnimplementedSongsServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedSongsServiceServer) GetWebhooks(context.Context, *GetWebhooksRequest) (*GetWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedSongsServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedSongsServiceServer) GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*GetWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedSongsServiceServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedSongsServiceServer) SubmitClaim(context.Context, *SubmitClaimRequest) (*SubmitClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitClaim not implemented")
+}
+func (UnimplementedSongsServiceServer) GetClaim(context.Context, *GetClaimRequest) (*GetClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaim not implemented")
+}
+func (UnimplementedSongsServiceServer) GetClaims(context.Context, *GetClaimsRequest) (*GetClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaims not implemented")
+}
+func (UnimplementedSongsServiceServer) FileCounterNotice(context.Context, *FileCounterNoticeRequest) (*FileCounterNoticeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileCounterNotice not implemented")
+}
+func (UnimplementedSongsServiceServer) ResolveClaim(context.Context, *ResolveClaimRequest) (*ResolveClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveClaim not implemented")
+}
+func (UnimplementedSongsServiceServer) VerifyClaimant(context.Context, *VerifyClaimantRequest) (*VerifyClaimantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyClaimant not implemented")
+}
 func (UnimplementedSongsServiceServer) mustEmbedUnimplementedSongsServiceServer() {}
 func (UnimplementedSongsServiceServer) testEmbeddedByValue()                      {}
 
@@ -489,87 +995,535 @@ func _SongsService_DeleteSongs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SongsService_GetSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSongsRequest)
+func _SongsService_GetTrashedSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrashedSongsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SongsServiceServer).GetSongs(ctx, in)
+		return srv.(SongsServiceServer).GetTrashedSongs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SongsService_GetSongs_FullMethodName,
+		FullMethod: SongsService_GetTrashedSongs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SongsServiceServer).GetSongs(ctx, req.(*GetSongsRequest))
+		return srv.(SongsServiceServer).GetTrashedSongs(ctx, req.(*GetTrashedSongsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SongsService_GetMySongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMySongsRequest)
+func _SongsService_RestoreTrashedSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTrashedSongsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SongsServiceServer).GetMySongs(ctx, in)
+		return srv.(SongsServiceServer).RestoreTrashedSongs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SongsService_GetMySongs_FullMethodName,
+		FullMethod: SongsService_RestoreTrashedSongs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SongsServiceServer).GetMySongs(ctx, req.(*GetMySongsRequest))
+		return srv.(SongsServiceServer).RestoreTrashedSongs(ctx, req.(*RestoreTrashedSongsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SongsService_ReleaseSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleaseSongsRequest)
+func _SongsService_GetCreditRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SongsServiceServer).ReleaseSongs(ctx, in)
+		return srv.(SongsServiceServer).GetCreditRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SongsService_ReleaseSongs_FullMethodName,
+		FullMethod: SongsService_GetCreditRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SongsServiceServer).ReleaseSongs(ctx, req.(*ReleaseSongsRequest))
+		return srv.(SongsServiceServer).GetCreditRequests(ctx, req.(*GetCreditRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// SongsService_ServiceDesc is the grpc.ServiceDesc for SongsService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var SongsService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.SongsService",
-	HandlerType: (*SongsServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Health",
-			Handler:    _SongsService_Health_Handler,
-		},
-		{
-			MethodName: "CreateSong",
-			Handler:    _SongsService_CreateSong_Handler,
-		},
-		{
-			MethodName: "GetSong",
-			Handler:    _SongsService_GetSong_Handler,
-		},
-		{
-			MethodName: "UpdateSong",
-			Handler:    _SongsService_UpdateSong_Handler,
-		},
+func _SongsService_ResolveCreditRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveCreditRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).ResolveCreditRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_ResolveCreditRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).ResolveCreditRequest(ctx, req.(*ResolveCreditRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_GetSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).GetSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_GetSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).GetSongs(ctx, req.(*GetSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_GetMySongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMySongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).GetMySongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_GetMySongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).GetMySongs(ctx, req.(*GetMySongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_GetMyUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).GetMyUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_GetMyUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).GetMyUsage(ctx, req.(*GetMyUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_GetArtistStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtistStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).GetArtistStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_GetArtistStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).GetArtistStats(ctx, req.(*GetArtistStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_ExportCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).ExportCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_ExportCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).ExportCatalog(ctx, req.(*ExportCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_GetCatalogExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).GetCatalogExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_GetCatalogExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).GetCatalogExports(ctx, req.(*GetCatalogExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_ReleaseSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).ReleaseSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_ReleaseSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).ReleaseSongs(ctx, req.(*ReleaseSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_FlagSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).FlagSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_FlagSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).FlagSong(ctx, req.(*FlagSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_TakeDownSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeDownSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).TakeDownSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_TakeDownSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).TakeDownSong(ctx, req.(*TakeDownSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_RestoreSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).RestoreSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_RestoreSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).RestoreSong(ctx, req.(*RestoreSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_GetDeadJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).GetDeadJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_GetDeadJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).GetDeadJobs(ctx, req.(*GetDeadJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_RetryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).RetryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_RetryJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).RetryJob(ctx, req.(*RetryJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_GetWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).GetWebhooks(ctx, req.(*GetWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_GetWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).GetWebhookDeliveries(ctx, req.(*GetWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_RedeliverWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_SubmitClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).SubmitClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_SubmitClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).SubmitClaim(ctx, req.(*SubmitClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_GetClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).GetClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_GetClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).GetClaim(ctx, req.(*GetClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_GetClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).GetClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_GetClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).GetClaims(ctx, req.(*GetClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_FileCounterNotice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileCounterNoticeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).FileCounterNotice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_FileCounterNotice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).FileCounterNotice(ctx, req.(*FileCounterNoticeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_ResolveClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).ResolveClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_ResolveClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).ResolveClaim(ctx, req.(*ResolveClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongsService_VerifyClaimant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyClaimantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongsServiceServer).VerifyClaimant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongsService_VerifyClaimant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongsServiceServer).VerifyClaimant(ctx, req.(*VerifyClaimantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SongsService_ServiceDesc is the grpc.ServiceDesc for SongsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SongsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.SongsService",
+	HandlerType: (*SongsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Health",
+			Handler:    _SongsService_Health_Handler,
+		},
+		{
+			MethodName: "CreateSong",
+			Handler:    _SongsService_CreateSong_Handler,
+		},
+		{
+			MethodName: "GetSong",
+			Handler:    _SongsService_GetSong_Handler,
+		},
+		{
+			MethodName: "UpdateSong",
+			Handler:    _SongsService_UpdateSong_Handler,
+		},
 		{
 			MethodName: "DeleteSongs",
 			Handler:    _SongsService_DeleteSongs_Handler,
 		},
+		{
+			MethodName: "GetTrashedSongs",
+			Handler:    _SongsService_GetTrashedSongs_Handler,
+		},
+		{
+			MethodName: "RestoreTrashedSongs",
+			Handler:    _SongsService_RestoreTrashedSongs_Handler,
+		},
+		{
+			MethodName: "GetCreditRequests",
+			Handler:    _SongsService_GetCreditRequests_Handler,
+		},
+		{
+			MethodName: "ResolveCreditRequest",
+			Handler:    _SongsService_ResolveCreditRequest_Handler,
+		},
 		{
 			MethodName: "GetSongs",
 			Handler:    _SongsService_GetSongs_Handler,
@@ -578,10 +1532,90 @@ var SongsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMySongs",
 			Handler:    _SongsService_GetMySongs_Handler,
 		},
+		{
+			MethodName: "GetMyUsage",
+			Handler:    _SongsService_GetMyUsage_Handler,
+		},
+		{
+			MethodName: "GetArtistStats",
+			Handler:    _SongsService_GetArtistStats_Handler,
+		},
+		{
+			MethodName: "ExportCatalog",
+			Handler:    _SongsService_ExportCatalog_Handler,
+		},
+		{
+			MethodName: "GetCatalogExports",
+			Handler:    _SongsService_GetCatalogExports_Handler,
+		},
 		{
 			MethodName: "ReleaseSongs",
 			Handler:    _SongsService_ReleaseSongs_Handler,
 		},
+		{
+			MethodName: "FlagSong",
+			Handler:    _SongsService_FlagSong_Handler,
+		},
+		{
+			MethodName: "TakeDownSong",
+			Handler:    _SongsService_TakeDownSong_Handler,
+		},
+		{
+			MethodName: "RestoreSong",
+			Handler:    _SongsService_RestoreSong_Handler,
+		},
+		{
+			MethodName: "GetDeadJobs",
+			Handler:    _SongsService_GetDeadJobs_Handler,
+		},
+		{
+			MethodName: "RetryJob",
+			Handler:    _SongsService_RetryJob_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _SongsService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _SongsService_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _SongsService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _SongsService_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _SongsService_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "SubmitClaim",
+			Handler:    _SongsService_SubmitClaim_Handler,
+		},
+		{
+			MethodName: "GetClaim",
+			Handler:    _SongsService_GetClaim_Handler,
+		},
+		{
+			MethodName: "GetClaims",
+			Handler:    _SongsService_GetClaims_Handler,
+		},
+		{
+			MethodName: "FileCounterNotice",
+			Handler:    _SongsService_FileCounterNotice_Handler,
+		},
+		{
+			MethodName: "ResolveClaim",
+			Handler:    _SongsService_ResolveClaim_Handler,
+		},
+		{
+			MethodName: "VerifyClaimant",
+			Handler:    _SongsService_VerifyClaimant_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -112,31 +112,4155 @@ func (ImageFileExtension) EnumDescriptor() ([]byte, []int) {
 	return file_api_types_proto_rawDescGZIP(), []int{1}
 }
 
+type CreditRole int32
+
+const (
+	CreditRole_FEATURED        CreditRole = 0
+	CreditRole_PRIMARY         CreditRole = 1
+	CreditRole_PRODUCER        CreditRole = 2
+	CreditRole_COMPOSER        CreditRole = 3
+	CreditRole_LYRICIST        CreditRole = 4
+	CreditRole_MIXING_ENGINEER CreditRole = 5
+)
+
+// Enum value maps for CreditRole.
+var (
+	CreditRole_name = map[int32]string{
+		0: "FEATURED",
+		1: "PRIMARY",
+		2: "PRODUCER",
+		3: "COMPOSER",
+		4: "LYRICIST",
+		5: "MIXING_ENGINEER",
+	}
+	CreditRole_value = map[string]int32{
+		"FEATURED":        0,
+		"PRIMARY":         1,
+		"PRODUCER":        2,
+		"COMPOSER":        3,
+		"LYRICIST":        4,
+		"MIXING_ENGINEER": 5,
+	}
+)
+
+func (x CreditRole) Enum() *CreditRole {
+	p := new(CreditRole)
+	*p = x
+	return p
+}
+
+func (x CreditRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreditRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[2].Descriptor()
+}
+
+func (CreditRole) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[2]
+}
+
+func (x CreditRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreditRole.Descriptor instead.
+func (CreditRole) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{2}
+}
+
+// Credits of other artists are pending until they approve or decline them.
+type CreditStatus int32
+
+const (
+	CreditStatus_CREDIT_APPROVED CreditStatus = 0
+	CreditStatus_CREDIT_PENDING  CreditStatus = 1
+	CreditStatus_CREDIT_DECLINED CreditStatus = 2
+)
+
+// Enum value maps for CreditStatus.
+var (
+	CreditStatus_name = map[int32]string{
+		0: "CREDIT_APPROVED",
+		1: "CREDIT_PENDING",
+		2: "CREDIT_DECLINED",
+	}
+	CreditStatus_value = map[string]int32{
+		"CREDIT_APPROVED": 0,
+		"CREDIT_PENDING":  1,
+		"CREDIT_DECLINED": 2,
+	}
+)
+
+func (x CreditStatus) Enum() *CreditStatus {
+	p := new(CreditStatus)
+	*p = x
+	return p
+}
+
+func (x CreditStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreditStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[3].Descriptor()
+}
+
+func (CreditStatus) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[3]
+}
+
+func (x CreditStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreditStatus.Descriptor instead.
+func (CreditStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{3}
+}
+
+// The song url is given once the file is ready.
+type AudioState int32
+
+const (
+	AudioState_AUDIO_DRAFT      AudioState = 0
+	AudioState_AUDIO_UPLOADING  AudioState = 1
+	AudioState_AUDIO_PROCESSING AudioState = 2
+	AudioState_AUDIO_READY      AudioState = 3
+	AudioState_AUDIO_FAILED     AudioState = 4
+)
+
+// Enum value maps for AudioState.
+var (
+	AudioState_name = map[int32]string{
+		0: "AUDIO_DRAFT",
+		1: "AUDIO_UPLOADING",
+		2: "AUDIO_PROCESSING",
+		3: "AUDIO_READY",
+		4: "AUDIO_FAILED",
+	}
+	AudioState_value = map[string]int32{
+		"AUDIO_DRAFT":      0,
+		"AUDIO_UPLOADING":  1,
+		"AUDIO_PROCESSING": 2,
+		"AUDIO_READY":      3,
+		"AUDIO_FAILED":     4,
+	}
+)
+
+func (x AudioState) Enum() *AudioState {
+	p := new(AudioState)
+	*p = x
+	return p
+}
+
+func (x AudioState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AudioState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[4].Descriptor()
+}
+
+func (AudioState) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[4]
+}
+
+func (x AudioState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AudioState.Descriptor instead.
+func (AudioState) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{4}
+}
+
+type ModerationStatus int32
+
+const (
+	ModerationStatus_ACTIVE       ModerationStatus = 0
+	ModerationStatus_UNDER_REVIEW ModerationStatus = 1
+	ModerationStatus_TAKEN_DOWN   ModerationStatus = 2
+)
+
+// Enum value maps for ModerationStatus.
+var (
+	ModerationStatus_name = map[int32]string{
+		0: "ACTIVE",
+		1: "UNDER_REVIEW",
+		2: "TAKEN_DOWN",
+	}
+	ModerationStatus_value = map[string]int32{
+		"ACTIVE":       0,
+		"UNDER_REVIEW": 1,
+		"TAKEN_DOWN":   2,
+	}
+)
+
+func (x ModerationStatus) Enum() *ModerationStatus {
+	p := new(ModerationStatus)
+	*p = x
+	return p
+}
+
+func (x ModerationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[5].Descriptor()
+}
+
+func (ModerationStatus) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[5]
+}
+
+func (x ModerationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationStatus.Descriptor instead.
+func (ModerationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{5}
+}
+
+type BitrateMode int32
+
+const (
+	BitrateMode_CBR BitrateMode = 0
+	BitrateMode_VBR BitrateMode = 1
+)
+
+// Enum value maps for BitrateMode.
+var (
+	BitrateMode_name = map[int32]string{
+		0: "CBR",
+		1: "VBR",
+	}
+	BitrateMode_value = map[string]int32{
+		"CBR": 0,
+		"VBR": 1,
+	}
+)
+
+func (x BitrateMode) Enum() *BitrateMode {
+	p := new(BitrateMode)
+	*p = x
+	return p
+}
+
+func (x BitrateMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BitrateMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[6].Descriptor()
+}
+
+func (BitrateMode) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[6]
+}
+
+func (x BitrateMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BitrateMode.Descriptor instead.
+func (BitrateMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{6}
+}
+
+type ChannelMode int32
+
+const (
+	ChannelMode_STEREO       ChannelMode = 0
+	ChannelMode_JOINT_STEREO ChannelMode = 1
+	ChannelMode_DUAL_CHANNEL ChannelMode = 2
+	ChannelMode_MONO         ChannelMode = 3
+)
+
+// Enum value maps for ChannelMode.
+var (
+	ChannelMode_name = map[int32]string{
+		0: "STEREO",
+		1: "JOINT_STEREO",
+		2: "DUAL_CHANNEL",
+		3: "MONO",
+	}
+	ChannelMode_value = map[string]int32{
+		"STEREO":       0,
+		"JOINT_STEREO": 1,
+		"DUAL_CHANNEL": 2,
+		"MONO":         3,
+	}
+)
+
+func (x ChannelMode) Enum() *ChannelMode {
+	p := new(ChannelMode)
+	*p = x
+	return p
+}
+
+func (x ChannelMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[7].Descriptor()
+}
+
+func (ChannelMode) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[7]
+}
+
+func (x ChannelMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelMode.Descriptor instead.
+func (ChannelMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{7}
+}
+
+type StatsBucket int32
+
+const (
+	StatsBucket_STATS_DAY StatsBucket = 0
+	// Weeks start on Monday
+	StatsBucket_STATS_WEEK StatsBucket = 1
+)
+
+// Enum value maps for StatsBucket.
+var (
+	StatsBucket_name = map[int32]string{
+		0: "STATS_DAY",
+		1: "STATS_WEEK",
+	}
+	StatsBucket_value = map[string]int32{
+		"STATS_DAY":  0,
+		"STATS_WEEK": 1,
+	}
+)
+
+func (x StatsBucket) Enum() *StatsBucket {
+	p := new(StatsBucket)
+	*p = x
+	return p
+}
+
+func (x StatsBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[8].Descriptor()
+}
+
+func (StatsBucket) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[8]
+}
+
+func (x StatsBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsBucket.Descriptor instead.
+func (StatsBucket) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{8}
+}
+
+type ClaimStatus int32
+
+const (
+	ClaimStatus_PENDING         ClaimStatus = 0
+	ClaimStatus_COUNTER_NOTICED ClaimStatus = 1
+	ClaimStatus_UPHELD          ClaimStatus = 2
+	ClaimStatus_REJECTED        ClaimStatus = 3
+)
+
+// Enum value maps for ClaimStatus.
+var (
+	ClaimStatus_name = map[int32]string{
+		0: "PENDING",
+		1: "COUNTER_NOTICED",
+		2: "UPHELD",
+		3: "REJECTED",
+	}
+	ClaimStatus_value = map[string]int32{
+		"PENDING":         0,
+		"COUNTER_NOTICED": 1,
+		"UPHELD":          2,
+		"REJECTED":        3,
+	}
+)
+
+func (x ClaimStatus) Enum() *ClaimStatus {
+	p := new(ClaimStatus)
+	*p = x
+	return p
+}
+
+func (x ClaimStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClaimStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[9].Descriptor()
+}
+
+func (ClaimStatus) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[9]
+}
+
+func (x ClaimStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClaimStatus.Descriptor instead.
+func (ClaimStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{9}
+}
+
+type ExportStatus int32
+
+const (
+	ExportStatus_EXPORT_PENDING ExportStatus = 0
+	ExportStatus_EXPORT_RUNNING ExportStatus = 1
+	ExportStatus_EXPORT_READY   ExportStatus = 2
+	ExportStatus_EXPORT_FAILED  ExportStatus = 3
+)
+
+// Enum value maps for ExportStatus.
+var (
+	ExportStatus_name = map[int32]string{
+		0: "EXPORT_PENDING",
+		1: "EXPORT_RUNNING",
+		2: "EXPORT_READY",
+		3: "EXPORT_FAILED",
+	}
+	ExportStatus_value = map[string]int32{
+		"EXPORT_PENDING": 0,
+		"EXPORT_RUNNING": 1,
+		"EXPORT_READY":   2,
+		"EXPORT_FAILED":  3,
+	}
+)
+
+func (x ExportStatus) Enum() *ExportStatus {
+	p := new(ExportStatus)
+	*p = x
+	return p
+}
+
+func (x ExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[10].Descriptor()
+}
+
+func (ExportStatus) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[10]
+}
+
+func (x ExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportStatus.Descriptor instead.
+func (ExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{10}
+}
+
+type JobStatus int32
+
+const (
+	JobStatus_JOB_QUEUED  JobStatus = 0
+	JobStatus_JOB_RUNNING JobStatus = 1
+	JobStatus_JOB_DEAD    JobStatus = 2
+)
+
+// Enum value maps for JobStatus.
+var (
+	JobStatus_name = map[int32]string{
+		0: "JOB_QUEUED",
+		1: "JOB_RUNNING",
+		2: "JOB_DEAD",
+	}
+	JobStatus_value = map[string]int32{
+		"JOB_QUEUED":  0,
+		"JOB_RUNNING": 1,
+		"JOB_DEAD":    2,
+	}
+)
+
+func (x JobStatus) Enum() *JobStatus {
+	p := new(JobStatus)
+	*p = x
+	return p
+}
+
+func (x JobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[11].Descriptor()
+}
+
+func (JobStatus) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[11]
+}
+
+func (x JobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobStatus.Descriptor instead.
+func (JobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{11}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING   WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_DELIVERED WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_FAILED    WebhookDeliveryStatus = 2
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_PENDING",
+		1: "WEBHOOK_DELIVERY_DELIVERED",
+		2: "WEBHOOK_DELIVERY_FAILED",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_PENDING":   0,
+		"WEBHOOK_DELIVERY_DELIVERED": 1,
+		"WEBHOOK_DELIVERY_FAILED":    2,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_types_proto_enumTypes[12].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_api_types_proto_enumTypes[12]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{12}
+}
+
 type UploadRawSongRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilePart  []byte            `protobuf:"bytes,1,opt,name=file_part,json=filePart,proto3" json:"file_part,omitempty"` // Max 1MB per part
-	Extension SongFileExtension `protobuf:"varint,2,opt,name=extension,proto3,enum=api.SongFileExtension" json:"extension,omitempty"`
-	SongId    string            `protobuf:"bytes,3,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	FilePart  []byte            `protobuf:"bytes,1,opt,name=file_part,json=filePart,proto3" json:"file_part,omitempty"` // Max 1MB per part
+	Extension SongFileExtension `protobuf:"varint,2,opt,name=extension,proto3,enum=api.SongFileExtension" json:"extension,omitempty"`
+	SongId    string            `protobuf:"bytes,3,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+}
+
+func (x *UploadRawSongRequest) Reset() {
+	*x = UploadRawSongRequest{}
+	mi := &file_api_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadRawSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadRawSongRequest) ProtoMessage() {}
+
+func (x *UploadRawSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadRawSongRequest.ProtoReflect.Descriptor instead.
+func (*UploadRawSongRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UploadRawSongRequest) GetFilePart() []byte {
+	if x != nil {
+		return x.FilePart
+	}
+	return nil
+}
+
+func (x *UploadRawSongRequest) GetExtension() SongFileExtension {
+	if x != nil {
+		return x.Extension
+	}
+	return SongFileExtension_MP3
+}
+
+func (x *UploadRawSongRequest) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+type UploadRawSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// newly created raw_song_i
+	// looks like 902435aad48afe51260f23f8a73b38a3245cac25.extension
+	// 1st part is sha1 hex-encoded 40 bytes hash of "Artist\u0001SongName".
+	// 2nd part is one of the formats from SongFileExtension.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// it's just https://HOST/songs/api/v1/song/raw/902435aad48afe51260f23f8a73b38a3245cac25.extension
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *UploadRawSongResponse) Reset() {
+	*x = UploadRawSongResponse{}
+	mi := &file_api_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadRawSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadRawSongResponse) ProtoMessage() {}
+
+func (x *UploadRawSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadRawSongResponse.ProtoReflect.Descriptor instead.
+func (*UploadRawSongResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UploadRawSongResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadRawSongResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetRawSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RawSongId string `protobuf:"bytes,1,opt,name=raw_song_id,json=rawSongId,proto3" json:"raw_song_id,omitempty"`
+}
+
+func (x *GetRawSongRequest) Reset() {
+	*x = GetRawSongRequest{}
+	mi := &file_api_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRawSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRawSongRequest) ProtoMessage() {}
+
+func (x *GetRawSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRawSongRequest.ProtoReflect.Descriptor instead.
+func (*GetRawSongRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRawSongRequest) GetRawSongId() string {
+	if x != nil {
+		return x.RawSongId
+	}
+	return ""
+}
+
+type GetRawSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilePart []byte `protobuf:"bytes,1,opt,name=file_part,json=filePart,proto3" json:"file_part,omitempty"`
+}
+
+func (x *GetRawSongResponse) Reset() {
+	*x = GetRawSongResponse{}
+	mi := &file_api_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRawSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRawSongResponse) ProtoMessage() {}
+
+func (x *GetRawSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRawSongResponse.ProtoReflect.Descriptor instead.
+func (*GetRawSongResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRawSongResponse) GetFilePart() []byte {
+	if x != nil {
+		return x.FilePart
+	}
+	return nil
+}
+
+type UploadRawSongImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilePart  []byte             `protobuf:"bytes,1,opt,name=file_part,json=filePart,proto3" json:"file_part,omitempty"` // Max 1MB per part
+	Extension ImageFileExtension `protobuf:"varint,2,opt,name=extension,proto3,enum=api.ImageFileExtension" json:"extension,omitempty"`
+	SongId    string             `protobuf:"bytes,3,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+}
+
+func (x *UploadRawSongImageRequest) Reset() {
+	*x = UploadRawSongImageRequest{}
+	mi := &file_api_types_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadRawSongImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadRawSongImageRequest) ProtoMessage() {}
+
+func (x *UploadRawSongImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadRawSongImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadRawSongImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UploadRawSongImageRequest) GetFilePart() []byte {
+	if x != nil {
+		return x.FilePart
+	}
+	return nil
+}
+
+func (x *UploadRawSongImageRequest) GetExtension() ImageFileExtension {
+	if x != nil {
+		return x.Extension
+	}
+	return ImageFileExtension_JPEG
+}
+
+func (x *UploadRawSongImageRequest) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+type UploadRawSongImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Similar to UploadRawSong
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *UploadRawSongImageResponse) Reset() {
+	*x = UploadRawSongImageResponse{}
+	mi := &file_api_types_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadRawSongImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadRawSongImageResponse) ProtoMessage() {}
+
+func (x *UploadRawSongImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadRawSongImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadRawSongImageResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UploadRawSongImageResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadRawSongImageResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetRawSongImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RawImageId string `protobuf:"bytes,1,opt,name=raw_image_id,json=rawImageId,proto3" json:"raw_image_id,omitempty"`
+}
+
+func (x *GetRawSongImageRequest) Reset() {
+	*x = GetRawSongImageRequest{}
+	mi := &file_api_types_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRawSongImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRawSongImageRequest) ProtoMessage() {}
+
+func (x *GetRawSongImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRawSongImageRequest.ProtoReflect.Descriptor instead.
+func (*GetRawSongImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRawSongImageRequest) GetRawImageId() string {
+	if x != nil {
+		return x.RawImageId
+	}
+	return ""
+}
+
+type GetRawSongImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilePart []byte `protobuf:"bytes,1,opt,name=file_part,json=filePart,proto3" json:"file_part,omitempty"`
+}
+
+func (x *GetRawSongImageResponse) Reset() {
+	*x = GetRawSongImageResponse{}
+	mi := &file_api_types_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRawSongImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRawSongImageResponse) ProtoMessage() {}
+
+func (x *GetRawSongImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRawSongImageResponse.ProtoReflect.Descriptor instead.
+func (*GetRawSongImageResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRawSongImageResponse) GetFilePart() []byte {
+	if x != nil {
+		return x.FilePart
+	}
+	return nil
+}
+
+type CreateSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUrl       *string  `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	FeatArtistsIds []string `protobuf:"bytes,4,rep,name=feat_artists_ids,json=featArtistsIds,proto3" json:"feat_artists_ids,omitempty"`
+	// The song is also marked explicit if the uploaded file has an explicit ID3 advisory.
+	Explicit *bool `protobuf:"varint,5,opt,name=explicit,proto3,oneof" json:"explicit,omitempty"`
+	// Feat artists are credited as featured before these.
+	Credits []*Credit `protobuf:"bytes,6,rep,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (x *CreateSongRequest) Reset() {
+	*x = CreateSongRequest{}
+	mi := &file_api_types_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSongRequest) ProtoMessage() {}
+
+func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSongRequest.ProtoReflect.Descriptor instead.
+func (*CreateSongRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateSongRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSongRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateSongRequest) GetFeatArtistsIds() []string {
+	if x != nil {
+		return x.FeatArtistsIds
+	}
+	return nil
+}
+
+func (x *CreateSongRequest) GetExplicit() bool {
+	if x != nil && x.Explicit != nil {
+		return *x.Explicit
+	}
+	return false
+}
+
+func (x *CreateSongRequest) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+type CreateSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Singer     *users.Artist          `protobuf:"bytes,2,opt,name=singer,proto3" json:"singer,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Artists    []*users.Artist        `protobuf:"bytes,4,rep,name=artists,proto3" json:"artists,omitempty"`
+	UploadedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	ImageUrl   *string                `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Explicit   bool                   `protobuf:"varint,7,opt,name=explicit,proto3" json:"explicit,omitempty"`
+}
+
+func (x *CreateSongResponse) Reset() {
+	*x = CreateSongResponse{}
+	mi := &file_api_types_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSongResponse) ProtoMessage() {}
+
+func (x *CreateSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSongResponse.ProtoReflect.Descriptor instead.
+func (*CreateSongResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateSongResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateSongResponse) GetSinger() *users.Artist {
+	if x != nil {
+		return x.Singer
+	}
+	return nil
+}
+
+func (x *CreateSongResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSongResponse) GetArtists() []*users.Artist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *CreateSongResponse) GetUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+func (x *CreateSongResponse) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateSongResponse) GetExplicit() bool {
+	if x != nil {
+		return x.Explicit
+	}
+	return false
+}
+
+type GetSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSongRequest) Reset() {
+	*x = GetSongRequest{}
+	mi := &file_api_types_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongRequest) ProtoMessage() {}
+
+func (x *GetSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongRequest.ProtoReflect.Descriptor instead.
+func (*GetSongRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSongRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Song *Song `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+}
+
+func (x *GetSongResponse) Reset() {
+	*x = GetSongResponse{}
+	mi := &file_api_types_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongResponse) ProtoMessage() {}
+
+func (x *GetSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongResponse.ProtoReflect.Descriptor instead.
+func (*GetSongResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSongResponse) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+type UpdateSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUrl *string `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Explicit *bool   `protobuf:"varint,5,opt,name=explicit,proto3,oneof" json:"explicit,omitempty"`
+	// Replaces the song's restrictions when set.
+	Regions *RegionRestrictions `protobuf:"bytes,6,opt,name=regions,proto3,oneof" json:"regions,omitempty"`
+	// Replaces all the song's credits, featured artists included, when set.
+	Credits *CreditList `protobuf:"bytes,7,opt,name=credits,proto3,oneof" json:"credits,omitempty"`
+}
+
+func (x *UpdateSongRequest) Reset() {
+	*x = UpdateSongRequest{}
+	mi := &file_api_types_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSongRequest) ProtoMessage() {}
+
+func (x *UpdateSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSongRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSongRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateSongRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSongRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSongRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateSongRequest) GetExplicit() bool {
+	if x != nil && x.Explicit != nil {
+		return *x.Explicit
+	}
+	return false
+}
+
+func (x *UpdateSongRequest) GetRegions() *RegionRestrictions {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *UpdateSongRequest) GetCredits() *CreditList {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+type UpdateSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateSongResponse) Reset() {
+	*x = UpdateSongResponse{}
+	mi := &file_api_types_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSongResponse) ProtoMessage() {}
+
+func (x *UpdateSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSongResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSongResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{13}
+}
+
+// A credit names an artist with an account or anyone without one.
+// Names of artists with accounts are taken from their accounts.
+type Credit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistId *string    `protobuf:"bytes,1,opt,name=artist_id,json=artistId,proto3,oneof" json:"artist_id,omitempty"`
+	Name     string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role     CreditRole `protobuf:"varint,3,opt,name=role,proto3,enum=api.CreditRole" json:"role,omitempty"`
+	// Output only, listeners see approved credits only.
+	Status CreditStatus `protobuf:"varint,4,opt,name=status,proto3,enum=api.CreditStatus" json:"status,omitempty"`
+}
+
+func (x *Credit) Reset() {
+	*x = Credit{}
+	mi := &file_api_types_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Credit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credit) ProtoMessage() {}
+
+func (x *Credit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credit.ProtoReflect.Descriptor instead.
+func (*Credit) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Credit) GetArtistId() string {
+	if x != nil && x.ArtistId != nil {
+		return *x.ArtistId
+	}
+	return ""
+}
+
+func (x *Credit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Credit) GetRole() CreditRole {
+	if x != nil {
+		return x.Role
+	}
+	return CreditRole_FEATURED
+}
+
+func (x *Credit) GetStatus() CreditStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreditStatus_CREDIT_APPROVED
+}
+
+type CreditList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credits []*Credit `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (x *CreditList) Reset() {
+	*x = CreditList{}
+	mi := &file_api_types_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditList) ProtoMessage() {}
+
+func (x *CreditList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditList.ProtoReflect.Descriptor instead.
+func (*CreditList) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreditList) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+// Country codes are ISO 3166-1 alpha-2.
+// A song is available in a country from the allowed list (any country if it is empty)
+// unless the country is denied.
+type RegionRestrictions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllowedCountries []string `protobuf:"bytes,1,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	DeniedCountries  []string `protobuf:"bytes,2,rep,name=denied_countries,json=deniedCountries,proto3" json:"denied_countries,omitempty"`
+}
+
+func (x *RegionRestrictions) Reset() {
+	*x = RegionRestrictions{}
+	mi := &file_api_types_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionRestrictions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionRestrictions) ProtoMessage() {}
+
+func (x *RegionRestrictions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionRestrictions.ProtoReflect.Descriptor instead.
+func (*RegionRestrictions) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RegionRestrictions) GetAllowedCountries() []string {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+func (x *RegionRestrictions) GetDeniedCountries() []string {
+	if x != nil {
+		return x.DeniedCountries
+	}
+	return nil
+}
+
+type DeleteSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *DeleteSongsRequest) Reset() {
+	*x = DeleteSongsRequest{}
+	mi := &file_api_types_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSongsRequest) ProtoMessage() {}
+
+func (x *DeleteSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSongsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSongsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteSongsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type DeleteSongsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteSongsResponse) Reset() {
+	*x = DeleteSongsResponse{}
+	mi := &file_api_types_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSongsResponse) ProtoMessage() {}
+
+func (x *DeleteSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSongsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSongsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{18}
+}
+
+type TrashedSong struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Song      *MySong                `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// The song is deleted for good after that
+	RestorableUntil *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=restorable_until,json=restorableUntil,proto3" json:"restorable_until,omitempty"`
+}
+
+func (x *TrashedSong) Reset() {
+	*x = TrashedSong{}
+	mi := &file_api_types_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashedSong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedSong) ProtoMessage() {}
+
+func (x *TrashedSong) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedSong.ProtoReflect.Descriptor instead.
+func (*TrashedSong) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TrashedSong) GetSong() *MySong {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+func (x *TrashedSong) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TrashedSong) GetRestorableUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RestorableUntil
+	}
+	return nil
+}
+
+type GetTrashedSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pagination queries
+	Page     *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+}
+
+func (x *GetTrashedSongsRequest) Reset() {
+	*x = GetTrashedSongsRequest{}
+	mi := &file_api_types_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrashedSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashedSongsRequest) ProtoMessage() {}
+
+func (x *GetTrashedSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashedSongsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrashedSongsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTrashedSongsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetTrashedSongsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type GetTrashedSongsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Songs      []*TrashedSong      `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+	Pagination *PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetTrashedSongsResponse) Reset() {
+	*x = GetTrashedSongsResponse{}
+	mi := &file_api_types_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrashedSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashedSongsResponse) ProtoMessage() {}
+
+func (x *GetTrashedSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashedSongsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrashedSongsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTrashedSongsResponse) GetSongs() []*TrashedSong {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+func (x *GetTrashedSongsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type RestoreTrashedSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *RestoreTrashedSongsRequest) Reset() {
+	*x = RestoreTrashedSongsRequest{}
+	mi := &file_api_types_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTrashedSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTrashedSongsRequest) ProtoMessage() {}
+
+func (x *RestoreTrashedSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTrashedSongsRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTrashedSongsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RestoreTrashedSongsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type RestoreTrashedSongsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreTrashedSongsResponse) Reset() {
+	*x = RestoreTrashedSongsResponse{}
+	mi := &file_api_types_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTrashedSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTrashedSongsResponse) ProtoMessage() {}
+
+func (x *RestoreTrashedSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTrashedSongsResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTrashedSongsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{23}
+}
+
+// A pending credit of yours on a song of another artist.
+type CreditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SongId   string        `protobuf:"bytes,2,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	SongName string        `protobuf:"bytes,3,opt,name=song_name,json=songName,proto3" json:"song_name,omitempty"`
+	Singer   *users.Artist `protobuf:"bytes,4,opt,name=singer,proto3" json:"singer,omitempty"`
+	Role     CreditRole    `protobuf:"varint,5,opt,name=role,proto3,enum=api.CreditRole" json:"role,omitempty"`
+}
+
+func (x *CreditRequest) Reset() {
+	*x = CreditRequest{}
+	mi := &file_api_types_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditRequest) ProtoMessage() {}
+
+func (x *CreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditRequest.ProtoReflect.Descriptor instead.
+func (*CreditRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreditRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreditRequest) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *CreditRequest) GetSongName() string {
+	if x != nil {
+		return x.SongName
+	}
+	return ""
+}
+
+func (x *CreditRequest) GetSinger() *users.Artist {
+	if x != nil {
+		return x.Singer
+	}
+	return nil
+}
+
+func (x *CreditRequest) GetRole() CreditRole {
+	if x != nil {
+		return x.Role
+	}
+	return CreditRole_FEATURED
+}
+
+type GetCreditRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pagination queries
+	Page     *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+}
+
+func (x *GetCreditRequestsRequest) Reset() {
+	*x = GetCreditRequestsRequest{}
+	mi := &file_api_types_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditRequestsRequest) ProtoMessage() {}
+
+func (x *GetCreditRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCreditRequestsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetCreditRequestsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type GetCreditRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests   []*CreditRequest    `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Pagination *PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetCreditRequestsResponse) Reset() {
+	*x = GetCreditRequestsResponse{}
+	mi := &file_api_types_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditRequestsResponse) ProtoMessage() {}
+
+func (x *GetCreditRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetCreditRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCreditRequestsResponse) GetRequests() []*CreditRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *GetCreditRequestsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ResolveCreditRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve bool  `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (x *ResolveCreditRequestRequest) Reset() {
+	*x = ResolveCreditRequestRequest{}
+	mi := &file_api_types_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCreditRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCreditRequestRequest) ProtoMessage() {}
+
+func (x *ResolveCreditRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCreditRequestRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCreditRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ResolveCreditRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveCreditRequestRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type ResolveCreditRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResolveCreditRequestResponse) Reset() {
+	*x = ResolveCreditRequestResponse{}
+	mi := &file_api_types_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCreditRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCreditRequestResponse) ProtoMessage() {}
+
+func (x *ResolveCreditRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCreditRequestResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCreditRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{28}
+}
+
+type Song struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Singer  *users.Artist   `protobuf:"bytes,9,opt,name=singer,proto3" json:"singer,omitempty"`
+	Artists []*users.Artist `protobuf:"bytes,2,rep,name=artists,proto3" json:"artists,omitempty"`
+	Name    string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Signed for the caller, empty for anonymous callers, they get preview_url.
+	SongUrl     string                 `protobuf:"bytes,4,opt,name=song_url,json=songUrl,proto3" json:"song_url,omitempty"`
+	ImageUrl    *string                `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Duration    *durationpb.Duration   `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	WeightBytes int32                  `protobuf:"varint,7,opt,name=weight_bytes,json=weightBytes,proto3" json:"weight_bytes,omitempty"`
+	ReleasedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	UploadedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	Explicit    bool                   `protobuf:"varint,11,opt,name=explicit,proto3" json:"explicit,omitempty"`
+	// The song is restricted in the caller's country, song_url and preview_url are empty then.
+	Unavailable bool `protobuf:"varint,12,opt,name=unavailable,proto3" json:"unavailable,omitempty"`
+	// The singer is the first primary artist.
+	Credits []*Credit `protobuf:"bytes,13,rep,name=credits,proto3" json:"credits,omitempty"`
+	// Public link to a short clip of the song, empty if previews are disabled.
+	PreviewUrl string `protobuf:"bytes,14,opt,name=preview_url,json=previewUrl,proto3" json:"preview_url,omitempty"`
+}
+
+func (x *Song) Reset() {
+	*x = Song{}
+	mi := &file_api_types_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Song) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Song) ProtoMessage() {}
+
+func (x *Song) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Song.ProtoReflect.Descriptor instead.
+func (*Song) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Song) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Song) GetSinger() *users.Artist {
+	if x != nil {
+		return x.Singer
+	}
+	return nil
+}
+
+func (x *Song) GetArtists() []*users.Artist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *Song) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Song) GetSongUrl() string {
+	if x != nil {
+		return x.SongUrl
+	}
+	return ""
+}
+
+func (x *Song) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Song) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Song) GetWeightBytes() int32 {
+	if x != nil {
+		return x.WeightBytes
+	}
+	return 0
+}
+
+func (x *Song) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+func (x *Song) GetUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+func (x *Song) GetExplicit() bool {
+	if x != nil {
+		return x.Explicit
+	}
+	return false
+}
+
+func (x *Song) GetUnavailable() bool {
+	if x != nil {
+		return x.Unavailable
+	}
+	return false
+}
+
+func (x *Song) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *Song) GetPreviewUrl() string {
+	if x != nil {
+		return x.PreviewUrl
+	}
+	return ""
+}
+
+type MySong struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Singer           *users.Artist          `protobuf:"bytes,9,opt,name=singer,proto3" json:"singer,omitempty"`
+	Artists          []*users.Artist        `protobuf:"bytes,2,rep,name=artists,proto3" json:"artists,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SongUrl          *string                `protobuf:"bytes,4,opt,name=song_url,json=songUrl,proto3,oneof" json:"song_url,omitempty"`
+	ImageUrl         *string                `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Duration         *durationpb.Duration   `protobuf:"bytes,6,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	WeightBytes      *int32                 `protobuf:"varint,7,opt,name=weight_bytes,json=weightBytes,proto3,oneof" json:"weight_bytes,omitempty"`
+	ReleasedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=released_at,json=releasedAt,proto3,oneof" json:"released_at,omitempty"`
+	UploadedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	ModerationStatus ModerationStatus       `protobuf:"varint,11,opt,name=moderation_status,json=moderationStatus,proto3,enum=api.ModerationStatus" json:"moderation_status,omitempty"`
+	// Set when the song is flagged or taken down.
+	ModerationReason *string             `protobuf:"bytes,12,opt,name=moderation_reason,json=moderationReason,proto3,oneof" json:"moderation_reason,omitempty"`
+	Explicit         bool                `protobuf:"varint,13,opt,name=explicit,proto3" json:"explicit,omitempty"`
+	Regions          *RegionRestrictions `protobuf:"bytes,14,opt,name=regions,proto3" json:"regions,omitempty"`
+	// The singer is the first primary artist.
+	Credits []*Credit `protobuf:"bytes,15,rep,name=credits,proto3" json:"credits,omitempty"`
+	// Set once the file is uploaded.
+	Audio      *AudioInfo `protobuf:"bytes,16,opt,name=audio,proto3" json:"audio,omitempty"`
+	AudioState AudioState `protobuf:"varint,17,opt,name=audio_state,json=audioState,proto3,enum=api.AudioState" json:"audio_state,omitempty"`
+	// Set when the upload failed.
+	AudioError *string `protobuf:"bytes,18,opt,name=audio_error,json=audioError,proto3,oneof" json:"audio_error,omitempty"`
+	// Jpeg thumbnail of the hosted image, set once it is made.
+	ThumbnailUrl *string `protobuf:"bytes,19,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+}
+
+func (x *MySong) Reset() {
+	*x = MySong{}
+	mi := &file_api_types_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MySong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MySong) ProtoMessage() {}
+
+func (x *MySong) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MySong.ProtoReflect.Descriptor instead.
+func (*MySong) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MySong) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MySong) GetSinger() *users.Artist {
+	if x != nil {
+		return x.Singer
+	}
+	return nil
+}
+
+func (x *MySong) GetArtists() []*users.Artist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *MySong) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MySong) GetSongUrl() string {
+	if x != nil && x.SongUrl != nil {
+		return *x.SongUrl
+	}
+	return ""
+}
+
+func (x *MySong) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *MySong) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *MySong) GetWeightBytes() int32 {
+	if x != nil && x.WeightBytes != nil {
+		return *x.WeightBytes
+	}
+	return 0
+}
+
+func (x *MySong) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+func (x *MySong) GetUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+func (x *MySong) GetModerationStatus() ModerationStatus {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ModerationStatus_ACTIVE
+}
+
+func (x *MySong) GetModerationReason() string {
+	if x != nil && x.ModerationReason != nil {
+		return *x.ModerationReason
+	}
+	return ""
+}
+
+func (x *MySong) GetExplicit() bool {
+	if x != nil {
+		return x.Explicit
+	}
+	return false
+}
+
+func (x *MySong) GetRegions() *RegionRestrictions {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *MySong) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *MySong) GetAudio() *AudioInfo {
+	if x != nil {
+		return x.Audio
+	}
+	return nil
+}
+
+func (x *MySong) GetAudioState() AudioState {
+	if x != nil {
+		return x.AudioState
+	}
+	return AudioState_AUDIO_DRAFT
+}
+
+func (x *MySong) GetAudioError() string {
+	if x != nil && x.AudioError != nil {
+		return *x.AudioError
+	}
+	return ""
+}
+
+func (x *MySong) GetThumbnailUrl() string {
+	if x != nil && x.ThumbnailUrl != nil {
+		return *x.ThumbnailUrl
+	}
+	return ""
+}
+
+// Technical metadata of the uploaded file.
+type AudioInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bits per second, the average one for VBR.
+	Bitrate     int32       `protobuf:"varint,1,opt,name=bitrate,proto3" json:"bitrate,omitempty"`
+	BitrateMode BitrateMode `protobuf:"varint,2,opt,name=bitrate_mode,json=bitrateMode,proto3,enum=api.BitrateMode" json:"bitrate_mode,omitempty"`
+	// In Hz.
+	SampleRate  int32       `protobuf:"varint,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	ChannelMode ChannelMode `protobuf:"varint,4,opt,name=channel_mode,json=channelMode,proto3,enum=api.ChannelMode" json:"channel_mode,omitempty"`
+	// From the LAME tag, e.g. LAME3.100.
+	Encoder *string `protobuf:"bytes,5,opt,name=encoder,proto3,oneof" json:"encoder,omitempty"`
+	// Levels of equal parts of the song from 0 to 100, empty if not made.
+	Waveform []int32 `protobuf:"varint,6,rep,packed,name=waveform,proto3" json:"waveform,omitempty"`
+}
+
+func (x *AudioInfo) Reset() {
+	*x = AudioInfo{}
+	mi := &file_api_types_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudioInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioInfo) ProtoMessage() {}
+
+func (x *AudioInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioInfo.ProtoReflect.Descriptor instead.
+func (*AudioInfo) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AudioInfo) GetBitrate() int32 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *AudioInfo) GetBitrateMode() BitrateMode {
+	if x != nil {
+		return x.BitrateMode
+	}
+	return BitrateMode_CBR
+}
+
+func (x *AudioInfo) GetSampleRate() int32 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *AudioInfo) GetChannelMode() ChannelMode {
+	if x != nil {
+		return x.ChannelMode
+	}
+	return ChannelMode_STEREO
+}
+
+func (x *AudioInfo) GetEncoder() string {
+	if x != nil && x.Encoder != nil {
+		return *x.Encoder
+	}
+	return ""
+}
+
+func (x *AudioInfo) GetWaveform() []int32 {
+	if x != nil {
+		return x.Waveform
+	}
+	return nil
+}
+
+type PaginationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastPage int32 `protobuf:"varint,1,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+}
+
+func (x *PaginationResponse) Reset() {
+	*x = PaginationResponse{}
+	mi := &file_api_types_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaginationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationResponse) ProtoMessage() {}
+
+func (x *PaginationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationResponse.ProtoReflect.Descriptor instead.
+func (*PaginationResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PaginationResponse) GetLastPage() int32 {
+	if x != nil {
+		return x.LastPage
+	}
+	return 0
+}
+
+type GetSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pagination queries
+	Page     *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Filtering queries, mutually exclusive
+	ArtistId    *string `protobuf:"bytes,3,opt,name=artist_id,json=artistId,proto3,oneof" json:"artist_id,omitempty"`
+	MatchArtist *string `protobuf:"bytes,4,opt,name=match_artist,json=matchArtist,proto3,oneof" json:"match_artist,omitempty"`
+	MatchName   *string `protobuf:"bytes,5,opt,name=match_name,json=matchName,proto3,oneof" json:"match_name,omitempty"`
+	// Ids don't work with pagination
+	Ids []string `protobuf:"bytes,6,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Songs crediting an artist or a person matching name, optionally in a role
+	CreditArtistId *string     `protobuf:"bytes,7,opt,name=credit_artist_id,json=creditArtistId,proto3,oneof" json:"credit_artist_id,omitempty"`
+	MatchCredit    *string     `protobuf:"bytes,8,opt,name=match_credit,json=matchCredit,proto3,oneof" json:"match_credit,omitempty"`
+	CreditRole     *CreditRole `protobuf:"varint,9,opt,name=credit_role,json=creditRole,proto3,enum=api.CreditRole,oneof" json:"credit_role,omitempty"`
+}
+
+func (x *GetSongsRequest) Reset() {
+	*x = GetSongsRequest{}
+	mi := &file_api_types_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongsRequest) ProtoMessage() {}
+
+func (x *GetSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongsRequest.ProtoReflect.Descriptor instead.
+func (*GetSongsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSongsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetSongsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSongsRequest) GetArtistId() string {
+	if x != nil && x.ArtistId != nil {
+		return *x.ArtistId
+	}
+	return ""
+}
+
+func (x *GetSongsRequest) GetMatchArtist() string {
+	if x != nil && x.MatchArtist != nil {
+		return *x.MatchArtist
+	}
+	return ""
+}
+
+func (x *GetSongsRequest) GetMatchName() string {
+	if x != nil && x.MatchName != nil {
+		return *x.MatchName
+	}
+	return ""
+}
+
+func (x *GetSongsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetSongsRequest) GetCreditArtistId() string {
+	if x != nil && x.CreditArtistId != nil {
+		return *x.CreditArtistId
+	}
+	return ""
+}
+
+func (x *GetSongsRequest) GetMatchCredit() string {
+	if x != nil && x.MatchCredit != nil {
+		return *x.MatchCredit
+	}
+	return ""
+}
+
+func (x *GetSongsRequest) GetCreditRole() CreditRole {
+	if x != nil && x.CreditRole != nil {
+		return *x.CreditRole
+	}
+	return CreditRole_FEATURED
+}
+
+type GetSongsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Songs      []*Song             `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+	Pagination *PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetSongsResponse) Reset() {
+	*x = GetSongsResponse{}
+	mi := &file_api_types_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongsResponse) ProtoMessage() {}
+
+func (x *GetSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongsResponse.ProtoReflect.Descriptor instead.
+func (*GetSongsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSongsResponse) GetSongs() []*Song {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+func (x *GetSongsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetMySongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ids don't work with pagination
+	Ids []string `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Pagination queries
+	Page     *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+}
+
+func (x *GetMySongsRequest) Reset() {
+	*x = GetMySongsRequest{}
+	mi := &file_api_types_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMySongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMySongsRequest) ProtoMessage() {}
+
+func (x *GetMySongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMySongsRequest.ProtoReflect.Descriptor instead.
+func (*GetMySongsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetMySongsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetMySongsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetMySongsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type GetMySongsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Songs      []*MySong           `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+	Pagination *PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetMySongsResponse) Reset() {
+	*x = GetMySongsResponse{}
+	mi := &file_api_types_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMySongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMySongsResponse) ProtoMessage() {}
+
+func (x *GetMySongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMySongsResponse.ProtoReflect.Descriptor instead.
+func (*GetMySongsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetMySongsResponse) GetSongs() []*MySong {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+func (x *GetMySongsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetMyUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMyUsageRequest) Reset() {
+	*x = GetMyUsageRequest{}
+	mi := &file_api_types_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyUsageRequest) ProtoMessage() {}
+
+func (x *GetMyUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetMyUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{37}
+}
+
+// Zero limits mean there is no limit.
+type GetMyUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SongsCount             int32 `protobuf:"varint,1,opt,name=songs_count,json=songsCount,proto3" json:"songs_count,omitempty"`
+	SongsLimit             int32 `protobuf:"varint,2,opt,name=songs_limit,json=songsLimit,proto3" json:"songs_limit,omitempty"`
+	WeightBytes            int64 `protobuf:"varint,3,opt,name=weight_bytes,json=weightBytes,proto3" json:"weight_bytes,omitempty"`
+	WeightBytesLimit       int64 `protobuf:"varint,4,opt,name=weight_bytes_limit,json=weightBytesLimit,proto3" json:"weight_bytes_limit,omitempty"`
+	UploadsPerHourLimit    int32 `protobuf:"varint,5,opt,name=uploads_per_hour_limit,json=uploadsPerHourLimit,proto3" json:"uploads_per_hour_limit,omitempty"`
+	ConcurrentUploadsLimit int32 `protobuf:"varint,6,opt,name=concurrent_uploads_limit,json=concurrentUploadsLimit,proto3" json:"concurrent_uploads_limit,omitempty"`
+}
+
+func (x *GetMyUsageResponse) Reset() {
+	*x = GetMyUsageResponse{}
+	mi := &file_api_types_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyUsageResponse) ProtoMessage() {}
+
+func (x *GetMyUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetMyUsageResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetMyUsageResponse) GetSongsCount() int32 {
+	if x != nil {
+		return x.SongsCount
+	}
+	return 0
+}
+
+func (x *GetMyUsageResponse) GetSongsLimit() int32 {
+	if x != nil {
+		return x.SongsLimit
+	}
+	return 0
+}
+
+func (x *GetMyUsageResponse) GetWeightBytes() int64 {
+	if x != nil {
+		return x.WeightBytes
+	}
+	return 0
+}
+
+func (x *GetMyUsageResponse) GetWeightBytesLimit() int64 {
+	if x != nil {
+		return x.WeightBytesLimit
+	}
+	return 0
+}
+
+func (x *GetMyUsageResponse) GetUploadsPerHourLimit() int32 {
+	if x != nil {
+		return x.UploadsPerHourLimit
+	}
+	return 0
+}
+
+func (x *GetMyUsageResponse) GetConcurrentUploadsLimit() int32 {
+	if x != nil {
+		return x.ConcurrentUploadsLimit
+	}
+	return 0
+}
+
+type StatsCounters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plays int64 `protobuf:"varint,1,opt,name=plays,proto3" json:"plays,omitempty"`
+	// Signed in listeners are told apart by their ids, anonymous ones by their addresses
+	UniqueListeners   int64 `protobuf:"varint,2,opt,name=unique_listeners,json=uniqueListeners,proto3" json:"unique_listeners,omitempty"`
+	Likes             int64 `protobuf:"varint,3,opt,name=likes,proto3" json:"likes,omitempty"`
+	Unlikes           int64 `protobuf:"varint,4,opt,name=unlikes,proto3" json:"unlikes,omitempty"`
+	PlaylistAdditions int64 `protobuf:"varint,5,opt,name=playlist_additions,json=playlistAdditions,proto3" json:"playlist_additions,omitempty"`
+}
+
+func (x *StatsCounters) Reset() {
+	*x = StatsCounters{}
+	mi := &file_api_types_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsCounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsCounters) ProtoMessage() {}
+
+func (x *StatsCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsCounters.ProtoReflect.Descriptor instead.
+func (*StatsCounters) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StatsCounters) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *StatsCounters) GetUniqueListeners() int64 {
+	if x != nil {
+		return x.UniqueListeners
+	}
+	return 0
+}
+
+func (x *StatsCounters) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *StatsCounters) GetUnlikes() int64 {
+	if x != nil {
+		return x.Unlikes
+	}
+	return 0
+}
+
+func (x *StatsCounters) GetPlaylistAdditions() int64 {
+	if x != nil {
+		return x.PlaylistAdditions
+	}
+	return 0
+}
+
+type ArtistStatsBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Counters *StatsCounters         `protobuf:"bytes,2,opt,name=counters,proto3" json:"counters,omitempty"`
+	// Followers at the end of the bucket
+	Followers int64 `protobuf:"varint,3,opt,name=followers,proto3" json:"followers,omitempty"`
+	// Negative if more followers left than came
+	FollowersGained int64 `protobuf:"varint,4,opt,name=followers_gained,json=followersGained,proto3" json:"followers_gained,omitempty"`
+}
+
+func (x *ArtistStatsBucket) Reset() {
+	*x = ArtistStatsBucket{}
+	mi := &file_api_types_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArtistStatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistStatsBucket) ProtoMessage() {}
+
+func (x *ArtistStatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistStatsBucket.ProtoReflect.Descriptor instead.
+func (*ArtistStatsBucket) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ArtistStatsBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ArtistStatsBucket) GetCounters() *StatsCounters {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *ArtistStatsBucket) GetFollowers() int64 {
+	if x != nil {
+		return x.Followers
+	}
+	return 0
+}
+
+func (x *ArtistStatsBucket) GetFollowersGained() int64 {
+	if x != nil {
+		return x.FollowersGained
+	}
+	return 0
+}
+
+type SongStatsBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Counters *StatsCounters         `protobuf:"bytes,2,opt,name=counters,proto3" json:"counters,omitempty"`
+}
+
+func (x *SongStatsBucket) Reset() {
+	*x = SongStatsBucket{}
+	mi := &file_api_types_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongStatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongStatsBucket) ProtoMessage() {}
+
+func (x *SongStatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongStatsBucket.ProtoReflect.Descriptor instead.
+func (*SongStatsBucket) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SongStatsBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *SongStatsBucket) GetCounters() *StatsCounters {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+type SongStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SongId  string             `protobuf:"bytes,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Name    string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Total   *StatsCounters     `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Buckets []*SongStatsBucket `protobuf:"bytes,4,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *SongStats) Reset() {
+	*x = SongStats{}
+	mi := &file_api_types_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongStats) ProtoMessage() {}
+
+func (x *SongStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongStats.ProtoReflect.Descriptor instead.
+func (*SongStats) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SongStats) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *SongStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SongStats) GetTotal() *StatsCounters {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *SongStats) GetBuckets() []*SongStatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type GetArtistStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Days of the period in UTC, both included.
+	// The last 30 days by default, a period is a year at most.
+	From   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	Bucket StatsBucket            `protobuf:"varint,3,opt,name=bucket,proto3,enum=api.StatsBucket" json:"bucket,omitempty"`
+}
+
+func (x *GetArtistStatsRequest) Reset() {
+	*x = GetArtistStatsRequest{}
+	mi := &file_api_types_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArtistStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtistStatsRequest) ProtoMessage() {}
+
+func (x *GetArtistStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtistStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetArtistStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetArtistStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetArtistStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetArtistStatsRequest) GetBucket() StatsBucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return StatsBucket_STATS_DAY
+}
+
+type GetArtistStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total           *StatsCounters `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Followers       int64          `protobuf:"varint,2,opt,name=followers,proto3" json:"followers,omitempty"`
+	FollowersGained int64          `protobuf:"varint,3,opt,name=followers_gained,json=followersGained,proto3" json:"followers_gained,omitempty"`
+	// Every bucket of the period, the empty ones too
+	Buckets []*ArtistStatsBucket `protobuf:"bytes,4,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// Songs with any activity in the period
+	Songs []*SongStats `protobuf:"bytes,5,rep,name=songs,proto3" json:"songs,omitempty"`
+}
+
+func (x *GetArtistStatsResponse) Reset() {
+	*x = GetArtistStatsResponse{}
+	mi := &file_api_types_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArtistStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtistStatsResponse) ProtoMessage() {}
+
+func (x *GetArtistStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtistStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetArtistStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetArtistStatsResponse) GetTotal() *StatsCounters {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetArtistStatsResponse) GetFollowers() int64 {
+	if x != nil {
+		return x.Followers
+	}
+	return 0
+}
+
+func (x *GetArtistStatsResponse) GetFollowersGained() int64 {
+	if x != nil {
+		return x.FollowersGained
+	}
+	return 0
+}
+
+func (x *GetArtistStatsResponse) GetBuckets() []*ArtistStatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetArtistStatsResponse) GetSongs() []*SongStats {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+type ReleaseSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids    []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Notify bool     `protobuf:"varint,2,opt,name=notify,proto3" json:"notify,omitempty"`
+}
+
+func (x *ReleaseSongsRequest) Reset() {
+	*x = ReleaseSongsRequest{}
+	mi := &file_api_types_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSongsRequest) ProtoMessage() {}
+
+func (x *ReleaseSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSongsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSongsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReleaseSongsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ReleaseSongsRequest) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+type ReleaseSongsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReleaseSongsResponse) Reset() {
+	*x = ReleaseSongsResponse{}
+	mi := &file_api_types_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSongsResponse) ProtoMessage() {}
+
+func (x *ReleaseSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSongsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSongsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{46}
+}
+
+type FlagSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *FlagSongRequest) Reset() {
+	*x = FlagSongRequest{}
+	mi := &file_api_types_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagSongRequest) ProtoMessage() {}
+
+func (x *FlagSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagSongRequest.ProtoReflect.Descriptor instead.
+func (*FlagSongRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FlagSongRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FlagSongRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FlagSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FlagSongResponse) Reset() {
+	*x = FlagSongResponse{}
+	mi := &file_api_types_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagSongResponse) ProtoMessage() {}
+
+func (x *FlagSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagSongResponse.ProtoReflect.Descriptor instead.
+func (*FlagSongResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{48}
+}
+
+type TakeDownSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *TakeDownSongRequest) Reset() {
+	*x = TakeDownSongRequest{}
+	mi := &file_api_types_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeDownSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeDownSongRequest) ProtoMessage() {}
+
+func (x *TakeDownSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeDownSongRequest.ProtoReflect.Descriptor instead.
+func (*TakeDownSongRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TakeDownSongRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TakeDownSongRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TakeDownSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TakeDownSongResponse) Reset() {
+	*x = TakeDownSongResponse{}
+	mi := &file_api_types_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeDownSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeDownSongResponse) ProtoMessage() {}
+
+func (x *TakeDownSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeDownSongResponse.ProtoReflect.Descriptor instead.
+func (*TakeDownSongResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{50}
+}
+
+type RestoreSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason *string `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+}
+
+func (x *RestoreSongRequest) Reset() {
+	*x = RestoreSongRequest{}
+	mi := &file_api_types_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSongRequest) ProtoMessage() {}
+
+func (x *RestoreSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSongRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSongRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RestoreSongRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreSongRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type RestoreSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreSongResponse) Reset() {
+	*x = RestoreSongResponse{}
+	mi := &file_api_types_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSongResponse) ProtoMessage() {}
+
+func (x *RestoreSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSongResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSongResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{52}
+}
+
+type Claim struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SongId         string                 `protobuf:"bytes,2,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	ClaimantId     string                 `protobuf:"bytes,3,opt,name=claimant_id,json=claimantId,proto3" json:"claimant_id,omitempty"`
+	ClaimantName   string                 `protobuf:"bytes,4,opt,name=claimant_name,json=claimantName,proto3" json:"claimant_name,omitempty"`
+	ClaimantEmail  string                 `protobuf:"bytes,5,opt,name=claimant_email,json=claimantEmail,proto3" json:"claimant_email,omitempty"`
+	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	EvidenceUrls   []string               `protobuf:"bytes,7,rep,name=evidence_urls,json=evidenceUrls,proto3" json:"evidence_urls,omitempty"`
+	Status         ClaimStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=api.ClaimStatus" json:"status,omitempty"`
+	CounterNotice  *string                `protobuf:"bytes,9,opt,name=counter_notice,json=counterNotice,proto3,oneof" json:"counter_notice,omitempty"`
+	ResolutionNote *string                `protobuf:"bytes,10,opt,name=resolution_note,json=resolutionNote,proto3,oneof" json:"resolution_note,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Claim) Reset() {
+	*x = Claim{}
+	mi := &file_api_types_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Claim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Claim) ProtoMessage() {}
+
+func (x *Claim) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Claim.ProtoReflect.Descriptor instead.
+func (*Claim) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Claim) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Claim) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *Claim) GetClaimantId() string {
+	if x != nil {
+		return x.ClaimantId
+	}
+	return ""
+}
+
+func (x *Claim) GetClaimantName() string {
+	if x != nil {
+		return x.ClaimantName
+	}
+	return ""
+}
+
+func (x *Claim) GetClaimantEmail() string {
+	if x != nil {
+		return x.ClaimantEmail
+	}
+	return ""
+}
+
+func (x *Claim) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Claim) GetEvidenceUrls() []string {
+	if x != nil {
+		return x.EvidenceUrls
+	}
+	return nil
+}
+
+func (x *Claim) GetStatus() ClaimStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ClaimStatus_PENDING
+}
+
+func (x *Claim) GetCounterNotice() string {
+	if x != nil && x.CounterNotice != nil {
+		return *x.CounterNotice
+	}
+	return ""
+}
+
+func (x *Claim) GetResolutionNote() string {
+	if x != nil && x.ResolutionNote != nil {
+		return *x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *Claim) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Claim) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A status change of a claim.
+type ClaimEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Not set for the submission.
+	FromStatus *ClaimStatus           `protobuf:"varint,1,opt,name=from_status,json=fromStatus,proto3,enum=api.ClaimStatus,oneof" json:"from_status,omitempty"`
+	ToStatus   ClaimStatus            `protobuf:"varint,2,opt,name=to_status,json=toStatus,proto3,enum=api.ClaimStatus" json:"to_status,omitempty"`
+	ActorId    string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Note       *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ClaimEvent) Reset() {
+	*x = ClaimEvent{}
+	mi := &file_api_types_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimEvent) ProtoMessage() {}
+
+func (x *ClaimEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimEvent.ProtoReflect.Descriptor instead.
+func (*ClaimEvent) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ClaimEvent) GetFromStatus() ClaimStatus {
+	if x != nil && x.FromStatus != nil {
+		return *x.FromStatus
+	}
+	return ClaimStatus_PENDING
+}
+
+func (x *ClaimEvent) GetToStatus() ClaimStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ClaimStatus_PENDING
+}
+
+func (x *ClaimEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ClaimEvent) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *ClaimEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SubmitClaimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SongId        string   `protobuf:"bytes,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	ClaimantName  string   `protobuf:"bytes,2,opt,name=claimant_name,json=claimantName,proto3" json:"claimant_name,omitempty"`
+	ClaimantEmail string   `protobuf:"bytes,3,opt,name=claimant_email,json=claimantEmail,proto3" json:"claimant_email,omitempty"`
+	Description   string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	EvidenceUrls  []string `protobuf:"bytes,5,rep,name=evidence_urls,json=evidenceUrls,proto3" json:"evidence_urls,omitempty"`
+}
+
+func (x *SubmitClaimRequest) Reset() {
+	*x = SubmitClaimRequest{}
+	mi := &file_api_types_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitClaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitClaimRequest) ProtoMessage() {}
+
+func (x *SubmitClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitClaimRequest.ProtoReflect.Descriptor instead.
+func (*SubmitClaimRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SubmitClaimRequest) GetSongId() string {
+	if x != nil {
+		return x.SongId
+	}
+	return ""
+}
+
+func (x *SubmitClaimRequest) GetClaimantName() string {
+	if x != nil {
+		return x.ClaimantName
+	}
+	return ""
+}
+
+func (x *SubmitClaimRequest) GetClaimantEmail() string {
+	if x != nil {
+		return x.ClaimantEmail
+	}
+	return ""
+}
+
+func (x *SubmitClaimRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SubmitClaimRequest) GetEvidenceUrls() []string {
+	if x != nil {
+		return x.EvidenceUrls
+	}
+	return nil
+}
+
+type SubmitClaimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claim *Claim `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+}
+
+func (x *SubmitClaimResponse) Reset() {
+	*x = SubmitClaimResponse{}
+	mi := &file_api_types_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitClaimResponse) ProtoMessage() {}
+
+func (x *SubmitClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitClaimResponse.ProtoReflect.Descriptor instead.
+func (*SubmitClaimResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SubmitClaimResponse) GetClaim() *Claim {
+	if x != nil {
+		return x.Claim
+	}
+	return nil
+}
+
+type GetClaimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *UploadRawSongRequest) Reset() {
-	*x = UploadRawSongRequest{}
-	mi := &file_api_types_proto_msgTypes[0]
+func (x *GetClaimRequest) Reset() {
+	*x = GetClaimRequest{}
+	mi := &file_api_types_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadRawSongRequest) String() string {
+func (x *GetClaimRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadRawSongRequest) ProtoMessage() {}
+func (*GetClaimRequest) ProtoMessage() {}
 
-func (x *UploadRawSongRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[0]
+func (x *GetClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -147,61 +4271,262 @@ func (x *UploadRawSongRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadRawSongRequest.ProtoReflect.Descriptor instead.
-func (*UploadRawSongRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetClaimRequest.ProtoReflect.Descriptor instead.
+func (*GetClaimRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UploadRawSongRequest) GetFilePart() []byte {
+func (x *GetClaimRequest) GetId() string {
 	if x != nil {
-		return x.FilePart
+		return x.Id
+	}
+	return ""
+}
+
+type GetClaimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claim   *Claim        `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	History []*ClaimEvent `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *GetClaimResponse) Reset() {
+	*x = GetClaimResponse{}
+	mi := &file_api_types_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimResponse) ProtoMessage() {}
+
+func (x *GetClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimResponse.ProtoReflect.Descriptor instead.
+func (*GetClaimResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetClaimResponse) GetClaim() *Claim {
+	if x != nil {
+		return x.Claim
 	}
 	return nil
 }
 
-func (x *UploadRawSongRequest) GetExtension() SongFileExtension {
+func (x *GetClaimResponse) GetHistory() []*ClaimEvent {
 	if x != nil {
-		return x.Extension
+		return x.History
 	}
-	return SongFileExtension_MP3
+	return nil
 }
 
-func (x *UploadRawSongRequest) GetSongId() string {
+type GetClaimsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *ClaimStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.ClaimStatus,oneof" json:"status,omitempty"`
+	// Pagination queries
+	Page     *int32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+}
+
+func (x *GetClaimsRequest) Reset() {
+	*x = GetClaimsRequest{}
+	mi := &file_api_types_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClaimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimsRequest) ProtoMessage() {}
+
+func (x *GetClaimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[59]
 	if x != nil {
-		return x.SongId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimsRequest.ProtoReflect.Descriptor instead.
+func (*GetClaimsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetClaimsRequest) GetStatus() ClaimStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ClaimStatus_PENDING
+}
+
+func (x *GetClaimsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetClaimsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type GetClaimsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claims     []*Claim            `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	Pagination *PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetClaimsResponse) Reset() {
+	*x = GetClaimsResponse{}
+	mi := &file_api_types_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClaimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimsResponse) ProtoMessage() {}
+
+func (x *GetClaimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimsResponse.ProtoReflect.Descriptor instead.
+func (*GetClaimsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetClaimsResponse) GetClaims() []*Claim {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *GetClaimsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type FileCounterNoticeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Statement string `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+}
+
+func (x *FileCounterNoticeRequest) Reset() {
+	*x = FileCounterNoticeRequest{}
+	mi := &file_api_types_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileCounterNoticeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileCounterNoticeRequest) ProtoMessage() {}
+
+func (x *FileCounterNoticeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileCounterNoticeRequest.ProtoReflect.Descriptor instead.
+func (*FileCounterNoticeRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FileCounterNoticeRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type UploadRawSongResponse struct {
+func (x *FileCounterNoticeRequest) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+type FileCounterNoticeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// newly created raw_song_i
-	// looks like 902435aad48afe51260f23f8a73b38a3245cac25.extension
-	// 1st part is sha1 hex-encoded 40 bytes hash of "Artist\u0001SongName".
-	// 2nd part is one of the formats from SongFileExtension.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// it's just https://HOST/songs/api/v1/song/raw/902435aad48afe51260f23f8a73b38a3245cac25.extension
-	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Claim *Claim `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
 }
 
-func (x *UploadRawSongResponse) Reset() {
-	*x = UploadRawSongResponse{}
-	mi := &file_api_types_proto_msgTypes[1]
+func (x *FileCounterNoticeResponse) Reset() {
+	*x = FileCounterNoticeResponse{}
+	mi := &file_api_types_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadRawSongResponse) String() string {
+func (x *FileCounterNoticeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadRawSongResponse) ProtoMessage() {}
+func (*FileCounterNoticeResponse) ProtoMessage() {}
 
-func (x *UploadRawSongResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[1]
+func (x *FileCounterNoticeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,48 +4537,149 @@ func (x *UploadRawSongResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadRawSongResponse.ProtoReflect.Descriptor instead.
-func (*UploadRawSongResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use FileCounterNoticeResponse.ProtoReflect.Descriptor instead.
+func (*FileCounterNoticeResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UploadRawSongResponse) GetId() string {
+func (x *FileCounterNoticeResponse) GetClaim() *Claim {
+	if x != nil {
+		return x.Claim
+	}
+	return nil
+}
+
+type ResolveClaimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Upheld bool    `protobuf:"varint,2,opt,name=upheld,proto3" json:"upheld,omitempty"`
+	Note   *string `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
+}
+
+func (x *ResolveClaimRequest) Reset() {
+	*x = ResolveClaimRequest{}
+	mi := &file_api_types_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveClaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveClaimRequest) ProtoMessage() {}
+
+func (x *ResolveClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveClaimRequest.ProtoReflect.Descriptor instead.
+func (*ResolveClaimRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ResolveClaimRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UploadRawSongResponse) GetUrl() string {
+func (x *ResolveClaimRequest) GetUpheld() bool {
 	if x != nil {
-		return x.Url
+		return x.Upheld
+	}
+	return false
+}
+
+func (x *ResolveClaimRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
 	}
 	return ""
 }
 
-type GetRawSongRequest struct {
+type ResolveClaimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claim *Claim `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+}
+
+func (x *ResolveClaimResponse) Reset() {
+	*x = ResolveClaimResponse{}
+	mi := &file_api_types_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveClaimResponse) ProtoMessage() {}
+
+func (x *ResolveClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveClaimResponse.ProtoReflect.Descriptor instead.
+func (*ResolveClaimResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ResolveClaimResponse) GetClaim() *Claim {
+	if x != nil {
+		return x.Claim
+	}
+	return nil
+}
+
+type VerifyClaimantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RawSongId string `protobuf:"bytes,1,opt,name=raw_song_id,json=rawSongId,proto3" json:"raw_song_id,omitempty"`
+	ClaimantId string `protobuf:"bytes,1,opt,name=claimant_id,json=claimantId,proto3" json:"claimant_id,omitempty"`
+	// False takes the verification back.
+	Verified bool `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
 }
 
-func (x *GetRawSongRequest) Reset() {
-	*x = GetRawSongRequest{}
-	mi := &file_api_types_proto_msgTypes[2]
+func (x *VerifyClaimantRequest) Reset() {
+	*x = VerifyClaimantRequest{}
+	mi := &file_api_types_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRawSongRequest) String() string {
+func (x *VerifyClaimantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRawSongRequest) ProtoMessage() {}
+func (*VerifyClaimantRequest) ProtoMessage() {}
 
-func (x *GetRawSongRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[2]
+func (x *VerifyClaimantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,41 +4690,46 @@ func (x *GetRawSongRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRawSongRequest.ProtoReflect.Descriptor instead.
-func (*GetRawSongRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use VerifyClaimantRequest.ProtoReflect.Descriptor instead.
+func (*VerifyClaimantRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetRawSongRequest) GetRawSongId() string {
+func (x *VerifyClaimantRequest) GetClaimantId() string {
 	if x != nil {
-		return x.RawSongId
+		return x.ClaimantId
 	}
 	return ""
 }
 
-type GetRawSongResponse struct {
+func (x *VerifyClaimantRequest) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type VerifyClaimantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	FilePart []byte `protobuf:"bytes,1,opt,name=file_part,json=filePart,proto3" json:"file_part,omitempty"`
 }
 
-func (x *GetRawSongResponse) Reset() {
-	*x = GetRawSongResponse{}
-	mi := &file_api_types_proto_msgTypes[3]
+func (x *VerifyClaimantResponse) Reset() {
+	*x = VerifyClaimantResponse{}
+	mi := &file_api_types_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRawSongResponse) String() string {
+func (x *VerifyClaimantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRawSongResponse) ProtoMessage() {}
+func (*VerifyClaimantResponse) ProtoMessage() {}
 
-func (x *GetRawSongResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[3]
+func (x *VerifyClaimantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,43 +4740,43 @@ func (x *GetRawSongResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRawSongResponse.ProtoReflect.Descriptor instead.
-func (*GetRawSongResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *GetRawSongResponse) GetFilePart() []byte {
-	if x != nil {
-		return x.FilePart
-	}
-	return nil
+// Deprecated: Use VerifyClaimantResponse.ProtoReflect.Descriptor instead.
+func (*VerifyClaimantResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{66}
 }
 
-type UploadRawSongImageRequest struct {
+type CatalogExport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilePart  []byte             `protobuf:"bytes,1,opt,name=file_part,json=filePart,proto3" json:"file_part,omitempty"` // Max 1MB per part
-	Extension ImageFileExtension `protobuf:"varint,2,opt,name=extension,proto3,enum=api.ImageFileExtension" json:"extension,omitempty"`
-	SongId    string             `protobuf:"bytes,3,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
-}
-
-func (x *UploadRawSongImageRequest) Reset() {
-	*x = UploadRawSongImageRequest{}
-	mi := &file_api_types_proto_msgTypes[4]
+	Id     string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status ExportStatus `protobuf:"varint,2,opt,name=status,proto3,enum=api.ExportStatus" json:"status,omitempty"`
+	// A zip with the songs, their images and manifest.json, set while the export is ready
+	DownloadUrl *string                `protobuf:"bytes,3,opt,name=download_url,json=downloadUrl,proto3,oneof" json:"download_url,omitempty"`
+	WeightBytes *int64                 `protobuf:"varint,4,opt,name=weight_bytes,json=weightBytes,proto3,oneof" json:"weight_bytes,omitempty"`
+	Error       *string                `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`
+	// The download link stops working after that
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+}
+
+func (x *CatalogExport) Reset() {
+	*x = CatalogExport{}
+	mi := &file_api_types_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadRawSongImageRequest) String() string {
+func (x *CatalogExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadRawSongImageRequest) ProtoMessage() {}
+func (*CatalogExport) ProtoMessage() {}
 
-func (x *UploadRawSongImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[4]
+func (x *CatalogExport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,57 +4787,88 @@ func (x *UploadRawSongImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadRawSongImageRequest.ProtoReflect.Descriptor instead.
-func (*UploadRawSongImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CatalogExport.ProtoReflect.Descriptor instead.
+func (*CatalogExport) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UploadRawSongImageRequest) GetFilePart() []byte {
+func (x *CatalogExport) GetId() string {
 	if x != nil {
-		return x.FilePart
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CatalogExport) GetStatus() ExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExportStatus_EXPORT_PENDING
+}
+
+func (x *CatalogExport) GetDownloadUrl() string {
+	if x != nil && x.DownloadUrl != nil {
+		return *x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *CatalogExport) GetWeightBytes() int64 {
+	if x != nil && x.WeightBytes != nil {
+		return *x.WeightBytes
+	}
+	return 0
+}
+
+func (x *CatalogExport) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *CatalogExport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *UploadRawSongImageRequest) GetExtension() ImageFileExtension {
+func (x *CatalogExport) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Extension
+		return x.FinishedAt
 	}
-	return ImageFileExtension_JPEG
+	return nil
 }
 
-func (x *UploadRawSongImageRequest) GetSongId() string {
+func (x *CatalogExport) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SongId
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-type UploadRawSongImageResponse struct {
+type ExportCatalogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Similar to UploadRawSong
-	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *UploadRawSongImageResponse) Reset() {
-	*x = UploadRawSongImageResponse{}
-	mi := &file_api_types_proto_msgTypes[5]
+func (x *ExportCatalogRequest) Reset() {
+	*x = ExportCatalogRequest{}
+	mi := &file_api_types_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadRawSongImageResponse) String() string {
+func (x *ExportCatalogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadRawSongImageResponse) ProtoMessage() {}
+func (*ExportCatalogRequest) ProtoMessage() {}
 
-func (x *UploadRawSongImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[5]
+func (x *ExportCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,48 +4879,34 @@ func (x *UploadRawSongImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadRawSongImageResponse.ProtoReflect.Descriptor instead.
-func (*UploadRawSongImageResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *UploadRawSongImageResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UploadRawSongImageResponse) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+// Deprecated: Use ExportCatalogRequest.ProtoReflect.Descriptor instead.
+func (*ExportCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{68}
 }
 
-type GetRawSongImageRequest struct {
+type ExportCatalogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RawImageId string `protobuf:"bytes,1,opt,name=raw_image_id,json=rawImageId,proto3" json:"raw_image_id,omitempty"`
+	Export *CatalogExport `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
 }
 
-func (x *GetRawSongImageRequest) Reset() {
-	*x = GetRawSongImageRequest{}
-	mi := &file_api_types_proto_msgTypes[6]
+func (x *ExportCatalogResponse) Reset() {
+	*x = ExportCatalogResponse{}
+	mi := &file_api_types_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRawSongImageRequest) String() string {
+func (x *ExportCatalogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRawSongImageRequest) ProtoMessage() {}
+func (*ExportCatalogResponse) ProtoMessage() {}
 
-func (x *GetRawSongImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[6]
+func (x *ExportCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,41 +4917,39 @@ func (x *GetRawSongImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRawSongImageRequest.ProtoReflect.Descriptor instead.
-func (*GetRawSongImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExportCatalogResponse.ProtoReflect.Descriptor instead.
+func (*ExportCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetRawSongImageRequest) GetRawImageId() string {
+func (x *ExportCatalogResponse) GetExport() *CatalogExport {
 	if x != nil {
-		return x.RawImageId
+		return x.Export
 	}
-	return ""
+	return nil
 }
 
-type GetRawSongImageResponse struct {
+type GetCatalogExportsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	FilePart []byte `protobuf:"bytes,1,opt,name=file_part,json=filePart,proto3" json:"file_part,omitempty"`
 }
 
-func (x *GetRawSongImageResponse) Reset() {
-	*x = GetRawSongImageResponse{}
-	mi := &file_api_types_proto_msgTypes[7]
+func (x *GetCatalogExportsRequest) Reset() {
+	*x = GetCatalogExportsRequest{}
+	mi := &file_api_types_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRawSongImageResponse) String() string {
+func (x *GetCatalogExportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRawSongImageResponse) ProtoMessage() {}
+func (*GetCatalogExportsRequest) ProtoMessage() {}
 
-func (x *GetRawSongImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[7]
+func (x *GetCatalogExportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,43 +4960,34 @@ func (x *GetRawSongImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRawSongImageResponse.ProtoReflect.Descriptor instead.
-func (*GetRawSongImageResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *GetRawSongImageResponse) GetFilePart() []byte {
-	if x != nil {
-		return x.FilePart
-	}
-	return nil
+// Deprecated: Use GetCatalogExportsRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogExportsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{70}
 }
 
-type CreateSongRequest struct {
+type GetCatalogExportsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ImageUrl       *string  `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	FeatArtistsIds []string `protobuf:"bytes,4,rep,name=feat_artists_ids,json=featArtistsIds,proto3" json:"feat_artists_ids,omitempty"`
+	Exports []*CatalogExport `protobuf:"bytes,1,rep,name=exports,proto3" json:"exports,omitempty"`
 }
 
-func (x *CreateSongRequest) Reset() {
-	*x = CreateSongRequest{}
-	mi := &file_api_types_proto_msgTypes[8]
+func (x *GetCatalogExportsResponse) Reset() {
+	*x = GetCatalogExportsResponse{}
+	mi := &file_api_types_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSongRequest) String() string {
+func (x *GetCatalogExportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSongRequest) ProtoMessage() {}
+func (*GetCatalogExportsResponse) ProtoMessage() {}
 
-func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[8]
+func (x *GetCatalogExportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,60 +4998,52 @@ func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSongRequest.ProtoReflect.Descriptor instead.
-func (*CreateSongRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *CreateSongRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateSongRequest) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
-	}
-	return ""
+// Deprecated: Use GetCatalogExportsResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogExportsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *CreateSongRequest) GetFeatArtistsIds() []string {
+func (x *GetCatalogExportsResponse) GetExports() []*CatalogExport {
 	if x != nil {
-		return x.FeatArtistsIds
+		return x.Exports
 	}
 	return nil
 }
 
-type CreateSongResponse struct {
+type Job struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Singer     *users.Artist          `protobuf:"bytes,2,opt,name=singer,proto3" json:"singer,omitempty"`
-	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Artists    []*users.Artist        `protobuf:"bytes,4,rep,name=artists,proto3" json:"artists,omitempty"`
-	UploadedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
-	ImageUrl   *string                `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-}
-
-func (x *CreateSongResponse) Reset() {
-	*x = CreateSongResponse{}
-	mi := &file_api_types_proto_msgTypes[9]
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// JSON
+	Payload     string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status      JobStatus              `protobuf:"varint,4,opt,name=status,proto3,enum=api.JobStatus" json:"status,omitempty"`
+	UniqueKey   *string                `protobuf:"bytes,5,opt,name=unique_key,json=uniqueKey,proto3,oneof" json:"unique_key,omitempty"`
+	Attempts    int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	MaxAttempts int32                  `protobuf:"varint,7,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	LastError   *string                `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	RunAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FailedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=failed_at,json=failedAt,proto3,oneof" json:"failed_at,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_api_types_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSongResponse) String() string {
+func (x *Job) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSongResponse) ProtoMessage() {}
+func (*Job) ProtoMessage() {}
 
-func (x *CreateSongResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[9]
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,121 +5054,113 @@ func (x *CreateSongResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSongResponse.ProtoReflect.Descriptor instead.
-func (*CreateSongResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *CreateSongResponse) GetId() string {
+func (x *Job) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateSongResponse) GetSinger() *users.Artist {
-	if x != nil {
-		return x.Singer
-	}
-	return nil
-}
-
-func (x *CreateSongResponse) GetName() string {
+func (x *Job) GetKind() string {
 	if x != nil {
-		return x.Name
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *CreateSongResponse) GetArtists() []*users.Artist {
+func (x *Job) GetPayload() string {
 	if x != nil {
-		return x.Artists
+		return x.Payload
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateSongResponse) GetUploadedAt() *timestamppb.Timestamp {
+func (x *Job) GetStatus() JobStatus {
 	if x != nil {
-		return x.UploadedAt
+		return x.Status
 	}
-	return nil
+	return JobStatus_JOB_QUEUED
 }
 
-func (x *CreateSongResponse) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
+func (x *Job) GetUniqueKey() string {
+	if x != nil && x.UniqueKey != nil {
+		return *x.UniqueKey
 	}
 	return ""
 }
 
-type GetSongRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *GetSongRequest) Reset() {
-	*x = GetSongRequest{}
-	mi := &file_api_types_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Job) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
 }
 
-func (x *GetSongRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Job) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
 }
 
-func (*GetSongRequest) ProtoMessage() {}
-
-func (x *GetSongRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[10]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Job) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *Job) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetSongRequest.ProtoReflect.Descriptor instead.
-func (*GetSongRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{10}
+func (x *Job) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GetSongRequest) GetId() string {
+func (x *Job) GetFailedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.FailedAt
 	}
-	return ""
+	return nil
 }
 
-type GetSongResponse struct {
+type GetDeadJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Song *Song `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	// Pagination queries
+	Page     *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
 }
 
-func (x *GetSongResponse) Reset() {
-	*x = GetSongResponse{}
-	mi := &file_api_types_proto_msgTypes[11]
+func (x *GetDeadJobsRequest) Reset() {
+	*x = GetDeadJobsRequest{}
+	mi := &file_api_types_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSongResponse) String() string {
+func (x *GetDeadJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSongResponse) ProtoMessage() {}
+func (*GetDeadJobsRequest) ProtoMessage() {}
 
-func (x *GetSongResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[11]
+func (x *GetDeadJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,43 +5171,49 @@ func (x *GetSongResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSongResponse.ProtoReflect.Descriptor instead.
-func (*GetSongResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetDeadJobsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetSongResponse) GetSong() *Song {
-	if x != nil {
-		return x.Song
+func (x *GetDeadJobsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
 	}
-	return nil
+	return 0
 }
 
-type UpdateSongRequest struct {
+func (x *GetDeadJobsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type GetDeadJobsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	ImageUrl *string `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Jobs       []*Job              `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Pagination *PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *UpdateSongRequest) Reset() {
-	*x = UpdateSongRequest{}
-	mi := &file_api_types_proto_msgTypes[12]
+func (x *GetDeadJobsResponse) Reset() {
+	*x = GetDeadJobsResponse{}
+	mi := &file_api_types_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSongRequest) String() string {
+func (x *GetDeadJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSongRequest) ProtoMessage() {}
+func (*GetDeadJobsResponse) ProtoMessage() {}
 
-func (x *UpdateSongRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[12]
+func (x *GetDeadJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,53 +5224,48 @@ func (x *UpdateSongRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSongRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSongRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetDeadJobsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *UpdateSongRequest) GetId() string {
+func (x *GetDeadJobsResponse) GetJobs() []*Job {
 	if x != nil {
-		return x.Id
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateSongRequest) GetName() string {
+func (x *GetDeadJobsResponse) GetPagination() *PaginationResponse {
 	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateSongRequest) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-type UpdateSongResponse struct {
+type RetryJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *UpdateSongResponse) Reset() {
-	*x = UpdateSongResponse{}
-	mi := &file_api_types_proto_msgTypes[13]
+func (x *RetryJobRequest) Reset() {
+	*x = RetryJobRequest{}
+	mi := &file_api_types_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSongResponse) String() string {
+func (x *RetryJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSongResponse) ProtoMessage() {}
+func (*RetryJobRequest) ProtoMessage() {}
 
-func (x *UpdateSongResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[13]
+func (x *RetryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,34 +5276,41 @@ func (x *UpdateSongResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSongResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSongResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RetryJobRequest.ProtoReflect.Descriptor instead.
+func (*RetryJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{75}
 }
 
-type DeleteSongsRequest struct {
+func (x *RetryJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RetryJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *DeleteSongsRequest) Reset() {
-	*x = DeleteSongsRequest{}
-	mi := &file_api_types_proto_msgTypes[14]
+func (x *RetryJobResponse) Reset() {
+	*x = RetryJobResponse{}
+	mi := &file_api_types_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSongsRequest) String() string {
+func (x *RetryJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSongsRequest) ProtoMessage() {}
+func (*RetryJobResponse) ProtoMessage() {}
 
-func (x *DeleteSongsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[14]
+func (x *RetryJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,39 +5321,44 @@ func (x *DeleteSongsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSongsRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSongsRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RetryJobResponse.ProtoReflect.Descriptor instead.
+func (*RetryJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *DeleteSongsRequest) GetIds() []string {
+func (x *RetryJobResponse) GetJob() *Job {
 	if x != nil {
-		return x.Ids
+		return x.Job
 	}
 	return nil
 }
 
-type DeleteSongsResponse struct {
+type Webhook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url       string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events    []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *DeleteSongsResponse) Reset() {
-	*x = DeleteSongsResponse{}
-	mi := &file_api_types_proto_msgTypes[15]
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_api_types_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSongsResponse) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSongsResponse) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *DeleteSongsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[15]
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,43 +5369,74 @@ func (x *DeleteSongsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSongsResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSongsResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{77}
 }
 
-type Song struct {
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Singer      *users.Artist          `protobuf:"bytes,9,opt,name=singer,proto3" json:"singer,omitempty"`
-	Artists     []*users.Artist        `protobuf:"bytes,2,rep,name=artists,proto3" json:"artists,omitempty"`
-	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	SongUrl     string                 `protobuf:"bytes,4,opt,name=song_url,json=songUrl,proto3" json:"song_url,omitempty"`
-	ImageUrl    *string                `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	Duration    *durationpb.Duration   `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
-	WeightBytes int32                  `protobuf:"varint,7,opt,name=weight_bytes,json=weightBytes,proto3" json:"weight_bytes,omitempty"`
-	ReleasedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
-	UploadedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
-}
-
-func (x *Song) Reset() {
-	*x = Song{}
-	mi := &file_api_types_proto_msgTypes[16]
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Event     string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// JSON body sent to the endpoint
+	Payload  string                `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status   WebhookDeliveryStatus `protobuf:"varint,5,opt,name=status,proto3,enum=api.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts int32                 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Set if the endpoint responded to the last attempt
+	ResponseCode *int32 `protobuf:"varint,7,opt,name=response_code,json=responseCode,proto3,oneof" json:"response_code,omitempty"`
+	// Set if the last attempt failed
+	Error       *string                `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AttemptedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=attempted_at,json=attemptedAt,proto3,oneof" json:"attempted_at,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_api_types_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Song) String() string {
+func (x *WebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Song) ProtoMessage() {}
+func (*WebhookDelivery) ProtoMessage() {}
 
-func (x *Song) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[16]
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,221 +5447,159 @@ func (x *Song) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Song.ProtoReflect.Descriptor instead.
-func (*Song) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *Song) GetId() string {
+func (x *WebhookDelivery) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Song) GetSinger() *users.Artist {
-	if x != nil {
-		return x.Singer
-	}
-	return nil
-}
-
-func (x *Song) GetArtists() []*users.Artist {
-	if x != nil {
-		return x.Artists
-	}
-	return nil
-}
-
-func (x *Song) GetName() string {
+func (x *WebhookDelivery) GetWebhookId() string {
 	if x != nil {
-		return x.Name
+		return x.WebhookId
 	}
 	return ""
 }
 
-func (x *Song) GetSongUrl() string {
+func (x *WebhookDelivery) GetEvent() string {
 	if x != nil {
-		return x.SongUrl
+		return x.Event
 	}
 	return ""
 }
 
-func (x *Song) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
 	}
 	return ""
 }
 
-func (x *Song) GetDuration() *durationpb.Duration {
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
 	if x != nil {
-		return x.Duration
+		return x.Status
 	}
-	return nil
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING
 }
 
-func (x *Song) GetWeightBytes() int32 {
+func (x *WebhookDelivery) GetAttempts() int32 {
 	if x != nil {
-		return x.WeightBytes
+		return x.Attempts
 	}
 	return 0
 }
 
-func (x *Song) GetReleasedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ReleasedAt
-	}
-	return nil
-}
-
-func (x *Song) GetUploadedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UploadedAt
-	}
-	return nil
-}
-
-type MySong struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Singer      *users.Artist          `protobuf:"bytes,9,opt,name=singer,proto3" json:"singer,omitempty"`
-	Artists     []*users.Artist        `protobuf:"bytes,2,rep,name=artists,proto3" json:"artists,omitempty"`
-	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	SongUrl     *string                `protobuf:"bytes,4,opt,name=song_url,json=songUrl,proto3,oneof" json:"song_url,omitempty"`
-	ImageUrl    *string                `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	Duration    *durationpb.Duration   `protobuf:"bytes,6,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
-	WeightBytes *int32                 `protobuf:"varint,7,opt,name=weight_bytes,json=weightBytes,proto3,oneof" json:"weight_bytes,omitempty"`
-	ReleasedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=released_at,json=releasedAt,proto3,oneof" json:"released_at,omitempty"`
-	UploadedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
-}
-
-func (x *MySong) Reset() {
-	*x = MySong{}
-	mi := &file_api_types_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MySong) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MySong) ProtoMessage() {}
-
-func (x *MySong) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[17]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *WebhookDelivery) GetResponseCode() int32 {
+	if x != nil && x.ResponseCode != nil {
+		return *x.ResponseCode
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MySong.ProtoReflect.Descriptor instead.
-func (*MySong) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{17}
+	return 0
 }
 
-func (x *MySong) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *WebhookDelivery) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-func (x *MySong) GetSinger() *users.Artist {
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Singer
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MySong) GetArtists() []*users.Artist {
+func (x *WebhookDelivery) GetAttemptedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Artists
+		return x.AttemptedAt
 	}
 	return nil
 }
 
-func (x *MySong) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url    string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Events []string `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *MySong) GetSongUrl() string {
-	if x != nil && x.SongUrl != nil {
-		return *x.SongUrl
-	}
-	return ""
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_api_types_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MySong) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
-	}
-	return ""
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MySong) GetDuration() *durationpb.Duration {
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[79]
 	if x != nil {
-		return x.Duration
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MySong) GetWeightBytes() int32 {
-	if x != nil && x.WeightBytes != nil {
-		return *x.WeightBytes
-	}
-	return 0
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *MySong) GetReleasedAt() *timestamppb.Timestamp {
+func (x *CreateWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.ReleasedAt
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *MySong) GetUploadedAt() *timestamppb.Timestamp {
+func (x *CreateWebhookRequest) GetEvents() []string {
 	if x != nil {
-		return x.UploadedAt
+		return x.Events
 	}
 	return nil
 }
 
-type PaginationResponse struct {
+type CreateWebhookResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LastPage int32 `protobuf:"varint,1,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// Signs the deliveries, it is not shown again
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *PaginationResponse) Reset() {
-	*x = PaginationResponse{}
-	mi := &file_api_types_proto_msgTypes[18]
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_api_types_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaginationResponse) String() string {
+func (x *CreateWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationResponse) ProtoMessage() {}
+func (*CreateWebhookResponse) ProtoMessage() {}
 
-func (x *PaginationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[18]
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,49 +5610,46 @@ func (x *PaginationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationResponse.ProtoReflect.Descriptor instead.
-func (*PaginationResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *PaginationResponse) GetLastPage() int32 {
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
 	if x != nil {
-		return x.LastPage
+		return x.Webhook
 	}
-	return 0
+	return nil
 }
 
-type GetSongsRequest struct {
+func (x *CreateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type GetWebhooksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Pagination queries
-	Page     *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	// Filtering queries, mutually exclusive
-	ArtistId    *string `protobuf:"bytes,3,opt,name=artist_id,json=artistId,proto3,oneof" json:"artist_id,omitempty"`
-	MatchArtist *string `protobuf:"bytes,4,opt,name=match_artist,json=matchArtist,proto3,oneof" json:"match_artist,omitempty"`
-	MatchName   *string `protobuf:"bytes,5,opt,name=match_name,json=matchName,proto3,oneof" json:"match_name,omitempty"`
-	// Ids don't work with pagination
-	Ids []string `protobuf:"bytes,6,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *GetSongsRequest) Reset() {
-	*x = GetSongsRequest{}
-	mi := &file_api_types_proto_msgTypes[19]
+func (x *GetWebhooksRequest) Reset() {
+	*x = GetWebhooksRequest{}
+	mi := &file_api_types_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSongsRequest) String() string {
+func (x *GetWebhooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSongsRequest) ProtoMessage() {}
+func (*GetWebhooksRequest) ProtoMessage() {}
 
-func (x *GetSongsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[19]
+func (x *GetWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,77 +5660,79 @@ func (x *GetSongsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSongsRequest.ProtoReflect.Descriptor instead.
-func (*GetSongsRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetSongsRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
+type GetWebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 }
 
-func (x *GetSongsRequest) GetPageSize() int32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
-	}
-	return 0
+func (x *GetWebhooksResponse) Reset() {
+	*x = GetWebhooksResponse{}
+	mi := &file_api_types_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSongsRequest) GetArtistId() string {
-	if x != nil && x.ArtistId != nil {
-		return *x.ArtistId
-	}
-	return ""
+func (x *GetWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetSongsRequest) GetMatchArtist() string {
-	if x != nil && x.MatchArtist != nil {
-		return *x.MatchArtist
+func (*GetWebhooksResponse) ProtoMessage() {}
+
+func (x *GetWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetSongsRequest) GetMatchName() string {
-	if x != nil && x.MatchName != nil {
-		return *x.MatchName
-	}
-	return ""
+// Deprecated: Use GetWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GetSongsRequest) GetIds() []string {
+func (x *GetWebhooksResponse) GetWebhooks() []*Webhook {
 	if x != nil {
-		return x.Ids
+		return x.Webhooks
 	}
 	return nil
 }
 
-type GetSongsResponse struct {
+type DeleteWebhookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Songs      []*Song             `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
-	Pagination *PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetSongsResponse) Reset() {
-	*x = GetSongsResponse{}
-	mi := &file_api_types_proto_msgTypes[20]
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_api_types_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSongsResponse) String() string {
+func (x *DeleteWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSongsResponse) ProtoMessage() {}
+func (*DeleteWebhookRequest) ProtoMessage() {}
 
-func (x *GetSongsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[20]
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,52 +5743,80 @@ func (x *GetSongsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSongsResponse.ProtoReflect.Descriptor instead.
-func (*GetSongsResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetSongsResponse) GetSongs() []*Song {
+func (x *DeleteWebhookRequest) GetId() string {
 	if x != nil {
-		return x.Songs
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSongsResponse) GetPagination() *PaginationResponse {
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_api_types_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[84]
 	if x != nil {
-		return x.Pagination
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type GetMySongsRequest struct {
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{84}
+}
+
+type GetWebhookDeliveriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Ids don't work with pagination
-	Ids []string `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
+	WebhookId string `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
 	// Pagination queries
-	Page     *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Page     *int32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
 }
 
-func (x *GetMySongsRequest) Reset() {
-	*x = GetMySongsRequest{}
-	mi := &file_api_types_proto_msgTypes[21]
+func (x *GetWebhookDeliveriesRequest) Reset() {
+	*x = GetWebhookDeliveriesRequest{}
+	mi := &file_api_types_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMySongsRequest) String() string {
+func (x *GetWebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMySongsRequest) ProtoMessage() {}
+func (*GetWebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *GetMySongsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[21]
+func (x *GetWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1397,56 +5827,56 @@ func (x *GetMySongsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMySongsRequest.ProtoReflect.Descriptor instead.
-func (*GetMySongsRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetMySongsRequest) GetIds() []string {
+func (x *GetWebhookDeliveriesRequest) GetWebhookId() string {
 	if x != nil {
-		return x.Ids
+		return x.WebhookId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMySongsRequest) GetPage() int32 {
+func (x *GetWebhookDeliveriesRequest) GetPage() int32 {
 	if x != nil && x.Page != nil {
 		return *x.Page
 	}
 	return 0
 }
 
-func (x *GetMySongsRequest) GetPageSize() int32 {
+func (x *GetWebhookDeliveriesRequest) GetPageSize() int32 {
 	if x != nil && x.PageSize != nil {
 		return *x.PageSize
 	}
 	return 0
 }
 
-type GetMySongsResponse struct {
+type GetWebhookDeliveriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Songs      []*MySong           `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+	Deliveries []*WebhookDelivery  `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 	Pagination *PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *GetMySongsResponse) Reset() {
-	*x = GetMySongsResponse{}
-	mi := &file_api_types_proto_msgTypes[22]
+func (x *GetWebhookDeliveriesResponse) Reset() {
+	*x = GetWebhookDeliveriesResponse{}
+	mi := &file_api_types_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMySongsResponse) String() string {
+func (x *GetWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMySongsResponse) ProtoMessage() {}
+func (*GetWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *GetMySongsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[22]
+func (x *GetWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,49 +5887,48 @@ func (x *GetMySongsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMySongsResponse.ProtoReflect.Descriptor instead.
-func (*GetMySongsResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetMySongsResponse) GetSongs() []*MySong {
+func (x *GetWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Songs
+		return x.Deliveries
 	}
 	return nil
 }
 
-func (x *GetMySongsResponse) GetPagination() *PaginationResponse {
+func (x *GetWebhookDeliveriesResponse) GetPagination() *PaginationResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type ReleaseSongsRequest struct {
+type RedeliverWebhookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids    []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
-	Notify bool     `protobuf:"varint,2,opt,name=notify,proto3" json:"notify,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ReleaseSongsRequest) Reset() {
-	*x = ReleaseSongsRequest{}
-	mi := &file_api_types_proto_msgTypes[23]
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_api_types_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseSongsRequest) String() string {
+func (x *RedeliverWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseSongsRequest) ProtoMessage() {}
+func (*RedeliverWebhookRequest) ProtoMessage() {}
 
-func (x *ReleaseSongsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[23]
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,46 +5939,41 @@ func (x *ReleaseSongsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseSongsRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseSongsRequest) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *ReleaseSongsRequest) GetIds() []string {
-	if x != nil {
-		return x.Ids
-	}
-	return nil
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *ReleaseSongsRequest) GetNotify() bool {
+func (x *RedeliverWebhookRequest) GetId() string {
 	if x != nil {
-		return x.Notify
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-type ReleaseSongsResponse struct {
+type RedeliverWebhookResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Delivery *WebhookDelivery `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
 }
 
-func (x *ReleaseSongsResponse) Reset() {
-	*x = ReleaseSongsResponse{}
-	mi := &file_api_types_proto_msgTypes[24]
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_api_types_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseSongsResponse) String() string {
+func (x *RedeliverWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseSongsResponse) ProtoMessage() {}
+func (*RedeliverWebhookResponse) ProtoMessage() {}
 
-func (x *ReleaseSongsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_types_proto_msgTypes[24]
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_types_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1560,9 +5984,16 @@ func (x *ReleaseSongsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseSongsResponse.ProtoReflect.Descriptor instead.
-func (*ReleaseSongsResponse) Descriptor() ([]byte, []int) {
-	return file_api_types_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_types_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
 }
 
 var File_api_types_proto protoreflect.FileDescriptor
@@ -1618,7 +6049,7 @@ var file_api_types_proto_rawDesc = []byte{
 	0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x53, 0x6f, 0x6e, 0x67, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f,
 	0x70, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x50, 0x61, 0x72, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x50, 0x61, 0x72, 0x74, 0x22, 0x8f, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
 	0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10,
 	0x01, 0x18, 0x80, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x69, 0x6d,
@@ -1628,171 +6059,808 @@ var file_api_types_proto_rawDesc = []byte{
 	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
 	0x42, 0x11, 0xfa, 0x42, 0x0e, 0x92, 0x01, 0x0b, 0x08, 0x00, 0x10, 0x10, 0x22, 0x05, 0x72, 0x03,
 	0xb0, 0x01, 0x01, 0x52, 0x0e, 0x66, 0x65, 0x61, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73,
-	0x49, 0x64, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72,
-	0x6c, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xfd, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29,
-	0x0a, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
-	0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x99, 0x02, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a,
+	0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x52, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67,
+	0x22, 0xbb, 0x02, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x88, 0x01, 0x01, 0x48, 0x00, 0x52,
+	0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01,
+	0x52, 0x08, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a,
+	0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x02, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x14,
+	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
+	0x2d, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01, 0xb0, 0x01, 0x01, 0x48,
+	0x00, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x11, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1e, 0xfa, 0x42, 0x1b, 0x92, 0x01, 0x18, 0x10, 0xfa, 0x01,
+	0x18, 0x01, 0x22, 0x11, 0x72, 0x0f, 0x32, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x5d, 0x7b, 0x32, 0x7d, 0x24, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x64, 0x65, 0x6e, 0x69, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x1e, 0xfa, 0x42, 0x1b, 0x92, 0x01, 0x18, 0x10, 0xfa, 0x01, 0x18, 0x01, 0x22, 0x11,
+	0x72, 0x0f, 0x32, 0x0d, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x7d,
+	0x24, 0x52, 0x0f, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x3a, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x10,
+	0xe8, 0x07, 0x22, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x15,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x53, 0x6f, 0x6e, 0x67,
+	0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x7f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8,
+	0x07, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x7a, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x12, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x05, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x6e,
+	0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x29, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x22, 0x81, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x01, 0x48, 0x01,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x1b, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x1e, 0x0a,
+	0x1c, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x04,
+	0x0a, 0x04, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a,
+	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x55, 0x72, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xbe, 0x07, 0x0a, 0x06, 0x4d, 0x79, 0x53, 0x6f,
+	0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x2b, 0x0a,
+	0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
 	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6f,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52,
-	0x04, 0x73, 0x6f, 0x6e, 0x67, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01,
-	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x88,
-	0x01, 0x01, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01,
-	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x4a,
-	0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x12, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x24, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12,
-	0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x05, 0x72, 0x03, 0xb0,
-	0x01, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1,
-	0x03, 0x0a, 0x04, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65,
-	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x67,
-	0x65, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x20,
+	0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x08, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x20,
 	0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01,
-	0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x09, 0x48, 0x01, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x3a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75,
-	0x72, 0x6c, 0x22, 0xf2, 0x03, 0x0a, 0x06, 0x4d, 0x79, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a,
-	0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x52, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72,
-	0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x73, 0x6f, 0x6e,
-	0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x73,
-	0x6f, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52,
-	0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12,
-	0x40, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x48, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x03, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x42, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x05, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12,
+	0x24, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0a,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
+	0x0d, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x6f, 0x6e, 0x67,
+	0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x68, 0x75, 0x6d, 0x62,
+	0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xf7, 0x01, 0x0a, 0x09, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x33, 0x0a, 0x0c, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x69, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61,
+	0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x77, 0x61,
+	0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x72, 0x22, 0x31, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0xb6, 0x04, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00,
+	0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x1a, 0x03, 0x18, 0xe8, 0x07, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72,
+	0x06, 0xd0, 0x01, 0x01, 0xb0, 0x01, 0x01, 0x48, 0x02, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30,
+	0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x72, 0x07, 0x10, 0x01, 0x18, 0x40, 0xd0, 0x01, 0x01,
+	0x48, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xfa,
+	0x42, 0x08, 0x92, 0x01, 0x05, 0x10, 0xd0, 0x0f, 0x28, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12,
+	0x3a, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06,
+	0xd0, 0x01, 0x01, 0xb0, 0x01, 0x01, 0x48, 0x05, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0c, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x72, 0x08, 0x10, 0x01, 0x18, 0x80, 0x01, 0xd0, 0x01, 0x01,
+	0x48, 0x06, 0x52, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02,
+	0x10, 0x01, 0x48, 0x07, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x6c,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x05, 0x73, 0x6f,
+	0x6e, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa2, 0x01, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x14, 0xfa, 0x42, 0x11, 0x92, 0x01, 0x0e, 0x08, 0x01, 0x10, 0xd0, 0x0f, 0x22, 0x05, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x28, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
+	0x00, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0x70, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x53,
+	0x6f, 0x6e, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x96, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x4d, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x13, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x50, 0x65, 0x72, 0x48, 0x6f,
+	0x75, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0xaf, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e,
+	0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x6e, 0x6c,
+	0x69, 0x6b, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x11, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x47, 0x61,
+	0x69, 0x6e, 0x65, 0x64, 0x22, 0x73, 0x0a, 0x0f, 0x53, 0x6f, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x09, 0x53, 0x6f,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2e,
+	0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xb7,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x31, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0xcc, 0x02, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x2a, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x1a, 0x03, 0x18, 0xe8, 0x07, 0x48, 0x01, 0x52,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x09,
-	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01, 0xb0, 0x01, 0x01, 0x48, 0x02, 0x52, 0x08,
-	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x72, 0x07, 0x10, 0x01,
-	0x18, 0x40, 0xd0, 0x01, 0x01, 0x48, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61,
-	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x92, 0x01, 0x05, 0x10, 0xd0, 0x0f, 0x28, 0x01, 0x52,
-	0x03, 0x69, 0x64, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
-	0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x37,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa2, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d,
-	0x79, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x03, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xfa, 0x42, 0x11, 0x92,
-	0x01, 0x0e, 0x08, 0x01, 0x10, 0xd0, 0x0f, 0x22, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x28, 0x01,
-	0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a,
-	0x05, 0x18, 0xe8, 0x07, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x70, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x4d, 0x79, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x79, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x05,
-	0x73, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x53,
+	0x6d, 0x70, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x28,
+	0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x22, 0xe3, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73,
+	0x47, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x22, 0x53,
 	0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x10, 0xd0, 0x0f, 0x22,
 	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e,
 	0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x74,
 	0x69, 0x66, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x6f,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x1c, 0x0a, 0x11, 0x53,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x0f, 0x46,
+	0x6c, 0x61, 0x67, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0x80, 0x08, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x12, 0x0a, 0x10,
+	0x46, 0x6c, 0x61, 0x67, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x53, 0x0a, 0x13, 0x54, 0x61, 0x6b, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x53, 0x6f, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x54, 0x61, 0x6b, 0x65, 0x44, 0x6f, 0x77,
+	0x6e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x15, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x04, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e,
+	0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x28, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0xfb,
+	0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a,
+	0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x81, 0x02, 0x0a,
+	0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06,
+	0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x61, 0x6e, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x18, 0x80, 0x02, 0x60, 0x01, 0x52, 0x0d, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x6e, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x20, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0d, 0x65, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x11, 0xfa, 0x42, 0x0e, 0x92, 0x01, 0x0b, 0x08, 0x01, 0x10, 0x10, 0x22, 0x05, 0x72, 0x03, 0x88,
+	0x01, 0x01, 0x52, 0x0c, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x73,
+	0x22, 0x37, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x29, 0x0a, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xb1, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02,
+	0x28, 0x00, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x1a, 0x03, 0x18, 0xe8, 0x07, 0x48, 0x02, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x70, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x22, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x06, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a,
+	0x18, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
+	0x80, 0x20, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3d, 0x0a,
+	0x19, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x22, 0x73, 0x0a, 0x13,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x70, 0x68, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75,
+	0x70, 0x68, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x20, 0x48, 0x00, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x6f, 0x74,
+	0x65, 0x22, 0x38, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x22, 0x5e, 0x0a, 0x15, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x03, 0x0a, 0x0d, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x01, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a,
+	0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xca, 0x03, 0x0a, 0x03, 0x4a, 0x6f,
+	0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d,
+	0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x09,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x7b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x01, 0x48, 0x01, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x6c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x6a, 0x6f,
+	0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a,
+	0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2b, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e,
+	0x0a, 0x10, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x7e,
+	0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb1,
+	0x03, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x42, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x22, 0x59, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0x18, 0x80,
+	0x10, 0x88, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x22, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01,
+	0x04, 0x08, 0x01, 0x10, 0x10, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x57, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x30, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07,
+	0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x17, 0x52, 0x65, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a,
+	0x18, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2a, 0x1c, 0x0a, 0x11, 0x53,
 	0x6f, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
 	0x12, 0x07, 0x0a, 0x03, 0x4d, 0x50, 0x33, 0x10, 0x00, 0x2a, 0x27, 0x0a, 0x12, 0x49, 0x6d, 0x61,
 	0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12,
 	0x08, 0x0a, 0x04, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4e, 0x47,
-	0x10, 0x01, 0x42, 0x78, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x42, 0x0a, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74,
-	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x72, 0x6a, 0x61, 0x37, 0x32, 0x2e, 0x72, 0x75, 0x2f, 0x67, 0x6f,
-	0x73, 0x70, 0x65, 0x63, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x30, 0x32, 0x2f, 0x73, 0x6f, 0x6e, 0x67,
-	0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x61,
-	0x70, 0x69, 0xa2, 0x02, 0x03, 0x41, 0x58, 0x58, 0xaa, 0x02, 0x03, 0x41, 0x70, 0x69, 0xca, 0x02,
-	0x03, 0x41, 0x70, 0x69, 0xe2, 0x02, 0x0f, 0x41, 0x70, 0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x03, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x10, 0x01, 0x2a, 0x66, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x0c, 0x0a, 0x08, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50,
+	0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d,
+	0x50, 0x4f, 0x53, 0x45, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x59, 0x52, 0x49, 0x43,
+	0x49, 0x53, 0x54, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x49, 0x58, 0x49, 0x4e, 0x47, 0x5f,
+	0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x4c, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x52,
+	0x45, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x44, 0x45,
+	0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x6b, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f,
+	0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x55, 0x44, 0x49, 0x4f,
+	0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
+	0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x59, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x40, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x52,
+	0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x4b, 0x45, 0x4e,
+	0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x2a, 0x1f, 0x0a, 0x0b, 0x42, 0x69, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x42, 0x52, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x56, 0x42, 0x52, 0x10, 0x01, 0x2a, 0x47, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x45, 0x52, 0x45,
+	0x4f, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4a, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x45,
+	0x52, 0x45, 0x4f, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x55, 0x41, 0x4c, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x4f, 0x4e, 0x4f, 0x10,
+	0x03, 0x2a, 0x2c, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x01, 0x2a,
+	0x49, 0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x43, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x48, 0x45, 0x4c, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x5b, 0x0a, 0x0c, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x3a, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x0a, 0x4a, 0x4f, 0x42, 0x5f, 0x51, 0x55, 0x45, 0x55,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x4f, 0x42, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x4f, 0x42, 0x5f, 0x44, 0x45, 0x41,
+	0x44, 0x10, 0x02, 0x2a, 0x72, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18,
+	0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x45,
+	0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x44,
+	0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x45,
+	0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x42, 0x78, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x35, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x72, 0x6a, 0x61, 0x37, 0x32, 0x2e,
+	0x72, 0x75, 0x2f, 0x67, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x30, 0x32,
+	0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x67, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0xa2, 0x02, 0x03, 0x41, 0x58, 0x58, 0xaa, 0x02, 0x03,
+	0x41, 0x70, 0x69, 0xca, 0x02, 0x03, 0x41, 0x70, 0x69, 0xe2, 0x02, 0x0f, 0x41, 0x70, 0x69, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x03, 0x41, 0x70,
+	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1807,66 +6875,213 @@ func file_api_types_proto_rawDescGZIP() []byte {
 	return file_api_types_proto_rawDescData
 }
 
-var file_api_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_types_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_api_types_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_api_types_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_api_types_proto_goTypes = []any{
-	(SongFileExtension)(0),             // 0: api.SongFileExtension
-	(ImageFileExtension)(0),            // 1: api.ImageFileExtension
-	(*UploadRawSongRequest)(nil),       // 2: api.UploadRawSongRequest
-	(*UploadRawSongResponse)(nil),      // 3: api.UploadRawSongResponse
-	(*GetRawSongRequest)(nil),          // 4: api.GetRawSongRequest
-	(*GetRawSongResponse)(nil),         // 5: api.GetRawSongResponse
-	(*UploadRawSongImageRequest)(nil),  // 6: api.UploadRawSongImageRequest
-	(*UploadRawSongImageResponse)(nil), // 7: api.UploadRawSongImageResponse
-	(*GetRawSongImageRequest)(nil),     // 8: api.GetRawSongImageRequest
-	(*GetRawSongImageResponse)(nil),    // 9: api.GetRawSongImageResponse
-	(*CreateSongRequest)(nil),          // 10: api.CreateSongRequest
-	(*CreateSongResponse)(nil),         // 11: api.CreateSongResponse
-	(*GetSongRequest)(nil),             // 12: api.GetSongRequest
-	(*GetSongResponse)(nil),            // 13: api.GetSongResponse
-	(*UpdateSongRequest)(nil),          // 14: api.UpdateSongRequest
-	(*UpdateSongResponse)(nil),         // 15: api.UpdateSongResponse
-	(*DeleteSongsRequest)(nil),         // 16: api.DeleteSongsRequest
-	(*DeleteSongsResponse)(nil),        // 17: api.DeleteSongsResponse
-	(*Song)(nil),                       // 18: api.Song
-	(*MySong)(nil),                     // 19: api.MySong
-	(*PaginationResponse)(nil),         // 20: api.PaginationResponse
-	(*GetSongsRequest)(nil),            // 21: api.GetSongsRequest
-	(*GetSongsResponse)(nil),           // 22: api.GetSongsResponse
-	(*GetMySongsRequest)(nil),          // 23: api.GetMySongsRequest
-	(*GetMySongsResponse)(nil),         // 24: api.GetMySongsResponse
-	(*ReleaseSongsRequest)(nil),        // 25: api.ReleaseSongsRequest
-	(*ReleaseSongsResponse)(nil),       // 26: api.ReleaseSongsResponse
-	(*users.Artist)(nil),               // 27: users_api.Artist
-	(*timestamppb.Timestamp)(nil),      // 28: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),        // 29: google.protobuf.Duration
+	(SongFileExtension)(0),               // 0: api.SongFileExtension
+	(ImageFileExtension)(0),              // 1: api.ImageFileExtension
+	(CreditRole)(0),                      // 2: api.CreditRole
+	(CreditStatus)(0),                    // 3: api.CreditStatus
+	(AudioState)(0),                      // 4: api.AudioState
+	(ModerationStatus)(0),                // 5: api.ModerationStatus
+	(BitrateMode)(0),                     // 6: api.BitrateMode
+	(ChannelMode)(0),                     // 7: api.ChannelMode
+	(StatsBucket)(0),                     // 8: api.StatsBucket
+	(ClaimStatus)(0),                     // 9: api.ClaimStatus
+	(ExportStatus)(0),                    // 10: api.ExportStatus
+	(JobStatus)(0),                       // 11: api.JobStatus
+	(WebhookDeliveryStatus)(0),           // 12: api.WebhookDeliveryStatus
+	(*UploadRawSongRequest)(nil),         // 13: api.UploadRawSongRequest
+	(*UploadRawSongResponse)(nil),        // 14: api.UploadRawSongResponse
+	(*GetRawSongRequest)(nil),            // 15: api.GetRawSongRequest
+	(*GetRawSongResponse)(nil),           // 16: api.GetRawSongResponse
+	(*UploadRawSongImageRequest)(nil),    // 17: api.UploadRawSongImageRequest
+	(*UploadRawSongImageResponse)(nil),   // 18: api.UploadRawSongImageResponse
+	(*GetRawSongImageRequest)(nil),       // 19: api.GetRawSongImageRequest
+	(*GetRawSongImageResponse)(nil),      // 20: api.GetRawSongImageResponse
+	(*CreateSongRequest)(nil),            // 21: api.CreateSongRequest
+	(*CreateSongResponse)(nil),           // 22: api.CreateSongResponse
+	(*GetSongRequest)(nil),               // 23: api.GetSongRequest
+	(*GetSongResponse)(nil),              // 24: api.GetSongResponse
+	(*UpdateSongRequest)(nil),            // 25: api.UpdateSongRequest
+	(*UpdateSongResponse)(nil),           // 26: api.UpdateSongResponse
+	(*Credit)(nil),                       // 27: api.Credit
+	(*CreditList)(nil),                   // 28: api.CreditList
+	(*RegionRestrictions)(nil),           // 29: api.RegionRestrictions
+	(*DeleteSongsRequest)(nil),           // 30: api.DeleteSongsRequest
+	(*DeleteSongsResponse)(nil),          // 31: api.DeleteSongsResponse
+	(*TrashedSong)(nil),                  // 32: api.TrashedSong
+	(*GetTrashedSongsRequest)(nil),       // 33: api.GetTrashedSongsRequest
+	(*GetTrashedSongsResponse)(nil),      // 34: api.GetTrashedSongsResponse
+	(*RestoreTrashedSongsRequest)(nil),   // 35: api.RestoreTrashedSongsRequest
+	(*RestoreTrashedSongsResponse)(nil),  // 36: api.RestoreTrashedSongsResponse
+	(*CreditRequest)(nil),                // 37: api.CreditRequest
+	(*GetCreditRequestsRequest)(nil),     // 38: api.GetCreditRequestsRequest
+	(*GetCreditRequestsResponse)(nil),    // 39: api.GetCreditRequestsResponse
+	(*ResolveCreditRequestRequest)(nil),  // 40: api.ResolveCreditRequestRequest
+	(*ResolveCreditRequestResponse)(nil), // 41: api.ResolveCreditRequestResponse
+	(*Song)(nil),                         // 42: api.Song
+	(*MySong)(nil),                       // 43: api.MySong
+	(*AudioInfo)(nil),                    // 44: api.AudioInfo
+	(*PaginationResponse)(nil),           // 45: api.PaginationResponse
+	(*GetSongsRequest)(nil),              // 46: api.GetSongsRequest
+	(*GetSongsResponse)(nil),             // 47: api.GetSongsResponse
+	(*GetMySongsRequest)(nil),            // 48: api.GetMySongsRequest
+	(*GetMySongsResponse)(nil),           // 49: api.GetMySongsResponse
+	(*GetMyUsageRequest)(nil),            // 50: api.GetMyUsageRequest
+	(*GetMyUsageResponse)(nil),           // 51: api.GetMyUsageResponse
+	(*StatsCounters)(nil),                // 52: api.StatsCounters
+	(*ArtistStatsBucket)(nil),            // 53: api.ArtistStatsBucket
+	(*SongStatsBucket)(nil),              // 54: api.SongStatsBucket
+	(*SongStats)(nil),                    // 55: api.SongStats
+	(*GetArtistStatsRequest)(nil),        // 56: api.GetArtistStatsRequest
+	(*GetArtistStatsResponse)(nil),       // 57: api.GetArtistStatsResponse
+	(*ReleaseSongsRequest)(nil),          // 58: api.ReleaseSongsRequest
+	(*ReleaseSongsResponse)(nil),         // 59: api.ReleaseSongsResponse
+	(*FlagSongRequest)(nil),              // 60: api.FlagSongRequest
+	(*FlagSongResponse)(nil),             // 61: api.FlagSongResponse
+	(*TakeDownSongRequest)(nil),          // 62: api.TakeDownSongRequest
+	(*TakeDownSongResponse)(nil),         // 63: api.TakeDownSongResponse
+	(*RestoreSongRequest)(nil),           // 64: api.RestoreSongRequest
+	(*RestoreSongResponse)(nil),          // 65: api.RestoreSongResponse
+	(*Claim)(nil),                        // 66: api.Claim
+	(*ClaimEvent)(nil),                   // 67: api.ClaimEvent
+	(*SubmitClaimRequest)(nil),           // 68: api.SubmitClaimRequest
+	(*SubmitClaimResponse)(nil),          // 69: api.SubmitClaimResponse
+	(*GetClaimRequest)(nil),              // 70: api.GetClaimRequest
+	(*GetClaimResponse)(nil),             // 71: api.GetClaimResponse
+	(*GetClaimsRequest)(nil),             // 72: api.GetClaimsRequest
+	(*GetClaimsResponse)(nil),            // 73: api.GetClaimsResponse
+	(*FileCounterNoticeRequest)(nil),     // 74: api.FileCounterNoticeRequest
+	(*FileCounterNoticeResponse)(nil),    // 75: api.FileCounterNoticeResponse
+	(*ResolveClaimRequest)(nil),          // 76: api.ResolveClaimRequest
+	(*ResolveClaimResponse)(nil),         // 77: api.ResolveClaimResponse
+	(*VerifyClaimantRequest)(nil),        // 78: api.VerifyClaimantRequest
+	(*VerifyClaimantResponse)(nil),       // 79: api.VerifyClaimantResponse
+	(*CatalogExport)(nil),                // 80: api.CatalogExport
+	(*ExportCatalogRequest)(nil),         // 81: api.ExportCatalogRequest
+	(*ExportCatalogResponse)(nil),        // 82: api.ExportCatalogResponse
+	(*GetCatalogExportsRequest)(nil),     // 83: api.GetCatalogExportsRequest
+	(*GetCatalogExportsResponse)(nil),    // 84: api.GetCatalogExportsResponse
+	(*Job)(nil),                          // 85: api.Job
+	(*GetDeadJobsRequest)(nil),           // 86: api.GetDeadJobsRequest
+	(*GetDeadJobsResponse)(nil),          // 87: api.GetDeadJobsResponse
+	(*RetryJobRequest)(nil),              // 88: api.RetryJobRequest
+	(*RetryJobResponse)(nil),             // 89: api.RetryJobResponse
+	(*Webhook)(nil),                      // 90: api.Webhook
+	(*WebhookDelivery)(nil),              // 91: api.WebhookDelivery
+	(*CreateWebhookRequest)(nil),         // 92: api.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),        // 93: api.CreateWebhookResponse
+	(*GetWebhooksRequest)(nil),           // 94: api.GetWebhooksRequest
+	(*GetWebhooksResponse)(nil),          // 95: api.GetWebhooksResponse
+	(*DeleteWebhookRequest)(nil),         // 96: api.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),        // 97: api.DeleteWebhookResponse
+	(*GetWebhookDeliveriesRequest)(nil),  // 98: api.GetWebhookDeliveriesRequest
+	(*GetWebhookDeliveriesResponse)(nil), // 99: api.GetWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),      // 100: api.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),     // 101: api.RedeliverWebhookResponse
+	(*users.Artist)(nil),                 // 102: users_api.Artist
+	(*timestamppb.Timestamp)(nil),        // 103: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 104: google.protobuf.Duration
 }
 var file_api_types_proto_depIdxs = []int32{
-	0,  // 0: api.UploadRawSongRequest.extension:type_name -> api.SongFileExtension
-	1,  // 1: api.UploadRawSongImageRequest.extension:type_name -> api.ImageFileExtension
-	27, // 2: api.CreateSongResponse.singer:type_name -> users_api.Artist
-	27, // 3: api.CreateSongResponse.artists:type_name -> users_api.Artist
-	28, // 4: api.CreateSongResponse.uploaded_at:type_name -> google.protobuf.Timestamp
-	18, // 5: api.GetSongResponse.song:type_name -> api.Song
-	27, // 6: api.Song.singer:type_name -> users_api.Artist
-	27, // 7: api.Song.artists:type_name -> users_api.Artist
-	29, // 8: api.Song.duration:type_name -> google.protobuf.Duration
-	28, // 9: api.Song.released_at:type_name -> google.protobuf.Timestamp
-	28, // 10: api.Song.uploaded_at:type_name -> google.protobuf.Timestamp
-	27, // 11: api.MySong.singer:type_name -> users_api.Artist
-	27, // 12: api.MySong.artists:type_name -> users_api.Artist
-	29, // 13: api.MySong.duration:type_name -> google.protobuf.Duration
-	28, // 14: api.MySong.released_at:type_name -> google.protobuf.Timestamp
-	28, // 15: api.MySong.uploaded_at:type_name -> google.protobuf.Timestamp
-	18, // 16: api.GetSongsResponse.songs:type_name -> api.Song
-	20, // 17: api.GetSongsResponse.pagination:type_name -> api.PaginationResponse
-	19, // 18: api.GetMySongsResponse.songs:type_name -> api.MySong
-	20, // 19: api.GetMySongsResponse.pagination:type_name -> api.PaginationResponse
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	0,   // 0: api.UploadRawSongRequest.extension:type_name -> api.SongFileExtension
+	1,   // 1: api.UploadRawSongImageRequest.extension:type_name -> api.ImageFileExtension
+	27,  // 2: api.CreateSongRequest.credits:type_name -> api.Credit
+	102, // 3: api.CreateSongResponse.singer:type_name -> users_api.Artist
+	102, // 4: api.CreateSongResponse.artists:type_name -> users_api.Artist
+	103, // 5: api.CreateSongResponse.uploaded_at:type_name -> google.protobuf.Timestamp
+	42,  // 6: api.GetSongResponse.song:type_name -> api.Song
+	29,  // 7: api.UpdateSongRequest.regions:type_name -> api.RegionRestrictions
+	28,  // 8: api.UpdateSongRequest.credits:type_name -> api.CreditList
+	2,   // 9: api.Credit.role:type_name -> api.CreditRole
+	3,   // 10: api.Credit.status:type_name -> api.CreditStatus
+	27,  // 11: api.CreditList.credits:type_name -> api.Credit
+	43,  // 12: api.TrashedSong.song:type_name -> api.MySong
+	103, // 13: api.TrashedSong.deleted_at:type_name -> google.protobuf.Timestamp
+	103, // 14: api.TrashedSong.restorable_until:type_name -> google.protobuf.Timestamp
+	32,  // 15: api.GetTrashedSongsResponse.songs:type_name -> api.TrashedSong
+	45,  // 16: api.GetTrashedSongsResponse.pagination:type_name -> api.PaginationResponse
+	102, // 17: api.CreditRequest.singer:type_name -> users_api.Artist
+	2,   // 18: api.CreditRequest.role:type_name -> api.CreditRole
+	37,  // 19: api.GetCreditRequestsResponse.requests:type_name -> api.CreditRequest
+	45,  // 20: api.GetCreditRequestsResponse.pagination:type_name -> api.PaginationResponse
+	102, // 21: api.Song.singer:type_name -> users_api.Artist
+	102, // 22: api.Song.artists:type_name -> users_api.Artist
+	104, // 23: api.Song.duration:type_name -> google.protobuf.Duration
+	103, // 24: api.Song.released_at:type_name -> google.protobuf.Timestamp
+	103, // 25: api.Song.uploaded_at:type_name -> google.protobuf.Timestamp
+	27,  // 26: api.Song.credits:type_name -> api.Credit
+	102, // 27: api.MySong.singer:type_name -> users_api.Artist
+	102, // 28: api.MySong.artists:type_name -> users_api.Artist
+	104, // 29: api.MySong.duration:type_name -> google.protobuf.Duration
+	103, // 30: api.MySong.released_at:type_name -> google.protobuf.Timestamp
+	103, // 31: api.MySong.uploaded_at:type_name -> google.protobuf.Timestamp
+	5,   // 32: api.MySong.moderation_status:type_name -> api.ModerationStatus
+	29,  // 33: api.MySong.regions:type_name -> api.RegionRestrictions
+	27,  // 34: api.MySong.credits:type_name -> api.Credit
+	44,  // 35: api.MySong.audio:type_name -> api.AudioInfo
+	4,   // 36: api.MySong.audio_state:type_name -> api.AudioState
+	6,   // 37: api.AudioInfo.bitrate_mode:type_name -> api.BitrateMode
+	7,   // 38: api.AudioInfo.channel_mode:type_name -> api.ChannelMode
+	2,   // 39: api.GetSongsRequest.credit_role:type_name -> api.CreditRole
+	42,  // 40: api.GetSongsResponse.songs:type_name -> api.Song
+	45,  // 41: api.GetSongsResponse.pagination:type_name -> api.PaginationResponse
+	43,  // 42: api.GetMySongsResponse.songs:type_name -> api.MySong
+	45,  // 43: api.GetMySongsResponse.pagination:type_name -> api.PaginationResponse
+	103, // 44: api.ArtistStatsBucket.start:type_name -> google.protobuf.Timestamp
+	52,  // 45: api.ArtistStatsBucket.counters:type_name -> api.StatsCounters
+	103, // 46: api.SongStatsBucket.start:type_name -> google.protobuf.Timestamp
+	52,  // 47: api.SongStatsBucket.counters:type_name -> api.StatsCounters
+	52,  // 48: api.SongStats.total:type_name -> api.StatsCounters
+	54,  // 49: api.SongStats.buckets:type_name -> api.SongStatsBucket
+	103, // 50: api.GetArtistStatsRequest.from:type_name -> google.protobuf.Timestamp
+	103, // 51: api.GetArtistStatsRequest.to:type_name -> google.protobuf.Timestamp
+	8,   // 52: api.GetArtistStatsRequest.bucket:type_name -> api.StatsBucket
+	52,  // 53: api.GetArtistStatsResponse.total:type_name -> api.StatsCounters
+	53,  // 54: api.GetArtistStatsResponse.buckets:type_name -> api.ArtistStatsBucket
+	55,  // 55: api.GetArtistStatsResponse.songs:type_name -> api.SongStats
+	9,   // 56: api.Claim.status:type_name -> api.ClaimStatus
+	103, // 57: api.Claim.created_at:type_name -> google.protobuf.Timestamp
+	103, // 58: api.Claim.updated_at:type_name -> google.protobuf.Timestamp
+	9,   // 59: api.ClaimEvent.from_status:type_name -> api.ClaimStatus
+	9,   // 60: api.ClaimEvent.to_status:type_name -> api.ClaimStatus
+	103, // 61: api.ClaimEvent.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 62: api.SubmitClaimResponse.claim:type_name -> api.Claim
+	66,  // 63: api.GetClaimResponse.claim:type_name -> api.Claim
+	67,  // 64: api.GetClaimResponse.history:type_name -> api.ClaimEvent
+	9,   // 65: api.GetClaimsRequest.status:type_name -> api.ClaimStatus
+	66,  // 66: api.GetClaimsResponse.claims:type_name -> api.Claim
+	45,  // 67: api.GetClaimsResponse.pagination:type_name -> api.PaginationResponse
+	66,  // 68: api.FileCounterNoticeResponse.claim:type_name -> api.Claim
+	66,  // 69: api.ResolveClaimResponse.claim:type_name -> api.Claim
+	10,  // 70: api.CatalogExport.status:type_name -> api.ExportStatus
+	103, // 71: api.CatalogExport.created_at:type_name -> google.protobuf.Timestamp
+	103, // 72: api.CatalogExport.finished_at:type_name -> google.protobuf.Timestamp
+	103, // 73: api.CatalogExport.expires_at:type_name -> google.protobuf.Timestamp
+	80,  // 74: api.ExportCatalogResponse.export:type_name -> api.CatalogExport
+	80,  // 75: api.GetCatalogExportsResponse.exports:type_name -> api.CatalogExport
+	11,  // 76: api.Job.status:type_name -> api.JobStatus
+	103, // 77: api.Job.run_at:type_name -> google.protobuf.Timestamp
+	103, // 78: api.Job.created_at:type_name -> google.protobuf.Timestamp
+	103, // 79: api.Job.failed_at:type_name -> google.protobuf.Timestamp
+	85,  // 80: api.GetDeadJobsResponse.jobs:type_name -> api.Job
+	45,  // 81: api.GetDeadJobsResponse.pagination:type_name -> api.PaginationResponse
+	85,  // 82: api.RetryJobResponse.job:type_name -> api.Job
+	103, // 83: api.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	12,  // 84: api.WebhookDelivery.status:type_name -> api.WebhookDeliveryStatus
+	103, // 85: api.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	103, // 86: api.WebhookDelivery.attempted_at:type_name -> google.protobuf.Timestamp
+	90,  // 87: api.CreateWebhookResponse.webhook:type_name -> api.Webhook
+	90,  // 88: api.GetWebhooksResponse.webhooks:type_name -> api.Webhook
+	91,  // 89: api.GetWebhookDeliveriesResponse.deliveries:type_name -> api.WebhookDelivery
+	45,  // 90: api.GetWebhookDeliveriesResponse.pagination:type_name -> api.PaginationResponse
+	91,  // 91: api.RedeliverWebhookResponse.delivery:type_name -> api.WebhookDelivery
+	92,  // [92:92] is the sub-list for method output_type
+	92,  // [92:92] is the sub-list for method input_type
+	92,  // [92:92] is the sub-list for extension type_name
+	92,  // [92:92] is the sub-list for extension extendee
+	0,   // [0:92] is the sub-list for field type_name
 }
 
 func init() { file_api_types_proto_init() }
@@ -1877,17 +7092,32 @@ func file_api_types_proto_init() {
 	file_api_types_proto_msgTypes[8].OneofWrappers = []any{}
 	file_api_types_proto_msgTypes[9].OneofWrappers = []any{}
 	file_api_types_proto_msgTypes[12].OneofWrappers = []any{}
-	file_api_types_proto_msgTypes[16].OneofWrappers = []any{}
-	file_api_types_proto_msgTypes[17].OneofWrappers = []any{}
-	file_api_types_proto_msgTypes[19].OneofWrappers = []any{}
-	file_api_types_proto_msgTypes[21].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[14].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[20].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[25].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[29].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[30].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[31].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[33].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[35].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[43].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[51].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[53].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[54].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[59].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[63].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[67].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[72].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[73].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[78].OneofWrappers = []any{}
+	file_api_types_proto_msgTypes[85].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_types_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   25,
+			NumEnums:      13,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
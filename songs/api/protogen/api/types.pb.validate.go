@@ -997,6 +997,51 @@ func (m *CreateSongRequest) validate(all bool) error {
 
 	}
 
+	if len(m.GetCredits()) > 100 {
+		err := CreateSongRequestValidationError{
+			field:  "Credits",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateSongRequestValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateSongRequestValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateSongRequestValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.ImageUrl != nil {
 
 		if uri, err := url.Parse(m.GetImageUrl()); err != nil {
@@ -1022,6 +1067,10 @@ func (m *CreateSongRequest) validate(all bool) error {
 
 	}
 
+	if m.Explicit != nil {
+		// no validation rules for Explicit
+	}
+
 	if len(errors) > 0 {
 		return CreateSongRequestMultiError(errors)
 	}
@@ -1228,6 +1277,8 @@ func (m *CreateSongResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Explicit
+
 	if m.ImageUrl != nil {
 		// no validation rules for ImageUrl
 	}
@@ -1631,6 +1682,76 @@ func (m *UpdateSongRequest) validate(all bool) error {
 
 	}
 
+	if m.Explicit != nil {
+		// no validation rules for Explicit
+	}
+
+	if m.Regions != nil {
+
+		if all {
+			switch v := interface{}(m.GetRegions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateSongRequestValidationError{
+						field:  "Regions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateSongRequestValidationError{
+						field:  "Regions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRegions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateSongRequestValidationError{
+					field:  "Regions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Credits != nil {
+
+		if all {
+			switch v := interface{}(m.GetCredits()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateSongRequestValidationError{
+						field:  "Credits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateSongRequestValidationError{
+						field:  "Credits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCredits()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateSongRequestValidationError{
+					field:  "Credits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UpdateSongRequestMultiError(errors)
 	}
@@ -1821,32 +1942,31 @@ var _ interface {
 	ErrorName() string
 } = UpdateSongResponseValidationError{}
 
-// Validate checks the field values on DeleteSongsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSongsRequest) Validate() error {
+// Validate checks the field values on Credit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Credit) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSongsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteSongsRequestMultiError, or nil if none found.
-func (m *DeleteSongsRequest) ValidateAll() error {
+// ValidateAll checks the field values on Credit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CreditMultiError, or nil if none found.
+func (m *Credit) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSongsRequest) validate(all bool) error {
+func (m *Credit) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := len(m.GetIds()); l < 1 || l > 1000 {
-		err := DeleteSongsRequestValidationError{
-			field:  "Ids",
-			reason: "value must contain between 1 and 1000 items, inclusive",
+	if utf8.RuneCountInString(m.GetName()) > 128 {
+		err := CreditValidationError{
+			field:  "Name",
+			reason: "value length must be at most 128 runes",
 		}
 		if !all {
 			return err
@@ -1854,31 +1974,47 @@ func (m *DeleteSongsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetIds() {
-		_, _ = idx, item
+	if _, ok := CreditRole_name[int32(m.GetRole())]; !ok {
+		err := CreditValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if err := m._validateUuid(item); err != nil {
-			err = DeleteSongsRequestValidationError{
-				field:  fmt.Sprintf("Ids[%v]", idx),
-				reason: "value must be a valid UUID",
-				cause:  err,
-			}
-			if !all {
-				return err
+	// no validation rules for Status
+
+	if m.ArtistId != nil {
+
+		if m.GetArtistId() != "" {
+
+			if err := m._validateUuid(m.GetArtistId()); err != nil {
+				err = CreditValidationError{
+					field:  "ArtistId",
+					reason: "value must be a valid UUID",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
 			}
-			errors = append(errors, err)
+
 		}
 
 	}
 
 	if len(errors) > 0 {
-		return DeleteSongsRequestMultiError(errors)
+		return CreditMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *DeleteSongsRequest) _validateUuid(uuid string) error {
+func (m *Credit) _validateUuid(uuid string) error {
 	if matched := _types_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -1886,13 +2022,12 @@ func (m *DeleteSongsRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// DeleteSongsRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteSongsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteSongsRequestMultiError []error
+// CreditMultiError is an error wrapping multiple validation errors returned by
+// Credit.ValidateAll() if the designated constraints aren't met.
+type CreditMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSongsRequestMultiError) Error() string {
+func (m CreditMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1901,11 +2036,11 @@ func (m DeleteSongsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSongsRequestMultiError) AllErrors() []error { return m }
+func (m CreditMultiError) AllErrors() []error { return m }
 
-// DeleteSongsRequestValidationError is the validation error returned by
-// DeleteSongsRequest.Validate if the designated constraints aren't met.
-type DeleteSongsRequestValidationError struct {
+// CreditValidationError is the validation error returned by Credit.Validate if
+// the designated constraints aren't met.
+type CreditValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1913,24 +2048,22 @@ type DeleteSongsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSongsRequestValidationError) Field() string { return e.field }
+func (e CreditValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSongsRequestValidationError) Reason() string { return e.reason }
+func (e CreditValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSongsRequestValidationError) Cause() error { return e.cause }
+func (e CreditValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSongsRequestValidationError) Key() bool { return e.key }
+func (e CreditValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSongsRequestValidationError) ErrorName() string {
-	return "DeleteSongsRequestValidationError"
-}
+func (e CreditValidationError) ErrorName() string { return "CreditValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteSongsRequestValidationError) Error() string {
+func (e CreditValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1942,14 +2075,14 @@ func (e DeleteSongsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSongsRequest.%s: %s%s",
+		"invalid %sCredit.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSongsRequestValidationError{}
+var _ error = CreditValidationError{}
 
 var _ interface {
 	Field() string
@@ -1957,44 +2090,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSongsRequestValidationError{}
+} = CreditValidationError{}
 
-// Validate checks the field values on DeleteSongsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSongsResponse) Validate() error {
+// Validate checks the field values on CreditList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreditList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSongsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteSongsResponseMultiError, or nil if none found.
-func (m *DeleteSongsResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreditList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreditListMultiError, or
+// nil if none found.
+func (m *CreditList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSongsResponse) validate(all bool) error {
+func (m *CreditList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if len(m.GetCredits()) > 100 {
+		err := CreditListValidationError{
+			field:  "Credits",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreditListValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreditListValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreditListValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeleteSongsResponseMultiError(errors)
+		return CreditListMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteSongsResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteSongsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteSongsResponseMultiError []error
+// CreditListMultiError is an error wrapping multiple validation errors
+// returned by CreditList.ValidateAll() if the designated constraints aren't met.
+type CreditListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSongsResponseMultiError) Error() string {
+func (m CreditListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2003,11 +2180,11 @@ func (m DeleteSongsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSongsResponseMultiError) AllErrors() []error { return m }
+func (m CreditListMultiError) AllErrors() []error { return m }
 
-// DeleteSongsResponseValidationError is the validation error returned by
-// DeleteSongsResponse.Validate if the designated constraints aren't met.
-type DeleteSongsResponseValidationError struct {
+// CreditListValidationError is the validation error returned by
+// CreditList.Validate if the designated constraints aren't met.
+type CreditListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2015,24 +2192,22 @@ type DeleteSongsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSongsResponseValidationError) Field() string { return e.field }
+func (e CreditListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSongsResponseValidationError) Reason() string { return e.reason }
+func (e CreditListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSongsResponseValidationError) Cause() error { return e.cause }
+func (e CreditListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSongsResponseValidationError) Key() bool { return e.key }
+func (e CreditListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSongsResponseValidationError) ErrorName() string {
-	return "DeleteSongsResponseValidationError"
-}
+func (e CreditListValidationError) ErrorName() string { return "CreditListValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteSongsResponseValidationError) Error() string {
+func (e CreditListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2044,14 +2219,14 @@ func (e DeleteSongsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSongsResponse.%s: %s%s",
+		"invalid %sCreditList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSongsResponseValidationError{}
+var _ error = CreditListValidationError{}
 
 var _ interface {
 	Field() string
@@ -2059,86 +2234,9305 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSongsResponseValidationError{}
+} = CreditListValidationError{}
 
-// Validate checks the field values on Song with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Song) Validate() error {
+// Validate checks the field values on RegionRestrictions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegionRestrictions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Song with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in SongMultiError, or nil if none found.
-func (m *Song) ValidateAll() error {
+// ValidateAll checks the field values on RegionRestrictions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegionRestrictionsMultiError, or nil if none found.
+func (m *RegionRestrictions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Song) validate(all bool) error {
+func (m *RegionRestrictions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if len(m.GetAllowedCountries()) > 250 {
+		err := RegionRestrictionsValidationError{
+			field:  "AllowedCountries",
+			reason: "value must contain no more than 250 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetSinger()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SongValidationError{
-					field:  "Singer",
-					reason: "embedded message failed validation",
-					cause:  err,
+	_RegionRestrictions_AllowedCountries_Unique := make(map[string]struct{}, len(m.GetAllowedCountries()))
+
+	for idx, item := range m.GetAllowedCountries() {
+		_, _ = idx, item
+
+		if _, exists := _RegionRestrictions_AllowedCountries_Unique[item]; exists {
+			err := RegionRestrictionsValidationError{
+				field:  fmt.Sprintf("AllowedCountries[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RegionRestrictions_AllowedCountries_Unique[item] = struct{}{}
+		}
+
+		if !_RegionRestrictions_AllowedCountries_Pattern.MatchString(item) {
+			err := RegionRestrictionsValidationError{
+				field:  fmt.Sprintf("AllowedCountries[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetDeniedCountries()) > 250 {
+		err := RegionRestrictionsValidationError{
+			field:  "DeniedCountries",
+			reason: "value must contain no more than 250 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_RegionRestrictions_DeniedCountries_Unique := make(map[string]struct{}, len(m.GetDeniedCountries()))
+
+	for idx, item := range m.GetDeniedCountries() {
+		_, _ = idx, item
+
+		if _, exists := _RegionRestrictions_DeniedCountries_Unique[item]; exists {
+			err := RegionRestrictionsValidationError{
+				field:  fmt.Sprintf("DeniedCountries[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RegionRestrictions_DeniedCountries_Unique[item] = struct{}{}
+		}
+
+		if !_RegionRestrictions_DeniedCountries_Pattern.MatchString(item) {
+			err := RegionRestrictionsValidationError{
+				field:  fmt.Sprintf("DeniedCountries[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RegionRestrictionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegionRestrictionsMultiError is an error wrapping multiple validation errors
+// returned by RegionRestrictions.ValidateAll() if the designated constraints
+// aren't met.
+type RegionRestrictionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegionRestrictionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegionRestrictionsMultiError) AllErrors() []error { return m }
+
+// RegionRestrictionsValidationError is the validation error returned by
+// RegionRestrictions.Validate if the designated constraints aren't met.
+type RegionRestrictionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegionRestrictionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegionRestrictionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegionRestrictionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegionRestrictionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegionRestrictionsValidationError) ErrorName() string {
+	return "RegionRestrictionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegionRestrictionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegionRestrictions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegionRestrictionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegionRestrictionsValidationError{}
+
+var _RegionRestrictions_AllowedCountries_Pattern = regexp.MustCompile("^[A-Za-z]{2}$")
+
+var _RegionRestrictions_DeniedCountries_Pattern = regexp.MustCompile("^[A-Za-z]{2}$")
+
+// Validate checks the field values on DeleteSongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSongsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSongsRequestMultiError, or nil if none found.
+func (m *DeleteSongsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSongsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetIds()); l < 1 || l > 1000 {
+		err := DeleteSongsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = DeleteSongsRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteSongsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteSongsRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteSongsRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteSongsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteSongsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSongsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSongsRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSongsRequestValidationError is the validation error returned by
+// DeleteSongsRequest.Validate if the designated constraints aren't met.
+type DeleteSongsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSongsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSongsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSongsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSongsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSongsRequestValidationError) ErrorName() string {
+	return "DeleteSongsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSongsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSongsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSongsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSongsRequestValidationError{}
+
+// Validate checks the field values on DeleteSongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSongsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSongsResponseMultiError, or nil if none found.
+func (m *DeleteSongsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSongsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSongsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSongsResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSongsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSongsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSongsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSongsResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSongsResponseValidationError is the validation error returned by
+// DeleteSongsResponse.Validate if the designated constraints aren't met.
+type DeleteSongsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSongsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSongsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSongsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSongsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSongsResponseValidationError) ErrorName() string {
+	return "DeleteSongsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSongsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSongsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSongsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSongsResponseValidationError{}
+
+// Validate checks the field values on TrashedSong with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrashedSong) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrashedSong with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrashedSongMultiError, or
+// nil if none found.
+func (m *TrashedSong) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrashedSong) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSong()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrashedSongValidationError{
+					field:  "Song",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrashedSongValidationError{
+					field:  "Song",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSong()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrashedSongValidationError{
+				field:  "Song",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrashedSongValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrashedSongValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrashedSongValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRestorableUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TrashedSongValidationError{
+					field:  "RestorableUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TrashedSongValidationError{
+					field:  "RestorableUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRestorableUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrashedSongValidationError{
+				field:  "RestorableUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TrashedSongMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrashedSongMultiError is an error wrapping multiple validation errors
+// returned by TrashedSong.ValidateAll() if the designated constraints aren't met.
+type TrashedSongMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrashedSongMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrashedSongMultiError) AllErrors() []error { return m }
+
+// TrashedSongValidationError is the validation error returned by
+// TrashedSong.Validate if the designated constraints aren't met.
+type TrashedSongValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrashedSongValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrashedSongValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrashedSongValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrashedSongValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrashedSongValidationError) ErrorName() string { return "TrashedSongValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrashedSongValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrashedSong.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrashedSongValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrashedSongValidationError{}
+
+// Validate checks the field values on GetTrashedSongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTrashedSongsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTrashedSongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTrashedSongsRequestMultiError, or nil if none found.
+func (m *GetTrashedSongsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTrashedSongsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Page != nil {
+
+		if m.GetPage() < 0 {
+			err := GetTrashedSongsRequestValidationError{
+				field:  "Page",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PageSize != nil {
+
+		if val := m.GetPageSize(); val < 1 || val > 1000 {
+			err := GetTrashedSongsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetTrashedSongsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTrashedSongsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTrashedSongsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTrashedSongsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTrashedSongsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTrashedSongsRequestMultiError) AllErrors() []error { return m }
+
+// GetTrashedSongsRequestValidationError is the validation error returned by
+// GetTrashedSongsRequest.Validate if the designated constraints aren't met.
+type GetTrashedSongsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTrashedSongsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTrashedSongsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTrashedSongsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTrashedSongsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTrashedSongsRequestValidationError) ErrorName() string {
+	return "GetTrashedSongsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTrashedSongsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTrashedSongsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTrashedSongsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTrashedSongsRequestValidationError{}
+
+// Validate checks the field values on GetTrashedSongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTrashedSongsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTrashedSongsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTrashedSongsResponseMultiError, or nil if none found.
+func (m *GetTrashedSongsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTrashedSongsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSongs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTrashedSongsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTrashedSongsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTrashedSongsResponseValidationError{
+					field:  fmt.Sprintf("Songs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTrashedSongsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTrashedSongsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTrashedSongsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTrashedSongsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTrashedSongsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTrashedSongsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTrashedSongsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTrashedSongsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTrashedSongsResponseMultiError) AllErrors() []error { return m }
+
+// GetTrashedSongsResponseValidationError is the validation error returned by
+// GetTrashedSongsResponse.Validate if the designated constraints aren't met.
+type GetTrashedSongsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTrashedSongsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTrashedSongsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTrashedSongsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTrashedSongsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTrashedSongsResponseValidationError) ErrorName() string {
+	return "GetTrashedSongsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTrashedSongsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTrashedSongsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTrashedSongsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTrashedSongsResponseValidationError{}
+
+// Validate checks the field values on RestoreTrashedSongsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreTrashedSongsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreTrashedSongsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreTrashedSongsRequestMultiError, or nil if none found.
+func (m *RestoreTrashedSongsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreTrashedSongsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetIds()); l < 1 || l > 1000 {
+		err := RestoreTrashedSongsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = RestoreTrashedSongsRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RestoreTrashedSongsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreTrashedSongsRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreTrashedSongsRequestMultiError is an error wrapping multiple
+// validation errors returned by RestoreTrashedSongsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RestoreTrashedSongsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreTrashedSongsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreTrashedSongsRequestMultiError) AllErrors() []error { return m }
+
+// RestoreTrashedSongsRequestValidationError is the validation error returned
+// by RestoreTrashedSongsRequest.Validate if the designated constraints aren't met.
+type RestoreTrashedSongsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreTrashedSongsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreTrashedSongsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreTrashedSongsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreTrashedSongsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreTrashedSongsRequestValidationError) ErrorName() string {
+	return "RestoreTrashedSongsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreTrashedSongsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreTrashedSongsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreTrashedSongsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreTrashedSongsRequestValidationError{}
+
+// Validate checks the field values on RestoreTrashedSongsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreTrashedSongsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreTrashedSongsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreTrashedSongsResponseMultiError, or nil if none found.
+func (m *RestoreTrashedSongsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreTrashedSongsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RestoreTrashedSongsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreTrashedSongsResponseMultiError is an error wrapping multiple
+// validation errors returned by RestoreTrashedSongsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RestoreTrashedSongsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreTrashedSongsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreTrashedSongsResponseMultiError) AllErrors() []error { return m }
+
+// RestoreTrashedSongsResponseValidationError is the validation error returned
+// by RestoreTrashedSongsResponse.Validate if the designated constraints
+// aren't met.
+type RestoreTrashedSongsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreTrashedSongsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreTrashedSongsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreTrashedSongsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreTrashedSongsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreTrashedSongsResponseValidationError) ErrorName() string {
+	return "RestoreTrashedSongsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreTrashedSongsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreTrashedSongsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreTrashedSongsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreTrashedSongsResponseValidationError{}
+
+// Validate checks the field values on CreditRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreditRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreditRequestMultiError, or
+// nil if none found.
+func (m *CreditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SongId
+
+	// no validation rules for SongName
+
+	if all {
+		switch v := interface{}(m.GetSinger()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreditRequestValidationError{
+					field:  "Singer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreditRequestValidationError{
+					field:  "Singer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSinger()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreditRequestValidationError{
+				field:  "Singer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return CreditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreditRequestMultiError is an error wrapping multiple validation errors
+// returned by CreditRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreditRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreditRequestMultiError) AllErrors() []error { return m }
+
+// CreditRequestValidationError is the validation error returned by
+// CreditRequest.Validate if the designated constraints aren't met.
+type CreditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreditRequestValidationError) ErrorName() string { return "CreditRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreditRequestValidationError{}
+
+// Validate checks the field values on GetCreditRequestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCreditRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCreditRequestsRequestMultiError, or nil if none found.
+func (m *GetCreditRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCreditRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Page != nil {
+
+		if m.GetPage() < 0 {
+			err := GetCreditRequestsRequestValidationError{
+				field:  "Page",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PageSize != nil {
+
+		if val := m.GetPageSize(); val < 1 || val > 1000 {
+			err := GetCreditRequestsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCreditRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCreditRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCreditRequestsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCreditRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCreditRequestsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCreditRequestsRequestMultiError) AllErrors() []error { return m }
+
+// GetCreditRequestsRequestValidationError is the validation error returned by
+// GetCreditRequestsRequest.Validate if the designated constraints aren't met.
+type GetCreditRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCreditRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCreditRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCreditRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCreditRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCreditRequestsRequestValidationError) ErrorName() string {
+	return "GetCreditRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCreditRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCreditRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCreditRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCreditRequestsRequestValidationError{}
+
+// Validate checks the field values on GetCreditRequestsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCreditRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCreditRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCreditRequestsResponseMultiError, or nil if none found.
+func (m *GetCreditRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCreditRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCreditRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCreditRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCreditRequestsResponseValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCreditRequestsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCreditRequestsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCreditRequestsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCreditRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCreditRequestsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCreditRequestsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetCreditRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCreditRequestsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCreditRequestsResponseMultiError) AllErrors() []error { return m }
+
+// GetCreditRequestsResponseValidationError is the validation error returned by
+// GetCreditRequestsResponse.Validate if the designated constraints aren't met.
+type GetCreditRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCreditRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCreditRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCreditRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCreditRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCreditRequestsResponseValidationError) ErrorName() string {
+	return "GetCreditRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCreditRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCreditRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCreditRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCreditRequestsResponseValidationError{}
+
+// Validate checks the field values on ResolveCreditRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveCreditRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveCreditRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveCreditRequestRequestMultiError, or nil if none found.
+func (m *ResolveCreditRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveCreditRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ResolveCreditRequestRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Approve
+
+	if len(errors) > 0 {
+		return ResolveCreditRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveCreditRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by ResolveCreditRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ResolveCreditRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveCreditRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveCreditRequestRequestMultiError) AllErrors() []error { return m }
+
+// ResolveCreditRequestRequestValidationError is the validation error returned
+// by ResolveCreditRequestRequest.Validate if the designated constraints
+// aren't met.
+type ResolveCreditRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveCreditRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveCreditRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveCreditRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveCreditRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveCreditRequestRequestValidationError) ErrorName() string {
+	return "ResolveCreditRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveCreditRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveCreditRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveCreditRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveCreditRequestRequestValidationError{}
+
+// Validate checks the field values on ResolveCreditRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveCreditRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveCreditRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveCreditRequestResponseMultiError, or nil if none found.
+func (m *ResolveCreditRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveCreditRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResolveCreditRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveCreditRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by ResolveCreditRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ResolveCreditRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveCreditRequestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveCreditRequestResponseMultiError) AllErrors() []error { return m }
+
+// ResolveCreditRequestResponseValidationError is the validation error returned
+// by ResolveCreditRequestResponse.Validate if the designated constraints
+// aren't met.
+type ResolveCreditRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveCreditRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveCreditRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveCreditRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveCreditRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveCreditRequestResponseValidationError) ErrorName() string {
+	return "ResolveCreditRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveCreditRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveCreditRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveCreditRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveCreditRequestResponseValidationError{}
+
+// Validate checks the field values on Song with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Song) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Song with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SongMultiError, or nil if none found.
+func (m *Song) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Song) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetSinger()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongValidationError{
+					field:  "Singer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongValidationError{
+					field:  "Singer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSinger()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongValidationError{
+				field:  "Singer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetArtists() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SongValidationError{
+						field:  fmt.Sprintf("Artists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SongValidationError{
+						field:  fmt.Sprintf("Artists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SongValidationError{
+					field:  fmt.Sprintf("Artists[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for SongUrl
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for WeightBytes
+
+	if all {
+		switch v := interface{}(m.GetReleasedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongValidationError{
+					field:  "ReleasedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongValidationError{
+					field:  "ReleasedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleasedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongValidationError{
+				field:  "ReleasedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUploadedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongValidationError{
+					field:  "UploadedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongValidationError{
+					field:  "UploadedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUploadedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongValidationError{
+				field:  "UploadedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Explicit
+
+	// no validation rules for Unavailable
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SongValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SongValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SongValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PreviewUrl
+
+	if m.ImageUrl != nil {
+		// no validation rules for ImageUrl
+	}
+
+	if len(errors) > 0 {
+		return SongMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongMultiError is an error wrapping multiple validation errors returned by
+// Song.ValidateAll() if the designated constraints aren't met.
+type SongMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongMultiError) AllErrors() []error { return m }
+
+// SongValidationError is the validation error returned by Song.Validate if the
+// designated constraints aren't met.
+type SongValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongValidationError) ErrorName() string { return "SongValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSong.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongValidationError{}
+
+// Validate checks the field values on MySong with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MySong) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MySong with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MySongMultiError, or nil if none found.
+func (m *MySong) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MySong) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetSinger()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MySongValidationError{
+					field:  "Singer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MySongValidationError{
+					field:  "Singer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSinger()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MySongValidationError{
+				field:  "Singer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetArtists() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MySongValidationError{
+						field:  fmt.Sprintf("Artists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MySongValidationError{
+						field:  fmt.Sprintf("Artists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MySongValidationError{
+					field:  fmt.Sprintf("Artists[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetUploadedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MySongValidationError{
+					field:  "UploadedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MySongValidationError{
+					field:  "UploadedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUploadedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MySongValidationError{
+				field:  "UploadedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ModerationStatus
+
+	// no validation rules for Explicit
+
+	if all {
+		switch v := interface{}(m.GetRegions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MySongValidationError{
+					field:  "Regions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MySongValidationError{
+					field:  "Regions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MySongValidationError{
+				field:  "Regions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MySongValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MySongValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MySongValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAudio()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MySongValidationError{
+					field:  "Audio",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MySongValidationError{
+					field:  "Audio",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAudio()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MySongValidationError{
+				field:  "Audio",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AudioState
+
+	if m.SongUrl != nil {
+		// no validation rules for SongUrl
+	}
+
+	if m.ImageUrl != nil {
+		// no validation rules for ImageUrl
+	}
+
+	if m.Duration != nil {
+
+		if all {
+			switch v := interface{}(m.GetDuration()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MySongValidationError{
+						field:  "Duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MySongValidationError{
+						field:  "Duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MySongValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.WeightBytes != nil {
+		// no validation rules for WeightBytes
+	}
+
+	if m.ReleasedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetReleasedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MySongValidationError{
+						field:  "ReleasedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MySongValidationError{
+						field:  "ReleasedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReleasedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MySongValidationError{
+					field:  "ReleasedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ModerationReason != nil {
+		// no validation rules for ModerationReason
+	}
+
+	if m.AudioError != nil {
+		// no validation rules for AudioError
+	}
+
+	if m.ThumbnailUrl != nil {
+		// no validation rules for ThumbnailUrl
+	}
+
+	if len(errors) > 0 {
+		return MySongMultiError(errors)
+	}
+
+	return nil
+}
+
+// MySongMultiError is an error wrapping multiple validation errors returned by
+// MySong.ValidateAll() if the designated constraints aren't met.
+type MySongMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MySongMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MySongMultiError) AllErrors() []error { return m }
+
+// MySongValidationError is the validation error returned by MySong.Validate if
+// the designated constraints aren't met.
+type MySongValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MySongValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MySongValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MySongValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MySongValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MySongValidationError) ErrorName() string { return "MySongValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MySongValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMySong.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MySongValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MySongValidationError{}
+
+// Validate checks the field values on AudioInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AudioInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AudioInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AudioInfoMultiError, or nil
+// if none found.
+func (m *AudioInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AudioInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Bitrate
+
+	// no validation rules for BitrateMode
+
+	// no validation rules for SampleRate
+
+	// no validation rules for ChannelMode
+
+	if m.Encoder != nil {
+		// no validation rules for Encoder
+	}
+
+	if len(errors) > 0 {
+		return AudioInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AudioInfoMultiError is an error wrapping multiple validation errors returned
+// by AudioInfo.ValidateAll() if the designated constraints aren't met.
+type AudioInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AudioInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AudioInfoMultiError) AllErrors() []error { return m }
+
+// AudioInfoValidationError is the validation error returned by
+// AudioInfo.Validate if the designated constraints aren't met.
+type AudioInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AudioInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AudioInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AudioInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AudioInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AudioInfoValidationError) ErrorName() string { return "AudioInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AudioInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAudioInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AudioInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AudioInfoValidationError{}
+
+// Validate checks the field values on PaginationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PaginationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaginationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PaginationResponseMultiError, or nil if none found.
+func (m *PaginationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaginationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LastPage
+
+	if len(errors) > 0 {
+		return PaginationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaginationResponseMultiError is an error wrapping multiple validation errors
+// returned by PaginationResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PaginationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaginationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaginationResponseMultiError) AllErrors() []error { return m }
+
+// PaginationResponseValidationError is the validation error returned by
+// PaginationResponse.Validate if the designated constraints aren't met.
+type PaginationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaginationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaginationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaginationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaginationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaginationResponseValidationError) ErrorName() string {
+	return "PaginationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PaginationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaginationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaginationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaginationResponseValidationError{}
+
+// Validate checks the field values on GetSongsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSongsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSongsRequestMultiError, or nil if none found.
+func (m *GetSongsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSongsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) > 0 {
+
+		if len(m.GetIds()) > 2000 {
+			err := GetSongsRequestValidationError{
+				field:  "Ids",
+				reason: "value must contain no more than 2000 item(s)",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Page != nil {
+
+		if m.GetPage() < 0 {
+			err := GetSongsRequestValidationError{
+				field:  "Page",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PageSize != nil {
+
+		if m.GetPageSize() > 1000 {
+			err := GetSongsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ArtistId != nil {
+
+		if m.GetArtistId() != "" {
+
+			if err := m._validateUuid(m.GetArtistId()); err != nil {
+				err = GetSongsRequestValidationError{
+					field:  "ArtistId",
+					reason: "value must be a valid UUID",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.MatchArtist != nil {
+		// no validation rules for MatchArtist
+	}
+
+	if m.MatchName != nil {
+
+		if m.GetMatchName() != "" {
+
+			if l := utf8.RuneCountInString(m.GetMatchName()); l < 1 || l > 64 {
+				err := GetSongsRequestValidationError{
+					field:  "MatchName",
+					reason: "value length must be between 1 and 64 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.CreditArtistId != nil {
+
+		if m.GetCreditArtistId() != "" {
+
+			if err := m._validateUuid(m.GetCreditArtistId()); err != nil {
+				err = GetSongsRequestValidationError{
+					field:  "CreditArtistId",
+					reason: "value must be a valid UUID",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.MatchCredit != nil {
+
+		if m.GetMatchCredit() != "" {
+
+			if l := utf8.RuneCountInString(m.GetMatchCredit()); l < 1 || l > 128 {
+				err := GetSongsRequestValidationError{
+					field:  "MatchCredit",
+					reason: "value length must be between 1 and 128 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.CreditRole != nil {
+
+		if _, ok := CreditRole_name[int32(m.GetCreditRole())]; !ok {
+			err := GetSongsRequestValidationError{
+				field:  "CreditRole",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSongsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetSongsRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetSongsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSongsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSongsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSongsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSongsRequestMultiError) AllErrors() []error { return m }
+
+// GetSongsRequestValidationError is the validation error returned by
+// GetSongsRequest.Validate if the designated constraints aren't met.
+type GetSongsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSongsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSongsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSongsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSongsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSongsRequestValidationError) ErrorName() string { return "GetSongsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSongsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSongsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSongsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSongsRequestValidationError{}
+
+// Validate checks the field values on GetSongsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSongsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSongsResponseMultiError, or nil if none found.
+func (m *GetSongsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSongsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSongs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSongsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSongsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSongsResponseValidationError{
+					field:  fmt.Sprintf("Songs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSongsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSongsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSongsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSongsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSongsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSongsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetSongsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSongsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSongsResponseMultiError) AllErrors() []error { return m }
+
+// GetSongsResponseValidationError is the validation error returned by
+// GetSongsResponse.Validate if the designated constraints aren't met.
+type GetSongsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSongsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSongsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSongsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSongsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSongsResponseValidationError) ErrorName() string { return "GetSongsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSongsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSongsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSongsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSongsResponseValidationError{}
+
+// Validate checks the field values on GetMySongsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetMySongsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMySongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMySongsRequestMultiError, or nil if none found.
+func (m *GetMySongsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMySongsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) > 0 {
+
+		if l := len(m.GetIds()); l < 1 || l > 2000 {
+			err := GetMySongsRequestValidationError{
+				field:  "Ids",
+				reason: "value must contain between 1 and 2000 items, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		for idx, item := range m.GetIds() {
+			_, _ = idx, item
+
+			if err := m._validateUuid(item); err != nil {
+				err = GetMySongsRequestValidationError{
+					field:  fmt.Sprintf("Ids[%v]", idx),
+					reason: "value must be a valid UUID",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.Page != nil {
+
+		if m.GetPage() < 0 {
+			err := GetMySongsRequestValidationError{
+				field:  "Page",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PageSize != nil {
+
+		if val := m.GetPageSize(); val < 1 || val > 1000 {
+			err := GetMySongsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMySongsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetMySongsRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetMySongsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMySongsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMySongsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMySongsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMySongsRequestMultiError) AllErrors() []error { return m }
+
+// GetMySongsRequestValidationError is the validation error returned by
+// GetMySongsRequest.Validate if the designated constraints aren't met.
+type GetMySongsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMySongsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMySongsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMySongsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMySongsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMySongsRequestValidationError) ErrorName() string {
+	return "GetMySongsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMySongsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMySongsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMySongsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMySongsRequestValidationError{}
+
+// Validate checks the field values on GetMySongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMySongsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMySongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMySongsResponseMultiError, or nil if none found.
+func (m *GetMySongsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMySongsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSongs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMySongsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMySongsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMySongsResponseValidationError{
+					field:  fmt.Sprintf("Songs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMySongsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMySongsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMySongsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetMySongsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMySongsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetMySongsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetMySongsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMySongsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMySongsResponseMultiError) AllErrors() []error { return m }
+
+// GetMySongsResponseValidationError is the validation error returned by
+// GetMySongsResponse.Validate if the designated constraints aren't met.
+type GetMySongsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMySongsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMySongsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMySongsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMySongsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMySongsResponseValidationError) ErrorName() string {
+	return "GetMySongsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMySongsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMySongsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMySongsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMySongsResponseValidationError{}
+
+// Validate checks the field values on GetMyUsageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetMyUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMyUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMyUsageRequestMultiError, or nil if none found.
+func (m *GetMyUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMyUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetMyUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMyUsageRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMyUsageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMyUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMyUsageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMyUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetMyUsageRequestValidationError is the validation error returned by
+// GetMyUsageRequest.Validate if the designated constraints aren't met.
+type GetMyUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMyUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMyUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMyUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMyUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMyUsageRequestValidationError) ErrorName() string {
+	return "GetMyUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMyUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMyUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMyUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMyUsageRequestValidationError{}
+
+// Validate checks the field values on GetMyUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMyUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMyUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMyUsageResponseMultiError, or nil if none found.
+func (m *GetMyUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMyUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongsCount
+
+	// no validation rules for SongsLimit
+
+	// no validation rules for WeightBytes
+
+	// no validation rules for WeightBytesLimit
+
+	// no validation rules for UploadsPerHourLimit
+
+	// no validation rules for ConcurrentUploadsLimit
+
+	if len(errors) > 0 {
+		return GetMyUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMyUsageResponseMultiError is an error wrapping multiple validation errors
+// returned by GetMyUsageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetMyUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMyUsageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMyUsageResponseMultiError) AllErrors() []error { return m }
+
+// GetMyUsageResponseValidationError is the validation error returned by
+// GetMyUsageResponse.Validate if the designated constraints aren't met.
+type GetMyUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMyUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMyUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMyUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMyUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMyUsageResponseValidationError) ErrorName() string {
+	return "GetMyUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMyUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMyUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMyUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMyUsageResponseValidationError{}
+
+// Validate checks the field values on StatsCounters with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatsCounters) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsCounters with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatsCountersMultiError, or
+// nil if none found.
+func (m *StatsCounters) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsCounters) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Plays
+
+	// no validation rules for UniqueListeners
+
+	// no validation rules for Likes
+
+	// no validation rules for Unlikes
+
+	// no validation rules for PlaylistAdditions
+
+	if len(errors) > 0 {
+		return StatsCountersMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsCountersMultiError is an error wrapping multiple validation errors
+// returned by StatsCounters.ValidateAll() if the designated constraints
+// aren't met.
+type StatsCountersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsCountersMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsCountersMultiError) AllErrors() []error { return m }
+
+// StatsCountersValidationError is the validation error returned by
+// StatsCounters.Validate if the designated constraints aren't met.
+type StatsCountersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsCountersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsCountersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsCountersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsCountersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsCountersValidationError) ErrorName() string { return "StatsCountersValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsCountersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsCounters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsCountersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsCountersValidationError{}
+
+// Validate checks the field values on ArtistStatsBucket with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ArtistStatsBucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArtistStatsBucket with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArtistStatsBucketMultiError, or nil if none found.
+func (m *ArtistStatsBucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArtistStatsBucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArtistStatsBucketValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArtistStatsBucketValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArtistStatsBucketValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCounters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArtistStatsBucketValidationError{
+					field:  "Counters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArtistStatsBucketValidationError{
+					field:  "Counters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCounters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArtistStatsBucketValidationError{
+				field:  "Counters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Followers
+
+	// no validation rules for FollowersGained
+
+	if len(errors) > 0 {
+		return ArtistStatsBucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArtistStatsBucketMultiError is an error wrapping multiple validation errors
+// returned by ArtistStatsBucket.ValidateAll() if the designated constraints
+// aren't met.
+type ArtistStatsBucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArtistStatsBucketMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArtistStatsBucketMultiError) AllErrors() []error { return m }
+
+// ArtistStatsBucketValidationError is the validation error returned by
+// ArtistStatsBucket.Validate if the designated constraints aren't met.
+type ArtistStatsBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArtistStatsBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArtistStatsBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArtistStatsBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArtistStatsBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArtistStatsBucketValidationError) ErrorName() string {
+	return "ArtistStatsBucketValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArtistStatsBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArtistStatsBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArtistStatsBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArtistStatsBucketValidationError{}
+
+// Validate checks the field values on SongStatsBucket with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongStatsBucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongStatsBucket with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongStatsBucketMultiError, or nil if none found.
+func (m *SongStatsBucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongStatsBucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongStatsBucketValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongStatsBucketValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongStatsBucketValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCounters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongStatsBucketValidationError{
+					field:  "Counters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongStatsBucketValidationError{
+					field:  "Counters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCounters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongStatsBucketValidationError{
+				field:  "Counters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SongStatsBucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongStatsBucketMultiError is an error wrapping multiple validation errors
+// returned by SongStatsBucket.ValidateAll() if the designated constraints
+// aren't met.
+type SongStatsBucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongStatsBucketMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongStatsBucketMultiError) AllErrors() []error { return m }
+
+// SongStatsBucketValidationError is the validation error returned by
+// SongStatsBucket.Validate if the designated constraints aren't met.
+type SongStatsBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongStatsBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongStatsBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongStatsBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongStatsBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongStatsBucketValidationError) ErrorName() string { return "SongStatsBucketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongStatsBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongStatsBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongStatsBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongStatsBucketValidationError{}
+
+// Validate checks the field values on SongStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SongStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SongStatsMultiError, or nil
+// if none found.
+func (m *SongStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SongId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongStatsValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongStatsValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongStatsValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBuckets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SongStatsValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SongStatsValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SongStatsValidationError{
+					field:  fmt.Sprintf("Buckets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SongStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongStatsMultiError is an error wrapping multiple validation errors returned
+// by SongStats.ValidateAll() if the designated constraints aren't met.
+type SongStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongStatsMultiError) AllErrors() []error { return m }
+
+// SongStatsValidationError is the validation error returned by
+// SongStats.Validate if the designated constraints aren't met.
+type SongStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongStatsValidationError) ErrorName() string { return "SongStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongStatsValidationError{}
+
+// Validate checks the field values on GetArtistStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArtistStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArtistStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArtistStatsRequestMultiError, or nil if none found.
+func (m *GetArtistStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArtistStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Bucket
+
+	if m.From != nil {
+
+		if all {
+			switch v := interface{}(m.GetFrom()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetArtistStatsRequestValidationError{
+						field:  "From",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetArtistStatsRequestValidationError{
+						field:  "From",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetArtistStatsRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.To != nil {
+
+		if all {
+			switch v := interface{}(m.GetTo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetArtistStatsRequestValidationError{
+						field:  "To",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetArtistStatsRequestValidationError{
+						field:  "To",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetArtistStatsRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetArtistStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArtistStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetArtistStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetArtistStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArtistStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArtistStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetArtistStatsRequestValidationError is the validation error returned by
+// GetArtistStatsRequest.Validate if the designated constraints aren't met.
+type GetArtistStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArtistStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArtistStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArtistStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArtistStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArtistStatsRequestValidationError) ErrorName() string {
+	return "GetArtistStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArtistStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArtistStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArtistStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArtistStatsRequestValidationError{}
+
+// Validate checks the field values on GetArtistStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArtistStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArtistStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArtistStatsResponseMultiError, or nil if none found.
+func (m *GetArtistStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArtistStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetArtistStatsResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetArtistStatsResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetArtistStatsResponseValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Followers
+
+	// no validation rules for FollowersGained
+
+	for idx, item := range m.GetBuckets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetArtistStatsResponseValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetArtistStatsResponseValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetArtistStatsResponseValidationError{
+					field:  fmt.Sprintf("Buckets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSongs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetArtistStatsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetArtistStatsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetArtistStatsResponseValidationError{
+					field:  fmt.Sprintf("Songs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetArtistStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArtistStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetArtistStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetArtistStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArtistStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArtistStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetArtistStatsResponseValidationError is the validation error returned by
+// GetArtistStatsResponse.Validate if the designated constraints aren't met.
+type GetArtistStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArtistStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArtistStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArtistStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArtistStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArtistStatsResponseValidationError) ErrorName() string {
+	return "GetArtistStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArtistStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArtistStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArtistStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArtistStatsResponseValidationError{}
+
+// Validate checks the field values on ReleaseSongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseSongsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseSongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseSongsRequestMultiError, or nil if none found.
+func (m *ReleaseSongsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseSongsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetIds()); l < 1 || l > 2000 {
+		err := ReleaseSongsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 2000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = ReleaseSongsRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Notify
+
+	if len(errors) > 0 {
+		return ReleaseSongsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReleaseSongsRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReleaseSongsRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseSongsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseSongsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseSongsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseSongsRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseSongsRequestValidationError is the validation error returned by
+// ReleaseSongsRequest.Validate if the designated constraints aren't met.
+type ReleaseSongsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseSongsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseSongsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseSongsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseSongsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseSongsRequestValidationError) ErrorName() string {
+	return "ReleaseSongsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseSongsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseSongsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseSongsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseSongsRequestValidationError{}
+
+// Validate checks the field values on ReleaseSongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseSongsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseSongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseSongsResponseMultiError, or nil if none found.
+func (m *ReleaseSongsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseSongsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReleaseSongsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseSongsResponseMultiError is an error wrapping multiple validation
+// errors returned by ReleaseSongsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseSongsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseSongsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseSongsResponseMultiError) AllErrors() []error { return m }
+
+// ReleaseSongsResponseValidationError is the validation error returned by
+// ReleaseSongsResponse.Validate if the designated constraints aren't met.
+type ReleaseSongsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseSongsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseSongsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseSongsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseSongsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseSongsResponseValidationError) ErrorName() string {
+	return "ReleaseSongsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseSongsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseSongsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseSongsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseSongsResponseValidationError{}
+
+// Validate checks the field values on FlagSongRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FlagSongRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlagSongRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlagSongRequestMultiError, or nil if none found.
+func (m *FlagSongRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlagSongRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = FlagSongRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 1024 {
+		err := FlagSongRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FlagSongRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *FlagSongRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// FlagSongRequestMultiError is an error wrapping multiple validation errors
+// returned by FlagSongRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FlagSongRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlagSongRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlagSongRequestMultiError) AllErrors() []error { return m }
+
+// FlagSongRequestValidationError is the validation error returned by
+// FlagSongRequest.Validate if the designated constraints aren't met.
+type FlagSongRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlagSongRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlagSongRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlagSongRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlagSongRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlagSongRequestValidationError) ErrorName() string { return "FlagSongRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FlagSongRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlagSongRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlagSongRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlagSongRequestValidationError{}
+
+// Validate checks the field values on FlagSongResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FlagSongResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlagSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlagSongResponseMultiError, or nil if none found.
+func (m *FlagSongResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlagSongResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FlagSongResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlagSongResponseMultiError is an error wrapping multiple validation errors
+// returned by FlagSongResponse.ValidateAll() if the designated constraints
+// aren't met.
+type FlagSongResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlagSongResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlagSongResponseMultiError) AllErrors() []error { return m }
+
+// FlagSongResponseValidationError is the validation error returned by
+// FlagSongResponse.Validate if the designated constraints aren't met.
+type FlagSongResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlagSongResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlagSongResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlagSongResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlagSongResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlagSongResponseValidationError) ErrorName() string { return "FlagSongResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FlagSongResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlagSongResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlagSongResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlagSongResponseValidationError{}
+
+// Validate checks the field values on TakeDownSongRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TakeDownSongRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TakeDownSongRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TakeDownSongRequestMultiError, or nil if none found.
+func (m *TakeDownSongRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TakeDownSongRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = TakeDownSongRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 1024 {
+		err := TakeDownSongRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TakeDownSongRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *TakeDownSongRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// TakeDownSongRequestMultiError is an error wrapping multiple validation
+// errors returned by TakeDownSongRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TakeDownSongRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TakeDownSongRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TakeDownSongRequestMultiError) AllErrors() []error { return m }
+
+// TakeDownSongRequestValidationError is the validation error returned by
+// TakeDownSongRequest.Validate if the designated constraints aren't met.
+type TakeDownSongRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TakeDownSongRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TakeDownSongRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TakeDownSongRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TakeDownSongRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TakeDownSongRequestValidationError) ErrorName() string {
+	return "TakeDownSongRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TakeDownSongRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTakeDownSongRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TakeDownSongRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TakeDownSongRequestValidationError{}
+
+// Validate checks the field values on TakeDownSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TakeDownSongResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TakeDownSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TakeDownSongResponseMultiError, or nil if none found.
+func (m *TakeDownSongResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TakeDownSongResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TakeDownSongResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TakeDownSongResponseMultiError is an error wrapping multiple validation
+// errors returned by TakeDownSongResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TakeDownSongResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TakeDownSongResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TakeDownSongResponseMultiError) AllErrors() []error { return m }
+
+// TakeDownSongResponseValidationError is the validation error returned by
+// TakeDownSongResponse.Validate if the designated constraints aren't met.
+type TakeDownSongResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TakeDownSongResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TakeDownSongResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TakeDownSongResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TakeDownSongResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TakeDownSongResponseValidationError) ErrorName() string {
+	return "TakeDownSongResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TakeDownSongResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTakeDownSongResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TakeDownSongResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TakeDownSongResponseValidationError{}
+
+// Validate checks the field values on RestoreSongRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreSongRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreSongRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreSongRequestMultiError, or nil if none found.
+func (m *RestoreSongRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreSongRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RestoreSongRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Reason != nil {
+
+		if utf8.RuneCountInString(m.GetReason()) > 1024 {
+			err := RestoreSongRequestValidationError{
+				field:  "Reason",
+				reason: "value length must be at most 1024 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RestoreSongRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreSongRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreSongRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreSongRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreSongRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreSongRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreSongRequestMultiError) AllErrors() []error { return m }
+
+// RestoreSongRequestValidationError is the validation error returned by
+// RestoreSongRequest.Validate if the designated constraints aren't met.
+type RestoreSongRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreSongRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreSongRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreSongRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreSongRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreSongRequestValidationError) ErrorName() string {
+	return "RestoreSongRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreSongRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreSongRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreSongRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreSongRequestValidationError{}
+
+// Validate checks the field values on RestoreSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreSongResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreSongResponseMultiError, or nil if none found.
+func (m *RestoreSongResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreSongResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RestoreSongResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreSongResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreSongResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreSongResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreSongResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreSongResponseMultiError) AllErrors() []error { return m }
+
+// RestoreSongResponseValidationError is the validation error returned by
+// RestoreSongResponse.Validate if the designated constraints aren't met.
+type RestoreSongResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreSongResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreSongResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreSongResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreSongResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreSongResponseValidationError) ErrorName() string {
+	return "RestoreSongResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreSongResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreSongResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreSongResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreSongResponseValidationError{}
+
+// Validate checks the field values on Claim with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Claim) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Claim with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ClaimMultiError, or nil if none found.
+func (m *Claim) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Claim) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SongId
+
+	// no validation rules for ClaimantId
+
+	// no validation rules for ClaimantName
+
+	// no validation rules for ClaimantEmail
+
+	// no validation rules for Description
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClaimValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClaimValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClaimValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClaimValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClaimValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClaimValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.CounterNotice != nil {
+		// no validation rules for CounterNotice
+	}
+
+	if m.ResolutionNote != nil {
+		// no validation rules for ResolutionNote
+	}
+
+	if len(errors) > 0 {
+		return ClaimMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimMultiError is an error wrapping multiple validation errors returned by
+// Claim.ValidateAll() if the designated constraints aren't met.
+type ClaimMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimMultiError) AllErrors() []error { return m }
+
+// ClaimValidationError is the validation error returned by Claim.Validate if
+// the designated constraints aren't met.
+type ClaimValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimValidationError) ErrorName() string { return "ClaimValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClaimValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaim.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimValidationError{}
+
+// Validate checks the field values on ClaimEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClaimEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClaimEventMultiError, or
+// nil if none found.
+func (m *ClaimEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ToStatus
+
+	// no validation rules for ActorId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClaimEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClaimEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClaimEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.FromStatus != nil {
+		// no validation rules for FromStatus
+	}
+
+	if m.Note != nil {
+		// no validation rules for Note
+	}
+
+	if len(errors) > 0 {
+		return ClaimEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimEventMultiError is an error wrapping multiple validation errors
+// returned by ClaimEvent.ValidateAll() if the designated constraints aren't met.
+type ClaimEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimEventMultiError) AllErrors() []error { return m }
+
+// ClaimEventValidationError is the validation error returned by
+// ClaimEvent.Validate if the designated constraints aren't met.
+type ClaimEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimEventValidationError) ErrorName() string { return "ClaimEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClaimEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimEventValidationError{}
+
+// Validate checks the field values on SubmitClaimRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitClaimRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitClaimRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitClaimRequestMultiError, or nil if none found.
+func (m *SubmitClaimRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitClaimRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSongId()); err != nil {
+		err = SubmitClaimRequestValidationError{
+			field:  "SongId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetClaimantName()); l < 1 || l > 256 {
+		err := SubmitClaimRequestValidationError{
+			field:  "ClaimantName",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetClaimantEmail()) > 256 {
+		err := SubmitClaimRequestValidationError{
+			field:  "ClaimantEmail",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetClaimantEmail()); err != nil {
+		err = SubmitClaimRequestValidationError{
+			field:  "ClaimantEmail",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetDescription()); l < 1 || l > 4096 {
+		err := SubmitClaimRequestValidationError{
+			field:  "Description",
+			reason: "value length must be between 1 and 4096 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetEvidenceUrls()); l < 1 || l > 16 {
+		err := SubmitClaimRequestValidationError{
+			field:  "EvidenceUrls",
+			reason: "value must contain between 1 and 16 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEvidenceUrls() {
+		_, _ = idx, item
+
+		if uri, err := url.Parse(item); err != nil {
+			err = SubmitClaimRequestValidationError{
+				field:  fmt.Sprintf("EvidenceUrls[%v]", idx),
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := SubmitClaimRequestValidationError{
+				field:  fmt.Sprintf("EvidenceUrls[%v]", idx),
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubmitClaimRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SubmitClaimRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *SubmitClaimRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+func (m *SubmitClaimRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SubmitClaimRequestMultiError is an error wrapping multiple validation errors
+// returned by SubmitClaimRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SubmitClaimRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitClaimRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitClaimRequestMultiError) AllErrors() []error { return m }
+
+// SubmitClaimRequestValidationError is the validation error returned by
+// SubmitClaimRequest.Validate if the designated constraints aren't met.
+type SubmitClaimRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitClaimRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitClaimRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitClaimRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitClaimRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitClaimRequestValidationError) ErrorName() string {
+	return "SubmitClaimRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitClaimRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitClaimRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitClaimRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitClaimRequestValidationError{}
+
+// Validate checks the field values on SubmitClaimResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitClaimResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitClaimResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitClaimResponseMultiError, or nil if none found.
+func (m *SubmitClaimResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitClaimResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClaim()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitClaimResponseValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitClaimResponseValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaim()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitClaimResponseValidationError{
+				field:  "Claim",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitClaimResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitClaimResponseMultiError is an error wrapping multiple validation
+// errors returned by SubmitClaimResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitClaimResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitClaimResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitClaimResponseMultiError) AllErrors() []error { return m }
+
+// SubmitClaimResponseValidationError is the validation error returned by
+// SubmitClaimResponse.Validate if the designated constraints aren't met.
+type SubmitClaimResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitClaimResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitClaimResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitClaimResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitClaimResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitClaimResponseValidationError) ErrorName() string {
+	return "SubmitClaimResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitClaimResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitClaimResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitClaimResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitClaimResponseValidationError{}
+
+// Validate checks the field values on GetClaimRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClaimRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClaimRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClaimRequestMultiError, or nil if none found.
+func (m *GetClaimRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClaimRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetClaimRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetClaimRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetClaimRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetClaimRequestMultiError is an error wrapping multiple validation errors
+// returned by GetClaimRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetClaimRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClaimRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClaimRequestMultiError) AllErrors() []error { return m }
+
+// GetClaimRequestValidationError is the validation error returned by
+// GetClaimRequest.Validate if the designated constraints aren't met.
+type GetClaimRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClaimRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClaimRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClaimRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClaimRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClaimRequestValidationError) ErrorName() string { return "GetClaimRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetClaimRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClaimRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClaimRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClaimRequestValidationError{}
+
+// Validate checks the field values on GetClaimResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClaimResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClaimResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClaimResponseMultiError, or nil if none found.
+func (m *GetClaimResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClaimResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClaim()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetClaimResponseValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetClaimResponseValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaim()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClaimResponseValidationError{
+				field:  "Claim",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetClaimResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetClaimResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetClaimResponseValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetClaimResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClaimResponseMultiError is an error wrapping multiple validation errors
+// returned by GetClaimResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetClaimResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClaimResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClaimResponseMultiError) AllErrors() []error { return m }
+
+// GetClaimResponseValidationError is the validation error returned by
+// GetClaimResponse.Validate if the designated constraints aren't met.
+type GetClaimResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClaimResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClaimResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClaimResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClaimResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClaimResponseValidationError) ErrorName() string { return "GetClaimResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetClaimResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClaimResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClaimResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClaimResponseValidationError{}
+
+// Validate checks the field values on GetClaimsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClaimsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClaimsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClaimsRequestMultiError, or nil if none found.
+func (m *GetClaimsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClaimsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.Page != nil {
+
+		if m.GetPage() < 0 {
+			err := GetClaimsRequestValidationError{
+				field:  "Page",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PageSize != nil {
+
+		if m.GetPageSize() > 1000 {
+			err := GetClaimsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetClaimsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClaimsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetClaimsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetClaimsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClaimsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClaimsRequestMultiError) AllErrors() []error { return m }
+
+// GetClaimsRequestValidationError is the validation error returned by
+// GetClaimsRequest.Validate if the designated constraints aren't met.
+type GetClaimsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClaimsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClaimsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClaimsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClaimsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClaimsRequestValidationError) ErrorName() string { return "GetClaimsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetClaimsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClaimsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClaimsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClaimsRequestValidationError{}
+
+// Validate checks the field values on GetClaimsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClaimsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClaimsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClaimsResponseMultiError, or nil if none found.
+func (m *GetClaimsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClaimsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClaims() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetClaimsResponseValidationError{
+						field:  fmt.Sprintf("Claims[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetClaimsResponseValidationError{
+						field:  fmt.Sprintf("Claims[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetClaimsResponseValidationError{
+					field:  fmt.Sprintf("Claims[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetClaimsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetClaimsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClaimsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetClaimsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClaimsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetClaimsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetClaimsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClaimsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClaimsResponseMultiError) AllErrors() []error { return m }
+
+// GetClaimsResponseValidationError is the validation error returned by
+// GetClaimsResponse.Validate if the designated constraints aren't met.
+type GetClaimsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClaimsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClaimsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClaimsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClaimsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClaimsResponseValidationError) ErrorName() string {
+	return "GetClaimsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClaimsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClaimsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClaimsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClaimsResponseValidationError{}
+
+// Validate checks the field values on FileCounterNoticeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FileCounterNoticeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileCounterNoticeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FileCounterNoticeRequestMultiError, or nil if none found.
+func (m *FileCounterNoticeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileCounterNoticeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = FileCounterNoticeRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetStatement()); l < 1 || l > 4096 {
+		err := FileCounterNoticeRequestValidationError{
+			field:  "Statement",
+			reason: "value length must be between 1 and 4096 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FileCounterNoticeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *FileCounterNoticeRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// FileCounterNoticeRequestMultiError is an error wrapping multiple validation
+// errors returned by FileCounterNoticeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FileCounterNoticeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileCounterNoticeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileCounterNoticeRequestMultiError) AllErrors() []error { return m }
+
+// FileCounterNoticeRequestValidationError is the validation error returned by
+// FileCounterNoticeRequest.Validate if the designated constraints aren't met.
+type FileCounterNoticeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileCounterNoticeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileCounterNoticeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileCounterNoticeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileCounterNoticeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileCounterNoticeRequestValidationError) ErrorName() string {
+	return "FileCounterNoticeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FileCounterNoticeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileCounterNoticeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileCounterNoticeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileCounterNoticeRequestValidationError{}
+
+// Validate checks the field values on FileCounterNoticeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FileCounterNoticeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileCounterNoticeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FileCounterNoticeResponseMultiError, or nil if none found.
+func (m *FileCounterNoticeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileCounterNoticeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClaim()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FileCounterNoticeResponseValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FileCounterNoticeResponseValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaim()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FileCounterNoticeResponseValidationError{
+				field:  "Claim",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FileCounterNoticeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileCounterNoticeResponseMultiError is an error wrapping multiple validation
+// errors returned by FileCounterNoticeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type FileCounterNoticeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileCounterNoticeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileCounterNoticeResponseMultiError) AllErrors() []error { return m }
+
+// FileCounterNoticeResponseValidationError is the validation error returned by
+// FileCounterNoticeResponse.Validate if the designated constraints aren't met.
+type FileCounterNoticeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileCounterNoticeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileCounterNoticeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileCounterNoticeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileCounterNoticeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileCounterNoticeResponseValidationError) ErrorName() string {
+	return "FileCounterNoticeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FileCounterNoticeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileCounterNoticeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileCounterNoticeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileCounterNoticeResponseValidationError{}
+
+// Validate checks the field values on ResolveClaimRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveClaimRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveClaimRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveClaimRequestMultiError, or nil if none found.
+func (m *ResolveClaimRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveClaimRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ResolveClaimRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Upheld
+
+	if m.Note != nil {
+
+		if utf8.RuneCountInString(m.GetNote()) > 4096 {
+			err := ResolveClaimRequestValidationError{
+				field:  "Note",
+				reason: "value length must be at most 4096 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ResolveClaimRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ResolveClaimRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ResolveClaimRequestMultiError is an error wrapping multiple validation
+// errors returned by ResolveClaimRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveClaimRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveClaimRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveClaimRequestMultiError) AllErrors() []error { return m }
+
+// ResolveClaimRequestValidationError is the validation error returned by
+// ResolveClaimRequest.Validate if the designated constraints aren't met.
+type ResolveClaimRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveClaimRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveClaimRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveClaimRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveClaimRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveClaimRequestValidationError) ErrorName() string {
+	return "ResolveClaimRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveClaimRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveClaimRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveClaimRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveClaimRequestValidationError{}
+
+// Validate checks the field values on ResolveClaimResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveClaimResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveClaimResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveClaimResponseMultiError, or nil if none found.
+func (m *ResolveClaimResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveClaimResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClaim()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveClaimResponseValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveClaimResponseValidationError{
+					field:  "Claim",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaim()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveClaimResponseValidationError{
+				field:  "Claim",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResolveClaimResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveClaimResponseMultiError is an error wrapping multiple validation
+// errors returned by ResolveClaimResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveClaimResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveClaimResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveClaimResponseMultiError) AllErrors() []error { return m }
+
+// ResolveClaimResponseValidationError is the validation error returned by
+// ResolveClaimResponse.Validate if the designated constraints aren't met.
+type ResolveClaimResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveClaimResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveClaimResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveClaimResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveClaimResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveClaimResponseValidationError) ErrorName() string {
+	return "ResolveClaimResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveClaimResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveClaimResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveClaimResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveClaimResponseValidationError{}
+
+// Validate checks the field values on VerifyClaimantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyClaimantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyClaimantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyClaimantRequestMultiError, or nil if none found.
+func (m *VerifyClaimantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyClaimantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetClaimantId()); err != nil {
+		err = VerifyClaimantRequestValidationError{
+			field:  "ClaimantId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Verified
+
+	if len(errors) > 0 {
+		return VerifyClaimantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VerifyClaimantRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VerifyClaimantRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyClaimantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyClaimantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyClaimantRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyClaimantRequestMultiError) AllErrors() []error { return m }
+
+// VerifyClaimantRequestValidationError is the validation error returned by
+// VerifyClaimantRequest.Validate if the designated constraints aren't met.
+type VerifyClaimantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyClaimantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyClaimantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyClaimantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyClaimantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyClaimantRequestValidationError) ErrorName() string {
+	return "VerifyClaimantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyClaimantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyClaimantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyClaimantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyClaimantRequestValidationError{}
+
+// Validate checks the field values on VerifyClaimantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyClaimantResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyClaimantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyClaimantResponseMultiError, or nil if none found.
+func (m *VerifyClaimantResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyClaimantResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyClaimantResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyClaimantResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyClaimantResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyClaimantResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyClaimantResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyClaimantResponseMultiError) AllErrors() []error { return m }
+
+// VerifyClaimantResponseValidationError is the validation error returned by
+// VerifyClaimantResponse.Validate if the designated constraints aren't met.
+type VerifyClaimantResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyClaimantResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyClaimantResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyClaimantResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyClaimantResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyClaimantResponseValidationError) ErrorName() string {
+	return "VerifyClaimantResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyClaimantResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyClaimantResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyClaimantResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyClaimantResponseValidationError{}
+
+// Validate checks the field values on CatalogExport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CatalogExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CatalogExport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CatalogExportMultiError, or
+// nil if none found.
+func (m *CatalogExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CatalogExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CatalogExportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CatalogExportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CatalogExportValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.DownloadUrl != nil {
+		// no validation rules for DownloadUrl
+	}
+
+	if m.WeightBytes != nil {
+		// no validation rules for WeightBytes
+	}
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if m.FinishedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetFinishedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CatalogExportValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CatalogExportValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CatalogExportValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ExpiresAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpiresAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CatalogExportValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CatalogExportValidationError{
+						field:  "ExpiresAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CatalogExportValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CatalogExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// CatalogExportMultiError is an error wrapping multiple validation errors
+// returned by CatalogExport.ValidateAll() if the designated constraints
+// aren't met.
+type CatalogExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CatalogExportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CatalogExportMultiError) AllErrors() []error { return m }
+
+// CatalogExportValidationError is the validation error returned by
+// CatalogExport.Validate if the designated constraints aren't met.
+type CatalogExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CatalogExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CatalogExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CatalogExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CatalogExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CatalogExportValidationError) ErrorName() string { return "CatalogExportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CatalogExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCatalogExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CatalogExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CatalogExportValidationError{}
+
+// Validate checks the field values on ExportCatalogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportCatalogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportCatalogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportCatalogRequestMultiError, or nil if none found.
+func (m *ExportCatalogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportCatalogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ExportCatalogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportCatalogRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportCatalogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportCatalogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportCatalogRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportCatalogRequestMultiError) AllErrors() []error { return m }
+
+// ExportCatalogRequestValidationError is the validation error returned by
+// ExportCatalogRequest.Validate if the designated constraints aren't met.
+type ExportCatalogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportCatalogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportCatalogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportCatalogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportCatalogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportCatalogRequestValidationError) ErrorName() string {
+	return "ExportCatalogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportCatalogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportCatalogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportCatalogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportCatalogRequestValidationError{}
+
+// Validate checks the field values on ExportCatalogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportCatalogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportCatalogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportCatalogResponseMultiError, or nil if none found.
+func (m *ExportCatalogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportCatalogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportCatalogResponseValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportCatalogResponseValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportCatalogResponseValidationError{
+				field:  "Export",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportCatalogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportCatalogResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportCatalogResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportCatalogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportCatalogResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportCatalogResponseMultiError) AllErrors() []error { return m }
+
+// ExportCatalogResponseValidationError is the validation error returned by
+// ExportCatalogResponse.Validate if the designated constraints aren't met.
+type ExportCatalogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportCatalogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportCatalogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportCatalogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportCatalogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportCatalogResponseValidationError) ErrorName() string {
+	return "ExportCatalogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportCatalogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportCatalogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportCatalogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportCatalogResponseValidationError{}
+
+// Validate checks the field values on GetCatalogExportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCatalogExportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCatalogExportsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCatalogExportsRequestMultiError, or nil if none found.
+func (m *GetCatalogExportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCatalogExportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCatalogExportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCatalogExportsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCatalogExportsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCatalogExportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCatalogExportsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCatalogExportsRequestMultiError) AllErrors() []error { return m }
+
+// GetCatalogExportsRequestValidationError is the validation error returned by
+// GetCatalogExportsRequest.Validate if the designated constraints aren't met.
+type GetCatalogExportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCatalogExportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCatalogExportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCatalogExportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCatalogExportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCatalogExportsRequestValidationError) ErrorName() string {
+	return "GetCatalogExportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCatalogExportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCatalogExportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCatalogExportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCatalogExportsRequestValidationError{}
+
+// Validate checks the field values on GetCatalogExportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCatalogExportsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCatalogExportsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCatalogExportsResponseMultiError, or nil if none found.
+func (m *GetCatalogExportsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCatalogExportsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetExports() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCatalogExportsResponseValidationError{
+						field:  fmt.Sprintf("Exports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCatalogExportsResponseValidationError{
+						field:  fmt.Sprintf("Exports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCatalogExportsResponseValidationError{
+					field:  fmt.Sprintf("Exports[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCatalogExportsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCatalogExportsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCatalogExportsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetCatalogExportsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCatalogExportsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCatalogExportsResponseMultiError) AllErrors() []error { return m }
+
+// GetCatalogExportsResponseValidationError is the validation error returned by
+// GetCatalogExportsResponse.Validate if the designated constraints aren't met.
+type GetCatalogExportsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCatalogExportsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCatalogExportsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCatalogExportsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCatalogExportsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCatalogExportsResponseValidationError) ErrorName() string {
+	return "GetCatalogExportsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCatalogExportsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCatalogExportsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCatalogExportsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCatalogExportsResponseValidationError{}
+
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Job) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Job with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobMultiError, or nil if none found.
+func (m *Job) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Job) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	// no validation rules for Payload
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for MaxAttempts
+
+	if all {
+		switch v := interface{}(m.GetRunAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "RunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "RunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRunAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "RunAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.UniqueKey != nil {
+		// no validation rules for UniqueKey
+	}
+
+	if m.LastError != nil {
+		// no validation rules for LastError
+	}
+
+	if m.FailedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetFailedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  "FailedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  "FailedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFailedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  "FailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return JobMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobMultiError is an error wrapping multiple validation errors returned by
+// Job.ValidateAll() if the designated constraints aren't met.
+type JobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobMultiError) AllErrors() []error { return m }
+
+// JobValidationError is the validation error returned by Job.Validate if the
+// designated constraints aren't met.
+type JobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobValidationError{}
+
+// Validate checks the field values on GetDeadJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeadJobsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeadJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeadJobsRequestMultiError, or nil if none found.
+func (m *GetDeadJobsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeadJobsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Page != nil {
+
+		if m.GetPage() < 0 {
+			err := GetDeadJobsRequestValidationError{
+				field:  "Page",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PageSize != nil {
+
+		if val := m.GetPageSize(); val < 1 || val > 1000 {
+			err := GetDeadJobsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDeadJobsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeadJobsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetDeadJobsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetDeadJobsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeadJobsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeadJobsRequestMultiError) AllErrors() []error { return m }
+
+// GetDeadJobsRequestValidationError is the validation error returned by
+// GetDeadJobsRequest.Validate if the designated constraints aren't met.
+type GetDeadJobsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeadJobsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeadJobsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeadJobsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeadJobsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeadJobsRequestValidationError) ErrorName() string {
+	return "GetDeadJobsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeadJobsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeadJobsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeadJobsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeadJobsRequestValidationError{}
+
+// Validate checks the field values on GetDeadJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeadJobsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeadJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeadJobsResponseMultiError, or nil if none found.
+func (m *GetDeadJobsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeadJobsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDeadJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDeadJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDeadJobsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeadJobsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeadJobsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeadJobsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDeadJobsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeadJobsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDeadJobsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeadJobsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeadJobsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeadJobsResponseMultiError) AllErrors() []error { return m }
+
+// GetDeadJobsResponseValidationError is the validation error returned by
+// GetDeadJobsResponse.Validate if the designated constraints aren't met.
+type GetDeadJobsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeadJobsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeadJobsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeadJobsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeadJobsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeadJobsResponseValidationError) ErrorName() string {
+	return "GetDeadJobsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeadJobsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeadJobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeadJobsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeadJobsResponseValidationError{}
+
+// Validate checks the field values on RetryJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RetryJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryJobRequestMultiError, or nil if none found.
+func (m *RetryJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RetryJobRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RetryJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RetryJobRequest) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RetryJobRequestMultiError is an error wrapping multiple validation errors
+// returned by RetryJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RetryJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryJobRequestMultiError) AllErrors() []error { return m }
+
+// RetryJobRequestValidationError is the validation error returned by
+// RetryJobRequest.Validate if the designated constraints aren't met.
+type RetryJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryJobRequestValidationError) ErrorName() string { return "RetryJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetryJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryJobRequestValidationError{}
+
+// Validate checks the field values on RetryJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RetryJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryJobResponseMultiError, or nil if none found.
+func (m *RetryJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetryJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetryJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetryJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RetryJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryJobResponseMultiError is an error wrapping multiple validation errors
+// returned by RetryJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RetryJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryJobResponseMultiError) AllErrors() []error { return m }
+
+// RetryJobResponseValidationError is the validation error returned by
+// RetryJobResponse.Validate if the designated constraints aren't met.
+type RetryJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryJobResponseValidationError) ErrorName() string { return "RetryJobResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetryJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryJobResponseValidationError{}
+
+// Validate checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Webhook) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WebhookMultiError, or nil if none found.
+func (m *Webhook) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Webhook) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookMultiError is an error wrapping multiple validation errors returned
+// by Webhook.ValidateAll() if the designated constraints aren't met.
+type WebhookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookMultiError) AllErrors() []error { return m }
+
+// WebhookValidationError is the validation error returned by Webhook.Validate
+// if the designated constraints aren't met.
+type WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookValidationError) ErrorName() string { return "WebhookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookValidationError{}
+
+// Validate checks the field values on WebhookDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDelivery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDelivery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveryMultiError, or nil if none found.
+func (m *WebhookDelivery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDelivery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for WebhookId
+
+	// no validation rules for Event
+
+	// no validation rules for Payload
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SongValidationError{
-					field:  "Singer",
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSinger()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SongValidationError{
-				field:  "Singer",
+			return WebhookDeliveryValidationError{
+				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetArtists() {
-		_, _ = idx, item
+	if m.ResponseCode != nil {
+		// no validation rules for ResponseCode
+	}
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if m.AttemptedAt != nil {
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetAttemptedAt()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SongValidationError{
-						field:  fmt.Sprintf("Artists[%v]", idx),
+					errors = append(errors, WebhookDeliveryValidationError{
+						field:  "AttemptedAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, SongValidationError{
-						field:  fmt.Sprintf("Artists[%v]", idx),
+					errors = append(errors, WebhookDeliveryValidationError{
+						field:  "AttemptedAt",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetAttemptedAt()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return SongValidationError{
-					field:  fmt.Sprintf("Artists[%v]", idx),
+				return WebhookDeliveryValidationError{
+					field:  "AttemptedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2147,116 +11541,296 @@ func (m *Song) validate(all bool) error {
 
 	}
 
-	// no validation rules for Name
+	if len(errors) > 0 {
+		return WebhookDeliveryMultiError(errors)
+	}
 
-	// no validation rules for SongUrl
+	return nil
+}
 
-	if all {
-		switch v := interface{}(m.GetDuration()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SongValidationError{
-					field:  "Duration",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SongValidationError{
-					field:  "Duration",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+// WebhookDeliveryMultiError is an error wrapping multiple validation errors
+// returned by WebhookDelivery.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookDeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveryMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveryValidationError is the validation error returned by
+// WebhookDelivery.Validate if the designated constraints aren't met.
+type WebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryValidationError) ErrorName() string { return "WebhookDeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryValidationError{}
+
+// Validate checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookRequestMultiError, or nil if none found.
+func (m *CreateWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+		err := CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2048 runes",
 		}
-	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SongValidationError{
-				field:  "Duration",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for WeightBytes
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetReleasedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SongValidationError{
-					field:  "ReleasedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SongValidationError{
-					field:  "ReleasedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if l := len(m.GetEvents()); l < 1 || l > 16 {
+		err := CreateWebhookRequestValidationError{
+			field:  "Events",
+			reason: "value must contain between 1 and 16 items, inclusive",
 		}
-	} else if v, ok := interface{}(m.GetReleasedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SongValidationError{
-				field:  "ReleasedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookRequestMultiError) AllErrors() []error { return m }
+
+// CreateWebhookRequestValidationError is the validation error returned by
+// CreateWebhookRequest.Validate if the designated constraints aren't met.
+type CreateWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookRequestValidationError) ErrorName() string {
+	return "CreateWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookRequestValidationError{}
+
+// Validate checks the field values on CreateWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookResponseMultiError, or nil if none found.
+func (m *CreateWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetUploadedAt()).(type) {
+		switch v := interface{}(m.GetWebhook()).(type) {
 		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SongValidationError{
-					field:  "UploadedAt",
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWebhookResponseValidationError{
+					field:  "Webhook",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SongValidationError{
-					field:  "UploadedAt",
+				errors = append(errors, CreateWebhookResponseValidationError{
+					field:  "Webhook",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUploadedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SongValidationError{
-				field:  "UploadedAt",
+			return CreateWebhookResponseValidationError{
+				field:  "Webhook",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.ImageUrl != nil {
-		// no validation rules for ImageUrl
-	}
+	// no validation rules for Secret
 
 	if len(errors) > 0 {
-		return SongMultiError(errors)
+		return CreateWebhookResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SongMultiError is an error wrapping multiple validation errors returned by
-// Song.ValidateAll() if the designated constraints aren't met.
-type SongMultiError []error
+// CreateWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SongMultiError) Error() string {
+func (m CreateWebhookResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2265,11 +11839,11 @@ func (m SongMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SongMultiError) AllErrors() []error { return m }
+func (m CreateWebhookResponseMultiError) AllErrors() []error { return m }
 
-// SongValidationError is the validation error returned by Song.Validate if the
-// designated constraints aren't met.
-type SongValidationError struct {
+// CreateWebhookResponseValidationError is the validation error returned by
+// CreateWebhookResponse.Validate if the designated constraints aren't met.
+type CreateWebhookResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2277,22 +11851,24 @@ type SongValidationError struct {
 }
 
 // Field function returns field value.
-func (e SongValidationError) Field() string { return e.field }
+func (e CreateWebhookResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SongValidationError) Reason() string { return e.reason }
+func (e CreateWebhookResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SongValidationError) Cause() error { return e.cause }
+func (e CreateWebhookResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SongValidationError) Key() bool { return e.key }
+func (e CreateWebhookResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SongValidationError) ErrorName() string { return "SongValidationError" }
+func (e CreateWebhookResponseValidationError) ErrorName() string {
+	return "CreateWebhookResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SongValidationError) Error() string {
+func (e CreateWebhookResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2304,14 +11880,14 @@ func (e SongValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSong.%s: %s%s",
+		"invalid %sCreateWebhookResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SongValidationError{}
+var _ error = CreateWebhookResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2319,216 +11895,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SongValidationError{}
+} = CreateWebhookResponseValidationError{}
 
-// Validate checks the field values on MySong with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *MySong) Validate() error {
+// Validate checks the field values on GetWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhooksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MySong with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MySongMultiError, or nil if none found.
-func (m *MySong) ValidateAll() error {
+// ValidateAll checks the field values on GetWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWebhooksRequestMultiError, or nil if none found.
+func (m *GetWebhooksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MySong) validate(all bool) error {
+func (m *GetWebhooksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetSinger()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MySongValidationError{
-					field:  "Singer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MySongValidationError{
-					field:  "Singer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSinger()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MySongValidationError{
-				field:  "Singer",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetArtists() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MySongValidationError{
-						field:  fmt.Sprintf("Artists[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MySongValidationError{
-						field:  fmt.Sprintf("Artists[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MySongValidationError{
-					field:  fmt.Sprintf("Artists[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Name
-
-	if all {
-		switch v := interface{}(m.GetUploadedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MySongValidationError{
-					field:  "UploadedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MySongValidationError{
-					field:  "UploadedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUploadedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MySongValidationError{
-				field:  "UploadedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.SongUrl != nil {
-		// no validation rules for SongUrl
-	}
-
-	if m.ImageUrl != nil {
-		// no validation rules for ImageUrl
-	}
-
-	if m.Duration != nil {
-
-		if all {
-			switch v := interface{}(m.GetDuration()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MySongValidationError{
-						field:  "Duration",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MySongValidationError{
-						field:  "Duration",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MySongValidationError{
-					field:  "Duration",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if m.WeightBytes != nil {
-		// no validation rules for WeightBytes
-	}
-
-	if m.ReleasedAt != nil {
-
-		if all {
-			switch v := interface{}(m.GetReleasedAt()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MySongValidationError{
-						field:  "ReleasedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MySongValidationError{
-						field:  "ReleasedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetReleasedAt()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MySongValidationError{
-					field:  "ReleasedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return MySongMultiError(errors)
+		return GetWebhooksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MySongMultiError is an error wrapping multiple validation errors returned by
-// MySong.ValidateAll() if the designated constraints aren't met.
-type MySongMultiError []error
+// GetWebhooksRequestMultiError is an error wrapping multiple validation errors
+// returned by GetWebhooksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetWebhooksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MySongMultiError) Error() string {
+func (m GetWebhooksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2537,11 +11941,11 @@ func (m MySongMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MySongMultiError) AllErrors() []error { return m }
+func (m GetWebhooksRequestMultiError) AllErrors() []error { return m }
 
-// MySongValidationError is the validation error returned by MySong.Validate if
-// the designated constraints aren't met.
-type MySongValidationError struct {
+// GetWebhooksRequestValidationError is the validation error returned by
+// GetWebhooksRequest.Validate if the designated constraints aren't met.
+type GetWebhooksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2549,22 +11953,24 @@ type MySongValidationError struct {
 }
 
 // Field function returns field value.
-func (e MySongValidationError) Field() string { return e.field }
+func (e GetWebhooksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MySongValidationError) Reason() string { return e.reason }
+func (e GetWebhooksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MySongValidationError) Cause() error { return e.cause }
+func (e GetWebhooksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MySongValidationError) Key() bool { return e.key }
+func (e GetWebhooksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MySongValidationError) ErrorName() string { return "MySongValidationError" }
+func (e GetWebhooksRequestValidationError) ErrorName() string {
+	return "GetWebhooksRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MySongValidationError) Error() string {
+func (e GetWebhooksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2576,14 +11982,14 @@ func (e MySongValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMySong.%s: %s%s",
+		"invalid %sGetWebhooksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MySongValidationError{}
+var _ error = GetWebhooksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2591,46 +11997,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MySongValidationError{}
+} = GetWebhooksRequestValidationError{}
 
-// Validate checks the field values on PaginationResponse with the rules
+// Validate checks the field values on GetWebhooksResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PaginationResponse) Validate() error {
+func (m *GetWebhooksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PaginationResponse with the rules
+// ValidateAll checks the field values on GetWebhooksResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PaginationResponseMultiError, or nil if none found.
-func (m *PaginationResponse) ValidateAll() error {
+// GetWebhooksResponseMultiError, or nil if none found.
+func (m *GetWebhooksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PaginationResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *GetWebhooksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWebhooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetWebhooksResponseValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetWebhooksResponseValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetWebhooksResponseValidationError{
+					field:  fmt.Sprintf("Webhooks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
-	// no validation rules for LastPage
-
 	if len(errors) > 0 {
-		return PaginationResponseMultiError(errors)
+		return GetWebhooksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PaginationResponseMultiError is an error wrapping multiple validation errors
-// returned by PaginationResponse.ValidateAll() if the designated constraints
-// aren't met.
-type PaginationResponseMultiError []error
+// GetWebhooksResponseMultiError is an error wrapping multiple validation
+// errors returned by GetWebhooksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetWebhooksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PaginationResponseMultiError) Error() string {
+func (m GetWebhooksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2639,11 +12077,11 @@ func (m PaginationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PaginationResponseMultiError) AllErrors() []error { return m }
+func (m GetWebhooksResponseMultiError) AllErrors() []error { return m }
 
-// PaginationResponseValidationError is the validation error returned by
-// PaginationResponse.Validate if the designated constraints aren't met.
-type PaginationResponseValidationError struct {
+// GetWebhooksResponseValidationError is the validation error returned by
+// GetWebhooksResponse.Validate if the designated constraints aren't met.
+type GetWebhooksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2651,24 +12089,24 @@ type PaginationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PaginationResponseValidationError) Field() string { return e.field }
+func (e GetWebhooksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PaginationResponseValidationError) Reason() string { return e.reason }
+func (e GetWebhooksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PaginationResponseValidationError) Cause() error { return e.cause }
+func (e GetWebhooksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PaginationResponseValidationError) Key() bool { return e.key }
+func (e GetWebhooksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PaginationResponseValidationError) ErrorName() string {
-	return "PaginationResponseValidationError"
+func (e GetWebhooksResponseValidationError) ErrorName() string {
+	return "GetWebhooksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PaginationResponseValidationError) Error() string {
+func (e GetWebhooksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2680,14 +12118,14 @@ func (e PaginationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPaginationResponse.%s: %s%s",
+		"invalid %sGetWebhooksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PaginationResponseValidationError{}
+var _ error = GetWebhooksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2695,126 +12133,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PaginationResponseValidationError{}
+} = GetWebhooksResponseValidationError{}
 
-// Validate checks the field values on GetSongsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSongsRequest) Validate() error {
+// Validate checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSongsRequest with the rules
+// ValidateAll checks the field values on DeleteWebhookRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSongsRequestMultiError, or nil if none found.
-func (m *GetSongsRequest) ValidateAll() error {
+// DeleteWebhookRequestMultiError, or nil if none found.
+func (m *DeleteWebhookRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSongsRequest) validate(all bool) error {
+func (m *DeleteWebhookRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetIds()) > 0 {
-
-		if len(m.GetIds()) > 2000 {
-			err := GetSongsRequestValidationError{
-				field:  "Ids",
-				reason: "value must contain no more than 2000 item(s)",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.Page != nil {
-
-		if m.GetPage() < 0 {
-			err := GetSongsRequestValidationError{
-				field:  "Page",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.PageSize != nil {
-
-		if m.GetPageSize() > 1000 {
-			err := GetSongsRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.ArtistId != nil {
-
-		if m.GetArtistId() != "" {
-
-			if err := m._validateUuid(m.GetArtistId()); err != nil {
-				err = GetSongsRequestValidationError{
-					field:  "ArtistId",
-					reason: "value must be a valid UUID",
-					cause:  err,
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteWebhookRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
 		}
-
-	}
-
-	if m.MatchArtist != nil {
-		// no validation rules for MatchArtist
-	}
-
-	if m.MatchName != nil {
-
-		if m.GetMatchName() != "" {
-
-			if l := utf8.RuneCountInString(m.GetMatchName()); l < 1 || l > 64 {
-				err := GetSongsRequestValidationError{
-					field:  "MatchName",
-					reason: "value length must be between 1 and 64 runes, inclusive",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
+		if !all {
+			return err
 		}
-
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetSongsRequestMultiError(errors)
+		return DeleteWebhookRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *GetSongsRequest) _validateUuid(uuid string) error {
+func (m *DeleteWebhookRequest) _validateUuid(uuid string) error {
 	if matched := _types_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -2822,13 +12184,13 @@ func (m *GetSongsRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// GetSongsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetSongsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetSongsRequestMultiError []error
+// DeleteWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSongsRequestMultiError) Error() string {
+func (m DeleteWebhookRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2837,11 +12199,11 @@ func (m GetSongsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSongsRequestMultiError) AllErrors() []error { return m }
+func (m DeleteWebhookRequestMultiError) AllErrors() []error { return m }
 
-// GetSongsRequestValidationError is the validation error returned by
-// GetSongsRequest.Validate if the designated constraints aren't met.
-type GetSongsRequestValidationError struct {
+// DeleteWebhookRequestValidationError is the validation error returned by
+// DeleteWebhookRequest.Validate if the designated constraints aren't met.
+type DeleteWebhookRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2849,22 +12211,24 @@ type GetSongsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSongsRequestValidationError) Field() string { return e.field }
+func (e DeleteWebhookRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSongsRequestValidationError) Reason() string { return e.reason }
+func (e DeleteWebhookRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSongsRequestValidationError) Cause() error { return e.cause }
+func (e DeleteWebhookRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSongsRequestValidationError) Key() bool { return e.key }
+func (e DeleteWebhookRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSongsRequestValidationError) ErrorName() string { return "GetSongsRequestValidationError" }
+func (e DeleteWebhookRequestValidationError) ErrorName() string {
+	return "DeleteWebhookRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetSongsRequestValidationError) Error() string {
+func (e DeleteWebhookRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2876,122 +12240,59 @@ func (e GetSongsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSongsRequest.%s: %s%s",
+		"invalid %sDeleteWebhookRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSongsRequestValidationError{}
+var _ error = DeleteWebhookRequestValidationError{}
 
 var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetSongsRequestValidationError{}
-
-// Validate checks the field values on GetSongsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSongsResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetSongsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetSongsResponseMultiError, or nil if none found.
-func (m *GetSongsResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *GetSongsResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetSongs() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetSongsResponseValidationError{
-						field:  fmt.Sprintf("Songs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetSongsResponseValidationError{
-						field:  fmt.Sprintf("Songs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetSongsResponseValidationError{
-					field:  fmt.Sprintf("Songs[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if all {
-		switch v := interface{}(m.GetPagination()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetSongsResponseValidationError{
-					field:  "Pagination",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetSongsResponseValidationError{
-					field:  "Pagination",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetSongsResponseValidationError{
-				field:  "Pagination",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookRequestValidationError{}
+
+// Validate checks the field values on DeleteWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookResponseMultiError, or nil if none found.
+func (m *DeleteWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return GetSongsResponseMultiError(errors)
+		return DeleteWebhookResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSongsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetSongsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetSongsResponseMultiError []error
+// DeleteWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSongsResponseMultiError) Error() string {
+func (m DeleteWebhookResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3000,11 +12301,11 @@ func (m GetSongsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSongsResponseMultiError) AllErrors() []error { return m }
+func (m DeleteWebhookResponseMultiError) AllErrors() []error { return m }
 
-// GetSongsResponseValidationError is the validation error returned by
-// GetSongsResponse.Validate if the designated constraints aren't met.
-type GetSongsResponseValidationError struct {
+// DeleteWebhookResponseValidationError is the validation error returned by
+// DeleteWebhookResponse.Validate if the designated constraints aren't met.
+type DeleteWebhookResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3012,22 +12313,24 @@ type GetSongsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSongsResponseValidationError) Field() string { return e.field }
+func (e DeleteWebhookResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSongsResponseValidationError) Reason() string { return e.reason }
+func (e DeleteWebhookResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSongsResponseValidationError) Cause() error { return e.cause }
+func (e DeleteWebhookResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSongsResponseValidationError) Key() bool { return e.key }
+func (e DeleteWebhookResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSongsResponseValidationError) ErrorName() string { return "GetSongsResponseValidationError" }
+func (e DeleteWebhookResponseValidationError) ErrorName() string {
+	return "DeleteWebhookResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetSongsResponseValidationError) Error() string {
+func (e DeleteWebhookResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3039,14 +12342,14 @@ func (e GetSongsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSongsResponse.%s: %s%s",
+		"invalid %sDeleteWebhookResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSongsResponseValidationError{}
+var _ error = DeleteWebhookResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3054,66 +12357,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSongsResponseValidationError{}
+} = DeleteWebhookResponseValidationError{}
 
-// Validate checks the field values on GetMySongsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetMySongsRequest) Validate() error {
+// Validate checks the field values on GetWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhookDeliveriesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMySongsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMySongsRequestMultiError, or nil if none found.
-func (m *GetMySongsRequest) ValidateAll() error {
+// GetWebhookDeliveriesRequestMultiError, or nil if none found.
+func (m *GetWebhookDeliveriesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMySongsRequest) validate(all bool) error {
+func (m *GetWebhookDeliveriesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetIds()) > 0 {
-
-		if l := len(m.GetIds()); l < 1 || l > 2000 {
-			err := GetMySongsRequestValidationError{
-				field:  "Ids",
-				reason: "value must contain between 1 and 2000 items, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if err := m._validateUuid(m.GetWebhookId()); err != nil {
+		err = GetWebhookDeliveriesRequestValidationError{
+			field:  "WebhookId",
+			reason: "value must be a valid UUID",
+			cause:  err,
 		}
-
-		for idx, item := range m.GetIds() {
-			_, _ = idx, item
-
-			if err := m._validateUuid(item); err != nil {
-				err = GetMySongsRequestValidationError{
-					field:  fmt.Sprintf("Ids[%v]", idx),
-					reason: "value must be a valid UUID",
-					cause:  err,
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
+		if !all {
+			return err
 		}
-
+		errors = append(errors, err)
 	}
 
 	if m.Page != nil {
 
 		if m.GetPage() < 0 {
-			err := GetMySongsRequestValidationError{
+			err := GetWebhookDeliveriesRequestValidationError{
 				field:  "Page",
 				reason: "value must be greater than or equal to 0",
 			}
@@ -3128,7 +12411,7 @@ func (m *GetMySongsRequest) validate(all bool) error {
 	if m.PageSize != nil {
 
 		if val := m.GetPageSize(); val < 1 || val > 1000 {
-			err := GetMySongsRequestValidationError{
+			err := GetWebhookDeliveriesRequestValidationError{
 				field:  "PageSize",
 				reason: "value must be inside range [1, 1000]",
 			}
@@ -3141,13 +12424,13 @@ func (m *GetMySongsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetMySongsRequestMultiError(errors)
+		return GetWebhookDeliveriesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *GetMySongsRequest) _validateUuid(uuid string) error {
+func (m *GetWebhookDeliveriesRequest) _validateUuid(uuid string) error {
 	if matched := _types_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -3155,13 +12438,13 @@ func (m *GetMySongsRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// GetMySongsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetMySongsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetMySongsRequestMultiError []error
+// GetWebhookDeliveriesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetWebhookDeliveriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetWebhookDeliveriesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMySongsRequestMultiError) Error() string {
+func (m GetWebhookDeliveriesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3170,11 +12453,12 @@ func (m GetMySongsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMySongsRequestMultiError) AllErrors() []error { return m }
+func (m GetWebhookDeliveriesRequestMultiError) AllErrors() []error { return m }
 
-// GetMySongsRequestValidationError is the validation error returned by
-// GetMySongsRequest.Validate if the designated constraints aren't met.
-type GetMySongsRequestValidationError struct {
+// GetWebhookDeliveriesRequestValidationError is the validation error returned
+// by GetWebhookDeliveriesRequest.Validate if the designated constraints
+// aren't met.
+type GetWebhookDeliveriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3182,24 +12466,24 @@ type GetMySongsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMySongsRequestValidationError) Field() string { return e.field }
+func (e GetWebhookDeliveriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMySongsRequestValidationError) Reason() string { return e.reason }
+func (e GetWebhookDeliveriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMySongsRequestValidationError) Cause() error { return e.cause }
+func (e GetWebhookDeliveriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMySongsRequestValidationError) Key() bool { return e.key }
+func (e GetWebhookDeliveriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMySongsRequestValidationError) ErrorName() string {
-	return "GetMySongsRequestValidationError"
+func (e GetWebhookDeliveriesRequestValidationError) ErrorName() string {
+	return "GetWebhookDeliveriesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMySongsRequestValidationError) Error() string {
+func (e GetWebhookDeliveriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3211,14 +12495,14 @@ func (e GetMySongsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMySongsRequest.%s: %s%s",
+		"invalid %sGetWebhookDeliveriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMySongsRequestValidationError{}
+var _ error = GetWebhookDeliveriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3226,47 +12510,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMySongsRequestValidationError{}
+} = GetWebhookDeliveriesRequestValidationError{}
 
-// Validate checks the field values on GetMySongsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetWebhookDeliveriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetMySongsResponse) Validate() error {
+func (m *GetWebhookDeliveriesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMySongsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetWebhookDeliveriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMySongsResponseMultiError, or nil if none found.
-func (m *GetMySongsResponse) ValidateAll() error {
+// GetWebhookDeliveriesResponseMultiError, or nil if none found.
+func (m *GetWebhookDeliveriesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMySongsResponse) validate(all bool) error {
+func (m *GetWebhookDeliveriesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSongs() {
+	for idx, item := range m.GetDeliveries() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetMySongsResponseValidationError{
-						field:  fmt.Sprintf("Songs[%v]", idx),
+					errors = append(errors, GetWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetMySongsResponseValidationError{
-						field:  fmt.Sprintf("Songs[%v]", idx),
+					errors = append(errors, GetWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3274,8 +12558,8 @@ func (m *GetMySongsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetMySongsResponseValidationError{
-					field:  fmt.Sprintf("Songs[%v]", idx),
+				return GetWebhookDeliveriesResponseValidationError{
+					field:  fmt.Sprintf("Deliveries[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3288,7 +12572,7 @@ func (m *GetMySongsResponse) validate(all bool) error {
 		switch v := interface{}(m.GetPagination()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetMySongsResponseValidationError{
+				errors = append(errors, GetWebhookDeliveriesResponseValidationError{
 					field:  "Pagination",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3296,7 +12580,7 @@ func (m *GetMySongsResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetMySongsResponseValidationError{
+				errors = append(errors, GetWebhookDeliveriesResponseValidationError{
 					field:  "Pagination",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3305,7 +12589,7 @@ func (m *GetMySongsResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetMySongsResponseValidationError{
+			return GetWebhookDeliveriesResponseValidationError{
 				field:  "Pagination",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3314,19 +12598,19 @@ func (m *GetMySongsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetMySongsResponseMultiError(errors)
+		return GetWebhookDeliveriesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMySongsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetMySongsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetMySongsResponseMultiError []error
+// GetWebhookDeliveriesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetWebhookDeliveriesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetWebhookDeliveriesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMySongsResponseMultiError) Error() string {
+func (m GetWebhookDeliveriesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3335,11 +12619,12 @@ func (m GetMySongsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMySongsResponseMultiError) AllErrors() []error { return m }
+func (m GetWebhookDeliveriesResponseMultiError) AllErrors() []error { return m }
 
-// GetMySongsResponseValidationError is the validation error returned by
-// GetMySongsResponse.Validate if the designated constraints aren't met.
-type GetMySongsResponseValidationError struct {
+// GetWebhookDeliveriesResponseValidationError is the validation error returned
+// by GetWebhookDeliveriesResponse.Validate if the designated constraints
+// aren't met.
+type GetWebhookDeliveriesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3347,24 +12632,24 @@ type GetMySongsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMySongsResponseValidationError) Field() string { return e.field }
+func (e GetWebhookDeliveriesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMySongsResponseValidationError) Reason() string { return e.reason }
+func (e GetWebhookDeliveriesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMySongsResponseValidationError) Cause() error { return e.cause }
+func (e GetWebhookDeliveriesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMySongsResponseValidationError) Key() bool { return e.key }
+func (e GetWebhookDeliveriesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMySongsResponseValidationError) ErrorName() string {
-	return "GetMySongsResponseValidationError"
+func (e GetWebhookDeliveriesResponseValidationError) ErrorName() string {
+	return "GetWebhookDeliveriesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMySongsResponseValidationError) Error() string {
+func (e GetWebhookDeliveriesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3376,14 +12661,14 @@ func (e GetMySongsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMySongsResponse.%s: %s%s",
+		"invalid %sGetWebhookDeliveriesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMySongsResponseValidationError{}
+var _ error = GetWebhookDeliveriesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3391,34 +12676,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMySongsResponseValidationError{}
+} = GetWebhookDeliveriesResponseValidationError{}
 
-// Validate checks the field values on ReleaseSongsRequest with the rules
+// Validate checks the field values on RedeliverWebhookRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReleaseSongsRequest) Validate() error {
+func (m *RedeliverWebhookRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReleaseSongsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RedeliverWebhookRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReleaseSongsRequestMultiError, or nil if none found.
-func (m *ReleaseSongsRequest) ValidateAll() error {
+// RedeliverWebhookRequestMultiError, or nil if none found.
+func (m *RedeliverWebhookRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReleaseSongsRequest) validate(all bool) error {
+func (m *RedeliverWebhookRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := len(m.GetIds()); l < 1 || l > 2000 {
-		err := ReleaseSongsRequestValidationError{
-			field:  "Ids",
-			reason: "value must contain between 1 and 2000 items, inclusive",
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RedeliverWebhookRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
 		}
 		if !all {
 			return err
@@ -3426,33 +12712,14 @@ func (m *ReleaseSongsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetIds() {
-		_, _ = idx, item
-
-		if err := m._validateUuid(item); err != nil {
-			err = ReleaseSongsRequestValidationError{
-				field:  fmt.Sprintf("Ids[%v]", idx),
-				reason: "value must be a valid UUID",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for Notify
-
 	if len(errors) > 0 {
-		return ReleaseSongsRequestMultiError(errors)
+		return RedeliverWebhookRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *ReleaseSongsRequest) _validateUuid(uuid string) error {
+func (m *RedeliverWebhookRequest) _validateUuid(uuid string) error {
 	if matched := _types_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -3460,13 +12727,13 @@ func (m *ReleaseSongsRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// ReleaseSongsRequestMultiError is an error wrapping multiple validation
-// errors returned by ReleaseSongsRequest.ValidateAll() if the designated
+// RedeliverWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by RedeliverWebhookRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ReleaseSongsRequestMultiError []error
+type RedeliverWebhookRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReleaseSongsRequestMultiError) Error() string {
+func (m RedeliverWebhookRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3475,11 +12742,11 @@ func (m ReleaseSongsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReleaseSongsRequestMultiError) AllErrors() []error { return m }
+func (m RedeliverWebhookRequestMultiError) AllErrors() []error { return m }
 
-// ReleaseSongsRequestValidationError is the validation error returned by
-// ReleaseSongsRequest.Validate if the designated constraints aren't met.
-type ReleaseSongsRequestValidationError struct {
+// RedeliverWebhookRequestValidationError is the validation error returned by
+// RedeliverWebhookRequest.Validate if the designated constraints aren't met.
+type RedeliverWebhookRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3487,24 +12754,24 @@ type ReleaseSongsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReleaseSongsRequestValidationError) Field() string { return e.field }
+func (e RedeliverWebhookRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReleaseSongsRequestValidationError) Reason() string { return e.reason }
+func (e RedeliverWebhookRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReleaseSongsRequestValidationError) Cause() error { return e.cause }
+func (e RedeliverWebhookRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReleaseSongsRequestValidationError) Key() bool { return e.key }
+func (e RedeliverWebhookRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReleaseSongsRequestValidationError) ErrorName() string {
-	return "ReleaseSongsRequestValidationError"
+func (e RedeliverWebhookRequestValidationError) ErrorName() string {
+	return "RedeliverWebhookRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReleaseSongsRequestValidationError) Error() string {
+func (e RedeliverWebhookRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3516,14 +12783,14 @@ func (e ReleaseSongsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReleaseSongsRequest.%s: %s%s",
+		"invalid %sRedeliverWebhookRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReleaseSongsRequestValidationError{}
+var _ error = RedeliverWebhookRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3531,44 +12798,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReleaseSongsRequestValidationError{}
+} = RedeliverWebhookRequestValidationError{}
 
-// Validate checks the field values on ReleaseSongsResponse with the rules
+// Validate checks the field values on RedeliverWebhookResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReleaseSongsResponse) Validate() error {
+func (m *RedeliverWebhookResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReleaseSongsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RedeliverWebhookResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReleaseSongsResponseMultiError, or nil if none found.
-func (m *ReleaseSongsResponse) ValidateAll() error {
+// RedeliverWebhookResponseMultiError, or nil if none found.
+func (m *RedeliverWebhookResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReleaseSongsResponse) validate(all bool) error {
+func (m *RedeliverWebhookResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetDelivery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RedeliverWebhookResponseValidationError{
+					field:  "Delivery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RedeliverWebhookResponseValidationError{
+					field:  "Delivery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDelivery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RedeliverWebhookResponseValidationError{
+				field:  "Delivery",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ReleaseSongsResponseMultiError(errors)
+		return RedeliverWebhookResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReleaseSongsResponseMultiError is an error wrapping multiple validation
-// errors returned by ReleaseSongsResponse.ValidateAll() if the designated
+// RedeliverWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by RedeliverWebhookResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ReleaseSongsResponseMultiError []error
+type RedeliverWebhookResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReleaseSongsResponseMultiError) Error() string {
+func (m RedeliverWebhookResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3577,11 +12873,11 @@ func (m ReleaseSongsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReleaseSongsResponseMultiError) AllErrors() []error { return m }
+func (m RedeliverWebhookResponseMultiError) AllErrors() []error { return m }
 
-// ReleaseSongsResponseValidationError is the validation error returned by
-// ReleaseSongsResponse.Validate if the designated constraints aren't met.
-type ReleaseSongsResponseValidationError struct {
+// RedeliverWebhookResponseValidationError is the validation error returned by
+// RedeliverWebhookResponse.Validate if the designated constraints aren't met.
+type RedeliverWebhookResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3589,24 +12885,24 @@ type ReleaseSongsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReleaseSongsResponseValidationError) Field() string { return e.field }
+func (e RedeliverWebhookResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReleaseSongsResponseValidationError) Reason() string { return e.reason }
+func (e RedeliverWebhookResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReleaseSongsResponseValidationError) Cause() error { return e.cause }
+func (e RedeliverWebhookResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReleaseSongsResponseValidationError) Key() bool { return e.key }
+func (e RedeliverWebhookResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReleaseSongsResponseValidationError) ErrorName() string {
-	return "ReleaseSongsResponseValidationError"
+func (e RedeliverWebhookResponseValidationError) ErrorName() string {
+	return "RedeliverWebhookResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReleaseSongsResponseValidationError) Error() string {
+func (e RedeliverWebhookResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3618,14 +12914,14 @@ func (e ReleaseSongsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReleaseSongsResponse.%s: %s%s",
+		"invalid %sRedeliverWebhookResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReleaseSongsResponseValidationError{}
+var _ error = RedeliverWebhookResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3633,4 +12929,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReleaseSongsResponseValidationError{}
+} = RedeliverWebhookResponseValidationError{}
@@ -1,39 +1,102 @@
+// Miniusers is a fake users service for the local development, it serves the users seeded from a YAML file.
+// With the token command it prints a token for any user instead.
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"net"
 	"os"
+	"os/signal"
 	"time"
 
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api/clients/users"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audio/auth"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 var (
-	pubStr  = "Gvbo6JyyS410wg87Gq0N9kphc67A5Lb1VS1wupzwYTU=" //nolint:unused
+	pubStr  = "Gvbo6JyyS410wg87Gq0N9kphc67A5Lb1VS1wupzwYTU="
 	privStr = "YerVSsnvOioynes/DkZ8cftHYvlSGimZVz/MRMi23l0a9ujonLJLjXTCDzsarQ32SmFzrsDktvVVLXC6nPBhNQ=="
 )
 
+const defaultExp = time.Hour * 24 * 365 * 100
+
 func main() {
-	userId := flag.String("uuid", uuid.New().String(), "user's uuid to generate token for")
-	isArtist := flag.Bool("artist", true, "is user an artist")
-	exp := flag.Duration("exp", time.Hour*24*365*100, "token expiration")
+	if len(os.Args) > 1 && os.Args[1] == "token" {
+		token(os.Args[2:])
+		return
+	}
+
+	serve(os.Args[1:])
+}
+
+func serve(args []string) {
+	flags := flag.NewFlagSet("miniusers", flag.ExitOnError)
+	addr := flags.String("addr", ":9090", "address of the grpc server")
+	seedPath := flags.String("seed", "cmd/miniusers/users.yaml", "seeded artists and listeners")
+	exp := flags.Duration("exp", defaultExp, "expiration of the tokens given on login")
+
+	_ = flags.Parse(args)
+
+	seed := must(LoadSeed(*seedPath))
+	signer := must(auth.NewSigner(privStr, *exp))
+
+	srv := grpc.NewServer()
+	users.RegisterUsersServiceServer(srv, newServer(seed, signer))
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+
+	lis := must(net.Listen("tcp", *addr))
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+
+	_, _ = fmt.Println("serving users on", lis.Addr())                                   //nolint:forbidigo
+	_, _ = fmt.Println("artists:", len(seed.Artists), "listeners:", len(seed.Listeners)) //nolint:forbidigo
+	_, _ = fmt.Println("public key:", pubStr)                                            //nolint:forbidigo
+
+	err := srv.Serve(lis)
+	if err != nil {
+		_, _ = fmt.Println(err) //nolint:forbidigo
+		os.Exit(1)              //nolint:revive,wsl
+	}
+}
+
+func token(args []string) {
+	flags := flag.NewFlagSet("miniusers token", flag.ExitOnError)
+	userId := flags.String("uuid", uuid.New().String(), "user's uuid to generate token for")
+	isArtist := flags.Bool("artist", true, "is user an artist")
+	isAdmin := flags.Bool("admin", false, "is user an admin")
+	hideExplicit := flags.Bool("hide-explicit", false, "does user hide explicit songs")
+	exp := flags.Duration("exp", defaultExp, "token expiration")
 
-	flag.Parse()
+	_ = flags.Parse(args)
 
 	signer := must(auth.NewSigner(privStr, *exp))
 
 	tkn := must(signer.Sign(auth.Token{ //nolint:exhaustruct
-		Subject:  must(uuid.Parse(*userId)),
-		IsArtist: *isArtist,
+		Subject:      must(uuid.Parse(*userId)),
+		IsArtist:     *isArtist,
+		IsAdmin:      *isAdmin,
+		HideExplicit: *hideExplicit,
 	}))
 
-	_, _ = fmt.Println("user id:", *userId)     //nolint:forbidigo
-	_, _ = fmt.Println("is artist:", *isArtist) //nolint:forbidigo
-	_, _ = fmt.Println("exp:", *exp)            //nolint:forbidigo
-	_, _ = fmt.Println(tkn)                     //nolint:forbidigo
+	_, _ = fmt.Println("user id:", *userId)             //nolint:forbidigo
+	_, _ = fmt.Println("is artist:", *isArtist)         //nolint:forbidigo
+	_, _ = fmt.Println("is admin:", *isAdmin)           //nolint:forbidigo
+	_, _ = fmt.Println("hide explicit:", *hideExplicit) //nolint:forbidigo
+	_, _ = fmt.Println("exp:", *exp)                    //nolint:forbidigo
+	_, _ = fmt.Println(tkn)                             //nolint:forbidigo
 }
 
 func must[T any](v T, err error) T {
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"os"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// Seed is the users the fake service knows, see users.yaml.
+type Seed struct {
+	Artists   []SeedArtist   `yaml:"artists"`
+	Listeners []SeedListener `yaml:"listeners"`
+}
+
+type SeedUser struct {
+	Id       uuid.UUID `yaml:"id"`
+	Name     string    `yaml:"name"`
+	Email    string    `yaml:"email"`
+	Password string    `yaml:"password"`
+	// Admins get the moderation endpoints
+	Admin        bool   `yaml:"admin"`
+	HideExplicit bool   `yaml:"hideExplicit"`
+	AvatarUrl    string `yaml:"avatarUrl"`
+}
+
+type SeedArtist struct {
+	SeedUser `yaml:",inline"`
+	Label    string `yaml:"label"`
+}
+
+type SeedListener struct {
+	SeedUser `yaml:",inline"`
+	// Ids of the followed artists
+	Follows []uuid.UUID `yaml:"follows"`
+}
+
+var (
+	ErrDuplicateUser = e.New("duplicate user")
+	ErrUnknownArtist = e.New("unknown followed artist")
+)
+
+func LoadSeed(path string) (Seed, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return Seed{}, e.NewFrom("reading seed", err, fields.F("path", path))
+	}
+
+	var seed Seed
+
+	err = yaml.Unmarshal(content, &seed)
+	if err != nil {
+		return Seed{}, e.NewFrom("parsing seed", err, fields.F("path", path))
+	}
+
+	err = seed.validate()
+	if err != nil {
+		return Seed{}, err
+	}
+
+	return seed, nil
+}
+
+// validate checks that the ids and emails are unique and the followed artists exist.
+func (s Seed) validate() error {
+	ids := make(map[uuid.UUID]bool)
+	emails := make(map[string]bool)
+
+	add := func(user SeedUser) error {
+		if ids[user.Id] || emails[user.Email] {
+			return ErrDuplicateUser.WithFields(fields.F("id", user.Id), fields.F("email", user.Email))
+		}
+
+		ids[user.Id] = true
+		emails[user.Email] = true
+
+		return nil
+	}
+
+	for _, artist := range s.Artists {
+		err := add(artist.SeedUser)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, listener := range s.Listeners {
+		err := add(listener.SeedUser)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, listener := range s.Listeners {
+		for _, id := range listener.Follows {
+			if _, err := s.artist(id); err != nil {
+				return ErrUnknownArtist.WithFields(fields.F("listener", listener.Id), fields.F("artist", id))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s Seed) artist(id uuid.UUID) (SeedArtist, error) {
+	for _, artist := range s.Artists {
+		if artist.Id == id {
+			return artist, nil
+		}
+	}
+
+	return SeedArtist{}, ErrUnknownArtist
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api/clients/users"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audio/auth"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// server is the fake users service, it answers from the seed and keeps no state.
+type server struct {
+	users.UnimplementedUsersServiceServer
+
+	seed   Seed
+	signer auth.Signer
+}
+
+func newServer(seed Seed, signer auth.Signer) *server {
+	return &server{
+		UnimplementedUsersServiceServer: users.UnimplementedUsersServiceServer{},
+		seed:                            seed,
+		signer:                          signer,
+	}
+}
+
+// Login signs the access token of the seeded user, the refresh token is never accepted back.
+func (s *server) Login(_ context.Context, req *users.LoginRequest) (*users.LoginResponse, error) {
+	user, isArtist, ok := s.userByEmail(req.GetEmail())
+	if !ok || user.Password != req.GetPassword() {
+		return nil, status.Error(codes.Unauthenticated, "wrong email or password")
+	}
+
+	token, err := s.signer.Sign(auth.Token{ //nolint:exhaustruct
+		Subject:      user.Id,
+		IsArtist:     isArtist,
+		IsAdmin:      user.Admin,
+		HideExplicit: user.HideExplicit,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &users.LoginResponse{
+		Tokens: &users.Tokens{
+			AccessToken:  token,
+			RefreshToken: uuid.NewString(),
+		},
+	}, nil
+}
+
+// GetArtists returns the artists matching any of the filters, like the real service does.
+// The id, name and label fields with the eq, neq and like operators are supported, the sort is ignored.
+func (s *server) GetArtists(_ context.Context, req *users.GetArtistsRequest) (*users.GetArtistsResponse, error) {
+	var matched []*users.Artist
+
+	for _, artist := range s.seed.Artists {
+		ok, err := matchesAny(artist, req.GetFilter())
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			matched = append(matched, mapArtist(artist))
+		}
+	}
+
+	page, pagination := paginate(matched, req.GetPagination())
+
+	return &users.GetArtistsResponse{
+		Artists:    page,
+		Pagination: pagination,
+	}, nil
+}
+
+func (s *server) GetArtist(_ context.Context, req *users.GetArtistRequest) (*users.GetArtistResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	artist, err := s.seed.artist(id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "artist not found")
+	}
+
+	return &users.GetArtistResponse{Artist: mapArtist(artist)}, nil
+}
+
+// GetFollowers returns the listeners following the artist, songs counts them for the analytics.
+func (s *server) GetFollowers(_ context.Context, req *users.GetFollowersRequest,
+) (*users.GetFollowersResponse, error) {
+	id, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	var followers []*users.Listener
+
+	for _, listener := range s.seed.Listeners {
+		for _, followed := range listener.Follows {
+			if followed == id {
+				followers = append(followers, s.mapListener(listener))
+				break
+			}
+		}
+	}
+
+	page, pagination := paginate(followers, req.GetPagination())
+
+	return &users.GetFollowersResponse{
+		Users:      page,
+		Pagination: pagination,
+	}, nil
+}
+
+func (s *server) userByEmail(email string) (SeedUser, bool, bool) {
+	for _, artist := range s.seed.Artists {
+		if artist.Email == email {
+			return artist.SeedUser, true, true
+		}
+	}
+
+	for _, listener := range s.seed.Listeners {
+		if listener.Email == email {
+			return listener.SeedUser, false, true
+		}
+	}
+
+	return SeedUser{}, false, false
+}
+
+func matchesAny(artist SeedArtist, filters []*users.Filter) (bool, error) {
+	if len(filters) == 0 {
+		return true, nil
+	}
+
+	for _, filter := range filters {
+		var value string
+
+		switch filter.GetField() {
+		case "id":
+			value = artist.Id.String()
+		case "name":
+			value = artist.Name
+		case "label":
+			value = artist.Label
+		default:
+			return false, status.Error(codes.InvalidArgument, "unsupported filter field "+filter.GetField())
+		}
+
+		ok, err := match(value, filter.GetOperator(), filter.GetValue())
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func match(value, operator, want string) (bool, error) {
+	switch operator {
+	case "eq":
+		return value == want, nil
+	case "neq":
+		return value != want, nil
+	case "like":
+		return strings.Contains(value, want), nil
+	}
+
+	return false, status.Error(codes.InvalidArgument, "unsupported filter operator "+operator)
+}
+
+// paginate returns the page of the items, without a limit it is all the items from the offset.
+func paginate[T any](items []T, req *users.PaginationRequest) ([]T, *users.PaginationResponse) {
+	total := int64(len(items))
+	offset := min(max(req.GetOffset(), 0), total)
+
+	end := total
+	if req.GetLimit() > 0 {
+		end = min(offset+req.GetLimit(), total)
+	}
+
+	lastPage := int64(1)
+	if req.GetLimit() > 0 && total > 0 {
+		lastPage = (total + req.GetLimit() - 1) / req.GetLimit()
+	}
+
+	return items[offset:end], &users.PaginationResponse{
+		Total:    total,
+		HasNext:  end < total,
+		LastPage: lastPage,
+	}
+}
+
+func mapArtist(artist SeedArtist) *users.Artist {
+	return &users.Artist{
+		Id:        artist.Id.String(),
+		Name:      artist.Name,
+		Label:     artist.Label,
+		AvatarUrl: artist.AvatarUrl,
+	}
+}
+
+func (s *server) mapListener(listener SeedListener) *users.Listener {
+	followed := make([]*users.Artist, 0, len(listener.Follows))
+
+	for _, id := range listener.Follows {
+		// The seed is validated, every followed artist exists
+		artist, _ := s.seed.artist(id)
+		followed = append(followed, mapArtist(artist))
+	}
+
+	return &users.Listener{
+		Id:              listener.Id.String(),
+		Name:            listener.Name,
+		FollowedArtists: followed,
+		AvatarUrl:       listener.AvatarUrl,
+	}
+}
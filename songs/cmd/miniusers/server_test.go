@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api/clients/users"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audio/auth"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	velvetId = "afe1d17c-fa31-4014-a3b0-77b8635c741d"
+	miraId   = "b1cb2661-0be5-4b38-bffd-604a7328ac90"
+)
+
+func newTestServer(t *testing.T) *server {
+	t.Helper()
+
+	seed, err := LoadSeed("users.yaml")
+	require.NoError(t, err)
+
+	signer, err := auth.NewSigner(privStr, time.Hour)
+	require.NoError(t, err)
+
+	return newServer(seed, signer)
+}
+
+func TestGetArtistsByIds(t *testing.T) {
+	srv := newTestServer(t)
+
+	resp, err := srv.GetArtists(context.Background(), &users.GetArtistsRequest{
+		Filter: []*users.Filter{
+			{Field: "id", Operator: "eq", Value: velvetId},
+			{Field: "id", Operator: "eq", Value: miraId},
+			{Field: "id", Operator: "eq", Value: uuid.NewString()},
+		},
+		Pagination: &users.PaginationRequest{Limit: 10},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, resp.GetArtists(), 2)
+	assert.Equal(t, velvetId, resp.GetArtists()[0].GetId())
+	assert.Equal(t, miraId, resp.GetArtists()[1].GetId())
+	assert.Equal(t, int64(2), resp.GetPagination().GetTotal())
+	assert.False(t, resp.GetPagination().GetHasNext())
+}
+
+func TestGetArtistsPagination(t *testing.T) {
+	srv := newTestServer(t)
+
+	resp, err := srv.GetArtists(context.Background(), &users.GetArtistsRequest{
+		Pagination: &users.PaginationRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, resp.GetArtists(), 1)
+	assert.Equal(t, miraId, resp.GetArtists()[0].GetId())
+	assert.Equal(t, int64(3), resp.GetPagination().GetTotal())
+	assert.Equal(t, int64(3), resp.GetPagination().GetLastPage())
+	assert.True(t, resp.GetPagination().GetHasNext())
+}
+
+func TestGetArtistsUnsupportedFilter(t *testing.T) {
+	srv := newTestServer(t)
+
+	_, err := srv.GetArtists(context.Background(), &users.GetArtistsRequest{
+		Filter: []*users.Filter{{Field: "id", Operator: "gt", Value: velvetId}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetArtist(t *testing.T) {
+	srv := newTestServer(t)
+
+	resp, err := srv.GetArtist(context.Background(), &users.GetArtistRequest{Id: miraId})
+	require.NoError(t, err)
+	assert.Equal(t, "Mira Holloway", resp.GetArtist().GetName())
+
+	_, err = srv.GetArtist(context.Background(), &users.GetArtistRequest{Id: uuid.NewString()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestLogin(t *testing.T) {
+	srv := newTestServer(t)
+
+	resp, err := srv.Login(context.Background(), &users.LoginRequest{Email: "sam@example.com", Password: "password"})
+	require.NoError(t, err)
+
+	parser, err := auth.NewParser(pubStr)
+	require.NoError(t, err)
+
+	token, err := parser.Parse(resp.GetTokens().GetAccessToken())
+	require.NoError(t, err)
+	assert.Equal(t, "126b0623-5c39-49e2-ad3d-b5e08546725c", token.Subject.String())
+	assert.False(t, token.IsArtist)
+	assert.True(t, token.HideExplicit)
+
+	_, err = srv.Login(context.Background(), &users.LoginRequest{Email: "sam@example.com", Password: "wrong"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestGetFollowers(t *testing.T) {
+	srv := newTestServer(t)
+
+	resp, err := srv.GetFollowers(context.Background(), &users.GetFollowersRequest{UserId: velvetId})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.GetPagination().GetTotal())
+}
+
+func TestSeedUnknownArtist(t *testing.T) {
+	seed := Seed{
+		Listeners: []SeedListener{{
+			SeedUser: SeedUser{Id: uuid.New(), Email: "a@example.com"},
+			Follows:  []uuid.UUID{uuid.New()},
+		}},
+	}
+
+	assert.ErrorIs(t, seed.validate(), ErrUnknownArtist)
+}
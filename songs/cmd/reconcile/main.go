@@ -0,0 +1,66 @@
+// Reconcile compares the objects of songs and images with the songs once and prints the report.
+// It exits with 1 if orphans or dangling references are left.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/app"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+
+	"dev.gaijin.team/go/golib/must"
+)
+
+func main() {
+	deleteOrphans := flag.Bool("delete", false, "delete orphans older than the grace period")
+	grace := flag.Duration("grace", 0, "grace period, features.reconcile.gracePeriod if zero")
+
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+
+	cfg := must.OK(config.Load("songs.yaml", "/etc/app/songs.yaml"))
+	cfg.Features.Reconcile.DeleteOrphans = cfg.Features.Reconcile.DeleteOrphans || *deleteOrphans
+
+	if *grace > 0 {
+		cfg.Features.Reconcile.GracePeriod = *grace
+	}
+
+	config.Set(cfg)
+
+	application := app.NewWithConfig(cfg)
+
+	report, err := application.Reconcile(ctx)
+	_ = application.Close()
+
+	cancel()
+
+	left := 0
+
+	for _, orphan := range report.Orphans {
+		if orphan.Deleted {
+			_, _ = fmt.Printf("deleted  %-5s %s\n", orphan.Kind, orphan.ObjectId) //nolint:forbidigo
+			continue
+		}
+
+		left++
+
+		_, _ = fmt.Printf("orphan   %-5s %s %d bytes, modified %s\n", //nolint:forbidigo
+			orphan.Kind, orphan.ObjectId, orphan.Size, orphan.ModifiedAt.Format("2006-01-02 15:04:05"))
+	}
+
+	for _, ref := range report.Dangling {
+		_, _ = fmt.Printf("dangling %-5s %s of song %s\n", ref.Kind, ref.ObjectId, ref.SongId) //nolint:forbidigo
+	}
+
+	_, _ = fmt.Printf("%d objects, %d orphans (%d bytes left), %d dangling references\n", //nolint:forbidigo
+		report.Objects, len(report.Orphans), report.OrphanedBytes(), len(report.Dangling))
+
+	if err != nil || left > 0 || len(report.Dangling) > 0 {
+		os.Exit(1)
+	}
+}
@@ -2,15 +2,20 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/metrics"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/tracing"
 
 	"dev.gaijin.team/go/golib/must"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
 )
@@ -21,7 +26,14 @@ type Application struct {
 	log        zerolog.Logger
 	grpcServer *grpc.Server
 	gateway    *http.Server
-	db         *storage.Storage
+	// Nil if the metrics are disabled
+	metrics *http.Server
+	db      *storage.Storage
+	service *service
+	// Flushes the spans left
+	stopTracing func(context.Context) error
+	// Workers the shutdown waits for
+	workers sync.WaitGroup
 }
 
 // New creates a new Application instance with loaded configuration.
@@ -36,6 +48,16 @@ func New() *Application {
 func NewWithConfig(cfg config.Config) *Application {
 	logger := newLogger(cfg)
 
+	stopTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		ServiceName: "songs",
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		logger.Fatal().Err(err).Msg("setting up tracing")
+	}
+
 	const dbConnectionTimeout = time.Second * 10
 
 	ctx, cancel := context.WithTimeout(context.Background(), dbConnectionTimeout)
@@ -48,17 +70,27 @@ func NewWithConfig(cfg config.Config) *Application {
 
 	logger.Info().Msg("connected to database")
 
-	srv, gw, err := newServers(logger, cfg, db)
+	prometheus.MustRegister(metrics.NewPoolCollector(db))
+
+	service, err := newService(db)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("creating services")
+	}
+
+	srv, gw, err := newServers(logger, cfg, service, newHealthChecker(cfg, db, service))
 	if err != nil {
 		logger.Fatal().Err(err).Msg("creating servers")
 	}
 
 	return &Application{
-		cfg:        cfg,
-		log:        logger,
-		grpcServer: srv,
-		gateway:    gw,
-		db:         db,
+		cfg:         cfg,
+		log:         logger,
+		grpcServer:  srv,
+		gateway:     gw,
+		metrics:     newMetricsServer(cfg),
+		db:          db,
+		service:     service,
+		stopTracing: stopTracing,
 	}
 }
 
@@ -97,6 +129,38 @@ func (a *Application) Run(ctx context.Context) error {
 		}
 	}()
 
+	if a.metrics != nil {
+		go func() {
+			a.log.Info().Int("port", a.cfg.Servers.Metrics.Port).Msg("started metrics")
+
+			err := a.metrics.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				a.log.Error().Err(err).Msg("metrics serve failed")
+			}
+		}()
+	}
+
+	go a.purgeTrash(ctx)
+	go a.buildExports(ctx)
+	go a.processUploads(ctx)
+	go a.consumeAnalytics(ctx, a.cfg.Connections.Kafka.PlaysTopic, a.service.analytics.HandlePlays)
+	go a.consumeAnalytics(ctx, a.cfg.Connections.Kafka.PlaylistsTopic, a.service.analytics.HandlePlaylists)
+	go a.snapshotFollowers(ctx)
+	go a.consumeWebhooks(ctx)
+	go a.purgeWebhookDeliveries(ctx)
+
+	if a.cfg.Features.Reconcile.Interval > 0 {
+		go a.reconcileObjects(ctx)
+	}
+
+	// Running jobs get to finish on shutdown
+	a.workers.Add(1)
+
+	go func() {
+		defer a.workers.Done()
+		a.runJobs(ctx)
+	}()
+
 	a.log.Info().Msg("started application")
 
 	<-ctx.Done()
@@ -104,6 +168,11 @@ func (a *Application) Run(ctx context.Context) error {
 	return nil
 }
 
+// Close disconnects from the storages of an application that was not run.
+func (a *Application) Close() error {
+	return a.db.Close() //nolint:wrapcheck
+}
+
 // shutdown gracefully stops the application components.
 func (a *Application) shutdown(ctx context.Context) {
 	a.log.Info().Msg("stopping application")
@@ -114,8 +183,24 @@ func (a *Application) shutdown(ctx context.Context) {
 	a.grpcServer.GracefulStop()
 	a.log.Info().Msg("stopped grpc")
 
+	if a.metrics != nil {
+		err = a.metrics.Shutdown(ctx)
+		a.log.Info().Err(err).Msg("stopped metrics")
+	}
+
+	a.workers.Wait()
+	a.log.Info().Msg("stopped workers")
+
 	err = a.db.Close()
 	a.log.Info().Err(err).Msg("disconnected from database")
 
+	const tracingFlushTimeout = time.Second * 5
+
+	flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), tracingFlushTimeout)
+	defer cancel()
+
+	err = a.stopTracing(flushCtx)
+	a.log.Info().Err(err).Msg("stopped tracing")
+
 	a.log.Info().Msg("stopped application")
 }
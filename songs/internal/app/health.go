@@ -0,0 +1,23 @@
+package app
+
+import (
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/health"
+)
+
+// newHealthChecker probes the storages and the users service, the fake one is always ready.
+func newHealthChecker(conf config.Config, db *storage.Storage, service *service) *health.Checker {
+	checker := health.NewChecker(conf.Servers.Health.Timeout)
+
+	checker.Add("postgres", db.PgStorage.Ping)
+	checker.Add("redis", db.RedStorage.Ping)
+	checker.Add("objects", db.ObjStorage.Ping)
+	checker.Add("kafka", db.KafkaProducer.Ping)
+
+	if !conf.Connections.UsersService.UseFake {
+		checker.Add("users", service.users.Ping)
+	}
+
+	return checker
+}
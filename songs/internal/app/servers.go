@@ -1,22 +1,47 @@
 package app
 
 import (
+	"fmt"
 	"net/http"
 
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
 	grpcserver "github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server"
-	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/grpcgw"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audio/auth"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/geoip"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/health"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/metrics"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/transport"
 
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
-func newServers(log zerolog.Logger, conf config.Config, db *storage.Storage) (*grpc.Server, *http.Server, error) {
+// newMetricsServer returns the server of /metrics, it is nil if the metrics are disabled.
+func newMetricsServer(conf config.Config) *http.Server {
+	if conf.Servers.Metrics.Port == 0 {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", conf.Servers.Metrics.Port),
+		Handler:           mux,
+		ReadHeaderTimeout: conf.Servers.Http.Timeout,
+	}
+}
+
+func newServers(log zerolog.Logger, conf config.Config, service *service, checker *health.Checker,
+) (*grpc.Server, *http.Server, error) {
 	var (
 		creds credentials.TransportCredentials
 		err   error
@@ -43,14 +68,12 @@ func newServers(log zerolog.Logger, conf config.Config, db *storage.Storage) (*g
 	srv := grpc.NewServer(
 		grpc.ConnectionTimeout(conf.Servers.Grpc.Timeout),
 		grpc.Creds(creds),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	)
 
-	mux := gateway.NewServeMux(transport.MuxWithAuthAndTraceHeaders())
+	healthpb.RegisterHealthServer(srv, health.NewServer(checker, api.SongsService_ServiceDesc.ServiceName))
 
-	service, err := newService(db)
-	if err != nil {
-		return nil, nil, err
-	}
+	mux := gateway.NewServeMux(transport.MuxWithForwardedHeaders(), transport.MuxWithSpanNames())
 
 	tokenParser, err := auth.NewParser(conf.Features.Auth.PublicKey)
 	if err != nil {
@@ -58,17 +81,71 @@ func newServers(log zerolog.Logger, conf config.Config, db *storage.Storage) (*g
 	}
 
 	grpcserver.Register(log, srv, mux, grpcserver.Dependencies{
-		Service:     service,
-		RawService:  service,
-		TokenParser: tokenParser,
+		Service:             service,
+		ClaimsService:       service.claims,
+		ExportsService:      service.exports,
+		AnalyticsService:    service.analytics,
+		JobsService:         service.jobs,
+		WebhooksService:     service.webhooks,
+		RawService:          service,
+		ImportService:       service.imports,
+		ExportService:       service.exports,
+		ImportMaxBytes:      conf.Features.Imports.MaxArchiveBytes,
+		ImportTimeout:       conf.Features.Imports.Timeout,
+		UploadPollInterval:  conf.Features.Uploads.PollInterval,
+		UploadEventsTimeout: conf.Features.Uploads.EventsTimeout,
+		HealthChecker:       checker,
+		TokenParser:         tokenParser,
 	})
 
+	// The probes of the orchestrator, they are out of the /songs/api prefix proxied to the gateway
+	err = mux.HandlePath(http.MethodGet, "/healthz", withoutParams(checker.Live))
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	err = mux.HandlePath(http.MethodGet, "/readyz", withoutParams(checker.Ready))
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
 	log.Info().Msg("registered grpcserver")
 
+	var geo grpcgw.CountryResolver
+
+	if path := conf.Features.Regions.GeoIpDatabase; path != "" {
+		// The database lives as long as the process does.
+		db, err := geoip.Open(path)
+		if err != nil {
+			return nil, nil, err //nolint:wrapcheck
+		}
+
+		log.Info().Str("path", path).Msg("loaded geoip database")
+
+		geo = db
+	}
+
+	proxies, err := grpcgw.ParseProxies(conf.Features.Regions.TrustedProxies)
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	country := grpcgw.CountryConfig{
+		Header:         conf.Features.Regions.CountryHeader,
+		TrustedProxies: proxies,
+		Geo:            geo,
+	}
+
 	return srv, &http.Server{
-		Handler:           mux,
+		Handler:           otelhttp.NewHandler(grpcgw.CountryMw(log, country, mux), "songs-gateway"),
 		ReadHeaderTimeout: conf.Servers.Http.Timeout,
 		ReadTimeout:       conf.Servers.Http.Timeout,
 		WriteTimeout:      conf.Servers.Http.Timeout,
 	}, nil
 }
+
+func withoutParams(handler http.HandlerFunc) gateway.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		handler(w, r)
+	}
+}
@@ -6,53 +6,143 @@ import (
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/analytics"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/exports"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/imports"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/reconcile"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/webhooks"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/thumbnail"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/urlsign"
+
+	"dev.gaijin.team/go/golib/e"
 )
 
 type service struct {
 	*songs.Service
 	*raw.ServiceRaw
-	closer io.Closer
+	claims    *claims.Service
+	imports   *imports.Service
+	exports   *exports.Service
+	analytics *analytics.Service
+	reconcile *reconcile.Service
+	jobs      *jobs.Service
+	webhooks  *webhooks.Service
+	users     usersClient
+}
+
+type usersClient interface {
+	songs.UserRepo
+	analytics.UserRepo
+	io.Closer
+	Ping(ctx context.Context) error
 }
 
 func newService(db *storage.Storage) (*service, error) {
+	streams := config.Get().Features.Streams
+
+	signer, err := urlsign.New(streams.SigningKey, streams.UrlTtl)
+	if err != nil {
+		return nil, e.NewFrom("creating url signer", err)
+	}
+
+	jobsService := jobs.New(jobs.Dependencies{
+		JobRepo: db,
+	})
+
 	rawService := raw.New(raw.Dependencies{
 		ObjectStorage: db,
 		SongRepo:      rawSongRepo{db},
 		SoundDecoder:  audiodecoder.Decoder{},
+		Broker:        db,
+		UploadLimiter: db,
+		PlayCounter:   db,
+		UrlSigner:     signer,
+		JobQueue:      jobsService,
+		Thumbnailer:   thumbnail.Maker{},
 	})
 
-	var usersClient interface {
-		songs.UserRepo
-		io.Closer
-	}
+	jobs.Handle(jobsService, raw.PreviewJob, rawService.HandlePreviewJob)
+	jobs.Handle(jobsService, raw.ThumbnailJob, rawService.HandleThumbnailJob)
+
+	var userClient usersClient
 
 	if config.Get().Connections.UsersService.UseFake {
-		usersClient = users.NewFake()
+		userClient = users.NewFake()
 	} else {
 		client, err := users.New()
 		if err != nil {
 			return nil, err //nolint:wrapcheck
 		}
 
-		usersClient = client
+		userClient = client
 	}
 
 	songsService := songs.New(songs.Dependencies{
 		SongRepo:   db,
-		UserRepo:   usersClient,
+		UserRepo:   userClient,
 		RawService: rawService,
 		Broker:     db,
+		JobQueue:   jobsService,
+	})
+
+	claimsService := claims.New(claims.Dependencies{
+		ClaimRepo: claimRepo{db},
+		SongCache: db,
+		Broker:    db,
+	})
+
+	importsService := imports.New(imports.Dependencies{
+		SongsService: songsService,
+		RawService:   rawService,
+		SongRepo:     db,
+	})
+
+	exportsService := exports.New(exports.Dependencies{
+		ExportRepo:    db,
+		ObjectStorage: db,
+		UserRepo:      userClient,
+		ImageObjects:  rawService,
+	})
+
+	analyticsService := analytics.New(analytics.Dependencies{
+		StatsRepo: statsRepo{db},
+		UserRepo:  userClient,
+	})
+
+	reconcileService := reconcile.New(reconcile.Dependencies{
+		SongRepo:      db,
+		ObjectStorage: db,
+		ImageObjects:  rawService,
+	})
+
+	webhooksConf := config.Get().Features.Webhooks
+
+	webhooksService := webhooks.New(webhooks.Dependencies{
+		WebhookRepo: webhookRepo{db},
+		JobQueue:    jobsService,
+		HttpClient:  webhooks.NewClient(webhooksConf.Timeout, webhooksConf.AllowPrivateAddresses),
 	})
 
+	jobs.Handle(jobsService, webhooks.DeliveryJob, webhooksService.HandleDeliveryJob)
+
 	return &service{
 		Service:    songsService,
 		ServiceRaw: rawService,
-		closer:     usersClient,
+		claims:     claimsService,
+		imports:    importsService,
+		exports:    exportsService,
+		analytics:  analyticsService,
+		reconcile:  reconcileService,
+		jobs:       jobsService,
+		webhooks:   webhooksService,
+		users:      userClient,
 	}, nil
 }
 
@@ -76,3 +166,66 @@ type rawSongRepoTx struct {
 func (r rawSongRepoTx) Begin(context.Context) (raw.SongRepo, error) {
 	return r, nil
 }
+
+type claimRepo struct {
+	*storage.Storage
+}
+
+func (r claimRepo) Begin(ctx context.Context) (claims.ClaimRepo, error) {
+	tx, err := r.Storage.Begin(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &claimRepoTx{tx}, nil
+}
+
+type claimRepoTx struct {
+	postgres.PgTx
+}
+
+func (r claimRepoTx) Begin(context.Context) (claims.ClaimRepo, error) {
+	return r, nil
+}
+
+type webhookRepo struct {
+	*storage.Storage
+}
+
+func (r webhookRepo) Begin(ctx context.Context) (webhooks.WebhookRepo, error) {
+	tx, err := r.Storage.Begin(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &webhookRepoTx{tx}, nil
+}
+
+type webhookRepoTx struct {
+	postgres.PgTx
+}
+
+func (r webhookRepoTx) Begin(context.Context) (webhooks.WebhookRepo, error) {
+	return r, nil
+}
+
+type statsRepo struct {
+	*storage.Storage
+}
+
+func (r statsRepo) Begin(ctx context.Context) (analytics.StatsRepo, error) {
+	tx, err := r.Storage.Begin(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &statsRepoTx{tx}, nil
+}
+
+type statsRepoTx struct {
+	postgres.PgTx
+}
+
+func (r statsRepoTx) Begin(context.Context) (analytics.StatsRepo, error) {
+	return r, nil
+}
@@ -0,0 +1,301 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/reconcile"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/events"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+)
+
+// purgeTrash hard-deletes songs whose restore window is over every purge interval until ctx is done.
+func (a *Application) purgeTrash(ctx context.Context) {
+	conf := a.cfg.Features.Trash
+	log := a.log.With().Str("worker", "trash_purge").Logger()
+	ctx = logger.WithLogger(ctx, log)
+
+	ticker := time.NewTicker(conf.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		// Purging in batches keeps transactions short, the rest goes right after
+		for {
+			purged, err := a.service.PurgeTrash(ctx, time.Now().Add(-conf.RestoreWindow), conf.PurgeBatchSize)
+			if err != nil {
+				log.Error().Err(err).Msg("purging trash")
+				break
+			}
+
+			if purged > 0 {
+				log.Info().Int("purged", purged).Msg("purged trashed songs")
+			}
+
+			if purged < int(conf.PurgeBatchSize) {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// exportsPurgeBatchSize is how many expired exports are removed at once.
+const exportsPurgeBatchSize = 100
+
+// buildExports builds queued exports and removes expired ones every poll interval until ctx is done.
+func (a *Application) buildExports(ctx context.Context) {
+	conf := a.cfg.Features.Exports
+	log := a.log.With().Str("worker", "exports").Logger()
+	ctx = logger.WithLogger(ctx, log)
+
+	ticker := time.NewTicker(conf.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Exports are built one by one, so a big catalog doesn't hold the others for long
+		for ctx.Err() == nil {
+			built, err := a.service.exports.BuildNextExport(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("building export")
+				break
+			}
+
+			if !built {
+				break
+			}
+		}
+
+		for {
+			purged, err := a.service.exports.PurgeExpiredExports(ctx, exportsPurgeBatchSize)
+			if err != nil {
+				log.Error().Err(err).Msg("purging exports")
+				break
+			}
+
+			if purged > 0 {
+				log.Info().Int("purged", purged).Msg("purged expired exports")
+			}
+
+			if purged < exportsPurgeBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// processUploads processes uploaded songs and fails stale uploads every poll interval until ctx is done.
+func (a *Application) processUploads(ctx context.Context) {
+	conf := a.cfg.Features.Uploads
+	log := a.log.With().Str("worker", "uploads").Logger()
+	ctx = logger.WithLogger(ctx, log)
+
+	ticker := time.NewTicker(conf.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			processed, err := a.service.ProcessNextUpload(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("processing upload")
+				break
+			}
+
+			if !processed {
+				break
+			}
+		}
+
+		failed, err := a.service.FailStaleUploads(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failing stale uploads")
+		}
+
+		if failed > 0 {
+			log.Info().Int("failed", failed).Msg("failed stale uploads")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runJobs runs the background jobs until ctx is done and the running jobs are finished.
+func (a *Application) runJobs(ctx context.Context) {
+	log := a.log.With().Str("worker", "jobs").Logger()
+
+	a.service.jobs.Run(logger.WithLogger(ctx, log))
+}
+
+// consumeAnalytics feeds the analytics with the events of the topic until ctx is done.
+func (a *Application) consumeAnalytics(ctx context.Context, topic string, register func(*events.Consumer)) {
+	log := a.log.With().Str("worker", "analytics").Str("topic", topic).Logger()
+
+	a.consume(logger.WithLogger(ctx, log), events.ConsumerConfig{
+		Brokers: a.cfg.Connections.Kafka.Brokers,
+		Topic:   topic,
+		GroupId: a.cfg.Connections.Kafka.AnalyticsGroupId,
+	}, a.cfg.Features.Analytics.RetryDelay, register)
+}
+
+// consumeWebhooks queues the deliveries of the lifecycle events until ctx is done.
+func (a *Application) consumeWebhooks(ctx context.Context) {
+	conf := a.cfg.Connections.Kafka
+	log := a.log.With().Str("worker", "webhooks").Str("topic", conf.LifecycleTopic).Logger()
+
+	a.consume(logger.WithLogger(ctx, log), events.ConsumerConfig{
+		Brokers: conf.Brokers,
+		Topic:   conf.LifecycleTopic,
+		GroupId: conf.WebhooksGroupId,
+	}, a.cfg.Features.Webhooks.RetryDelay, a.service.webhooks.HandleLifecycle)
+}
+
+// consume runs the consumer with the registered handlers until ctx is done.
+// When an event fails the consumer is restarted after the delay, the event is delivered again then.
+func (a *Application) consume(ctx context.Context, conf events.ConsumerConfig, retryDelay time.Duration,
+	register func(*events.Consumer),
+) {
+	log := logger.FromContext(ctx)
+
+	for {
+		consumer := events.NewConsumer(conf)
+		register(consumer)
+
+		err := consumer.Run(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("consuming events")
+		}
+
+		err = consumer.Close()
+		if err != nil {
+			log.Warn().Err(err).Msg("closing consumer")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+// webhooksPurgeInterval is how often the deliveries out of retention are removed.
+const webhooksPurgeInterval = time.Hour
+
+// purgeWebhookDeliveries removes old deliveries from the log every purge interval until ctx is done.
+func (a *Application) purgeWebhookDeliveries(ctx context.Context) {
+	log := a.log.With().Str("worker", "webhooks_purge").Logger()
+	ctx = logger.WithLogger(ctx, log)
+
+	ticker := time.NewTicker(webhooksPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := a.service.webhooks.PurgeDeliveries(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("purging webhook deliveries")
+		} else if purged > 0 {
+			log.Info().Int64("purged", purged).Msg("purged webhook deliveries")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// followersBatchSize is how many artists are walked at once.
+const followersBatchSize = 100
+
+// snapshotFollowers counts the followers of artists and forgets old processed events
+// every followers interval until ctx is done.
+func (a *Application) snapshotFollowers(ctx context.Context) {
+	log := a.log.With().Str("worker", "followers").Logger()
+	ctx = logger.WithLogger(ctx, log)
+
+	ticker := time.NewTicker(a.cfg.Features.Analytics.FollowersInterval)
+	defer ticker.Stop()
+
+	for {
+		counted, err := a.service.analytics.SnapshotFollowers(ctx, followersBatchSize)
+		if err != nil {
+			log.Error().Err(err).Int("counted", counted).Msg("counting followers")
+		} else {
+			log.Info().Int("counted", counted).Msg("counted followers")
+		}
+
+		purged, err := a.service.analytics.PurgeProcessedEvents(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("purging processed events")
+		} else if purged > 0 {
+			log.Info().Int64("purged", purged).Msg("purged processed events")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reconcileObjects compares the objects with the songs every reconcile interval until ctx is done.
+func (a *Application) reconcileObjects(ctx context.Context) {
+	ticker := time.NewTicker(a.cfg.Features.Reconcile.Interval)
+	defer ticker.Stop()
+
+	for {
+		_, _ = a.Reconcile(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile compares the objects with the songs once and logs the orphans and dangling references.
+func (a *Application) Reconcile(ctx context.Context) (reconcile.Report, error) {
+	log := a.log.With().Str("worker", "reconcile").Logger()
+	ctx = logger.WithLogger(ctx, log)
+
+	report, err := a.service.reconcile.Reconcile(ctx)
+
+	for _, orphan := range report.Orphans {
+		log.Info().Str("kind", string(orphan.Kind)).Str("object_id", orphan.ObjectId).
+			Int64("size", orphan.Size).Time("modified_at", orphan.ModifiedAt).Bool("deleted", orphan.Deleted).
+			Msg("orphaned object")
+	}
+
+	for _, ref := range report.Dangling {
+		log.Warn().Str("kind", string(ref.Kind)).Stringer("song_id", ref.SongId).Str("object_id", ref.ObjectId).
+			Msg("dangling object reference")
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg("reconciling objects")
+		return report, err //nolint:wrapcheck
+	}
+
+	log.Info().Int("objects", report.Objects).Int("orphans", len(report.Orphans)).
+		Int64("orphaned_bytes", report.OrphanedBytes()).Int("dangling", len(report.Dangling)).
+		Msg("reconciled objects")
+
+	return report, nil
+}
@@ -10,12 +10,14 @@ import (
 )
 
 type Fake struct {
-	memo sync.Map
+	memo      sync.Map
+	followers sync.Map
 }
 
 func NewFake() *Fake {
 	return &Fake{
-		memo: sync.Map{},
+		memo:      sync.Map{},
+		followers: sync.Map{},
 	}
 }
 
@@ -42,6 +44,27 @@ func (*Fake) ArtistsMatchingName(_ context.Context, name string) ([]Artist, erro
 	return artists, nil
 }
 
+// FollowersCount grows a little every call, so the followers charts are not flat.
+func (f *Fake) FollowersCount(_ context.Context, userId uuid.UUID) (int64, error) {
+	const maxGrowth = 10
+
+	count := rand.Int64N(maxGrowth) //nolint:gosec
+
+	if v, ok := f.followers.Load(userId); ok {
+		if prev, ok := v.(int64); ok {
+			count += prev
+		}
+	}
+
+	f.followers.Store(userId, count)
+
+	return count, nil
+}
+
+func (*Fake) Ping(_ context.Context) error {
+	return nil
+}
+
 func (*Fake) Close() error {
 	return nil
 }
@@ -12,39 +12,49 @@ import (
 
 	"dev.gaijin.team/go/golib/e"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 )
 
+// serviceTokenKey is the metadata of the service token checked by users on its service routes.
+const serviceTokenKey = "x-service-token"
+
 type Client struct {
-	c    users.UsersServiceClient
-	conn *grpc.ClientConn
+	c            users.UsersServiceClient
+	conn         *grpc.ClientConn
+	serviceToken string
 }
 
 type Config struct {
-	Target string
+	Target       string
+	ServiceToken string
 }
 
 func New() (*Client, error) {
 	conf := config.Get()
 
 	return NewWithConfig(Config{
-		Target: conf.Connections.UsersService.Target,
+		Target:       conf.Connections.UsersService.Target,
+		ServiceToken: conf.Connections.UsersService.ServiceToken,
 	})
 }
 
 func NewWithConfig(conf Config) (*Client, error) {
 	conn, err := grpc.NewClient(conf.Target,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	)
 	if err != nil {
 		return nil, e.NewFrom("new users client", err)
 	}
 
 	return &Client{
-		c:    users.NewUsersServiceClient(conn),
-		conn: conn,
+		c:            users.NewUsersServiceClient(conn),
+		conn:         conn,
+		serviceToken: conf.ServiceToken,
 	}, nil
 }
 
@@ -57,6 +67,17 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// Ping checks that the users service answers its grpc.health.v1 service.
+// Its status isn't checked, so the readiness of users doesn't make songs unready.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := healthpb.NewHealthClient(c.conn).Check(ctx, &healthpb.HealthCheckRequest{}) //nolint:exhaustruct
+	if err != nil {
+		return e.NewFrom("checking users health", err)
+	}
+
+	return nil
+}
+
 func (c *Client) ArtistsByIds(ctx context.Context, ids []uuid.UUID) ([]Artist, error) {
 	log := logger.FromContext(ctx)
 
@@ -155,3 +176,25 @@ func (c *Client) ArtistsMatchingName(ctx context.Context, name string) ([]Artist
 
 	return result, nil
 }
+
+// FollowersCount returns how many followers the user has,
+// it is the total of the followers pages. Users gives them only to the callers with the service token.
+func (c *Client) FollowersCount(ctx context.Context, userId uuid.UUID) (int64, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		transport.TraceIdKey: logger.TraceIdFromContext(ctx),
+		serviceTokenKey:      c.serviceToken,
+	}))
+
+	resp, err := c.c.GetFollowers(ctx, &users.GetFollowersRequest{
+		UserId: userId.String(),
+		Pagination: &users.PaginationRequest{
+			Offset: 0,
+			Limit:  1,
+		},
+	})
+	if err != nil {
+		return 0, e.NewFrom("get followers in client", err)
+	}
+
+	return resp.GetPagination().GetTotal(), nil
+}
@@ -11,6 +11,7 @@ type Config struct {
 	Connections Connections `yaml:"connections"`
 	Servers     Servers     `yaml:"servers"`
 	Logging     Logging     `yaml:"logging"`
+	Tracing     Tracing     `yaml:"tracing"`
 	Features    Features    `yaml:"features"`
 }
 
@@ -19,6 +20,9 @@ type Servers struct {
 	Host string `env:"HOST" env-default:"localhost:8080" yaml:"common.host"`
 	Grpc Grpc   `yaml:"grpc"`
 	Http Http   `yaml:"http"`
+	// Internal, it is not proxied by the gateway
+	Metrics Metrics `yaml:"metrics"`
+	Health  Health  `yaml:"health"`
 }
 
 type Tls struct {
@@ -38,6 +42,16 @@ type Grpc struct {
 	Timeout time.Duration `env:"GRPC_TIMEOUT" env-default:"5s"    yaml:"timeout"`
 }
 
+type Metrics struct {
+	// Zero disables the server
+	Port int `env:"METRICS_PORT" env-default:"2112" yaml:"port"`
+}
+
+type Health struct {
+	// Every probe of a dependency in the readiness checks is canceled after it
+	Timeout time.Duration `env:"HEALTH_TIMEOUT" env-default:"2s" yaml:"timeout"`
+}
+
 type Connections struct {
 	Postgres     Postgres     `yaml:"postgres"`
 	Redis        Redis        `yaml:"redis"`
@@ -64,28 +78,55 @@ type Redis struct {
 }
 
 type Kafka struct {
-	Topic   string   `env:"KAFKA_TOPIC" env-default:"released-songs" yaml:"songReleasedTopic"`
-	Brokers []string `env:"KAFKA_BROKERS" env-default:"kafka:9092" yaml:"brokers"`
+	ReleasedTopic  string `env:"KAFKA_TOPIC" env-default:"released-songs" yaml:"songReleasedTopic"`
+	LifecycleTopic string `env:"KAFKA_LIFECYCLE_TOPIC" env-default:"songs-lifecycle" yaml:"songLifecycleTopic"`
+	PlaysTopic     string `env:"KAFKA_PLAYS_TOPIC" env-default:"songs-plays" yaml:"songPlaysTopic"`
+	// Published by the playlists service, consumed for analytics
+	PlaylistsTopic    string   `env:"KAFKA_PLAYLISTS_TOPIC" env-default:"playlists-activity" yaml:"playlistsActivityTopic"`
+	AnalyticsGroupId  string   `env:"KAFKA_ANALYTICS_GROUP_ID" env-default:"songs-analytics" yaml:"analyticsGroupId"`
+	WebhooksGroupId   string   `env:"KAFKA_WEBHOOKS_GROUP_ID" env-default:"songs-webhooks" yaml:"webhooksGroupId"`
+	Partitions        int      `env:"KAFKA_PARTITIONS" env-default:"1" yaml:"partitions"`
+	ReplicationFactor int      `env:"KAFKA_REPLICATION_FACTOR" env-default:"1" yaml:"replicationFactor"`
+	Brokers           []string `env:"KAFKA_BROKERS" env-default:"kafka:9092" yaml:"brokers"`
 }
 
 type S3 struct {
+	// minio or local, the local one keeps objects in localDir and needs no running services
+	Backend      string `env:"S3_BACKEND" env-default:"minio" yaml:"backend"`
 	Endpoint     string `env:"S3_ENDPOINT" env-default:"minio:9000" yaml:"endpoint"`
 	AccessKey    string `env:"S3_ACCESS_KEY" e.g:"Q3AM3UQ867SPQQA43P2F" yaml:"accessKey"`
 	SecretKey    string `env:"S3_SECRET_KEY" e.g:"zuf+tfteSlswRu7BJ86wekitnifILbZam1KYY3TG" yaml:"secretKey"`
 	UseSsl       bool   `env:"S3_USE_SSL" env-default:"false" yaml:"useSsl"`
+	LocalDir     string `env:"S3_LOCAL_DIR" env-default:"data/objects" yaml:"localDir"`
 	SongsBucket  string `env:"S3_SONGS_BUCKET" e.g:"songs" yaml:"songsBucket"`
 	ImagesBucket string `env:"S3_IMAGES_BUCKET" e.g:"songs_images" yaml:"imagesBucket"`
+	// Catalog exports of artists, they expire after features.exports.linkTtl
+	ExportsBucket string `env:"S3_EXPORTS_BUCKET" env-default:"songs-exports" yaml:"exportsBucket"`
+	// Preview clips of released songs, see features.previews
+	PreviewsBucket string `env:"S3_PREVIEWS_BUCKET" env-default:"songs-previews" yaml:"previewsBucket"`
+	// Thumbnails of hosted images, see features.uploads.thumbnailSize
+	ThumbnailsBucket string `env:"S3_THUMBNAILS_BUCKET" env-default:"songs-thumbnails" yaml:"thumbnailsBucket"`
 }
 
 type UsersService struct {
 	UseFake bool   `env:"USERS_SERVICE_USE_FAKE" env-default:"false" yaml:"useFake"`
 	Target  string `env:"USERS_SERVICE_TARGET" e.g:"users:9090" yaml:"target"`
+	// AUTH_SERVICE_TOKEN of users, the followers are only given to the services with it
+	ServiceToken string `env:"USERS_SERVICE_TOKEN" yaml:"serviceToken"`
 }
 
 type Logging struct {
 	Level zerolog.Level `env:"LOG_LEVEL" env-default:"info" yaml:"level"`
 }
 
+type Tracing struct {
+	// OTLP gRPC endpoint of the collector, empty keeps the traces only in the trace_id of the logs
+	Endpoint string `env:"TRACING_ENDPOINT" e.g:"otel-collector:4317" yaml:"endpoint"`
+	Insecure bool   `env:"TRACING_INSECURE" env-default:"true" yaml:"insecure"`
+	// Share of the sampled traces started by the service, the callers decide for theirs
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO" env-default:"1" yaml:"sampleRatio"`
+}
+
 type Features struct {
 	Auth struct { //nolint:revive
 		PublicKey string `env:"JWT_PUBLIC_KEY" e.g:"Gvbo6JyyS410wg87Gq0N9kphc67A5Lb1VS1wupzwYTU=" yaml:"publicKey"`
@@ -94,4 +135,125 @@ type Features struct {
 		SongsTtl   time.Duration `env:"CACHE_SONGS_TTL" env-default:"5m" yaml:"songsTtl"`
 		MySongsTtl time.Duration `env:"CACHE_MY_SONGS_TTL" env-default:"5m" yaml:"mySongsTtl"`
 	} `yaml:"cache"`
+	Regions struct { //nolint:revive
+		// Header with the caller's country set by the proxy, e.g. X-Country from nginx geoip module.
+		// Empty means the country is only resolved with the GeoIP database.
+		CountryHeader string `env:"REGIONS_COUNTRY_HEADER" env-default:"" yaml:"countryHeader"`
+		// Addresses or CIDRs of the proxies whose country header and X-Real-IP are trusted.
+		TrustedProxies []string `env:"REGIONS_TRUSTED_PROXIES" e.g:"172.16.0.0/12" yaml:"trustedProxies"`
+		// Optional MaxMind compatible database, used when the header is missing.
+		GeoIpDatabase string `env:"REGIONS_GEOIP_DATABASE" e.g:"/etc/app/GeoLite2-Country.mmdb" yaml:"geoIpDatabase"`
+	} `yaml:"regions"`
+	// Per-artist limits, zero means unlimited.
+	Quotas struct { //nolint:revive
+		MaxSongs          int32 `env:"QUOTAS_MAX_SONGS" env-default:"500" yaml:"maxSongs"`
+		MaxBytes          int64 `env:"QUOTAS_MAX_BYTES" env-default:"2147483648" yaml:"maxBytes"`
+		UploadsPerHour    int32 `env:"QUOTAS_UPLOADS_PER_HOUR" env-default:"30" yaml:"uploadsPerHour"`
+		ConcurrentUploads int32 `env:"QUOTAS_CONCURRENT_UPLOADS" env-default:"2" yaml:"concurrentUploads"`
+		// Uploads of imports don't count towards uploadsPerHour, they have this budget instead
+		ImportUploadsPerHour int32 `env:"QUOTAS_IMPORT_UPLOADS_PER_HOUR" env-default:"1000" yaml:"importUploadsPerHour"`
+	} `yaml:"quotas"`
+	// Uploads failing the checks are rejected as corrupt, zero disables a check.
+	Audio struct { //nolint:revive
+		MinDuration time.Duration `env:"AUDIO_MIN_DURATION" env-default:"1s" yaml:"minDuration"`
+		// Share of the file bytes that are not mp3 frames
+		MaxSkippedRatio float64 `env:"AUDIO_MAX_SKIPPED_RATIO" env-default:"0.1" yaml:"maxSkippedRatio"`
+	} `yaml:"audio"`
+	Trash struct { //nolint:revive
+		RestoreWindow  time.Duration `env:"TRASH_RESTORE_WINDOW" env-default:"720h" yaml:"restoreWindow"`
+		PurgeInterval  time.Duration `env:"TRASH_PURGE_INTERVAL" env-default:"1h" yaml:"purgeInterval"`
+		PurgeBatchSize int32         `env:"TRASH_PURGE_BATCH_SIZE" env-default:"100" yaml:"purgeBatchSize"`
+	} `yaml:"trash"`
+	Imports struct { //nolint:revive
+		MaxRows         int   `env:"IMPORTS_MAX_ROWS" env-default:"5000" yaml:"maxRows"`
+		MaxArchiveBytes int64 `env:"IMPORTS_MAX_ARCHIVE_BYTES" env-default:"10737418240" yaml:"maxArchiveBytes"`
+		// Replaces the HTTP server timeouts for imports
+		Timeout time.Duration `env:"IMPORTS_TIMEOUT" env-default:"1h" yaml:"timeout"`
+	} `yaml:"imports"`
+	Analytics struct { //nolint:revive
+		// How often the followers of artists are counted, the last count of a day is kept
+		FollowersInterval time.Duration `env:"ANALYTICS_FOLLOWERS_INTERVAL" env-default:"6h" yaml:"followersInterval"`
+		// Processed event ids are kept this long to skip redelivered events
+		EventsRetention time.Duration `env:"ANALYTICS_EVENTS_RETENTION" env-default:"168h" yaml:"eventsRetention"`
+		// Consumers are restarted after this delay when an event fails
+		RetryDelay time.Duration `env:"ANALYTICS_RETRY_DELAY" env-default:"5s" yaml:"retryDelay"`
+	} `yaml:"analytics"`
+	Exports struct { //nolint:revive
+		// How long the download link of an export works
+		LinkTtl      time.Duration `env:"EXPORTS_LINK_TTL" env-default:"24h" yaml:"linkTtl"`
+		PollInterval time.Duration `env:"EXPORTS_POLL_INTERVAL" env-default:"10s" yaml:"pollInterval"`
+		// Running exports not finished in this time are taken over by other workers
+		StaleAfter time.Duration `env:"EXPORTS_STALE_AFTER" env-default:"1h" yaml:"staleAfter"`
+	} `yaml:"exports"`
+	Reconcile struct { //nolint:revive
+		// How often the objects are compared with the songs, zero disables the worker,
+		// cmd/reconcile runs it once then
+		Interval time.Duration `env:"RECONCILE_INTERVAL" env-default:"0" yaml:"interval"`
+		// Orphans modified in this time may belong to uploads in progress, they are not deleted
+		GracePeriod   time.Duration `env:"RECONCILE_GRACE_PERIOD" env-default:"24h" yaml:"gracePeriod"`
+		DeleteOrphans bool          `env:"RECONCILE_DELETE_ORPHANS" env-default:"false" yaml:"deleteOrphans"`
+	} `yaml:"reconcile"`
+	// Links to songs and images are signed, they work for one listener until they expire
+	Streams struct { //nolint:revive
+		// At least 32 characters, changing it breaks the links given out before
+		SigningKey string        `env:"STREAMS_SIGNING_KEY" e.g:"mD5gq1Rk0bL7yX2vTn9sWc4hJf8pEa3Z" yaml:"signingKey"`
+		UrlTtl     time.Duration `env:"STREAMS_URL_TTL" env-default:"6h" yaml:"urlTtl"`
+		// Fetches of a song by a listener count as one play in this time, zero counts every fetch
+		PlayWindow time.Duration `env:"STREAMS_PLAY_WINDOW" env-default:"30m" yaml:"playWindow"`
+	} `yaml:"streams"`
+	// Uploaded files are processed by a worker, the progress streams poll the state as often
+	Uploads struct { //nolint:revive
+		PollInterval time.Duration `env:"UPLOADS_POLL_INTERVAL" env-default:"2s" yaml:"pollInterval"`
+		// Processing not finished in this time is taken over by other workers,
+		// uploads not stored in this time fail
+		StaleAfter time.Duration `env:"UPLOADS_STALE_AFTER" env-default:"10m" yaml:"staleAfter"`
+		// Progress streams are closed after this time, clients reconnect if they still wait
+		EventsTimeout time.Duration `env:"UPLOADS_EVENTS_TIMEOUT" env-default:"30m" yaml:"eventsTimeout"`
+		// Levels in the waveform of a song, zero disables waveforms
+		WaveformPoints int `env:"UPLOADS_WAVEFORM_POINTS" env-default:"100" yaml:"waveformPoints"`
+		// Longer side of the thumbnails of hosted images in pixels, zero disables thumbnails
+		ThumbnailSize int `env:"UPLOADS_THUMBNAIL_SIZE" env-default:"256" yaml:"thumbnailSize"`
+	} `yaml:"uploads"`
+	// Previews are cut at release, anonymous listeners and link unfurls get them instead of the songs
+	Previews struct { //nolint:revive
+		// Share of the song before the preview starts, it moves back for short songs
+		Start float64 `env:"PREVIEWS_START" env-default:"0.3" yaml:"start"`
+		// Zero disables the previews
+		Length time.Duration `env:"PREVIEWS_LENGTH" env-default:"30s" yaml:"length"`
+		Fade   time.Duration `env:"PREVIEWS_FADE" env-default:"1s" yaml:"fade"`
+	} `yaml:"previews"`
+	// Background jobs are kept in postgres and run by the workers of every replica
+	Jobs struct { //nolint:revive
+		// Zero disables the workers, jobs are still queued then
+		Workers      int           `env:"JOBS_WORKERS" env-default:"2" yaml:"workers"`
+		PollInterval time.Duration `env:"JOBS_POLL_INTERVAL" env-default:"1s" yaml:"pollInterval"`
+		// Jobs out of attempts are dead until an admin retries them
+		MaxAttempts int32 `env:"JOBS_MAX_ATTEMPTS" env-default:"10" yaml:"maxAttempts"`
+		// Failed jobs wait twice as long after every attempt
+		MinBackoff time.Duration `env:"JOBS_MIN_BACKOFF" env-default:"10s" yaml:"minBackoff"`
+		MaxBackoff time.Duration `env:"JOBS_MAX_BACKOFF" env-default:"1h" yaml:"maxBackoff"`
+		// Jobs are cancelled after this time and taken over by other workers
+		StaleAfter time.Duration `env:"JOBS_STALE_AFTER" env-default:"10m" yaml:"staleAfter"`
+		// Running jobs are cancelled if they don't finish in this time on shutdown
+		ShutdownTimeout time.Duration `env:"JOBS_SHUTDOWN_TIMEOUT" env-default:"20s" yaml:"shutdownTimeout"`
+	} `yaml:"jobs"`
+	// Artists get the events of their songs delivered to their HTTPS endpoints by the jobs
+	Webhooks struct { //nolint:revive
+		// Zero means unlimited
+		MaxPerArtist int32         `env:"WEBHOOKS_MAX_PER_ARTIST" env-default:"5" yaml:"maxPerArtist"`
+		Timeout      time.Duration `env:"WEBHOOKS_TIMEOUT" env-default:"10s" yaml:"timeout"`
+		// Deliveries failing this many times are failed until the artist redelivers them,
+		// the attempts wait as long as the failed jobs
+		MaxAttempts         int32         `env:"WEBHOOKS_MAX_ATTEMPTS" env-default:"12" yaml:"maxAttempts"`
+		DeliveriesRetention time.Duration `env:"WEBHOOKS_DELIVERIES_RETENTION" env-default:"720h" yaml:"deliveriesRetention"`
+		// Endpoints on private addresses are refused unless allowed, e.g. for local development
+		AllowPrivateAddresses bool `env:"WEBHOOKS_ALLOW_PRIVATE_ADDRESSES" env-default:"false" yaml:"allowPrivateAddresses"`
+		// The consumer is restarted after this delay when an event fails
+		RetryDelay time.Duration `env:"WEBHOOKS_RETRY_DELAY" env-default:"5s" yaml:"retryDelay"`
+	} `yaml:"webhooks"`
+	// Claims of verified claimants take the songs down at once, the others put them under review
+	Claims struct { //nolint:revive
+		// Pending and counter-noticed claims of one claimant, zero means unlimited
+		MaxOpenPerClaimant int32 `env:"CLAIMS_MAX_OPEN_PER_CLAIMANT" env-default:"10" yaml:"maxOpenPerClaimant"`
+	} `yaml:"claims"`
 }
@@ -41,6 +41,7 @@ func Load(paths ...string) (Config, error) {
 
 	config.Servers.Tls.CertPath = relatePath(config.Servers.Tls.CertPath, path)
 	config.Servers.Tls.KeyPath = relatePath(config.Servers.Tls.KeyPath, path)
+	config.Features.Regions.GeoIpDatabase = relatePath(config.Features.Regions.GeoIpDatabase, path)
 
 	return config, nil
 }
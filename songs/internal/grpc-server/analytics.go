@@ -0,0 +1,100 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/analytics"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AnalyticsService interface {
+	GetArtistStats(ctx context.Context, in analytics.GetArtistStatsInput) (analytics.GetArtistStatsOutput, error)
+}
+
+func (s *songsServer) GetArtistStats(ctx context.Context, req *api.GetArtistStatsRequest,
+) (*api.GetArtistStatsResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "GetArtistStats",
+		uniceptors.Auth[*api.GetArtistStatsRequest, *api.GetArtistStatsResponse](true, s.tokenParser))(
+		s.getArtistStatsImpl)
+}
+
+func (s *songsServer) getArtistStatsImpl(ctx context.Context, req *api.GetArtistStatsRequest,
+) (*api.GetArtistStatsResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	bucket := analytics.BucketDay
+	if req.GetBucket() == api.StatsBucket_STATS_WEEK {
+		bucket = analytics.BucketWeek
+	}
+
+	out, err := s.analytics.GetArtistStats(ctx, analytics.GetArtistStatsInput{
+		ArtistId: token.Subject,
+		From:     optionalTime(req.From),
+		To:       optionalTime(req.To),
+		Bucket:   bucket,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	resp := &api.GetArtistStatsResponse{
+		Total:           mapCounters(out.Total),
+		Followers:       out.Followers,
+		FollowersGained: out.FollowersGained,
+		Buckets:         make([]*api.ArtistStatsBucket, len(out.Buckets)),
+		Songs:           make([]*api.SongStats, len(out.Songs)),
+	}
+
+	for i, bucket := range out.Buckets {
+		resp.Buckets[i] = &api.ArtistStatsBucket{
+			Start:           timestamppb.New(bucket.Start),
+			Counters:        mapCounters(bucket.Counters),
+			Followers:       bucket.Followers,
+			FollowersGained: bucket.FollowersGained,
+		}
+	}
+
+	for i, song := range out.Songs {
+		buckets := make([]*api.SongStatsBucket, len(song.Buckets))
+		for j, bucket := range song.Buckets {
+			buckets[j] = &api.SongStatsBucket{
+				Start:    timestamppb.New(bucket.Start),
+				Counters: mapCounters(bucket.Counters),
+			}
+		}
+
+		resp.Songs[i] = &api.SongStats{
+			SongId:  song.SongId.String(),
+			Name:    song.Name,
+			Total:   mapCounters(song.Total),
+			Buckets: buckets,
+		}
+	}
+
+	return resp, nil
+}
+
+func mapCounters(counters analytics.Counters) *api.StatsCounters {
+	return &api.StatsCounters{
+		Plays:             counters.Plays,
+		UniqueListeners:   counters.UniqueListeners,
+		Likes:             counters.Likes,
+		Unlikes:           counters.Unlikes,
+		PlaylistAdditions: counters.PlaylistAdditions,
+	}
+}
+
+func optionalTime(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	tm := t.AsTime()
+
+	return &tm
+}
@@ -0,0 +1,261 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ClaimsService interface {
+	SubmitClaim(ctx context.Context, in claims.SubmitClaimInput) (claims.SubmitClaimOutput, error)
+	GetClaim(ctx context.Context, in claims.GetClaimInput) (claims.GetClaimOutput, error)
+	GetClaims(ctx context.Context, in claims.GetClaimsInput) (claims.GetClaimsOutput, error)
+	FileCounterNotice(ctx context.Context, in claims.FileCounterNoticeInput) (claims.FileCounterNoticeOutput, error)
+	ResolveClaim(ctx context.Context, in claims.ResolveClaimInput) (claims.ResolveClaimOutput, error)
+	VerifyClaimant(ctx context.Context, in claims.VerifyClaimantInput) (claims.VerifyClaimantOutput, error)
+}
+
+func (s *songsServer) SubmitClaim(ctx context.Context, req *api.SubmitClaimRequest,
+) (*api.SubmitClaimResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "SubmitClaim",
+		uniceptors.Auth[*api.SubmitClaimRequest, *api.SubmitClaimResponse](false, s.tokenParser))(s.submitClaimImpl)
+}
+
+func (s *songsServer) submitClaimImpl(ctx context.Context, req *api.SubmitClaimRequest,
+) (*api.SubmitClaimResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	result, err := s.claims.SubmitClaim(ctx, claims.SubmitClaimInput{
+		ClaimantId:    token.Subject,
+		SongId:        uuid.MustParse(req.GetSongId()),
+		ClaimantName:  req.GetClaimantName(),
+		ClaimantEmail: req.GetClaimantEmail(),
+		Description:   req.GetDescription(),
+		EvidenceUrls:  req.GetEvidenceUrls(),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.SubmitClaimResponse{
+		Claim: mapClaim(result.Claim),
+	}, nil
+}
+
+func (s *songsServer) GetClaim(ctx context.Context, req *api.GetClaimRequest) (*api.GetClaimResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "GetClaim",
+		uniceptors.Auth[*api.GetClaimRequest, *api.GetClaimResponse](false, s.tokenParser))(s.getClaimImpl)
+}
+
+func (s *songsServer) getClaimImpl(ctx context.Context, req *api.GetClaimRequest) (*api.GetClaimResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	result, err := s.claims.GetClaim(ctx, claims.GetClaimInput{
+		UserId:  token.Subject,
+		IsAdmin: token.IsAdmin,
+		ClaimId: uuid.MustParse(req.GetId()),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	history := make([]*api.ClaimEvent, len(result.History))
+
+	for i, event := range result.History {
+		var from *api.ClaimStatus
+		if event.FromStatus != nil {
+			status := mapClaimStatus(*event.FromStatus)
+			from = &status
+		}
+
+		history[i] = &api.ClaimEvent{
+			FromStatus: from,
+			ToStatus:   mapClaimStatus(event.ToStatus),
+			ActorId:    event.ActorId.String(),
+			Note:       event.Note,
+			CreatedAt:  timestamppb.New(event.CreatedAt),
+		}
+	}
+
+	return &api.GetClaimResponse{
+		Claim:   mapClaim(result.Claim),
+		History: history,
+	}, nil
+}
+
+func (s *songsServer) GetClaims(ctx context.Context, req *api.GetClaimsRequest) (*api.GetClaimsResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "GetClaims",
+		uniceptors.Auth[*api.GetClaimsRequest, *api.GetClaimsResponse](false, s.tokenParser))(s.getClaimsImpl)
+}
+
+func (s *songsServer) getClaimsImpl(ctx context.Context, req *api.GetClaimsRequest) (*api.GetClaimsResponse, error) {
+	var (
+		status         *postgres.ClaimStatus
+		page, pageSize int32 = 1, 10
+	)
+
+	token := uniceptors.TokenFromCtx(ctx)
+
+	if req.Status != nil { //nolint:protogetter
+		st := mapApiClaimStatus(req.GetStatus())
+		status = &st
+	}
+
+	if req.GetPage() > 0 {
+		page = req.GetPage()
+	}
+
+	if req.GetPageSize() > 0 {
+		pageSize = req.GetPageSize()
+	}
+
+	result, err := s.claims.GetClaims(ctx, claims.GetClaimsInput{
+		UserId:   token.Subject,
+		IsAdmin:  token.IsAdmin,
+		Status:   status,
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	outClaims := make([]*api.Claim, len(result.Claims))
+	for i := range result.Claims {
+		outClaims[i] = mapClaim(result.Claims[i])
+	}
+
+	return &api.GetClaimsResponse{
+		Claims: outClaims,
+		Pagination: &api.PaginationResponse{
+			LastPage: result.LastPage,
+		},
+	}, nil
+}
+
+func (s *songsServer) FileCounterNotice(ctx context.Context, req *api.FileCounterNoticeRequest,
+) (*api.FileCounterNoticeResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "FileCounterNotice",
+		uniceptors.Auth[*api.FileCounterNoticeRequest, *api.FileCounterNoticeResponse](
+			true, s.tokenParser))(s.fileCounterNoticeImpl)
+}
+
+func (s *songsServer) fileCounterNoticeImpl(ctx context.Context, req *api.FileCounterNoticeRequest,
+) (*api.FileCounterNoticeResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	result, err := s.claims.FileCounterNotice(ctx, claims.FileCounterNoticeInput{
+		ArtistId:  token.Subject,
+		ClaimId:   uuid.MustParse(req.GetId()),
+		Statement: req.GetStatement(),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.FileCounterNoticeResponse{
+		Claim: mapClaim(result.Claim),
+	}, nil
+}
+
+func (s *songsServer) ResolveClaim(ctx context.Context, req *api.ResolveClaimRequest,
+) (*api.ResolveClaimResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "ResolveClaim",
+		uniceptors.Admin[*api.ResolveClaimRequest, *api.ResolveClaimResponse](s.tokenParser))(s.resolveClaimImpl)
+}
+
+func (s *songsServer) resolveClaimImpl(ctx context.Context, req *api.ResolveClaimRequest,
+) (*api.ResolveClaimResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	result, err := s.claims.ResolveClaim(ctx, claims.ResolveClaimInput{
+		AdminId: token.Subject,
+		ClaimId: uuid.MustParse(req.GetId()),
+		Upheld:  req.GetUpheld(),
+		Note:    req.Note, //nolint:protogetter
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.ResolveClaimResponse{
+		Claim: mapClaim(result.Claim),
+	}, nil
+}
+
+func (s *songsServer) VerifyClaimant(ctx context.Context, req *api.VerifyClaimantRequest,
+) (*api.VerifyClaimantResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "VerifyClaimant",
+		uniceptors.Admin[*api.VerifyClaimantRequest, *api.VerifyClaimantResponse](s.tokenParser))(s.verifyClaimantImpl)
+}
+
+func (s *songsServer) verifyClaimantImpl(ctx context.Context, req *api.VerifyClaimantRequest,
+) (*api.VerifyClaimantResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	_, err := s.claims.VerifyClaimant(ctx, claims.VerifyClaimantInput{
+		AdminId:    token.Subject,
+		ClaimantId: uuid.MustParse(req.GetClaimantId()),
+		Verified:   req.GetVerified(),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.VerifyClaimantResponse{}, nil
+}
+
+func mapClaim(claim claims.Claim) *api.Claim {
+	return &api.Claim{
+		Id:             claim.Id.String(),
+		SongId:         claim.SongId.String(),
+		ClaimantId:     claim.ClaimantId.String(),
+		ClaimantName:   claim.ClaimantName,
+		ClaimantEmail:  claim.ClaimantEmail,
+		Description:    claim.Description,
+		EvidenceUrls:   claim.EvidenceUrls,
+		Status:         mapClaimStatus(claim.Status),
+		CounterNotice:  claim.CounterNotice,
+		ResolutionNote: claim.ResolutionNote,
+		CreatedAt:      timestamppb.New(claim.CreatedAt),
+		UpdatedAt:      timestamppb.New(claim.UpdatedAt),
+	}
+}
+
+func mapClaimStatus(status postgres.ClaimStatus) api.ClaimStatus {
+	switch status {
+	case postgres.ClaimStatusCounterNoticed:
+		return api.ClaimStatus_COUNTER_NOTICED
+	case postgres.ClaimStatusUpheld:
+		return api.ClaimStatus_UPHELD
+	case postgres.ClaimStatusRejected:
+		return api.ClaimStatus_REJECTED
+	default:
+		return api.ClaimStatus_PENDING
+	}
+}
+
+func mapApiClaimStatus(status api.ClaimStatus) postgres.ClaimStatus {
+	switch status {
+	case api.ClaimStatus_COUNTER_NOTICED:
+		return postgres.ClaimStatusCounterNoticed
+	case api.ClaimStatus_UPHELD:
+		return postgres.ClaimStatusUpheld
+	case api.ClaimStatus_REJECTED:
+		return postgres.ClaimStatusRejected
+	default:
+		return postgres.ClaimStatusPending
+	}
+}
@@ -0,0 +1,172 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"github.com/google/uuid"
+)
+
+func (s *songsServer) GetCreditRequests(ctx context.Context, req *api.GetCreditRequestsRequest,
+) (*api.GetCreditRequestsResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "GetCreditRequests",
+		uniceptors.Auth[*api.GetCreditRequestsRequest, *api.GetCreditRequestsResponse](true, s.tokenParser),
+	)(s.getCreditRequestsImpl)
+}
+
+func (s *songsServer) getCreditRequestsImpl(ctx context.Context, req *api.GetCreditRequestsRequest,
+) (*api.GetCreditRequestsResponse, error) {
+	var (
+		page, pageSize int32 = 1, 10
+	)
+
+	token := uniceptors.TokenFromCtx(ctx)
+
+	if req.GetPage() > 0 {
+		page = req.GetPage()
+	}
+
+	if req.GetPageSize() > 0 {
+		pageSize = req.GetPageSize()
+	}
+
+	result, err := s.service.GetCreditRequests(ctx, songs.GetCreditRequestsInput{
+		UserId:   token.Subject,
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	requests := make([]*api.CreditRequest, len(result.Requests))
+
+	for i, request := range result.Requests {
+		requests[i] = &api.CreditRequest{
+			Id:       request.Id,
+			SongId:   request.SongId.String(),
+			SongName: request.SongName,
+			Singer:   mapArtist(request.Singer),
+			Role:     mapCreditRole(request.Role),
+		}
+	}
+
+	return &api.GetCreditRequestsResponse{
+		Requests: requests,
+		Pagination: &api.PaginationResponse{
+			LastPage: result.LastPage,
+		},
+	}, nil
+}
+
+func (s *songsServer) ResolveCreditRequest(ctx context.Context, req *api.ResolveCreditRequestRequest,
+) (*api.ResolveCreditRequestResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "ResolveCreditRequest",
+		uniceptors.Auth[*api.ResolveCreditRequestRequest, *api.ResolveCreditRequestResponse](true, s.tokenParser),
+	)(s.resolveCreditRequestImpl)
+}
+
+func (s *songsServer) resolveCreditRequestImpl(ctx context.Context, req *api.ResolveCreditRequestRequest,
+) (*api.ResolveCreditRequestResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	_, err := s.service.ResolveCreditRequest(ctx, songs.ResolveCreditRequestInput{
+		UserId:   token.Subject,
+		CreditId: req.GetId(),
+		Approve:  req.GetApprove(),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.ResolveCreditRequestResponse{}, nil
+}
+
+func mapCredits(credits []songs.Credit) []*api.Credit {
+	out := make([]*api.Credit, len(credits))
+
+	for i, credit := range credits {
+		out[i] = &api.Credit{ //nolint:exhaustruct
+			Name:   credit.Name,
+			Role:   mapCreditRole(credit.Role),
+			Status: mapCreditStatus(credit.Status),
+		}
+
+		if credit.ArtistId != nil {
+			id := credit.ArtistId.String()
+			out[i].ArtistId = &id
+		}
+	}
+
+	return out
+}
+
+func mapCreditsInput(credits []*api.Credit) []songs.Credit {
+	out := make([]songs.Credit, len(credits))
+
+	for i, credit := range credits {
+		out[i] = songs.Credit{
+			ArtistId: nil,
+			Name:     credit.GetName(),
+			Role:     mapCreditRoleInput(credit.GetRole()),
+		}
+
+		if credit.GetArtistId() != "" {
+			id := uuid.MustParse(credit.GetArtistId())
+			out[i].ArtistId = &id
+		}
+	}
+
+	return out
+}
+
+func mapCreditRole(role postgres.CreditRole) api.CreditRole {
+	switch role {
+	case postgres.CreditRolePrimary:
+		return api.CreditRole_PRIMARY
+	case postgres.CreditRoleProducer:
+		return api.CreditRole_PRODUCER
+	case postgres.CreditRoleComposer:
+		return api.CreditRole_COMPOSER
+	case postgres.CreditRoleLyricist:
+		return api.CreditRole_LYRICIST
+	case postgres.CreditRoleMixingEngineer:
+		return api.CreditRole_MIXING_ENGINEER
+	default:
+		return api.CreditRole_FEATURED
+	}
+}
+
+func mapCreditRoleInput(role api.CreditRole) postgres.CreditRole {
+	switch role {
+	case api.CreditRole_PRIMARY:
+		return postgres.CreditRolePrimary
+	case api.CreditRole_PRODUCER:
+		return postgres.CreditRoleProducer
+	case api.CreditRole_COMPOSER:
+		return postgres.CreditRoleComposer
+	case api.CreditRole_LYRICIST:
+		return postgres.CreditRoleLyricist
+	case api.CreditRole_MIXING_ENGINEER:
+		return postgres.CreditRoleMixingEngineer
+	default:
+		return postgres.CreditRoleFeatured
+	}
+}
+
+func mapCreditStatus(status postgres.CreditStatus) api.CreditStatus {
+	switch status {
+	case postgres.CreditStatusPending:
+		return api.CreditStatus_CREDIT_PENDING
+	case postgres.CreditStatusDeclined:
+		return api.CreditStatus_CREDIT_DECLINED
+	default:
+		return api.CreditStatus_CREDIT_APPROVED
+	}
+}
@@ -10,8 +10,6 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -35,6 +33,8 @@ func (s *songsServer) createSongImpl(ctx context.Context, req *api.CreateSongReq
 		ImageUrl:    req.ImageUrl, //nolint:protogetter
 		SingerId:    token.Subject,
 		FeatArtists: artistsIds,
+		Explicit:    req.GetExplicit(),
+		Credits:     mapCreditsInput(req.GetCredits()),
 	})
 	if err != nil {
 		return nil, err //nolint:wrapcheck
@@ -47,6 +47,7 @@ func (s *songsServer) createSongImpl(ctx context.Context, req *api.CreateSongReq
 		Artists:    mapArtists(out.Artists),
 		UploadedAt: timestamppb.New(out.UploadedAt),
 		ImageUrl:   out.ImageUrl,
+		Explicit:   out.Explicit,
 	}, nil
 }
 
@@ -57,8 +58,12 @@ func (s *songsServer) GetSong(ctx context.Context, req *api.GetSongRequest) (*ap
 }
 
 func (s *songsServer) getSongImpl(ctx context.Context, req *api.GetSongRequest) (*api.GetSongResponse, error) {
+	token, _ := uniceptors.MaybeTokenFromCtx(ctx)
+
 	out, err := s.service.GetSong(ctx, songs.GetSongInput{
-		Id: uuid.MustParse(req.GetId()),
+		Id:         uuid.MustParse(req.GetId()),
+		Country:    uniceptors.CountryFromMetadata(ctx),
+		ListenerId: listenerId(token),
 	})
 	if err != nil {
 		return nil, err //nolint:wrapcheck
@@ -78,11 +83,15 @@ func (s *songsServer) getSongImpl(ctx context.Context, req *api.GetSongRequest)
 			Artists:     mapArtists(out.Artists),
 			Name:        out.Name,
 			SongUrl:     out.SongUrl,
+			PreviewUrl:  out.PreviewUrl,
 			ImageUrl:    out.ImageUrl,
 			Duration:    durationpb.New(out.Duration),
 			WeightBytes: out.WeightBytes,
 			UploadedAt:  uploadedAt,
 			ReleasedAt:  releasedAt,
+			Explicit:    out.Explicit,
+			Unavailable: out.Unavailable,
+			Credits:     mapCredits(out.Credits),
 		}}, nil
 
 }
@@ -93,8 +102,35 @@ func (s *songsServer) UpdateSong(ctx context.Context, req *api.UpdateSongRequest
 		uniceptors.Auth[*api.UpdateSongRequest, *api.UpdateSongResponse](true, s.tokenParser))(s.updateSongImpl)
 }
 
-func (*songsServer) updateSongImpl(_ context.Context, _ *api.UpdateSongRequest) (*api.UpdateSongResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "will be implemented later") //nolint:wrapcheck
+func (s *songsServer) updateSongImpl(ctx context.Context, req *api.UpdateSongRequest) (*api.UpdateSongResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	input := songs.UpdateSongInput{ //nolint:exhaustruct
+		UserId:   token.Subject,
+		SongId:   uuid.MustParse(req.GetId()),
+		Name:     req.GetName(),
+		ImageUrl: req.ImageUrl, //nolint:protogetter
+		Explicit: req.Explicit, //nolint:protogetter
+	}
+
+	if req.Regions != nil { //nolint:protogetter
+		input.Regions = &songs.Regions{
+			AllowedCountries: req.GetRegions().GetAllowedCountries(),
+			DeniedCountries:  req.GetRegions().GetDeniedCountries(),
+		}
+	}
+
+	if req.Credits != nil { //nolint:protogetter
+		credits := mapCreditsInput(req.GetCredits().GetCredits())
+		input.Credits = &credits
+	}
+
+	_, err := s.service.UpdateSong(ctx, input)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.UpdateSongResponse{}, nil
 }
 
 func (s *songsServer) DeleteSongs(ctx context.Context, req *api.DeleteSongsRequest) (*api.DeleteSongsResponse, error) {
@@ -103,8 +139,18 @@ func (s *songsServer) DeleteSongs(ctx context.Context, req *api.DeleteSongsReque
 		uniceptors.Auth[*api.DeleteSongsRequest, *api.DeleteSongsResponse](true, s.tokenParser))(s.deleteSongsImpl)
 }
 
-func (*songsServer) deleteSongsImpl(_ context.Context, _ *api.DeleteSongsRequest) (*api.DeleteSongsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "will be implemented later") //nolint:wrapcheck
+func (s *songsServer) deleteSongsImpl(ctx context.Context, req *api.DeleteSongsRequest) (*api.DeleteSongsResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	_, err := s.service.DeleteSongs(ctx, songs.DeleteSongsInput{
+		UserId:   token.Subject,
+		SongsIds: mapUuids(req.GetIds()),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.DeleteSongsResponse{}, nil
 }
 
 func mapArtists(artists []usersclient.Artist) []*users.Artist {
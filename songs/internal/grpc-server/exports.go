@@ -0,0 +1,105 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/exports"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ExportsService interface {
+	ExportCatalog(ctx context.Context, in exports.ExportCatalogInput) (exports.ExportCatalogOutput, error)
+	GetCatalogExports(ctx context.Context, in exports.GetCatalogExportsInput) (exports.GetCatalogExportsOutput, error)
+}
+
+func (s *songsServer) ExportCatalog(ctx context.Context, req *api.ExportCatalogRequest,
+) (*api.ExportCatalogResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "ExportCatalog",
+		uniceptors.Auth[*api.ExportCatalogRequest, *api.ExportCatalogResponse](true, s.tokenParser))(s.exportCatalogImpl)
+}
+
+func (s *songsServer) exportCatalogImpl(ctx context.Context, _ *api.ExportCatalogRequest,
+) (*api.ExportCatalogResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	out, err := s.exports.ExportCatalog(ctx, exports.ExportCatalogInput{
+		ArtistId: token.Subject,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.ExportCatalogResponse{
+		Export: mapExport(out.Export),
+	}, nil
+}
+
+func (s *songsServer) GetCatalogExports(ctx context.Context, req *api.GetCatalogExportsRequest,
+) (*api.GetCatalogExportsResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "GetCatalogExports",
+		uniceptors.Auth[*api.GetCatalogExportsRequest, *api.GetCatalogExportsResponse](true, s.tokenParser))(
+		s.getCatalogExportsImpl)
+}
+
+func (s *songsServer) getCatalogExportsImpl(ctx context.Context, _ *api.GetCatalogExportsRequest,
+) (*api.GetCatalogExportsResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	out, err := s.exports.GetCatalogExports(ctx, exports.GetCatalogExportsInput{
+		ArtistId: token.Subject,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	resp := &api.GetCatalogExportsResponse{
+		Exports: make([]*api.CatalogExport, 0, len(out.Exports)),
+	}
+
+	for _, export := range out.Exports {
+		resp.Exports = append(resp.Exports, mapExport(export))
+	}
+
+	return resp, nil
+}
+
+func mapExport(export exports.Export) *api.CatalogExport {
+	return &api.CatalogExport{
+		Id:          export.Id.String(),
+		Status:      mapExportStatus(export.Status),
+		DownloadUrl: export.DownloadUrl,
+		WeightBytes: export.WeightBytes,
+		Error:       export.Error,
+		CreatedAt:   timestamppb.New(export.CreatedAt),
+		FinishedAt:  mapOptionalTime(export.FinishedAt),
+		ExpiresAt:   mapOptionalTime(export.ExpiresAt),
+	}
+}
+
+func mapExportStatus(status postgres.ExportStatus) api.ExportStatus {
+	switch status {
+	case postgres.ExportStatusRunning:
+		return api.ExportStatus_EXPORT_RUNNING
+	case postgres.ExportStatusReady:
+		return api.ExportStatus_EXPORT_READY
+	case postgres.ExportStatusFailed:
+		return api.ExportStatus_EXPORT_FAILED
+	default:
+		return api.ExportStatus_EXPORT_PENDING
+	}
+}
+
+func mapOptionalTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audio/auth"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -13,13 +15,15 @@ import (
 )
 
 func (s *songsServer) GetSongs(ctx context.Context, req *api.GetSongsRequest) (*api.GetSongsResponse, error) {
-	return applyUnis[*api.GetSongsRequest, *api.GetSongsResponse](
-		ctx, s.log, req, "GetSongs")(s.getSongsImpl)
+	return applyUnis(
+		ctx, s.log, req, "GetSongs",
+		uniceptors.ParseToken[*api.GetSongsRequest, *api.GetSongsResponse](s.tokenParser))(s.getSongsImpl)
 }
 
 func (s *songsServer) getSongsImpl(ctx context.Context, req *api.GetSongsRequest) (*api.GetSongsResponse, error) {
 	var (
 		artistId       *uuid.UUID
+		credit         *songs.CreditFilter
 		page, pageSize int32 = 1, 10
 	)
 
@@ -28,6 +32,22 @@ func (s *songsServer) getSongsImpl(ctx context.Context, req *api.GetSongsRequest
 		artistId = &id
 	}
 
+	if req.GetCreditArtistId() != "" || req.GetMatchCredit() != "" {
+		credit = &songs.CreditFilter{ //nolint:exhaustruct
+			MatchName: req.MatchCredit, //nolint:protogetter
+		}
+
+		if req.GetCreditArtistId() != "" {
+			id := uuid.MustParse(req.GetCreditArtistId())
+			credit.ArtistId = &id
+		}
+
+		if req.CreditRole != nil { //nolint:protogetter
+			role := mapCreditRoleInput(req.GetCreditRole())
+			credit.Role = &role
+		}
+	}
+
 	if req.GetPage() > 0 {
 		page = req.GetPage()
 	}
@@ -36,13 +56,20 @@ func (s *songsServer) getSongsImpl(ctx context.Context, req *api.GetSongsRequest
 		pageSize = req.GetPageSize()
 	}
 
+	// Anonymous listeners see everything and get anonymous links
+	token, _ := uniceptors.MaybeTokenFromCtx(ctx)
+
 	result, err := s.service.GetSongs(ctx, songs.GetSongsInput{
-		ArtistId:    artistId,
-		MatchArtist: req.MatchArtist, //nolint:protogetter
-		MatchName:   req.MatchName,   //nolint:protogetter
-		Ids:         mapUuids(req.GetIds()),
-		Page:        page,
-		PageSize:    pageSize,
+		ArtistId:     artistId,
+		MatchArtist:  req.MatchArtist, //nolint:protogetter
+		MatchName:    req.MatchName,   //nolint:protogetter
+		Ids:          mapUuids(req.GetIds()),
+		Credit:       credit,
+		HideExplicit: token.HideExplicit,
+		Country:      uniceptors.CountryFromMetadata(ctx),
+		ListenerId:   listenerId(token),
+		Page:         page,
+		PageSize:     pageSize,
 	})
 	if err != nil {
 		return nil, err //nolint:wrapcheck
@@ -57,11 +84,15 @@ func (s *songsServer) getSongsImpl(ctx context.Context, req *api.GetSongsRequest
 			Artists:     mapArtists(song.Artists),
 			Name:        song.Name,
 			SongUrl:     song.SongUrl,
+			PreviewUrl:  song.PreviewUrl,
 			ImageUrl:    song.ImageUrl,
 			Duration:    durationpb.New(song.Duration),
 			WeightBytes: song.WeightBytes,
 			UploadedAt:  timestamppb.New(song.UploadedAt),
 			ReleasedAt:  timestamppb.New(song.ReleasedAt),
+			Explicit:    song.Explicit,
+			Unavailable: song.Unavailable,
+			Credits:     mapCredits(song.Credits),
 		}
 	}
 
@@ -116,30 +147,7 @@ func (s *songsServer) getMySongsImpl(ctx context.Context, req *api.GetMySongsReq
 	outSongs := make([]*api.MySong, len(result.Songs))
 
 	for i, song := range result.Songs {
-		var dur *durationpb.Duration
-		if song.Duration != nil {
-			dur = durationpb.New(*song.Duration)
-		}
-
-		uploadedAt := timestamppb.New(song.UploadedAt)
-
-		var releasedAt *timestamppb.Timestamp
-		if song.ReleasedAt != nil {
-			releasedAt = timestamppb.New(*song.ReleasedAt)
-		}
-
-		outSongs[i] = &api.MySong{
-			Id:          song.Id.String(),
-			Singer:      mapArtist(song.Singer),
-			Artists:     mapArtists(song.Artists),
-			Name:        song.Name,
-			SongUrl:     song.SongUrl,
-			ImageUrl:    song.ImageUrl,
-			Duration:    dur,
-			WeightBytes: song.WeightBytes,
-			UploadedAt:  uploadedAt,
-			ReleasedAt:  releasedAt,
-		}
+		outSongs[i] = mapMySong(song)
 	}
 
 	return &api.GetMySongsResponse{
@@ -150,6 +158,15 @@ func (s *songsServer) getMySongsImpl(ctx context.Context, req *api.GetMySongsReq
 	}, nil
 }
 
+// listenerId is the id links are signed for, the token of anonymous listeners is empty.
+func listenerId(token auth.Token) string {
+	if token.Subject == uuid.Nil {
+		return ""
+	}
+
+	return token.Subject.String()
+}
+
 func mapUuids(ids []string) []uuid.UUID {
 	out := make([]uuid.UUID, len(ids))
 	for i, id := range ids {
@@ -158,3 +175,94 @@ func mapUuids(ids []string) []uuid.UUID {
 
 	return out
 }
+
+func mapMySong(song songs.MySong) *api.MySong {
+	var dur *durationpb.Duration
+	if song.Duration != nil {
+		dur = durationpb.New(*song.Duration)
+	}
+
+	uploadedAt := timestamppb.New(song.UploadedAt)
+
+	var releasedAt *timestamppb.Timestamp
+	if song.ReleasedAt != nil {
+		releasedAt = timestamppb.New(*song.ReleasedAt)
+	}
+
+	return &api.MySong{
+		Id:           song.Id.String(),
+		Singer:       mapArtist(song.Singer),
+		Artists:      mapArtists(song.Artists),
+		Name:         song.Name,
+		SongUrl:      song.SongUrl,
+		ImageUrl:     song.ImageUrl,
+		ThumbnailUrl: song.ThumbnailUrl,
+		Duration:     dur,
+		WeightBytes:  song.WeightBytes,
+		UploadedAt:   uploadedAt,
+		ReleasedAt:   releasedAt,
+
+		ModerationStatus: mapModerationStatus(song.ModerationStatus),
+		ModerationReason: song.ModerationReason,
+		Explicit:         song.Explicit,
+		Regions: &api.RegionRestrictions{
+			AllowedCountries: song.AllowedCountries,
+			DeniedCountries:  song.DeniedCountries,
+		},
+		Credits:    mapCredits(song.Credits),
+		Audio:      mapAudioInfo(song.Audio),
+		AudioState: mapAudioState(song.AudioState),
+		AudioError: song.AudioError,
+	}
+}
+
+func mapAudioState(state postgres.AudioState) api.AudioState {
+	switch state {
+	case postgres.AudioStateUploading:
+		return api.AudioState_AUDIO_UPLOADING
+	case postgres.AudioStateProcessing:
+		return api.AudioState_AUDIO_PROCESSING
+	case postgres.AudioStateReady:
+		return api.AudioState_AUDIO_READY
+	case postgres.AudioStateFailed:
+		return api.AudioState_AUDIO_FAILED
+	default:
+		return api.AudioState_AUDIO_DRAFT
+	}
+}
+
+func mapAudioInfo(audio *songs.AudioInfo) *api.AudioInfo {
+	if audio == nil {
+		return nil
+	}
+
+	return &api.AudioInfo{
+		Bitrate:     audio.Bitrate,
+		BitrateMode: mapBitrateMode(audio.BitrateMode),
+		SampleRate:  audio.SampleRate,
+		ChannelMode: mapChannelMode(audio.ChannelMode),
+		Encoder:     audio.Encoder,
+		Waveform:    audio.Waveform,
+	}
+}
+
+func mapBitrateMode(mode postgres.BitrateMode) api.BitrateMode {
+	if mode == postgres.BitrateModeVbr {
+		return api.BitrateMode_VBR
+	}
+
+	return api.BitrateMode_CBR
+}
+
+func mapChannelMode(mode postgres.ChannelMode) api.ChannelMode {
+	switch mode {
+	case postgres.ChannelModeJointStereo:
+		return api.ChannelMode_JOINT_STEREO
+	case postgres.ChannelModeDualChannel:
+		return api.ChannelMode_DUAL_CHANNEL
+	case postgres.ChannelModeMono:
+		return api.ChannelMode_MONO
+	default:
+		return api.ChannelMode_STEREO
+	}
+}
@@ -0,0 +1,123 @@
+package grpcgw
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/transport"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/rs/zerolog"
+)
+
+// realIpHeader is the client address set by nginx in front of the gateway.
+const realIpHeader = "X-Real-IP"
+
+type CountryResolver interface {
+	Country(ip net.IP) (string, error)
+}
+
+// CountryConfig tells where the caller's country comes from, everything is optional.
+type CountryConfig struct {
+	// Header with the country set by the proxy, it is read only from the trusted proxies
+	Header string
+	// Proxies whose Header and X-Real-IP are trusted
+	TrustedProxies []*net.IPNet
+	Geo            CountryResolver
+}
+
+// ParseProxies parses the addresses and CIDRs of the trusted proxies.
+func ParseProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, e.New("invalid proxy address", fields.F("proxy", proxy))
+			}
+
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)}) //nolint:mnd
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, e.NewFrom("parsing proxy cidr", err, fields.F("proxy", proxy))
+		}
+
+		nets = append(nets, ipNet)
+	}
+
+	return nets, nil
+}
+
+// CountryMw sets the caller's country to the transport.CountryKey header,
+// which is forwarded to the grpc handlers. Whatever the client sent itself is dropped.
+// Behind a trusted proxy the country is taken from its header and resolved by its X-Real-IP,
+// otherwise it is only resolved by the address of the connection.
+func CountryMw(log zerolog.Logger, conf CountryConfig, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var country string
+
+		ip := remoteIp(r.RemoteAddr)
+		trusted := isTrusted(ip, conf.TrustedProxies)
+
+		if trusted && conf.Header != "" {
+			country = r.Header.Get(conf.Header)
+		}
+
+		if trusted {
+			if realIp := net.ParseIP(r.Header.Get(realIpHeader)); realIp != nil {
+				ip = realIp
+			}
+		}
+
+		if country == "" && conf.Geo != nil && ip != nil {
+			country = resolveCountry(log, conf.Geo, ip)
+		}
+
+		r.Header.Del(transport.CountryKey)
+
+		if country != "" {
+			r.Header.Set(transport.CountryKey, strings.ToUpper(country))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func remoteIp(remoteAddr string) net.IP {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+
+	return net.ParseIP(host)
+}
+
+func isTrusted(ip net.IP, proxies []*net.IPNet) bool {
+	if ip == nil {
+		return false
+	}
+
+	for _, proxy := range proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resolveCountry(log zerolog.Logger, geo CountryResolver, ip net.IP) string {
+	country, err := geo.Country(ip)
+	if err != nil {
+		log.Debug().Err(err).Str("ip", ip.String()).Msg("resolving country")
+		return ""
+	}
+
+	return country
+}
@@ -0,0 +1,103 @@
+package grpcgw_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/grpcgw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/transport"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// geo resolves the addresses of 10.0.0.0/8 to RU and others to DE.
+type geo struct{}
+
+func (geo) Country(ip net.IP) (string, error) {
+	if ip.To4() != nil && ip.To4()[0] == 10 {
+		return "RU", nil
+	}
+
+	return "DE", nil
+}
+
+func TestCountryMw(t *testing.T) {
+	proxies, err := grpcgw.ParseProxies([]string{"172.16.0.0/12", "192.168.1.1"})
+	require.NoError(t, err)
+
+	conf := grpcgw.CountryConfig{Header: "X-Country", TrustedProxies: proxies, Geo: geo{}}
+
+	tests := []struct {
+		name       string
+		conf       grpcgw.CountryConfig
+		remoteAddr string
+		headers    map[string]string
+		want       string
+	}{
+		{
+			name:       "client header is ignored",
+			conf:       conf,
+			remoteAddr: "1.2.3.4:5000",
+			headers:    map[string]string{"X-Country": "XX", "X-Real-IP": "10.0.0.1"},
+			want:       "DE",
+		},
+		{
+			name:       "proxy header",
+			conf:       conf,
+			remoteAddr: "172.18.0.5:5000",
+			headers:    map[string]string{"X-Country": "fr"},
+			want:       "FR",
+		},
+		{
+			name:       "proxy real ip",
+			conf:       conf,
+			remoteAddr: "192.168.1.1:5000",
+			headers:    map[string]string{"X-Real-IP": "10.0.0.1"},
+			want:       "RU",
+		},
+		{
+			name:       "geoip only by default",
+			conf:       grpcgw.CountryConfig{TrustedProxies: proxies, Geo: geo{}},
+			remoteAddr: "172.18.0.5:5000",
+			headers:    map[string]string{"X-Country": "XX", "X-Real-IP": "10.0.0.1"},
+			want:       "RU",
+		},
+		{
+			name:       "unknown",
+			conf:       grpcgw.CountryConfig{Header: "X-Country"},
+			remoteAddr: "1.2.3.4:5000",
+			headers:    map[string]string{"X-Country": "XX"},
+			want:       "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+
+			handler := grpcgw.CountryMw(zerolog.Nop(), tt.conf, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get(transport.CountryKey)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/songs/api/v1/songs", nil)
+			req.RemoteAddr = tt.remoteAddr
+
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseProxiesInvalid(t *testing.T) {
+	_, err := grpcgw.ParseProxies([]string{"nginx"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package grpcgw
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+)
+
+var ErrExportIdNotUuid = erix.NewStatus("id path param must be uuid", erix.CodeBadRequest)
+
+type ExportService interface {
+	GetExportObject(ctx context.Context, exportId uuid.UUID) (io.Reader, error)
+}
+
+type ExportHandlers struct {
+	Service ExportService
+}
+
+// DownloadExportHandler streams the zip of the export, the link works until the export expires.
+func (s ExportHandlers) DownloadExportHandler() HandlerErrFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) error {
+		exportId, err := uuid.Parse(pathParams["id"])
+		if err != nil {
+			return ErrExportIdNotUuid.Wrap(err)
+		}
+
+		reader, err := s.Service.GetExportObject(r.Context(), exportId)
+		if err != nil {
+			return err
+		}
+
+		if closer, ok := reader.(io.Closer); ok {
+			defer closer.Close()
+		}
+
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", `attachment; filename="catalog-`+exportId.String()+`.zip"`)
+
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			return ErrFileNotFound.Wrap(err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,129 @@
+package grpcgw
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/imports"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+)
+
+var (
+	ErrInvalidImportForm = erix.NewStatus("form must contain files 'manifest' and 'archive'", erix.CodeBadRequest)
+	ErrImportTooLarge    = erix.NewStatus("import too large", erix.CodeBadRequest)
+)
+
+// importFormMemory is how much of the form is kept in memory, the rest is written to temporary files.
+const importFormMemory = 32 << 20
+
+type ImportService interface {
+	ImportSongs(ctx context.Context, in imports.ImportSongsInput) (imports.ImportSongsOutput, error)
+}
+
+type ImportHandlers struct {
+	Service ImportService
+	// Zero means unlimited
+	MaxBytes int64
+	// Imports take longer than the server timeouts allow, zero keeps them
+	Timeout time.Duration
+}
+
+func (s ImportHandlers) ImportSongsHandler() HandlerErrFunc {
+	type row struct {
+		Row    int     `json:"row"`
+		Name   string  `json:"name"`
+		SongId *string `json:"songId,omitempty"`
+		Status string  `json:"status"`
+		Error  *string `json:"error,omitempty"`
+	}
+
+	type response struct {
+		Rows []row `json:"rows"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+		token := TokenFromCtx(r.Context())
+
+		if s.Timeout > 0 {
+			rc := http.NewResponseController(w)
+			deadline := time.Now().Add(s.Timeout)
+
+			// Not every writer supports deadlines, the server timeouts stay then
+			_ = rc.SetReadDeadline(deadline)
+			_ = rc.SetWriteDeadline(deadline)
+		}
+
+		if s.MaxBytes > 0 {
+			r.Body = http.MaxBytesReader(w, r.Body, s.MaxBytes)
+		}
+
+		err := r.ParseMultipartForm(importFormMemory)
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return ErrImportTooLarge.Wrap(err)
+			}
+
+			return ErrParsingForm.Wrap(err)
+		}
+		defer r.MultipartForm.RemoveAll() //nolint:errcheck
+
+		manifest, manifestHeader, err := r.FormFile("manifest")
+		if err != nil {
+			return ErrInvalidImportForm.Wrap(err)
+		}
+		defer manifest.Close()
+
+		archive, archiveHeader, err := r.FormFile("archive")
+		if err != nil {
+			return ErrInvalidImportForm.Wrap(err)
+		}
+		defer archive.Close()
+
+		out, err := s.Service.ImportSongs(r.Context(), imports.ImportSongsInput{
+			ArtistId:       token.Subject,
+			ManifestFormat: imports.ManifestFormat(fileExt(manifestHeader.Filename)),
+			Manifest:       manifest,
+			ArchiveFormat:  imports.ArchiveFormat(fileExt(archiveHeader.Filename)),
+			Archive:        archive,
+			ArchiveSize:    archiveHeader.Size,
+		})
+		if err != nil {
+			return err
+		}
+
+		resp := response{Rows: make([]row, 0, len(out.Rows))}
+
+		for _, result := range out.Rows {
+			item := row{
+				Row:    result.Row,
+				Name:   result.Name,
+				SongId: nil,
+				Status: string(result.Status),
+				Error:  nil,
+			}
+
+			if result.SongId != nil {
+				id := result.SongId.String()
+				item.SongId = &id
+			}
+
+			if result.Err != nil {
+				reason := erix.LastReason(result.Err)
+				item.Error = &reason
+			}
+
+			resp.Rows = append(resp.Rows, item)
+		}
+
+		return jsonResp(w, resp)
+	}
+}
+
+func fileExt(name string) string {
+	return strings.ToLower(strings.TrimPrefix(filepath.Ext(name), "."))
+}
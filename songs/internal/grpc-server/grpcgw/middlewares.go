@@ -10,6 +10,8 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audio/auth"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/metrics"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/tracing"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/transport"
 
 	"dev.gaijin.team/go/golib/stacktrace"
@@ -28,9 +30,12 @@ var (
 
 func ContextWithLogger(base zerolog.Logger, next gateway.HandlerFunc) gateway.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
-		var traceId string
+		traceId := tracing.TraceId(r.Context())
+
+		if traceId == "" {
+			traceId = r.Header.Get(transport.TraceIdKey)
+		}
 
-		traceId = r.Header.Get(transport.TraceIdKey)
 		if traceId == "" {
 			traceId = uuid.NewString()
 		}
@@ -93,6 +98,14 @@ func LoggingMw(next HandlerErrFunc) gateway.HandlerFunc {
 		handlerErr := next(w, r, pathParams)
 		elapsed := time.Since(start)
 
+		// The route, the path has the params
+		method := r.Method
+		if pattern, ok := gateway.HTTPPattern(r.Context()); ok {
+			method += " " + pattern.String()
+		}
+
+		metrics.ObserveRequest(metrics.TransportHttp, method, elapsed, handlerErr)
+
 		var logLevel zerolog.Level = zerolog.InfoLevel
 
 		if handlerErr != nil {
@@ -135,6 +148,26 @@ func AuthMw(mustArtist bool, parser auth.Parser, next HandlerErrFunc) HandlerErr
 	}
 }
 
+// OptionalAuthMw is AuthMw for the routes open to everyone:
+// requests without a token pass as is, see [OptionalTokenFromCtx].
+func OptionalAuthMw(parser auth.Parser, next HandlerErrFunc) HandlerErrFunc {
+	withToken := AuthMw(false, parser, next)
+
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) error {
+		if r.Header.Get("Authorization") == "" {
+			return next(w, r, pathParams)
+		}
+
+		return withToken(w, r, pathParams)
+	}
+}
+
+func OptionalTokenFromCtx(ctx context.Context) (auth.Token, bool) {
+	tkn, ok := ctx.Value(tokenKey{}).(auth.Token)
+
+	return tkn, ok
+}
+
 func TokenFromCtx(ctx context.Context) auth.Token {
 	tkn, ok := ctx.Value(tokenKey{}).(auth.Token)
 	if !ok {
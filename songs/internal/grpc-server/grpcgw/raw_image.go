@@ -45,13 +45,18 @@ func (s RawHandlers) UploadRawSongImageHandler() HandlerErrFunc {
 
 func (s RawHandlers) GetRawSongImageHandler() HandlerErrFunc {
 	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) error {
-		songId := pathParams["id"]
-
-		output, err := s.Service.GetRawSongImage(r.Context(), songId)
+		output, err := s.Service.GetRawSongImage(r.Context(), raw.GetRawSongImageInput{
+			ObjectId:  pathParams["id"],
+			Signature: r.URL.Query(),
+		})
 		if err != nil {
 			return err
 		}
 
+		if closer, ok := output.Content.(io.Closer); ok {
+			defer closer.Close()
+		}
+
 		_, err = io.Copy(w, output.Content)
 		if err != nil {
 			return ErrFileNotFound.Wrap(err)
@@ -62,3 +67,29 @@ func (s RawHandlers) GetRawSongImageHandler() HandlerErrFunc {
 		return nil
 	}
 }
+
+// GetRawSongThumbnailHandler serves the jpeg thumbnail of the image by the link from MySong.
+func (s RawHandlers) GetRawSongThumbnailHandler() HandlerErrFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) error {
+		reader, err := s.Service.GetRawSongThumbnail(r.Context(), raw.GetRawSongImageInput{
+			ObjectId:  pathParams["id"],
+			Signature: r.URL.Query(),
+		})
+		if err != nil {
+			return err
+		}
+
+		if closer, ok := reader.(io.Closer); ok {
+			defer closer.Close()
+		}
+
+		w.Header().Set("Content-Type", "image/jpeg")
+
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			return ErrFileNotFound.Wrap(err)
+		}
+
+		return nil
+	}
+}
@@ -2,24 +2,36 @@ package grpcgw
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"io"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/transport"
 )
 
 type RawService interface {
 	UploadRawSong(ctx context.Context, in raw.UploadRawSongInput) (raw.UploadRawSongOutput, error)
-	GetRawSong(ctx context.Context, songId string) (io.Reader, error)
+	GetRawSong(ctx context.Context, in raw.GetRawSongInput) (io.Reader, error)
 	UploadRawSongImage(ctx context.Context, input raw.UploadRawSongImageInput) (raw.UploadRawSongImageOutput, error)
-	GetRawSongImage(ctx context.Context, songId string) (raw.GetRawSongImageOutput, error)
+	GetRawSongImage(ctx context.Context, in raw.GetRawSongImageInput) (raw.GetRawSongImageOutput, error)
+	GetRawSongThumbnail(ctx context.Context, in raw.GetRawSongImageInput) (io.Reader, error)
+	GetPreview(ctx context.Context, in raw.GetPreviewInput) (io.Reader, error)
+	GetUploadState(ctx context.Context, in raw.GetUploadStateInput) (raw.UploadState, error)
 }
 
 type RawHandlers struct {
 	Service RawService
+	// How often the upload events check the state and how long they last, zero timeout keeps the server one
+	PollInterval  time.Duration
+	EventsTimeout time.Duration
 }
 
 var (
@@ -29,6 +41,7 @@ var (
 func (s RawHandlers) UploadRawSongHandler() HandlerErrFunc {
 	type response struct {
 		SongUrl string `json:"songUrl"`
+		State   string `json:"state"`
 	}
 
 	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) error {
@@ -50,6 +63,7 @@ func (s RawHandlers) UploadRawSongHandler() HandlerErrFunc {
 			Extension:   form.Ext,
 			WeightBytes: form.Size,
 			Content:     form.File,
+			Imported:    false,
 		})
 		if err != nil {
 			return err
@@ -57,19 +71,28 @@ func (s RawHandlers) UploadRawSongHandler() HandlerErrFunc {
 
 		return jsonResp(w, response{
 			SongUrl: out.SongUrl,
+			State:   string(out.State),
 		})
 	}
 }
 
 func (s RawHandlers) GetRawSongHandler() HandlerErrFunc {
 	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) error {
-		songId := pathParams["id"]
-
-		reader, err := s.Service.GetRawSong(r.Context(), songId)
+		reader, err := s.Service.GetRawSong(r.Context(), raw.GetRawSongInput{
+			ObjectId:   pathParams["id"],
+			Signature:  r.URL.Query(),
+			Country:    r.Header.Get(transport.CountryKey),
+			ListenerId: listenerId(r),
+			SignedIn:   signedIn(r),
+		})
 		if err != nil {
 			return err
 		}
 
+		if closer, ok := reader.(io.Closer); ok {
+			defer closer.Close()
+		}
+
 		_, err = io.Copy(w, reader)
 		if err != nil {
 			return ErrFileNotFound.Wrap(err)
@@ -80,3 +103,65 @@ func (s RawHandlers) GetRawSongHandler() HandlerErrFunc {
 		return nil
 	}
 }
+
+// Seconds a listener waits for a missing preview, its job makes it in a few seconds.
+const previewRetryAfter = "10"
+
+// GetPreviewHandler serves the preview of the song to anyone, link unfurls included.
+func (s RawHandlers) GetPreviewHandler() HandlerErrFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) error {
+		songId, err := uuid.Parse(pathParams["song_id"])
+		if err != nil {
+			return ErrNotUuid.Wrap(err)
+		}
+
+		reader, err := s.Service.GetPreview(r.Context(), raw.GetPreviewInput{
+			SongId:  songId,
+			Country: r.Header.Get(transport.CountryKey),
+		})
+		if errors.Is(err, raw.ErrPreviewNotReady) {
+			w.Header().Set("Retry-After", previewRetryAfter)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if closer, ok := reader.(io.Closer); ok {
+			defer closer.Close()
+		}
+
+		w.Header().Set("Content-Type", "audio/mpeg")
+
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			return ErrFileNotFound.Wrap(err)
+		}
+
+		return nil
+	}
+}
+
+func signedIn(r *http.Request) bool {
+	_, ok := OptionalTokenFromCtx(r.Context())
+	return ok
+}
+
+// listenerId tells the listeners apart in analytics: signed in ones by their ids
+// and anonymous ones by a hash of their address, so the address itself is not stored.
+func listenerId(r *http.Request) string {
+	if token, ok := OptionalTokenFromCtx(r.Context()); ok {
+		return token.Subject.String()
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	const hashLen = 16
+
+	sum := sha256.Sum256([]byte(host))
+
+	return "anon:" + hex.EncodeToString(sum[:hashLen])
+}
@@ -0,0 +1,135 @@
+package grpcgw
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+)
+
+type uploadEvent struct {
+	State     postgres.AudioState `json:"state"`
+	Error     *string             `json:"error,omitempty"`
+	ChangedAt time.Time           `json:"changedAt"`
+}
+
+// UploadEventsHandler streams the audio state of the song as server-sent events.
+// An event is sent on every change, the stream ends once the song is ready or failed.
+// A draft song has no upload to wait for, it gets the single event of its state.
+func (s RawHandlers) UploadEventsHandler() HandlerErrFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) error {
+		token := TokenFromCtx(r.Context())
+
+		songId, err := uuid.Parse(pathParams["song_id"])
+		if err != nil {
+			return ErrNotUuid.Wrap(err)
+		}
+
+		input := raw.GetUploadStateInput{
+			ArtistId: token.Subject,
+			SongId:   songId,
+		}
+
+		// Errors before the stream starts are regular responses
+		state, err := s.Service.GetUploadState(r.Context(), input)
+		if err != nil {
+			return err
+		}
+
+		ctx := r.Context()
+		rc := http.NewResponseController(w)
+
+		// The stream outlives the server timeouts, not every writer supports deadlines though
+		if s.EventsTimeout > 0 {
+			_ = rc.SetWriteDeadline(time.Now().Add(s.EventsTimeout))
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+
+		log := logger.FromContext(ctx)
+
+		ticker := time.NewTicker(s.PollInterval)
+		defer ticker.Stop()
+
+		var timeout <-chan time.Time
+
+		if s.EventsTimeout > 0 {
+			timer := time.NewTimer(s.EventsTimeout)
+			defer timer.Stop()
+
+			timeout = timer.C
+		}
+
+		for {
+			err = writeUploadEvent(w, rc, state)
+			if err != nil {
+				log.Debug().Err(err).Msg("listener of upload events is gone")
+				return nil
+			}
+
+			if !uploadInProgress(state.State) {
+				return nil
+			}
+
+			last := state
+
+			for sameUploadState(state, last) {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-timeout:
+					return nil
+				case <-ticker.C:
+				}
+
+				state, err = s.Service.GetUploadState(ctx, input)
+				if err != nil {
+					log.Warn().Err(err).Msg("error getting upload state")
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// uploadInProgress tells whether the state may change without a new upload.
+func uploadInProgress(state postgres.AudioState) bool {
+	return state == postgres.AudioStateUploading || state == postgres.AudioStateProcessing
+}
+
+// sameUploadState compares the times with Equal, == tells apart the same instant in other locations.
+func sameUploadState(a, b raw.UploadState) bool {
+	return a.State == b.State && a.Error == b.Error && a.ChangedAt.Equal(b.ChangedAt)
+}
+
+func writeUploadEvent(w http.ResponseWriter, rc *http.ResponseController, state raw.UploadState) error {
+	event := uploadEvent{
+		State:     state.State,
+		Error:     nil,
+		ChangedAt: state.ChangedAt,
+	}
+
+	if state.Error != "" {
+		event.Error = &state.Error
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	_, err = fmt.Fprintf(w, "event: state\ndata: %s\n\n", data)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return rc.Flush() //nolint:wrapcheck
+}
@@ -0,0 +1,74 @@
+package grpcgw
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audio/auth"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// uploadStates returns the states one by one and repeats the last one.
+type uploadStates struct {
+	RawService
+
+	states []raw.UploadState
+}
+
+func (u *uploadStates) GetUploadState(context.Context, raw.GetUploadStateInput) (raw.UploadState, error) {
+	state := u.states[0]
+	if len(u.states) > 1 {
+		u.states = u.states[1:]
+	}
+
+	return state, nil
+}
+
+func serveUploadEvents(t *testing.T, states ...raw.UploadState) []string {
+	t.Helper()
+
+	handlers := RawHandlers{
+		Service:       &uploadStates{RawService: nil, states: states},
+		PollInterval:  time.Millisecond,
+		EventsTimeout: 0,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/songs/api/v1/song/raw/events", nil)
+	req = req.WithContext(context.WithValue(req.Context(), tokenKey{}, auth.Token{Subject: uuid.New()})) //nolint:exhaustruct
+	rec := httptest.NewRecorder()
+
+	err := handlers.UploadEventsHandler()(rec, req, map[string]string{"song_id": uuid.NewString()})
+	require.NoError(t, err)
+
+	return strings.Split(strings.TrimSpace(rec.Body.String()), "\n\n")
+}
+
+func TestUploadEvents_Draft(t *testing.T) {
+	events := serveUploadEvents(t, raw.UploadState{State: postgres.AudioStateDraft}) //nolint:exhaustruct
+
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0], `"state":"draft"`)
+}
+
+func TestUploadEvents_SameInstantInOtherLocation(t *testing.T) {
+	changedAt := time.Now()
+
+	events := serveUploadEvents(t,
+		raw.UploadState{State: postgres.AudioStateProcessing, Error: "", ChangedAt: changedAt},
+		raw.UploadState{State: postgres.AudioStateProcessing, Error: "", ChangedAt: changedAt.UTC()},
+		raw.UploadState{State: postgres.AudioStateReady, Error: "", ChangedAt: changedAt.Add(time.Second)},
+	)
+
+	require.Len(t, events, 2)
+	assert.Contains(t, events[0], `"state":"processing"`)
+	assert.Contains(t, events[1], `"state":"ready"`)
+}
@@ -0,0 +1,107 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type JobsService interface {
+	GetDeadJobs(ctx context.Context, in jobs.GetDeadJobsInput) (jobs.GetDeadJobsOutput, error)
+	RetryJob(ctx context.Context, in jobs.RetryJobInput) (jobs.RetryJobOutput, error)
+}
+
+func (s *songsServer) GetDeadJobs(ctx context.Context, req *api.GetDeadJobsRequest,
+) (*api.GetDeadJobsResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "GetDeadJobs",
+		uniceptors.Admin[*api.GetDeadJobsRequest, *api.GetDeadJobsResponse](s.tokenParser))(s.getDeadJobsImpl)
+}
+
+func (s *songsServer) getDeadJobsImpl(ctx context.Context, req *api.GetDeadJobsRequest,
+) (*api.GetDeadJobsResponse, error) {
+	var (
+		page, pageSize int32 = 1, 10
+	)
+
+	if req.GetPage() > 0 {
+		page = req.GetPage()
+	}
+
+	if req.GetPageSize() > 0 {
+		pageSize = req.GetPageSize()
+	}
+
+	out, err := s.jobs.GetDeadJobs(ctx, jobs.GetDeadJobsInput{
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	resp := &api.GetDeadJobsResponse{
+		Jobs: make([]*api.Job, 0, len(out.Jobs)),
+		Pagination: &api.PaginationResponse{
+			LastPage: out.LastPage,
+		},
+	}
+
+	for _, job := range out.Jobs {
+		resp.Jobs = append(resp.Jobs, mapJob(job))
+	}
+
+	return resp, nil
+}
+
+func (s *songsServer) RetryJob(ctx context.Context, req *api.RetryJobRequest) (*api.RetryJobResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "RetryJob",
+		uniceptors.Admin[*api.RetryJobRequest, *api.RetryJobResponse](s.tokenParser))(s.retryJobImpl)
+}
+
+func (s *songsServer) retryJobImpl(ctx context.Context, req *api.RetryJobRequest) (*api.RetryJobResponse, error) {
+	out, err := s.jobs.RetryJob(ctx, jobs.RetryJobInput{
+		JobId: uuid.MustParse(req.GetId()),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.RetryJobResponse{
+		Job: mapJob(out.Job),
+	}, nil
+}
+
+func mapJob(job jobs.Job) *api.Job {
+	return &api.Job{
+		Id:          job.Id.String(),
+		Kind:        job.Kind,
+		Payload:     string(job.Payload),
+		Status:      mapJobStatus(job.Status),
+		UniqueKey:   job.UniqueKey,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		LastError:   job.LastError,
+		RunAt:       timestamppb.New(job.RunAt),
+		CreatedAt:   timestamppb.New(job.CreatedAt),
+		FailedAt:    mapOptionalTime(job.FailedAt),
+	}
+}
+
+func mapJobStatus(status postgres.JobStatus) api.JobStatus {
+	switch status {
+	case postgres.JobStatusRunning:
+		return api.JobStatus_JOB_RUNNING
+	case postgres.JobStatusDead:
+		return api.JobStatus_JOB_DEAD
+	default:
+		return api.JobStatus_JOB_QUEUED
+	}
+}
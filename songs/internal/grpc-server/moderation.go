@@ -0,0 +1,91 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"github.com/google/uuid"
+)
+
+func (s *songsServer) FlagSong(ctx context.Context, req *api.FlagSongRequest) (*api.FlagSongResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "FlagSong",
+		uniceptors.Admin[*api.FlagSongRequest, *api.FlagSongResponse](s.tokenParser))(s.flagSongImpl)
+}
+
+func (s *songsServer) flagSongImpl(ctx context.Context, req *api.FlagSongRequest) (*api.FlagSongResponse, error) {
+	reason := req.GetReason()
+
+	err := s.moderateSong(ctx, req.GetId(), postgres.ModerationStatusUnderReview, &reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.FlagSongResponse{}, nil
+}
+
+func (s *songsServer) TakeDownSong(ctx context.Context, req *api.TakeDownSongRequest,
+) (*api.TakeDownSongResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "TakeDownSong",
+		uniceptors.Admin[*api.TakeDownSongRequest, *api.TakeDownSongResponse](s.tokenParser))(s.takeDownSongImpl)
+}
+
+func (s *songsServer) takeDownSongImpl(ctx context.Context, req *api.TakeDownSongRequest,
+) (*api.TakeDownSongResponse, error) {
+	reason := req.GetReason()
+
+	err := s.moderateSong(ctx, req.GetId(), postgres.ModerationStatusTakenDown, &reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.TakeDownSongResponse{}, nil
+}
+
+func (s *songsServer) RestoreSong(ctx context.Context, req *api.RestoreSongRequest,
+) (*api.RestoreSongResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "RestoreSong",
+		uniceptors.Admin[*api.RestoreSongRequest, *api.RestoreSongResponse](s.tokenParser))(s.restoreSongImpl)
+}
+
+func (s *songsServer) restoreSongImpl(ctx context.Context, req *api.RestoreSongRequest,
+) (*api.RestoreSongResponse, error) {
+	err := s.moderateSong(ctx, req.GetId(), postgres.ModerationStatusActive, req.Reason) //nolint:protogetter
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.RestoreSongResponse{}, nil
+}
+
+func (s *songsServer) moderateSong(ctx context.Context,
+	id string, status postgres.ModerationStatus, reason *string,
+) error {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	_, err := s.service.ModerateSong(ctx, songs.ModerateSongInput{
+		ModeratorId: token.Subject,
+		SongId:      uuid.MustParse(id),
+		Status:      status,
+		Reason:      reason,
+	})
+
+	return err //nolint:wrapcheck
+}
+
+func mapModerationStatus(status postgres.ModerationStatus) api.ModerationStatus {
+	switch status {
+	case postgres.ModerationStatusUnderReview:
+		return api.ModerationStatus_UNDER_REVIEW
+	case postgres.ModerationStatusTakenDown:
+		return api.ModerationStatus_TAKEN_DOWN
+	default:
+		return api.ModerationStatus_ACTIVE
+	}
+}
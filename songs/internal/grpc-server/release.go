@@ -2,6 +2,7 @@ package grpcserver
 
 import (
 	"context"
+	"time"
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
@@ -20,9 +21,10 @@ func (s *songsServer) releaseSongsImpl(ctx context.Context, req *api.ReleaseSong
 	token := uniceptors.TokenFromCtx(ctx)
 
 	_, err := s.service.ReleaseSongs(ctx, songs.ReleaseSongsInput{
-		UserId:   token.Subject,
-		SongsIds: mapUuids(req.GetIds()),
-		Notify:   req.GetNotify(),
+		UserId:     token.Subject,
+		SongsIds:   mapUuids(req.GetIds()),
+		Notify:     req.GetNotify(),
+		ReleasedAt: time.Time{},
 	})
 	if err != nil {
 		return nil, err //nolint:wrapcheck
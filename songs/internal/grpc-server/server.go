@@ -3,17 +3,22 @@ package grpcserver
 import (
 	"context"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/grpcgw"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/health"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/transport"
 
 	"dev.gaijin.team/go/golib/e"
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -22,6 +27,12 @@ type songsServer struct {
 	api.UnimplementedSongsServiceServer
 
 	service     Service
+	claims      ClaimsService
+	exports     ExportsService
+	analytics   AnalyticsService
+	jobs        JobsService
+	webhooks    WebhooksService
+	health      *health.Checker
 	tokenParser uniceptors.TokenParser
 }
 
@@ -31,12 +42,36 @@ type Service interface {
 	GetSong(ctx context.Context, input songs.GetSongInput) (songs.GetSongOutput, error)
 	GetSongs(ctx context.Context, input songs.GetSongsInput) (songs.GetSongsOutput, error)
 	ReleaseSongs(ctx context.Context, in songs.ReleaseSongsInput) (songs.ReleaseSongsOutput, error)
+	UpdateSong(ctx context.Context, in songs.UpdateSongInput) (songs.UpdateSongOutput, error)
+	DeleteSongs(ctx context.Context, in songs.DeleteSongsInput) (songs.DeleteSongsOutput, error)
+	ModerateSong(ctx context.Context, in songs.ModerateSongInput) (songs.ModerateSongOutput, error)
+	GetMyUsage(ctx context.Context, in songs.GetMyUsageInput) (songs.GetMyUsageOutput, error)
+	GetTrashedSongs(ctx context.Context, in songs.GetTrashedSongsInput) (songs.GetTrashedSongsOutput, error)
+	RestoreTrashedSongs(ctx context.Context, in songs.RestoreTrashedSongsInput) (songs.RestoreTrashedSongsOutput, error)
+	GetCreditRequests(ctx context.Context, in songs.GetCreditRequestsInput) (songs.GetCreditRequestsOutput, error)
+	ResolveCreditRequest(ctx context.Context, in songs.ResolveCreditRequestInput,
+	) (songs.ResolveCreditRequestOutput, error)
 }
 
 type Dependencies struct {
-	Service     Service
-	RawService  grpcgw.RawService
-	TokenParser uniceptors.TokenParser
+	Service          Service
+	ClaimsService    ClaimsService
+	ExportsService   ExportsService
+	AnalyticsService AnalyticsService
+	JobsService      JobsService
+	WebhooksService  WebhooksService
+	RawService       grpcgw.RawService
+	ImportService    grpcgw.ImportService
+	ExportService    grpcgw.ExportService
+	// Max size and duration of an import request, zero means unlimited
+	ImportMaxBytes int64
+	ImportTimeout  time.Duration
+	// How often upload events check the state and how long they last, zero timeout keeps the server one
+	UploadPollInterval  time.Duration
+	UploadEventsTimeout time.Duration
+	// Probes of the dependencies for the Health RPC
+	HealthChecker *health.Checker
+	TokenParser   uniceptors.TokenParser
 }
 
 func Register(log zerolog.Logger, server *grpc.Server, gatewayMux *gateway.ServeMux, deps Dependencies) {
@@ -44,6 +79,12 @@ func Register(log zerolog.Logger, server *grpc.Server, gatewayMux *gateway.Serve
 		log:                             log,
 		UnimplementedSongsServiceServer: api.UnimplementedSongsServiceServer{},
 		service:                         deps.Service,
+		claims:                          deps.ClaimsService,
+		exports:                         deps.ExportsService,
+		analytics:                       deps.AnalyticsService,
+		jobs:                            deps.JobsService,
+		webhooks:                        deps.WebhooksService,
+		health:                          deps.HealthChecker,
 		tokenParser:                     deps.TokenParser,
 	}
 
@@ -58,13 +99,21 @@ func Register(log zerolog.Logger, server *grpc.Server, gatewayMux *gateway.Serve
 	_ = api.RegisterSongsServiceHandlerServer(context.Background(), gatewayMux, srv)
 }
 
-func (*songsServer) Health(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+// Health fails with Unavailable if a dependency has failed, /readyz tells which ones and why.
+func (s *songsServer) Health(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	report := s.health.Check(ctx)
+	if !report.Ready {
+		return nil, status.Error(codes.Unavailable, "not ready: "+strings.Join(report.Failed(), ", "))
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
 func registerHandlers(mux *gateway.ServeMux, log zerolog.Logger, deps Dependencies) error {
 	h := grpcgw.RawHandlers{
-		Service: deps.RawService,
+		Service:       deps.RawService,
+		PollInterval:  deps.UploadPollInterval,
+		EventsTimeout: deps.UploadEventsTimeout,
 	}
 
 	mws := func(hand grpcgw.HandlerErrFunc) gateway.HandlerFunc {
@@ -81,11 +130,24 @@ func registerHandlers(mux *gateway.ServeMux, log zerolog.Logger, deps Dependenci
 		return e.NewFrom("register post song/raw", err)
 	}
 
-	err = mux.HandlePath(http.MethodGet, "/songs/api/v1/song/raw/{id}", mws(h.GetRawSongHandler()))
+	err = mux.HandlePath(http.MethodGet, "/songs/api/v1/song/{song_id}/raw/events",
+		authMws(h.UploadEventsHandler()))
+	if err != nil {
+		return e.NewFrom("register get song/raw/events", err)
+	}
+
+	// Links given to anonymous listeners need a token, it also tells the listeners apart in analytics
+	err = mux.HandlePath(http.MethodGet, "/songs/api/v1/song/raw/{id}",
+		mws(grpcgw.OptionalAuthMw(deps.TokenParser, h.GetRawSongHandler())))
 	if err != nil {
 		return e.NewFrom("register get song/raw", err)
 	}
 
+	err = mux.HandlePath(http.MethodGet, "/songs/api/v1/song/{song_id}/preview", mws(h.GetPreviewHandler()))
+	if err != nil {
+		return e.NewFrom("register get song/preview", err)
+	}
+
 	err = mux.HandlePath(http.MethodPost, "/songs/api/v1/song/{song_id}/image/raw",
 		authMws(h.UploadRawSongImageHandler()))
 	if err != nil {
@@ -97,6 +159,33 @@ func registerHandlers(mux *gateway.ServeMux, log zerolog.Logger, deps Dependenci
 		return e.NewFrom("register get song/image/raw", err)
 	}
 
+	err = mux.HandlePath(http.MethodGet, "/songs/api/v1/song/image/thumbnail/{id}",
+		mws(h.GetRawSongThumbnailHandler()))
+	if err != nil {
+		return e.NewFrom("register get song/image/thumbnail", err)
+	}
+
+	imh := grpcgw.ImportHandlers{
+		Service:  deps.ImportService,
+		MaxBytes: deps.ImportMaxBytes,
+		Timeout:  deps.ImportTimeout,
+	}
+
+	err = mux.HandlePath(http.MethodPost, "/songs/api/v1/songs/import", authMws(imh.ImportSongsHandler()))
+	if err != nil {
+		return e.NewFrom("register post songs/import", err)
+	}
+
+	exh := grpcgw.ExportHandlers{
+		Service: deps.ExportService,
+	}
+
+	// The link is the secret, it works without a token until the export expires
+	err = mux.HandlePath(http.MethodGet, "/songs/api/v1/exports/{id}/download", mws(exh.DownloadExportHandler()))
+	if err != nil {
+		return e.NewFrom("register get exports/download", err)
+	}
+
 	return nil
 }
 
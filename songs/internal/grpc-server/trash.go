@@ -0,0 +1,85 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *songsServer) GetTrashedSongs(ctx context.Context, req *api.GetTrashedSongsRequest,
+) (*api.GetTrashedSongsResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "GetTrashedSongs",
+		uniceptors.Auth[*api.GetTrashedSongsRequest, *api.GetTrashedSongsResponse](true, s.tokenParser),
+	)(s.getTrashedSongsImpl)
+}
+
+func (s *songsServer) getTrashedSongsImpl(ctx context.Context, req *api.GetTrashedSongsRequest,
+) (*api.GetTrashedSongsResponse, error) {
+	var (
+		page, pageSize int32 = 1, 10
+	)
+
+	token := uniceptors.TokenFromCtx(ctx)
+
+	if req.GetPage() > 0 {
+		page = req.GetPage()
+	}
+
+	if req.GetPageSize() > 0 {
+		pageSize = req.GetPageSize()
+	}
+
+	result, err := s.service.GetTrashedSongs(ctx, songs.GetTrashedSongsInput{
+		UserId:   token.Subject,
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	outSongs := make([]*api.TrashedSong, len(result.Songs))
+
+	for i, song := range result.Songs {
+		outSongs[i] = &api.TrashedSong{
+			Song:            mapMySong(song.MySong),
+			DeletedAt:       timestamppb.New(song.DeletedAt),
+			RestorableUntil: timestamppb.New(song.RestorableUntil),
+		}
+	}
+
+	return &api.GetTrashedSongsResponse{
+		Songs: outSongs,
+		Pagination: &api.PaginationResponse{
+			LastPage: result.LastPage,
+		},
+	}, nil
+}
+
+func (s *songsServer) RestoreTrashedSongs(ctx context.Context, req *api.RestoreTrashedSongsRequest,
+) (*api.RestoreTrashedSongsResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "RestoreTrashedSongs",
+		uniceptors.Auth[*api.RestoreTrashedSongsRequest, *api.RestoreTrashedSongsResponse](true, s.tokenParser),
+	)(s.restoreTrashedSongsImpl)
+}
+
+func (s *songsServer) restoreTrashedSongsImpl(ctx context.Context, req *api.RestoreTrashedSongsRequest,
+) (*api.RestoreTrashedSongsResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	_, err := s.service.RestoreTrashedSongs(ctx, songs.RestoreTrashedSongsInput{
+		UserId:   token.Subject,
+		SongsIds: mapUuids(req.GetIds()),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.RestoreTrashedSongsResponse{}, nil
+}
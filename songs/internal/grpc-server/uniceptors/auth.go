@@ -17,6 +17,7 @@ type TokenParser interface {
 
 var (
 	ErrNotArtist          = erix.NewStatus("insufficient rights: not artist", erix.CodeForbidden)
+	ErrNotAdmin           = erix.NewStatus("insufficient rights: not admin", erix.CodeForbidden)
 	ErrInsufficientRights = erix.NewStatus("insufficient rights", erix.CodeForbidden)
 )
 
@@ -25,40 +26,91 @@ type tokenKey struct{}
 func Auth[T, T2 any](mustArtist bool, parser TokenParser) transport.Uniceptor[T, T2] {
 	return func(next transport.GrpcHandler[T, T2]) transport.GrpcHandler[T, T2] {
 		return func(ctx context.Context, req T) (T2, error) {
-			const authorizationHeaderPartsCount = 2
-
-			md, ok := metadata.FromIncomingContext(ctx)
-			if !ok {
-				return *new(T2), ErrInsufficientRights
+			token, err := tokenFromMetadata(ctx, parser)
+			if err != nil {
+				return *new(T2), err
 			}
 
-			authValue := md.Get(transport.AuthKey)
-			if len(authValue) == 0 {
-				return *new(T2), ErrInsufficientRights
+			if mustArtist && !token.IsArtist {
+				return *new(T2), ErrNotArtist
 			}
 
-			authToken := strings.Split(authValue[0], " ")
-			if len(authToken) != authorizationHeaderPartsCount {
-				return *new(T2), ErrInsufficientRights
-			}
+			ctx = context.WithValue(ctx, tokenKey{}, token)
+
+			return next(ctx, req)
+
+		}
+	}
+}
 
-			token, err := parser.Parse(authToken[1])
+// Admin is like Auth, but lets only admins through.
+func Admin[T, T2 any](parser TokenParser) transport.Uniceptor[T, T2] {
+	return func(next transport.GrpcHandler[T, T2]) transport.GrpcHandler[T, T2] {
+		return func(ctx context.Context, req T) (T2, error) {
+			token, err := tokenFromMetadata(ctx, parser)
 			if err != nil {
-				return *new(T2), ErrInsufficientRights.Wrap(err)
+				return *new(T2), err
 			}
 
-			if mustArtist && !token.IsArtist {
-				return *new(T2), ErrNotArtist
+			if !token.IsAdmin {
+				return *new(T2), ErrNotAdmin
 			}
 
 			ctx = context.WithValue(ctx, tokenKey{}, token)
 
 			return next(ctx, req)
+		}
+	}
+}
+
+// ParseToken puts the token into the context if there is a valid one.
+// Unlike Auth, it lets anonymous requests through.
+func ParseToken[T, T2 any](parser TokenParser) transport.Uniceptor[T, T2] {
+	return func(next transport.GrpcHandler[T, T2]) transport.GrpcHandler[T, T2] {
+		return func(ctx context.Context, req T) (T2, error) {
+			token, err := tokenFromMetadata(ctx, parser)
+			if err == nil {
+				ctx = context.WithValue(ctx, tokenKey{}, token)
+			}
 
+			return next(ctx, req)
 		}
 	}
 }
 
+func tokenFromMetadata(ctx context.Context, parser TokenParser) (auth.Token, error) {
+	const authorizationHeaderPartsCount = 2
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return auth.Token{}, ErrInsufficientRights
+	}
+
+	authValue := md.Get(transport.AuthKey)
+	if len(authValue) == 0 {
+		return auth.Token{}, ErrInsufficientRights
+	}
+
+	authToken := strings.Split(authValue[0], " ")
+	if len(authToken) != authorizationHeaderPartsCount {
+		return auth.Token{}, ErrInsufficientRights
+	}
+
+	token, err := parser.Parse(authToken[1])
+	if err != nil {
+		return auth.Token{}, ErrInsufficientRights.Wrap(err)
+	}
+
+	return token, nil
+}
+
+// MaybeTokenFromCtx returns the token put by ParseToken, if any.
+func MaybeTokenFromCtx(ctx context.Context) (auth.Token, bool) {
+	token, ok := ctx.Value(tokenKey{}).(auth.Token)
+
+	return token, ok
+}
+
 func TokenFromCtx(ctx context.Context) auth.Token {
 	token, ok := ctx.Value(tokenKey{}).(auth.Token)
 	if !ok {
@@ -0,0 +1,25 @@
+package uniceptors
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/transport"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// CountryFromMetadata returns the caller's country resolved by the gateway.
+// It is empty if the country is unknown.
+func CountryFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	country := md.Get(transport.CountryKey)
+	if len(country) == 0 {
+		return ""
+	}
+
+	return country[0]
+}
@@ -0,0 +1,35 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+)
+
+func (s *songsServer) GetMyUsage(ctx context.Context, req *api.GetMyUsageRequest) (*api.GetMyUsageResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "GetMyUsage",
+		uniceptors.Auth[*api.GetMyUsageRequest, *api.GetMyUsageResponse](true, s.tokenParser))(s.getMyUsageImpl)
+}
+
+func (s *songsServer) getMyUsageImpl(ctx context.Context, _ *api.GetMyUsageRequest) (*api.GetMyUsageResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	out, err := s.service.GetMyUsage(ctx, songs.GetMyUsageInput{
+		ArtistId: token.Subject,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.GetMyUsageResponse{
+		SongsCount:             out.SongsCount,
+		SongsLimit:             out.MaxSongs,
+		WeightBytes:            out.WeightBytes,
+		WeightBytesLimit:       out.MaxBytes,
+		UploadsPerHourLimit:    out.UploadsPerHour,
+		ConcurrentUploadsLimit: out.ConcurrentUploads,
+	}, nil
+}
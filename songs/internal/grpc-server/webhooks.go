@@ -0,0 +1,207 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/grpc-server/uniceptors"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/webhooks"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WebhooksService interface {
+	CreateWebhook(ctx context.Context, in webhooks.CreateWebhookInput) (webhooks.CreateWebhookOutput, error)
+	GetWebhooks(ctx context.Context, in webhooks.GetWebhooksInput) (webhooks.GetWebhooksOutput, error)
+	DeleteWebhook(ctx context.Context, in webhooks.DeleteWebhookInput) error
+	GetWebhookDeliveries(ctx context.Context, in webhooks.GetWebhookDeliveriesInput,
+	) (webhooks.GetWebhookDeliveriesOutput, error)
+	RedeliverWebhook(ctx context.Context, in webhooks.RedeliverWebhookInput) (webhooks.RedeliverWebhookOutput, error)
+}
+
+func (s *songsServer) CreateWebhook(ctx context.Context, req *api.CreateWebhookRequest,
+) (*api.CreateWebhookResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "CreateWebhook",
+		uniceptors.Auth[*api.CreateWebhookRequest, *api.CreateWebhookResponse](true, s.tokenParser))(s.createWebhookImpl)
+}
+
+func (s *songsServer) createWebhookImpl(ctx context.Context, req *api.CreateWebhookRequest,
+) (*api.CreateWebhookResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	out, err := s.webhooks.CreateWebhook(ctx, webhooks.CreateWebhookInput{
+		ArtistId: token.Subject,
+		Url:      req.GetUrl(),
+		Events:   req.GetEvents(),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.CreateWebhookResponse{
+		Webhook: mapWebhook(out.Webhook),
+		Secret:  out.Secret,
+	}, nil
+}
+
+func (s *songsServer) GetWebhooks(ctx context.Context, req *api.GetWebhooksRequest,
+) (*api.GetWebhooksResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "GetWebhooks",
+		uniceptors.Auth[*api.GetWebhooksRequest, *api.GetWebhooksResponse](true, s.tokenParser))(s.getWebhooksImpl)
+}
+
+func (s *songsServer) getWebhooksImpl(ctx context.Context, _ *api.GetWebhooksRequest,
+) (*api.GetWebhooksResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	out, err := s.webhooks.GetWebhooks(ctx, webhooks.GetWebhooksInput{
+		ArtistId: token.Subject,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	resp := &api.GetWebhooksResponse{
+		Webhooks: make([]*api.Webhook, 0, len(out.Webhooks)),
+	}
+
+	for _, webhook := range out.Webhooks {
+		resp.Webhooks = append(resp.Webhooks, mapWebhook(webhook))
+	}
+
+	return resp, nil
+}
+
+func (s *songsServer) DeleteWebhook(ctx context.Context, req *api.DeleteWebhookRequest,
+) (*api.DeleteWebhookResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "DeleteWebhook",
+		uniceptors.Auth[*api.DeleteWebhookRequest, *api.DeleteWebhookResponse](true, s.tokenParser))(s.deleteWebhookImpl)
+}
+
+func (s *songsServer) deleteWebhookImpl(ctx context.Context, req *api.DeleteWebhookRequest,
+) (*api.DeleteWebhookResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	err := s.webhooks.DeleteWebhook(ctx, webhooks.DeleteWebhookInput{
+		ArtistId:  token.Subject,
+		WebhookId: uuid.MustParse(req.GetId()),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.DeleteWebhookResponse{}, nil
+}
+
+func (s *songsServer) GetWebhookDeliveries(ctx context.Context, req *api.GetWebhookDeliveriesRequest,
+) (*api.GetWebhookDeliveriesResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "GetWebhookDeliveries",
+		uniceptors.Auth[*api.GetWebhookDeliveriesRequest, *api.GetWebhookDeliveriesResponse](true, s.tokenParser))(
+		s.getWebhookDeliveriesImpl)
+}
+
+func (s *songsServer) getWebhookDeliveriesImpl(ctx context.Context, req *api.GetWebhookDeliveriesRequest,
+) (*api.GetWebhookDeliveriesResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	var (
+		page, pageSize int32 = 1, 10
+	)
+
+	if req.GetPage() > 0 {
+		page = req.GetPage()
+	}
+
+	if req.GetPageSize() > 0 {
+		pageSize = req.GetPageSize()
+	}
+
+	out, err := s.webhooks.GetWebhookDeliveries(ctx, webhooks.GetWebhookDeliveriesInput{
+		ArtistId:  token.Subject,
+		WebhookId: uuid.MustParse(req.GetWebhookId()),
+		Page:      page,
+		PageSize:  pageSize,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	resp := &api.GetWebhookDeliveriesResponse{
+		Deliveries: make([]*api.WebhookDelivery, 0, len(out.Deliveries)),
+		Pagination: &api.PaginationResponse{
+			LastPage: out.LastPage,
+		},
+	}
+
+	for _, delivery := range out.Deliveries {
+		resp.Deliveries = append(resp.Deliveries, mapWebhookDelivery(delivery))
+	}
+
+	return resp, nil
+}
+
+func (s *songsServer) RedeliverWebhook(ctx context.Context, req *api.RedeliverWebhookRequest,
+) (*api.RedeliverWebhookResponse, error) {
+	return applyUnis(
+		ctx, s.log, req, "RedeliverWebhook",
+		uniceptors.Auth[*api.RedeliverWebhookRequest, *api.RedeliverWebhookResponse](true, s.tokenParser))(
+		s.redeliverWebhookImpl)
+}
+
+func (s *songsServer) redeliverWebhookImpl(ctx context.Context, req *api.RedeliverWebhookRequest,
+) (*api.RedeliverWebhookResponse, error) {
+	token := uniceptors.TokenFromCtx(ctx)
+
+	out, err := s.webhooks.RedeliverWebhook(ctx, webhooks.RedeliverWebhookInput{
+		ArtistId:   token.Subject,
+		DeliveryId: uuid.MustParse(req.GetId()),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &api.RedeliverWebhookResponse{
+		Delivery: mapWebhookDelivery(out.Delivery),
+	}, nil
+}
+
+func mapWebhook(webhook webhooks.Webhook) *api.Webhook {
+	return &api.Webhook{
+		Id:        webhook.Id.String(),
+		Url:       webhook.Url,
+		Events:    webhook.Events,
+		CreatedAt: timestamppb.New(webhook.CreatedAt),
+	}
+}
+
+func mapWebhookDelivery(delivery webhooks.Delivery) *api.WebhookDelivery {
+	return &api.WebhookDelivery{
+		Id:           delivery.Id.String(),
+		WebhookId:    delivery.WebhookId.String(),
+		Event:        delivery.Event,
+		Payload:      string(delivery.Payload),
+		Status:       mapWebhookDeliveryStatus(delivery.Status),
+		Attempts:     delivery.Attempts,
+		ResponseCode: delivery.ResponseCode,
+		Error:        delivery.Error,
+		CreatedAt:    timestamppb.New(delivery.CreatedAt),
+		AttemptedAt:  mapOptionalTime(delivery.AttemptedAt),
+	}
+}
+
+func mapWebhookDeliveryStatus(status postgres.WebhookDeliveryStatus) api.WebhookDeliveryStatus {
+	switch status {
+	case postgres.WebhookDeliveryStatusDelivered:
+		return api.WebhookDeliveryStatus_WEBHOOK_DELIVERY_DELIVERED
+	case postgres.WebhookDeliveryStatusFailed:
+		return api.WebhookDeliveryStatus_WEBHOOK_DELIVERY_FAILED
+	default:
+		return api.WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING
+	}
+}
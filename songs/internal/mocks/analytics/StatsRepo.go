@@ -0,0 +1,685 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package analyticsmocks
+
+import (
+	context "context"
+
+	analytics "github.com/Benzogang-Tape/audio-hosting/songs/internal/services/analytics"
+
+	mock "github.com/stretchr/testify/mock"
+
+	postgres "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// StatsRepo is an autogenerated mock type for the StatsRepo type
+type StatsRepo struct {
+	mock.Mock
+}
+
+type StatsRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatsRepo) EXPECT() *StatsRepo_Expecter {
+	return &StatsRepo_Expecter{mock: &_m.Mock}
+}
+
+// AddSongStats provides a mock function with given fields: _a0, _a1
+func (_m *StatsRepo) AddSongStats(_a0 context.Context, _a1 postgres.AddSongStatsParams) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSongStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.AddSongStatsParams) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsRepo_AddSongStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSongStats'
+type StatsRepo_AddSongStats_Call struct {
+	*mock.Call
+}
+
+// AddSongStats is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.AddSongStatsParams
+func (_e *StatsRepo_Expecter) AddSongStats(_a0 interface{}, _a1 interface{}) *StatsRepo_AddSongStats_Call {
+	return &StatsRepo_AddSongStats_Call{Call: _e.mock.On("AddSongStats", _a0, _a1)}
+}
+
+func (_c *StatsRepo_AddSongStats_Call) Run(run func(_a0 context.Context, _a1 postgres.AddSongStatsParams)) *StatsRepo_AddSongStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.AddSongStatsParams))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_AddSongStats_Call) Return(_a0 error) *StatsRepo_AddSongStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsRepo_AddSongStats_Call) RunAndReturn(run func(context.Context, postgres.AddSongStatsParams) error) *StatsRepo_AddSongStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ArtistFollowers provides a mock function with given fields: _a0, _a1
+func (_m *StatsRepo) ArtistFollowers(_a0 context.Context, _a1 postgres.ArtistFollowersParams) ([]postgres.ArtistFollowersRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistFollowers")
+	}
+
+	var r0 []postgres.ArtistFollowersRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ArtistFollowersParams) ([]postgres.ArtistFollowersRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ArtistFollowersParams) []postgres.ArtistFollowersRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.ArtistFollowersRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ArtistFollowersParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepo_ArtistFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtistFollowers'
+type StatsRepo_ArtistFollowers_Call struct {
+	*mock.Call
+}
+
+// ArtistFollowers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ArtistFollowersParams
+func (_e *StatsRepo_Expecter) ArtistFollowers(_a0 interface{}, _a1 interface{}) *StatsRepo_ArtistFollowers_Call {
+	return &StatsRepo_ArtistFollowers_Call{Call: _e.mock.On("ArtistFollowers", _a0, _a1)}
+}
+
+func (_c *StatsRepo_ArtistFollowers_Call) Run(run func(_a0 context.Context, _a1 postgres.ArtistFollowersParams)) *StatsRepo_ArtistFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ArtistFollowersParams))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_ArtistFollowers_Call) Return(_a0 []postgres.ArtistFollowersRow, _a1 error) *StatsRepo_ArtistFollowers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepo_ArtistFollowers_Call) RunAndReturn(run func(context.Context, postgres.ArtistFollowersParams) ([]postgres.ArtistFollowersRow, error)) *StatsRepo_ArtistFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ArtistListeners provides a mock function with given fields: _a0, _a1
+func (_m *StatsRepo) ArtistListeners(_a0 context.Context, _a1 postgres.ArtistListenersParams) ([]postgres.ArtistListenersRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistListeners")
+	}
+
+	var r0 []postgres.ArtistListenersRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ArtistListenersParams) ([]postgres.ArtistListenersRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ArtistListenersParams) []postgres.ArtistListenersRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.ArtistListenersRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ArtistListenersParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepo_ArtistListeners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtistListeners'
+type StatsRepo_ArtistListeners_Call struct {
+	*mock.Call
+}
+
+// ArtistListeners is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ArtistListenersParams
+func (_e *StatsRepo_Expecter) ArtistListeners(_a0 interface{}, _a1 interface{}) *StatsRepo_ArtistListeners_Call {
+	return &StatsRepo_ArtistListeners_Call{Call: _e.mock.On("ArtistListeners", _a0, _a1)}
+}
+
+func (_c *StatsRepo_ArtistListeners_Call) Run(run func(_a0 context.Context, _a1 postgres.ArtistListenersParams)) *StatsRepo_ArtistListeners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ArtistListenersParams))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_ArtistListeners_Call) Return(_a0 []postgres.ArtistListenersRow, _a1 error) *StatsRepo_ArtistListeners_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepo_ArtistListeners_Call) RunAndReturn(run func(context.Context, postgres.ArtistListenersParams) ([]postgres.ArtistListenersRow, error)) *StatsRepo_ArtistListeners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ArtistSongStats provides a mock function with given fields: _a0, _a1
+func (_m *StatsRepo) ArtistSongStats(_a0 context.Context, _a1 postgres.ArtistSongStatsParams) ([]postgres.ArtistSongStatsRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistSongStats")
+	}
+
+	var r0 []postgres.ArtistSongStatsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ArtistSongStatsParams) ([]postgres.ArtistSongStatsRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ArtistSongStatsParams) []postgres.ArtistSongStatsRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.ArtistSongStatsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ArtistSongStatsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepo_ArtistSongStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtistSongStats'
+type StatsRepo_ArtistSongStats_Call struct {
+	*mock.Call
+}
+
+// ArtistSongStats is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ArtistSongStatsParams
+func (_e *StatsRepo_Expecter) ArtistSongStats(_a0 interface{}, _a1 interface{}) *StatsRepo_ArtistSongStats_Call {
+	return &StatsRepo_ArtistSongStats_Call{Call: _e.mock.On("ArtistSongStats", _a0, _a1)}
+}
+
+func (_c *StatsRepo_ArtistSongStats_Call) Run(run func(_a0 context.Context, _a1 postgres.ArtistSongStatsParams)) *StatsRepo_ArtistSongStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ArtistSongStatsParams))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_ArtistSongStats_Call) Return(_a0 []postgres.ArtistSongStatsRow, _a1 error) *StatsRepo_ArtistSongStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepo_ArtistSongStats_Call) RunAndReturn(run func(context.Context, postgres.ArtistSongStatsParams) ([]postgres.ArtistSongStatsRow, error)) *StatsRepo_ArtistSongStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ArtistsWithSongs provides a mock function with given fields: _a0, _a1
+func (_m *StatsRepo) ArtistsWithSongs(_a0 context.Context, _a1 postgres.ArtistsWithSongsParams) ([]uuid.UUID, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistsWithSongs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ArtistsWithSongsParams) ([]uuid.UUID, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ArtistsWithSongsParams) []uuid.UUID); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ArtistsWithSongsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepo_ArtistsWithSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtistsWithSongs'
+type StatsRepo_ArtistsWithSongs_Call struct {
+	*mock.Call
+}
+
+// ArtistsWithSongs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ArtistsWithSongsParams
+func (_e *StatsRepo_Expecter) ArtistsWithSongs(_a0 interface{}, _a1 interface{}) *StatsRepo_ArtistsWithSongs_Call {
+	return &StatsRepo_ArtistsWithSongs_Call{Call: _e.mock.On("ArtistsWithSongs", _a0, _a1)}
+}
+
+func (_c *StatsRepo_ArtistsWithSongs_Call) Run(run func(_a0 context.Context, _a1 postgres.ArtistsWithSongsParams)) *StatsRepo_ArtistsWithSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ArtistsWithSongsParams))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_ArtistsWithSongs_Call) Return(_a0 []uuid.UUID, _a1 error) *StatsRepo_ArtistsWithSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepo_ArtistsWithSongs_Call) RunAndReturn(run func(context.Context, postgres.ArtistsWithSongsParams) ([]uuid.UUID, error)) *StatsRepo_ArtistsWithSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Begin provides a mock function with given fields: _a0
+func (_m *StatsRepo) Begin(_a0 context.Context) (analytics.StatsRepo, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 analytics.StatsRepo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (analytics.StatsRepo, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) analytics.StatsRepo); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(analytics.StatsRepo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepo_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type StatsRepo_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *StatsRepo_Expecter) Begin(_a0 interface{}) *StatsRepo_Begin_Call {
+	return &StatsRepo_Begin_Call{Call: _e.mock.On("Begin", _a0)}
+}
+
+func (_c *StatsRepo_Begin_Call) Run(run func(_a0 context.Context)) *StatsRepo_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_Begin_Call) Return(_a0 analytics.StatsRepo, _a1 error) *StatsRepo_Begin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepo_Begin_Call) RunAndReturn(run func(context.Context) (analytics.StatsRepo, error)) *StatsRepo_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Commit provides a mock function with given fields: _a0
+func (_m *StatsRepo) Commit(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsRepo_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type StatsRepo_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *StatsRepo_Expecter) Commit(_a0 interface{}) *StatsRepo_Commit_Call {
+	return &StatsRepo_Commit_Call{Call: _e.mock.On("Commit", _a0)}
+}
+
+func (_c *StatsRepo_Commit_Call) Run(run func(_a0 context.Context)) *StatsRepo_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_Commit_Call) Return(_a0 error) *StatsRepo_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsRepo_Commit_Call) RunAndReturn(run func(context.Context) error) *StatsRepo_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeAnalyticsEvents provides a mock function with given fields: ctx, processedBefore
+func (_m *StatsRepo) PurgeAnalyticsEvents(ctx context.Context, processedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, processedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeAnalyticsEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, processedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, processedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, processedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepo_PurgeAnalyticsEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeAnalyticsEvents'
+type StatsRepo_PurgeAnalyticsEvents_Call struct {
+	*mock.Call
+}
+
+// PurgeAnalyticsEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - processedBefore time.Time
+func (_e *StatsRepo_Expecter) PurgeAnalyticsEvents(ctx interface{}, processedBefore interface{}) *StatsRepo_PurgeAnalyticsEvents_Call {
+	return &StatsRepo_PurgeAnalyticsEvents_Call{Call: _e.mock.On("PurgeAnalyticsEvents", ctx, processedBefore)}
+}
+
+func (_c *StatsRepo_PurgeAnalyticsEvents_Call) Run(run func(ctx context.Context, processedBefore time.Time)) *StatsRepo_PurgeAnalyticsEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_PurgeAnalyticsEvents_Call) Return(_a0 int64, _a1 error) *StatsRepo_PurgeAnalyticsEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepo_PurgeAnalyticsEvents_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *StatsRepo_PurgeAnalyticsEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with given fields: _a0
+func (_m *StatsRepo) Rollback(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsRepo_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type StatsRepo_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *StatsRepo_Expecter) Rollback(_a0 interface{}) *StatsRepo_Rollback_Call {
+	return &StatsRepo_Rollback_Call{Call: _e.mock.On("Rollback", _a0)}
+}
+
+func (_c *StatsRepo_Rollback_Call) Run(run func(_a0 context.Context)) *StatsRepo_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_Rollback_Call) Return(_a0 error) *StatsRepo_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsRepo_Rollback_Call) RunAndReturn(run func(context.Context) error) *StatsRepo_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAnalyticsEvent provides a mock function with given fields: ctx, eventId
+func (_m *StatsRepo) SaveAnalyticsEvent(ctx context.Context, eventId string) (int64, error) {
+	ret := _m.Called(ctx, eventId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAnalyticsEvent")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, eventId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, eventId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepo_SaveAnalyticsEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAnalyticsEvent'
+type StatsRepo_SaveAnalyticsEvent_Call struct {
+	*mock.Call
+}
+
+// SaveAnalyticsEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventId string
+func (_e *StatsRepo_Expecter) SaveAnalyticsEvent(ctx interface{}, eventId interface{}) *StatsRepo_SaveAnalyticsEvent_Call {
+	return &StatsRepo_SaveAnalyticsEvent_Call{Call: _e.mock.On("SaveAnalyticsEvent", ctx, eventId)}
+}
+
+func (_c *StatsRepo_SaveAnalyticsEvent_Call) Run(run func(ctx context.Context, eventId string)) *StatsRepo_SaveAnalyticsEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_SaveAnalyticsEvent_Call) Return(_a0 int64, _a1 error) *StatsRepo_SaveAnalyticsEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepo_SaveAnalyticsEvent_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *StatsRepo_SaveAnalyticsEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveArtistFollowers provides a mock function with given fields: _a0, _a1
+func (_m *StatsRepo) SaveArtistFollowers(_a0 context.Context, _a1 postgres.SaveArtistFollowersParams) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveArtistFollowers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveArtistFollowersParams) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsRepo_SaveArtistFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveArtistFollowers'
+type StatsRepo_SaveArtistFollowers_Call struct {
+	*mock.Call
+}
+
+// SaveArtistFollowers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SaveArtistFollowersParams
+func (_e *StatsRepo_Expecter) SaveArtistFollowers(_a0 interface{}, _a1 interface{}) *StatsRepo_SaveArtistFollowers_Call {
+	return &StatsRepo_SaveArtistFollowers_Call{Call: _e.mock.On("SaveArtistFollowers", _a0, _a1)}
+}
+
+func (_c *StatsRepo_SaveArtistFollowers_Call) Run(run func(_a0 context.Context, _a1 postgres.SaveArtistFollowersParams)) *StatsRepo_SaveArtistFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SaveArtistFollowersParams))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_SaveArtistFollowers_Call) Return(_a0 error) *StatsRepo_SaveArtistFollowers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsRepo_SaveArtistFollowers_Call) RunAndReturn(run func(context.Context, postgres.SaveArtistFollowersParams) error) *StatsRepo_SaveArtistFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSongListener provides a mock function with given fields: _a0, _a1
+func (_m *StatsRepo) SaveSongListener(_a0 context.Context, _a1 postgres.SaveSongListenerParams) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSongListener")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveSongListenerParams) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatsRepo_SaveSongListener_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSongListener'
+type StatsRepo_SaveSongListener_Call struct {
+	*mock.Call
+}
+
+// SaveSongListener is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SaveSongListenerParams
+func (_e *StatsRepo_Expecter) SaveSongListener(_a0 interface{}, _a1 interface{}) *StatsRepo_SaveSongListener_Call {
+	return &StatsRepo_SaveSongListener_Call{Call: _e.mock.On("SaveSongListener", _a0, _a1)}
+}
+
+func (_c *StatsRepo_SaveSongListener_Call) Run(run func(_a0 context.Context, _a1 postgres.SaveSongListenerParams)) *StatsRepo_SaveSongListener_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SaveSongListenerParams))
+	})
+	return _c
+}
+
+func (_c *StatsRepo_SaveSongListener_Call) Return(_a0 error) *StatsRepo_SaveSongListener_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatsRepo_SaveSongListener_Call) RunAndReturn(run func(context.Context, postgres.SaveSongListenerParams) error) *StatsRepo_SaveSongListener_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatsRepo creates a new instance of StatsRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatsRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatsRepo {
+	mock := &StatsRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package analyticsmocks
+
+import (
+	context "context"
+
+	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserRepo is an autogenerated mock type for the UserRepo type
+type UserRepo struct {
+	mock.Mock
+}
+
+type UserRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserRepo) EXPECT() *UserRepo_Expecter {
+	return &UserRepo_Expecter{mock: &_m.Mock}
+}
+
+// FollowersCount provides a mock function with given fields: ctx, userId
+func (_m *UserRepo) FollowersCount(ctx context.Context, userId uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowersCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepo_FollowersCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FollowersCount'
+type UserRepo_FollowersCount_Call struct {
+	*mock.Call
+}
+
+// FollowersCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *UserRepo_Expecter) FollowersCount(ctx interface{}, userId interface{}) *UserRepo_FollowersCount_Call {
+	return &UserRepo_FollowersCount_Call{Call: _e.mock.On("FollowersCount", ctx, userId)}
+}
+
+func (_c *UserRepo_FollowersCount_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *UserRepo_FollowersCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserRepo_FollowersCount_Call) Return(_a0 int64, _a1 error) *UserRepo_FollowersCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepo_FollowersCount_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int64, error)) *UserRepo_FollowersCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserRepo creates a new instance of UserRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserRepo {
+	mock := &UserRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,1021 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package claimsmocks
+
+import (
+	context "context"
+
+	claims "github.com/Benzogang-Tape/audio-hosting/songs/internal/services/claims"
+
+	mock "github.com/stretchr/testify/mock"
+
+	postgres "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	uuid "github.com/google/uuid"
+)
+
+// ClaimRepo is an autogenerated mock type for the ClaimRepo type
+type ClaimRepo struct {
+	mock.Mock
+}
+
+type ClaimRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClaimRepo) EXPECT() *ClaimRepo_Expecter {
+	return &ClaimRepo_Expecter{mock: &_m.Mock}
+}
+
+// Begin provides a mock function with given fields: _a0
+func (_m *ClaimRepo) Begin(_a0 context.Context) (claims.ClaimRepo, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 claims.ClaimRepo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (claims.ClaimRepo, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) claims.ClaimRepo); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(claims.ClaimRepo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type ClaimRepo_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *ClaimRepo_Expecter) Begin(_a0 interface{}) *ClaimRepo_Begin_Call {
+	return &ClaimRepo_Begin_Call{Call: _e.mock.On("Begin", _a0)}
+}
+
+func (_c *ClaimRepo_Begin_Call) Run(run func(_a0 context.Context)) *ClaimRepo_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_Begin_Call) Return(_a0 claims.ClaimRepo, _a1 error) *ClaimRepo_Begin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_Begin_Call) RunAndReturn(run func(context.Context) (claims.ClaimRepo, error)) *ClaimRepo_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Claim provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) Claim(_a0 context.Context, _a1 uuid.UUID) (postgres.Claim, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 postgres.Claim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (postgres.Claim, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) postgres.Claim); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Claim)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type ClaimRepo_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *ClaimRepo_Expecter) Claim(_a0 interface{}, _a1 interface{}) *ClaimRepo_Claim_Call {
+	return &ClaimRepo_Claim_Call{Call: _e.mock.On("Claim", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_Claim_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *ClaimRepo_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_Claim_Call) Return(_a0 postgres.Claim, _a1 error) *ClaimRepo_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_Claim_Call) RunAndReturn(run func(context.Context, uuid.UUID) (postgres.Claim, error)) *ClaimRepo_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimEvents provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) ClaimEvents(_a0 context.Context, _a1 uuid.UUID) ([]postgres.ClaimEvent, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimEvents")
+	}
+
+	var r0 []postgres.ClaimEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]postgres.ClaimEvent, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []postgres.ClaimEvent); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.ClaimEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_ClaimEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimEvents'
+type ClaimRepo_ClaimEvents_Call struct {
+	*mock.Call
+}
+
+// ClaimEvents is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *ClaimRepo_Expecter) ClaimEvents(_a0 interface{}, _a1 interface{}) *ClaimRepo_ClaimEvents_Call {
+	return &ClaimRepo_ClaimEvents_Call{Call: _e.mock.On("ClaimEvents", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_ClaimEvents_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *ClaimRepo_ClaimEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_ClaimEvents_Call) Return(_a0 []postgres.ClaimEvent, _a1 error) *ClaimRepo_ClaimEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_ClaimEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]postgres.ClaimEvent, error)) *ClaimRepo_ClaimEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimantVerified provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) ClaimantVerified(_a0 context.Context, _a1 uuid.UUID) (bool, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimantVerified")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_ClaimantVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimantVerified'
+type ClaimRepo_ClaimantVerified_Call struct {
+	*mock.Call
+}
+
+// ClaimantVerified is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *ClaimRepo_Expecter) ClaimantVerified(_a0 interface{}, _a1 interface{}) *ClaimRepo_ClaimantVerified_Call {
+	return &ClaimRepo_ClaimantVerified_Call{Call: _e.mock.On("ClaimantVerified", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_ClaimantVerified_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *ClaimRepo_ClaimantVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_ClaimantVerified_Call) Return(_a0 bool, _a1 error) *ClaimRepo_ClaimantVerified_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_ClaimantVerified_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *ClaimRepo_ClaimantVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimedSong provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) ClaimedSong(_a0 context.Context, _a1 uuid.UUID) (postgres.ClaimedSongRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimedSong")
+	}
+
+	var r0 postgres.ClaimedSongRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (postgres.ClaimedSongRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) postgres.ClaimedSongRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.ClaimedSongRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_ClaimedSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimedSong'
+type ClaimRepo_ClaimedSong_Call struct {
+	*mock.Call
+}
+
+// ClaimedSong is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *ClaimRepo_Expecter) ClaimedSong(_a0 interface{}, _a1 interface{}) *ClaimRepo_ClaimedSong_Call {
+	return &ClaimRepo_ClaimedSong_Call{Call: _e.mock.On("ClaimedSong", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_ClaimedSong_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *ClaimRepo_ClaimedSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_ClaimedSong_Call) Return(_a0 postgres.ClaimedSongRow, _a1 error) *ClaimRepo_ClaimedSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_ClaimedSong_Call) RunAndReturn(run func(context.Context, uuid.UUID) (postgres.ClaimedSongRow, error)) *ClaimRepo_ClaimedSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Claims provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) Claims(_a0 context.Context, _a1 postgres.ClaimsParams) ([]postgres.Claim, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claims")
+	}
+
+	var r0 []postgres.Claim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ClaimsParams) ([]postgres.Claim, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ClaimsParams) []postgres.Claim); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.Claim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ClaimsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_Claims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claims'
+type ClaimRepo_Claims_Call struct {
+	*mock.Call
+}
+
+// Claims is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ClaimsParams
+func (_e *ClaimRepo_Expecter) Claims(_a0 interface{}, _a1 interface{}) *ClaimRepo_Claims_Call {
+	return &ClaimRepo_Claims_Call{Call: _e.mock.On("Claims", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_Claims_Call) Run(run func(_a0 context.Context, _a1 postgres.ClaimsParams)) *ClaimRepo_Claims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ClaimsParams))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_Claims_Call) Return(_a0 []postgres.Claim, _a1 error) *ClaimRepo_Claims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_Claims_Call) RunAndReturn(run func(context.Context, postgres.ClaimsParams) ([]postgres.Claim, error)) *ClaimRepo_Claims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Commit provides a mock function with given fields: _a0
+func (_m *ClaimRepo) Commit(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimRepo_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type ClaimRepo_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *ClaimRepo_Expecter) Commit(_a0 interface{}) *ClaimRepo_Commit_Call {
+	return &ClaimRepo_Commit_Call{Call: _e.mock.On("Commit", _a0)}
+}
+
+func (_c *ClaimRepo_Commit_Call) Run(run func(_a0 context.Context)) *ClaimRepo_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_Commit_Call) Return(_a0 error) *ClaimRepo_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimRepo_Commit_Call) RunAndReturn(run func(context.Context) error) *ClaimRepo_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountClaimantOpenClaims provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) CountClaimantOpenClaims(_a0 context.Context, _a1 uuid.UUID) (int32, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountClaimantOpenClaims")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int32, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int32); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_CountClaimantOpenClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountClaimantOpenClaims'
+type ClaimRepo_CountClaimantOpenClaims_Call struct {
+	*mock.Call
+}
+
+// CountClaimantOpenClaims is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *ClaimRepo_Expecter) CountClaimantOpenClaims(_a0 interface{}, _a1 interface{}) *ClaimRepo_CountClaimantOpenClaims_Call {
+	return &ClaimRepo_CountClaimantOpenClaims_Call{Call: _e.mock.On("CountClaimantOpenClaims", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_CountClaimantOpenClaims_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *ClaimRepo_CountClaimantOpenClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_CountClaimantOpenClaims_Call) Return(_a0 int32, _a1 error) *ClaimRepo_CountClaimantOpenClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_CountClaimantOpenClaims_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int32, error)) *ClaimRepo_CountClaimantOpenClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountClaims provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) CountClaims(_a0 context.Context, _a1 postgres.CountClaimsParams) (int32, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountClaims")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CountClaimsParams) (int32, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CountClaimsParams) int32); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.CountClaimsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_CountClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountClaims'
+type ClaimRepo_CountClaims_Call struct {
+	*mock.Call
+}
+
+// CountClaims is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.CountClaimsParams
+func (_e *ClaimRepo_Expecter) CountClaims(_a0 interface{}, _a1 interface{}) *ClaimRepo_CountClaims_Call {
+	return &ClaimRepo_CountClaims_Call{Call: _e.mock.On("CountClaims", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_CountClaims_Call) Run(run func(_a0 context.Context, _a1 postgres.CountClaimsParams)) *ClaimRepo_CountClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.CountClaimsParams))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_CountClaims_Call) Return(_a0 int32, _a1 error) *ClaimRepo_CountClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_CountClaims_Call) RunAndReturn(run func(context.Context, postgres.CountClaimsParams) (int32, error)) *ClaimRepo_CountClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountOpenClaims provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) CountOpenClaims(_a0 context.Context, _a1 postgres.CountOpenClaimsParams) (int32, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOpenClaims")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CountOpenClaimsParams) (int32, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CountOpenClaimsParams) int32); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.CountOpenClaimsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_CountOpenClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOpenClaims'
+type ClaimRepo_CountOpenClaims_Call struct {
+	*mock.Call
+}
+
+// CountOpenClaims is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.CountOpenClaimsParams
+func (_e *ClaimRepo_Expecter) CountOpenClaims(_a0 interface{}, _a1 interface{}) *ClaimRepo_CountOpenClaims_Call {
+	return &ClaimRepo_CountOpenClaims_Call{Call: _e.mock.On("CountOpenClaims", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_CountOpenClaims_Call) Run(run func(_a0 context.Context, _a1 postgres.CountOpenClaimsParams)) *ClaimRepo_CountOpenClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.CountOpenClaimsParams))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_CountOpenClaims_Call) Return(_a0 int32, _a1 error) *ClaimRepo_CountOpenClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_CountOpenClaims_Call) RunAndReturn(run func(context.Context, postgres.CountOpenClaimsParams) (int32, error)) *ClaimRepo_CountOpenClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ModerateClaimedSong provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) ModerateClaimedSong(_a0 context.Context, _a1 postgres.ModerateClaimedSongParams) (postgres.ModerateClaimedSongRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModerateClaimedSong")
+	}
+
+	var r0 postgres.ModerateClaimedSongRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ModerateClaimedSongParams) (postgres.ModerateClaimedSongRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ModerateClaimedSongParams) postgres.ModerateClaimedSongRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.ModerateClaimedSongRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ModerateClaimedSongParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_ModerateClaimedSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ModerateClaimedSong'
+type ClaimRepo_ModerateClaimedSong_Call struct {
+	*mock.Call
+}
+
+// ModerateClaimedSong is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ModerateClaimedSongParams
+func (_e *ClaimRepo_Expecter) ModerateClaimedSong(_a0 interface{}, _a1 interface{}) *ClaimRepo_ModerateClaimedSong_Call {
+	return &ClaimRepo_ModerateClaimedSong_Call{Call: _e.mock.On("ModerateClaimedSong", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_ModerateClaimedSong_Call) Run(run func(_a0 context.Context, _a1 postgres.ModerateClaimedSongParams)) *ClaimRepo_ModerateClaimedSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ModerateClaimedSongParams))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_ModerateClaimedSong_Call) Return(_a0 postgres.ModerateClaimedSongRow, _a1 error) *ClaimRepo_ModerateClaimedSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_ModerateClaimedSong_Call) RunAndReturn(run func(context.Context, postgres.ModerateClaimedSongParams) (postgres.ModerateClaimedSongRow, error)) *ClaimRepo_ModerateClaimedSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreClaimedSong provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) RestoreClaimedSong(_a0 context.Context, _a1 postgres.RestoreClaimedSongParams) (postgres.ModerationStatus, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreClaimedSong")
+	}
+
+	var r0 postgres.ModerationStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.RestoreClaimedSongParams) (postgres.ModerationStatus, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.RestoreClaimedSongParams) postgres.ModerationStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.ModerationStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.RestoreClaimedSongParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_RestoreClaimedSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreClaimedSong'
+type ClaimRepo_RestoreClaimedSong_Call struct {
+	*mock.Call
+}
+
+// RestoreClaimedSong is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.RestoreClaimedSongParams
+func (_e *ClaimRepo_Expecter) RestoreClaimedSong(_a0 interface{}, _a1 interface{}) *ClaimRepo_RestoreClaimedSong_Call {
+	return &ClaimRepo_RestoreClaimedSong_Call{Call: _e.mock.On("RestoreClaimedSong", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_RestoreClaimedSong_Call) Run(run func(_a0 context.Context, _a1 postgres.RestoreClaimedSongParams)) *ClaimRepo_RestoreClaimedSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.RestoreClaimedSongParams))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_RestoreClaimedSong_Call) Return(_a0 postgres.ModerationStatus, _a1 error) *ClaimRepo_RestoreClaimedSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_RestoreClaimedSong_Call) RunAndReturn(run func(context.Context, postgres.RestoreClaimedSongParams) (postgres.ModerationStatus, error)) *ClaimRepo_RestoreClaimedSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with given fields: _a0
+func (_m *ClaimRepo) Rollback(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimRepo_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type ClaimRepo_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *ClaimRepo_Expecter) Rollback(_a0 interface{}) *ClaimRepo_Rollback_Call {
+	return &ClaimRepo_Rollback_Call{Call: _e.mock.On("Rollback", _a0)}
+}
+
+func (_c *ClaimRepo_Rollback_Call) Run(run func(_a0 context.Context)) *ClaimRepo_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_Rollback_Call) Return(_a0 error) *ClaimRepo_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimRepo_Rollback_Call) RunAndReturn(run func(context.Context) error) *ClaimRepo_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveClaim provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) SaveClaim(_a0 context.Context, _a1 postgres.SaveClaimParams) (postgres.Claim, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveClaim")
+	}
+
+	var r0 postgres.Claim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveClaimParams) (postgres.Claim, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveClaimParams) postgres.Claim); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Claim)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.SaveClaimParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_SaveClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveClaim'
+type ClaimRepo_SaveClaim_Call struct {
+	*mock.Call
+}
+
+// SaveClaim is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SaveClaimParams
+func (_e *ClaimRepo_Expecter) SaveClaim(_a0 interface{}, _a1 interface{}) *ClaimRepo_SaveClaim_Call {
+	return &ClaimRepo_SaveClaim_Call{Call: _e.mock.On("SaveClaim", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_SaveClaim_Call) Run(run func(_a0 context.Context, _a1 postgres.SaveClaimParams)) *ClaimRepo_SaveClaim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SaveClaimParams))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_SaveClaim_Call) Return(_a0 postgres.Claim, _a1 error) *ClaimRepo_SaveClaim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_SaveClaim_Call) RunAndReturn(run func(context.Context, postgres.SaveClaimParams) (postgres.Claim, error)) *ClaimRepo_SaveClaim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveClaimEvent provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) SaveClaimEvent(_a0 context.Context, _a1 postgres.SaveClaimEventParams) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveClaimEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveClaimEventParams) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimRepo_SaveClaimEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveClaimEvent'
+type ClaimRepo_SaveClaimEvent_Call struct {
+	*mock.Call
+}
+
+// SaveClaimEvent is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SaveClaimEventParams
+func (_e *ClaimRepo_Expecter) SaveClaimEvent(_a0 interface{}, _a1 interface{}) *ClaimRepo_SaveClaimEvent_Call {
+	return &ClaimRepo_SaveClaimEvent_Call{Call: _e.mock.On("SaveClaimEvent", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_SaveClaimEvent_Call) Run(run func(_a0 context.Context, _a1 postgres.SaveClaimEventParams)) *ClaimRepo_SaveClaimEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SaveClaimEventParams))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_SaveClaimEvent_Call) Return(_a0 error) *ClaimRepo_SaveClaimEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimRepo_SaveClaimEvent_Call) RunAndReturn(run func(context.Context, postgres.SaveClaimEventParams) error) *ClaimRepo_SaveClaimEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetClaimStatus provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) SetClaimStatus(_a0 context.Context, _a1 postgres.SetClaimStatusParams) (postgres.Claim, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetClaimStatus")
+	}
+
+	var r0 postgres.Claim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SetClaimStatusParams) (postgres.Claim, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SetClaimStatusParams) postgres.Claim); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Claim)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.SetClaimStatusParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimRepo_SetClaimStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetClaimStatus'
+type ClaimRepo_SetClaimStatus_Call struct {
+	*mock.Call
+}
+
+// SetClaimStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SetClaimStatusParams
+func (_e *ClaimRepo_Expecter) SetClaimStatus(_a0 interface{}, _a1 interface{}) *ClaimRepo_SetClaimStatus_Call {
+	return &ClaimRepo_SetClaimStatus_Call{Call: _e.mock.On("SetClaimStatus", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_SetClaimStatus_Call) Run(run func(_a0 context.Context, _a1 postgres.SetClaimStatusParams)) *ClaimRepo_SetClaimStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SetClaimStatusParams))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_SetClaimStatus_Call) Return(_a0 postgres.Claim, _a1 error) *ClaimRepo_SetClaimStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimRepo_SetClaimStatus_Call) RunAndReturn(run func(context.Context, postgres.SetClaimStatusParams) (postgres.Claim, error)) *ClaimRepo_SetClaimStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnverifyClaimant provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) UnverifyClaimant(_a0 context.Context, _a1 uuid.UUID) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnverifyClaimant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimRepo_UnverifyClaimant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnverifyClaimant'
+type ClaimRepo_UnverifyClaimant_Call struct {
+	*mock.Call
+}
+
+// UnverifyClaimant is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *ClaimRepo_Expecter) UnverifyClaimant(_a0 interface{}, _a1 interface{}) *ClaimRepo_UnverifyClaimant_Call {
+	return &ClaimRepo_UnverifyClaimant_Call{Call: _e.mock.On("UnverifyClaimant", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_UnverifyClaimant_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *ClaimRepo_UnverifyClaimant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_UnverifyClaimant_Call) Return(_a0 error) *ClaimRepo_UnverifyClaimant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimRepo_UnverifyClaimant_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ClaimRepo_UnverifyClaimant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyClaimant provides a mock function with given fields: _a0, _a1
+func (_m *ClaimRepo) VerifyClaimant(_a0 context.Context, _a1 postgres.VerifyClaimantParams) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyClaimant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.VerifyClaimantParams) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimRepo_VerifyClaimant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyClaimant'
+type ClaimRepo_VerifyClaimant_Call struct {
+	*mock.Call
+}
+
+// VerifyClaimant is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.VerifyClaimantParams
+func (_e *ClaimRepo_Expecter) VerifyClaimant(_a0 interface{}, _a1 interface{}) *ClaimRepo_VerifyClaimant_Call {
+	return &ClaimRepo_VerifyClaimant_Call{Call: _e.mock.On("VerifyClaimant", _a0, _a1)}
+}
+
+func (_c *ClaimRepo_VerifyClaimant_Call) Run(run func(_a0 context.Context, _a1 postgres.VerifyClaimantParams)) *ClaimRepo_VerifyClaimant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.VerifyClaimantParams))
+	})
+	return _c
+}
+
+func (_c *ClaimRepo_VerifyClaimant_Call) Return(_a0 error) *ClaimRepo_VerifyClaimant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimRepo_VerifyClaimant_Call) RunAndReturn(run func(context.Context, postgres.VerifyClaimantParams) error) *ClaimRepo_VerifyClaimant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClaimRepo creates a new instance of ClaimRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClaimRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClaimRepo {
+	mock := &ClaimRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,71 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package claimsmocks
+
+import (
+	context "context"
+
+	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SongCache is an autogenerated mock type for the SongCache type
+type SongCache struct {
+	mock.Mock
+}
+
+type SongCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SongCache) EXPECT() *SongCache_Expecter {
+	return &SongCache_Expecter{mock: &_m.Mock}
+}
+
+// InvalidateSongs provides a mock function with given fields: _a0, _a1
+func (_m *SongCache) InvalidateSongs(_a0 context.Context, _a1 []uuid.UUID) {
+	_m.Called(_a0, _a1)
+}
+
+// SongCache_InvalidateSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateSongs'
+type SongCache_InvalidateSongs_Call struct {
+	*mock.Call
+}
+
+// InvalidateSongs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []uuid.UUID
+func (_e *SongCache_Expecter) InvalidateSongs(_a0 interface{}, _a1 interface{}) *SongCache_InvalidateSongs_Call {
+	return &SongCache_InvalidateSongs_Call{Call: _e.mock.On("InvalidateSongs", _a0, _a1)}
+}
+
+func (_c *SongCache_InvalidateSongs_Call) Run(run func(_a0 context.Context, _a1 []uuid.UUID)) *SongCache_InvalidateSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SongCache_InvalidateSongs_Call) Return() *SongCache_InvalidateSongs_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SongCache_InvalidateSongs_Call) RunAndReturn(run func(context.Context, []uuid.UUID)) *SongCache_InvalidateSongs_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewSongCache creates a new instance of SongCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSongCache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SongCache {
+	mock := &SongCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
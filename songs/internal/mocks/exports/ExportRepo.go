@@ -0,0 +1,494 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package exportsmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+
+	postgres "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	uuid "github.com/google/uuid"
+)
+
+// ExportRepo is an autogenerated mock type for the ExportRepo type
+type ExportRepo struct {
+	mock.Mock
+}
+
+type ExportRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExportRepo) EXPECT() *ExportRepo_Expecter {
+	return &ExportRepo_Expecter{mock: &_m.Mock}
+}
+
+// ArtistExports provides a mock function with given fields: _a0, _a1
+func (_m *ExportRepo) ArtistExports(_a0 context.Context, _a1 postgres.ArtistExportsParams) ([]postgres.Export, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistExports")
+	}
+
+	var r0 []postgres.Export
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ArtistExportsParams) ([]postgres.Export, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ArtistExportsParams) []postgres.Export); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.Export)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ArtistExportsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportRepo_ArtistExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtistExports'
+type ExportRepo_ArtistExports_Call struct {
+	*mock.Call
+}
+
+// ArtistExports is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ArtistExportsParams
+func (_e *ExportRepo_Expecter) ArtistExports(_a0 interface{}, _a1 interface{}) *ExportRepo_ArtistExports_Call {
+	return &ExportRepo_ArtistExports_Call{Call: _e.mock.On("ArtistExports", _a0, _a1)}
+}
+
+func (_c *ExportRepo_ArtistExports_Call) Run(run func(_a0 context.Context, _a1 postgres.ArtistExportsParams)) *ExportRepo_ArtistExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ArtistExportsParams))
+	})
+	return _c
+}
+
+func (_c *ExportRepo_ArtistExports_Call) Return(_a0 []postgres.Export, _a1 error) *ExportRepo_ArtistExports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExportRepo_ArtistExports_Call) RunAndReturn(run func(context.Context, postgres.ArtistExportsParams) ([]postgres.Export, error)) *ExportRepo_ArtistExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExports provides a mock function with given fields: _a0, _a1
+func (_m *ExportRepo) DeleteExports(_a0 context.Context, _a1 []uuid.UUID) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExports")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportRepo_DeleteExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExports'
+type ExportRepo_DeleteExports_Call struct {
+	*mock.Call
+}
+
+// DeleteExports is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []uuid.UUID
+func (_e *ExportRepo_Expecter) DeleteExports(_a0 interface{}, _a1 interface{}) *ExportRepo_DeleteExports_Call {
+	return &ExportRepo_DeleteExports_Call{Call: _e.mock.On("DeleteExports", _a0, _a1)}
+}
+
+func (_c *ExportRepo_DeleteExports_Call) Run(run func(_a0 context.Context, _a1 []uuid.UUID)) *ExportRepo_DeleteExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ExportRepo_DeleteExports_Call) Return(_a0 error) *ExportRepo_DeleteExports_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExportRepo_DeleteExports_Call) RunAndReturn(run func(context.Context, []uuid.UUID) error) *ExportRepo_DeleteExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpiredExports provides a mock function with given fields: _a0, _a1
+func (_m *ExportRepo) ExpiredExports(_a0 context.Context, _a1 int32) ([]postgres.Export, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpiredExports")
+	}
+
+	var r0 []postgres.Export
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]postgres.Export, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []postgres.Export); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.Export)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportRepo_ExpiredExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpiredExports'
+type ExportRepo_ExpiredExports_Call struct {
+	*mock.Call
+}
+
+// ExpiredExports is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int32
+func (_e *ExportRepo_Expecter) ExpiredExports(_a0 interface{}, _a1 interface{}) *ExportRepo_ExpiredExports_Call {
+	return &ExportRepo_ExpiredExports_Call{Call: _e.mock.On("ExpiredExports", _a0, _a1)}
+}
+
+func (_c *ExportRepo_ExpiredExports_Call) Run(run func(_a0 context.Context, _a1 int32)) *ExportRepo_ExpiredExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ExportRepo_ExpiredExports_Call) Return(_a0 []postgres.Export, _a1 error) *ExportRepo_ExpiredExports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExportRepo_ExpiredExports_Call) RunAndReturn(run func(context.Context, int32) ([]postgres.Export, error)) *ExportRepo_ExpiredExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: _a0, _a1
+func (_m *ExportRepo) Export(_a0 context.Context, _a1 uuid.UUID) (postgres.Export, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 postgres.Export
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (postgres.Export, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) postgres.Export); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Export)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportRepo_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type ExportRepo_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *ExportRepo_Expecter) Export(_a0 interface{}, _a1 interface{}) *ExportRepo_Export_Call {
+	return &ExportRepo_Export_Call{Call: _e.mock.On("Export", _a0, _a1)}
+}
+
+func (_c *ExportRepo_Export_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *ExportRepo_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ExportRepo_Export_Call) Return(_a0 postgres.Export, _a1 error) *ExportRepo_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExportRepo_Export_Call) RunAndReturn(run func(context.Context, uuid.UUID) (postgres.Export, error)) *ExportRepo_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishExport provides a mock function with given fields: _a0, _a1
+func (_m *ExportRepo) FinishExport(_a0 context.Context, _a1 postgres.FinishExportParams) (postgres.Export, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishExport")
+	}
+
+	var r0 postgres.Export
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.FinishExportParams) (postgres.Export, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.FinishExportParams) postgres.Export); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Export)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.FinishExportParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportRepo_FinishExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishExport'
+type ExportRepo_FinishExport_Call struct {
+	*mock.Call
+}
+
+// FinishExport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.FinishExportParams
+func (_e *ExportRepo_Expecter) FinishExport(_a0 interface{}, _a1 interface{}) *ExportRepo_FinishExport_Call {
+	return &ExportRepo_FinishExport_Call{Call: _e.mock.On("FinishExport", _a0, _a1)}
+}
+
+func (_c *ExportRepo_FinishExport_Call) Run(run func(_a0 context.Context, _a1 postgres.FinishExportParams)) *ExportRepo_FinishExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.FinishExportParams))
+	})
+	return _c
+}
+
+func (_c *ExportRepo_FinishExport_Call) Return(_a0 postgres.Export, _a1 error) *ExportRepo_FinishExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExportRepo_FinishExport_Call) RunAndReturn(run func(context.Context, postgres.FinishExportParams) (postgres.Export, error)) *ExportRepo_FinishExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MySongs provides a mock function with given fields: _a0, _a1
+func (_m *ExportRepo) MySongs(_a0 context.Context, _a1 postgres.MySongsParams) ([]postgres.MySongsRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MySongs")
+	}
+
+	var r0 []postgres.MySongsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.MySongsParams) ([]postgres.MySongsRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.MySongsParams) []postgres.MySongsRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.MySongsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.MySongsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportRepo_MySongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MySongs'
+type ExportRepo_MySongs_Call struct {
+	*mock.Call
+}
+
+// MySongs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.MySongsParams
+func (_e *ExportRepo_Expecter) MySongs(_a0 interface{}, _a1 interface{}) *ExportRepo_MySongs_Call {
+	return &ExportRepo_MySongs_Call{Call: _e.mock.On("MySongs", _a0, _a1)}
+}
+
+func (_c *ExportRepo_MySongs_Call) Run(run func(_a0 context.Context, _a1 postgres.MySongsParams)) *ExportRepo_MySongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.MySongsParams))
+	})
+	return _c
+}
+
+func (_c *ExportRepo_MySongs_Call) Return(_a0 []postgres.MySongsRow, _a1 error) *ExportRepo_MySongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExportRepo_MySongs_Call) RunAndReturn(run func(context.Context, postgres.MySongsParams) ([]postgres.MySongsRow, error)) *ExportRepo_MySongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveExport provides a mock function with given fields: _a0, _a1
+func (_m *ExportRepo) SaveExport(_a0 context.Context, _a1 postgres.SaveExportParams) (postgres.Export, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveExport")
+	}
+
+	var r0 postgres.Export
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveExportParams) (postgres.Export, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveExportParams) postgres.Export); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Export)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.SaveExportParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportRepo_SaveExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveExport'
+type ExportRepo_SaveExport_Call struct {
+	*mock.Call
+}
+
+// SaveExport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SaveExportParams
+func (_e *ExportRepo_Expecter) SaveExport(_a0 interface{}, _a1 interface{}) *ExportRepo_SaveExport_Call {
+	return &ExportRepo_SaveExport_Call{Call: _e.mock.On("SaveExport", _a0, _a1)}
+}
+
+func (_c *ExportRepo_SaveExport_Call) Run(run func(_a0 context.Context, _a1 postgres.SaveExportParams)) *ExportRepo_SaveExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SaveExportParams))
+	})
+	return _c
+}
+
+func (_c *ExportRepo_SaveExport_Call) Return(_a0 postgres.Export, _a1 error) *ExportRepo_SaveExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExportRepo_SaveExport_Call) RunAndReturn(run func(context.Context, postgres.SaveExportParams) (postgres.Export, error)) *ExportRepo_SaveExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartExport provides a mock function with given fields: _a0, _a1
+func (_m *ExportRepo) StartExport(_a0 context.Context, _a1 pgtype.Timestamptz) (postgres.Export, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartExport")
+	}
+
+	var r0 postgres.Export
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) (postgres.Export, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) postgres.Export); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Export)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportRepo_StartExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartExport'
+type ExportRepo_StartExport_Call struct {
+	*mock.Call
+}
+
+// StartExport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 pgtype.Timestamptz
+func (_e *ExportRepo_Expecter) StartExport(_a0 interface{}, _a1 interface{}) *ExportRepo_StartExport_Call {
+	return &ExportRepo_StartExport_Call{Call: _e.mock.On("StartExport", _a0, _a1)}
+}
+
+func (_c *ExportRepo_StartExport_Call) Run(run func(_a0 context.Context, _a1 pgtype.Timestamptz)) *ExportRepo_StartExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *ExportRepo_StartExport_Call) Return(_a0 postgres.Export, _a1 error) *ExportRepo_StartExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExportRepo_StartExport_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) (postgres.Export, error)) *ExportRepo_StartExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExportRepo creates a new instance of ExportRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExportRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExportRepo {
+	mock := &ExportRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
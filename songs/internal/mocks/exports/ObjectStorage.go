@@ -0,0 +1,320 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package exportsmocks
+
+import (
+	context "context"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ObjectStorage is an autogenerated mock type for the ObjectStorage type
+type ObjectStorage struct {
+	mock.Mock
+}
+
+type ObjectStorage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ObjectStorage) EXPECT() *ObjectStorage_Expecter {
+	return &ObjectStorage_Expecter{mock: &_m.Mock}
+}
+
+// DeleteExportObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) DeleteExportObject(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExportObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_DeleteExportObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExportObject'
+type ObjectStorage_DeleteExportObject_Call struct {
+	*mock.Call
+}
+
+// DeleteExportObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) DeleteExportObject(ctx interface{}, id interface{}) *ObjectStorage_DeleteExportObject_Call {
+	return &ObjectStorage_DeleteExportObject_Call{Call: _e.mock.On("DeleteExportObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_DeleteExportObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_DeleteExportObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteExportObject_Call) Return(_a0 error) *ObjectStorage_DeleteExportObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteExportObject_Call) RunAndReturn(run func(context.Context, string) error) *ObjectStorage_DeleteExportObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExportObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) GetExportObject(ctx context.Context, id string) (io.Reader, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExportObject")
+	}
+
+	var r0 io.Reader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.Reader, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.Reader); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Reader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ObjectStorage_GetExportObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportObject'
+type ObjectStorage_GetExportObject_Call struct {
+	*mock.Call
+}
+
+// GetExportObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) GetExportObject(ctx interface{}, id interface{}) *ObjectStorage_GetExportObject_Call {
+	return &ObjectStorage_GetExportObject_Call{Call: _e.mock.On("GetExportObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_GetExportObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_GetExportObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_GetExportObject_Call) Return(_a0 io.Reader, _a1 error) *ObjectStorage_GetExportObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ObjectStorage_GetExportObject_Call) RunAndReturn(run func(context.Context, string) (io.Reader, error)) *ObjectStorage_GetExportObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImageObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) GetImageObject(ctx context.Context, id string) (io.Reader, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImageObject")
+	}
+
+	var r0 io.Reader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.Reader, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.Reader); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Reader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ObjectStorage_GetImageObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImageObject'
+type ObjectStorage_GetImageObject_Call struct {
+	*mock.Call
+}
+
+// GetImageObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) GetImageObject(ctx interface{}, id interface{}) *ObjectStorage_GetImageObject_Call {
+	return &ObjectStorage_GetImageObject_Call{Call: _e.mock.On("GetImageObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_GetImageObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_GetImageObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_GetImageObject_Call) Return(_a0 io.Reader, _a1 error) *ObjectStorage_GetImageObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ObjectStorage_GetImageObject_Call) RunAndReturn(run func(context.Context, string) (io.Reader, error)) *ObjectStorage_GetImageObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSongObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) GetSongObject(ctx context.Context, id string) (io.Reader, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSongObject")
+	}
+
+	var r0 io.Reader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.Reader, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.Reader); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Reader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ObjectStorage_GetSongObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSongObject'
+type ObjectStorage_GetSongObject_Call struct {
+	*mock.Call
+}
+
+// GetSongObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) GetSongObject(ctx interface{}, id interface{}) *ObjectStorage_GetSongObject_Call {
+	return &ObjectStorage_GetSongObject_Call{Call: _e.mock.On("GetSongObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_GetSongObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_GetSongObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_GetSongObject_Call) Return(_a0 io.Reader, _a1 error) *ObjectStorage_GetSongObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ObjectStorage_GetSongObject_Call) RunAndReturn(run func(context.Context, string) (io.Reader, error)) *ObjectStorage_GetSongObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutExportObject provides a mock function with given fields: ctx, id, content
+func (_m *ObjectStorage) PutExportObject(ctx context.Context, id string, content io.Reader) (int64, error) {
+	ret := _m.Called(ctx, id, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutExportObject")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) (int64, error)); ok {
+		return rf(ctx, id, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) int64); ok {
+		r0 = rf(ctx, id, content)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader) error); ok {
+		r1 = rf(ctx, id, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ObjectStorage_PutExportObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutExportObject'
+type ObjectStorage_PutExportObject_Call struct {
+	*mock.Call
+}
+
+// PutExportObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - content io.Reader
+func (_e *ObjectStorage_Expecter) PutExportObject(ctx interface{}, id interface{}, content interface{}) *ObjectStorage_PutExportObject_Call {
+	return &ObjectStorage_PutExportObject_Call{Call: _e.mock.On("PutExportObject", ctx, id, content)}
+}
+
+func (_c *ObjectStorage_PutExportObject_Call) Run(run func(ctx context.Context, id string, content io.Reader)) *ObjectStorage_PutExportObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_PutExportObject_Call) Return(_a0 int64, _a1 error) *ObjectStorage_PutExportObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ObjectStorage_PutExportObject_Call) RunAndReturn(run func(context.Context, string, io.Reader) (int64, error)) *ObjectStorage_PutExportObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewObjectStorage creates a new instance of ObjectStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewObjectStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ObjectStorage {
+	mock := &ObjectStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,152 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package importsmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	raw "github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+)
+
+// RawService is an autogenerated mock type for the RawService type
+type RawService struct {
+	mock.Mock
+}
+
+type RawService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RawService) EXPECT() *RawService_Expecter {
+	return &RawService_Expecter{mock: &_m.Mock}
+}
+
+// UploadRawSong provides a mock function with given fields: _a0, _a1
+func (_m *RawService) UploadRawSong(_a0 context.Context, _a1 raw.UploadRawSongInput) (raw.UploadRawSongOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadRawSong")
+	}
+
+	var r0 raw.UploadRawSongOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, raw.UploadRawSongInput) (raw.UploadRawSongOutput, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, raw.UploadRawSongInput) raw.UploadRawSongOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(raw.UploadRawSongOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, raw.UploadRawSongInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RawService_UploadRawSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadRawSong'
+type RawService_UploadRawSong_Call struct {
+	*mock.Call
+}
+
+// UploadRawSong is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 raw.UploadRawSongInput
+func (_e *RawService_Expecter) UploadRawSong(_a0 interface{}, _a1 interface{}) *RawService_UploadRawSong_Call {
+	return &RawService_UploadRawSong_Call{Call: _e.mock.On("UploadRawSong", _a0, _a1)}
+}
+
+func (_c *RawService_UploadRawSong_Call) Run(run func(_a0 context.Context, _a1 raw.UploadRawSongInput)) *RawService_UploadRawSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(raw.UploadRawSongInput))
+	})
+	return _c
+}
+
+func (_c *RawService_UploadRawSong_Call) Return(_a0 raw.UploadRawSongOutput, _a1 error) *RawService_UploadRawSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RawService_UploadRawSong_Call) RunAndReturn(run func(context.Context, raw.UploadRawSongInput) (raw.UploadRawSongOutput, error)) *RawService_UploadRawSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadRawSongImage provides a mock function with given fields: _a0, _a1
+func (_m *RawService) UploadRawSongImage(_a0 context.Context, _a1 raw.UploadRawSongImageInput) (raw.UploadRawSongImageOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadRawSongImage")
+	}
+
+	var r0 raw.UploadRawSongImageOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, raw.UploadRawSongImageInput) (raw.UploadRawSongImageOutput, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, raw.UploadRawSongImageInput) raw.UploadRawSongImageOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(raw.UploadRawSongImageOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, raw.UploadRawSongImageInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RawService_UploadRawSongImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadRawSongImage'
+type RawService_UploadRawSongImage_Call struct {
+	*mock.Call
+}
+
+// UploadRawSongImage is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 raw.UploadRawSongImageInput
+func (_e *RawService_Expecter) UploadRawSongImage(_a0 interface{}, _a1 interface{}) *RawService_UploadRawSongImage_Call {
+	return &RawService_UploadRawSongImage_Call{Call: _e.mock.On("UploadRawSongImage", _a0, _a1)}
+}
+
+func (_c *RawService_UploadRawSongImage_Call) Run(run func(_a0 context.Context, _a1 raw.UploadRawSongImageInput)) *RawService_UploadRawSongImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(raw.UploadRawSongImageInput))
+	})
+	return _c
+}
+
+func (_c *RawService_UploadRawSongImage_Call) Return(_a0 raw.UploadRawSongImageOutput, _a1 error) *RawService_UploadRawSongImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RawService_UploadRawSongImage_Call) RunAndReturn(run func(context.Context, raw.UploadRawSongImageInput) (raw.UploadRawSongImageOutput, error)) *RawService_UploadRawSongImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRawService creates a new instance of RawService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRawService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RawService {
+	mock := &RawService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
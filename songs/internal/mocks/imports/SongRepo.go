@@ -0,0 +1,95 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package importsmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	postgres "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+)
+
+// SongRepo is an autogenerated mock type for the SongRepo type
+type SongRepo struct {
+	mock.Mock
+}
+
+type SongRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SongRepo) EXPECT() *SongRepo_Expecter {
+	return &SongRepo_Expecter{mock: &_m.Mock}
+}
+
+// MySongByName provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) MySongByName(_a0 context.Context, _a1 postgres.MySongByNameParams) (postgres.MySongByNameRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MySongByName")
+	}
+
+	var r0 postgres.MySongByNameRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.MySongByNameParams) (postgres.MySongByNameRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.MySongByNameParams) postgres.MySongByNameRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.MySongByNameRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.MySongByNameParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_MySongByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MySongByName'
+type SongRepo_MySongByName_Call struct {
+	*mock.Call
+}
+
+// MySongByName is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.MySongByNameParams
+func (_e *SongRepo_Expecter) MySongByName(_a0 interface{}, _a1 interface{}) *SongRepo_MySongByName_Call {
+	return &SongRepo_MySongByName_Call{Call: _e.mock.On("MySongByName", _a0, _a1)}
+}
+
+func (_c *SongRepo_MySongByName_Call) Run(run func(_a0 context.Context, _a1 postgres.MySongByNameParams)) *SongRepo_MySongByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.MySongByNameParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_MySongByName_Call) Return(_a0 postgres.MySongByNameRow, _a1 error) *SongRepo_MySongByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_MySongByName_Call) RunAndReturn(run func(context.Context, postgres.MySongByNameParams) (postgres.MySongByNameRow, error)) *SongRepo_MySongByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSongRepo creates a new instance of SongRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSongRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SongRepo {
+	mock := &SongRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,152 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package importsmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	songs "github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+)
+
+// SongsService is an autogenerated mock type for the SongsService type
+type SongsService struct {
+	mock.Mock
+}
+
+type SongsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SongsService) EXPECT() *SongsService_Expecter {
+	return &SongsService_Expecter{mock: &_m.Mock}
+}
+
+// CreateSong provides a mock function with given fields: _a0, _a1
+func (_m *SongsService) CreateSong(_a0 context.Context, _a1 songs.CreateSongInput) (songs.CreateSongOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSong")
+	}
+
+	var r0 songs.CreateSongOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, songs.CreateSongInput) (songs.CreateSongOutput, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, songs.CreateSongInput) songs.CreateSongOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(songs.CreateSongOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, songs.CreateSongInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsService_CreateSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSong'
+type SongsService_CreateSong_Call struct {
+	*mock.Call
+}
+
+// CreateSong is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 songs.CreateSongInput
+func (_e *SongsService_Expecter) CreateSong(_a0 interface{}, _a1 interface{}) *SongsService_CreateSong_Call {
+	return &SongsService_CreateSong_Call{Call: _e.mock.On("CreateSong", _a0, _a1)}
+}
+
+func (_c *SongsService_CreateSong_Call) Run(run func(_a0 context.Context, _a1 songs.CreateSongInput)) *SongsService_CreateSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(songs.CreateSongInput))
+	})
+	return _c
+}
+
+func (_c *SongsService_CreateSong_Call) Return(_a0 songs.CreateSongOutput, _a1 error) *SongsService_CreateSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsService_CreateSong_Call) RunAndReturn(run func(context.Context, songs.CreateSongInput) (songs.CreateSongOutput, error)) *SongsService_CreateSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseSongs provides a mock function with given fields: _a0, _a1
+func (_m *SongsService) ReleaseSongs(_a0 context.Context, _a1 songs.ReleaseSongsInput) (songs.ReleaseSongsOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseSongs")
+	}
+
+	var r0 songs.ReleaseSongsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, songs.ReleaseSongsInput) (songs.ReleaseSongsOutput, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, songs.ReleaseSongsInput) songs.ReleaseSongsOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(songs.ReleaseSongsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, songs.ReleaseSongsInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsService_ReleaseSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseSongs'
+type SongsService_ReleaseSongs_Call struct {
+	*mock.Call
+}
+
+// ReleaseSongs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 songs.ReleaseSongsInput
+func (_e *SongsService_Expecter) ReleaseSongs(_a0 interface{}, _a1 interface{}) *SongsService_ReleaseSongs_Call {
+	return &SongsService_ReleaseSongs_Call{Call: _e.mock.On("ReleaseSongs", _a0, _a1)}
+}
+
+func (_c *SongsService_ReleaseSongs_Call) Run(run func(_a0 context.Context, _a1 songs.ReleaseSongsInput)) *SongsService_ReleaseSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(songs.ReleaseSongsInput))
+	})
+	return _c
+}
+
+func (_c *SongsService_ReleaseSongs_Call) Return(_a0 songs.ReleaseSongsOutput, _a1 error) *SongsService_ReleaseSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongsService_ReleaseSongs_Call) RunAndReturn(run func(context.Context, songs.ReleaseSongsInput) (songs.ReleaseSongsOutput, error)) *SongsService_ReleaseSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSongsService creates a new instance of SongsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSongsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SongsService {
+	mock := &SongsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
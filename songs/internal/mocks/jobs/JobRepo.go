@@ -0,0 +1,420 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package jobsmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	postgres "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	uuid "github.com/google/uuid"
+)
+
+// JobRepo is an autogenerated mock type for the JobRepo type
+type JobRepo struct {
+	mock.Mock
+}
+
+type JobRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobRepo) EXPECT() *JobRepo_Expecter {
+	return &JobRepo_Expecter{mock: &_m.Mock}
+}
+
+// ClaimJob provides a mock function with given fields: _a0, _a1
+func (_m *JobRepo) ClaimJob(_a0 context.Context, _a1 postgres.ClaimJobParams) (postgres.Job, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimJob")
+	}
+
+	var r0 postgres.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ClaimJobParams) (postgres.Job, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ClaimJobParams) postgres.Job); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ClaimJobParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepo_ClaimJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimJob'
+type JobRepo_ClaimJob_Call struct {
+	*mock.Call
+}
+
+// ClaimJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ClaimJobParams
+func (_e *JobRepo_Expecter) ClaimJob(_a0 interface{}, _a1 interface{}) *JobRepo_ClaimJob_Call {
+	return &JobRepo_ClaimJob_Call{Call: _e.mock.On("ClaimJob", _a0, _a1)}
+}
+
+func (_c *JobRepo_ClaimJob_Call) Run(run func(_a0 context.Context, _a1 postgres.ClaimJobParams)) *JobRepo_ClaimJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ClaimJobParams))
+	})
+	return _c
+}
+
+func (_c *JobRepo_ClaimJob_Call) Return(_a0 postgres.Job, _a1 error) *JobRepo_ClaimJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepo_ClaimJob_Call) RunAndReturn(run func(context.Context, postgres.ClaimJobParams) (postgres.Job, error)) *JobRepo_ClaimJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteJob provides a mock function with given fields: _a0, _a1
+func (_m *JobRepo) CompleteJob(_a0 context.Context, _a1 postgres.CompleteJobParams) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CompleteJobParams) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRepo_CompleteJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteJob'
+type JobRepo_CompleteJob_Call struct {
+	*mock.Call
+}
+
+// CompleteJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.CompleteJobParams
+func (_e *JobRepo_Expecter) CompleteJob(_a0 interface{}, _a1 interface{}) *JobRepo_CompleteJob_Call {
+	return &JobRepo_CompleteJob_Call{Call: _e.mock.On("CompleteJob", _a0, _a1)}
+}
+
+func (_c *JobRepo_CompleteJob_Call) Run(run func(_a0 context.Context, _a1 postgres.CompleteJobParams)) *JobRepo_CompleteJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.CompleteJobParams))
+	})
+	return _c
+}
+
+func (_c *JobRepo_CompleteJob_Call) Return(_a0 error) *JobRepo_CompleteJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRepo_CompleteJob_Call) RunAndReturn(run func(context.Context, postgres.CompleteJobParams) error) *JobRepo_CompleteJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountDeadJobs provides a mock function with given fields: _a0
+func (_m *JobRepo) CountDeadJobs(_a0 context.Context) (int32, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountDeadJobs")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int32, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int32); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepo_CountDeadJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountDeadJobs'
+type JobRepo_CountDeadJobs_Call struct {
+	*mock.Call
+}
+
+// CountDeadJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *JobRepo_Expecter) CountDeadJobs(_a0 interface{}) *JobRepo_CountDeadJobs_Call {
+	return &JobRepo_CountDeadJobs_Call{Call: _e.mock.On("CountDeadJobs", _a0)}
+}
+
+func (_c *JobRepo_CountDeadJobs_Call) Run(run func(_a0 context.Context)) *JobRepo_CountDeadJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *JobRepo_CountDeadJobs_Call) Return(_a0 int32, _a1 error) *JobRepo_CountDeadJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepo_CountDeadJobs_Call) RunAndReturn(run func(context.Context) (int32, error)) *JobRepo_CountDeadJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeadJobs provides a mock function with given fields: _a0, _a1
+func (_m *JobRepo) DeadJobs(_a0 context.Context, _a1 postgres.DeadJobsParams) ([]postgres.Job, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeadJobs")
+	}
+
+	var r0 []postgres.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.DeadJobsParams) ([]postgres.Job, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.DeadJobsParams) []postgres.Job); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.DeadJobsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepo_DeadJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeadJobs'
+type JobRepo_DeadJobs_Call struct {
+	*mock.Call
+}
+
+// DeadJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.DeadJobsParams
+func (_e *JobRepo_Expecter) DeadJobs(_a0 interface{}, _a1 interface{}) *JobRepo_DeadJobs_Call {
+	return &JobRepo_DeadJobs_Call{Call: _e.mock.On("DeadJobs", _a0, _a1)}
+}
+
+func (_c *JobRepo_DeadJobs_Call) Run(run func(_a0 context.Context, _a1 postgres.DeadJobsParams)) *JobRepo_DeadJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.DeadJobsParams))
+	})
+	return _c
+}
+
+func (_c *JobRepo_DeadJobs_Call) Return(_a0 []postgres.Job, _a1 error) *JobRepo_DeadJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepo_DeadJobs_Call) RunAndReturn(run func(context.Context, postgres.DeadJobsParams) ([]postgres.Job, error)) *JobRepo_DeadJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueJob provides a mock function with given fields: _a0, _a1
+func (_m *JobRepo) EnqueueJob(_a0 context.Context, _a1 postgres.EnqueueJobParams) (postgres.Job, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueJob")
+	}
+
+	var r0 postgres.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.EnqueueJobParams) (postgres.Job, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.EnqueueJobParams) postgres.Job); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.EnqueueJobParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepo_EnqueueJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueJob'
+type JobRepo_EnqueueJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.EnqueueJobParams
+func (_e *JobRepo_Expecter) EnqueueJob(_a0 interface{}, _a1 interface{}) *JobRepo_EnqueueJob_Call {
+	return &JobRepo_EnqueueJob_Call{Call: _e.mock.On("EnqueueJob", _a0, _a1)}
+}
+
+func (_c *JobRepo_EnqueueJob_Call) Run(run func(_a0 context.Context, _a1 postgres.EnqueueJobParams)) *JobRepo_EnqueueJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.EnqueueJobParams))
+	})
+	return _c
+}
+
+func (_c *JobRepo_EnqueueJob_Call) Return(_a0 postgres.Job, _a1 error) *JobRepo_EnqueueJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepo_EnqueueJob_Call) RunAndReturn(run func(context.Context, postgres.EnqueueJobParams) (postgres.Job, error)) *JobRepo_EnqueueJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailJob provides a mock function with given fields: _a0, _a1
+func (_m *JobRepo) FailJob(_a0 context.Context, _a1 postgres.FailJobParams) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.FailJobParams) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRepo_FailJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailJob'
+type JobRepo_FailJob_Call struct {
+	*mock.Call
+}
+
+// FailJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.FailJobParams
+func (_e *JobRepo_Expecter) FailJob(_a0 interface{}, _a1 interface{}) *JobRepo_FailJob_Call {
+	return &JobRepo_FailJob_Call{Call: _e.mock.On("FailJob", _a0, _a1)}
+}
+
+func (_c *JobRepo_FailJob_Call) Run(run func(_a0 context.Context, _a1 postgres.FailJobParams)) *JobRepo_FailJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.FailJobParams))
+	})
+	return _c
+}
+
+func (_c *JobRepo_FailJob_Call) Return(_a0 error) *JobRepo_FailJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRepo_FailJob_Call) RunAndReturn(run func(context.Context, postgres.FailJobParams) error) *JobRepo_FailJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryDeadJob provides a mock function with given fields: _a0, _a1
+func (_m *JobRepo) RetryDeadJob(_a0 context.Context, _a1 uuid.UUID) (postgres.Job, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryDeadJob")
+	}
+
+	var r0 postgres.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (postgres.Job, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) postgres.Job); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRepo_RetryDeadJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryDeadJob'
+type JobRepo_RetryDeadJob_Call struct {
+	*mock.Call
+}
+
+// RetryDeadJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *JobRepo_Expecter) RetryDeadJob(_a0 interface{}, _a1 interface{}) *JobRepo_RetryDeadJob_Call {
+	return &JobRepo_RetryDeadJob_Call{Call: _e.mock.On("RetryDeadJob", _a0, _a1)}
+}
+
+func (_c *JobRepo_RetryDeadJob_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *JobRepo_RetryDeadJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *JobRepo_RetryDeadJob_Call) Return(_a0 postgres.Job, _a1 error) *JobRepo_RetryDeadJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRepo_RetryDeadJob_Call) RunAndReturn(run func(context.Context, uuid.UUID) (postgres.Job, error)) *JobRepo_RetryDeadJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobRepo creates a new instance of JobRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobRepo {
+	mock := &JobRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package jobsmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	postgres "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+)
+
+// JobSaver is an autogenerated mock type for the JobSaver type
+type JobSaver struct {
+	mock.Mock
+}
+
+type JobSaver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobSaver) EXPECT() *JobSaver_Expecter {
+	return &JobSaver_Expecter{mock: &_m.Mock}
+}
+
+// EnqueueJob provides a mock function with given fields: _a0, _a1
+func (_m *JobSaver) EnqueueJob(_a0 context.Context, _a1 postgres.EnqueueJobParams) (postgres.Job, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueJob")
+	}
+
+	var r0 postgres.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.EnqueueJobParams) (postgres.Job, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.EnqueueJobParams) postgres.Job); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.EnqueueJobParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobSaver_EnqueueJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueJob'
+type JobSaver_EnqueueJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.EnqueueJobParams
+func (_e *JobSaver_Expecter) EnqueueJob(_a0 interface{}, _a1 interface{}) *JobSaver_EnqueueJob_Call {
+	return &JobSaver_EnqueueJob_Call{Call: _e.mock.On("EnqueueJob", _a0, _a1)}
+}
+
+func (_c *JobSaver_EnqueueJob_Call) Run(run func(_a0 context.Context, _a1 postgres.EnqueueJobParams)) *JobSaver_EnqueueJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.EnqueueJobParams))
+	})
+	return _c
+}
+
+func (_c *JobSaver_EnqueueJob_Call) Return(_a0 postgres.Job, _a1 error) *JobSaver_EnqueueJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobSaver_EnqueueJob_Call) RunAndReturn(run func(context.Context, postgres.EnqueueJobParams) (postgres.Job, error)) *JobSaver_EnqueueJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobSaver creates a new instance of JobSaver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobSaver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobSaver {
+	mock := &JobSaver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
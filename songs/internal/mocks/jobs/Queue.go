@@ -0,0 +1,100 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package jobsmocks
+
+import (
+	context "context"
+
+	jobs "github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Queue is an autogenerated mock type for the Queue type
+type Queue struct {
+	mock.Mock
+}
+
+type Queue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Queue) EXPECT() *Queue_Expecter {
+	return &Queue_Expecter{mock: &_m.Mock}
+}
+
+// EnqueueJob provides a mock function with given fields: ctx, kind, payload, opts
+func (_m *Queue) EnqueueJob(ctx context.Context, kind string, payload []byte, opts jobs.Options) (uuid.UUID, error) {
+	ret := _m.Called(ctx, kind, payload, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueJob")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, jobs.Options) (uuid.UUID, error)); ok {
+		return rf(ctx, kind, payload, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, jobs.Options) uuid.UUID); ok {
+		r0 = rf(ctx, kind, payload, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte, jobs.Options) error); ok {
+		r1 = rf(ctx, kind, payload, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Queue_EnqueueJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueJob'
+type Queue_EnqueueJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kind string
+//   - payload []byte
+//   - opts jobs.Options
+func (_e *Queue_Expecter) EnqueueJob(ctx interface{}, kind interface{}, payload interface{}, opts interface{}) *Queue_EnqueueJob_Call {
+	return &Queue_EnqueueJob_Call{Call: _e.mock.On("EnqueueJob", ctx, kind, payload, opts)}
+}
+
+func (_c *Queue_EnqueueJob_Call) Run(run func(ctx context.Context, kind string, payload []byte, opts jobs.Options)) *Queue_EnqueueJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte), args[3].(jobs.Options))
+	})
+	return _c
+}
+
+func (_c *Queue_EnqueueJob_Call) Return(_a0 uuid.UUID, _a1 error) *Queue_EnqueueJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Queue_EnqueueJob_Call) RunAndReturn(run func(context.Context, string, []byte, jobs.Options) (uuid.UUID, error)) *Queue_EnqueueJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewQueue creates a new instance of Queue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Queue {
+	mock := &Queue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,132 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package rawmocks
+
+import (
+	context "context"
+
+	broker "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Broker is an autogenerated mock type for the Broker type
+type Broker struct {
+	mock.Mock
+}
+
+type Broker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Broker) EXPECT() *Broker_Expecter {
+	return &Broker_Expecter{mock: &_m.Mock}
+}
+
+// SendPlayedMessages provides a mock function with given fields: _a0, _a1
+func (_m *Broker) SendPlayedMessages(_a0 context.Context, _a1 []broker.SongPlayedMessage) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendPlayedMessages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []broker.SongPlayedMessage) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Broker_SendPlayedMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendPlayedMessages'
+type Broker_SendPlayedMessages_Call struct {
+	*mock.Call
+}
+
+// SendPlayedMessages is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []broker.SongPlayedMessage
+func (_e *Broker_Expecter) SendPlayedMessages(_a0 interface{}, _a1 interface{}) *Broker_SendPlayedMessages_Call {
+	return &Broker_SendPlayedMessages_Call{Call: _e.mock.On("SendPlayedMessages", _a0, _a1)}
+}
+
+func (_c *Broker_SendPlayedMessages_Call) Run(run func(_a0 context.Context, _a1 []broker.SongPlayedMessage)) *Broker_SendPlayedMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]broker.SongPlayedMessage))
+	})
+	return _c
+}
+
+func (_c *Broker_SendPlayedMessages_Call) Return(_a0 error) *Broker_SendPlayedMessages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Broker_SendPlayedMessages_Call) RunAndReturn(run func(context.Context, []broker.SongPlayedMessage) error) *Broker_SendPlayedMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendSongMessages provides a mock function with given fields: _a0, _a1
+func (_m *Broker) SendSongMessages(_a0 context.Context, _a1 []broker.SongMessage) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendSongMessages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []broker.SongMessage) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Broker_SendSongMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendSongMessages'
+type Broker_SendSongMessages_Call struct {
+	*mock.Call
+}
+
+// SendSongMessages is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []broker.SongMessage
+func (_e *Broker_Expecter) SendSongMessages(_a0 interface{}, _a1 interface{}) *Broker_SendSongMessages_Call {
+	return &Broker_SendSongMessages_Call{Call: _e.mock.On("SendSongMessages", _a0, _a1)}
+}
+
+func (_c *Broker_SendSongMessages_Call) Run(run func(_a0 context.Context, _a1 []broker.SongMessage)) *Broker_SendSongMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]broker.SongMessage))
+	})
+	return _c
+}
+
+func (_c *Broker_SendSongMessages_Call) Return(_a0 error) *Broker_SendSongMessages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Broker_SendSongMessages_Call) RunAndReturn(run func(context.Context, []broker.SongMessage) error) *Broker_SendSongMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBroker creates a new instance of Broker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBroker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Broker {
+	mock := &Broker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
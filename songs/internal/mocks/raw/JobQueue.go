@@ -0,0 +1,100 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package rawmocks
+
+import (
+	context "context"
+
+	jobs "github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// JobQueue is an autogenerated mock type for the JobQueue type
+type JobQueue struct {
+	mock.Mock
+}
+
+type JobQueue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobQueue) EXPECT() *JobQueue_Expecter {
+	return &JobQueue_Expecter{mock: &_m.Mock}
+}
+
+// EnqueueJob provides a mock function with given fields: ctx, kind, payload, opts
+func (_m *JobQueue) EnqueueJob(ctx context.Context, kind string, payload []byte, opts jobs.Options) (uuid.UUID, error) {
+	ret := _m.Called(ctx, kind, payload, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueJob")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, jobs.Options) (uuid.UUID, error)); ok {
+		return rf(ctx, kind, payload, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, jobs.Options) uuid.UUID); ok {
+		r0 = rf(ctx, kind, payload, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte, jobs.Options) error); ok {
+		r1 = rf(ctx, kind, payload, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobQueue_EnqueueJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueJob'
+type JobQueue_EnqueueJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kind string
+//   - payload []byte
+//   - opts jobs.Options
+func (_e *JobQueue_Expecter) EnqueueJob(ctx interface{}, kind interface{}, payload interface{}, opts interface{}) *JobQueue_EnqueueJob_Call {
+	return &JobQueue_EnqueueJob_Call{Call: _e.mock.On("EnqueueJob", ctx, kind, payload, opts)}
+}
+
+func (_c *JobQueue_EnqueueJob_Call) Run(run func(ctx context.Context, kind string, payload []byte, opts jobs.Options)) *JobQueue_EnqueueJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte), args[3].(jobs.Options))
+	})
+	return _c
+}
+
+func (_c *JobQueue_EnqueueJob_Call) Return(_a0 uuid.UUID, _a1 error) *JobQueue_EnqueueJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobQueue_EnqueueJob_Call) RunAndReturn(run func(context.Context, string, []byte, jobs.Options) (uuid.UUID, error)) *JobQueue_EnqueueJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobQueue creates a new instance of JobQueue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobQueue {
+	mock := &JobQueue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
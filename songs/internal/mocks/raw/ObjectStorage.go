@@ -8,7 +8,7 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	s3minio "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/s3minio"
+	objects "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/objects"
 )
 
 // ObjectStorage is an autogenerated mock type for the ObjectStorage type
@@ -24,6 +24,194 @@ func (_m *ObjectStorage) EXPECT() *ObjectStorage_Expecter {
 	return &ObjectStorage_Expecter{mock: &_m.Mock}
 }
 
+// DeleteImageObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) DeleteImageObject(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteImageObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_DeleteImageObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteImageObject'
+type ObjectStorage_DeleteImageObject_Call struct {
+	*mock.Call
+}
+
+// DeleteImageObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) DeleteImageObject(ctx interface{}, id interface{}) *ObjectStorage_DeleteImageObject_Call {
+	return &ObjectStorage_DeleteImageObject_Call{Call: _e.mock.On("DeleteImageObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_DeleteImageObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_DeleteImageObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteImageObject_Call) Return(_a0 error) *ObjectStorage_DeleteImageObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteImageObject_Call) RunAndReturn(run func(context.Context, string) error) *ObjectStorage_DeleteImageObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePreviewObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) DeletePreviewObject(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePreviewObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_DeletePreviewObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePreviewObject'
+type ObjectStorage_DeletePreviewObject_Call struct {
+	*mock.Call
+}
+
+// DeletePreviewObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) DeletePreviewObject(ctx interface{}, id interface{}) *ObjectStorage_DeletePreviewObject_Call {
+	return &ObjectStorage_DeletePreviewObject_Call{Call: _e.mock.On("DeletePreviewObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_DeletePreviewObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_DeletePreviewObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_DeletePreviewObject_Call) Return(_a0 error) *ObjectStorage_DeletePreviewObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_DeletePreviewObject_Call) RunAndReturn(run func(context.Context, string) error) *ObjectStorage_DeletePreviewObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSongObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) DeleteSongObject(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSongObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_DeleteSongObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSongObject'
+type ObjectStorage_DeleteSongObject_Call struct {
+	*mock.Call
+}
+
+// DeleteSongObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) DeleteSongObject(ctx interface{}, id interface{}) *ObjectStorage_DeleteSongObject_Call {
+	return &ObjectStorage_DeleteSongObject_Call{Call: _e.mock.On("DeleteSongObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_DeleteSongObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_DeleteSongObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteSongObject_Call) Return(_a0 error) *ObjectStorage_DeleteSongObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteSongObject_Call) RunAndReturn(run func(context.Context, string) error) *ObjectStorage_DeleteSongObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteThumbnailObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) DeleteThumbnailObject(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteThumbnailObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_DeleteThumbnailObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteThumbnailObject'
+type ObjectStorage_DeleteThumbnailObject_Call struct {
+	*mock.Call
+}
+
+// DeleteThumbnailObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) DeleteThumbnailObject(ctx interface{}, id interface{}) *ObjectStorage_DeleteThumbnailObject_Call {
+	return &ObjectStorage_DeleteThumbnailObject_Call{Call: _e.mock.On("DeleteThumbnailObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_DeleteThumbnailObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_DeleteThumbnailObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteThumbnailObject_Call) Return(_a0 error) *ObjectStorage_DeleteThumbnailObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteThumbnailObject_Call) RunAndReturn(run func(context.Context, string) error) *ObjectStorage_DeleteThumbnailObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImageObject provides a mock function with given fields: ctx, id
 func (_m *ObjectStorage) GetImageObject(ctx context.Context, id string) (io.Reader, error) {
 	ret := _m.Called(ctx, id)
@@ -83,6 +271,65 @@ func (_c *ObjectStorage_GetImageObject_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetPreviewObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) GetPreviewObject(ctx context.Context, id string) (io.Reader, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreviewObject")
+	}
+
+	var r0 io.Reader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.Reader, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.Reader); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Reader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ObjectStorage_GetPreviewObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviewObject'
+type ObjectStorage_GetPreviewObject_Call struct {
+	*mock.Call
+}
+
+// GetPreviewObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) GetPreviewObject(ctx interface{}, id interface{}) *ObjectStorage_GetPreviewObject_Call {
+	return &ObjectStorage_GetPreviewObject_Call{Call: _e.mock.On("GetPreviewObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_GetPreviewObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_GetPreviewObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_GetPreviewObject_Call) Return(_a0 io.Reader, _a1 error) *ObjectStorage_GetPreviewObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ObjectStorage_GetPreviewObject_Call) RunAndReturn(run func(context.Context, string) (io.Reader, error)) *ObjectStorage_GetPreviewObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSongObject provides a mock function with given fields: ctx, id
 func (_m *ObjectStorage) GetSongObject(ctx context.Context, id string) (io.Reader, error) {
 	ret := _m.Called(ctx, id)
@@ -142,8 +389,67 @@ func (_c *ObjectStorage_GetSongObject_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetThumbnailObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) GetThumbnailObject(ctx context.Context, id string) (io.Reader, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetThumbnailObject")
+	}
+
+	var r0 io.Reader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.Reader, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.Reader); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.Reader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ObjectStorage_GetThumbnailObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetThumbnailObject'
+type ObjectStorage_GetThumbnailObject_Call struct {
+	*mock.Call
+}
+
+// GetThumbnailObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) GetThumbnailObject(ctx interface{}, id interface{}) *ObjectStorage_GetThumbnailObject_Call {
+	return &ObjectStorage_GetThumbnailObject_Call{Call: _e.mock.On("GetThumbnailObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_GetThumbnailObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_GetThumbnailObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_GetThumbnailObject_Call) Return(_a0 io.Reader, _a1 error) *ObjectStorage_GetThumbnailObject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ObjectStorage_GetThumbnailObject_Call) RunAndReturn(run func(context.Context, string) (io.Reader, error)) *ObjectStorage_GetThumbnailObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PutImageObject provides a mock function with given fields: ctx, image
-func (_m *ObjectStorage) PutImageObject(ctx context.Context, image s3minio.ImageObject) error {
+func (_m *ObjectStorage) PutImageObject(ctx context.Context, image objects.ImageObject) error {
 	ret := _m.Called(ctx, image)
 
 	if len(ret) == 0 {
@@ -151,7 +457,7 @@ func (_m *ObjectStorage) PutImageObject(ctx context.Context, image s3minio.Image
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, s3minio.ImageObject) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, objects.ImageObject) error); ok {
 		r0 = rf(ctx, image)
 	} else {
 		r0 = ret.Error(0)
@@ -167,14 +473,14 @@ type ObjectStorage_PutImageObject_Call struct {
 
 // PutImageObject is a helper method to define mock.On call
 //   - ctx context.Context
-//   - image s3minio.ImageObject
+//   - image objects.ImageObject
 func (_e *ObjectStorage_Expecter) PutImageObject(ctx interface{}, image interface{}) *ObjectStorage_PutImageObject_Call {
 	return &ObjectStorage_PutImageObject_Call{Call: _e.mock.On("PutImageObject", ctx, image)}
 }
 
-func (_c *ObjectStorage_PutImageObject_Call) Run(run func(ctx context.Context, image s3minio.ImageObject)) *ObjectStorage_PutImageObject_Call {
+func (_c *ObjectStorage_PutImageObject_Call) Run(run func(ctx context.Context, image objects.ImageObject)) *ObjectStorage_PutImageObject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(s3minio.ImageObject))
+		run(args[0].(context.Context), args[1].(objects.ImageObject))
 	})
 	return _c
 }
@@ -184,13 +490,62 @@ func (_c *ObjectStorage_PutImageObject_Call) Return(_a0 error) *ObjectStorage_Pu
 	return _c
 }
 
-func (_c *ObjectStorage_PutImageObject_Call) RunAndReturn(run func(context.Context, s3minio.ImageObject) error) *ObjectStorage_PutImageObject_Call {
+func (_c *ObjectStorage_PutImageObject_Call) RunAndReturn(run func(context.Context, objects.ImageObject) error) *ObjectStorage_PutImageObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutPreviewObject provides a mock function with given fields: ctx, id, content, size
+func (_m *ObjectStorage) PutPreviewObject(ctx context.Context, id string, content io.Reader, size int64) error {
+	ret := _m.Called(ctx, id, content, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutPreviewObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, int64) error); ok {
+		r0 = rf(ctx, id, content, size)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_PutPreviewObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutPreviewObject'
+type ObjectStorage_PutPreviewObject_Call struct {
+	*mock.Call
+}
+
+// PutPreviewObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - content io.Reader
+//   - size int64
+func (_e *ObjectStorage_Expecter) PutPreviewObject(ctx interface{}, id interface{}, content interface{}, size interface{}) *ObjectStorage_PutPreviewObject_Call {
+	return &ObjectStorage_PutPreviewObject_Call{Call: _e.mock.On("PutPreviewObject", ctx, id, content, size)}
+}
+
+func (_c *ObjectStorage_PutPreviewObject_Call) Run(run func(ctx context.Context, id string, content io.Reader, size int64)) *ObjectStorage_PutPreviewObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_PutPreviewObject_Call) Return(_a0 error) *ObjectStorage_PutPreviewObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_PutPreviewObject_Call) RunAndReturn(run func(context.Context, string, io.Reader, int64) error) *ObjectStorage_PutPreviewObject_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // PutSongObject provides a mock function with given fields: _a0, _a1
-func (_m *ObjectStorage) PutSongObject(_a0 context.Context, _a1 s3minio.SongObject) error {
+func (_m *ObjectStorage) PutSongObject(_a0 context.Context, _a1 objects.SongObject) error {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
@@ -198,7 +553,7 @@ func (_m *ObjectStorage) PutSongObject(_a0 context.Context, _a1 s3minio.SongObje
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, s3minio.SongObject) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, objects.SongObject) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -214,14 +569,14 @@ type ObjectStorage_PutSongObject_Call struct {
 
 // PutSongObject is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 s3minio.SongObject
+//   - _a1 objects.SongObject
 func (_e *ObjectStorage_Expecter) PutSongObject(_a0 interface{}, _a1 interface{}) *ObjectStorage_PutSongObject_Call {
 	return &ObjectStorage_PutSongObject_Call{Call: _e.mock.On("PutSongObject", _a0, _a1)}
 }
 
-func (_c *ObjectStorage_PutSongObject_Call) Run(run func(_a0 context.Context, _a1 s3minio.SongObject)) *ObjectStorage_PutSongObject_Call {
+func (_c *ObjectStorage_PutSongObject_Call) Run(run func(_a0 context.Context, _a1 objects.SongObject)) *ObjectStorage_PutSongObject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(s3minio.SongObject))
+		run(args[0].(context.Context), args[1].(objects.SongObject))
 	})
 	return _c
 }
@@ -231,7 +586,56 @@ func (_c *ObjectStorage_PutSongObject_Call) Return(_a0 error) *ObjectStorage_Put
 	return _c
 }
 
-func (_c *ObjectStorage_PutSongObject_Call) RunAndReturn(run func(context.Context, s3minio.SongObject) error) *ObjectStorage_PutSongObject_Call {
+func (_c *ObjectStorage_PutSongObject_Call) RunAndReturn(run func(context.Context, objects.SongObject) error) *ObjectStorage_PutSongObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutThumbnailObject provides a mock function with given fields: ctx, id, content, size
+func (_m *ObjectStorage) PutThumbnailObject(ctx context.Context, id string, content io.Reader, size int64) error {
+	ret := _m.Called(ctx, id, content, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutThumbnailObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, int64) error); ok {
+		r0 = rf(ctx, id, content, size)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_PutThumbnailObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutThumbnailObject'
+type ObjectStorage_PutThumbnailObject_Call struct {
+	*mock.Call
+}
+
+// PutThumbnailObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - content io.Reader
+//   - size int64
+func (_e *ObjectStorage_Expecter) PutThumbnailObject(ctx interface{}, id interface{}, content interface{}, size interface{}) *ObjectStorage_PutThumbnailObject_Call {
+	return &ObjectStorage_PutThumbnailObject_Call{Call: _e.mock.On("PutThumbnailObject", ctx, id, content, size)}
+}
+
+func (_c *ObjectStorage_PutThumbnailObject_Call) Run(run func(ctx context.Context, id string, content io.Reader, size int64)) *ObjectStorage_PutThumbnailObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_PutThumbnailObject_Call) Return(_a0 error) *ObjectStorage_PutThumbnailObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_PutThumbnailObject_Call) RunAndReturn(run func(context.Context, string, io.Reader, int64) error) *ObjectStorage_PutThumbnailObject_Call {
 	_c.Call.Return(run)
 	return _c
 }
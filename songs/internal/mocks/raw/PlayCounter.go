@@ -0,0 +1,99 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package rawmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// PlayCounter is an autogenerated mock type for the PlayCounter type
+type PlayCounter struct {
+	mock.Mock
+}
+
+type PlayCounter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PlayCounter) EXPECT() *PlayCounter_Expecter {
+	return &PlayCounter_Expecter{mock: &_m.Mock}
+}
+
+// CountPlay provides a mock function with given fields: ctx, listenerId, songId, window
+func (_m *PlayCounter) CountPlay(ctx context.Context, listenerId string, songId uuid.UUID, window time.Duration) (bool, error) {
+	ret := _m.Called(ctx, listenerId, songId, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPlay")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Duration) (bool, error)); ok {
+		return rf(ctx, listenerId, songId, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, time.Duration) bool); ok {
+		r0 = rf(ctx, listenerId, songId, window)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, time.Duration) error); ok {
+		r1 = rf(ctx, listenerId, songId, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlayCounter_CountPlay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPlay'
+type PlayCounter_CountPlay_Call struct {
+	*mock.Call
+}
+
+// CountPlay is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listenerId string
+//   - songId uuid.UUID
+//   - window time.Duration
+func (_e *PlayCounter_Expecter) CountPlay(ctx interface{}, listenerId interface{}, songId interface{}, window interface{}) *PlayCounter_CountPlay_Call {
+	return &PlayCounter_CountPlay_Call{Call: _e.mock.On("CountPlay", ctx, listenerId, songId, window)}
+}
+
+func (_c *PlayCounter_CountPlay_Call) Run(run func(ctx context.Context, listenerId string, songId uuid.UUID, window time.Duration)) *PlayCounter_CountPlay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *PlayCounter_CountPlay_Call) Return(_a0 bool, _a1 error) *PlayCounter_CountPlay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PlayCounter_CountPlay_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, time.Duration) (bool, error)) *PlayCounter_CountPlay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPlayCounter creates a new instance of PlayCounter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPlayCounter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PlayCounter {
+	mock := &PlayCounter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
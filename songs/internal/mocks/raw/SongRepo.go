@@ -5,10 +5,14 @@ package rawmocks
 import (
 	context "context"
 
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	mock "github.com/stretchr/testify/mock"
+
 	postgres "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
 
 	raw "github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+
+	uuid "github.com/google/uuid"
 )
 
 // SongRepo is an autogenerated mock type for the SongRepo type
@@ -24,6 +28,63 @@ func (_m *SongRepo) EXPECT() *SongRepo_Expecter {
 	return &SongRepo_Expecter{mock: &_m.Mock}
 }
 
+// ArtistUsage provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) ArtistUsage(_a0 context.Context, _a1 uuid.UUID) (postgres.ArtistUsageRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistUsage")
+	}
+
+	var r0 postgres.ArtistUsageRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (postgres.ArtistUsageRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) postgres.ArtistUsageRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.ArtistUsageRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_ArtistUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtistUsage'
+type SongRepo_ArtistUsage_Call struct {
+	*mock.Call
+}
+
+// ArtistUsage is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *SongRepo_Expecter) ArtistUsage(_a0 interface{}, _a1 interface{}) *SongRepo_ArtistUsage_Call {
+	return &SongRepo_ArtistUsage_Call{Call: _e.mock.On("ArtistUsage", _a0, _a1)}
+}
+
+func (_c *SongRepo_ArtistUsage_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *SongRepo_ArtistUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SongRepo_ArtistUsage_Call) Return(_a0 postgres.ArtistUsageRow, _a1 error) *SongRepo_ArtistUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_ArtistUsage_Call) RunAndReturn(run func(context.Context, uuid.UUID) (postgres.ArtistUsageRow, error)) *SongRepo_ArtistUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Begin provides a mock function with given fields: _a0
 func (_m *SongRepo) Begin(_a0 context.Context) (raw.SongRepo, error) {
 	ret := _m.Called(_a0)
@@ -128,6 +189,342 @@ func (_c *SongRepo_Commit_Call) RunAndReturn(run func(context.Context) error) *S
 	return _c
 }
 
+// DeleteSongs provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) DeleteSongs(_a0 context.Context, _a1 []uuid.UUID) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSongs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SongRepo_DeleteSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSongs'
+type SongRepo_DeleteSongs_Call struct {
+	*mock.Call
+}
+
+// DeleteSongs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []uuid.UUID
+func (_e *SongRepo_Expecter) DeleteSongs(_a0 interface{}, _a1 interface{}) *SongRepo_DeleteSongs_Call {
+	return &SongRepo_DeleteSongs_Call{Call: _e.mock.On("DeleteSongs", _a0, _a1)}
+}
+
+func (_c *SongRepo_DeleteSongs_Call) Run(run func(_a0 context.Context, _a1 []uuid.UUID)) *SongRepo_DeleteSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SongRepo_DeleteSongs_Call) Return(_a0 error) *SongRepo_DeleteSongs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SongRepo_DeleteSongs_Call) RunAndReturn(run func(context.Context, []uuid.UUID) error) *SongRepo_DeleteSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpiredTrashedSongs provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) ExpiredTrashedSongs(_a0 context.Context, _a1 postgres.ExpiredTrashedSongsParams) ([]postgres.Song, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpiredTrashedSongs")
+	}
+
+	var r0 []postgres.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ExpiredTrashedSongsParams) ([]postgres.Song, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ExpiredTrashedSongsParams) []postgres.Song); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ExpiredTrashedSongsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_ExpiredTrashedSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpiredTrashedSongs'
+type SongRepo_ExpiredTrashedSongs_Call struct {
+	*mock.Call
+}
+
+// ExpiredTrashedSongs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ExpiredTrashedSongsParams
+func (_e *SongRepo_Expecter) ExpiredTrashedSongs(_a0 interface{}, _a1 interface{}) *SongRepo_ExpiredTrashedSongs_Call {
+	return &SongRepo_ExpiredTrashedSongs_Call{Call: _e.mock.On("ExpiredTrashedSongs", _a0, _a1)}
+}
+
+func (_c *SongRepo_ExpiredTrashedSongs_Call) Run(run func(_a0 context.Context, _a1 postgres.ExpiredTrashedSongsParams)) *SongRepo_ExpiredTrashedSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ExpiredTrashedSongsParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_ExpiredTrashedSongs_Call) Return(_a0 []postgres.Song, _a1 error) *SongRepo_ExpiredTrashedSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_ExpiredTrashedSongs_Call) RunAndReturn(run func(context.Context, postgres.ExpiredTrashedSongsParams) ([]postgres.Song, error)) *SongRepo_ExpiredTrashedSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailStaleUploads provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) FailStaleUploads(_a0 context.Context, _a1 postgres.FailStaleUploadsParams) ([]uuid.UUID, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailStaleUploads")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.FailStaleUploadsParams) ([]uuid.UUID, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.FailStaleUploadsParams) []uuid.UUID); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.FailStaleUploadsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_FailStaleUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailStaleUploads'
+type SongRepo_FailStaleUploads_Call struct {
+	*mock.Call
+}
+
+// FailStaleUploads is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.FailStaleUploadsParams
+func (_e *SongRepo_Expecter) FailStaleUploads(_a0 interface{}, _a1 interface{}) *SongRepo_FailStaleUploads_Call {
+	return &SongRepo_FailStaleUploads_Call{Call: _e.mock.On("FailStaleUploads", _a0, _a1)}
+}
+
+func (_c *SongRepo_FailStaleUploads_Call) Run(run func(_a0 context.Context, _a1 postgres.FailStaleUploadsParams)) *SongRepo_FailStaleUploads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.FailStaleUploadsParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_FailStaleUploads_Call) Return(_a0 []uuid.UUID, _a1 error) *SongRepo_FailStaleUploads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_FailStaleUploads_Call) RunAndReturn(run func(context.Context, postgres.FailStaleUploadsParams) ([]uuid.UUID, error)) *SongRepo_FailStaleUploads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishProcessing provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) FinishProcessing(_a0 context.Context, _a1 postgres.FinishProcessingParams) (postgres.Song, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishProcessing")
+	}
+
+	var r0 postgres.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.FinishProcessingParams) (postgres.Song, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.FinishProcessingParams) postgres.Song); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Song)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.FinishProcessingParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_FinishProcessing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishProcessing'
+type SongRepo_FinishProcessing_Call struct {
+	*mock.Call
+}
+
+// FinishProcessing is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.FinishProcessingParams
+func (_e *SongRepo_Expecter) FinishProcessing(_a0 interface{}, _a1 interface{}) *SongRepo_FinishProcessing_Call {
+	return &SongRepo_FinishProcessing_Call{Call: _e.mock.On("FinishProcessing", _a0, _a1)}
+}
+
+func (_c *SongRepo_FinishProcessing_Call) Run(run func(_a0 context.Context, _a1 postgres.FinishProcessingParams)) *SongRepo_FinishProcessing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.FinishProcessingParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_FinishProcessing_Call) Return(_a0 postgres.Song, _a1 error) *SongRepo_FinishProcessing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_FinishProcessing_Call) RunAndReturn(run func(context.Context, postgres.FinishProcessingParams) (postgres.Song, error)) *SongRepo_FinishProcessing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImageObjectStatus provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) ImageObjectStatus(_a0 context.Context, _a1 pgtype.Text) (postgres.ImageObjectStatusRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImageObjectStatus")
+	}
+
+	var r0 postgres.ImageObjectStatusRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (postgres.ImageObjectStatusRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) postgres.ImageObjectStatusRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.ImageObjectStatusRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_ImageObjectStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImageObjectStatus'
+type SongRepo_ImageObjectStatus_Call struct {
+	*mock.Call
+}
+
+// ImageObjectStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 pgtype.Text
+func (_e *SongRepo_Expecter) ImageObjectStatus(_a0 interface{}, _a1 interface{}) *SongRepo_ImageObjectStatus_Call {
+	return &SongRepo_ImageObjectStatus_Call{Call: _e.mock.On("ImageObjectStatus", _a0, _a1)}
+}
+
+func (_c *SongRepo_ImageObjectStatus_Call) Run(run func(_a0 context.Context, _a1 pgtype.Text)) *SongRepo_ImageObjectStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *SongRepo_ImageObjectStatus_Call) Return(_a0 postgres.ImageObjectStatusRow, _a1 error) *SongRepo_ImageObjectStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_ImageObjectStatus_Call) RunAndReturn(run func(context.Context, pgtype.Text) (postgres.ImageObjectStatusRow, error)) *SongRepo_ImageObjectStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImageUsedByOthers provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) ImageUsedByOthers(_a0 context.Context, _a1 postgres.ImageUsedByOthersParams) (bool, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImageUsedByOthers")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ImageUsedByOthersParams) (bool, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ImageUsedByOthersParams) bool); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ImageUsedByOthersParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_ImageUsedByOthers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImageUsedByOthers'
+type SongRepo_ImageUsedByOthers_Call struct {
+	*mock.Call
+}
+
+// ImageUsedByOthers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ImageUsedByOthersParams
+func (_e *SongRepo_Expecter) ImageUsedByOthers(_a0 interface{}, _a1 interface{}) *SongRepo_ImageUsedByOthers_Call {
+	return &SongRepo_ImageUsedByOthers_Call{Call: _e.mock.On("ImageUsedByOthers", _a0, _a1)}
+}
+
+func (_c *SongRepo_ImageUsedByOthers_Call) Run(run func(_a0 context.Context, _a1 postgres.ImageUsedByOthersParams)) *SongRepo_ImageUsedByOthers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ImageUsedByOthersParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_ImageUsedByOthers_Call) Return(_a0 bool, _a1 error) *SongRepo_ImageUsedByOthers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_ImageUsedByOthers_Call) RunAndReturn(run func(context.Context, postgres.ImageUsedByOthersParams) (bool, error)) *SongRepo_ImageUsedByOthers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MySong provides a mock function with given fields: _a0, _a1
 func (_m *SongRepo) MySong(_a0 context.Context, _a1 postgres.MySongParams) (postgres.MySongRow, error) {
 	ret := _m.Called(_a0, _a1)
@@ -288,6 +685,281 @@ func (_c *SongRepo_Rollback_Call) RunAndReturn(run func(context.Context) error)
 	return _c
 }
 
+// SetAudioState provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) SetAudioState(_a0 context.Context, _a1 postgres.SetAudioStateParams) (postgres.Song, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAudioState")
+	}
+
+	var r0 postgres.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SetAudioStateParams) (postgres.Song, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SetAudioStateParams) postgres.Song); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Song)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.SetAudioStateParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_SetAudioState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAudioState'
+type SongRepo_SetAudioState_Call struct {
+	*mock.Call
+}
+
+// SetAudioState is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SetAudioStateParams
+func (_e *SongRepo_Expecter) SetAudioState(_a0 interface{}, _a1 interface{}) *SongRepo_SetAudioState_Call {
+	return &SongRepo_SetAudioState_Call{Call: _e.mock.On("SetAudioState", _a0, _a1)}
+}
+
+func (_c *SongRepo_SetAudioState_Call) Run(run func(_a0 context.Context, _a1 postgres.SetAudioStateParams)) *SongRepo_SetAudioState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SetAudioStateParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_SetAudioState_Call) Return(_a0 postgres.Song, _a1 error) *SongRepo_SetAudioState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_SetAudioState_Call) RunAndReturn(run func(context.Context, postgres.SetAudioStateParams) (postgres.Song, error)) *SongRepo_SetAudioState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetImageThumbnail provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) SetImageThumbnail(_a0 context.Context, _a1 postgres.SetImageThumbnailParams) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetImageThumbnail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SetImageThumbnailParams) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SongRepo_SetImageThumbnail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetImageThumbnail'
+type SongRepo_SetImageThumbnail_Call struct {
+	*mock.Call
+}
+
+// SetImageThumbnail is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SetImageThumbnailParams
+func (_e *SongRepo_Expecter) SetImageThumbnail(_a0 interface{}, _a1 interface{}) *SongRepo_SetImageThumbnail_Call {
+	return &SongRepo_SetImageThumbnail_Call{Call: _e.mock.On("SetImageThumbnail", _a0, _a1)}
+}
+
+func (_c *SongRepo_SetImageThumbnail_Call) Run(run func(_a0 context.Context, _a1 postgres.SetImageThumbnailParams)) *SongRepo_SetImageThumbnail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SetImageThumbnailParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_SetImageThumbnail_Call) Return(_a0 error) *SongRepo_SetImageThumbnail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SongRepo_SetImageThumbnail_Call) RunAndReturn(run func(context.Context, postgres.SetImageThumbnailParams) error) *SongRepo_SetImageThumbnail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SongObjectStatus provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) SongObjectStatus(_a0 context.Context, _a1 pgtype.Text) (postgres.SongObjectStatusRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SongObjectStatus")
+	}
+
+	var r0 postgres.SongObjectStatusRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (postgres.SongObjectStatusRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) postgres.SongObjectStatusRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.SongObjectStatusRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_SongObjectStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SongObjectStatus'
+type SongRepo_SongObjectStatus_Call struct {
+	*mock.Call
+}
+
+// SongObjectStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 pgtype.Text
+func (_e *SongRepo_Expecter) SongObjectStatus(_a0 interface{}, _a1 interface{}) *SongRepo_SongObjectStatus_Call {
+	return &SongRepo_SongObjectStatus_Call{Call: _e.mock.On("SongObjectStatus", _a0, _a1)}
+}
+
+func (_c *SongRepo_SongObjectStatus_Call) Run(run func(_a0 context.Context, _a1 pgtype.Text)) *SongRepo_SongObjectStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *SongRepo_SongObjectStatus_Call) Return(_a0 postgres.SongObjectStatusRow, _a1 error) *SongRepo_SongObjectStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_SongObjectStatus_Call) RunAndReturn(run func(context.Context, pgtype.Text) (postgres.SongObjectStatusRow, error)) *SongRepo_SongObjectStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SongPreviewStatus provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) SongPreviewStatus(_a0 context.Context, _a1 uuid.UUID) (postgres.SongPreviewStatusRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SongPreviewStatus")
+	}
+
+	var r0 postgres.SongPreviewStatusRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (postgres.SongPreviewStatusRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) postgres.SongPreviewStatusRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.SongPreviewStatusRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_SongPreviewStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SongPreviewStatus'
+type SongRepo_SongPreviewStatus_Call struct {
+	*mock.Call
+}
+
+// SongPreviewStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *SongRepo_Expecter) SongPreviewStatus(_a0 interface{}, _a1 interface{}) *SongRepo_SongPreviewStatus_Call {
+	return &SongRepo_SongPreviewStatus_Call{Call: _e.mock.On("SongPreviewStatus", _a0, _a1)}
+}
+
+func (_c *SongRepo_SongPreviewStatus_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *SongRepo_SongPreviewStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SongRepo_SongPreviewStatus_Call) Return(_a0 postgres.SongPreviewStatusRow, _a1 error) *SongRepo_SongPreviewStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_SongPreviewStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID) (postgres.SongPreviewStatusRow, error)) *SongRepo_SongPreviewStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartProcessing provides a mock function with given fields: ctx, staleBefore
+func (_m *SongRepo) StartProcessing(ctx context.Context, staleBefore pgtype.Timestamptz) (postgres.Song, error) {
+	ret := _m.Called(ctx, staleBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartProcessing")
+	}
+
+	var r0 postgres.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) (postgres.Song, error)); ok {
+		return rf(ctx, staleBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) postgres.Song); ok {
+		r0 = rf(ctx, staleBefore)
+	} else {
+		r0 = ret.Get(0).(postgres.Song)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, staleBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_StartProcessing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartProcessing'
+type SongRepo_StartProcessing_Call struct {
+	*mock.Call
+}
+
+// StartProcessing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - staleBefore pgtype.Timestamptz
+func (_e *SongRepo_Expecter) StartProcessing(ctx interface{}, staleBefore interface{}) *SongRepo_StartProcessing_Call {
+	return &SongRepo_StartProcessing_Call{Call: _e.mock.On("StartProcessing", ctx, staleBefore)}
+}
+
+func (_c *SongRepo_StartProcessing_Call) Run(run func(ctx context.Context, staleBefore pgtype.Timestamptz)) *SongRepo_StartProcessing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *SongRepo_StartProcessing_Call) Return(_a0 postgres.Song, _a1 error) *SongRepo_StartProcessing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_StartProcessing_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) (postgres.Song, error)) *SongRepo_StartProcessing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSongRepo creates a new instance of SongRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSongRepo(t interface {
@@ -4,6 +4,9 @@ package rawmocks
 
 import (
 	context "context"
+
+	audiodecoder "github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+
 	io "io"
 
 	mock "github.com/stretchr/testify/mock"
@@ -24,23 +27,139 @@ func (_m *SoundDecoder) EXPECT() *SoundDecoder_Expecter {
 	return &SoundDecoder_Expecter{mock: &_m.Mock}
 }
 
-// GetMp3Duration provides a mock function with given fields: _a0, _a1
-func (_m *SoundDecoder) GetMp3Duration(_a0 context.Context, _a1 io.Reader) (time.Duration, error) {
-	ret := _m.Called(_a0, _a1)
+// CutMp3 provides a mock function with given fields: ctx, r, w, clip
+func (_m *SoundDecoder) CutMp3(ctx context.Context, r io.Reader, w io.Writer, clip audiodecoder.Clip) (time.Duration, error) {
+	ret := _m.Called(ctx, r, w, clip)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMp3Duration")
+		panic("no return value specified for CutMp3")
 	}
 
 	var r0 time.Duration
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) (time.Duration, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, io.Writer, audiodecoder.Clip) (time.Duration, error)); ok {
+		return rf(ctx, r, w, clip)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, io.Writer, audiodecoder.Clip) time.Duration); ok {
+		r0 = rf(ctx, r, w, clip)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader, io.Writer, audiodecoder.Clip) error); ok {
+		r1 = rf(ctx, r, w, clip)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoundDecoder_CutMp3_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CutMp3'
+type SoundDecoder_CutMp3_Call struct {
+	*mock.Call
+}
+
+// CutMp3 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r io.Reader
+//   - w io.Writer
+//   - clip audiodecoder.Clip
+func (_e *SoundDecoder_Expecter) CutMp3(ctx interface{}, r interface{}, w interface{}, clip interface{}) *SoundDecoder_CutMp3_Call {
+	return &SoundDecoder_CutMp3_Call{Call: _e.mock.On("CutMp3", ctx, r, w, clip)}
+}
+
+func (_c *SoundDecoder_CutMp3_Call) Run(run func(ctx context.Context, r io.Reader, w io.Writer, clip audiodecoder.Clip)) *SoundDecoder_CutMp3_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Reader), args[2].(io.Writer), args[3].(audiodecoder.Clip))
+	})
+	return _c
+}
+
+func (_c *SoundDecoder_CutMp3_Call) Return(_a0 time.Duration, _a1 error) *SoundDecoder_CutMp3_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SoundDecoder_CutMp3_Call) RunAndReturn(run func(context.Context, io.Reader, io.Writer, audiodecoder.Clip) (time.Duration, error)) *SoundDecoder_CutMp3_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMp3Advisory provides a mock function with given fields: _a0, _a1
+func (_m *SoundDecoder) GetMp3Advisory(_a0 context.Context, _a1 io.Reader) (audiodecoder.Advisory, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMp3Advisory")
+	}
+
+	var r0 audiodecoder.Advisory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) (audiodecoder.Advisory, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) time.Duration); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) audiodecoder.Advisory); ok {
 		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(audiodecoder.Advisory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoundDecoder_GetMp3Advisory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMp3Advisory'
+type SoundDecoder_GetMp3Advisory_Call struct {
+	*mock.Call
+}
+
+// GetMp3Advisory is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 io.Reader
+func (_e *SoundDecoder_Expecter) GetMp3Advisory(_a0 interface{}, _a1 interface{}) *SoundDecoder_GetMp3Advisory_Call {
+	return &SoundDecoder_GetMp3Advisory_Call{Call: _e.mock.On("GetMp3Advisory", _a0, _a1)}
+}
+
+func (_c *SoundDecoder_GetMp3Advisory_Call) Run(run func(_a0 context.Context, _a1 io.Reader)) *SoundDecoder_GetMp3Advisory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *SoundDecoder_GetMp3Advisory_Call) Return(_a0 audiodecoder.Advisory, _a1 error) *SoundDecoder_GetMp3Advisory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SoundDecoder_GetMp3Advisory_Call) RunAndReturn(run func(context.Context, io.Reader) (audiodecoder.Advisory, error)) *SoundDecoder_GetMp3Advisory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMp3Info provides a mock function with given fields: _a0, _a1
+func (_m *SoundDecoder) GetMp3Info(_a0 context.Context, _a1 io.Reader) (audiodecoder.Mp3Info, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMp3Info")
+	}
+
+	var r0 audiodecoder.Mp3Info
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) (audiodecoder.Mp3Info, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) audiodecoder.Mp3Info); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(audiodecoder.Mp3Info)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, io.Reader) error); ok {
@@ -52,31 +171,91 @@ func (_m *SoundDecoder) GetMp3Duration(_a0 context.Context, _a1 io.Reader) (time
 	return r0, r1
 }
 
-// SoundDecoder_GetMp3Duration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMp3Duration'
-type SoundDecoder_GetMp3Duration_Call struct {
+// SoundDecoder_GetMp3Info_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMp3Info'
+type SoundDecoder_GetMp3Info_Call struct {
 	*mock.Call
 }
 
-// GetMp3Duration is a helper method to define mock.On call
+// GetMp3Info is a helper method to define mock.On call
 //   - _a0 context.Context
 //   - _a1 io.Reader
-func (_e *SoundDecoder_Expecter) GetMp3Duration(_a0 interface{}, _a1 interface{}) *SoundDecoder_GetMp3Duration_Call {
-	return &SoundDecoder_GetMp3Duration_Call{Call: _e.mock.On("GetMp3Duration", _a0, _a1)}
+func (_e *SoundDecoder_Expecter) GetMp3Info(_a0 interface{}, _a1 interface{}) *SoundDecoder_GetMp3Info_Call {
+	return &SoundDecoder_GetMp3Info_Call{Call: _e.mock.On("GetMp3Info", _a0, _a1)}
 }
 
-func (_c *SoundDecoder_GetMp3Duration_Call) Run(run func(_a0 context.Context, _a1 io.Reader)) *SoundDecoder_GetMp3Duration_Call {
+func (_c *SoundDecoder_GetMp3Info_Call) Run(run func(_a0 context.Context, _a1 io.Reader)) *SoundDecoder_GetMp3Info_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(io.Reader))
 	})
 	return _c
 }
 
-func (_c *SoundDecoder_GetMp3Duration_Call) Return(_a0 time.Duration, _a1 error) *SoundDecoder_GetMp3Duration_Call {
+func (_c *SoundDecoder_GetMp3Info_Call) Return(_a0 audiodecoder.Mp3Info, _a1 error) *SoundDecoder_GetMp3Info_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SoundDecoder_GetMp3Info_Call) RunAndReturn(run func(context.Context, io.Reader) (audiodecoder.Mp3Info, error)) *SoundDecoder_GetMp3Info_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMp3Waveform provides a mock function with given fields: ctx, r, n
+func (_m *SoundDecoder) GetMp3Waveform(ctx context.Context, r io.Reader, n int) ([]int, error) {
+	ret := _m.Called(ctx, r, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMp3Waveform")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, int) ([]int, error)); ok {
+		return rf(ctx, r, n)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, int) []int); ok {
+		r0 = rf(ctx, r, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader, int) error); ok {
+		r1 = rf(ctx, r, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoundDecoder_GetMp3Waveform_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMp3Waveform'
+type SoundDecoder_GetMp3Waveform_Call struct {
+	*mock.Call
+}
+
+// GetMp3Waveform is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r io.Reader
+//   - n int
+func (_e *SoundDecoder_Expecter) GetMp3Waveform(ctx interface{}, r interface{}, n interface{}) *SoundDecoder_GetMp3Waveform_Call {
+	return &SoundDecoder_GetMp3Waveform_Call{Call: _e.mock.On("GetMp3Waveform", ctx, r, n)}
+}
+
+func (_c *SoundDecoder_GetMp3Waveform_Call) Run(run func(ctx context.Context, r io.Reader, n int)) *SoundDecoder_GetMp3Waveform_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Reader), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SoundDecoder_GetMp3Waveform_Call) Return(_a0 []int, _a1 error) *SoundDecoder_GetMp3Waveform_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *SoundDecoder_GetMp3Duration_Call) RunAndReturn(run func(context.Context, io.Reader) (time.Duration, error)) *SoundDecoder_GetMp3Duration_Call {
+func (_c *SoundDecoder_GetMp3Waveform_Call) RunAndReturn(run func(context.Context, io.Reader, int) ([]int, error)) *SoundDecoder_GetMp3Waveform_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,86 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package rawmocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Thumbnailer is an autogenerated mock type for the Thumbnailer type
+type Thumbnailer struct {
+	mock.Mock
+}
+
+type Thumbnailer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Thumbnailer) EXPECT() *Thumbnailer_Expecter {
+	return &Thumbnailer_Expecter{mock: &_m.Mock}
+}
+
+// MakeThumbnail provides a mock function with given fields: ctx, r, w, size
+func (_m *Thumbnailer) MakeThumbnail(ctx context.Context, r io.Reader, w io.Writer, size int) error {
+	ret := _m.Called(ctx, r, w, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeThumbnail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, io.Writer, int) error); ok {
+		r0 = rf(ctx, r, w, size)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Thumbnailer_MakeThumbnail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeThumbnail'
+type Thumbnailer_MakeThumbnail_Call struct {
+	*mock.Call
+}
+
+// MakeThumbnail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r io.Reader
+//   - w io.Writer
+//   - size int
+func (_e *Thumbnailer_Expecter) MakeThumbnail(ctx interface{}, r interface{}, w interface{}, size interface{}) *Thumbnailer_MakeThumbnail_Call {
+	return &Thumbnailer_MakeThumbnail_Call{Call: _e.mock.On("MakeThumbnail", ctx, r, w, size)}
+}
+
+func (_c *Thumbnailer_MakeThumbnail_Call) Run(run func(ctx context.Context, r io.Reader, w io.Writer, size int)) *Thumbnailer_MakeThumbnail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Reader), args[2].(io.Writer), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *Thumbnailer_MakeThumbnail_Call) Return(_a0 error) *Thumbnailer_MakeThumbnail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Thumbnailer_MakeThumbnail_Call) RunAndReturn(run func(context.Context, io.Reader, io.Writer, int) error) *Thumbnailer_MakeThumbnail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewThumbnailer creates a new instance of Thumbnailer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewThumbnailer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Thumbnailer {
+	mock := &Thumbnailer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,263 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package rawmocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// UploadLimiter is an autogenerated mock type for the UploadLimiter type
+type UploadLimiter struct {
+	mock.Mock
+}
+
+type UploadLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UploadLimiter) EXPECT() *UploadLimiter_Expecter {
+	return &UploadLimiter_Expecter{mock: &_m.Mock}
+}
+
+// AcquireUploadSlot provides a mock function with given fields: ctx, artistId, uploadId, ttl
+func (_m *UploadLimiter) AcquireUploadSlot(ctx context.Context, artistId uuid.UUID, uploadId string, ttl time.Duration) (int64, error) {
+	ret := _m.Called(ctx, artistId, uploadId, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireUploadSlot")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Duration) (int64, error)); ok {
+		return rf(ctx, artistId, uploadId, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Duration) int64); ok {
+		r0 = rf(ctx, artistId, uploadId, ttl)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Duration) error); ok {
+		r1 = rf(ctx, artistId, uploadId, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadLimiter_AcquireUploadSlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireUploadSlot'
+type UploadLimiter_AcquireUploadSlot_Call struct {
+	*mock.Call
+}
+
+// AcquireUploadSlot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artistId uuid.UUID
+//   - uploadId string
+//   - ttl time.Duration
+func (_e *UploadLimiter_Expecter) AcquireUploadSlot(ctx interface{}, artistId interface{}, uploadId interface{}, ttl interface{}) *UploadLimiter_AcquireUploadSlot_Call {
+	return &UploadLimiter_AcquireUploadSlot_Call{Call: _e.mock.On("AcquireUploadSlot", ctx, artistId, uploadId, ttl)}
+}
+
+func (_c *UploadLimiter_AcquireUploadSlot_Call) Run(run func(ctx context.Context, artistId uuid.UUID, uploadId string, ttl time.Duration)) *UploadLimiter_AcquireUploadSlot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *UploadLimiter_AcquireUploadSlot_Call) Return(_a0 int64, _a1 error) *UploadLimiter_AcquireUploadSlot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadLimiter_AcquireUploadSlot_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Duration) (int64, error)) *UploadLimiter_AcquireUploadSlot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountImportUpload provides a mock function with given fields: ctx, artistId, window
+func (_m *UploadLimiter) CountImportUpload(ctx context.Context, artistId uuid.UUID, window time.Duration) (int64, error) {
+	ret := _m.Called(ctx, artistId, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountImportUpload")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) (int64, error)); ok {
+		return rf(ctx, artistId, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) int64); ok {
+		r0 = rf(ctx, artistId, window)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Duration) error); ok {
+		r1 = rf(ctx, artistId, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadLimiter_CountImportUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountImportUpload'
+type UploadLimiter_CountImportUpload_Call struct {
+	*mock.Call
+}
+
+// CountImportUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artistId uuid.UUID
+//   - window time.Duration
+func (_e *UploadLimiter_Expecter) CountImportUpload(ctx interface{}, artistId interface{}, window interface{}) *UploadLimiter_CountImportUpload_Call {
+	return &UploadLimiter_CountImportUpload_Call{Call: _e.mock.On("CountImportUpload", ctx, artistId, window)}
+}
+
+func (_c *UploadLimiter_CountImportUpload_Call) Run(run func(ctx context.Context, artistId uuid.UUID, window time.Duration)) *UploadLimiter_CountImportUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *UploadLimiter_CountImportUpload_Call) Return(_a0 int64, _a1 error) *UploadLimiter_CountImportUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadLimiter_CountImportUpload_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Duration) (int64, error)) *UploadLimiter_CountImportUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountUpload provides a mock function with given fields: ctx, artistId, window
+func (_m *UploadLimiter) CountUpload(ctx context.Context, artistId uuid.UUID, window time.Duration) (int64, error) {
+	ret := _m.Called(ctx, artistId, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUpload")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) (int64, error)); ok {
+		return rf(ctx, artistId, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) int64); ok {
+		r0 = rf(ctx, artistId, window)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Duration) error); ok {
+		r1 = rf(ctx, artistId, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadLimiter_CountUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUpload'
+type UploadLimiter_CountUpload_Call struct {
+	*mock.Call
+}
+
+// CountUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artistId uuid.UUID
+//   - window time.Duration
+func (_e *UploadLimiter_Expecter) CountUpload(ctx interface{}, artistId interface{}, window interface{}) *UploadLimiter_CountUpload_Call {
+	return &UploadLimiter_CountUpload_Call{Call: _e.mock.On("CountUpload", ctx, artistId, window)}
+}
+
+func (_c *UploadLimiter_CountUpload_Call) Run(run func(ctx context.Context, artistId uuid.UUID, window time.Duration)) *UploadLimiter_CountUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *UploadLimiter_CountUpload_Call) Return(_a0 int64, _a1 error) *UploadLimiter_CountUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadLimiter_CountUpload_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Duration) (int64, error)) *UploadLimiter_CountUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseUploadSlot provides a mock function with given fields: ctx, artistId, uploadId
+func (_m *UploadLimiter) ReleaseUploadSlot(ctx context.Context, artistId uuid.UUID, uploadId string) error {
+	ret := _m.Called(ctx, artistId, uploadId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseUploadSlot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, artistId, uploadId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadLimiter_ReleaseUploadSlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseUploadSlot'
+type UploadLimiter_ReleaseUploadSlot_Call struct {
+	*mock.Call
+}
+
+// ReleaseUploadSlot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artistId uuid.UUID
+//   - uploadId string
+func (_e *UploadLimiter_Expecter) ReleaseUploadSlot(ctx interface{}, artistId interface{}, uploadId interface{}) *UploadLimiter_ReleaseUploadSlot_Call {
+	return &UploadLimiter_ReleaseUploadSlot_Call{Call: _e.mock.On("ReleaseUploadSlot", ctx, artistId, uploadId)}
+}
+
+func (_c *UploadLimiter_ReleaseUploadSlot_Call) Run(run func(ctx context.Context, artistId uuid.UUID, uploadId string)) *UploadLimiter_ReleaseUploadSlot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UploadLimiter_ReleaseUploadSlot_Call) Return(_a0 error) *UploadLimiter_ReleaseUploadSlot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadLimiter_ReleaseUploadSlot_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *UploadLimiter_ReleaseUploadSlot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUploadLimiter creates a new instance of UploadLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUploadLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UploadLimiter {
+	mock := &UploadLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,142 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package rawmocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	url "net/url"
+)
+
+// UrlSigner is an autogenerated mock type for the UrlSigner type
+type UrlSigner struct {
+	mock.Mock
+}
+
+type UrlSigner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UrlSigner) EXPECT() *UrlSigner_Expecter {
+	return &UrlSigner_Expecter{mock: &_m.Mock}
+}
+
+// Sign provides a mock function with given fields: kind, objectId, listenerId
+func (_m *UrlSigner) Sign(kind string, objectId string, listenerId string) string {
+	ret := _m.Called(kind, objectId, listenerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sign")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(kind, objectId, listenerId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// UrlSigner_Sign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sign'
+type UrlSigner_Sign_Call struct {
+	*mock.Call
+}
+
+// Sign is a helper method to define mock.On call
+//   - kind string
+//   - objectId string
+//   - listenerId string
+func (_e *UrlSigner_Expecter) Sign(kind interface{}, objectId interface{}, listenerId interface{}) *UrlSigner_Sign_Call {
+	return &UrlSigner_Sign_Call{Call: _e.mock.On("Sign", kind, objectId, listenerId)}
+}
+
+func (_c *UrlSigner_Sign_Call) Run(run func(kind string, objectId string, listenerId string)) *UrlSigner_Sign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UrlSigner_Sign_Call) Return(_a0 string) *UrlSigner_Sign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UrlSigner_Sign_Call) RunAndReturn(run func(string, string, string) string) *UrlSigner_Sign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: kind, objectId, query
+func (_m *UrlSigner) Verify(kind string, objectId string, query url.Values) (string, error) {
+	ret := _m.Called(kind, objectId, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, url.Values) (string, error)); ok {
+		return rf(kind, objectId, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, url.Values) string); ok {
+		r0 = rf(kind, objectId, query)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, url.Values) error); ok {
+		r1 = rf(kind, objectId, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UrlSigner_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type UrlSigner_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - kind string
+//   - objectId string
+//   - query url.Values
+func (_e *UrlSigner_Expecter) Verify(kind interface{}, objectId interface{}, query interface{}) *UrlSigner_Verify_Call {
+	return &UrlSigner_Verify_Call{Call: _e.mock.On("Verify", kind, objectId, query)}
+}
+
+func (_c *UrlSigner_Verify_Call) Run(run func(kind string, objectId string, query url.Values)) *UrlSigner_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(url.Values))
+	})
+	return _c
+}
+
+func (_c *UrlSigner_Verify_Call) Return(_a0 string, _a1 error) *UrlSigner_Verify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UrlSigner_Verify_Call) RunAndReturn(run func(string, string, url.Values) (string, error)) *UrlSigner_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUrlSigner creates a new instance of UrlSigner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUrlSigner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UrlSigner {
+	mock := &UrlSigner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,88 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package reconcilemocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ImageObjects is an autogenerated mock type for the ImageObjects type
+type ImageObjects struct {
+	mock.Mock
+}
+
+type ImageObjects_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ImageObjects) EXPECT() *ImageObjects_Expecter {
+	return &ImageObjects_Expecter{mock: &_m.Mock}
+}
+
+// ImageObjectId provides a mock function with given fields: imageUrl
+func (_m *ImageObjects) ImageObjectId(imageUrl string) (string, bool) {
+	ret := _m.Called(imageUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImageObjectId")
+	}
+
+	var r0 string
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (string, bool)); ok {
+		return rf(imageUrl)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(imageUrl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(imageUrl)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// ImageObjects_ImageObjectId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImageObjectId'
+type ImageObjects_ImageObjectId_Call struct {
+	*mock.Call
+}
+
+// ImageObjectId is a helper method to define mock.On call
+//   - imageUrl string
+func (_e *ImageObjects_Expecter) ImageObjectId(imageUrl interface{}) *ImageObjects_ImageObjectId_Call {
+	return &ImageObjects_ImageObjectId_Call{Call: _e.mock.On("ImageObjectId", imageUrl)}
+}
+
+func (_c *ImageObjects_ImageObjectId_Call) Run(run func(imageUrl string)) *ImageObjects_ImageObjectId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ImageObjects_ImageObjectId_Call) Return(_a0 string, _a1 bool) *ImageObjects_ImageObjectId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ImageObjects_ImageObjectId_Call) RunAndReturn(run func(string) (string, bool)) *ImageObjects_ImageObjectId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewImageObjects creates a new instance of ImageObjects. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImageObjects(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImageObjects {
+	mock := &ImageObjects{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
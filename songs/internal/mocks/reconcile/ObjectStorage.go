@@ -0,0 +1,225 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package reconcilemocks
+
+import (
+	context "context"
+
+	objstore "github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ObjectStorage is an autogenerated mock type for the ObjectStorage type
+type ObjectStorage struct {
+	mock.Mock
+}
+
+type ObjectStorage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ObjectStorage) EXPECT() *ObjectStorage_Expecter {
+	return &ObjectStorage_Expecter{mock: &_m.Mock}
+}
+
+// DeleteImageObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) DeleteImageObject(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteImageObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_DeleteImageObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteImageObject'
+type ObjectStorage_DeleteImageObject_Call struct {
+	*mock.Call
+}
+
+// DeleteImageObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) DeleteImageObject(ctx interface{}, id interface{}) *ObjectStorage_DeleteImageObject_Call {
+	return &ObjectStorage_DeleteImageObject_Call{Call: _e.mock.On("DeleteImageObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_DeleteImageObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_DeleteImageObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteImageObject_Call) Return(_a0 error) *ObjectStorage_DeleteImageObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteImageObject_Call) RunAndReturn(run func(context.Context, string) error) *ObjectStorage_DeleteImageObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSongObject provides a mock function with given fields: ctx, id
+func (_m *ObjectStorage) DeleteSongObject(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSongObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_DeleteSongObject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSongObject'
+type ObjectStorage_DeleteSongObject_Call struct {
+	*mock.Call
+}
+
+// DeleteSongObject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ObjectStorage_Expecter) DeleteSongObject(ctx interface{}, id interface{}) *ObjectStorage_DeleteSongObject_Call {
+	return &ObjectStorage_DeleteSongObject_Call{Call: _e.mock.On("DeleteSongObject", ctx, id)}
+}
+
+func (_c *ObjectStorage_DeleteSongObject_Call) Run(run func(ctx context.Context, id string)) *ObjectStorage_DeleteSongObject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteSongObject_Call) Return(_a0 error) *ObjectStorage_DeleteSongObject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_DeleteSongObject_Call) RunAndReturn(run func(context.Context, string) error) *ObjectStorage_DeleteSongObject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListImageObjects provides a mock function with given fields: ctx, fn
+func (_m *ObjectStorage) ListImageObjects(ctx context.Context, fn func(objstore.ObjectInfo) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListImageObjects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(objstore.ObjectInfo) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_ListImageObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImageObjects'
+type ObjectStorage_ListImageObjects_Call struct {
+	*mock.Call
+}
+
+// ListImageObjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(objstore.ObjectInfo) error
+func (_e *ObjectStorage_Expecter) ListImageObjects(ctx interface{}, fn interface{}) *ObjectStorage_ListImageObjects_Call {
+	return &ObjectStorage_ListImageObjects_Call{Call: _e.mock.On("ListImageObjects", ctx, fn)}
+}
+
+func (_c *ObjectStorage_ListImageObjects_Call) Run(run func(ctx context.Context, fn func(objstore.ObjectInfo) error)) *ObjectStorage_ListImageObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(objstore.ObjectInfo) error))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_ListImageObjects_Call) Return(_a0 error) *ObjectStorage_ListImageObjects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_ListImageObjects_Call) RunAndReturn(run func(context.Context, func(objstore.ObjectInfo) error) error) *ObjectStorage_ListImageObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSongObjects provides a mock function with given fields: ctx, fn
+func (_m *ObjectStorage) ListSongObjects(ctx context.Context, fn func(objstore.ObjectInfo) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSongObjects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(objstore.ObjectInfo) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ObjectStorage_ListSongObjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSongObjects'
+type ObjectStorage_ListSongObjects_Call struct {
+	*mock.Call
+}
+
+// ListSongObjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(objstore.ObjectInfo) error
+func (_e *ObjectStorage_Expecter) ListSongObjects(ctx interface{}, fn interface{}) *ObjectStorage_ListSongObjects_Call {
+	return &ObjectStorage_ListSongObjects_Call{Call: _e.mock.On("ListSongObjects", ctx, fn)}
+}
+
+func (_c *ObjectStorage_ListSongObjects_Call) Run(run func(ctx context.Context, fn func(objstore.ObjectInfo) error)) *ObjectStorage_ListSongObjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(objstore.ObjectInfo) error))
+	})
+	return _c
+}
+
+func (_c *ObjectStorage_ListSongObjects_Call) Return(_a0 error) *ObjectStorage_ListSongObjects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ObjectStorage_ListSongObjects_Call) RunAndReturn(run func(context.Context, func(objstore.ObjectInfo) error) error) *ObjectStorage_ListSongObjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewObjectStorage creates a new instance of ObjectStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewObjectStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ObjectStorage {
+	mock := &ObjectStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package reconcilemocks
+
+import (
+	context "context"
+
+	postgres "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SongRepo is an autogenerated mock type for the SongRepo type
+type SongRepo struct {
+	mock.Mock
+}
+
+type SongRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SongRepo) EXPECT() *SongRepo_Expecter {
+	return &SongRepo_Expecter{mock: &_m.Mock}
+}
+
+// SongObjectRefs provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) SongObjectRefs(_a0 context.Context, _a1 postgres.SongObjectRefsParams) ([]postgres.SongObjectRefsRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SongObjectRefs")
+	}
+
+	var r0 []postgres.SongObjectRefsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SongObjectRefsParams) ([]postgres.SongObjectRefsRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SongObjectRefsParams) []postgres.SongObjectRefsRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.SongObjectRefsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.SongObjectRefsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_SongObjectRefs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SongObjectRefs'
+type SongRepo_SongObjectRefs_Call struct {
+	*mock.Call
+}
+
+// SongObjectRefs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SongObjectRefsParams
+func (_e *SongRepo_Expecter) SongObjectRefs(_a0 interface{}, _a1 interface{}) *SongRepo_SongObjectRefs_Call {
+	return &SongRepo_SongObjectRefs_Call{Call: _e.mock.On("SongObjectRefs", _a0, _a1)}
+}
+
+func (_c *SongRepo_SongObjectRefs_Call) Run(run func(_a0 context.Context, _a1 postgres.SongObjectRefsParams)) *SongRepo_SongObjectRefs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SongObjectRefsParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_SongObjectRefs_Call) Return(_a0 []postgres.SongObjectRefsRow, _a1 error) *SongRepo_SongObjectRefs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_SongObjectRefs_Call) RunAndReturn(run func(context.Context, postgres.SongObjectRefsParams) ([]postgres.SongObjectRefsRow, error)) *SongRepo_SongObjectRefs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSongRepo creates a new instance of SongRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSongRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SongRepo {
+	mock := &SongRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
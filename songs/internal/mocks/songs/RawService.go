@@ -2,7 +2,11 @@
 
 package songsmocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
 
 // RawService is an autogenerated mock type for the RawService type
 type RawService struct {
@@ -17,17 +21,158 @@ func (_m *RawService) EXPECT() *RawService_Expecter {
 	return &RawService_Expecter{mock: &_m.Mock}
 }
 
-// SongUrl provides a mock function with given fields: rawSongId
-func (_m *RawService) SongUrl(rawSongId string) string {
-	ret := _m.Called(rawSongId)
+// PreviewUrl provides a mock function with given fields: songId
+func (_m *RawService) PreviewUrl(songId uuid.UUID) string {
+	ret := _m.Called(songId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewUrl")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(uuid.UUID) string); ok {
+		r0 = rf(songId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// RawService_PreviewUrl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewUrl'
+type RawService_PreviewUrl_Call struct {
+	*mock.Call
+}
+
+// PreviewUrl is a helper method to define mock.On call
+//   - songId uuid.UUID
+func (_e *RawService_Expecter) PreviewUrl(songId interface{}) *RawService_PreviewUrl_Call {
+	return &RawService_PreviewUrl_Call{Call: _e.mock.On("PreviewUrl", songId)}
+}
+
+func (_c *RawService_PreviewUrl_Call) Run(run func(songId uuid.UUID)) *RawService_PreviewUrl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RawService_PreviewUrl_Call) Return(_a0 string) *RawService_PreviewUrl_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RawService_PreviewUrl_Call) RunAndReturn(run func(uuid.UUID) string) *RawService_PreviewUrl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignImageUrl provides a mock function with given fields: imageUrl, listenerId
+func (_m *RawService) SignImageUrl(imageUrl string, listenerId string) string {
+	ret := _m.Called(imageUrl, listenerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignImageUrl")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(imageUrl, listenerId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// RawService_SignImageUrl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignImageUrl'
+type RawService_SignImageUrl_Call struct {
+	*mock.Call
+}
+
+// SignImageUrl is a helper method to define mock.On call
+//   - imageUrl string
+//   - listenerId string
+func (_e *RawService_Expecter) SignImageUrl(imageUrl interface{}, listenerId interface{}) *RawService_SignImageUrl_Call {
+	return &RawService_SignImageUrl_Call{Call: _e.mock.On("SignImageUrl", imageUrl, listenerId)}
+}
+
+func (_c *RawService_SignImageUrl_Call) Run(run func(imageUrl string, listenerId string)) *RawService_SignImageUrl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RawService_SignImageUrl_Call) Return(_a0 string) *RawService_SignImageUrl_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RawService_SignImageUrl_Call) RunAndReturn(run func(string, string) string) *RawService_SignImageUrl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignThumbnailUrl provides a mock function with given fields: thumbnailUrl, imageUrl, listenerId
+func (_m *RawService) SignThumbnailUrl(thumbnailUrl string, imageUrl string, listenerId string) string {
+	ret := _m.Called(thumbnailUrl, imageUrl, listenerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignThumbnailUrl")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(thumbnailUrl, imageUrl, listenerId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// RawService_SignThumbnailUrl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignThumbnailUrl'
+type RawService_SignThumbnailUrl_Call struct {
+	*mock.Call
+}
+
+// SignThumbnailUrl is a helper method to define mock.On call
+//   - thumbnailUrl string
+//   - imageUrl string
+//   - listenerId string
+func (_e *RawService_Expecter) SignThumbnailUrl(thumbnailUrl interface{}, imageUrl interface{}, listenerId interface{}) *RawService_SignThumbnailUrl_Call {
+	return &RawService_SignThumbnailUrl_Call{Call: _e.mock.On("SignThumbnailUrl", thumbnailUrl, imageUrl, listenerId)}
+}
+
+func (_c *RawService_SignThumbnailUrl_Call) Run(run func(thumbnailUrl string, imageUrl string, listenerId string)) *RawService_SignThumbnailUrl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RawService_SignThumbnailUrl_Call) Return(_a0 string) *RawService_SignThumbnailUrl_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RawService_SignThumbnailUrl_Call) RunAndReturn(run func(string, string, string) string) *RawService_SignThumbnailUrl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SongUrl provides a mock function with given fields: rawSongId, listenerId
+func (_m *RawService) SongUrl(rawSongId string, listenerId string) string {
+	ret := _m.Called(rawSongId, listenerId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SongUrl")
 	}
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(rawSongId)
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(rawSongId, listenerId)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
@@ -42,13 +187,14 @@ type RawService_SongUrl_Call struct {
 
 // SongUrl is a helper method to define mock.On call
 //   - rawSongId string
-func (_e *RawService_Expecter) SongUrl(rawSongId interface{}) *RawService_SongUrl_Call {
-	return &RawService_SongUrl_Call{Call: _e.mock.On("SongUrl", rawSongId)}
+//   - listenerId string
+func (_e *RawService_Expecter) SongUrl(rawSongId interface{}, listenerId interface{}) *RawService_SongUrl_Call {
+	return &RawService_SongUrl_Call{Call: _e.mock.On("SongUrl", rawSongId, listenerId)}
 }
 
-func (_c *RawService_SongUrl_Call) Run(run func(rawSongId string)) *RawService_SongUrl_Call {
+func (_c *RawService_SongUrl_Call) Run(run func(rawSongId string, listenerId string)) *RawService_SongUrl_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
@@ -58,7 +204,7 @@ func (_c *RawService_SongUrl_Call) Return(_a0 string) *RawService_SongUrl_Call {
 	return _c
 }
 
-func (_c *RawService_SongUrl_Call) RunAndReturn(run func(string) string) *RawService_SongUrl_Call {
+func (_c *RawService_SongUrl_Call) RunAndReturn(run func(string, string) string) *RawService_SongUrl_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,8 +5,8 @@ package songsmocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
 	postgres "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
 )
@@ -24,6 +24,120 @@ func (_m *SongRepo) EXPECT() *SongRepo_Expecter {
 	return &SongRepo_Expecter{mock: &_m.Mock}
 }
 
+// ArtistUsage provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) ArtistUsage(_a0 context.Context, _a1 uuid.UUID) (postgres.ArtistUsageRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistUsage")
+	}
+
+	var r0 postgres.ArtistUsageRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (postgres.ArtistUsageRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) postgres.ArtistUsageRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.ArtistUsageRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_ArtistUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtistUsage'
+type SongRepo_ArtistUsage_Call struct {
+	*mock.Call
+}
+
+// ArtistUsage is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *SongRepo_Expecter) ArtistUsage(_a0 interface{}, _a1 interface{}) *SongRepo_ArtistUsage_Call {
+	return &SongRepo_ArtistUsage_Call{Call: _e.mock.On("ArtistUsage", _a0, _a1)}
+}
+
+func (_c *SongRepo_ArtistUsage_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *SongRepo_ArtistUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SongRepo_ArtistUsage_Call) Return(_a0 postgres.ArtistUsageRow, _a1 error) *SongRepo_ArtistUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_ArtistUsage_Call) RunAndReturn(run func(context.Context, uuid.UUID) (postgres.ArtistUsageRow, error)) *SongRepo_ArtistUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountCreditRequests provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) CountCreditRequests(_a0 context.Context, _a1 uuid.UUID) (int32, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCreditRequests")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int32, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int32); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_CountCreditRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountCreditRequests'
+type SongRepo_CountCreditRequests_Call struct {
+	*mock.Call
+}
+
+// CountCreditRequests is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *SongRepo_Expecter) CountCreditRequests(_a0 interface{}, _a1 interface{}) *SongRepo_CountCreditRequests_Call {
+	return &SongRepo_CountCreditRequests_Call{Call: _e.mock.On("CountCreditRequests", _a0, _a1)}
+}
+
+func (_c *SongRepo_CountCreditRequests_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *SongRepo_CountCreditRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SongRepo_CountCreditRequests_Call) Return(_a0 int32, _a1 error) *SongRepo_CountCreditRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_CountCreditRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int32, error)) *SongRepo_CountCreditRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CountMySongs provides a mock function with given fields: _a0, _a1
 func (_m *SongRepo) CountMySongs(_a0 context.Context, _a1 uuid.UUID) (int32, error) {
 	ret := _m.Called(_a0, _a1)
@@ -195,6 +309,236 @@ func (_c *SongRepo_CountSongsWithArtistsIds_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// CountSongsWithCredit provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) CountSongsWithCredit(_a0 context.Context, _a1 postgres.CountSongsWithCreditParams) (int32, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountSongsWithCredit")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CountSongsWithCreditParams) (int32, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CountSongsWithCreditParams) int32); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.CountSongsWithCreditParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_CountSongsWithCredit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSongsWithCredit'
+type SongRepo_CountSongsWithCredit_Call struct {
+	*mock.Call
+}
+
+// CountSongsWithCredit is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.CountSongsWithCreditParams
+func (_e *SongRepo_Expecter) CountSongsWithCredit(_a0 interface{}, _a1 interface{}) *SongRepo_CountSongsWithCredit_Call {
+	return &SongRepo_CountSongsWithCredit_Call{Call: _e.mock.On("CountSongsWithCredit", _a0, _a1)}
+}
+
+func (_c *SongRepo_CountSongsWithCredit_Call) Run(run func(_a0 context.Context, _a1 postgres.CountSongsWithCreditParams)) *SongRepo_CountSongsWithCredit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.CountSongsWithCreditParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_CountSongsWithCredit_Call) Return(_a0 int32, _a1 error) *SongRepo_CountSongsWithCredit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_CountSongsWithCredit_Call) RunAndReturn(run func(context.Context, postgres.CountSongsWithCreditParams) (int32, error)) *SongRepo_CountSongsWithCredit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountTrashedSongs provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) CountTrashedSongs(_a0 context.Context, _a1 postgres.CountTrashedSongsParams) (int32, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountTrashedSongs")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CountTrashedSongsParams) (int32, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CountTrashedSongsParams) int32); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.CountTrashedSongsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_CountTrashedSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountTrashedSongs'
+type SongRepo_CountTrashedSongs_Call struct {
+	*mock.Call
+}
+
+// CountTrashedSongs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.CountTrashedSongsParams
+func (_e *SongRepo_Expecter) CountTrashedSongs(_a0 interface{}, _a1 interface{}) *SongRepo_CountTrashedSongs_Call {
+	return &SongRepo_CountTrashedSongs_Call{Call: _e.mock.On("CountTrashedSongs", _a0, _a1)}
+}
+
+func (_c *SongRepo_CountTrashedSongs_Call) Run(run func(_a0 context.Context, _a1 postgres.CountTrashedSongsParams)) *SongRepo_CountTrashedSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.CountTrashedSongsParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_CountTrashedSongs_Call) Return(_a0 int32, _a1 error) *SongRepo_CountTrashedSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_CountTrashedSongs_Call) RunAndReturn(run func(context.Context, postgres.CountTrashedSongsParams) (int32, error)) *SongRepo_CountTrashedSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreditRequests provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) CreditRequests(_a0 context.Context, _a1 postgres.CreditRequestsParams) ([]postgres.CreditRequestsRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreditRequests")
+	}
+
+	var r0 []postgres.CreditRequestsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CreditRequestsParams) ([]postgres.CreditRequestsRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CreditRequestsParams) []postgres.CreditRequestsRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.CreditRequestsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.CreditRequestsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_CreditRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreditRequests'
+type SongRepo_CreditRequests_Call struct {
+	*mock.Call
+}
+
+// CreditRequests is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.CreditRequestsParams
+func (_e *SongRepo_Expecter) CreditRequests(_a0 interface{}, _a1 interface{}) *SongRepo_CreditRequests_Call {
+	return &SongRepo_CreditRequests_Call{Call: _e.mock.On("CreditRequests", _a0, _a1)}
+}
+
+func (_c *SongRepo_CreditRequests_Call) Run(run func(_a0 context.Context, _a1 postgres.CreditRequestsParams)) *SongRepo_CreditRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.CreditRequestsParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_CreditRequests_Call) Return(_a0 []postgres.CreditRequestsRow, _a1 error) *SongRepo_CreditRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_CreditRequests_Call) RunAndReturn(run func(context.Context, postgres.CreditRequestsParams) ([]postgres.CreditRequestsRow, error)) *SongRepo_CreditRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ModerateSong provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) ModerateSong(_a0 context.Context, _a1 postgres.ModerateSongParams) (postgres.Song, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModerateSong")
+	}
+
+	var r0 postgres.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ModerateSongParams) (postgres.Song, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ModerateSongParams) postgres.Song); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Song)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ModerateSongParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_ModerateSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ModerateSong'
+type SongRepo_ModerateSong_Call struct {
+	*mock.Call
+}
+
+// ModerateSong is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ModerateSongParams
+func (_e *SongRepo_Expecter) ModerateSong(_a0 interface{}, _a1 interface{}) *SongRepo_ModerateSong_Call {
+	return &SongRepo_ModerateSong_Call{Call: _e.mock.On("ModerateSong", _a0, _a1)}
+}
+
+func (_c *SongRepo_ModerateSong_Call) Run(run func(_a0 context.Context, _a1 postgres.ModerateSongParams)) *SongRepo_ModerateSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ModerateSongParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_ModerateSong_Call) Return(_a0 postgres.Song, _a1 error) *SongRepo_ModerateSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_ModerateSong_Call) RunAndReturn(run func(context.Context, postgres.ModerateSongParams) (postgres.Song, error)) *SongRepo_ModerateSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MySongs provides a mock function with given fields: _a0, _a1
 func (_m *SongRepo) MySongs(_a0 context.Context, _a1 postgres.MySongsParams) ([]postgres.MySongsRow, error) {
 	ret := _m.Called(_a0, _a1)
@@ -254,6 +598,63 @@ func (_c *SongRepo_MySongs_Call) RunAndReturn(run func(context.Context, postgres
 	return _c
 }
 
+// PatchSong provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) PatchSong(_a0 context.Context, _a1 postgres.PatchSongParams) (postgres.Song, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchSong")
+	}
+
+	var r0 postgres.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.PatchSongParams) (postgres.Song, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.PatchSongParams) postgres.Song); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Song)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.PatchSongParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_PatchSong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchSong'
+type SongRepo_PatchSong_Call struct {
+	*mock.Call
+}
+
+// PatchSong is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.PatchSongParams
+func (_e *SongRepo_Expecter) PatchSong(_a0 interface{}, _a1 interface{}) *SongRepo_PatchSong_Call {
+	return &SongRepo_PatchSong_Call{Call: _e.mock.On("PatchSong", _a0, _a1)}
+}
+
+func (_c *SongRepo_PatchSong_Call) Run(run func(_a0 context.Context, _a1 postgres.PatchSongParams)) *SongRepo_PatchSong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.PatchSongParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_PatchSong_Call) Return(_a0 postgres.Song, _a1 error) *SongRepo_PatchSong_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_PatchSong_Call) RunAndReturn(run func(context.Context, postgres.PatchSongParams) (postgres.Song, error)) *SongRepo_PatchSong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PatchSongs provides a mock function with given fields: _a0, _a1
 func (_m *SongRepo) PatchSongs(_a0 context.Context, _a1 postgres.PatchSongsParams) error {
 	ret := _m.Called(_a0, _a1)
@@ -360,6 +761,169 @@ func (_c *SongRepo_ReleasedSongs_Call) RunAndReturn(run func(context.Context, po
 	return _c
 }
 
+// ReplaceCredits provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) ReplaceCredits(_a0 context.Context, _a1 postgres.ReplaceCreditsParams) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceCredits")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ReplaceCreditsParams) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SongRepo_ReplaceCredits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceCredits'
+type SongRepo_ReplaceCredits_Call struct {
+	*mock.Call
+}
+
+// ReplaceCredits is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ReplaceCreditsParams
+func (_e *SongRepo_Expecter) ReplaceCredits(_a0 interface{}, _a1 interface{}) *SongRepo_ReplaceCredits_Call {
+	return &SongRepo_ReplaceCredits_Call{Call: _e.mock.On("ReplaceCredits", _a0, _a1)}
+}
+
+func (_c *SongRepo_ReplaceCredits_Call) Run(run func(_a0 context.Context, _a1 postgres.ReplaceCreditsParams)) *SongRepo_ReplaceCredits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ReplaceCreditsParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_ReplaceCredits_Call) Return(_a0 error) *SongRepo_ReplaceCredits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SongRepo_ReplaceCredits_Call) RunAndReturn(run func(context.Context, postgres.ReplaceCreditsParams) error) *SongRepo_ReplaceCredits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveCreditRequest provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) ResolveCreditRequest(_a0 context.Context, _a1 postgres.ResolveCreditRequestParams) (postgres.ResolveCreditRequestRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveCreditRequest")
+	}
+
+	var r0 postgres.ResolveCreditRequestRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ResolveCreditRequestParams) (postgres.ResolveCreditRequestRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.ResolveCreditRequestParams) postgres.ResolveCreditRequestRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.ResolveCreditRequestRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.ResolveCreditRequestParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_ResolveCreditRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveCreditRequest'
+type SongRepo_ResolveCreditRequest_Call struct {
+	*mock.Call
+}
+
+// ResolveCreditRequest is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.ResolveCreditRequestParams
+func (_e *SongRepo_Expecter) ResolveCreditRequest(_a0 interface{}, _a1 interface{}) *SongRepo_ResolveCreditRequest_Call {
+	return &SongRepo_ResolveCreditRequest_Call{Call: _e.mock.On("ResolveCreditRequest", _a0, _a1)}
+}
+
+func (_c *SongRepo_ResolveCreditRequest_Call) Run(run func(_a0 context.Context, _a1 postgres.ResolveCreditRequestParams)) *SongRepo_ResolveCreditRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.ResolveCreditRequestParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_ResolveCreditRequest_Call) Return(_a0 postgres.ResolveCreditRequestRow, _a1 error) *SongRepo_ResolveCreditRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_ResolveCreditRequest_Call) RunAndReturn(run func(context.Context, postgres.ResolveCreditRequestParams) (postgres.ResolveCreditRequestRow, error)) *SongRepo_ResolveCreditRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSongs provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) RestoreSongs(_a0 context.Context, _a1 postgres.RestoreSongsParams) ([]postgres.Song, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSongs")
+	}
+
+	var r0 []postgres.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.RestoreSongsParams) ([]postgres.Song, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.RestoreSongsParams) []postgres.Song); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.Song)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.RestoreSongsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_RestoreSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSongs'
+type SongRepo_RestoreSongs_Call struct {
+	*mock.Call
+}
+
+// RestoreSongs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.RestoreSongsParams
+func (_e *SongRepo_Expecter) RestoreSongs(_a0 interface{}, _a1 interface{}) *SongRepo_RestoreSongs_Call {
+	return &SongRepo_RestoreSongs_Call{Call: _e.mock.On("RestoreSongs", _a0, _a1)}
+}
+
+func (_c *SongRepo_RestoreSongs_Call) Run(run func(_a0 context.Context, _a1 postgres.RestoreSongsParams)) *SongRepo_RestoreSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.RestoreSongsParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_RestoreSongs_Call) Return(_a0 []postgres.Song, _a1 error) *SongRepo_RestoreSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_RestoreSongs_Call) RunAndReturn(run func(context.Context, postgres.RestoreSongsParams) ([]postgres.Song, error)) *SongRepo_RestoreSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveSong provides a mock function with given fields: _a0, _a1
 func (_m *SongRepo) SaveSong(_a0 context.Context, _a1 postgres.SaveSongParams) error {
 	ret := _m.Called(_a0, _a1)
@@ -464,6 +1028,112 @@ func (_c *SongRepo_Song_Call) RunAndReturn(run func(context.Context, uuid.UUID)
 	return _c
 }
 
+// TrashSongs provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) TrashSongs(_a0 context.Context, _a1 postgres.TrashSongsParams) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrashSongs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.TrashSongsParams) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SongRepo_TrashSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashSongs'
+type SongRepo_TrashSongs_Call struct {
+	*mock.Call
+}
+
+// TrashSongs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.TrashSongsParams
+func (_e *SongRepo_Expecter) TrashSongs(_a0 interface{}, _a1 interface{}) *SongRepo_TrashSongs_Call {
+	return &SongRepo_TrashSongs_Call{Call: _e.mock.On("TrashSongs", _a0, _a1)}
+}
+
+func (_c *SongRepo_TrashSongs_Call) Run(run func(_a0 context.Context, _a1 postgres.TrashSongsParams)) *SongRepo_TrashSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.TrashSongsParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_TrashSongs_Call) Return(_a0 error) *SongRepo_TrashSongs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SongRepo_TrashSongs_Call) RunAndReturn(run func(context.Context, postgres.TrashSongsParams) error) *SongRepo_TrashSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrashedSongs provides a mock function with given fields: _a0, _a1
+func (_m *SongRepo) TrashedSongs(_a0 context.Context, _a1 postgres.TrashedSongsParams) ([]postgres.TrashedSongsRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrashedSongs")
+	}
+
+	var r0 []postgres.TrashedSongsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.TrashedSongsParams) ([]postgres.TrashedSongsRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.TrashedSongsParams) []postgres.TrashedSongsRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.TrashedSongsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.TrashedSongsParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongRepo_TrashedSongs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashedSongs'
+type SongRepo_TrashedSongs_Call struct {
+	*mock.Call
+}
+
+// TrashedSongs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.TrashedSongsParams
+func (_e *SongRepo_Expecter) TrashedSongs(_a0 interface{}, _a1 interface{}) *SongRepo_TrashedSongs_Call {
+	return &SongRepo_TrashedSongs_Call{Call: _e.mock.On("TrashedSongs", _a0, _a1)}
+}
+
+func (_c *SongRepo_TrashedSongs_Call) Run(run func(_a0 context.Context, _a1 postgres.TrashedSongsParams)) *SongRepo_TrashedSongs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.TrashedSongsParams))
+	})
+	return _c
+}
+
+func (_c *SongRepo_TrashedSongs_Call) Return(_a0 []postgres.TrashedSongsRow, _a1 error) *SongRepo_TrashedSongs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SongRepo_TrashedSongs_Call) RunAndReturn(run func(context.Context, postgres.TrashedSongsParams) ([]postgres.TrashedSongsRow, error)) *SongRepo_TrashedSongs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSongRepo creates a new instance of SongRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSongRepo(t interface {
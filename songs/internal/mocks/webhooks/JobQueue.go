@@ -0,0 +1,148 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package webhooksmocks
+
+import (
+	context "context"
+
+	jobs "github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// JobQueue is an autogenerated mock type for the JobQueue type
+type JobQueue struct {
+	mock.Mock
+}
+
+type JobQueue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobQueue) EXPECT() *JobQueue_Expecter {
+	return &JobQueue_Expecter{mock: &_m.Mock}
+}
+
+// EnqueueJob provides a mock function with given fields: ctx, kind, payload, opts
+func (_m *JobQueue) EnqueueJob(ctx context.Context, kind string, payload []byte, opts jobs.Options) (uuid.UUID, error) {
+	ret := _m.Called(ctx, kind, payload, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueJob")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, jobs.Options) (uuid.UUID, error)); ok {
+		return rf(ctx, kind, payload, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, jobs.Options) uuid.UUID); ok {
+		r0 = rf(ctx, kind, payload, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte, jobs.Options) error); ok {
+		r1 = rf(ctx, kind, payload, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobQueue_EnqueueJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueJob'
+type JobQueue_EnqueueJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kind string
+//   - payload []byte
+//   - opts jobs.Options
+func (_e *JobQueue_Expecter) EnqueueJob(ctx interface{}, kind interface{}, payload interface{}, opts interface{}) *JobQueue_EnqueueJob_Call {
+	return &JobQueue_EnqueueJob_Call{Call: _e.mock.On("EnqueueJob", ctx, kind, payload, opts)}
+}
+
+func (_c *JobQueue_EnqueueJob_Call) Run(run func(ctx context.Context, kind string, payload []byte, opts jobs.Options)) *JobQueue_EnqueueJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte), args[3].(jobs.Options))
+	})
+	return _c
+}
+
+func (_c *JobQueue_EnqueueJob_Call) Return(_a0 uuid.UUID, _a1 error) *JobQueue_EnqueueJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobQueue_EnqueueJob_Call) RunAndReturn(run func(context.Context, string, []byte, jobs.Options) (uuid.UUID, error)) *JobQueue_EnqueueJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InTx provides a mock function with given fields: _a0
+func (_m *JobQueue) InTx(_a0 jobs.JobSaver) jobs.Queue {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InTx")
+	}
+
+	var r0 jobs.Queue
+	if rf, ok := ret.Get(0).(func(jobs.JobSaver) jobs.Queue); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(jobs.Queue)
+		}
+	}
+
+	return r0
+}
+
+// JobQueue_InTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InTx'
+type JobQueue_InTx_Call struct {
+	*mock.Call
+}
+
+// InTx is a helper method to define mock.On call
+//   - _a0 jobs.JobSaver
+func (_e *JobQueue_Expecter) InTx(_a0 interface{}) *JobQueue_InTx_Call {
+	return &JobQueue_InTx_Call{Call: _e.mock.On("InTx", _a0)}
+}
+
+func (_c *JobQueue_InTx_Call) Run(run func(_a0 jobs.JobSaver)) *JobQueue_InTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(jobs.JobSaver))
+	})
+	return _c
+}
+
+func (_c *JobQueue_InTx_Call) Return(_a0 jobs.Queue) *JobQueue_InTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobQueue_InTx_Call) RunAndReturn(run func(jobs.JobSaver) jobs.Queue) *JobQueue_InTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobQueue creates a new instance of JobQueue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobQueue {
+	mock := &JobQueue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
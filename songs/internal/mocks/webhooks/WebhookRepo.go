@@ -0,0 +1,941 @@
+// Code generated by mockery v2.48.0. DO NOT EDIT.
+
+package webhooksmocks
+
+import (
+	context "context"
+	time "time"
+
+	postgres "github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+
+	webhooks "github.com/Benzogang-Tape/audio-hosting/songs/internal/services/webhooks"
+)
+
+// WebhookRepo is an autogenerated mock type for the WebhookRepo type
+type WebhookRepo struct {
+	mock.Mock
+}
+
+type WebhookRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookRepo) EXPECT() *WebhookRepo_Expecter {
+	return &WebhookRepo_Expecter{mock: &_m.Mock}
+}
+
+// ArtistWebhooks provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) ArtistWebhooks(_a0 context.Context, _a1 uuid.UUID) ([]postgres.Webhook, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistWebhooks")
+	}
+
+	var r0 []postgres.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]postgres.Webhook, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []postgres.Webhook); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_ArtistWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtistWebhooks'
+type WebhookRepo_ArtistWebhooks_Call struct {
+	*mock.Call
+}
+
+// ArtistWebhooks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *WebhookRepo_Expecter) ArtistWebhooks(_a0 interface{}, _a1 interface{}) *WebhookRepo_ArtistWebhooks_Call {
+	return &WebhookRepo_ArtistWebhooks_Call{Call: _e.mock.On("ArtistWebhooks", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_ArtistWebhooks_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *WebhookRepo_ArtistWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_ArtistWebhooks_Call) Return(_a0 []postgres.Webhook, _a1 error) *WebhookRepo_ArtistWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_ArtistWebhooks_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]postgres.Webhook, error)) *WebhookRepo_ArtistWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Begin provides a mock function with given fields: _a0
+func (_m *WebhookRepo) Begin(_a0 context.Context) (webhooks.WebhookRepo, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 webhooks.WebhookRepo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (webhooks.WebhookRepo, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) webhooks.WebhookRepo); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(webhooks.WebhookRepo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type WebhookRepo_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *WebhookRepo_Expecter) Begin(_a0 interface{}) *WebhookRepo_Begin_Call {
+	return &WebhookRepo_Begin_Call{Call: _e.mock.On("Begin", _a0)}
+}
+
+func (_c *WebhookRepo_Begin_Call) Run(run func(_a0 context.Context)) *WebhookRepo_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_Begin_Call) Return(_a0 webhooks.WebhookRepo, _a1 error) *WebhookRepo_Begin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_Begin_Call) RunAndReturn(run func(context.Context) (webhooks.WebhookRepo, error)) *WebhookRepo_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Commit provides a mock function with given fields: _a0
+func (_m *WebhookRepo) Commit(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepo_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type WebhookRepo_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *WebhookRepo_Expecter) Commit(_a0 interface{}) *WebhookRepo_Commit_Call {
+	return &WebhookRepo_Commit_Call{Call: _e.mock.On("Commit", _a0)}
+}
+
+func (_c *WebhookRepo_Commit_Call) Run(run func(_a0 context.Context)) *WebhookRepo_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_Commit_Call) Return(_a0 error) *WebhookRepo_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepo_Commit_Call) RunAndReturn(run func(context.Context) error) *WebhookRepo_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountArtistWebhooks provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) CountArtistWebhooks(_a0 context.Context, _a1 uuid.UUID) (int32, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountArtistWebhooks")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int32, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int32); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_CountArtistWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountArtistWebhooks'
+type WebhookRepo_CountArtistWebhooks_Call struct {
+	*mock.Call
+}
+
+// CountArtistWebhooks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *WebhookRepo_Expecter) CountArtistWebhooks(_a0 interface{}, _a1 interface{}) *WebhookRepo_CountArtistWebhooks_Call {
+	return &WebhookRepo_CountArtistWebhooks_Call{Call: _e.mock.On("CountArtistWebhooks", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_CountArtistWebhooks_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *WebhookRepo_CountArtistWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_CountArtistWebhooks_Call) Return(_a0 int32, _a1 error) *WebhookRepo_CountArtistWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_CountArtistWebhooks_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int32, error)) *WebhookRepo_CountArtistWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountWebhookDeliveries provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) CountWebhookDeliveries(_a0 context.Context, _a1 postgres.CountWebhookDeliveriesParams) (int32, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountWebhookDeliveries")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CountWebhookDeliveriesParams) (int32, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.CountWebhookDeliveriesParams) int32); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.CountWebhookDeliveriesParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_CountWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountWebhookDeliveries'
+type WebhookRepo_CountWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// CountWebhookDeliveries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.CountWebhookDeliveriesParams
+func (_e *WebhookRepo_Expecter) CountWebhookDeliveries(_a0 interface{}, _a1 interface{}) *WebhookRepo_CountWebhookDeliveries_Call {
+	return &WebhookRepo_CountWebhookDeliveries_Call{Call: _e.mock.On("CountWebhookDeliveries", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_CountWebhookDeliveries_Call) Run(run func(_a0 context.Context, _a1 postgres.CountWebhookDeliveriesParams)) *WebhookRepo_CountWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.CountWebhookDeliveriesParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_CountWebhookDeliveries_Call) Return(_a0 int32, _a1 error) *WebhookRepo_CountWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_CountWebhookDeliveries_Call) RunAndReturn(run func(context.Context, postgres.CountWebhookDeliveriesParams) (int32, error)) *WebhookRepo_CountWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) DeleteWebhook(_a0 context.Context, _a1 postgres.DeleteWebhookParams) (uuid.UUID, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.DeleteWebhookParams) (uuid.UUID, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.DeleteWebhookParams) uuid.UUID); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.DeleteWebhookParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type WebhookRepo_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.DeleteWebhookParams
+func (_e *WebhookRepo_Expecter) DeleteWebhook(_a0 interface{}, _a1 interface{}) *WebhookRepo_DeleteWebhook_Call {
+	return &WebhookRepo_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_DeleteWebhook_Call) Run(run func(_a0 context.Context, _a1 postgres.DeleteWebhookParams)) *WebhookRepo_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.DeleteWebhookParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_DeleteWebhook_Call) Return(_a0 uuid.UUID, _a1 error) *WebhookRepo_DeleteWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_DeleteWebhook_Call) RunAndReturn(run func(context.Context, postgres.DeleteWebhookParams) (uuid.UUID, error)) *WebhookRepo_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueJob provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) EnqueueJob(_a0 context.Context, _a1 postgres.EnqueueJobParams) (postgres.Job, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueJob")
+	}
+
+	var r0 postgres.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.EnqueueJobParams) (postgres.Job, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.EnqueueJobParams) postgres.Job); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.EnqueueJobParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_EnqueueJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueJob'
+type WebhookRepo_EnqueueJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.EnqueueJobParams
+func (_e *WebhookRepo_Expecter) EnqueueJob(_a0 interface{}, _a1 interface{}) *WebhookRepo_EnqueueJob_Call {
+	return &WebhookRepo_EnqueueJob_Call{Call: _e.mock.On("EnqueueJob", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_EnqueueJob_Call) Run(run func(_a0 context.Context, _a1 postgres.EnqueueJobParams)) *WebhookRepo_EnqueueJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.EnqueueJobParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_EnqueueJob_Call) Return(_a0 postgres.Job, _a1 error) *WebhookRepo_EnqueueJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_EnqueueJob_Call) RunAndReturn(run func(context.Context, postgres.EnqueueJobParams) (postgres.Job, error)) *WebhookRepo_EnqueueJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeWebhookDeliveries provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) PurgeWebhookDeliveries(_a0 context.Context, _a1 time.Time) (int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeWebhookDeliveries")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_PurgeWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeWebhookDeliveries'
+type WebhookRepo_PurgeWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// PurgeWebhookDeliveries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 time.Time
+func (_e *WebhookRepo_Expecter) PurgeWebhookDeliveries(_a0 interface{}, _a1 interface{}) *WebhookRepo_PurgeWebhookDeliveries_Call {
+	return &WebhookRepo_PurgeWebhookDeliveries_Call{Call: _e.mock.On("PurgeWebhookDeliveries", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_PurgeWebhookDeliveries_Call) Run(run func(_a0 context.Context, _a1 time.Time)) *WebhookRepo_PurgeWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_PurgeWebhookDeliveries_Call) Return(_a0 int64, _a1 error) *WebhookRepo_PurgeWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_PurgeWebhookDeliveries_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *WebhookRepo_PurgeWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordWebhookAttempt provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) RecordWebhookAttempt(_a0 context.Context, _a1 postgres.RecordWebhookAttemptParams) (postgres.WebhookDelivery, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordWebhookAttempt")
+	}
+
+	var r0 postgres.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.RecordWebhookAttemptParams) (postgres.WebhookDelivery, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.RecordWebhookAttemptParams) postgres.WebhookDelivery); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.RecordWebhookAttemptParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_RecordWebhookAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordWebhookAttempt'
+type WebhookRepo_RecordWebhookAttempt_Call struct {
+	*mock.Call
+}
+
+// RecordWebhookAttempt is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.RecordWebhookAttemptParams
+func (_e *WebhookRepo_Expecter) RecordWebhookAttempt(_a0 interface{}, _a1 interface{}) *WebhookRepo_RecordWebhookAttempt_Call {
+	return &WebhookRepo_RecordWebhookAttempt_Call{Call: _e.mock.On("RecordWebhookAttempt", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_RecordWebhookAttempt_Call) Run(run func(_a0 context.Context, _a1 postgres.RecordWebhookAttemptParams)) *WebhookRepo_RecordWebhookAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.RecordWebhookAttemptParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_RecordWebhookAttempt_Call) Return(_a0 postgres.WebhookDelivery, _a1 error) *WebhookRepo_RecordWebhookAttempt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_RecordWebhookAttempt_Call) RunAndReturn(run func(context.Context, postgres.RecordWebhookAttemptParams) (postgres.WebhookDelivery, error)) *WebhookRepo_RecordWebhookAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeliverWebhook provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) RedeliverWebhook(_a0 context.Context, _a1 postgres.RedeliverWebhookParams) (postgres.WebhookDelivery, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeliverWebhook")
+	}
+
+	var r0 postgres.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.RedeliverWebhookParams) (postgres.WebhookDelivery, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.RedeliverWebhookParams) postgres.WebhookDelivery); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.RedeliverWebhookParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_RedeliverWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeliverWebhook'
+type WebhookRepo_RedeliverWebhook_Call struct {
+	*mock.Call
+}
+
+// RedeliverWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.RedeliverWebhookParams
+func (_e *WebhookRepo_Expecter) RedeliverWebhook(_a0 interface{}, _a1 interface{}) *WebhookRepo_RedeliverWebhook_Call {
+	return &WebhookRepo_RedeliverWebhook_Call{Call: _e.mock.On("RedeliverWebhook", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_RedeliverWebhook_Call) Run(run func(_a0 context.Context, _a1 postgres.RedeliverWebhookParams)) *WebhookRepo_RedeliverWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.RedeliverWebhookParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_RedeliverWebhook_Call) Return(_a0 postgres.WebhookDelivery, _a1 error) *WebhookRepo_RedeliverWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_RedeliverWebhook_Call) RunAndReturn(run func(context.Context, postgres.RedeliverWebhookParams) (postgres.WebhookDelivery, error)) *WebhookRepo_RedeliverWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with given fields: _a0
+func (_m *WebhookRepo) Rollback(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepo_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type WebhookRepo_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *WebhookRepo_Expecter) Rollback(_a0 interface{}) *WebhookRepo_Rollback_Call {
+	return &WebhookRepo_Rollback_Call{Call: _e.mock.On("Rollback", _a0)}
+}
+
+func (_c *WebhookRepo_Rollback_Call) Run(run func(_a0 context.Context)) *WebhookRepo_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_Rollback_Call) Return(_a0 error) *WebhookRepo_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepo_Rollback_Call) RunAndReturn(run func(context.Context) error) *WebhookRepo_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveWebhook provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) SaveWebhook(_a0 context.Context, _a1 postgres.SaveWebhookParams) (postgres.Webhook, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWebhook")
+	}
+
+	var r0 postgres.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveWebhookParams) (postgres.Webhook, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveWebhookParams) postgres.Webhook); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.Webhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.SaveWebhookParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_SaveWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveWebhook'
+type WebhookRepo_SaveWebhook_Call struct {
+	*mock.Call
+}
+
+// SaveWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SaveWebhookParams
+func (_e *WebhookRepo_Expecter) SaveWebhook(_a0 interface{}, _a1 interface{}) *WebhookRepo_SaveWebhook_Call {
+	return &WebhookRepo_SaveWebhook_Call{Call: _e.mock.On("SaveWebhook", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_SaveWebhook_Call) Run(run func(_a0 context.Context, _a1 postgres.SaveWebhookParams)) *WebhookRepo_SaveWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SaveWebhookParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_SaveWebhook_Call) Return(_a0 postgres.Webhook, _a1 error) *WebhookRepo_SaveWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_SaveWebhook_Call) RunAndReturn(run func(context.Context, postgres.SaveWebhookParams) (postgres.Webhook, error)) *WebhookRepo_SaveWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveWebhookDelivery provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) SaveWebhookDelivery(_a0 context.Context, _a1 postgres.SaveWebhookDeliveryParams) (postgres.WebhookDelivery, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWebhookDelivery")
+	}
+
+	var r0 postgres.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveWebhookDeliveryParams) (postgres.WebhookDelivery, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SaveWebhookDeliveryParams) postgres.WebhookDelivery); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.SaveWebhookDeliveryParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_SaveWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveWebhookDelivery'
+type WebhookRepo_SaveWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// SaveWebhookDelivery is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SaveWebhookDeliveryParams
+func (_e *WebhookRepo_Expecter) SaveWebhookDelivery(_a0 interface{}, _a1 interface{}) *WebhookRepo_SaveWebhookDelivery_Call {
+	return &WebhookRepo_SaveWebhookDelivery_Call{Call: _e.mock.On("SaveWebhookDelivery", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_SaveWebhookDelivery_Call) Run(run func(_a0 context.Context, _a1 postgres.SaveWebhookDeliveryParams)) *WebhookRepo_SaveWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SaveWebhookDeliveryParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_SaveWebhookDelivery_Call) Return(_a0 postgres.WebhookDelivery, _a1 error) *WebhookRepo_SaveWebhookDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_SaveWebhookDelivery_Call) RunAndReturn(run func(context.Context, postgres.SaveWebhookDeliveryParams) (postgres.WebhookDelivery, error)) *WebhookRepo_SaveWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribedWebhooks provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) SubscribedWebhooks(_a0 context.Context, _a1 postgres.SubscribedWebhooksParams) ([]postgres.Webhook, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribedWebhooks")
+	}
+
+	var r0 []postgres.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SubscribedWebhooksParams) ([]postgres.Webhook, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.SubscribedWebhooksParams) []postgres.Webhook); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.SubscribedWebhooksParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_SubscribedWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribedWebhooks'
+type WebhookRepo_SubscribedWebhooks_Call struct {
+	*mock.Call
+}
+
+// SubscribedWebhooks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.SubscribedWebhooksParams
+func (_e *WebhookRepo_Expecter) SubscribedWebhooks(_a0 interface{}, _a1 interface{}) *WebhookRepo_SubscribedWebhooks_Call {
+	return &WebhookRepo_SubscribedWebhooks_Call{Call: _e.mock.On("SubscribedWebhooks", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_SubscribedWebhooks_Call) Run(run func(_a0 context.Context, _a1 postgres.SubscribedWebhooksParams)) *WebhookRepo_SubscribedWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.SubscribedWebhooksParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_SubscribedWebhooks_Call) Return(_a0 []postgres.Webhook, _a1 error) *WebhookRepo_SubscribedWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_SubscribedWebhooks_Call) RunAndReturn(run func(context.Context, postgres.SubscribedWebhooksParams) ([]postgres.Webhook, error)) *WebhookRepo_SubscribedWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WebhookDeliveries provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) WebhookDeliveries(_a0 context.Context, _a1 postgres.WebhookDeliveriesParams) ([]postgres.WebhookDelivery, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookDeliveries")
+	}
+
+	var r0 []postgres.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.WebhookDeliveriesParams) ([]postgres.WebhookDelivery, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, postgres.WebhookDeliveriesParams) []postgres.WebhookDelivery); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]postgres.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, postgres.WebhookDeliveriesParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_WebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveries'
+type WebhookRepo_WebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// WebhookDeliveries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 postgres.WebhookDeliveriesParams
+func (_e *WebhookRepo_Expecter) WebhookDeliveries(_a0 interface{}, _a1 interface{}) *WebhookRepo_WebhookDeliveries_Call {
+	return &WebhookRepo_WebhookDeliveries_Call{Call: _e.mock.On("WebhookDeliveries", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_WebhookDeliveries_Call) Run(run func(_a0 context.Context, _a1 postgres.WebhookDeliveriesParams)) *WebhookRepo_WebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(postgres.WebhookDeliveriesParams))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_WebhookDeliveries_Call) Return(_a0 []postgres.WebhookDelivery, _a1 error) *WebhookRepo_WebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_WebhookDeliveries_Call) RunAndReturn(run func(context.Context, postgres.WebhookDeliveriesParams) ([]postgres.WebhookDelivery, error)) *WebhookRepo_WebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WebhookDelivery provides a mock function with given fields: _a0, _a1
+func (_m *WebhookRepo) WebhookDelivery(_a0 context.Context, _a1 uuid.UUID) (postgres.WebhookDeliveryRow, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookDelivery")
+	}
+
+	var r0 postgres.WebhookDeliveryRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (postgres.WebhookDeliveryRow, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) postgres.WebhookDeliveryRow); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(postgres.WebhookDeliveryRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepo_WebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDelivery'
+type WebhookRepo_WebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// WebhookDelivery is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *WebhookRepo_Expecter) WebhookDelivery(_a0 interface{}, _a1 interface{}) *WebhookRepo_WebhookDelivery_Call {
+	return &WebhookRepo_WebhookDelivery_Call{Call: _e.mock.On("WebhookDelivery", _a0, _a1)}
+}
+
+func (_c *WebhookRepo_WebhookDelivery_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *WebhookRepo_WebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepo_WebhookDelivery_Call) Return(_a0 postgres.WebhookDeliveryRow, _a1 error) *WebhookRepo_WebhookDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepo_WebhookDelivery_Call) RunAndReturn(run func(context.Context, uuid.UUID) (postgres.WebhookDeliveryRow, error)) *WebhookRepo_WebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookRepo creates a new instance of WebhookRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepo {
+	mock := &WebhookRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,106 @@
+// Package analytics tells artists how their songs perform.
+//
+// Counters are aggregated per song and day from the events: plays come from the plays topic
+// of this service, likes and playlist additions from the playlists service.
+// Events are delivered at least once, so the processed ones are remembered for a while
+// and a redelivered event is not counted twice. Followers of artists are counted
+// by the followers worker, it keeps a snapshot per day.
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidPeriod = erix.NewStatus("period must start before it ends", erix.CodeBadRequest)
+	ErrPeriodTooLong = erix.NewStatus("period must be a year at most", erix.CodeBadRequest)
+)
+
+type Service struct {
+	c     Config
+	repo  StatsRepo
+	users UserRepo
+}
+
+type StatsRepo interface {
+	SaveAnalyticsEvent(ctx context.Context, eventId string) (int64, error)
+	PurgeAnalyticsEvents(ctx context.Context, processedBefore time.Time) (int64, error)
+	AddSongStats(context.Context, postgres.AddSongStatsParams) error
+	SaveSongListener(context.Context, postgres.SaveSongListenerParams) error
+	SaveArtistFollowers(context.Context, postgres.SaveArtistFollowersParams) error
+	ArtistsWithSongs(context.Context, postgres.ArtistsWithSongsParams) ([]uuid.UUID, error)
+	ArtistSongStats(context.Context, postgres.ArtistSongStatsParams) ([]postgres.ArtistSongStatsRow, error)
+	ArtistListeners(context.Context, postgres.ArtistListenersParams) ([]postgres.ArtistListenersRow, error)
+	ArtistFollowers(context.Context, postgres.ArtistFollowersParams) ([]postgres.ArtistFollowersRow, error)
+	Begin(context.Context) (StatsRepo, error)
+	Commit(context.Context) error
+	Rollback(context.Context) error
+}
+
+type UserRepo interface {
+	FollowersCount(ctx context.Context, userId uuid.UUID) (int64, error)
+}
+
+type Dependencies struct {
+	StatsRepo StatsRepo
+	UserRepo  UserRepo
+}
+
+type Config struct {
+	Dependencies
+	// Processed event ids are kept this long
+	EventsRetention time.Duration
+}
+
+func New(deps Dependencies) *Service {
+	conf := config.Get()
+
+	return NewWithConfig(Config{
+		Dependencies:    deps,
+		EventsRetention: conf.Features.Analytics.EventsRetention,
+	})
+}
+
+func NewWithConfig(conf Config) *Service {
+	return &Service{
+		c:     conf,
+		repo:  conf.StatsRepo,
+		users: conf.UserRepo,
+	}
+}
+
+// Bucket is a date_trunc unit the counters are grouped by.
+type Bucket string
+
+const (
+	BucketDay  Bucket = "day"
+	BucketWeek Bucket = "week"
+)
+
+type Counters struct {
+	Plays             int64
+	UniqueListeners   int64
+	Likes             int64
+	Unlikes           int64
+	PlaylistAdditions int64
+}
+
+func (c *Counters) add(other Counters) {
+	c.Plays += other.Plays
+	c.UniqueListeners += other.UniqueListeners
+	c.Likes += other.Likes
+	c.Unlikes += other.Unlikes
+	c.PlaylistAdditions += other.PlaylistAdditions
+}
+
+// day truncates the time to the UTC day, the counters are stored by those days.
+func day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour) //nolint:mnd
+}
@@ -0,0 +1,309 @@
+package analytics_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	analyticsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/analytics"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/analytics"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AnalyticsSuite struct {
+	suite.Suite
+
+	rm *analyticsmocks.StatsRepo
+	um *analyticsmocks.UserRepo
+
+	s      *analytics.Service
+	ctx    context.Context
+	artist uuid.UUID
+}
+
+func (s *AnalyticsSuite) SetupTest() {
+	s.rm = analyticsmocks.NewStatsRepo(s.T())
+	s.um = analyticsmocks.NewUserRepo(s.T())
+
+	s.s = analytics.NewWithConfig(analytics.Config{
+		Dependencies: analytics.Dependencies{
+			StatsRepo: s.rm,
+			UserRepo:  s.um,
+		},
+		EventsRetention: time.Hour,
+	})
+
+	s.ctx = context.Background()
+	s.artist = uuid.New()
+}
+
+func (s *AnalyticsSuite) TestRecordPlay() {
+	songId := uuid.New()
+	playedAt := time.Date(2026, 3, 4, 15, 30, 0, 0, time.UTC)
+	day := date(2026, 3, 4)
+
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.rm, nil).Once()
+	s.rm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.rm.EXPECT().SaveAnalyticsEvent(mock.Anything, "songs/1").Return(1, nil).Once()
+	s.rm.EXPECT().AddSongStats(mock.Anything, postgres.AddSongStatsParams{
+		Day:          day,
+		Plays:        1,
+		Likes:        0,
+		Unlikes:      0,
+		PlaylistAdds: 0,
+		SongIds:      []uuid.UUID{songId},
+	}).Return(nil).Once()
+	s.rm.EXPECT().SaveSongListener(mock.Anything, postgres.SaveSongListenerParams{
+		Day:      day,
+		Listener: "listener",
+		SongID:   songId,
+	}).Return(nil).Once()
+	s.rm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	err := s.s.RecordPlay(s.ctx, analytics.RecordPlayInput{
+		EventId:    "songs/1",
+		SongId:     songId,
+		ListenerId: "listener",
+		PlayedAt:   playedAt,
+	})
+
+	s.NoError(err)
+}
+
+func (s *AnalyticsSuite) TestRecordPlayProcessed() {
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.rm, nil).Once()
+	s.rm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.rm.EXPECT().SaveAnalyticsEvent(mock.Anything, "songs/1").Return(0, nil).Once()
+
+	err := s.s.RecordPlay(s.ctx, analytics.RecordPlayInput{
+		EventId:    "songs/1",
+		SongId:     uuid.New(),
+		ListenerId: "listener",
+		PlayedAt:   time.Now(),
+	})
+
+	s.NoError(err)
+}
+
+func (s *AnalyticsSuite) TestRecordPlayError() {
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.rm, nil).Once()
+	s.rm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.rm.EXPECT().SaveAnalyticsEvent(mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.rm.EXPECT().AddSongStats(mock.Anything, mock.Anything).Return(errors.New("db is down")).Once()
+
+	err := s.s.RecordPlay(s.ctx, analytics.RecordPlayInput{
+		EventId:    "songs/1",
+		SongId:     uuid.New(),
+		ListenerId: "",
+		PlayedAt:   time.Now(),
+	})
+
+	s.Error(err)
+}
+
+func (s *AnalyticsSuite) TestRecordLike() {
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.rm, nil).Once()
+	s.rm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.rm.EXPECT().SaveAnalyticsEvent(mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.rm.EXPECT().AddSongStats(mock.Anything, mock.MatchedBy(func(p postgres.AddSongStatsParams) bool {
+		return p.Likes == 0 && p.Unlikes == 1 && p.Plays == 0
+	})).Return(nil).Once()
+	s.rm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	err := s.s.RecordLike(s.ctx, analytics.RecordLikeInput{
+		EventId: "playlists/1",
+		SongId:  uuid.New(),
+		Liked:   false,
+		At:      time.Now(),
+	})
+
+	s.NoError(err)
+}
+
+func (s *AnalyticsSuite) TestRecordPlaylistAddition() {
+	songIds := []uuid.UUID{uuid.New(), uuid.New()}
+
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.rm, nil).Once()
+	s.rm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.rm.EXPECT().SaveAnalyticsEvent(mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.rm.EXPECT().AddSongStats(mock.Anything, mock.MatchedBy(func(p postgres.AddSongStatsParams) bool {
+		return p.PlaylistAdds == 1 && len(p.SongIds) == 2
+	})).Return(nil).Once()
+	s.rm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+
+	err := s.s.RecordPlaylistAddition(s.ctx, analytics.RecordPlaylistAdditionInput{
+		EventId: "playlists/1",
+		SongIds: songIds,
+		AddedAt: time.Now(),
+	})
+
+	s.NoError(err)
+}
+
+func (s *AnalyticsSuite) TestRecordPlaylistAdditionEmpty() {
+	err := s.s.RecordPlaylistAddition(s.ctx, analytics.RecordPlaylistAdditionInput{
+		EventId: "playlists/1",
+		SongIds: nil,
+		AddedAt: time.Now(),
+	})
+
+	s.NoError(err)
+}
+
+func (s *AnalyticsSuite) TestGetArtistStatsByDays() {
+	from, to := date(2026, 3, 4), date(2026, 3, 10)
+	hit, quiet := uuid.New(), uuid.New()
+
+	s.rm.EXPECT().ArtistSongStats(mock.Anything, postgres.ArtistSongStatsParams{
+		Bucket:   "day",
+		ArtistID: s.artist,
+		FromDay:  from,
+		ToDay:    to,
+	}).Return([]postgres.ArtistSongStatsRow{
+		{SongID: quiet, Name: "Quiet", BucketStart: date(2026, 3, 5), Plays: 1, Likes: 0, Unlikes: 0, PlaylistAdds: 1},
+		{SongID: hit, Name: "Hit", BucketStart: date(2026, 3, 5), Plays: 3, Likes: 2, Unlikes: 1, PlaylistAdds: 0},
+		{SongID: hit, Name: "Hit", BucketStart: date(2026, 3, 9), Plays: 5, Likes: 0, Unlikes: 0, PlaylistAdds: 2},
+	}, nil).Once()
+	s.rm.EXPECT().ArtistListeners(mock.Anything, mock.Anything).Return([]postgres.ArtistListenersRow{
+		{SongID: hit, BucketStart: date(2026, 3, 5), AllSongs: false, WholePeriod: false, Listeners: 2},
+		{SongID: hit, BucketStart: time.Time{}, AllSongs: false, WholePeriod: true, Listeners: 4},
+		{SongID: uuid.Nil, BucketStart: date(2026, 3, 5), AllSongs: true, WholePeriod: false, Listeners: 3},
+		{SongID: uuid.Nil, BucketStart: time.Time{}, AllSongs: true, WholePeriod: true, Listeners: 5},
+	}, nil).Once()
+	s.rm.EXPECT().ArtistFollowers(mock.Anything, mock.Anything).Return([]postgres.ArtistFollowersRow{
+		{Day: date(2026, 3, 1), Followers: 10},
+		{Day: date(2026, 3, 5), Followers: 12},
+		{Day: date(2026, 3, 9), Followers: 17},
+	}, nil).Once()
+
+	out, err := s.s.GetArtistStats(s.ctx, analytics.GetArtistStatsInput{
+		ArtistId: s.artist,
+		From:     &from,
+		To:       &to,
+		Bucket:   analytics.BucketDay,
+	})
+
+	s.Require().NoError(err)
+	s.Require().Len(out.Buckets, 7)
+	s.Equal(from, out.Buckets[0].Start)
+	s.Equal(to, out.Buckets[6].Start)
+
+	s.Equal(analytics.Counters{Plays: 9, UniqueListeners: 5, Likes: 2, Unlikes: 1, PlaylistAdditions: 3}, out.Total)
+	s.Equal(analytics.Counters{Plays: 4, UniqueListeners: 3, Likes: 2, Unlikes: 1, PlaylistAdditions: 1},
+		out.Buckets[1].Counters)
+	s.Equal(analytics.Counters{}, out.Buckets[2].Counters)
+
+	s.Equal(int64(17), out.Followers)
+	s.Equal(int64(7), out.FollowersGained)
+	s.Equal(int64(10), out.Buckets[0].Followers)
+	s.Equal(int64(0), out.Buckets[0].FollowersGained)
+	s.Equal(int64(12), out.Buckets[1].Followers)
+	s.Equal(int64(2), out.Buckets[1].FollowersGained)
+
+	s.Require().Len(out.Songs, 2)
+	s.Equal(hit, out.Songs[0].SongId)
+	s.Equal(int64(8), out.Songs[0].Total.Plays)
+	s.Equal(int64(4), out.Songs[0].Total.UniqueListeners)
+	s.Equal(int64(2), out.Songs[0].Buckets[1].Counters.UniqueListeners)
+	s.Len(out.Songs[0].Buckets, 7)
+	s.Equal(quiet, out.Songs[1].SongId)
+}
+
+func (s *AnalyticsSuite) TestGetArtistStatsByWeeks() {
+	from, to := date(2026, 3, 4), date(2026, 3, 10)
+	songId := uuid.New()
+
+	s.rm.EXPECT().ArtistSongStats(mock.Anything, mock.MatchedBy(func(p postgres.ArtistSongStatsParams) bool {
+		return p.Bucket == "week"
+	})).Return([]postgres.ArtistSongStatsRow{
+		{SongID: songId, Name: "Song", BucketStart: date(2026, 3, 2), Plays: 2, Likes: 0, Unlikes: 0, PlaylistAdds: 0},
+		{SongID: songId, Name: "Song", BucketStart: date(2026, 3, 9), Plays: 3, Likes: 0, Unlikes: 0, PlaylistAdds: 0},
+	}, nil).Once()
+	s.rm.EXPECT().ArtistListeners(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.rm.EXPECT().ArtistFollowers(mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+	out, err := s.s.GetArtistStats(s.ctx, analytics.GetArtistStatsInput{
+		ArtistId: s.artist,
+		From:     &from,
+		To:       &to,
+		Bucket:   analytics.BucketWeek,
+	})
+
+	s.Require().NoError(err)
+	s.Require().Len(out.Buckets, 2)
+	s.Equal(date(2026, 3, 2), out.Buckets[0].Start)
+	s.Equal(date(2026, 3, 9), out.Buckets[1].Start)
+	s.Equal(int64(2), out.Buckets[0].Counters.Plays)
+	s.Equal(int64(3), out.Buckets[1].Counters.Plays)
+	s.Equal(int64(0), out.Followers)
+}
+
+func (s *AnalyticsSuite) TestGetArtistStatsInvalidPeriod() {
+	from, to := date(2026, 3, 10), date(2026, 3, 4)
+
+	_, err := s.s.GetArtistStats(s.ctx, analytics.GetArtistStatsInput{
+		ArtistId: s.artist,
+		From:     &from,
+		To:       &to,
+		Bucket:   analytics.BucketDay,
+	})
+
+	s.ErrorIs(err, analytics.ErrInvalidPeriod)
+}
+
+func (s *AnalyticsSuite) TestGetArtistStatsPeriodTooLong() {
+	from, to := date(2025, 1, 1), date(2026, 3, 4)
+
+	_, err := s.s.GetArtistStats(s.ctx, analytics.GetArtistStatsInput{
+		ArtistId: s.artist,
+		From:     &from,
+		To:       &to,
+		Bucket:   analytics.BucketDay,
+	})
+
+	s.ErrorIs(err, analytics.ErrPeriodTooLong)
+}
+
+func (s *AnalyticsSuite) TestSnapshotFollowers() {
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+	s.rm.EXPECT().ArtistsWithSongs(mock.Anything, postgres.ArtistsWithSongsParams{AfterID: uuid.Nil, Limitv: 2}).
+		Return([]uuid.UUID{first, second}, nil).Once()
+	s.rm.EXPECT().ArtistsWithSongs(mock.Anything, postgres.ArtistsWithSongsParams{AfterID: second, Limitv: 2}).
+		Return([]uuid.UUID{third}, nil).Once()
+
+	for i, artistId := range []uuid.UUID{first, second, third} {
+		s.um.EXPECT().FollowersCount(mock.Anything, artistId).Return(int64(i), nil).Once()
+		s.rm.EXPECT().SaveArtistFollowers(mock.Anything, mock.MatchedBy(func(p postgres.SaveArtistFollowersParams) bool {
+			return p.ArtistID == artistId && p.Followers == int64(i)
+		})).Return(nil).Once()
+	}
+
+	counted, err := s.s.SnapshotFollowers(s.ctx, 2)
+
+	s.NoError(err)
+	s.Equal(3, counted)
+}
+
+func (s *AnalyticsSuite) TestSnapshotFollowersError() {
+	s.rm.EXPECT().ArtistsWithSongs(mock.Anything, mock.Anything).Return([]uuid.UUID{uuid.New()}, nil).Once()
+	s.um.EXPECT().FollowersCount(mock.Anything, mock.Anything).Return(0, errors.New("users are down")).Once()
+
+	counted, err := s.s.SnapshotFollowers(s.ctx, 2)
+
+	s.Error(err)
+	s.Equal(0, counted)
+}
+
+func TestAnalytics(t *testing.T) {
+	suite.Run(t, new(AnalyticsSuite))
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
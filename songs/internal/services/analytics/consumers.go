@@ -0,0 +1,122 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api/clients/playlists"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/events"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Event types of the playlists service, see api/clients/playlists/events.proto.
+const (
+	TypeTrackLiked          = "com.audio-hosting.playlists.track.liked.v1"
+	TypeTrackUnliked        = "com.audio-hosting.playlists.track.unliked.v1"
+	TypePlaylistTracksAdded = "com.audio-hosting.playlists.playlist.tracks_added.v1"
+)
+
+// HandlePlays registers the handlers of the plays topic.
+func (s *Service) HandlePlays(c *events.Consumer) {
+	events.Handle(c, events.TypeSongPlayed,
+		func(ctx context.Context, env events.Envelope, event *api.SongPlayedEvent) error {
+			songId, ok := parseSongId(ctx, env, event.GetSongId())
+			if !ok {
+				return nil
+			}
+
+			return s.RecordPlay(ctx, RecordPlayInput{
+				EventId:    eventId(env),
+				SongId:     songId,
+				ListenerId: event.GetListenerId(),
+				PlayedAt:   eventTime(env, event.GetPlayedAt()),
+			})
+		})
+}
+
+// HandlePlaylists registers the handlers of the playlists service topic.
+func (s *Service) HandlePlaylists(c *events.Consumer) {
+	events.Handle(c, TypeTrackLiked,
+		func(ctx context.Context, env events.Envelope, event *playlists.TrackLikedEvent) error {
+			songId, ok := parseSongId(ctx, env, event.GetTrackId())
+			if !ok {
+				return nil
+			}
+
+			return s.RecordLike(ctx, RecordLikeInput{
+				EventId: eventId(env),
+				SongId:  songId,
+				Liked:   true,
+				At:      eventTime(env, event.GetLikedAt()),
+			})
+		})
+
+	events.Handle(c, TypeTrackUnliked,
+		func(ctx context.Context, env events.Envelope, event *playlists.TrackUnlikedEvent) error {
+			songId, ok := parseSongId(ctx, env, event.GetTrackId())
+			if !ok {
+				return nil
+			}
+
+			return s.RecordLike(ctx, RecordLikeInput{
+				EventId: eventId(env),
+				SongId:  songId,
+				Liked:   false,
+				At:      eventTime(env, event.GetUnlikedAt()),
+			})
+		})
+
+	events.Handle(c, TypePlaylistTracksAdded,
+		func(ctx context.Context, env events.Envelope, event *playlists.PlaylistTracksAddedEvent) error {
+			// Albums are made by the artists of their own songs, they are not a sign of popularity
+			if event.GetIsAlbum() {
+				return nil
+			}
+
+			songIds := make([]uuid.UUID, 0, len(event.GetTrackIds()))
+
+			for _, trackId := range event.GetTrackIds() {
+				songId, ok := parseSongId(ctx, env, trackId)
+				if ok {
+					songIds = append(songIds, songId)
+				}
+			}
+
+			return s.RecordPlaylistAddition(ctx, RecordPlaylistAdditionInput{
+				EventId: eventId(env),
+				SongIds: songIds,
+				AddedAt: eventTime(env, event.GetAddedAt()),
+			})
+		})
+}
+
+// eventId is unique across the services, ids of CloudEvents are unique within their source.
+func eventId(env events.Envelope) string {
+	return env.Source + "/" + env.Id
+}
+
+func eventTime(env events.Envelope, at *timestamppb.Timestamp) time.Time {
+	if at == nil {
+		return env.Time
+	}
+
+	return at.AsTime()
+}
+
+// parseSongId skips malformed ids, the event can't be handled later either.
+func parseSongId(ctx context.Context, env events.Envelope, id string) (uuid.UUID, bool) {
+	log := logger.FromContext(ctx)
+
+	songId, err := uuid.Parse(id)
+	if err != nil {
+		log.Warn().Err(err).Str("event_id", env.Id).Str("type", env.Type).Msg("skipping malformed song id")
+
+		return uuid.Nil, false
+	}
+
+	return songId, true
+}
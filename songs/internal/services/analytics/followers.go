@@ -0,0 +1,62 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+// SnapshotFollowers saves the followers count of every artist with songs for today,
+// the artists are walked by batches. A later snapshot of the same day replaces the earlier one.
+// Returns how many artists are counted.
+func (s *Service) SnapshotFollowers(ctx context.Context, batchSize int32) (int, error) {
+	log := logger.FromContext(ctx)
+	today := day(time.Now())
+
+	var (
+		after   = uuid.Nil
+		counted int
+	)
+
+	for {
+		artists, err := s.repo.ArtistsWithSongs(ctx, postgres.ArtistsWithSongsParams{
+			AfterID: after,
+			Limitv:  batchSize,
+		})
+		if err != nil {
+			return counted, e.NewFrom("getting artists", err, fields.F("after", after))
+		}
+
+		for _, artistId := range artists {
+			followers, err := s.users.FollowersCount(ctx, artistId)
+			if err != nil {
+				return counted, e.NewFrom("counting followers", err, fields.F("artist_id", artistId))
+			}
+
+			err = s.repo.SaveArtistFollowers(ctx, postgres.SaveArtistFollowersParams{
+				ArtistID:  artistId,
+				Day:       today,
+				Followers: followers,
+			})
+			if err != nil {
+				return counted, e.NewFrom("saving followers", err, fields.F("artist_id", artistId))
+			}
+
+			counted++
+		}
+
+		log.Debug().Int("counted", counted).Msg("snapshotted followers")
+
+		if len(artists) < int(batchSize) {
+			return counted, nil
+		}
+
+		after = artists[len(artists)-1]
+	}
+}
@@ -0,0 +1,158 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+type RecordPlayInput struct {
+	EventId    string
+	SongId     uuid.UUID
+	ListenerId string
+	PlayedAt   time.Time
+}
+
+// RecordPlay counts the play and the listener of the song.
+func (s *Service) RecordPlay(ctx context.Context, in RecordPlayInput) error {
+	return s.record(ctx, in.EventId, func(tx StatsRepo) error {
+		err := tx.AddSongStats(ctx, postgres.AddSongStatsParams{
+			Day:          day(in.PlayedAt),
+			Plays:        1,
+			Likes:        0,
+			Unlikes:      0,
+			PlaylistAdds: 0,
+			SongIds:      []uuid.UUID{in.SongId},
+		})
+		if err != nil {
+			return e.NewFrom("adding plays", err, fields.F("song_id", in.SongId))
+		}
+
+		if in.ListenerId == "" {
+			return nil
+		}
+
+		err = tx.SaveSongListener(ctx, postgres.SaveSongListenerParams{
+			Day:      day(in.PlayedAt),
+			Listener: in.ListenerId,
+			SongID:   in.SongId,
+		})
+		if err != nil {
+			return e.NewFrom("saving listener", err, fields.F("song_id", in.SongId))
+		}
+
+		return nil
+	})
+}
+
+type RecordLikeInput struct {
+	EventId string
+	SongId  uuid.UUID
+	// False if the like is taken back
+	Liked bool
+	At    time.Time
+}
+
+// RecordLike counts a like or an unlike of the song.
+func (s *Service) RecordLike(ctx context.Context, in RecordLikeInput) error {
+	var likes, unlikes int64 = 1, 0
+	if !in.Liked {
+		likes, unlikes = 0, 1
+	}
+
+	return s.record(ctx, in.EventId, func(tx StatsRepo) error {
+		err := tx.AddSongStats(ctx, postgres.AddSongStatsParams{
+			Day:          day(in.At),
+			Plays:        0,
+			Likes:        likes,
+			Unlikes:      unlikes,
+			PlaylistAdds: 0,
+			SongIds:      []uuid.UUID{in.SongId},
+		})
+		if err != nil {
+			return e.NewFrom("adding likes", err, fields.F("song_id", in.SongId))
+		}
+
+		return nil
+	})
+}
+
+type RecordPlaylistAdditionInput struct {
+	EventId string
+	SongIds []uuid.UUID
+	AddedAt time.Time
+}
+
+// RecordPlaylistAddition counts the songs added to a playlist.
+func (s *Service) RecordPlaylistAddition(ctx context.Context, in RecordPlaylistAdditionInput) error {
+	if len(in.SongIds) == 0 {
+		return nil
+	}
+
+	return s.record(ctx, in.EventId, func(tx StatsRepo) error {
+		err := tx.AddSongStats(ctx, postgres.AddSongStatsParams{
+			Day:          day(in.AddedAt),
+			Plays:        0,
+			Likes:        0,
+			Unlikes:      0,
+			PlaylistAdds: 1,
+			SongIds:      in.SongIds,
+		})
+		if err != nil {
+			return e.NewFrom("adding playlist additions", err, fields.F("songs", len(in.SongIds)))
+		}
+
+		return nil
+	})
+}
+
+// record applies the event in a transaction with the mark that it is processed,
+// an event that is already processed is skipped.
+func (s *Service) record(ctx context.Context, eventId string, apply func(tx StatsRepo) error) error {
+	log := logger.FromContext(ctx)
+
+	txRepo, err := s.repo.Begin(ctx)
+	if err != nil {
+		return e.NewFrom("begin transaction", err)
+	}
+	defer txRepo.Rollback(ctx) //nolint:errcheck
+
+	saved, err := txRepo.SaveAnalyticsEvent(ctx, eventId)
+	if err != nil {
+		return e.NewFrom("saving event", err, fields.F("event_id", eventId))
+	}
+
+	if saved == 0 {
+		log.Debug().Str("event_id", eventId).Msg("skipping processed event")
+		return nil
+	}
+
+	err = apply(txRepo)
+	if err != nil {
+		return err
+	}
+
+	err = txRepo.Commit(ctx)
+	if err != nil {
+		return e.NewFrom("commit transaction", err)
+	}
+
+	return nil
+}
+
+// PurgeProcessedEvents forgets the events processed before the retention,
+// their redelivery is not expected anymore.
+func (s *Service) PurgeProcessedEvents(ctx context.Context) (int64, error) {
+	purged, err := s.repo.PurgeAnalyticsEvents(ctx, time.Now().Add(-s.c.EventsRetention))
+	if err != nil {
+		return 0, e.NewFrom("purging processed events", err)
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,282 @@
+package analytics
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPeriodDays = 30
+	maxPeriodDays     = 366
+	daysInWeek        = 7
+)
+
+type GetArtistStatsInput struct {
+	ArtistId uuid.UUID
+	// Days of the period, both included. The last 30 days by default.
+	From *time.Time
+	To   *time.Time
+	// Days by default
+	Bucket Bucket
+}
+
+type ArtistBucket struct {
+	Start    time.Time
+	Counters Counters
+	// Followers at the end of the bucket
+	Followers       int64
+	FollowersGained int64
+}
+
+type SongBucket struct {
+	Start    time.Time
+	Counters Counters
+}
+
+type SongStats struct {
+	SongId  uuid.UUID
+	Name    string
+	Total   Counters
+	Buckets []SongBucket
+}
+
+type GetArtistStatsOutput struct {
+	Total           Counters
+	Followers       int64
+	FollowersGained int64
+	// Every bucket of the period, the empty ones too
+	Buckets []ArtistBucket
+	// Songs with any activity in the period, the most played first
+	Songs []SongStats
+}
+
+// GetArtistStats returns the counters of the artist songs by buckets, in total and per song.
+func (s *Service) GetArtistStats(ctx context.Context, in GetArtistStatsInput) (GetArtistStatsOutput, error) {
+	var (
+		null GetArtistStatsOutput
+		log  = logger.FromContext(ctx)
+	)
+
+	from, to, err := period(in.From, in.To)
+	if err != nil {
+		return null, err
+	}
+
+	bucket := in.Bucket
+	if bucket != BucketWeek {
+		bucket = BucketDay
+	}
+
+	log.Debug().Stringer("artist_id", in.ArtistId).Time("from", from).Time("to", to).
+		Str("bucket", string(bucket)).Msg("getting artist stats")
+
+	songRows, err := s.repo.ArtistSongStats(ctx, postgres.ArtistSongStatsParams{
+		Bucket:   string(bucket),
+		ArtistID: in.ArtistId,
+		FromDay:  from,
+		ToDay:    to,
+	})
+	if err != nil {
+		return null, e.NewFrom("getting song stats", err, fields.F("artist_id", in.ArtistId))
+	}
+
+	listenerRows, err := s.repo.ArtistListeners(ctx, postgres.ArtistListenersParams{
+		FromDay:  from,
+		Bucket:   string(bucket),
+		ArtistID: in.ArtistId,
+		ToDay:    to,
+	})
+	if err != nil {
+		return null, e.NewFrom("getting listeners", err, fields.F("artist_id", in.ArtistId))
+	}
+
+	snapshots, err := s.repo.ArtistFollowers(ctx, postgres.ArtistFollowersParams{
+		ArtistID: in.ArtistId,
+		ToDay:    to,
+		FromDay:  from,
+	})
+	if err != nil {
+		return null, e.NewFrom("getting followers", err, fields.F("artist_id", in.ArtistId))
+	}
+
+	starts := bucketStarts(from, to, bucket)
+
+	// Dates are keyed by the unix time, the locations of equal dates may differ
+	index := make(map[int64]int, len(starts))
+
+	out := GetArtistStatsOutput{
+		Total:           Counters{}, //nolint:exhaustruct
+		Followers:       0,
+		FollowersGained: 0,
+		Buckets:         make([]ArtistBucket, len(starts)),
+		Songs:           nil,
+	}
+
+	for i, start := range starts {
+		index[start.Unix()] = i
+
+		end := to
+		if i+1 < len(starts) {
+			end = starts[i+1].AddDate(0, 0, -1)
+		}
+
+		// The first week may start before the period
+		bucketFrom := start
+		if bucketFrom.Before(from) {
+			bucketFrom = from
+		}
+
+		out.Buckets[i].Start = start
+		out.Buckets[i].Followers, out.Buckets[i].FollowersGained = followersGrowth(snapshots, bucketFrom, end)
+	}
+
+	out.Followers, out.FollowersGained = followersGrowth(snapshots, from, to)
+
+	songs := make(map[uuid.UUID]*SongStats)
+
+	for _, row := range songRows {
+		i, ok := index[row.BucketStart.Unix()]
+		if !ok {
+			continue
+		}
+
+		song, ok := songs[row.SongID]
+		if !ok {
+			song = &SongStats{
+				SongId:  row.SongID,
+				Name:    row.Name,
+				Total:   Counters{}, //nolint:exhaustruct
+				Buckets: make([]SongBucket, len(starts)),
+			}
+
+			for j, start := range starts {
+				song.Buckets[j].Start = start
+			}
+
+			songs[row.SongID] = song
+		}
+
+		counters := Counters{
+			Plays:             row.Plays,
+			UniqueListeners:   0,
+			Likes:             row.Likes,
+			Unlikes:           row.Unlikes,
+			PlaylistAdditions: row.PlaylistAdds,
+		}
+
+		song.Buckets[i].Counters.add(counters)
+		song.Total.add(counters)
+		out.Buckets[i].Counters.add(counters)
+		out.Total.add(counters)
+	}
+
+	for _, row := range listenerRows {
+		i, inPeriod := index[row.BucketStart.Unix()]
+		song, known := songs[row.SongID]
+
+		switch {
+		case row.AllSongs && row.WholePeriod:
+			out.Total.UniqueListeners = row.Listeners
+		case row.AllSongs && inPeriod:
+			out.Buckets[i].Counters.UniqueListeners = row.Listeners
+		case !known:
+			continue
+		case row.WholePeriod:
+			song.Total.UniqueListeners = row.Listeners
+		case inPeriod:
+			song.Buckets[i].Counters.UniqueListeners = row.Listeners
+		}
+	}
+
+	out.Songs = make([]SongStats, 0, len(songs))
+	for _, song := range songs {
+		out.Songs = append(out.Songs, *song)
+	}
+
+	slices.SortFunc(out.Songs, func(a, b SongStats) int {
+		return cmp.Or(cmp.Compare(b.Total.Plays, a.Total.Plays), cmp.Compare(a.Name, b.Name))
+	})
+
+	return out, nil
+}
+
+// period returns the first and the last days of the period.
+func period(from, to *time.Time) (time.Time, time.Time, error) {
+	end := day(time.Now())
+	if to != nil {
+		end = day(*to)
+	}
+
+	start := end.AddDate(0, 0, 1-defaultPeriodDays)
+	if from != nil {
+		start = day(*from)
+	}
+
+	switch {
+	case start.After(end):
+		return start, end, ErrInvalidPeriod
+
+	case !start.After(end.AddDate(0, 0, -maxPeriodDays)):
+		return start, end, ErrPeriodTooLong
+	}
+
+	return start, end, nil
+}
+
+// bucketStarts returns the first days of the buckets covering the period.
+// Weeks start on Monday like date_trunc ones, so the first week may start before the period.
+func bucketStarts(from, to time.Time, bucket Bucket) []time.Time {
+	start, step := from, 1
+
+	if bucket == BucketWeek {
+		sinceMonday := (int(from.Weekday()) + daysInWeek - 1) % daysInWeek
+		start = from.AddDate(0, 0, -sinceMonday)
+		step = daysInWeek
+	}
+
+	var starts []time.Time
+
+	for ; !start.After(to); start = start.AddDate(0, 0, step) {
+		starts = append(starts, start)
+	}
+
+	return starts
+}
+
+// followersGrowth returns the followers at the end of the days and how many of them came during the days.
+// Without a snapshot before the days the growth is counted from the first snapshot of the days.
+func followersGrowth(snapshots []postgres.ArtistFollowersRow, start, end time.Time) (int64, int64) {
+	var (
+		base, last       int64
+		hasBase, hasLast bool
+	)
+
+	for _, snapshot := range snapshots {
+		switch {
+		case snapshot.Day.Before(start):
+			base, hasBase = snapshot.Followers, true
+
+		case !snapshot.Day.After(end):
+			if !hasBase {
+				base, hasBase = snapshot.Followers, true
+			}
+
+			last, hasLast = snapshot.Followers, true
+		}
+	}
+
+	if !hasLast {
+		return base, 0
+	}
+
+	return last, last - base
+}
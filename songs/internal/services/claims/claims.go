@@ -0,0 +1,120 @@
+// Package claims handles copyright (DMCA) claims against songs.
+//
+// A submitted claim of a claimant verified by an admin takes the song down until an admin resolves it,
+// claims of other claimants put the song under review and an upheld claim takes it down.
+// Claimants may have a limited number of open claims.
+// When the last open claim is rejected, the song gets back the moderation state it had before,
+// unless it was taken down for another reason or moderated since.
+// The artist of the song may dispute a pending claim with a counter-notice.
+// Every status change is recorded in the claim history.
+// Claims and their history are kept after the song is purged.
+package claims
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrClaimNotFound     = erix.NewStatus("claim not found", erix.CodeNotFound)
+	ErrSongNotFound      = erix.NewStatus("song not found", erix.CodeNotFound)
+	ErrOwnSong           = erix.NewStatus("not able to claim your own song", erix.CodeBadRequest)
+	ErrInvalidTransition = erix.NewStatus("claim status does not allow this action", erix.CodePreconditionFailed)
+	ErrTooManyClaims     = erix.NewStatus("too many open claims", erix.CodeTooManyRequests)
+)
+
+type Service struct {
+	c      Config
+	repo   ClaimRepo
+	cache  SongCache
+	broker Broker
+}
+
+type ClaimRepo interface {
+	ClaimedSong(context.Context, uuid.UUID) (postgres.ClaimedSongRow, error)
+	SaveClaim(context.Context, postgres.SaveClaimParams) (postgres.Claim, error)
+	Claim(context.Context, uuid.UUID) (postgres.Claim, error)
+	Claims(context.Context, postgres.ClaimsParams) ([]postgres.Claim, error)
+	CountClaims(context.Context, postgres.CountClaimsParams) (int32, error)
+	SetClaimStatus(context.Context, postgres.SetClaimStatusParams) (postgres.Claim, error)
+	SaveClaimEvent(context.Context, postgres.SaveClaimEventParams) error
+	ClaimEvents(context.Context, uuid.UUID) ([]postgres.ClaimEvent, error)
+	CountOpenClaims(context.Context, postgres.CountOpenClaimsParams) (int32, error)
+	ModerateClaimedSong(context.Context, postgres.ModerateClaimedSongParams) (postgres.ModerateClaimedSongRow, error)
+	RestoreClaimedSong(context.Context, postgres.RestoreClaimedSongParams) (postgres.ModerationStatus, error)
+	CountClaimantOpenClaims(context.Context, uuid.UUID) (int32, error)
+	ClaimantVerified(context.Context, uuid.UUID) (bool, error)
+	VerifyClaimant(context.Context, postgres.VerifyClaimantParams) error
+	UnverifyClaimant(context.Context, uuid.UUID) error
+	Begin(context.Context) (ClaimRepo, error)
+	Commit(context.Context) error
+	Rollback(context.Context) error
+}
+
+// SongCache is invalidated after the songs are changed in a transaction.
+type SongCache interface {
+	InvalidateSongs(context.Context, []uuid.UUID)
+}
+
+type Broker interface {
+	SendSongMessages(context.Context, []broker.SongMessage) error
+}
+
+type Dependencies struct {
+	ClaimRepo ClaimRepo
+	SongCache SongCache
+	Broker    Broker
+}
+
+type Config struct {
+	Dependencies
+	// Pending and counter-noticed claims of one claimant, zero means unlimited
+	MaxOpenPerClaimant int32
+}
+
+func New(deps Dependencies) *Service {
+	return NewWithConfig(Config{
+		Dependencies:       deps,
+		MaxOpenPerClaimant: config.Get().Features.Claims.MaxOpenPerClaimant,
+	})
+}
+
+func NewWithConfig(conf Config) *Service {
+	return &Service{
+		c:      conf,
+		repo:   conf.ClaimRepo,
+		cache:  conf.SongCache,
+		broker: conf.Broker,
+	}
+}
+
+type Claim struct {
+	Id             uuid.UUID
+	SongId         uuid.UUID
+	ArtistId       uuid.UUID
+	ClaimantId     uuid.UUID
+	ClaimantName   string
+	ClaimantEmail  string
+	Description    string
+	EvidenceUrls   []string
+	Status         postgres.ClaimStatus
+	CounterNotice  *string
+	ResolutionNote *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type ClaimEvent struct {
+	FromStatus *postgres.ClaimStatus
+	ToStatus   postgres.ClaimStatus
+	ActorId    uuid.UUID
+	Note       *string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,73 @@
+package claims
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+
+	"dev.gaijin.team/go/golib/e"
+	"github.com/google/uuid"
+)
+
+type FileCounterNoticeInput struct {
+	ArtistId  uuid.UUID
+	ClaimId   uuid.UUID
+	Statement string
+}
+
+type FileCounterNoticeOutput struct {
+	Claim Claim
+}
+
+// FileCounterNotice disputes a pending claim. The song stays taken down until the claim is resolved.
+func (s *Service) FileCounterNotice(ctx context.Context, in FileCounterNoticeInput) (FileCounterNoticeOutput, error) {
+	var (
+		null FileCounterNoticeOutput
+		log  = logger.FromContext(ctx)
+	)
+
+	log.Debug().Stringer("claim_id", in.ClaimId).Msg("getting claim")
+
+	current, err := s.claim(ctx, in.ClaimId)
+	if err != nil {
+		return null, err
+	}
+
+	// Other users must not know about the claim
+	if current.ArtistID != in.ArtistId {
+		return null, ErrClaimNotFound
+	}
+
+	txRepo, err := s.repo.Begin(ctx)
+	if err != nil {
+		return null, e.NewFrom("begin transaction", err)
+	}
+	defer txRepo.Rollback(ctx) //nolint:errcheck
+
+	claim, err := applyTransition(ctx, txRepo, transition{ //nolint:exhaustruct
+		claim:         current,
+		from:          []postgres.ClaimStatus{postgres.ClaimStatusPending},
+		to:            postgres.ClaimStatusCounterNoticed,
+		actorId:       in.ArtistId,
+		note:          &in.Statement,
+		counterNotice: &in.Statement,
+		at:            time.Now(),
+	})
+	if err != nil {
+		return null, err
+	}
+
+	err = txRepo.Commit(ctx)
+	if err != nil {
+		return null, e.NewFrom("commit transaction", err)
+	}
+
+	broker.SendOrLog(ctx, s.broker, claimMessage(claim))
+
+	return FileCounterNoticeOutput{
+		Claim: mapClaim(claim),
+	}, nil
+}
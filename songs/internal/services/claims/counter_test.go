@@ -0,0 +1,83 @@
+package claims_test
+
+import (
+	"context"
+	"testing"
+
+	claimsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FileCounterNoticeSuite struct {
+	suite.Suite
+
+	rm *claimsmocks.ClaimRepo
+	tm *claimsmocks.ClaimRepo
+	bm *claimsmocks.Broker
+
+	s     *claims.Service
+	ctx   context.Context
+	claim postgres.Claim
+	input claims.FileCounterNoticeInput
+}
+
+func (s *FileCounterNoticeSuite) SetupTest() {
+	s.rm = claimsmocks.NewClaimRepo(s.T())
+	s.tm = claimsmocks.NewClaimRepo(s.T())
+	s.bm = claimsmocks.NewBroker(s.T())
+
+	s.s = claims.NewWithConfig(claims.Config{
+		Dependencies: claims.Dependencies{
+			ClaimRepo: s.rm,
+			SongCache: claimsmocks.NewSongCache(s.T()),
+			Broker:    s.bm,
+		},
+	})
+
+	s.ctx = context.Background()
+	s.claim = validClaim(postgres.ClaimStatusPending)
+	s.input = claims.FileCounterNoticeInput{
+		ArtistId:  s.claim.ArtistID,
+		ClaimId:   s.claim.ClaimID,
+		Statement: gofakeit.Sentence(10),
+	}
+}
+
+func (s *FileCounterNoticeSuite) TestHappyPath() {
+	noticed := s.claim
+	noticed.Status = postgres.ClaimStatusCounterNoticed
+
+	s.rm.EXPECT().Claim(mock.Anything, s.input.ClaimId).Return(s.claim, nil).Once()
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().SetClaimStatus(mock.Anything, mock.MatchedBy(func(p postgres.SetClaimStatusParams) bool {
+		return p.Status == postgres.ClaimStatusCounterNoticed && p.CounterNotice.String == s.input.Statement &&
+			len(p.FromStatuses) == 1 && p.FromStatuses[0] == string(postgres.ClaimStatusPending)
+	})).Return(noticed, nil).Once()
+	s.tm.EXPECT().SaveClaimEvent(mock.Anything, mock.Anything).Return(nil).Once()
+	s.tm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.tm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	out, err := s.s.FileCounterNotice(s.ctx, s.input)
+	s.Require().NoError(err)
+	s.Equal(postgres.ClaimStatusCounterNoticed, out.Claim.Status)
+}
+
+func (s *FileCounterNoticeSuite) TestNotArtist() {
+	s.input.ArtistId = uuid.New()
+
+	s.rm.EXPECT().Claim(mock.Anything, s.input.ClaimId).Return(s.claim, nil).Once()
+
+	_, err := s.s.FileCounterNotice(s.ctx, s.input)
+	s.ErrorIs(err, claims.ErrClaimNotFound)
+}
+
+func TestFileCounterNotice(t *testing.T) {
+	suite.Run(t, new(FileCounterNoticeSuite))
+}
@@ -0,0 +1,122 @@
+package claims
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+type GetClaimInput struct {
+	UserId  uuid.UUID
+	IsAdmin bool
+	ClaimId uuid.UUID
+}
+
+type GetClaimOutput struct {
+	Claim   Claim
+	History []ClaimEvent
+}
+
+// GetClaim returns the claim to its claimant, the artist of the song or an admin.
+// For everyone else the claim is not found.
+func (s *Service) GetClaim(ctx context.Context, in GetClaimInput) (GetClaimOutput, error) {
+	var (
+		null GetClaimOutput
+		log  = logger.FromContext(ctx)
+	)
+
+	log.Debug().Stringer("claim_id", in.ClaimId).Msg("getting claim")
+
+	claim, err := s.claim(ctx, in.ClaimId)
+	if err != nil {
+		return null, err
+	}
+
+	if !in.IsAdmin && claim.ClaimantID != in.UserId && claim.ArtistID != in.UserId {
+		return null, ErrClaimNotFound
+	}
+
+	events, err := s.repo.ClaimEvents(ctx, in.ClaimId)
+	if err != nil {
+		return null, e.NewFrom("getting claim events", err, fields.F("claim_id", in.ClaimId))
+	}
+
+	history := make([]ClaimEvent, len(events))
+	for i := range events {
+		history[i] = mapClaimEvent(events[i])
+	}
+
+	return GetClaimOutput{
+		Claim:   mapClaim(claim),
+		History: history,
+	}, nil
+}
+
+type GetClaimsInput struct {
+	UserId  uuid.UUID
+	IsAdmin bool
+	Status  *postgres.ClaimStatus
+	// pagination
+	Page     int32
+	PageSize int32
+}
+
+type GetClaimsOutput struct {
+	Claims   []Claim
+	LastPage int32
+}
+
+func (s *Service) GetClaims(ctx context.Context, in GetClaimsInput) (GetClaimsOutput, error) {
+	var (
+		null = GetClaimsOutput{Claims: []Claim{}, LastPage: 0}
+		log  = logger.FromContext(ctx)
+	)
+
+	status := ""
+	if in.Status != nil {
+		status = string(*in.Status)
+	}
+
+	log.Debug().Bool("is_admin", in.IsAdmin).Str("status", status).Msg("counting claims")
+
+	count, err := s.repo.CountClaims(ctx, postgres.CountClaimsParams{
+		AllClaims: in.IsAdmin,
+		UserID:    in.UserId,
+		ByStatus:  in.Status != nil,
+		Status:    status,
+	})
+	if err != nil {
+		return null, e.NewFrom("counting claims", err)
+	}
+
+	if count == 0 {
+		return null, nil
+	}
+
+	rows, err := s.repo.Claims(ctx, postgres.ClaimsParams{
+		AllClaims: in.IsAdmin,
+		UserID:    in.UserId,
+		ByStatus:  in.Status != nil,
+		Status:    status,
+		Offsetv:   (in.Page - 1) * in.PageSize,
+		Limitv:    in.PageSize,
+	})
+	if err != nil {
+		return null, e.NewFrom("getting claims", err)
+	}
+
+	claims := make([]Claim, len(rows))
+	for i := range rows {
+		claims[i] = mapClaim(rows[i])
+	}
+
+	return GetClaimsOutput{
+		Claims:   claims,
+		LastPage: (count-1)/in.PageSize + 1,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package claims_test
+
+import (
+	"context"
+	"testing"
+
+	claimsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetClaimSuite struct {
+	suite.Suite
+
+	rm *claimsmocks.ClaimRepo
+
+	s     *claims.Service
+	ctx   context.Context
+	claim postgres.Claim
+}
+
+func (s *GetClaimSuite) SetupTest() {
+	s.rm = claimsmocks.NewClaimRepo(s.T())
+
+	s.s = claims.NewWithConfig(claims.Config{
+		Dependencies: claims.Dependencies{
+			ClaimRepo: s.rm,
+			SongCache: claimsmocks.NewSongCache(s.T()),
+			Broker:    claimsmocks.NewBroker(s.T()),
+		},
+	})
+
+	s.ctx = context.Background()
+	s.claim = validClaim(postgres.ClaimStatusPending)
+}
+
+func (s *GetClaimSuite) TestClaimant() {
+	s.rm.EXPECT().Claim(mock.Anything, s.claim.ClaimID).Return(s.claim, nil).Once()
+	s.rm.EXPECT().ClaimEvents(mock.Anything, s.claim.ClaimID).Return([]postgres.ClaimEvent{{ //nolint:exhaustruct
+		ClaimFk:  s.claim.ClaimID,
+		ToStatus: postgres.ClaimStatusPending,
+		ActorID:  s.claim.ClaimantID,
+	}}, nil).Once()
+
+	out, err := s.s.GetClaim(s.ctx, claims.GetClaimInput{
+		UserId:  s.claim.ClaimantID,
+		IsAdmin: false,
+		ClaimId: s.claim.ClaimID,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(out.History, 1)
+	s.Nil(out.History[0].FromStatus)
+}
+
+func (s *GetClaimSuite) TestOtherUser() {
+	s.rm.EXPECT().Claim(mock.Anything, s.claim.ClaimID).Return(s.claim, nil).Once()
+
+	_, err := s.s.GetClaim(s.ctx, claims.GetClaimInput{
+		UserId:  uuid.New(),
+		IsAdmin: false,
+		ClaimId: s.claim.ClaimID,
+	})
+	s.ErrorIs(err, claims.ErrClaimNotFound)
+}
+
+func (s *GetClaimSuite) TestGetClaims_Admin() {
+	s.rm.EXPECT().CountClaims(mock.Anything, mock.MatchedBy(func(p postgres.CountClaimsParams) bool {
+		return p.AllClaims && !p.ByStatus
+	})).Return(11, nil).Once()
+	s.rm.EXPECT().Claims(mock.Anything, mock.MatchedBy(func(p postgres.ClaimsParams) bool {
+		return p.AllClaims && p.Offsetv == 0 && p.Limitv == 10
+	})).Return([]postgres.Claim{s.claim}, nil).Once()
+
+	out, err := s.s.GetClaims(s.ctx, claims.GetClaimsInput{
+		UserId:   uuid.New(),
+		IsAdmin:  true,
+		Status:   nil,
+		Page:     1,
+		PageSize: 10,
+	})
+	s.Require().NoError(err)
+	s.Len(out.Claims, 1)
+	s.Equal(int32(2), out.LastPage)
+}
+
+func TestGetClaim(t *testing.T) {
+	suite.Run(t, new(GetClaimSuite))
+}
@@ -0,0 +1,180 @@
+package claims
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func mapClaim(claim postgres.Claim) Claim {
+	return Claim{
+		Id:             claim.ClaimID,
+		SongId:         claim.SongID,
+		ArtistId:       claim.ArtistID,
+		ClaimantId:     claim.ClaimantID,
+		ClaimantName:   claim.ClaimantName,
+		ClaimantEmail:  claim.ClaimantEmail,
+		Description:    claim.Description,
+		EvidenceUrls:   claim.EvidenceUrls,
+		Status:         claim.Status,
+		CounterNotice:  pgconv.FromText(claim.CounterNotice),
+		ResolutionNote: pgconv.FromText(claim.ResolutionNote),
+		CreatedAt:      claim.CreatedAt,
+		UpdatedAt:      claim.UpdatedAt,
+	}
+}
+
+func mapClaimEvent(event postgres.ClaimEvent) ClaimEvent {
+	var from *postgres.ClaimStatus
+	if event.FromStatus.Valid {
+		from = &event.FromStatus.ClaimStatus
+	}
+
+	return ClaimEvent{
+		FromStatus: from,
+		ToStatus:   event.ToStatus,
+		ActorId:    event.ActorID,
+		Note:       pgconv.FromText(event.Note),
+		CreatedAt:  event.CreatedAt,
+	}
+}
+
+func (s *Service) claim(ctx context.Context, id uuid.UUID) (postgres.Claim, error) {
+	claim, err := s.repo.Claim(ctx, id)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return postgres.Claim{}, ErrClaimNotFound.Wrap(err, fields.F("claim_id", id))
+
+	case err != nil:
+		return postgres.Claim{}, e.NewFrom("getting claim", err, fields.F("claim_id", id))
+	}
+
+	return claim, nil
+}
+
+type transition struct {
+	claim          postgres.Claim
+	from           []postgres.ClaimStatus
+	to             postgres.ClaimStatus
+	actorId        uuid.UUID
+	note           *string
+	counterNotice  *string
+	resolutionNote *string
+	at             time.Time
+}
+
+// applyTransition changes the status of the claim and records it in the history.
+// If the claim is not in one of the from statuses, ErrInvalidTransition is returned.
+func applyTransition(ctx context.Context, tx ClaimRepo, t transition) (postgres.Claim, error) {
+	from := make([]string, len(t.from))
+	for i := range t.from {
+		from[i] = string(t.from[i])
+	}
+
+	claim, err := tx.SetClaimStatus(ctx, postgres.SetClaimStatusParams{
+		Status:         t.to,
+		CounterNotice:  pgconv.TextPtr(t.counterNotice),
+		ResolutionNote: pgconv.TextPtr(t.resolutionNote),
+		UpdatedAt:      t.at,
+		ClaimID:        t.claim.ClaimID,
+		FromStatuses:   from,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return postgres.Claim{}, ErrInvalidTransition.Wrap(err,
+			fields.F("status", t.claim.Status), fields.F("to_status", t.to))
+
+	case err != nil:
+		return postgres.Claim{}, e.NewFrom("setting claim status", err, fields.F("claim_id", t.claim.ClaimID))
+	}
+
+	err = tx.SaveClaimEvent(ctx, postgres.SaveClaimEventParams{
+		ClaimFk:    t.claim.ClaimID,
+		FromStatus: postgres.NullClaimStatus{ClaimStatus: t.claim.Status, Valid: true},
+		ToStatus:   t.to,
+		ActorID:    t.actorId,
+		Note:       pgconv.TextPtr(t.note),
+		CreatedAt:  t.at,
+	})
+	if err != nil {
+		return postgres.Claim{}, e.NewFrom("saving claim event", err, fields.F("claim_id", t.claim.ClaimID))
+	}
+
+	return claim, nil
+}
+
+func claimMessage(claim postgres.Claim) broker.ClaimMessage {
+	return broker.ClaimMessage{
+		ClaimId:    claim.ClaimID,
+		SongId:     claim.SongID,
+		ArtistId:   claim.ArtistID,
+		ClaimantId: claim.ClaimantID,
+		Status:     claim.Status,
+		OccurredAt: claim.UpdatedAt,
+	}
+}
+
+// claimReason is the moderation reason of the songs moderated by the claim.
+func claimReason(claimId uuid.UUID) string {
+	return "copyright claim " + claimId.String()
+}
+
+// priorModeration is the moderation state of a song before the claims moderated it.
+type priorModeration struct {
+	status postgres.ModerationStatus
+	reason pgtype.Text
+}
+
+// moderateSong takes the song down or puts it under review for the claim.
+// The returned flag is false if the song already has this or a stricter status.
+// A song moderated by another claim keeps the state before that claim as the prior one.
+func moderateSong(ctx context.Context,
+	tx ClaimRepo, claimId, songId uuid.UUID, status postgres.ModerationStatus, at time.Time,
+) (priorModeration, bool, error) {
+	log := logger.FromContext(ctx)
+
+	log.Info().Stringer("claim_id", claimId).Stringer("song_id", songId).
+		Str("status", string(status)).Msg("moderating claimed song")
+
+	row, err := tx.ModerateClaimedSong(ctx, postgres.ModerateClaimedSongParams{
+		ModerationStatus: status,
+		ModerationReason: claimReason(claimId),
+		ModeratedAt:      pgconv.Timestamptz(at),
+		SongID:           songId,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		log.Info().Stringer("song_id", songId).Msg("song is already moderated, keeping its moderation")
+
+		return priorModeration{}, false, nil
+
+	case err != nil:
+		return priorModeration{}, false, e.NewFrom("moderating song", err, fields.F("song_id", songId))
+	}
+
+	if row.PriorClaimID.Valid {
+		return priorModeration{
+			status: row.ClaimPriorStatus.ModerationStatus,
+			reason: row.ClaimPriorReason,
+		}, true, nil
+	}
+
+	return priorModeration{
+		status: row.PriorModerationStatus,
+		reason: row.PriorModerationReason,
+	}, true, nil
+}
@@ -0,0 +1,161 @@
+package claims
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+type ResolveClaimInput struct {
+	AdminId uuid.UUID
+	ClaimId uuid.UUID
+	Upheld  bool
+	Note    *string
+}
+
+type ResolveClaimOutput struct {
+	Claim Claim
+}
+
+// ResolveClaim upholds or rejects an open claim.
+// The song of an upheld claim is taken down if it is not already.
+// The song of a rejected claim is restored if there are no other open claims against it
+// and it is still taken down by a claim.
+func (s *Service) ResolveClaim(ctx context.Context, in ResolveClaimInput) (ResolveClaimOutput, error) {
+	var (
+		null ResolveClaimOutput
+		log  = logger.FromContext(ctx)
+	)
+
+	log.Debug().Stringer("claim_id", in.ClaimId).Bool("upheld", in.Upheld).Msg("resolving claim")
+
+	current, err := s.claim(ctx, in.ClaimId)
+	if err != nil {
+		return null, err
+	}
+
+	status := postgres.ClaimStatusRejected
+	if in.Upheld {
+		status = postgres.ClaimStatusUpheld
+	}
+
+	now := time.Now()
+
+	txRepo, err := s.repo.Begin(ctx)
+	if err != nil {
+		return null, e.NewFrom("begin transaction", err)
+	}
+	defer txRepo.Rollback(ctx) //nolint:errcheck
+
+	claim, err := applyTransition(ctx, txRepo, transition{ //nolint:exhaustruct
+		claim:          current,
+		from:           []postgres.ClaimStatus{postgres.ClaimStatusPending, postgres.ClaimStatusCounterNoticed},
+		to:             status,
+		actorId:        in.AdminId,
+		note:           in.Note,
+		resolutionNote: in.Note,
+		at:             now,
+	})
+	if err != nil {
+		return null, err
+	}
+
+	var (
+		moderatedStatus = postgres.ModerationStatusTakenDown
+		moderated       bool
+	)
+
+	if in.Upheld {
+		_, moderated, err = moderateSong(ctx, txRepo, claim.ClaimID, claim.SongID, moderatedStatus, now)
+	} else {
+		moderatedStatus, moderated, err = restoreSong(ctx, txRepo, claim, in.AdminId, now)
+	}
+
+	if err != nil {
+		return null, err
+	}
+
+	err = txRepo.Commit(ctx)
+	if err != nil {
+		return null, e.NewFrom("commit transaction", err)
+	}
+
+	messages := []broker.SongMessage{claimMessage(claim)}
+
+	if moderated {
+		s.cache.InvalidateSongs(ctx, []uuid.UUID{claim.SongID})
+
+		message := broker.SongModeratedMessage{ //nolint:exhaustruct
+			SongId:      claim.SongID,
+			ArtistId:    claim.ArtistID,
+			Status:      moderatedStatus,
+			ModeratedAt: now,
+		}
+
+		if in.Upheld {
+			reason := claimReason(claim.ClaimID)
+			message.Reason = &reason
+		}
+
+		messages = append(messages, message)
+	}
+
+	broker.SendOrLog(ctx, s.broker, messages...)
+
+	return ResolveClaimOutput{
+		Claim: mapClaim(claim),
+	}, nil
+}
+
+// restoreSong gives the song back the moderation state it had before the claims moderated it.
+// The returned flag is false if the song is not restored.
+func restoreSong(ctx context.Context,
+	tx ClaimRepo, claim postgres.Claim, adminId uuid.UUID, at time.Time,
+) (postgres.ModerationStatus, bool, error) {
+	log := logger.FromContext(ctx)
+
+	openClaims, err := tx.CountOpenClaims(ctx, postgres.CountOpenClaimsParams{
+		SongID:  claim.SongID,
+		ClaimID: claim.ClaimID,
+	})
+	if err != nil {
+		return "", false, e.NewFrom("counting open claims", err, fields.F("song_id", claim.SongID))
+	}
+
+	if openClaims > 0 {
+		log.Info().Int32("open_claims", openClaims).Stringer("song_id", claim.SongID).
+			Msg("song has other open claims, not restoring")
+
+		return "", false, nil
+	}
+
+	log.Info().Stringer("song_id", claim.SongID).Msg("restoring song")
+
+	status, err := tx.RestoreClaimedSong(ctx, postgres.RestoreClaimedSongParams{
+		ModeratorID: pgconv.UUID(adminId),
+		ModeratedAt: pgconv.Timestamptz(at),
+		SongID:      claim.SongID,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		log.Info().Stringer("song_id", claim.SongID).Msg("song is not moderated by a claim, not restoring")
+
+		return "", false, nil
+
+	case err != nil:
+		return "", false, e.NewFrom("restoring song", err, fields.F("song_id", claim.SongID))
+	}
+
+	return status, true, nil
+}
@@ -0,0 +1,198 @@
+package claims_test
+
+import (
+	"context"
+	"testing"
+
+	claimsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResolveClaimSuite struct {
+	suite.Suite
+
+	rm *claimsmocks.ClaimRepo
+	tm *claimsmocks.ClaimRepo
+	cm *claimsmocks.SongCache
+	bm *claimsmocks.Broker
+
+	s     *claims.Service
+	ctx   context.Context
+	claim postgres.Claim
+}
+
+func (s *ResolveClaimSuite) SetupTest() {
+	s.rm = claimsmocks.NewClaimRepo(s.T())
+	s.tm = claimsmocks.NewClaimRepo(s.T())
+	s.cm = claimsmocks.NewSongCache(s.T())
+	s.bm = claimsmocks.NewBroker(s.T())
+
+	s.s = claims.NewWithConfig(claims.Config{
+		Dependencies: claims.Dependencies{
+			ClaimRepo: s.rm,
+			SongCache: s.cm,
+			Broker:    s.bm,
+		},
+	})
+
+	s.ctx = context.Background()
+	s.claim = validClaim(postgres.ClaimStatusCounterNoticed)
+}
+
+func (s *ResolveClaimSuite) expectTransition(to postgres.ClaimStatus) {
+	resolved := s.claim
+	resolved.Status = to
+
+	s.rm.EXPECT().Claim(mock.Anything, s.claim.ClaimID).Return(s.claim, nil).Once()
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().SetClaimStatus(mock.Anything, mock.MatchedBy(func(p postgres.SetClaimStatusParams) bool {
+		return p.ClaimID == s.claim.ClaimID && p.Status == to
+	})).Return(resolved, nil).Once()
+	s.tm.EXPECT().SaveClaimEvent(mock.Anything, mock.MatchedBy(func(p postgres.SaveClaimEventParams) bool {
+		return p.FromStatus.ClaimStatus == s.claim.Status && p.ToStatus == to
+	})).Return(nil).Once()
+	s.tm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+}
+
+func (s *ResolveClaimSuite) TestUpheld() {
+	s.expectTransition(postgres.ClaimStatusUpheld)
+	s.tm.EXPECT().ModerateClaimedSong(mock.Anything, mock.Anything).
+		Return(postgres.ModerateClaimedSongRow{}, repoerrs.ErrEmptyResult).Once() //nolint:exhaustruct
+	s.tm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(m []broker.SongMessage) bool {
+		return len(m) == 1
+	})).Return(nil).Once()
+
+	out, err := s.s.ResolveClaim(s.ctx, claims.ResolveClaimInput{
+		AdminId: uuid.New(),
+		ClaimId: s.claim.ClaimID,
+		Upheld:  true,
+		Note:    nil,
+	})
+	s.Require().NoError(err)
+	s.Equal(postgres.ClaimStatusUpheld, out.Claim.Status)
+}
+
+func (s *ResolveClaimSuite) TestUpheld_TakesSongDown() {
+	s.expectTransition(postgres.ClaimStatusUpheld)
+	s.tm.EXPECT().ModerateClaimedSong(mock.Anything, mock.MatchedBy(func(p postgres.ModerateClaimedSongParams) bool {
+		return p.SongID == s.claim.SongID && p.ModerationStatus == postgres.ModerationStatusTakenDown
+	})).Return(postgres.ModerateClaimedSongRow{ //nolint:exhaustruct
+		PriorModerationStatus: postgres.ModerationStatusUnderReview,
+	}, nil).Once()
+	s.tm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.cm.EXPECT().InvalidateSongs(mock.Anything, []uuid.UUID{s.claim.SongID}).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(m []broker.SongMessage) bool {
+		moderated, ok := m[len(m)-1].(broker.SongModeratedMessage)
+
+		return ok && moderated.Status == postgres.ModerationStatusTakenDown
+	})).Return(nil).Once()
+
+	_, err := s.s.ResolveClaim(s.ctx, claims.ResolveClaimInput{
+		AdminId: uuid.New(),
+		ClaimId: s.claim.ClaimID,
+		Upheld:  true,
+		Note:    nil,
+	})
+	s.NoError(err)
+}
+
+func (s *ResolveClaimSuite) TestRejected_RestoresSong() {
+	s.expectTransition(postgres.ClaimStatusRejected)
+	s.tm.EXPECT().CountOpenClaims(mock.Anything, postgres.CountOpenClaimsParams{
+		SongID:  s.claim.SongID,
+		ClaimID: s.claim.ClaimID,
+	}).Return(0, nil).Once()
+	s.tm.EXPECT().RestoreClaimedSong(mock.Anything, mock.MatchedBy(func(p postgres.RestoreClaimedSongParams) bool {
+		return p.SongID == s.claim.SongID
+	})).Return(postgres.ModerationStatusUnderReview, nil).Once()
+	s.tm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.cm.EXPECT().InvalidateSongs(mock.Anything, []uuid.UUID{s.claim.SongID}).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(m []broker.SongMessage) bool {
+		moderated, ok := m[len(m)-1].(broker.SongModeratedMessage)
+
+		return ok && moderated.Status == postgres.ModerationStatusUnderReview
+	})).Return(nil).Once()
+
+	_, err := s.s.ResolveClaim(s.ctx, claims.ResolveClaimInput{
+		AdminId: uuid.New(),
+		ClaimId: s.claim.ClaimID,
+		Upheld:  false,
+		Note:    nil,
+	})
+	s.NoError(err)
+}
+
+func (s *ResolveClaimSuite) TestRejected_NotTakenDownByClaim() {
+	s.expectTransition(postgres.ClaimStatusRejected)
+	s.tm.EXPECT().CountOpenClaims(mock.Anything, mock.Anything).Return(0, nil).Once()
+	s.tm.EXPECT().RestoreClaimedSong(mock.Anything, mock.Anything).
+		Return("", repoerrs.ErrEmptyResult).Once()
+	s.tm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(m []broker.SongMessage) bool {
+		return len(m) == 1
+	})).Return(nil).Once()
+
+	_, err := s.s.ResolveClaim(s.ctx, claims.ResolveClaimInput{
+		AdminId: uuid.New(),
+		ClaimId: s.claim.ClaimID,
+		Upheld:  false,
+		Note:    nil,
+	})
+	s.NoError(err)
+}
+
+func (s *ResolveClaimSuite) TestRejected_OtherOpenClaims() {
+	s.expectTransition(postgres.ClaimStatusRejected)
+	s.tm.EXPECT().CountOpenClaims(mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.tm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.ResolveClaim(s.ctx, claims.ResolveClaimInput{
+		AdminId: uuid.New(),
+		ClaimId: s.claim.ClaimID,
+		Upheld:  false,
+		Note:    nil,
+	})
+	s.NoError(err)
+}
+
+func (s *ResolveClaimSuite) TestAlreadyResolved() {
+	s.rm.EXPECT().Claim(mock.Anything, s.claim.ClaimID).Return(s.claim, nil).Once()
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().SetClaimStatus(mock.Anything, mock.Anything).
+		Return(postgres.Claim{}, repoerrs.ErrEmptyResult).Once() //nolint:exhaustruct
+	s.tm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	_, err := s.s.ResolveClaim(s.ctx, claims.ResolveClaimInput{
+		AdminId: uuid.New(),
+		ClaimId: s.claim.ClaimID,
+		Upheld:  true,
+		Note:    nil,
+	})
+	s.ErrorIs(err, claims.ErrInvalidTransition)
+}
+
+func (s *ResolveClaimSuite) TestClaim_EmptyResultError() {
+	s.rm.EXPECT().Claim(mock.Anything, mock.Anything).
+		Return(postgres.Claim{}, repoerrs.ErrEmptyResult).Once() //nolint:exhaustruct
+
+	_, err := s.s.ResolveClaim(s.ctx, claims.ResolveClaimInput{
+		AdminId: uuid.New(),
+		ClaimId: uuid.New(),
+		Upheld:  true,
+		Note:    nil,
+	})
+	s.ErrorIs(err, claims.ErrClaimNotFound)
+}
+
+func TestResolveClaim(t *testing.T) {
+	suite.Run(t, new(ResolveClaimSuite))
+}
@@ -0,0 +1,156 @@
+package claims
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+type SubmitClaimInput struct {
+	ClaimantId    uuid.UUID
+	SongId        uuid.UUID
+	ClaimantName  string
+	ClaimantEmail string
+	Description   string
+	EvidenceUrls  []string
+}
+
+type SubmitClaimOutput struct {
+	Claim Claim
+}
+
+// SubmitClaim saves a pending claim and moderates the song in one transaction.
+// The song of a verified claimant is taken down, the songs of other claimants are put under review.
+// A song with this or a stricter moderation status keeps it, the claim doesn't overwrite it.
+func (s *Service) SubmitClaim(ctx context.Context, in SubmitClaimInput) (SubmitClaimOutput, error) {
+	var (
+		null SubmitClaimOutput
+		log  = logger.FromContext(ctx)
+	)
+
+	log.Debug().Stringer("song_id", in.SongId).Msg("getting claimed song")
+
+	song, err := s.repo.ClaimedSong(ctx, in.SongId)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return null, ErrSongNotFound.Wrap(err, fields.F("song_id", in.SongId))
+
+	case err != nil:
+		return null, e.NewFrom("getting claimed song", err, fields.F("song_id", in.SongId))
+
+	case song.SingerFk == in.ClaimantId:
+		return null, ErrOwnSong
+	}
+
+	if s.c.MaxOpenPerClaimant > 0 {
+		count, err := s.repo.CountClaimantOpenClaims(ctx, in.ClaimantId)
+		if err != nil {
+			return null, e.NewFrom("counting open claims", err, fields.F("claimant_id", in.ClaimantId))
+		}
+
+		if count >= s.c.MaxOpenPerClaimant {
+			return null, ErrTooManyClaims
+		}
+	}
+
+	verified, err := s.repo.ClaimantVerified(ctx, in.ClaimantId)
+	if err != nil {
+		return null, e.NewFrom("checking claimant", err, fields.F("claimant_id", in.ClaimantId))
+	}
+
+	status := postgres.ModerationStatusUnderReview
+	if verified {
+		status = postgres.ModerationStatusTakenDown
+	}
+
+	now := time.Now()
+
+	txRepo, err := s.repo.Begin(ctx)
+	if err != nil {
+		return null, e.NewFrom("begin transaction", err)
+	}
+	defer txRepo.Rollback(ctx) //nolint:errcheck
+
+	claimId := uuid.New()
+
+	prior, moderated, err := moderateSong(ctx, txRepo, claimId, in.SongId, status, now)
+	if err != nil {
+		return null, err
+	}
+
+	params := postgres.SaveClaimParams{ //nolint:exhaustruct
+		ClaimID:       claimId,
+		SongID:        in.SongId,
+		SongName:      song.Name,
+		ArtistID:      song.SingerFk,
+		ClaimantID:    in.ClaimantId,
+		ClaimantName:  in.ClaimantName,
+		ClaimantEmail: in.ClaimantEmail,
+		Description:   in.Description,
+		EvidenceUrls:  in.EvidenceUrls,
+		CreatedAt:     now,
+	}
+
+	if moderated {
+		params.PriorModerationStatus = postgres.NullModerationStatus{
+			ModerationStatus: prior.status,
+			Valid:            true,
+		}
+		params.PriorModerationReason = prior.reason
+	}
+
+	claim, err := txRepo.SaveClaim(ctx, params)
+	if err != nil {
+		return null, e.NewFrom("saving claim", err, fields.F("song_id", in.SongId))
+	}
+
+	err = txRepo.SaveClaimEvent(ctx, postgres.SaveClaimEventParams{
+		ClaimFk:    claim.ClaimID,
+		FromStatus: postgres.NullClaimStatus{}, //nolint:exhaustruct
+		ToStatus:   claim.Status,
+		ActorID:    in.ClaimantId,
+		Note:       pgconv.NullText(),
+		CreatedAt:  now,
+	})
+	if err != nil {
+		return null, e.NewFrom("saving claim event", err, fields.F("claim_id", claim.ClaimID))
+	}
+
+	err = txRepo.Commit(ctx)
+	if err != nil {
+		return null, e.NewFrom("commit transaction", err)
+	}
+
+	messages := []broker.SongMessage{claimMessage(claim)}
+
+	if moderated {
+		s.cache.InvalidateSongs(ctx, []uuid.UUID{in.SongId})
+
+		reason := claimReason(claimId)
+
+		messages = append(messages, broker.SongModeratedMessage{
+			SongId:      in.SongId,
+			ArtistId:    song.SingerFk,
+			Status:      status,
+			Reason:      &reason,
+			ModeratedAt: now,
+		})
+	}
+
+	broker.SendOrLog(ctx, s.broker, messages...)
+
+	return SubmitClaimOutput{
+		Claim: mapClaim(claim),
+	}, nil
+}
@@ -0,0 +1,268 @@
+package claims_test
+
+import (
+	"context"
+	"testing"
+
+	claimsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SubmitClaimSuite struct {
+	suite.Suite
+
+	rm *claimsmocks.ClaimRepo
+	tm *claimsmocks.ClaimRepo
+	cm *claimsmocks.SongCache
+	bm *claimsmocks.Broker
+
+	s     *claims.Service
+	ctx   context.Context
+	input claims.SubmitClaimInput
+	song  postgres.ClaimedSongRow
+}
+
+func (s *SubmitClaimSuite) SetupTest() {
+	s.rm = claimsmocks.NewClaimRepo(s.T())
+	s.tm = claimsmocks.NewClaimRepo(s.T())
+	s.cm = claimsmocks.NewSongCache(s.T())
+	s.bm = claimsmocks.NewBroker(s.T())
+
+	s.s = claims.NewWithConfig(claims.Config{
+		Dependencies: claims.Dependencies{
+			ClaimRepo: s.rm,
+			SongCache: s.cm,
+			Broker:    s.bm,
+		},
+		MaxOpenPerClaimant: 3,
+	})
+
+	s.ctx = context.Background()
+	s.input = validSubmitClaimInput()
+	s.song = postgres.ClaimedSongRow{
+		SingerFk: uuid.New(),
+		Name:     gofakeit.Sentence(3),
+	}
+}
+
+func (s *SubmitClaimSuite) expectClaimant(openClaims int32, verified bool) {
+	s.rm.EXPECT().ClaimedSong(mock.Anything, s.input.SongId).Return(s.song, nil).Once()
+	s.rm.EXPECT().CountClaimantOpenClaims(mock.Anything, s.input.ClaimantId).Return(openClaims, nil).Once()
+	s.rm.EXPECT().ClaimantVerified(mock.Anything, s.input.ClaimantId).Return(verified, nil).Once()
+}
+
+func (s *SubmitClaimSuite) expectSave(claim postgres.Claim, prior *postgres.ModerationStatus) {
+	s.tm.EXPECT().SaveClaim(mock.Anything, mock.MatchedBy(func(p postgres.SaveClaimParams) bool {
+		if prior == nil {
+			return !p.PriorModerationStatus.Valid
+		}
+
+		return p.SongID == s.input.SongId && p.SongName == s.song.Name && p.ClaimantID == s.input.ClaimantId &&
+			p.PriorModerationStatus.Valid && p.PriorModerationStatus.ModerationStatus == *prior
+	})).Return(claim, nil).Once()
+	s.tm.EXPECT().SaveClaimEvent(mock.Anything, mock.MatchedBy(func(p postgres.SaveClaimEventParams) bool {
+		return !p.FromStatus.Valid && p.ToStatus == postgres.ClaimStatusPending
+	})).Return(nil).Once()
+	s.tm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.tm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+}
+
+func (s *SubmitClaimSuite) TestHappyPath() {
+	claim := s.claim()
+	prior := postgres.ModerationStatusActive
+
+	s.expectClaimant(0, true)
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().ModerateClaimedSong(mock.Anything, mock.MatchedBy(func(p postgres.ModerateClaimedSongParams) bool {
+		return p.SongID == s.input.SongId && p.ModerationStatus == postgres.ModerationStatusTakenDown
+	})).Return(postgres.ModerateClaimedSongRow{ //nolint:exhaustruct
+		PriorModerationStatus: prior,
+	}, nil).Once()
+	s.expectSave(claim, &prior)
+	s.cm.EXPECT().InvalidateSongs(mock.Anything, []uuid.UUID{s.input.SongId}).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(m []broker.SongMessage) bool {
+		moderated, ok := m[len(m)-1].(broker.SongModeratedMessage)
+
+		return len(m) == 2 && ok && moderated.Status == postgres.ModerationStatusTakenDown
+	})).Return(nil).Once()
+
+	out, err := s.s.SubmitClaim(s.ctx, s.input)
+	s.Require().NoError(err)
+	s.Equal(claim.ClaimID, out.Claim.Id)
+	s.Equal(s.song.SingerFk, out.Claim.ArtistId)
+}
+
+func (s *SubmitClaimSuite) TestNotVerified_UnderReview() {
+	claim := s.claim()
+	prior := postgres.ModerationStatusActive
+
+	s.expectClaimant(2, false)
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().ModerateClaimedSong(mock.Anything, mock.MatchedBy(func(p postgres.ModerateClaimedSongParams) bool {
+		return p.ModerationStatus == postgres.ModerationStatusUnderReview
+	})).Return(postgres.ModerateClaimedSongRow{ //nolint:exhaustruct
+		PriorModerationStatus: prior,
+	}, nil).Once()
+	s.expectSave(claim, &prior)
+	s.cm.EXPECT().InvalidateSongs(mock.Anything, []uuid.UUID{s.input.SongId}).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(m []broker.SongMessage) bool {
+		moderated, ok := m[len(m)-1].(broker.SongModeratedMessage)
+
+		return len(m) == 2 && ok && moderated.Status == postgres.ModerationStatusUnderReview
+	})).Return(nil).Once()
+
+	_, err := s.s.SubmitClaim(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *SubmitClaimSuite) TestModeratedByOtherClaim() {
+	claim := s.claim()
+	prior := postgres.ModerationStatusActive
+
+	s.expectClaimant(0, true)
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().ModerateClaimedSong(mock.Anything, mock.Anything).Return(postgres.ModerateClaimedSongRow{
+		PriorModerationStatus: postgres.ModerationStatusUnderReview,
+		PriorModerationReason: pgtype.Text{String: "copyright claim " + uuid.NewString(), Valid: true},
+		PriorClaimID:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		ClaimPriorStatus:      postgres.NullModerationStatus{ModerationStatus: prior, Valid: true},
+		ClaimPriorReason:      pgtype.Text{}, //nolint:exhaustruct
+	}, nil).Once()
+	s.expectSave(claim, &prior)
+	s.cm.EXPECT().InvalidateSongs(mock.Anything, []uuid.UUID{s.input.SongId}).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.SubmitClaim(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *SubmitClaimSuite) TestAlreadyTakenDown() {
+	claim := s.claim()
+
+	s.expectClaimant(0, true)
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().ModerateClaimedSong(mock.Anything, mock.Anything).
+		Return(postgres.ModerateClaimedSongRow{}, repoerrs.ErrEmptyResult).Once() //nolint:exhaustruct
+	s.expectSave(claim, nil)
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(m []broker.SongMessage) bool {
+		return len(m) == 1
+	})).Return(nil).Once()
+
+	_, err := s.s.SubmitClaim(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *SubmitClaimSuite) TestTooManyClaims() {
+	s.rm.EXPECT().ClaimedSong(mock.Anything, s.input.SongId).Return(s.song, nil).Once()
+	s.rm.EXPECT().CountClaimantOpenClaims(mock.Anything, s.input.ClaimantId).Return(3, nil).Once()
+
+	_, err := s.s.SubmitClaim(s.ctx, s.input)
+	s.ErrorIs(err, claims.ErrTooManyClaims)
+}
+
+func (s *SubmitClaimSuite) TestUnlimitedClaims() {
+	s.s = claims.NewWithConfig(claims.Config{
+		Dependencies: claims.Dependencies{
+			ClaimRepo: s.rm,
+			SongCache: s.cm,
+			Broker:    s.bm,
+		},
+	})
+
+	s.rm.EXPECT().ClaimedSong(mock.Anything, s.input.SongId).Return(s.song, nil).Once()
+	s.rm.EXPECT().ClaimantVerified(mock.Anything, s.input.ClaimantId).Return(false, nil).Once()
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().ModerateClaimedSong(mock.Anything, mock.Anything).
+		Return(postgres.ModerateClaimedSongRow{}, repoerrs.ErrEmptyResult).Once() //nolint:exhaustruct
+	s.expectSave(s.claim(), nil)
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.SubmitClaim(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *SubmitClaimSuite) TestCountClaimantOpenClaimsError() {
+	s.rm.EXPECT().ClaimedSong(mock.Anything, s.input.SongId).Return(s.song, nil).Once()
+	s.rm.EXPECT().CountClaimantOpenClaims(mock.Anything, s.input.ClaimantId).
+		Return(0, gofakeit.ErrorDatabase()).Once()
+
+	_, err := s.s.SubmitClaim(s.ctx, s.input)
+	s.Error(err)
+}
+
+func (s *SubmitClaimSuite) TestOwnSong() {
+	s.song.SingerFk = s.input.ClaimantId
+
+	s.rm.EXPECT().ClaimedSong(mock.Anything, s.input.SongId).Return(s.song, nil).Once()
+
+	_, err := s.s.SubmitClaim(s.ctx, s.input)
+	s.ErrorIs(err, claims.ErrOwnSong)
+}
+
+func (s *SubmitClaimSuite) TestClaimedSong_EmptyResultError() {
+	s.rm.EXPECT().ClaimedSong(mock.Anything, mock.Anything).
+		Return(postgres.ClaimedSongRow{}, repoerrs.ErrEmptyResult).Once() //nolint:exhaustruct
+
+	_, err := s.s.SubmitClaim(s.ctx, s.input)
+	s.ErrorIs(err, claims.ErrSongNotFound)
+}
+
+func (s *SubmitClaimSuite) TestModerateClaimedSongError() {
+	s.expectClaimant(0, true)
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().ModerateClaimedSong(mock.Anything, mock.Anything).
+		Return(postgres.ModerateClaimedSongRow{}, gofakeit.ErrorDatabase()).Once() //nolint:exhaustruct
+	s.tm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	_, err := s.s.SubmitClaim(s.ctx, s.input)
+	s.Error(err)
+}
+
+func (s *SubmitClaimSuite) claim() postgres.Claim {
+	claim := validClaim(postgres.ClaimStatusPending)
+	claim.SongID = s.input.SongId
+	claim.ArtistID = s.song.SingerFk
+
+	return claim
+}
+
+func validSubmitClaimInput() claims.SubmitClaimInput {
+	return claims.SubmitClaimInput{
+		ClaimantId:    uuid.New(),
+		SongId:        uuid.New(),
+		ClaimantName:  gofakeit.Company(),
+		ClaimantEmail: gofakeit.Email(),
+		Description:   gofakeit.Sentence(10),
+		EvidenceUrls:  []string{gofakeit.URL()},
+	}
+}
+
+func validClaim(status postgres.ClaimStatus) postgres.Claim {
+	return postgres.Claim{ //nolint:exhaustruct
+		ClaimID:       uuid.New(),
+		SongID:        uuid.New(),
+		SongName:      gofakeit.Sentence(3),
+		ArtistID:      uuid.New(),
+		ClaimantID:    uuid.New(),
+		ClaimantName:  gofakeit.Company(),
+		ClaimantEmail: gofakeit.Email(),
+		Description:   gofakeit.Sentence(10),
+		EvidenceUrls:  []string{gofakeit.URL()},
+		Status:        status,
+		CreatedAt:     gofakeit.PastDate(),
+		UpdatedAt:     gofakeit.PastDate(),
+	}
+}
+
+func TestSubmitClaim(t *testing.T) {
+	suite.Run(t, new(SubmitClaimSuite))
+}
@@ -0,0 +1,52 @@
+package claims
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+type VerifyClaimantInput struct {
+	AdminId    uuid.UUID
+	ClaimantId uuid.UUID
+	Verified   bool
+}
+
+type VerifyClaimantOutput struct{}
+
+// VerifyClaimant lets the claims of the claimant take the songs down at once, or stops it.
+// Claims submitted before keep their moderation.
+func (s *Service) VerifyClaimant(ctx context.Context, in VerifyClaimantInput) (VerifyClaimantOutput, error) {
+	var (
+		null VerifyClaimantOutput
+		log  = logger.FromContext(ctx)
+	)
+
+	log.Info().Stringer("claimant_id", in.ClaimantId).Bool("verified", in.Verified).Msg("verifying claimant")
+
+	if !in.Verified {
+		err := s.repo.UnverifyClaimant(ctx, in.ClaimantId)
+		if err != nil {
+			return null, e.NewFrom("unverifying claimant", err, fields.F("claimant_id", in.ClaimantId))
+		}
+
+		return VerifyClaimantOutput{}, nil
+	}
+
+	err := s.repo.VerifyClaimant(ctx, postgres.VerifyClaimantParams{
+		ClaimantID: in.ClaimantId,
+		VerifiedBy: in.AdminId,
+		VerifiedAt: time.Now(),
+	})
+	if err != nil {
+		return null, e.NewFrom("verifying claimant", err, fields.F("claimant_id", in.ClaimantId))
+	}
+
+	return VerifyClaimantOutput{}, nil
+}
@@ -0,0 +1,71 @@
+package claims_test
+
+import (
+	"context"
+	"testing"
+
+	claimsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/claims"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type VerifyClaimantSuite struct {
+	suite.Suite
+
+	rm *claimsmocks.ClaimRepo
+
+	s     *claims.Service
+	ctx   context.Context
+	input claims.VerifyClaimantInput
+}
+
+func (s *VerifyClaimantSuite) SetupTest() {
+	s.rm = claimsmocks.NewClaimRepo(s.T())
+
+	s.s = claims.NewWithConfig(claims.Config{
+		Dependencies: claims.Dependencies{
+			ClaimRepo: s.rm,
+		},
+	})
+
+	s.ctx = context.Background()
+	s.input = claims.VerifyClaimantInput{
+		AdminId:    uuid.New(),
+		ClaimantId: uuid.New(),
+		Verified:   true,
+	}
+}
+
+func (s *VerifyClaimantSuite) TestVerified() {
+	s.rm.EXPECT().VerifyClaimant(mock.Anything, mock.MatchedBy(func(p postgres.VerifyClaimantParams) bool {
+		return p.ClaimantID == s.input.ClaimantId && p.VerifiedBy == s.input.AdminId
+	})).Return(nil).Once()
+
+	_, err := s.s.VerifyClaimant(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *VerifyClaimantSuite) TestUnverified() {
+	s.input.Verified = false
+
+	s.rm.EXPECT().UnverifyClaimant(mock.Anything, s.input.ClaimantId).Return(nil).Once()
+
+	_, err := s.s.VerifyClaimant(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *VerifyClaimantSuite) TestVerifyClaimantError() {
+	s.rm.EXPECT().VerifyClaimant(mock.Anything, mock.Anything).Return(gofakeit.ErrorDatabase()).Once()
+
+	_, err := s.s.VerifyClaimant(s.ctx, s.input)
+	s.Error(err)
+}
+
+func TestVerifyClaimant(t *testing.T) {
+	suite.Run(t, new(VerifyClaimantSuite))
+}
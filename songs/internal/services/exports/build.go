@@ -0,0 +1,291 @@
+package exports
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+const (
+	// songsPageSize is how many songs are read at once while building an export.
+	songsPageSize = 100
+	manifestName  = "manifest.json"
+)
+
+// BuildNextExport builds the oldest queued export and reports whether there was one.
+// A failed export is finished as failed, only errors of the queue itself are returned.
+func (s *Service) BuildNextExport(ctx context.Context) (bool, error) {
+	log := logger.FromContext(ctx)
+
+	export, err := s.repo.StartExport(ctx, pgconv.Timestamptz(time.Now().Add(-s.c.StaleAfter)))
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return false, nil
+
+	case err != nil:
+		return false, e.NewFrom("starting export", err)
+	}
+
+	log = log.With().Stringer("export_id", export.ExportID).Stringer("artist_id", export.ArtistFk).Logger()
+	log.Info().Msg("building export")
+
+	params := postgres.FinishExportParams{
+		Status:      postgres.ExportStatusReady,
+		Error:       pgconv.NullText(),
+		WeightBytes: pgconv.NullInt8(),
+		// Failed exports are kept as long, so the artist sees why
+		ExpiresAt: pgconv.Timestamptz(time.Now().Add(s.c.LinkTtl)),
+		ExportID:  export.ExportID,
+	}
+
+	size, err := s.build(ctx, export)
+	if err != nil {
+		log.Error().Err(err).Msg("building export")
+
+		params.Status = postgres.ExportStatusFailed
+		params.Error = pgconv.Text(erix.LastReason(err))
+	} else {
+		log.Info().Int64("weight_bytes", size).Msg("export is ready")
+
+		params.WeightBytes = pgconv.Int8(size)
+	}
+
+	_, err = s.repo.FinishExport(ctx, params)
+	if err != nil && !errors.Is(err, repoerrs.ErrEmptyResult) {
+		return true, e.NewFrom("finishing export", err, fields.F("export_id", export.ExportID))
+	}
+
+	return true, nil
+}
+
+// build streams the zip of the export to the object storage and returns its size.
+func (s *Service) build(ctx context.Context, export postgres.Export) (int64, error) {
+	reader, writer := io.Pipe()
+
+	go func() {
+		// The put stops reading on error, the write fails then and the pipe is closed
+		_ = writer.CloseWithError(s.writeCatalog(ctx, export.ArtistFk, writer))
+	}()
+
+	size, err := s.storage.PutExportObject(ctx, objectId(export.ExportID), reader)
+	_ = reader.CloseWithError(err)
+
+	if err != nil {
+		return 0, e.NewFrom("putting export object", err)
+	}
+
+	return size, nil
+}
+
+// writeCatalog writes a zip with the songs of the artist, their images and the manifest.
+// The manifest follows the format of imports, so the catalog can be imported back.
+func (s *Service) writeCatalog(ctx context.Context, artistId uuid.UUID, w io.Writer) error {
+	var (
+		log      = logger.FromContext(ctx)
+		archive  = zip.NewWriter(w)
+		manifest = make([]manifestSong, 0, songsPageSize)
+	)
+
+	for offset := int32(0); ; offset += songsPageSize {
+		rows, err := s.repo.MySongs(ctx, postgres.MySongsParams{
+			SingerID: artistId,
+			ByIds:    false,
+			Ids:      nil,
+			Offsetv:  offset,
+			Limitv:   songsPageSize,
+		})
+		if err != nil && !errors.Is(err, repoerrs.ErrEmptyResult) {
+			return e.NewFrom("getting songs", err, fields.F("offset", offset))
+		}
+
+		for _, row := range rows {
+			song, err := s.writeSong(ctx, archive, row)
+			if err != nil {
+				return err
+			}
+
+			manifest = append(manifest, song)
+		}
+
+		if len(rows) < songsPageSize {
+			break
+		}
+	}
+
+	log.Debug().Int("songs", len(manifest)).Msg("writing manifest")
+
+	err := s.nameCredits(ctx, manifest)
+	if err != nil {
+		return err
+	}
+
+	file, err := archive.Create(manifestName)
+	if err != nil {
+		return e.NewFrom("creating manifest", err)
+	}
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+
+	err = encoder.Encode(manifest)
+	if err != nil {
+		return e.NewFrom("writing manifest", err)
+	}
+
+	err = archive.Close()
+	if err != nil {
+		return e.NewFrom("closing zip", err)
+	}
+
+	return nil
+}
+
+// writeSong copies the objects of the song to the zip and describes it for the manifest.
+func (s *Service) writeSong(ctx context.Context, archive *zip.Writer, row postgres.MySongsRow) (manifestSong, error) {
+	song := newManifestSong(row)
+
+	if row.Song.AudioState == postgres.AudioStateReady {
+		song.File = "songs/" + row.Song.S3ObjectName.String
+
+		err := copyObject(archive, song.File, func() (io.Reader, error) {
+			return s.storage.GetSongObject(ctx, row.Song.S3ObjectName.String)
+		})
+		if err != nil {
+			return song, e.NewFrom("copying song object", err, fields.F("song_id", row.Song.SongID))
+		}
+	}
+
+	imageId, ok := s.images.ImageObjectId(row.Song.ImageUrl.String)
+	if ok && row.Song.ImageUrl.Valid {
+		song.Image = "images/" + imageId
+
+		err := copyObject(archive, song.Image, func() (io.Reader, error) {
+			return s.storage.GetImageObject(ctx, imageId)
+		})
+		if err != nil {
+			return song, e.NewFrom("copying image object", err, fields.F("song_id", row.Song.SongID))
+		}
+	}
+
+	return song, nil
+}
+
+// copyObject stores the object as is, audio and images are compressed already.
+func copyObject(archive *zip.Writer, name string, get func() (io.Reader, error)) error {
+	object, err := get()
+	if err != nil {
+		return err
+	}
+
+	if closer, ok := object.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	file, err := archive.CreateHeader(&zip.FileHeader{ //nolint:exhaustruct
+		Name:     name,
+		Method:   zip.Store,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return e.NewFrom("creating zip file", err)
+	}
+
+	_, err = io.Copy(file, object)
+	if err != nil {
+		return e.NewFrom("copying object", err)
+	}
+
+	return nil
+}
+
+// nameCredits takes the names of credited artists from their accounts, credits keep no names for them.
+func (s *Service) nameCredits(ctx context.Context, manifest []manifestSong) error {
+	var ids []uuid.UUID
+
+	for _, song := range manifest {
+		for _, credit := range song.Credits {
+			if credit.ArtistId != nil && credit.Name == "" && !slices.Contains(ids, *credit.ArtistId) {
+				ids = append(ids, *credit.ArtistId)
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	artists, err := s.users.ArtistsByIds(ctx, ids)
+	if err != nil && !errors.Is(err, repoerrs.ErrEmptyResult) {
+		return e.NewFrom("getting credited artists", err)
+	}
+
+	for i := range manifest {
+		for j, credit := range manifest[i].Credits {
+			if credit.ArtistId == nil || credit.Name != "" {
+				continue
+			}
+
+			idx := slices.IndexFunc(artists, func(a users.Artist) bool {
+				return a.Id == *credit.ArtistId
+			})
+			if idx != -1 {
+				manifest[i].Credits[j].Name = artists[idx].Name
+			}
+		}
+	}
+
+	return nil
+}
+
+// PurgeExpiredExports removes at most limit expired exports with their objects
+// and returns the number of removed exports.
+func (s *Service) PurgeExpiredExports(ctx context.Context, limit int32) (int, error) {
+	exports, err := s.repo.ExpiredExports(ctx, limit)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult) || (len(exports) == 0 && err == nil):
+		return 0, nil
+
+	case err != nil:
+		return 0, e.NewFrom("getting expired exports", err)
+	}
+
+	ids := make([]uuid.UUID, len(exports))
+
+	// Objects are removed first, if it fails, the exports are removed by the next purge
+	for i, export := range exports {
+		ids[i] = export.ExportID
+
+		if export.Status != postgres.ExportStatusReady {
+			continue
+		}
+
+		err = s.storage.DeleteExportObject(ctx, objectId(export.ExportID))
+		if err != nil {
+			return 0, e.NewFrom("deleting export object", err, fields.F("export_id", export.ExportID))
+		}
+	}
+
+	err = s.repo.DeleteExports(ctx, ids)
+	if err != nil {
+		return 0, e.NewFrom("deleting exports", err)
+	}
+
+	return len(ids), nil
+}
@@ -0,0 +1,98 @@
+package exports
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+// exportsListLimit is how many latest exports the artist sees.
+const exportsListLimit = 10
+
+type ExportCatalogInput struct {
+	ArtistId uuid.UUID
+}
+
+type ExportCatalogOutput struct {
+	Export Export
+}
+
+// ExportCatalog queues an export of all the songs of the artist.
+// If the artist's previous export is not built yet, it is returned instead of a new one.
+func (s *Service) ExportCatalog(ctx context.Context, in ExportCatalogInput) (ExportCatalogOutput, error) {
+	log := logger.FromContext(ctx)
+
+	export, err := s.repo.SaveExport(ctx, postgres.SaveExportParams{
+		ExportID: uuid.New(),
+		ArtistID: in.ArtistId,
+	})
+	if err != nil {
+		return ExportCatalogOutput{}, e.NewFrom("saving export", err, fields.F("artist_id", in.ArtistId))
+	}
+
+	log.Debug().Stringer("export_id", export.ExportID).Str("status", string(export.Status)).Msg("export queued")
+
+	return ExportCatalogOutput{Export: s.mapExport(export)}, nil
+}
+
+type GetCatalogExportsInput struct {
+	ArtistId uuid.UUID
+}
+
+type GetCatalogExportsOutput struct {
+	Exports []Export
+}
+
+// GetCatalogExports returns the latest exports of the artist which have not expired, newest first.
+func (s *Service) GetCatalogExports(ctx context.Context, in GetCatalogExportsInput,
+) (GetCatalogExportsOutput, error) {
+	exports, err := s.repo.ArtistExports(ctx, postgres.ArtistExportsParams{
+		ArtistID: in.ArtistId,
+		Limitv:   exportsListLimit,
+	})
+	if err != nil && !errors.Is(err, repoerrs.ErrEmptyResult) {
+		return GetCatalogExportsOutput{}, e.NewFrom("getting exports", err, fields.F("artist_id", in.ArtistId))
+	}
+
+	out := GetCatalogExportsOutput{Exports: make([]Export, 0, len(exports))}
+	for _, export := range exports {
+		out.Exports = append(out.Exports, s.mapExport(export))
+	}
+
+	return out, nil
+}
+
+// GetExportObject returns the zip of the export while its link works.
+func (s *Service) GetExportObject(ctx context.Context, exportId uuid.UUID) (io.Reader, error) {
+	export, err := s.repo.Export(ctx, exportId)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return nil, ErrExportNotFound.Wrap(err, fields.F("export_id", exportId))
+
+	case err != nil:
+		return nil, e.NewFrom("getting export", err, fields.F("export_id", exportId))
+
+	case export.Status != postgres.ExportStatusReady:
+		return nil, ErrExportNotReady
+
+	case expired(export):
+		return nil, ErrExportExpired
+	}
+
+	reader, err := s.storage.GetExportObject(ctx, objectId(exportId))
+	if errors.Is(err, objstore.ErrNotFound) {
+		return nil, ErrExportNotFound.Wrap(err, fields.F("export_id", exportId))
+	}
+
+	return reader, err //nolint:wrapcheck
+}
@@ -0,0 +1,151 @@
+// Package exports packages the catalogs of artists into zips they can download.
+//
+// Artists queue an export and the export worker builds it: the zip with the songs, their images
+// and a manifest describing them is streamed to the object storage. The download link works
+// until the export expires, then the worker removes it.
+package exports
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrExportNotFound = erix.NewStatus("export not found", erix.CodeNotFound)
+	ErrExportNotReady = erix.NewStatus("export is not ready yet", erix.CodePreconditionFailed)
+	ErrExportExpired  = erix.NewStatus("export link expired", erix.CodeNotFound)
+)
+
+type Service struct {
+	c       Config
+	repo    ExportRepo
+	storage ObjectStorage
+	users   UserRepo
+	images  ImageObjects
+
+	downloadUrlTpl string
+}
+
+type ExportRepo interface {
+	SaveExport(context.Context, postgres.SaveExportParams) (postgres.Export, error)
+	Export(context.Context, uuid.UUID) (postgres.Export, error)
+	ArtistExports(context.Context, postgres.ArtistExportsParams) ([]postgres.Export, error)
+	StartExport(context.Context, pgtype.Timestamptz) (postgres.Export, error)
+	FinishExport(context.Context, postgres.FinishExportParams) (postgres.Export, error)
+	ExpiredExports(context.Context, int32) ([]postgres.Export, error)
+	DeleteExports(context.Context, []uuid.UUID) error
+	MySongs(context.Context, postgres.MySongsParams) ([]postgres.MySongsRow, error)
+}
+
+type ObjectStorage interface {
+	GetSongObject(ctx context.Context, id string) (io.Reader, error)
+	GetImageObject(ctx context.Context, id string) (io.Reader, error)
+	PutExportObject(ctx context.Context, id string, content io.Reader) (int64, error)
+	GetExportObject(ctx context.Context, id string) (io.Reader, error)
+	DeleteExportObject(ctx context.Context, id string) error
+}
+
+type UserRepo interface {
+	ArtistsByIds(context.Context, []uuid.UUID) ([]users.Artist, error)
+}
+
+// ImageObjects tells the objects of images hosted by us from images hosted elsewhere.
+type ImageObjects interface {
+	ImageObjectId(imageUrl string) (string, bool)
+}
+
+type Dependencies struct {
+	ExportRepo    ExportRepo
+	ObjectStorage ObjectStorage
+	UserRepo      UserRepo
+	ImageObjects  ImageObjects
+}
+
+type Config struct {
+	Dependencies
+	HostUsesTls bool
+	Host        string
+	LinkTtl     time.Duration
+	// Running exports not finished in this time are built again
+	StaleAfter time.Duration
+}
+
+func New(deps Dependencies) *Service {
+	conf := config.Get()
+
+	return NewWithConfig(Config{
+		Dependencies: deps,
+		HostUsesTls:  conf.Servers.Http.UseTls,
+		Host:         conf.Servers.Host,
+		LinkTtl:      conf.Features.Exports.LinkTtl,
+		StaleAfter:   conf.Features.Exports.StaleAfter,
+	})
+}
+
+func NewWithConfig(conf Config) *Service {
+	schema := "http"
+	if conf.HostUsesTls {
+		schema = "https"
+	}
+
+	return &Service{
+		c:              conf,
+		repo:           conf.ExportRepo,
+		storage:        conf.ObjectStorage,
+		users:          conf.UserRepo,
+		images:         conf.ImageObjects,
+		downloadUrlTpl: fmt.Sprintf("%s://%s/songs/api/v1/exports/%%s/download", schema, conf.Host),
+	}
+}
+
+type Export struct {
+	Id     uuid.UUID
+	Status postgres.ExportStatus
+	// Set while the export is ready and not expired
+	DownloadUrl *string
+	WeightBytes *int64
+	// Set if the export failed
+	Error      *string
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+	ExpiresAt  *time.Time
+}
+
+func (s *Service) mapExport(export postgres.Export) Export {
+	out := Export{
+		Id:          export.ExportID,
+		Status:      export.Status,
+		DownloadUrl: nil,
+		WeightBytes: pgconv.FromInt8(export.WeightBytes),
+		Error:       pgconv.FromText(export.Error),
+		CreatedAt:   export.CreatedAt,
+		FinishedAt:  pgconv.FromTimestamptz(export.FinishedAt),
+		ExpiresAt:   pgconv.FromTimestamptz(export.ExpiresAt),
+	}
+
+	if export.Status == postgres.ExportStatusReady && !expired(export) {
+		url := fmt.Sprintf(s.downloadUrlTpl, export.ExportID)
+		out.DownloadUrl = &url
+	}
+
+	return out
+}
+
+func expired(export postgres.Export) bool {
+	return export.ExpiresAt.Valid && !export.ExpiresAt.Time.After(time.Now())
+}
+
+func objectId(exportId uuid.UUID) string {
+	return exportId.String() + ".zip"
+}
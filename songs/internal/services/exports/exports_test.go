@@ -0,0 +1,300 @@
+package exports_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
+	exportsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/exports"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/exports"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExportsSuite struct {
+	suite.Suite
+
+	rm *exportsmocks.ExportRepo
+	om *exportsmocks.ObjectStorage
+	um *exportsmocks.UserRepo
+	im *exportsmocks.ImageObjects
+
+	s      *exports.Service
+	ctx    context.Context
+	artist uuid.UUID
+}
+
+func (s *ExportsSuite) SetupTest() {
+	s.rm = exportsmocks.NewExportRepo(s.T())
+	s.om = exportsmocks.NewObjectStorage(s.T())
+	s.um = exportsmocks.NewUserRepo(s.T())
+	s.im = exportsmocks.NewImageObjects(s.T())
+
+	s.s = exports.NewWithConfig(exports.Config{
+		Dependencies: exports.Dependencies{
+			ExportRepo:    s.rm,
+			ObjectStorage: s.om,
+			UserRepo:      s.um,
+			ImageObjects:  s.im,
+		},
+		HostUsesTls: true,
+		Host:        "example.com",
+		LinkTtl:     time.Hour,
+		StaleAfter:  time.Hour,
+	})
+
+	s.ctx = context.Background()
+	s.artist = uuid.New()
+}
+
+func (s *ExportsSuite) TestExportCatalog() {
+	s.rm.EXPECT().SaveExport(mock.Anything, mock.MatchedBy(func(p postgres.SaveExportParams) bool {
+		return p.ArtistID == s.artist && p.ExportID != uuid.Nil
+	})).Return(s.export(postgres.ExportStatusPending), nil).Once()
+
+	out, err := s.s.ExportCatalog(s.ctx, exports.ExportCatalogInput{ArtistId: s.artist})
+
+	s.Require().NoError(err)
+	s.Equal(postgres.ExportStatusPending, out.Export.Status)
+	s.Nil(out.Export.DownloadUrl)
+}
+
+func (s *ExportsSuite) TestGetCatalogExportsLinks() {
+	ready := s.export(postgres.ExportStatusReady)
+	ready.ExpiresAt = pgconv.Timestamptz(time.Now().Add(time.Hour))
+	failed := s.export(postgres.ExportStatusFailed)
+	failed.Error = pgconv.Text("internal server error")
+
+	s.rm.EXPECT().ArtistExports(mock.Anything, postgres.ArtistExportsParams{ArtistID: s.artist, Limitv: 10}).
+		Return([]postgres.Export{ready, failed}, nil).Once()
+
+	out, err := s.s.GetCatalogExports(s.ctx, exports.GetCatalogExportsInput{ArtistId: s.artist})
+
+	s.Require().NoError(err)
+	s.Require().Len(out.Exports, 2)
+	s.Require().NotNil(out.Exports[0].DownloadUrl)
+	s.Equal("https://example.com/songs/api/v1/exports/"+ready.ExportID.String()+"/download",
+		*out.Exports[0].DownloadUrl)
+	s.Nil(out.Exports[1].DownloadUrl)
+	s.Equal("internal server error", *out.Exports[1].Error)
+}
+
+func (s *ExportsSuite) TestGetExportObject() {
+	export := s.export(postgres.ExportStatusReady)
+	export.ExpiresAt = pgconv.Timestamptz(time.Now().Add(time.Hour))
+
+	s.rm.EXPECT().Export(mock.Anything, export.ExportID).Return(export, nil).Once()
+	s.om.EXPECT().GetExportObject(mock.Anything, export.ExportID.String()+".zip").
+		Return(strings.NewReader("zip"), nil).Once()
+
+	reader, err := s.s.GetExportObject(s.ctx, export.ExportID)
+
+	s.Require().NoError(err)
+	s.NotNil(reader)
+}
+
+func (s *ExportsSuite) TestGetExportObjectExpired() {
+	export := s.export(postgres.ExportStatusReady)
+	export.ExpiresAt = pgconv.Timestamptz(time.Now().Add(-time.Minute))
+
+	s.rm.EXPECT().Export(mock.Anything, export.ExportID).Return(export, nil).Once()
+
+	_, err := s.s.GetExportObject(s.ctx, export.ExportID)
+
+	s.ErrorIs(err, exports.ErrExportExpired)
+}
+
+func (s *ExportsSuite) TestGetExportObjectNotReady() {
+	export := s.export(postgres.ExportStatusRunning)
+
+	s.rm.EXPECT().Export(mock.Anything, export.ExportID).Return(export, nil).Once()
+
+	_, err := s.s.GetExportObject(s.ctx, export.ExportID)
+
+	s.ErrorIs(err, exports.ErrExportNotReady)
+}
+
+func (s *ExportsSuite) TestGetExportObjectNotFound() {
+	id := uuid.New()
+
+	s.rm.EXPECT().Export(mock.Anything, id).Return(postgres.Export{}, repoerrs.ErrEmptyResult).Once() //nolint:exhaustruct
+
+	_, err := s.s.GetExportObject(s.ctx, id)
+
+	s.ErrorIs(err, exports.ErrExportNotFound)
+}
+
+func (s *ExportsSuite) TestGetExportObjectMissing() {
+	export := s.export(postgres.ExportStatusReady)
+	export.ExpiresAt = pgconv.Timestamptz(time.Now().Add(time.Hour))
+
+	s.rm.EXPECT().Export(mock.Anything, export.ExportID).Return(export, nil).Once()
+	s.om.EXPECT().GetExportObject(mock.Anything, export.ExportID.String()+".zip").Return(nil, objstore.ErrNotFound).Once()
+
+	_, err := s.s.GetExportObject(s.ctx, export.ExportID)
+
+	s.ErrorIs(err, exports.ErrExportNotFound)
+}
+
+func (s *ExportsSuite) TestBuildNextExport() {
+	export := s.export(postgres.ExportStatusRunning)
+	feat := uuid.New()
+	song := postgres.Song{ //nolint:exhaustruct
+		SongID:       uuid.New(),
+		Name:         "song",
+		SingerFk:     s.artist,
+		S3ObjectName: pgconv.Text("song.mp3"),
+		AudioState:   postgres.AudioStateReady,
+		ImageUrl:     pgconv.Text("https://example.com/songs/api/v1/song/image/raw/image.png"),
+		ReleasedAt:   pgconv.Timestamptz(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+	}
+
+	s.rm.EXPECT().StartExport(mock.Anything, mock.Anything).Return(export, nil).Once()
+	s.rm.EXPECT().MySongs(mock.Anything, mock.MatchedBy(func(p postgres.MySongsParams) bool {
+		return p.SingerID == s.artist && p.Offsetv == 0
+	})).Return([]postgres.MySongsRow{{
+		Song:              song,
+		ArtistsIds:        []uuid.UUID{s.artist, feat},
+		CreditsArtistsIds: []uuid.UUID{feat},
+		CreditsNames:      []string{""},
+		CreditsRoles:      []string{string(postgres.CreditRoleFeatured)},
+		CreditsStatuses:   []string{string(postgres.CreditStatusApproved)},
+	}}, nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, "song.mp3").Return(strings.NewReader("audio"), nil).Once()
+	s.im.EXPECT().ImageObjectId(song.ImageUrl.String).Return("image.png", true).Once()
+	s.om.EXPECT().GetImageObject(mock.Anything, "image.png").Return(strings.NewReader("image"), nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, []uuid.UUID{feat}).
+		Return([]users.Artist{{Id: feat, Name: "feat"}}, nil).Once() //nolint:exhaustruct
+
+	var files map[string][]byte
+
+	s.om.EXPECT().PutExportObject(mock.Anything, export.ExportID.String()+".zip", mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, content io.Reader) (int64, error) {
+			data, err := io.ReadAll(content)
+			if err != nil {
+				return 0, err
+			}
+
+			files = unzip(s.T(), data)
+
+			return int64(len(data)), nil
+		}).Once()
+	s.rm.EXPECT().FinishExport(mock.Anything, mock.MatchedBy(func(p postgres.FinishExportParams) bool {
+		return p.ExportID == export.ExportID && p.Status == postgres.ExportStatusReady &&
+			p.WeightBytes.Valid && p.ExpiresAt.Valid
+	})).Return(export, nil).Once()
+
+	built, err := s.s.BuildNextExport(s.ctx)
+
+	s.Require().NoError(err)
+	s.True(built)
+	s.Equal("audio", string(files["songs/song.mp3"]))
+	s.Equal("image", string(files["images/image.png"]))
+
+	var manifest []map[string]any
+
+	s.Require().NoError(json.Unmarshal(files["manifest.json"], &manifest))
+	s.Require().Len(manifest, 1)
+	s.Equal("song", manifest[0]["name"])
+	s.Equal("songs/song.mp3", manifest[0]["file"])
+	s.Equal("images/image.png", manifest[0]["image"])
+	s.Equal([]any{feat.String()}, manifest[0]["feats"])
+	s.Equal("feat", manifest[0]["credits"].([]any)[0].(map[string]any)["name"])
+}
+
+func (s *ExportsSuite) TestBuildNextExportFailed() {
+	export := s.export(postgres.ExportStatusRunning)
+
+	s.rm.EXPECT().StartExport(mock.Anything, mock.Anything).Return(export, nil).Once()
+	s.rm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(nil, errors.New("db is down")).Once()
+	s.om.EXPECT().PutExportObject(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, content io.Reader) (int64, error) {
+			return io.Copy(io.Discard, content)
+		}).Once()
+	s.rm.EXPECT().FinishExport(mock.Anything, mock.MatchedBy(func(p postgres.FinishExportParams) bool {
+		return p.Status == postgres.ExportStatusFailed && p.Error.Valid && !p.WeightBytes.Valid
+	})).Return(export, nil).Once()
+
+	built, err := s.s.BuildNextExport(s.ctx)
+
+	s.Require().NoError(err)
+	s.True(built)
+}
+
+func (s *ExportsSuite) TestBuildNextExportEmptyQueue() {
+	s.rm.EXPECT().StartExport(mock.Anything, mock.Anything).
+		Return(postgres.Export{}, repoerrs.ErrEmptyResult).Once() //nolint:exhaustruct
+
+	built, err := s.s.BuildNextExport(s.ctx)
+
+	s.Require().NoError(err)
+	s.False(built)
+}
+
+func (s *ExportsSuite) TestPurgeExpiredExports() {
+	ready := s.export(postgres.ExportStatusReady)
+	failed := s.export(postgres.ExportStatusFailed)
+
+	s.rm.EXPECT().ExpiredExports(mock.Anything, int32(10)).Return([]postgres.Export{ready, failed}, nil).Once()
+	s.om.EXPECT().DeleteExportObject(mock.Anything, ready.ExportID.String()+".zip").Return(nil).Once()
+	s.rm.EXPECT().DeleteExports(mock.Anything, []uuid.UUID{ready.ExportID, failed.ExportID}).Return(nil).Once()
+
+	purged, err := s.s.PurgeExpiredExports(s.ctx, 10)
+
+	s.Require().NoError(err)
+	s.Equal(2, purged)
+}
+
+func (s *ExportsSuite) export(status postgres.ExportStatus) postgres.Export {
+	return postgres.Export{
+		ExportID:    uuid.New(),
+		ArtistFk:    s.artist,
+		Status:      status,
+		Error:       pgconv.NullText(),
+		WeightBytes: pgconv.NullInt8(),
+		CreatedAt:   time.Now(),
+		StartedAt:   pgconv.NullTimestamptz(),
+		FinishedAt:  pgconv.NullTimestamptz(),
+		ExpiresAt:   pgconv.NullTimestamptz(),
+	}
+}
+
+func unzip(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := make(map[string][]byte, len(reader.File))
+
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		files[file.Name], _ = io.ReadAll(rc)
+		_ = rc.Close()
+	}
+
+	return files
+}
+
+func TestExports(t *testing.T) {
+	suite.Run(t, new(ExportsSuite))
+}
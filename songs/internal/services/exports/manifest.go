@@ -0,0 +1,77 @@
+package exports
+
+import (
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+
+	"github.com/google/uuid"
+)
+
+// manifestSong describes a song of the export. The fields shared with import rows
+// have the same names, the rest are ignored by imports.
+type manifestSong struct {
+	Id   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// Approved featured artists with accounts
+	Feats []string `json:"feats,omitempty"`
+	// Paths in the zip, the image is an URL if it is hosted elsewhere
+	File             string           `json:"file,omitempty"`
+	Image            string           `json:"image,omitempty"`
+	ReleaseDate      *time.Time       `json:"release_date,omitempty"`
+	UploadedAt       time.Time        `json:"uploaded_at"`
+	DurationSeconds  *float64         `json:"duration_seconds,omitempty"`
+	WeightBytes      *int32           `json:"weight_bytes,omitempty"`
+	Explicit         bool             `json:"explicit"`
+	AllowedCountries []string         `json:"allowed_countries,omitempty"`
+	DeniedCountries  []string         `json:"denied_countries,omitempty"`
+	Credits          []manifestCredit `json:"credits"`
+}
+
+type manifestCredit struct {
+	ArtistId *uuid.UUID            `json:"artist_id,omitempty"`
+	Name     string                `json:"name"`
+	Role     postgres.CreditRole   `json:"role"`
+	Status   postgres.CreditStatus `json:"status"`
+}
+
+func newManifestSong(row postgres.MySongsRow) manifestSong {
+	song := manifestSong{
+		Id:               row.Song.SongID,
+		Name:             row.Song.Name,
+		Feats:            nil,
+		File:             "",
+		Image:            row.Song.ImageUrl.String,
+		ReleaseDate:      pgconv.FromTimestamptz(row.Song.ReleasedAt),
+		UploadedAt:       row.Song.UploadedAt,
+		DurationSeconds:  nil,
+		WeightBytes:      pgconv.FromInt4(row.Song.WeightBytes),
+		Explicit:         row.Song.Explicit,
+		AllowedCountries: row.Song.AllowedCountries,
+		DeniedCountries:  row.Song.DeniedCountries,
+		Credits:          make([]manifestCredit, 0, len(row.CreditsRoles)),
+	}
+
+	if duration := pgconv.FromInterval(row.Song.Duration); duration != nil {
+		seconds := duration.Seconds()
+		song.DurationSeconds = &seconds
+	}
+
+	for _, credit := range row.GetCredits() {
+		out := manifestCredit{ArtistId: nil, Name: credit.Name, Role: credit.Role, Status: credit.Status}
+
+		if credit.ArtistId != uuid.Nil {
+			id := credit.ArtistId
+			out.ArtistId = &id
+
+			if credit.Role == postgres.CreditRoleFeatured && credit.Status == postgres.CreditStatusApproved {
+				song.Feats = append(song.Feats, id.String())
+			}
+		}
+
+		song.Credits = append(song.Credits, out)
+	}
+
+	return song
+}
@@ -0,0 +1,139 @@
+package imports
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"errors"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+
+	"dev.gaijin.team/go/golib/fields"
+)
+
+var ErrFileNotInArchive = erix.NewStatus("file not found in archive", erix.CodeNotFound)
+
+type ArchiveFormat string
+
+const (
+	ArchiveZip ArchiveFormat = "zip"
+	// Only uncompressed, the files are read in place
+	ArchiveTar ArchiveFormat = "tar"
+)
+
+// archive gives the regular files of a zip or tar by their paths.
+type archive struct {
+	r     io.ReaderAt
+	files map[string]archiveFile
+}
+
+type archiveFile struct {
+	zip *zip.File
+	// Data of tar files
+	offset int64
+	size   int64
+}
+
+func openArchive(format ArchiveFormat, r io.ReaderAt, size int64) (*archive, error) {
+	switch format {
+	case ArchiveZip:
+		return openZip(r, size)
+
+	case ArchiveTar:
+		return openTar(r, size)
+
+	default:
+		return nil, ErrUnsupportedArchive
+	}
+}
+
+func openZip(r io.ReaderAt, size int64) (*archive, error) {
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, ErrInvalidArchive.Wrap(err)
+	}
+
+	a := &archive{r: r, files: make(map[string]archiveFile, len(reader.File))}
+
+	for _, file := range reader.File {
+		if !file.Mode().IsRegular() {
+			continue
+		}
+
+		a.files[cleanPath(file.Name)] = archiveFile{
+			zip:    file,
+			offset: 0,
+			size:   int64(file.UncompressedSize64), //nolint:gosec
+		}
+	}
+
+	return a, nil
+}
+
+// openTar indexes the files of the tar, their data is read from r later.
+func openTar(r io.ReaderAt, size int64) (*archive, error) {
+	var (
+		section = io.NewSectionReader(r, 0, size)
+		reader  = tar.NewReader(section)
+		a       = &archive{r: r, files: make(map[string]archiveFile)}
+	)
+
+	for {
+		header, err := reader.Next()
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return a, nil
+
+		case err != nil:
+			return nil, ErrInvalidArchive.Wrap(err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		// The reader stops right at the data of the entry
+		offset, err := section.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, ErrInvalidArchive.Wrap(err)
+		}
+
+		a.files[cleanPath(header.Name)] = archiveFile{
+			zip:    nil,
+			offset: offset,
+			size:   header.Size,
+		}
+	}
+}
+
+// open returns the content of the file and its size.
+func (a *archive) open(name string) (io.ReadCloser, int64, error) {
+	file, ok := a.files[cleanPath(name)]
+	if !ok {
+		return nil, 0, ErrFileNotInArchive
+	}
+
+	if file.zip == nil {
+		return io.NopCloser(io.NewSectionReader(a.r, file.offset, file.size)), file.size, nil
+	}
+
+	content, err := file.zip.Open()
+	if err != nil {
+		return nil, 0, ErrInvalidArchive.Wrap(err, fields.F("name", name))
+	}
+
+	return content, file.size, nil
+}
+
+func (a *archive) contains(name string) bool {
+	_, ok := a.files[cleanPath(name)]
+	return ok
+}
+
+// cleanPath makes "./a/b.mp3" and "/a/b.mp3" the same file as "a/b.mp3".
+func cleanPath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
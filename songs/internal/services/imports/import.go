@@ -0,0 +1,361 @@
+package imports
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNameRequired       = erix.NewStatus("name is required", erix.CodeBadRequest)
+	ErrFileRequired       = erix.NewStatus("file is required", erix.CodeBadRequest)
+	ErrInvalidFeat        = erix.NewStatus("feats must be artist ids", erix.CodeBadRequest)
+	ErrInvalidReleaseDate = erix.NewStatus("release date must be YYYY-MM-DD or RFC 3339", erix.CodeBadRequest)
+	ErrFutureReleaseDate  = erix.NewStatus("release date is in the future", erix.CodeBadRequest)
+	ErrFileTooLarge       = erix.NewStatus("file too large, max size is 2GB", erix.CodeBadRequest)
+)
+
+type ImportSongsInput struct {
+	ArtistId       uuid.UUID
+	ManifestFormat ManifestFormat
+	Manifest       io.Reader
+	ArchiveFormat  ArchiveFormat
+	Archive        io.ReaderAt
+	ArchiveSize    int64
+}
+
+type RowStatus string
+
+const (
+	RowImported RowStatus = "imported"
+	// The song had been imported before
+	RowSkipped RowStatus = "skipped"
+	RowFailed  RowStatus = "failed"
+)
+
+type RowResult struct {
+	// Numbered from 1 in the order of the manifest
+	Row    int
+	Name   string
+	SongId *uuid.UUID
+	Status RowStatus
+	// Set if the row failed
+	Err error
+}
+
+type ImportSongsOutput struct {
+	Rows []RowResult
+}
+
+// ImportSongs creates the songs of the manifest one by one, uploads their files from the archive
+// and releases the ones with a release date. A failed row doesn't stop the import.
+// Songs that already exist only get the steps they miss, the rest of the row is not applied to them.
+func (s *Service) ImportSongs(ctx context.Context, in ImportSongsInput) (ImportSongsOutput, error) {
+	var (
+		null = ImportSongsOutput{Rows: nil}
+		log  = logger.FromContext(ctx)
+	)
+
+	rows, err := parseManifest(in.ManifestFormat, in.Manifest)
+	if err != nil {
+		return null, err
+	}
+
+	if s.c.MaxRows > 0 && len(rows) > s.c.MaxRows {
+		return null, ErrTooManyRows
+	}
+
+	files, err := openArchive(in.ArchiveFormat, in.Archive, in.ArchiveSize)
+	if err != nil {
+		return null, err
+	}
+
+	log.Info().Int("rows", len(rows)).Int("files", len(files.files)).Msg("importing songs")
+
+	results := make([]RowResult, 0, len(rows))
+
+	for i, row := range rows {
+		if ctx.Err() != nil {
+			return null, e.NewFrom("importing songs", ctx.Err(), fields.F("row", i+1))
+		}
+
+		result := RowResult{
+			Row:    i + 1,
+			Name:   row.Name,
+			SongId: nil,
+			Status: RowSkipped,
+			Err:    nil,
+		}
+
+		songId, imported, err := s.importRow(ctx, in.ArtistId, files, row)
+		if songId != uuid.Nil {
+			result.SongId = &songId
+		}
+
+		switch {
+		case err != nil:
+			log.Warn().Err(err).Int("row", result.Row).Msg("row failed")
+
+			result.Status = RowFailed
+			result.Err = err
+
+		case imported:
+			result.Status = RowImported
+		}
+
+		results = append(results, result)
+	}
+
+	return ImportSongsOutput{Rows: results}, nil
+}
+
+// importedSong is the state of the song of a row.
+type importedSong struct {
+	id       uuid.UUID
+	loaded   bool
+	hasImage bool
+	released bool
+}
+
+// loaded tells whether the file of the song is uploaded, a failed one is uploaded again.
+func loaded(state postgres.AudioState) bool {
+	return state == postgres.AudioStateProcessing || state == postgres.AudioStateReady
+}
+
+// importRow brings the song of the row to the state the manifest describes.
+// It returns the song id, if the song exists, and whether anything was done.
+func (s *Service) importRow(ctx context.Context, artistId uuid.UUID, files *archive, row Row,
+) (uuid.UUID, bool, error) {
+	parsed, err := parseRow(row, files)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+
+	song, imported, err := s.findOrCreateSong(ctx, artistId, parsed)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+
+	if !song.loaded {
+		err = s.uploadSong(ctx, artistId, song.id, files, parsed.file)
+		if err != nil {
+			return song.id, imported, err
+		}
+
+		imported = true
+	}
+
+	if parsed.image != "" && !song.hasImage {
+		err = s.uploadImage(ctx, artistId, song.id, files, parsed.image)
+		if err != nil {
+			return song.id, imported, err
+		}
+
+		imported = true
+	}
+
+	if parsed.releasedAt != nil && !song.released {
+		_, err = s.songs.ReleaseSongs(ctx, songs.ReleaseSongsInput{
+			UserId:     artistId,
+			SongsIds:   []uuid.UUID{song.id},
+			Notify:     false,
+			ReleasedAt: *parsed.releasedAt,
+		})
+		if err != nil {
+			return song.id, imported, err //nolint:wrapcheck
+		}
+
+		imported = true
+	}
+
+	return song.id, imported, nil
+}
+
+func (s *Service) findOrCreateSong(ctx context.Context, artistId uuid.UUID, row parsedRow,
+) (importedSong, bool, error) {
+	existing, err := s.repo.MySongByName(ctx, postgres.MySongByNameParams{
+		SingerID: artistId,
+		Name:     row.name,
+	})
+
+	switch {
+	case err == nil:
+		return importedSong{
+			id:       existing.Song.SongID,
+			loaded:   loaded(existing.Song.AudioState),
+			hasImage: existing.Song.ImageUrl.Valid,
+			released: existing.Song.ReleasedAt.Valid,
+		}, false, nil
+
+	case !errors.Is(err, repoerrs.ErrEmptyResult):
+		return importedSong{}, false, e.NewFrom("getting song by name", err, fields.F("name", row.name))
+	}
+
+	out, err := s.songs.CreateSong(ctx, songs.CreateSongInput{
+		Name:        row.name,
+		SingerId:    artistId,
+		ImageUrl:    row.imageUrl,
+		FeatArtists: row.feats,
+		Explicit:    false,
+		Credits:     nil,
+	})
+	if err != nil {
+		return importedSong{}, false, err //nolint:wrapcheck
+	}
+
+	return importedSong{
+		id:       out.Id,
+		loaded:   false,
+		hasImage: row.imageUrl != nil,
+		released: false,
+	}, true, nil
+}
+
+func (s *Service) uploadSong(ctx context.Context, artistId, songId uuid.UUID, files *archive, name string) error {
+	content, size, err := files.open(name)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	if size > math.MaxInt32 {
+		return ErrFileTooLarge
+	}
+
+	_, err = s.raw.UploadRawSong(ctx, raw.UploadRawSongInput{
+		ArtistId:    artistId,
+		SongId:      songId,
+		Extension:   extension(name),
+		WeightBytes: int32(size), //nolint:gosec
+		Content:     content,
+		Imported:    true,
+	})
+
+	return err //nolint:wrapcheck
+}
+
+func (s *Service) uploadImage(ctx context.Context, artistId, songId uuid.UUID, files *archive, name string) error {
+	content, size, err := files.open(name)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	if size > math.MaxInt32 {
+		return ErrFileTooLarge
+	}
+
+	_, err = s.raw.UploadRawSongImage(ctx, raw.UploadRawSongImageInput{
+		ArtistId:    artistId,
+		SongId:      songId,
+		Extension:   extension(name),
+		WeightBytes: int32(size), //nolint:gosec
+		Content:     content,
+	})
+
+	return err //nolint:wrapcheck
+}
+
+type parsedRow struct {
+	name  string
+	feats []uuid.UUID
+	file  string
+	// Only one of them is set, if any
+	image    string
+	imageUrl *string
+	// Nil if the song stays unreleased
+	releasedAt *time.Time
+}
+
+// parseRow checks the row before anything is created, so a bad row leaves no song behind.
+func parseRow(row Row, files *archive) (parsedRow, error) {
+	out := parsedRow{
+		name:       strings.TrimSpace(row.Name),
+		feats:      make([]uuid.UUID, 0, len(row.Feats)),
+		file:       strings.TrimSpace(row.File),
+		image:      "",
+		imageUrl:   nil,
+		releasedAt: nil,
+	}
+
+	if out.name == "" {
+		return out, ErrNameRequired
+	}
+
+	if out.file == "" {
+		return out, ErrFileRequired
+	}
+
+	if !files.contains(out.file) {
+		return out, ErrFileNotInArchive.Wrap(e.New(out.file))
+	}
+
+	for _, feat := range row.Feats {
+		id, err := uuid.Parse(strings.TrimSpace(feat))
+		if err != nil {
+			return out, ErrInvalidFeat.Wrap(err, fields.F("feat", feat))
+		}
+
+		out.feats = append(out.feats, id)
+	}
+
+	switch image := strings.TrimSpace(row.Image); {
+	case image == "":
+
+	case strings.HasPrefix(image, "http://") || strings.HasPrefix(image, "https://"):
+		out.imageUrl = &image
+
+	case !files.contains(image):
+		return out, ErrFileNotInArchive.Wrap(e.New(image))
+
+	default:
+		out.image = image
+	}
+
+	if date := strings.TrimSpace(row.ReleaseDate); date != "" {
+		releasedAt, err := parseReleaseDate(date)
+		if err != nil {
+			return out, err
+		}
+
+		out.releasedAt = &releasedAt
+	}
+
+	return out, nil
+}
+
+func parseReleaseDate(date string) (time.Time, error) {
+	releasedAt, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		releasedAt, err = time.Parse(time.RFC3339, date)
+	}
+
+	if err != nil {
+		return time.Time{}, ErrInvalidReleaseDate.Wrap(err)
+	}
+
+	if releasedAt.After(time.Now()) {
+		return time.Time{}, ErrFutureReleaseDate
+	}
+
+	return releasedAt, nil
+}
+
+func extension(name string) string {
+	return strings.ToLower(strings.TrimPrefix(path.Ext(name), "."))
+}
@@ -0,0 +1,270 @@
+package imports_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	importsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/imports"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/imports"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImportSongsSuite struct {
+	suite.Suite
+
+	sm *importsmocks.SongsService
+	rm *importsmocks.RawService
+	pm *importsmocks.SongRepo
+
+	s      *imports.Service
+	ctx    context.Context
+	artist uuid.UUID
+}
+
+func (s *ImportSongsSuite) SetupTest() {
+	s.sm = importsmocks.NewSongsService(s.T())
+	s.rm = importsmocks.NewRawService(s.T())
+	s.pm = importsmocks.NewSongRepo(s.T())
+
+	s.s = imports.NewWithConfig(imports.Config{
+		Dependencies: imports.Dependencies{
+			SongsService: s.sm,
+			RawService:   s.rm,
+			SongRepo:     s.pm,
+		},
+		MaxRows: 3,
+	})
+
+	s.ctx = context.Background()
+	s.artist = uuid.New()
+}
+
+func (s *ImportSongsSuite) TestHappyPath() {
+	feat := uuid.New()
+	songId := uuid.New()
+	manifest := "\ufeffimage,Name,file,feats,release_date\n" +
+		"covers/a.png,Song A,./songs/a.mp3,\"" + feat.String() + "; \",2020-01-02\n"
+	archive := zipArchive(s.T(), "songs/a.mp3", "covers/a.png")
+
+	s.pm.EXPECT().MySongByName(mock.Anything, postgres.MySongByNameParams{SingerID: s.artist, Name: "Song A"}).
+		Return(postgres.MySongByNameRow{}, repoerrs.ErrEmptyResult).Once() //nolint:exhaustruct
+	s.sm.EXPECT().CreateSong(mock.Anything, mock.MatchedBy(func(in songs.CreateSongInput) bool {
+		return in.Name == "Song A" && in.SingerId == s.artist && in.ImageUrl == nil &&
+			len(in.FeatArtists) == 1 && in.FeatArtists[0] == feat
+	})).Return(songs.CreateSongOutput{Id: songId}, nil).Once() //nolint:exhaustruct
+	s.rm.EXPECT().UploadRawSong(mock.Anything, mock.MatchedBy(func(in raw.UploadRawSongInput) bool {
+		return in.SongId == songId && in.Extension == "mp3" && in.WeightBytes == 11 && in.Imported
+	})).Return(raw.UploadRawSongOutput{}, nil).Once() //nolint:exhaustruct
+	s.rm.EXPECT().UploadRawSongImage(mock.Anything, mock.MatchedBy(func(in raw.UploadRawSongImageInput) bool {
+		return in.SongId == songId && in.Extension == "png"
+	})).Return(raw.UploadRawSongImageOutput{}, nil).Once() //nolint:exhaustruct
+	s.sm.EXPECT().ReleaseSongs(mock.Anything, songs.ReleaseSongsInput{
+		UserId:     s.artist,
+		SongsIds:   []uuid.UUID{songId},
+		Notify:     false,
+		ReleasedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+	}).Return(songs.ReleaseSongsOutput{}, nil).Once()
+
+	out, err := s.s.ImportSongs(s.ctx, s.input(imports.ManifestCsv, manifest, imports.ArchiveZip, archive))
+	s.Require().NoError(err)
+	s.Require().Len(out.Rows, 1)
+	s.Equal(imports.RowImported, out.Rows[0].Status)
+	s.Equal(&songId, out.Rows[0].SongId)
+}
+
+func (s *ImportSongsSuite) TestResume() {
+	loaded, done := validSong(), validSong()
+	loaded.S3ObjectName = pgconv.Text("object")
+	loaded.AudioState = postgres.AudioStateReady
+	done.S3ObjectName = pgconv.Text("object")
+	done.AudioState = postgres.AudioStateReady
+	done.ReleasedAt = pgconv.Timestamptz(time.Now())
+
+	manifest := `[
+		{"name": "Loaded", "file": "a.mp3", "release_date": "2020-01-02T10:00:00Z"},
+		{"name": "Done", "file": "b.mp3", "image": "https://example.com/b.png", "release_date": "2020-01-02"}
+	]`
+	archive := tarArchive(s.T(), "a.mp3", "b.mp3")
+
+	s.pm.EXPECT().MySongByName(mock.Anything, mock.MatchedBy(func(p postgres.MySongByNameParams) bool {
+		return p.Name == "Loaded"
+	})).Return(postgres.MySongByNameRow{Song: loaded}, nil).Once()
+	s.pm.EXPECT().MySongByName(mock.Anything, mock.MatchedBy(func(p postgres.MySongByNameParams) bool {
+		return p.Name == "Done"
+	})).Return(postgres.MySongByNameRow{Song: done}, nil).Once()
+	// Only the missing release of the first song is done
+	s.sm.EXPECT().ReleaseSongs(mock.Anything, mock.MatchedBy(func(in songs.ReleaseSongsInput) bool {
+		return in.SongsIds[0] == loaded.SongID
+	})).Return(songs.ReleaseSongsOutput{}, nil).Once()
+
+	out, err := s.s.ImportSongs(s.ctx, s.input(imports.ManifestJson, manifest, imports.ArchiveTar, archive))
+	s.Require().NoError(err)
+	s.Require().Len(out.Rows, 2)
+	s.Equal(imports.RowImported, out.Rows[0].Status)
+	s.Equal(imports.RowSkipped, out.Rows[1].Status)
+	s.Equal(&done.SongID, out.Rows[1].SongId)
+}
+
+func (s *ImportSongsSuite) TestFailedRows() {
+	manifest := `[
+		{"name": "No file", "file": "missing.mp3"},
+		{"name": "Bad feat", "file": "a.mp3", "feats": ["someone"]},
+		{"name": "Not created", "file": "a.mp3", "release_date": "2999-01-01"}
+	]`
+	archive := tarArchive(s.T(), "a.mp3")
+
+	out, err := s.s.ImportSongs(s.ctx, s.input(imports.ManifestJson, manifest, imports.ArchiveTar, archive))
+	s.Require().NoError(err)
+	s.Require().Len(out.Rows, 3)
+
+	for _, row := range out.Rows {
+		s.Equal(imports.RowFailed, row.Status)
+		s.Nil(row.SongId)
+	}
+
+	s.ErrorIs(out.Rows[0].Err, imports.ErrFileNotInArchive)
+	s.ErrorIs(out.Rows[1].Err, imports.ErrInvalidFeat)
+	s.ErrorIs(out.Rows[2].Err, imports.ErrFutureReleaseDate)
+}
+
+func (s *ImportSongsSuite) TestUploadFailed() {
+	songId := uuid.New()
+	manifest := "name,file\nSong A,a.mp3\nSong B,b.mp3\n"
+	archive := tarArchive(s.T(), "a.mp3", "b.mp3")
+	uploadErr := errors.New("upload failed")
+
+	s.pm.EXPECT().MySongByName(mock.Anything, mock.Anything).
+		Return(postgres.MySongByNameRow{}, repoerrs.ErrEmptyResult).Twice() //nolint:exhaustruct
+	s.sm.EXPECT().CreateSong(mock.Anything, mock.Anything).
+		Return(songs.CreateSongOutput{Id: songId}, nil).Twice() //nolint:exhaustruct
+	s.rm.EXPECT().UploadRawSong(mock.Anything, mock.Anything).
+		Return(raw.UploadRawSongOutput{}, uploadErr).Once() //nolint:exhaustruct
+	s.rm.EXPECT().UploadRawSong(mock.Anything, mock.MatchedBy(func(in raw.UploadRawSongInput) bool {
+		content, err := io.ReadAll(in.Content)
+		return err == nil && string(content) == fileContent
+	})).Return(raw.UploadRawSongOutput{}, nil).Once() //nolint:exhaustruct
+
+	out, err := s.s.ImportSongs(s.ctx, s.input(imports.ManifestCsv, manifest, imports.ArchiveTar, archive))
+	s.Require().NoError(err)
+	s.Require().Len(out.Rows, 2)
+	// The song is kept, the upload is retried when the import is sent again
+	s.Equal(imports.RowFailed, out.Rows[0].Status)
+	s.Equal(&songId, out.Rows[0].SongId)
+	s.ErrorIs(out.Rows[0].Err, uploadErr)
+	s.Equal(imports.RowImported, out.Rows[1].Status)
+}
+
+func (s *ImportSongsSuite) TestTooManyRows() {
+	manifest := "name,file\na,a.mp3\nb,b.mp3\nc,c.mp3\nd,d.mp3\n"
+
+	_, err := s.s.ImportSongs(s.ctx, s.input(imports.ManifestCsv, manifest, imports.ArchiveZip, nil))
+	s.ErrorIs(err, imports.ErrTooManyRows)
+}
+
+func (s *ImportSongsSuite) TestInvalidInput() {
+	_, err := s.s.ImportSongs(s.ctx, s.input("xml", "", imports.ArchiveZip, nil))
+	s.ErrorIs(err, imports.ErrUnsupportedManifest)
+
+	_, err = s.s.ImportSongs(s.ctx, s.input(imports.ManifestCsv, "title\n", imports.ArchiveZip, nil))
+	s.ErrorIs(err, imports.ErrInvalidManifest)
+
+	_, err = s.s.ImportSongs(s.ctx, s.input(imports.ManifestJson, "[]", "rar", nil))
+	s.ErrorIs(err, imports.ErrUnsupportedArchive)
+
+	_, err = s.s.ImportSongs(s.ctx, s.input(imports.ManifestJson, "[]", imports.ArchiveZip, []byte("not a zip")))
+	s.ErrorIs(err, imports.ErrInvalidArchive)
+}
+
+func (s *ImportSongsSuite) input(manifestFormat imports.ManifestFormat, manifest string,
+	archiveFormat imports.ArchiveFormat, archive []byte,
+) imports.ImportSongsInput {
+	return imports.ImportSongsInput{
+		ArtistId:       s.artist,
+		ManifestFormat: manifestFormat,
+		Manifest:       strings.NewReader(manifest),
+		ArchiveFormat:  archiveFormat,
+		Archive:        bytes.NewReader(archive),
+		ArchiveSize:    int64(len(archive)),
+	}
+}
+
+func TestImportSongs(t *testing.T) {
+	suite.Run(t, new(ImportSongsSuite))
+}
+
+// fileContent is the content of every file in the test archives.
+const fileContent = "not a sound"
+
+func zipArchive(t *testing.T, names ...string) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+
+	for _, name := range names {
+		file, err := writer.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _ = file.Write([]byte(fileContent))
+	}
+
+	err := writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func tarArchive(t *testing.T, names ...string) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	writer := tar.NewWriter(buf)
+
+	for _, name := range names {
+		err := writer.WriteHeader(&tar.Header{ //nolint:exhaustruct
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(fileContent)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _ = writer.Write([]byte(fileContent))
+	}
+
+	err := writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func validSong() postgres.Song {
+	return postgres.Song{ //nolint:exhaustruct
+		SongID:   uuid.New(),
+		Name:     "song",
+		SingerFk: uuid.New(),
+	}
+}
@@ -0,0 +1,73 @@
+// Package imports creates songs in bulk from a manifest and an archive with their files.
+//
+// Every manifest row is matched to a song of the artist by name, it is unique per artist.
+// So an import may be sent again after it failed or timed out: imported rows are skipped
+// and the rest continue from the step they stopped at.
+package imports
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+)
+
+var (
+	ErrUnsupportedManifest = erix.NewStatus("manifest must be .json or .csv", erix.CodeBadRequest)
+	ErrUnsupportedArchive  = erix.NewStatus("archive must be .zip or .tar", erix.CodeBadRequest)
+	ErrInvalidManifest     = erix.NewStatus("invalid manifest", erix.CodeBadRequest)
+	ErrInvalidArchive      = erix.NewStatus("invalid archive", erix.CodeBadRequest)
+	ErrTooManyRows         = erix.NewStatus("too many rows in manifest", erix.CodeBadRequest)
+)
+
+type Service struct {
+	c     Config
+	songs SongsService
+	raw   RawService
+	repo  SongRepo
+}
+
+type SongsService interface {
+	CreateSong(context.Context, songs.CreateSongInput) (songs.CreateSongOutput, error)
+	ReleaseSongs(context.Context, songs.ReleaseSongsInput) (songs.ReleaseSongsOutput, error)
+}
+
+type RawService interface {
+	UploadRawSong(context.Context, raw.UploadRawSongInput) (raw.UploadRawSongOutput, error)
+	UploadRawSongImage(context.Context, raw.UploadRawSongImageInput) (raw.UploadRawSongImageOutput, error)
+}
+
+type SongRepo interface {
+	MySongByName(context.Context, postgres.MySongByNameParams) (postgres.MySongByNameRow, error)
+}
+
+type Dependencies struct {
+	SongsService SongsService
+	RawService   RawService
+	SongRepo     SongRepo
+}
+
+type Config struct {
+	Dependencies
+	// Zero means unlimited
+	MaxRows int
+}
+
+func New(deps Dependencies) *Service {
+	return NewWithConfig(Config{
+		Dependencies: deps,
+		MaxRows:      config.Get().Features.Imports.MaxRows,
+	})
+}
+
+func NewWithConfig(conf Config) *Service {
+	return &Service{
+		c:     conf,
+		songs: conf.SongsService,
+		raw:   conf.RawService,
+		repo:  conf.SongRepo,
+	}
+}
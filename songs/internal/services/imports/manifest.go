@@ -0,0 +1,124 @@
+package imports
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+)
+
+type ManifestFormat string
+
+const (
+	ManifestJson ManifestFormat = "json"
+	ManifestCsv  ManifestFormat = "csv"
+)
+
+// Row is a song of the manifest. Its values are checked when the row is imported,
+// so a bad row fails alone.
+type Row struct {
+	Name string `json:"name"`
+	// Ids of the feat artists
+	Feats []string `json:"feats"`
+	// Path of the mp3 in the archive
+	File string `json:"file"`
+	// Path of the image in the archive or its URL, optional
+	Image string `json:"image"`
+	// YYYY-MM-DD or RFC 3339, the song stays unreleased without it
+	ReleaseDate string `json:"release_date"`
+}
+
+// featsSeparator separates the feat artists in a CSV cell.
+const featsSeparator = ";"
+
+func parseManifest(format ManifestFormat, r io.Reader) ([]Row, error) {
+	switch format {
+	case ManifestJson:
+		return parseJsonManifest(r)
+
+	case ManifestCsv:
+		return parseCsvManifest(r)
+
+	default:
+		return nil, ErrUnsupportedManifest
+	}
+}
+
+// parseJsonManifest reads an array of rows.
+func parseJsonManifest(r io.Reader) ([]Row, error) {
+	var rows []Row
+
+	err := json.NewDecoder(r).Decode(&rows)
+	if err != nil {
+		return nil, ErrInvalidManifest.Wrap(err)
+	}
+
+	return rows, nil
+}
+
+// parseCsvManifest reads rows under a header naming the columns in any order.
+// Unknown columns are ignored, feats are separated by semicolons.
+func parseCsvManifest(r io.Reader) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, ErrInvalidManifest.Wrap(err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		// Spreadsheets put a byte order mark in front of the file
+		name = strings.TrimPrefix(name, "\ufeff")
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	if _, ok := columns["name"]; !ok {
+		return nil, ErrInvalidManifest.Wrap(e.New("no name column"))
+	}
+
+	var rows []Row
+
+	for {
+		record, err := reader.Read()
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return rows, nil
+
+		case err != nil:
+			return nil, ErrInvalidManifest.Wrap(err, fields.F("row", len(rows)+1))
+		}
+
+		cell := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+
+			return strings.TrimSpace(record[i])
+		}
+
+		row := Row{
+			Name:        cell("name"),
+			Feats:       nil,
+			File:        cell("file"),
+			Image:       cell("image"),
+			ReleaseDate: cell("release_date"),
+		}
+
+		for _, feat := range strings.Split(cell("feats"), featsSeparator) {
+			if feat = strings.TrimSpace(feat); feat != "" {
+				row.Feats = append(row.Feats, feat)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+}
@@ -0,0 +1,120 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+type Job struct {
+	Id          uuid.UUID
+	Kind        string
+	Payload     []byte
+	Status      postgres.JobStatus
+	UniqueKey   *string
+	Attempts    int32
+	MaxAttempts int32
+	LastError   *string
+	RunAt       time.Time
+	CreatedAt   time.Time
+	FailedAt    *time.Time
+}
+
+func mapJob(job postgres.Job) Job {
+	return Job{
+		Id:          job.JobID,
+		Kind:        job.Kind,
+		Payload:     job.Payload,
+		Status:      job.Status,
+		UniqueKey:   pgconv.FromText(job.UniqueKey),
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		LastError:   pgconv.FromText(job.LastError),
+		RunAt:       job.RunAt,
+		CreatedAt:   job.CreatedAt,
+		FailedAt:    pgconv.FromTimestamptz(job.FailedAt),
+	}
+}
+
+type GetDeadJobsInput struct {
+	// pagination
+	Page     int32
+	PageSize int32
+}
+
+type GetDeadJobsOutput struct {
+	Jobs     []Job
+	LastPage int32
+}
+
+// GetDeadJobs returns the jobs out of attempts, recently failed first.
+func (s *Service) GetDeadJobs(ctx context.Context, input GetDeadJobsInput) (GetDeadJobsOutput, error) {
+	null := GetDeadJobsOutput{Jobs: []Job{}, LastPage: 0}
+
+	jobs, err := s.repo.DeadJobs(ctx, postgres.DeadJobsParams{
+		Offsetv: (input.Page - 1) * input.PageSize,
+		Limitv:  input.PageSize,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult) || (len(jobs) == 0 && err == nil):
+		return null, nil
+
+	case err != nil:
+		return null, e.NewFrom("getting dead jobs", err)
+	}
+
+	count, err := s.repo.CountDeadJobs(ctx)
+	if err != nil {
+		return null, e.NewFrom("getting dead jobs count", err)
+	}
+
+	out := GetDeadJobsOutput{
+		Jobs:     make([]Job, len(jobs)),
+		LastPage: (count-1)/input.PageSize + 1,
+	}
+
+	for i, job := range jobs {
+		out.Jobs[i] = mapJob(job)
+	}
+
+	return out, nil
+}
+
+type RetryJobInput struct {
+	JobId uuid.UUID
+}
+
+type RetryJobOutput struct {
+	Job Job
+}
+
+// RetryJob queues the dead job again with all its attempts.
+func (s *Service) RetryJob(ctx context.Context, input RetryJobInput) (RetryJobOutput, error) {
+	job, err := s.repo.RetryDeadJob(ctx, input.JobId)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return RetryJobOutput{}, ErrJobNotFound
+
+	case errors.Is(err, repoerrs.ErrUnique):
+		return RetryJobOutput{}, ErrJobQueued
+
+	case err != nil:
+		return RetryJobOutput{}, e.NewFrom("retrying job", err, fields.F("job_id", input.JobId))
+	}
+
+	log := logger.FromContext(ctx)
+	log.Info().Stringer("job_id", job.JobID).Str("kind", job.Kind).Msg("retrying dead job")
+
+	return RetryJobOutput{Job: mapJob(job)}, nil
+}
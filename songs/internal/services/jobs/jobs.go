@@ -0,0 +1,196 @@
+// Package jobs runs background jobs kept in postgres.
+//
+// Services queue jobs of a kind with a payload and the workers of every replica run them by the handlers
+// of their kinds. A failed job is retried with an exponential backoff until it is out of attempts,
+// then it is dead until an admin retries it. Jobs with a unique key are queued once while one of them
+// waits or runs. Jobs may be queued in the transaction of a service with [Service.InTx].
+// On shutdown the workers stop taking jobs and wait for the running ones.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrJobNotFound = erix.NewStatus("dead job not found", erix.CodeNotFound)
+	ErrJobQueued   = erix.NewStatus("job with the same unique key is queued", erix.CodePreconditionFailed)
+
+	// ErrPermanent fails the job without retries, handlers wrap errors retrying can't fix with it.
+	ErrPermanent = e.New("permanent job failure")
+)
+
+type Service struct {
+	c        Config
+	repo     JobRepo
+	handlers map[string]handler
+	kinds    []string
+}
+
+type JobRepo interface {
+	EnqueueJob(context.Context, postgres.EnqueueJobParams) (postgres.Job, error)
+	ClaimJob(context.Context, postgres.ClaimJobParams) (postgres.Job, error)
+	CompleteJob(context.Context, postgres.CompleteJobParams) error
+	FailJob(context.Context, postgres.FailJobParams) error
+	DeadJobs(context.Context, postgres.DeadJobsParams) ([]postgres.Job, error)
+	CountDeadJobs(context.Context) (int32, error)
+	RetryDeadJob(context.Context, uuid.UUID) (postgres.Job, error)
+}
+
+type Dependencies struct {
+	JobRepo JobRepo
+}
+
+type Config struct {
+	Dependencies
+	// Zero disables the workers
+	Workers      int
+	PollInterval time.Duration
+	MaxAttempts  int32
+	MinBackoff   time.Duration
+	MaxBackoff   time.Duration
+	// Jobs are cancelled after this time and taken over by other workers
+	StaleAfter time.Duration
+	// Running jobs are cancelled if they don't finish in this time on shutdown
+	ShutdownTimeout time.Duration
+}
+
+func New(deps Dependencies) *Service {
+	conf := config.Get().Features.Jobs
+
+	return NewWithConfig(Config{
+		Dependencies:    deps,
+		Workers:         conf.Workers,
+		PollInterval:    conf.PollInterval,
+		MaxAttempts:     conf.MaxAttempts,
+		MinBackoff:      conf.MinBackoff,
+		MaxBackoff:      conf.MaxBackoff,
+		StaleAfter:      conf.StaleAfter,
+		ShutdownTimeout: conf.ShutdownTimeout,
+	})
+}
+
+func NewWithConfig(conf Config) *Service {
+	return &Service{
+		c:        conf,
+		repo:     conf.JobRepo,
+		handlers: make(map[string]handler),
+		kinds:    nil,
+	}
+}
+
+// Kind names the jobs with payloads of type T, it is stored with the jobs and must not change.
+type Kind[T any] string
+
+type handler func(ctx context.Context, payload []byte) error
+
+// Handle registers the handler of the jobs of the kind, the job is retried while fn returns an error.
+// Replicas take only the jobs of the kinds they handle. Handlers are registered before [Service.Run].
+func Handle[T any](s *Service, kind Kind[T], fn func(context.Context, T) error) {
+	if _, ok := s.handlers[string(kind)]; !ok {
+		s.kinds = append(s.kinds, string(kind))
+	}
+
+	s.handlers[string(kind)] = func(ctx context.Context, payload []byte) error {
+		var args T
+
+		err := json.Unmarshal(payload, &args)
+		if err != nil {
+			return ErrPermanent.Wrap(err)
+		}
+
+		return fn(ctx, args)
+	}
+}
+
+// Queue queues jobs, it is [Service].
+type Queue interface {
+	EnqueueJob(ctx context.Context, kind string, payload []byte, opts Options) (uuid.UUID, error)
+}
+
+type Options struct {
+	// Jobs with the same key are queued once while one of them waits or runs, empty means no key
+	UniqueKey string
+	// Zero runs the job right away
+	RunAt time.Time
+	// Zero means the configured one
+	MaxAttempts int32
+}
+
+// Enqueue queues the job of the kind and returns its id.
+// If a job with the same unique key waits or runs, its id is returned instead.
+func Enqueue[T any](ctx context.Context, q Queue, kind Kind[T], args T, opts Options) (uuid.UUID, error) {
+	payload, err := json.Marshal(args)
+	if err != nil {
+		return uuid.Nil, e.NewFrom("marshaling job payload", err, fields.F("kind", string(kind)))
+	}
+
+	return q.EnqueueJob(ctx, string(kind), payload, opts) //nolint:wrapcheck
+}
+
+// EnqueueJob queues the job with the JSON payload, see [Enqueue].
+func (s *Service) EnqueueJob(ctx context.Context, kind string, payload []byte, opts Options) (uuid.UUID, error) {
+	return s.enqueueJob(ctx, s.repo, kind, payload, opts)
+}
+
+// JobSaver saves the queued jobs, it is a transaction of the repo of a service.
+type JobSaver interface {
+	EnqueueJob(context.Context, postgres.EnqueueJobParams) (postgres.Job, error)
+}
+
+// InTx returns the queue saving the jobs in the transaction, they are run once it is committed.
+// Services queue jobs with the rows they are about, so that neither is saved without the other.
+func (s *Service) InTx(tx JobSaver) Queue {
+	return txQueue{s: s, tx: tx}
+}
+
+type txQueue struct {
+	s  *Service
+	tx JobSaver
+}
+
+func (q txQueue) EnqueueJob(ctx context.Context, kind string, payload []byte, opts Options) (uuid.UUID, error) {
+	return q.s.enqueueJob(ctx, q.tx, kind, payload, opts)
+}
+
+func (s *Service) enqueueJob(ctx context.Context,
+	repo JobSaver, kind string, payload []byte, opts Options,
+) (uuid.UUID, error) {
+	params := postgres.EnqueueJobParams{
+		JobID:       uuid.New(),
+		Kind:        kind,
+		Payload:     payload,
+		UniqueKey:   pgconv.NullText(),
+		MaxAttempts: s.c.MaxAttempts,
+		RunAt:       time.Now(),
+	}
+
+	if opts.UniqueKey != "" {
+		params.UniqueKey = pgconv.Text(opts.UniqueKey)
+	}
+
+	if !opts.RunAt.IsZero() {
+		params.RunAt = opts.RunAt
+	}
+
+	if opts.MaxAttempts > 0 {
+		params.MaxAttempts = opts.MaxAttempts
+	}
+
+	job, err := repo.EnqueueJob(ctx, params)
+	if err != nil {
+		return uuid.Nil, e.NewFrom("queueing job", err, fields.F("kind", kind))
+	}
+
+	return job.JobID, nil
+}
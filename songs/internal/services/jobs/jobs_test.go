@@ -0,0 +1,337 @@
+package jobs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	jobsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type greeting struct {
+	Name string `json:"name"`
+}
+
+const greetJob jobs.Kind[greeting] = "greet"
+
+type JobsSuite struct {
+	suite.Suite
+
+	rm *jobsmocks.JobRepo
+
+	s   *jobs.Service
+	ctx context.Context
+}
+
+func (s *JobsSuite) SetupTest() {
+	s.rm = jobsmocks.NewJobRepo(s.T())
+
+	s.s = jobs.NewWithConfig(jobs.Config{
+		Dependencies:    jobs.Dependencies{JobRepo: s.rm},
+		Workers:         1,
+		PollInterval:    time.Millisecond,
+		MaxAttempts:     3,
+		MinBackoff:      10 * time.Second,
+		MaxBackoff:      time.Minute,
+		StaleAfter:      time.Minute,
+		ShutdownTimeout: time.Second,
+	})
+
+	s.ctx = context.Background()
+}
+
+// job returns a claimed job of the kind with the payload.
+func (s *JobsSuite) job(kind string, payload any, attempt int32) postgres.Job {
+	bytes, err := json.Marshal(payload)
+	s.Require().NoError(err)
+
+	return postgres.Job{
+		JobID:       uuid.New(),
+		Kind:        kind,
+		Payload:     bytes,
+		Status:      postgres.JobStatusRunning,
+		UniqueKey:   pgconv.NullText(),
+		Attempts:    attempt,
+		MaxAttempts: 3,
+		LastError:   pgconv.NullText(),
+		RunAt:       time.Now(),
+		LockedAt:    pgconv.Timestamptz(time.Now()),
+		CreatedAt:   time.Now(),
+		FailedAt:    pgconv.NullTimestamptz(),
+	}
+}
+
+func (s *JobsSuite) expectClaim(job postgres.Job) {
+	s.rm.EXPECT().ClaimJob(mock.Anything, mock.MatchedBy(func(p postgres.ClaimJobParams) bool {
+		return len(p.Kinds) == 1 && p.Kinds[0] == string(greetJob)
+	})).Return(job, nil).Once()
+}
+
+func (s *JobsSuite) TestEnqueue() {
+	runAt := time.Now().Add(time.Hour)
+
+	s.rm.EXPECT().EnqueueJob(mock.Anything, mock.MatchedBy(func(p postgres.EnqueueJobParams) bool {
+		return p.Kind == "greet" && string(p.Payload) == `{"name":"Alice"}` &&
+			p.UniqueKey.String == "greet:alice" && p.MaxAttempts == 3 && p.RunAt.Equal(runAt)
+	})).RunAndReturn(func(_ context.Context, p postgres.EnqueueJobParams) (postgres.Job, error) {
+		return postgres.Job{JobID: p.JobID}, nil //nolint:exhaustruct
+	}).Once()
+
+	id, err := jobs.Enqueue(s.ctx, s.s, greetJob, greeting{Name: "Alice"}, jobs.Options{
+		UniqueKey:   "greet:alice",
+		RunAt:       runAt,
+		MaxAttempts: 0,
+	})
+	s.NoError(err)
+	s.NotEqual(uuid.Nil, id)
+}
+
+func (s *JobsSuite) TestEnqueue_Defaults() {
+	s.rm.EXPECT().EnqueueJob(mock.Anything, mock.MatchedBy(func(p postgres.EnqueueJobParams) bool {
+		return !p.UniqueKey.Valid && p.MaxAttempts == 3 && time.Since(p.RunAt) < time.Minute
+	})).Return(postgres.Job{}, nil).Once()
+
+	_, err := jobs.Enqueue(s.ctx, s.s, greetJob, greeting{Name: "Bob"}, jobs.Options{}) //nolint:exhaustruct
+	s.NoError(err)
+}
+
+func (s *JobsSuite) TestEnqueue_Error() {
+	s.rm.EXPECT().EnqueueJob(mock.Anything, mock.Anything).Return(postgres.Job{}, gofakeit.ErrorDatabase()).Once()
+
+	_, err := jobs.Enqueue(s.ctx, s.s, greetJob, greeting{Name: "Bob"}, jobs.Options{}) //nolint:exhaustruct
+	s.Error(err)
+}
+
+func (s *JobsSuite) TestEnqueue_InTx() {
+	tx := jobsmocks.NewJobSaver(s.T())
+
+	tx.EXPECT().EnqueueJob(mock.Anything, mock.MatchedBy(func(p postgres.EnqueueJobParams) bool {
+		return p.Kind == "greet" && p.UniqueKey.String == "greet:alice" && p.MaxAttempts == 3
+	})).Return(postgres.Job{}, nil).Once() //nolint:exhaustruct
+
+	_, err := jobs.Enqueue(s.ctx, s.s.InTx(tx), greetJob, greeting{Name: "Alice"}, jobs.Options{ //nolint:exhaustruct
+		UniqueKey: "greet:alice",
+	})
+	s.NoError(err)
+}
+
+func (s *JobsSuite) TestRunNextJob() {
+	var greeted string
+
+	jobs.Handle(s.s, greetJob, func(_ context.Context, g greeting) error {
+		greeted = g.Name
+		return nil
+	})
+
+	job := s.job("greet", greeting{Name: "Alice"}, 1)
+
+	s.expectClaim(job)
+	s.rm.EXPECT().CompleteJob(mock.Anything, postgres.CompleteJobParams{JobID: job.JobID, LockedAt: job.LockedAt}).
+		Return(nil).Once()
+
+	ran, err := s.s.RunNextJob(s.ctx)
+	s.NoError(err)
+	s.True(ran)
+	s.Equal("Alice", greeted)
+}
+
+func (s *JobsSuite) TestRunNextJob_Nothing() {
+	jobs.Handle(s.s, greetJob, func(context.Context, greeting) error { return nil })
+
+	s.rm.EXPECT().ClaimJob(mock.Anything, mock.Anything).Return(postgres.Job{}, repoerrs.ErrEmptyResult).Once()
+
+	ran, err := s.s.RunNextJob(s.ctx)
+	s.NoError(err)
+	s.False(ran)
+}
+
+func (s *JobsSuite) TestRunNextJob_NoHandlers() {
+	// Nothing is claimed, the jobs are left to replicas handling them
+	ran, err := s.s.RunNextJob(s.ctx)
+	s.NoError(err)
+	s.False(ran)
+}
+
+func (s *JobsSuite) TestRunNextJob_Retried() {
+	cases := []struct {
+		attempt int32
+		backoff time.Duration
+	}{
+		{attempt: 1, backoff: 10 * time.Second},
+		{attempt: 2, backoff: 20 * time.Second},
+	}
+
+	for _, c := range cases {
+		s.Run("attempt "+strconv.Itoa(int(c.attempt)), func() {
+			s.SetupTest()
+			jobs.Handle(s.s, greetJob, func(context.Context, greeting) error { return errors.New("no luck") })
+
+			job := s.job("greet", greeting{Name: "Alice"}, c.attempt)
+
+			s.expectClaim(job)
+			s.rm.EXPECT().FailJob(mock.Anything, mock.MatchedBy(func(p postgres.FailJobParams) bool {
+				wait := time.Until(p.RunAt)
+
+				return p.Status == postgres.JobStatusQueued && p.LastError.String == "no luck" &&
+					p.JobID == job.JobID && p.LockedAt == job.LockedAt &&
+					wait > c.backoff-time.Second && wait <= c.backoff
+			})).Return(nil).Once()
+
+			ran, err := s.s.RunNextJob(s.ctx)
+			s.NoError(err)
+			s.True(ran)
+		})
+	}
+}
+
+func (s *JobsSuite) TestRunNextJob_Dead() {
+	jobs.Handle(s.s, greetJob, func(context.Context, greeting) error { return errors.New("no luck") })
+
+	s.expectClaim(s.job("greet", greeting{Name: "Alice"}, 3))
+	s.rm.EXPECT().FailJob(mock.Anything, mock.MatchedBy(func(p postgres.FailJobParams) bool {
+		return p.Status == postgres.JobStatusDead
+	})).Return(nil).Once()
+
+	_, err := s.s.RunNextJob(s.ctx)
+	s.NoError(err)
+}
+
+func (s *JobsSuite) TestRunNextJob_Permanent() {
+	jobs.Handle(s.s, greetJob, func(context.Context, greeting) error {
+		return jobs.ErrPermanent.Wrap(errors.New("no such person"))
+	})
+
+	s.expectClaim(s.job("greet", greeting{Name: "Alice"}, 1))
+	s.rm.EXPECT().FailJob(mock.Anything, mock.MatchedBy(func(p postgres.FailJobParams) bool {
+		return p.Status == postgres.JobStatusDead
+	})).Return(nil).Once()
+
+	_, err := s.s.RunNextJob(s.ctx)
+	s.NoError(err)
+}
+
+func (s *JobsSuite) TestRunNextJob_BadPayload() {
+	jobs.Handle(s.s, greetJob, func(context.Context, greeting) error { return nil })
+
+	s.expectClaim(s.job("greet", "not a greeting", 1))
+	s.rm.EXPECT().FailJob(mock.Anything, mock.MatchedBy(func(p postgres.FailJobParams) bool {
+		return p.Status == postgres.JobStatusDead
+	})).Return(nil).Once()
+
+	_, err := s.s.RunNextJob(s.ctx)
+	s.NoError(err)
+}
+
+func (s *JobsSuite) TestRunNextJob_Panic() {
+	jobs.Handle(s.s, greetJob, func(context.Context, greeting) error { panic("oops") })
+
+	s.expectClaim(s.job("greet", greeting{Name: "Alice"}, 1))
+	s.rm.EXPECT().FailJob(mock.Anything, mock.MatchedBy(func(p postgres.FailJobParams) bool {
+		return p.Status == postgres.JobStatusQueued && p.LastError.Valid
+	})).Return(nil).Once()
+
+	ran, err := s.s.RunNextJob(s.ctx)
+	s.NoError(err)
+	s.True(ran)
+}
+
+func (s *JobsSuite) TestRunNextJob_ClaimError() {
+	jobs.Handle(s.s, greetJob, func(context.Context, greeting) error { return nil })
+
+	s.rm.EXPECT().ClaimJob(mock.Anything, mock.Anything).Return(postgres.Job{}, gofakeit.ErrorDatabase()).Once()
+
+	_, err := s.s.RunNextJob(s.ctx)
+	s.Error(err)
+}
+
+func (s *JobsSuite) TestRun_FinishesRunningJobs() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	started := make(chan struct{})
+
+	var jobErr error
+
+	jobs.Handle(s.s, greetJob, func(jobCtx context.Context, _ greeting) error {
+		close(started)
+		time.Sleep(50 * time.Millisecond)
+
+		jobErr = jobCtx.Err()
+
+		return nil
+	})
+
+	s.rm.EXPECT().ClaimJob(mock.Anything, mock.Anything).Return(s.job("greet", greeting{Name: "Alice"}, 1), nil).Once()
+	s.rm.EXPECT().CompleteJob(mock.Anything, mock.Anything).Return(nil).Once()
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	s.s.Run(ctx)
+	s.NoError(jobErr)
+}
+
+func (s *JobsSuite) TestGetDeadJobs() {
+	dead := s.job("greet", greeting{Name: "Alice"}, 3)
+	dead.Status = postgres.JobStatusDead
+	dead.LastError = pgconv.Text("no luck")
+
+	s.rm.EXPECT().DeadJobs(mock.Anything, postgres.DeadJobsParams{Offsetv: 10, Limitv: 10}).
+		Return([]postgres.Job{dead}, nil).Once()
+	s.rm.EXPECT().CountDeadJobs(mock.Anything).Return(11, nil).Once()
+
+	out, err := s.s.GetDeadJobs(s.ctx, jobs.GetDeadJobsInput{Page: 2, PageSize: 10})
+	s.Require().NoError(err)
+	s.Require().Len(out.Jobs, 1)
+	s.Equal(dead.JobID, out.Jobs[0].Id)
+	s.Equal("no luck", *out.Jobs[0].LastError)
+	s.Equal(int32(2), out.LastPage)
+}
+
+func (s *JobsSuite) TestRetryJob() {
+	job := s.job("greet", greeting{Name: "Alice"}, 0)
+	job.Status = postgres.JobStatusQueued
+
+	s.rm.EXPECT().RetryDeadJob(mock.Anything, job.JobID).Return(job, nil).Once()
+
+	out, err := s.s.RetryJob(s.ctx, jobs.RetryJobInput{JobId: job.JobID})
+	s.NoError(err)
+	s.Equal(postgres.JobStatusQueued, out.Job.Status)
+}
+
+func (s *JobsSuite) TestRetryJob_Errors() {
+	cases := []struct {
+		name    string
+		repoErr error
+		err     error
+	}{
+		{name: "not dead", repoErr: repoerrs.ErrEmptyResult, err: jobs.ErrJobNotFound},
+		{name: "same key queued", repoErr: repoerrs.ErrUnique, err: jobs.ErrJobQueued},
+	}
+
+	for _, c := range cases {
+		s.Run(c.name, func() {
+			s.SetupTest()
+			s.rm.EXPECT().RetryDeadJob(mock.Anything, mock.Anything).Return(postgres.Job{}, c.repoErr).Once()
+
+			_, err := s.s.RetryJob(s.ctx, jobs.RetryJobInput{JobId: uuid.New()})
+			s.ErrorIs(err, c.err)
+		})
+	}
+}
+
+func TestJobs(t *testing.T) {
+	suite.Run(t, new(JobsSuite))
+}
@@ -0,0 +1,190 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+)
+
+// Run runs the jobs with the workers until ctx is done, then waits for the running jobs.
+func (s *Service) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for range s.c.Workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			s.work(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// work runs due jobs one by one every poll interval until ctx is done.
+func (s *Service) work(ctx context.Context) {
+	log := logger.FromContext(ctx)
+
+	ticker := time.NewTicker(s.c.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			ran, err := s.RunNextJob(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("running job")
+				break
+			}
+
+			if !ran {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunNextJob runs the due job waiting first and reports whether there was one.
+// A failed job is queued again or dead, only errors of the queue itself are returned.
+func (s *Service) RunNextJob(ctx context.Context) (bool, error) {
+	if len(s.kinds) == 0 {
+		return false, nil
+	}
+
+	job, err := s.repo.ClaimJob(ctx, postgres.ClaimJobParams{
+		Kinds:       s.kinds,
+		StaleBefore: pgconv.Timestamptz(time.Now().Add(-s.c.StaleAfter)),
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return false, nil
+
+	case err != nil:
+		return false, e.NewFrom("claiming job", err)
+	}
+
+	log := logger.FromContext(ctx).With().
+		Stringer("job_id", job.JobID).Str("kind", job.Kind).Int32("attempt", job.Attempts).Logger()
+
+	log.Debug().Msg("running job")
+
+	jobCtx, cancel := s.jobContext(logger.WithLogger(ctx, log))
+	runErr := s.run(jobCtx, job)
+
+	cancel()
+
+	// The result is saved even if the worker is stopping
+	ctx = context.WithoutCancel(ctx)
+
+	if runErr == nil {
+		err = s.repo.CompleteJob(ctx, postgres.CompleteJobParams{JobID: job.JobID, LockedAt: job.LockedAt})
+		if err != nil {
+			return true, e.NewFrom("completing job", err, fields.F("job_id", job.JobID))
+		}
+
+		log.Debug().Msg("job is done")
+
+		return true, nil
+	}
+
+	params := postgres.FailJobParams{
+		Status:    postgres.JobStatusQueued,
+		LastError: pgconv.Text(runErr.Error()),
+		RunAt:     time.Now().Add(s.backoff(job.Attempts)),
+		JobID:     job.JobID,
+		LockedAt:  job.LockedAt,
+	}
+
+	if job.Attempts >= job.MaxAttempts || errors.Is(runErr, ErrPermanent) {
+		log.Error().Err(runErr).Msg("job is dead")
+
+		params.Status = postgres.JobStatusDead
+	} else {
+		log.Warn().Err(runErr).Time("retry_at", params.RunAt).Msg("job failed")
+	}
+
+	err = s.repo.FailJob(ctx, params)
+	if err != nil {
+		return true, e.NewFrom("failing job", err, fields.F("job_id", job.JobID))
+	}
+
+	return true, nil
+}
+
+// jobContext lets the job finish when ctx is done, it is cancelled after the shutdown timeout then.
+// Jobs running longer than the stale time are cancelled too, other workers take them over.
+func (s *Service) jobContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	var (
+		jobCtx context.Context
+		cancel context.CancelFunc
+	)
+
+	if s.c.StaleAfter > 0 {
+		jobCtx, cancel = context.WithTimeout(context.WithoutCancel(ctx), s.c.StaleAfter)
+	} else {
+		jobCtx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		timer := time.NewTimer(s.c.ShutdownTimeout)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			cancel()
+		case <-jobCtx.Done():
+		}
+	})
+
+	return jobCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// run runs the handler of the job, a panic fails the job.
+func (s *Service) run(ctx context.Context, job postgres.Job) (err error) {
+	handle, ok := s.handlers[job.Kind]
+	if !ok {
+		return e.New("no handler for the job kind", fields.F("kind", job.Kind))
+	}
+
+	defer func() {
+		if recov := recover(); recov != nil {
+			err = e.New("job panicked", fields.F("panic", recov))
+		}
+	}()
+
+	return handle(ctx, job.Payload)
+}
+
+// backoff is how long the job waits after the attempt, it doubles every attempt up to the max.
+func (s *Service) backoff(attempt int32) time.Duration {
+	delay := s.c.MinBackoff
+
+	for range attempt - 1 {
+		delay *= 2
+
+		if delay >= s.c.MaxBackoff {
+			return s.c.MaxBackoff
+		}
+	}
+
+	return delay
+}
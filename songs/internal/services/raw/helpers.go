@@ -4,6 +4,7 @@ import (
 	"crypto/sha1" //nolint:gosec
 	"encoding/hex"
 	"reflect"
+	"strings"
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
@@ -14,19 +15,23 @@ import (
 	"github.com/rs/zerolog"
 )
 
-func getObjectId(artistId uuid.UUID, songName, fileExt string) string {
-	objectIdHash := sha1.Sum([]byte(artistId.String() + "\u0002" + songName)) //nolint:gosec
+// getObjectId names the objects of the song by its id, the names are reused once a song is trashed
+// and the objects of the trashed song must not be shared with the new one.
+func getObjectId(artistId, songId uuid.UUID, fileExt string) string {
+	objectIdHash := sha1.Sum([]byte(artistId.String() + "\u0002" + songId.String())) //nolint:gosec
 	return hex.EncodeToString(objectIdHash[:]) + "." + fileExt
 }
 
-func (s *ServiceRaw) SongUrl(rawSongId string) string {
-	return s.songUrlTpl + rawSongId
-}
-
+// ImageUrl is the unsigned url of the image, it is stored with the song and signed by SignImageUrl.
 func (s *ServiceRaw) ImageUrl(rawImageId string) string {
 	return s.imageUrlTpl + rawImageId
 }
 
+// ImageObjectId returns the object of the image, images hosted elsewhere have none.
+func (s *ServiceRaw) ImageObjectId(imageUrl string) (string, bool) {
+	return strings.CutPrefix(imageUrl, s.imageUrlTpl)
+}
+
 func songsDiff(a, b postgres.Song) zerolog.LogObjectMarshaler {
 	return logger.ObjectFunc(func(e *zerolog.Event) {
 		if a.SongID != b.SongID {
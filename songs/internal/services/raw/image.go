@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/url"
 	"path/filepath"
+	"time"
 
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/objects"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
-	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/s3minio"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
 
@@ -63,7 +67,7 @@ func (s *ServiceRaw) UploadRawSongImage(ctx context.Context,
 		return null, e.NewFrom("getting song", err, fields.F("song_id", input.SongId))
 	}
 
-	objectId := getObjectId(input.ArtistId, songRow.Song.Name, input.Extension)
+	objectId := getObjectId(input.ArtistId, songRow.Song.SongID, input.Extension)
 
 	log.Debug().Str("object_id", objectId).Msg("calculated object id")
 
@@ -86,7 +90,7 @@ func (s *ServiceRaw) UploadRawSongImage(ctx context.Context,
 	log.Debug().Object("songs_diff", songsDiff(songRow.Song, patchedSong)).Msg("patched song")
 	log.Debug().Msg("putting song object")
 
-	err = s.storage.PutImageObject(ctx, s3minio.ImageObject{
+	err = s.storage.PutImageObject(ctx, objects.ImageObject{
 		Id:          objectId,
 		Extension:   input.Extension,
 		WeightBytes: input.WeightBytes,
@@ -101,24 +105,74 @@ func (s *ServiceRaw) UploadRawSongImage(ctx context.Context,
 		return null, e.NewFrom("commit transaction", err)
 	}
 
+	// The song shows the image without a thumbnail until the job has made it
+	if s.c.ThumbnailSize > 0 {
+		err = EnqueueThumbnail(ctx, s.jobs, objectId)
+		if err != nil {
+			log.Warn().Err(err).Str("object_id", objectId).Msg("error queueing thumbnail")
+		}
+	}
+
+	broker.SendOrLog(ctx, s.broker, broker.SongImageChangedMessage{
+		SongId:    input.SongId,
+		ArtistId:  input.ArtistId,
+		ImageUrl:  s.ImageUrl(objectId),
+		ChangedAt: time.Now(),
+	})
+
 	return UploadRawSongImageOutput{
-		ImageUrl: s.ImageUrl(objectId),
+		ImageUrl: s.SignImageUrl(s.ImageUrl(objectId), input.ArtistId.String()),
 	}, nil
 }
 
+type GetRawSongImageInput struct {
+	ObjectId string
+	// Query of the signed link, see [ServiceRaw.SignImageUrl]
+	Signature url.Values
+}
+
 type GetRawSongImageOutput struct {
 	Extension string
 	Content   io.Reader
 }
 
-func (s *ServiceRaw) GetRawSongImage(ctx context.Context, songId string) (GetRawSongImageOutput, error) {
-	reader, err := s.storage.GetImageObject(ctx, songId)
+// GetRawSongImage returns the image object if the link is signed and not expired.
+// Images of unreleased and taken down songs are not found unless the link was given to the singer
+// of a song using the image that is not taken down.
+func (s *ServiceRaw) GetRawSongImage(ctx context.Context, in GetRawSongImageInput) (GetRawSongImageOutput, error) {
+	log := logger.FromContext(ctx)
+
+	listenerId, err := s.verifyLink(linkKindImage, in.ObjectId, in.Signature)
 	if err != nil {
-		return GetRawSongImageOutput{}, e.NewFrom("getting song object", err, fields.F("song_id", songId))
+		return GetRawSongImageOutput{}, err
+	}
+
+	status, err := s.repo.ImageObjectStatus(ctx, pgconv.Text(s.ImageUrl(in.ObjectId)))
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return GetRawSongImageOutput{}, ErrFileNotFound
+
+	case err != nil:
+		return GetRawSongImageOutput{}, e.NewFrom("getting image status", err, fields.F("image_id", in.ObjectId))
+
+	case imageHiddenFrom(listenerId, status):
+		log.Debug().Str("image_id", in.ObjectId).Msg("songs of the image are not released")
+		return GetRawSongImageOutput{}, ErrFileNotFound
+	}
+
+	reader, err := s.storage.GetImageObject(ctx, in.ObjectId)
+
+	switch {
+	case errors.Is(err, objstore.ErrNotFound):
+		return GetRawSongImageOutput{}, ErrFileNotFound.Wrap(err, fields.F("image_id", in.ObjectId))
+
+	case err != nil:
+		return GetRawSongImageOutput{}, e.NewFrom("getting image object", err, fields.F("image_id", in.ObjectId))
 	}
 
 	return GetRawSongImageOutput{
-		Extension: filepath.Ext(songId)[1:],
+		Extension: filepath.Ext(in.ObjectId)[1:],
 		Content:   reader,
 	}, nil
 }
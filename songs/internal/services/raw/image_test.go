@@ -2,13 +2,16 @@ package raw_test
 
 import (
 	"context"
+	"net/url"
 	"strings"
 	"testing"
 
 	rawmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/raw"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/urlsign"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/google/uuid"
@@ -21,6 +24,7 @@ type UploadRawSongImageSuite struct {
 
 	om *rawmocks.ObjectStorage
 	sm *rawmocks.SongRepo
+	bm *rawmocks.Broker
 
 	s     *raw.ServiceRaw
 	ctx   context.Context
@@ -30,11 +34,14 @@ type UploadRawSongImageSuite struct {
 func (s *UploadRawSongImageSuite) SetupTest() {
 	s.om = rawmocks.NewObjectStorage(s.T())
 	s.sm = rawmocks.NewSongRepo(s.T())
+	s.bm = rawmocks.NewBroker(s.T())
 
 	s.s = raw.NewWithConfig(raw.Config{
 		Dependencies: raw.Dependencies{
 			ObjectStorage: s.om,
 			SongRepo:      s.sm,
+			Broker:        s.bm,
+			UrlSigner:     newSigner(),
 		},
 		HostUsesTls: true,
 		Host:        gofakeit.DomainName(),
@@ -50,6 +57,7 @@ func (s *UploadRawSongImageSuite) TestHappyPath() {
 	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(postgres.Song(validMySongRow(s.input.SongId).Song), nil).Once()
 	s.om.EXPECT().PutImageObject(mock.Anything, mock.Anything).Return(nil).Once()
 	s.sm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
 	s.sm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
 
 	_, err := s.s.UploadRawSongImage(s.ctx, s.input)
@@ -135,16 +143,22 @@ func validUploadRawSongImageInput() raw.UploadRawSongImageInput {
 type GetRawSongImageSuite struct {
 	suite.Suite
 
-	om  *rawmocks.ObjectStorage
-	s   *raw.ServiceRaw
-	ctx context.Context
+	om     *rawmocks.ObjectStorage
+	sm     *rawmocks.SongRepo
+	signer *urlsign.Signer
+	s      *raw.ServiceRaw
+	ctx    context.Context
 }
 
 func (s *GetRawSongImageSuite) SetupTest() {
 	s.om = rawmocks.NewObjectStorage(s.T())
+	s.sm = rawmocks.NewSongRepo(s.T())
+	s.signer = newSigner()
 	s.s = raw.NewWithConfig(raw.Config{
 		Dependencies: raw.Dependencies{
 			ObjectStorage: s.om,
+			SongRepo:      s.sm,
+			UrlSigner:     s.signer,
 		},
 		HostUsesTls: true,
 		Host:        gofakeit.DomainName(),
@@ -153,21 +167,103 @@ func (s *GetRawSongImageSuite) SetupTest() {
 }
 
 func (s *GetRawSongImageSuite) TestHappyPath() {
+	s.sm.EXPECT().ImageObjectStatus(mock.Anything, mock.Anything).Return(releasedImageStatus(), nil).Once()
 	s.om.EXPECT().GetImageObject(mock.Anything, mock.Anything).Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
 
-	output, err := s.s.GetRawSongImage(s.ctx, gofakeit.Fruit()+".jpg")
+	output, err := s.s.GetRawSongImage(s.ctx, s.input(""))
 	s.NoError(err)
 	s.NotNil(output.Content)
 	s.Equal("jpg", output.Extension)
 }
 
 func (s *GetRawSongImageSuite) TestError() {
+	s.sm.EXPECT().ImageObjectStatus(mock.Anything, mock.Anything).Return(releasedImageStatus(), nil).Once()
 	s.om.EXPECT().GetImageObject(mock.Anything, mock.Anything).Return(nil, gofakeit.Error()).Once()
 
-	_, err := s.s.GetRawSongImage(s.ctx, gofakeit.Fruit()+".jpg")
+	_, err := s.s.GetRawSongImage(s.ctx, s.input(""))
 	s.Error(err)
 }
 
+func (s *GetRawSongImageSuite) TestObjectNotFound() {
+	s.sm.EXPECT().ImageObjectStatus(mock.Anything, mock.Anything).Return(releasedImageStatus(), nil).Once()
+	s.om.EXPECT().GetImageObject(mock.Anything, mock.Anything).Return(nil, objstore.ErrNotFound).Once()
+
+	_, err := s.s.GetRawSongImage(s.ctx, s.input(""))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *GetRawSongImageSuite) TestInvalidLink() {
+	_, err := s.s.GetRawSongImage(s.ctx, raw.GetRawSongImageInput{ObjectId: "a.jpg", Signature: url.Values{}})
+	s.ErrorIs(err, raw.ErrInvalidLink)
+}
+
+func (s *GetRawSongImageSuite) TestImageStatus_EmptyResult() {
+	s.sm.EXPECT().ImageObjectStatus(mock.Anything, mock.Anything).Return(postgres.ImageObjectStatusRow{}, repoerrs.ErrEmptyResult).Once()
+
+	_, err := s.s.GetRawSongImage(s.ctx, s.input(""))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *GetRawSongImageSuite) TestUnreleased() {
+	singerId := uuid.New()
+	status := postgres.ImageObjectStatusRow{Released: false, SingerIds: []uuid.UUID{singerId}}
+
+	s.sm.EXPECT().ImageObjectStatus(mock.Anything, mock.Anything).Return(status, nil).Twice()
+	s.om.EXPECT().GetImageObject(mock.Anything, mock.Anything).Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+
+	_, err := s.s.GetRawSongImage(s.ctx, s.input(uuid.NewString()))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+
+	output, err := s.s.GetRawSongImage(s.ctx, s.input(singerId.String()))
+	s.NoError(err)
+	s.NotNil(output.Content)
+}
+
+func (s *GetRawSongImageSuite) TestSharedByTwoUnreleasedSongs() {
+	firstSinger, secondSinger := uuid.New(), uuid.New()
+	status := postgres.ImageObjectStatusRow{Released: false, SingerIds: []uuid.UUID{firstSinger, secondSinger}}
+
+	s.sm.EXPECT().ImageObjectStatus(mock.Anything, mock.Anything).Return(status, nil).Times(3)
+	s.om.EXPECT().GetImageObject(mock.Anything, mock.Anything).Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Twice()
+
+	_, err := s.s.GetRawSongImage(s.ctx, s.input(firstSinger.String()))
+	s.NoError(err)
+
+	_, err = s.s.GetRawSongImage(s.ctx, s.input(secondSinger.String()))
+	s.NoError(err)
+
+	_, err = s.s.GetRawSongImage(s.ctx, s.input(uuid.NewString()))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *GetRawSongImageSuite) TestOnlyTakenDownSongs() {
+	// Songs taken down leave neither the release nor their singers in the status
+	status := postgres.ImageObjectStatusRow{Released: false, SingerIds: nil}
+
+	s.sm.EXPECT().ImageObjectStatus(mock.Anything, mock.Anything).Return(status, nil).Once()
+
+	_, err := s.s.GetRawSongImage(s.ctx, s.input(uuid.NewString()))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+// input returns the input with a link to a new image given to the listener.
+func (s *GetRawSongImageSuite) input(listenerId string) raw.GetRawSongImageInput {
+	objectId := gofakeit.Fruit() + ".jpg"
+
+	return raw.GetRawSongImageInput{
+		ObjectId:  objectId,
+		Signature: parseQuery(s.T(), s.signer.Sign("image", objectId, listenerId)),
+	}
+}
+
+// releasedImageStatus is the status of an image shared by a released song and an unreleased one.
+func releasedImageStatus() postgres.ImageObjectStatusRow {
+	return postgres.ImageObjectStatusRow{
+		Released:  true,
+		SingerIds: []uuid.UUID{uuid.New(), uuid.New()},
+	}
+}
+
 func TestGetRawSongImage(t *testing.T) {
 	suite.Run(t, new(GetRawSongImageSuite))
 }
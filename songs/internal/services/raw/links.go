@@ -0,0 +1,74 @@
+package raw
+
+import (
+	"errors"
+	"net/url"
+	"slices"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/urlsign"
+
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Kinds of signed objects, a link to a song can't be used for an image with the same id.
+const (
+	linkKindSong      = "song"
+	linkKindImage     = "image"
+	linkKindThumbnail = "thumbnail"
+)
+
+var (
+	ErrInvalidLink = erix.NewStatus("link is invalid", erix.CodeForbidden)
+	ErrLinkExpired = erix.NewStatus("link expired", erix.CodeForbidden)
+)
+
+// SongUrl returns the link to the song for the listener, it works until it expires.
+// The listener id is empty for anonymous listeners.
+func (s *ServiceRaw) SongUrl(rawSongId, listenerId string) string {
+	return s.songUrlTpl + rawSongId + "?" + s.signer.Sign(linkKindSong, rawSongId, listenerId)
+}
+
+// SignImageUrl returns the link to the image for the listener.
+// Images hosted elsewhere are returned as they are.
+func (s *ServiceRaw) SignImageUrl(imageUrl, listenerId string) string {
+	imageId, ok := s.ImageObjectId(imageUrl)
+	if !ok {
+		return imageUrl
+	}
+
+	return imageUrl + "?" + s.signer.Sign(linkKindImage, imageId, listenerId)
+}
+
+// verifyLink returns the listener the link was given to.
+func (s *ServiceRaw) verifyLink(kind, objectId string, query url.Values) (string, error) {
+	listenerId, err := s.signer.Verify(kind, objectId, query)
+
+	switch {
+	case errors.Is(err, urlsign.ErrExpired):
+		return "", ErrLinkExpired.Wrap(err, fields.F("object_id", objectId))
+
+	case err != nil:
+		return "", ErrInvalidLink.Wrap(err, fields.F("object_id", objectId))
+	}
+
+	return listenerId, nil
+}
+
+// hiddenFrom tells whether the object of the song is hidden from the listener,
+// objects of unreleased songs are served to their singers only.
+func hiddenFrom(listenerId string, singerId uuid.UUID, releasedAt pgtype.Timestamptz) bool {
+	return !releasedAt.Valid && listenerId != singerId.String()
+}
+
+// imageHiddenFrom tells whether the hosted image is hidden from the listener.
+// Songs may share an image, it is served once one of them is released
+// and to the singers of the songs before that.
+func imageHiddenFrom(listenerId string, status postgres.ImageObjectStatusRow) bool {
+	return !status.Released && !slices.ContainsFunc(status.SingerIds, func(id uuid.UUID) bool {
+		return id.String() == listenerId
+	})
+}
@@ -0,0 +1,177 @@
+package raw
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/regions"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+)
+
+var ErrPreviewNotReady = erix.NewStatus("preview is being made, try again later", erix.CodeServiceUnavailable)
+
+// PreviewUrl returns the public link to the preview of the song, it is empty if previews are disabled.
+func (s *ServiceRaw) PreviewUrl(songId uuid.UUID) string {
+	if s.c.PreviewLength == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(s.previewUrlTpl, songId)
+}
+
+// PreviewJob cuts the preview of a released song, see [ServiceRaw.HandlePreviewJob].
+const PreviewJob jobs.Kind[PreviewJobArgs] = "make_preview"
+
+type PreviewJobArgs struct {
+	ObjectId string        `json:"objectId"`
+	Duration time.Duration `json:"duration"`
+}
+
+// EnqueuePreview queues the preview of the song object,
+// it is queued once while its job waits or runs.
+func EnqueuePreview(ctx context.Context, q jobs.Queue, objectId string, duration time.Duration) error {
+	_, err := jobs.Enqueue(ctx, q, PreviewJob, PreviewJobArgs{
+		ObjectId: objectId,
+		Duration: duration,
+	}, jobs.Options{UniqueKey: "preview:" + objectId}) //nolint:exhaustruct
+	if err != nil {
+		return e.NewFrom("queueing preview", err, fields.F("song_id", objectId))
+	}
+
+	return nil
+}
+
+// HandlePreviewJob makes the preview of the job, songs whose objects are gone are not retried.
+func (s *ServiceRaw) HandlePreviewJob(ctx context.Context, args PreviewJobArgs) error {
+	err := s.MakePreview(ctx, args.ObjectId, args.Duration)
+	if errors.Is(err, objstore.ErrNotFound) {
+		return jobs.ErrPermanent.Wrap(err)
+	}
+
+	return err
+}
+
+// MakePreview cuts the preview of the song object and stores it with the same id.
+// It does nothing if previews are disabled.
+func (s *ServiceRaw) MakePreview(ctx context.Context, objectId string, duration time.Duration) error {
+	if s.c.PreviewLength == 0 {
+		return nil
+	}
+
+	log := logger.FromContext(ctx)
+
+	reader, err := s.storage.GetSongObject(ctx, objectId)
+	if err != nil {
+		return e.NewFrom("getting song object", err, fields.F("song_id", objectId))
+	}
+
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	clip := s.previewClip(duration)
+	preview := &bytes.Buffer{}
+
+	length, err := s.decoder.CutMp3(ctx, reader, preview, clip)
+	if err != nil {
+		return e.NewFrom("cutting preview", err, fields.F("song_id", objectId))
+	}
+
+	log.Debug().
+		Str("song_id", objectId).Dur("start", clip.Start).Dur("length", length).
+		Msg("cut preview")
+
+	err = s.storage.PutPreviewObject(ctx, objectId, preview, int64(preview.Len()))
+	if err != nil {
+		return e.NewFrom("putting preview object", err, fields.F("song_id", objectId))
+	}
+
+	return nil
+}
+
+// previewClip starts the window at the share of the song, moving it back so it fits the song.
+func (s *ServiceRaw) previewClip(duration time.Duration) audiodecoder.Clip {
+	start := time.Duration(float64(duration) * s.c.PreviewStart)
+	start = max(0, min(start, duration-s.c.PreviewLength))
+
+	return audiodecoder.Clip{
+		Start:  start,
+		Length: s.c.PreviewLength,
+		Fade:   s.c.PreviewFade,
+	}
+}
+
+type GetPreviewInput struct {
+	SongId uuid.UUID
+	// Country of the listener, see [regions.Available]
+	Country string
+}
+
+// GetPreview returns the preview of the released song, anyone may listen to it and it is not counted as a play.
+// A preview missing since the release, e.g. of a song released before previews, is queued on the first request
+// and ErrPreviewNotReady is returned until its job has made it.
+func (s *ServiceRaw) GetPreview(ctx context.Context, in GetPreviewInput) (io.Reader, error) {
+	log := logger.FromContext(ctx)
+
+	if s.c.PreviewLength == 0 {
+		return nil, ErrFileNotFound
+	}
+
+	status, err := s.repo.SongPreviewStatus(ctx, in.SongId)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return nil, ErrFileNotFound
+
+	case err != nil:
+		return nil, e.NewFrom("getting song status", err, fields.F("song_id", in.SongId))
+
+	case !status.ReleasedAt.Valid || !status.S3ObjectName.Valid:
+		return nil, ErrFileNotFound
+
+	case status.ModerationStatus == postgres.ModerationStatusTakenDown:
+		log.Debug().Stringer("song_id", in.SongId).Msg("song is taken down")
+		return nil, ErrFileNotFound
+
+	case !regions.Available(status.AllowedCountries, status.DeniedCountries, in.Country):
+		log.Debug().Stringer("song_id", in.SongId).Str("country", in.Country).Msg("song is restricted in the region")
+		return nil, ErrRegionRestricted
+	}
+
+	objectId := status.S3ObjectName.String
+
+	reader, err := s.storage.GetPreviewObject(ctx, objectId)
+
+	switch {
+	case errors.Is(err, objstore.ErrNotFound):
+		log.Debug().Stringer("song_id", in.SongId).Msg("queueing missing preview")
+
+		qErr := EnqueuePreview(ctx, s.jobs, objectId, pointer.Get(pgconv.FromInterval(status.Duration)))
+		if qErr != nil {
+			return nil, qErr
+		}
+
+		return nil, ErrPreviewNotReady.Wrap(err, fields.F("song_id", in.SongId))
+
+	case err != nil:
+		return nil, e.NewFrom("getting preview object", err, fields.F("song_id", in.SongId))
+	}
+
+	return reader, nil
+}
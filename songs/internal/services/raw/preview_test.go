@@ -0,0 +1,221 @@
+package raw_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	rawmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PreviewSuite struct {
+	suite.Suite
+
+	om *rawmocks.ObjectStorage
+	sm *rawmocks.SongRepo
+	dm *rawmocks.SoundDecoder
+	jm *rawmocks.JobQueue
+
+	ctx context.Context
+}
+
+func (s *PreviewSuite) SetupTest() {
+	s.om = rawmocks.NewObjectStorage(s.T())
+	s.sm = rawmocks.NewSongRepo(s.T())
+	s.dm = rawmocks.NewSoundDecoder(s.T())
+	s.jm = rawmocks.NewJobQueue(s.T())
+	s.ctx = context.Background()
+}
+
+func (s *PreviewSuite) service(length time.Duration) *raw.ServiceRaw {
+	return raw.NewWithConfig(raw.Config{
+		Dependencies: raw.Dependencies{
+			ObjectStorage: s.om,
+			SongRepo:      s.sm,
+			SoundDecoder:  s.dm,
+			JobQueue:      s.jm,
+		},
+		HostUsesTls:   true,
+		Host:          "songs.example.com",
+		PreviewStart:  0.3,
+		PreviewLength: length,
+		PreviewFade:   time.Second,
+	})
+}
+
+func (s *PreviewSuite) expectCut(clip audiodecoder.Clip) {
+	s.om.EXPECT().GetSongObject(mock.Anything, "abc.mp3").Return(strings.NewReader("song"), nil).Once()
+	s.dm.EXPECT().CutMp3(mock.Anything, mock.Anything, mock.Anything, clip).Return(clip.Length, nil).Once()
+	s.om.EXPECT().PutPreviewObject(mock.Anything, "abc.mp3", mock.Anything, mock.Anything).Return(nil).Once()
+}
+
+func (s *PreviewSuite) TestPreviewUrl() {
+	songId := uuid.New()
+
+	s.Equal("https://songs.example.com/songs/api/v1/song/"+songId.String()+"/preview",
+		s.service(30*time.Second).PreviewUrl(songId))
+	s.Empty(s.service(0).PreviewUrl(songId))
+}
+
+func (s *PreviewSuite) TestMakePreview() {
+	s.expectCut(audiodecoder.Clip{Start: 60 * time.Second, Length: 30 * time.Second, Fade: time.Second})
+
+	err := s.service(30*time.Second).MakePreview(s.ctx, "abc.mp3", 200*time.Second)
+	s.NoError(err)
+}
+
+func (s *PreviewSuite) TestMakePreview_ShortSong() {
+	s.expectCut(audiodecoder.Clip{Start: 10 * time.Second, Length: 30 * time.Second, Fade: time.Second})
+
+	err := s.service(30*time.Second).MakePreview(s.ctx, "abc.mp3", 40*time.Second)
+	s.NoError(err)
+}
+
+func (s *PreviewSuite) TestMakePreview_ShorterThanPreview() {
+	s.expectCut(audiodecoder.Clip{Start: 0, Length: 30 * time.Second, Fade: time.Second})
+
+	err := s.service(30*time.Second).MakePreview(s.ctx, "abc.mp3", 20*time.Second)
+	s.NoError(err)
+}
+
+func (s *PreviewSuite) TestMakePreview_Disabled() {
+	err := s.service(0).MakePreview(s.ctx, "abc.mp3", 200*time.Second)
+	s.NoError(err)
+}
+
+func (s *PreviewSuite) TestMakePreview_CutError() {
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(strings.NewReader("song"), nil).Once()
+	s.dm.EXPECT().CutMp3(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0, gofakeit.Error()).Once()
+
+	err := s.service(30*time.Second).MakePreview(s.ctx, "abc.mp3", 200*time.Second)
+	s.Error(err)
+}
+
+func (s *PreviewSuite) TestHandlePreviewJob() {
+	s.expectCut(audiodecoder.Clip{Start: 60 * time.Second, Length: 30 * time.Second, Fade: time.Second})
+
+	err := s.service(30*time.Second).HandlePreviewJob(s.ctx, raw.PreviewJobArgs{
+		ObjectId: "abc.mp3",
+		Duration: 200 * time.Second,
+	})
+	s.NoError(err)
+}
+
+func (s *PreviewSuite) TestHandlePreviewJob_ObjectGone() {
+	s.om.EXPECT().GetSongObject(mock.Anything, "abc.mp3").Return(nil, objstore.ErrNotFound).Once()
+
+	err := s.service(30*time.Second).HandlePreviewJob(s.ctx, raw.PreviewJobArgs{
+		ObjectId: "abc.mp3",
+		Duration: 200 * time.Second,
+	})
+	s.ErrorIs(err, jobs.ErrPermanent)
+}
+
+func (s *PreviewSuite) TestGetPreview() {
+	status := releasedPreviewStatus()
+
+	s.sm.EXPECT().SongPreviewStatus(mock.Anything, status.SongID).Return(status, nil).Once()
+	s.om.EXPECT().GetPreviewObject(mock.Anything, "abc.mp3").Return(strings.NewReader("preview"), nil).Once()
+
+	reader, err := s.service(30*time.Second).GetPreview(s.ctx, raw.GetPreviewInput{SongId: status.SongID, Country: ""})
+	s.NoError(err)
+	s.NotNil(reader)
+}
+
+func (s *PreviewSuite) TestGetPreview_QueuedWhenMissing() {
+	status := releasedPreviewStatus()
+
+	s.sm.EXPECT().SongPreviewStatus(mock.Anything, status.SongID).Return(status, nil).Once()
+	s.om.EXPECT().GetPreviewObject(mock.Anything, "abc.mp3").Return(nil, objstore.ErrNotFound).Once()
+	s.jm.EXPECT().EnqueueJob(mock.Anything, string(raw.PreviewJob), mock.Anything, jobs.Options{ //nolint:exhaustruct
+		UniqueKey: "preview:abc.mp3",
+	}).Return(uuid.New(), nil).Once()
+
+	_, err := s.service(30*time.Second).GetPreview(s.ctx, raw.GetPreviewInput{SongId: status.SongID, Country: ""})
+	s.ErrorIs(err, raw.ErrPreviewNotReady)
+}
+
+func (s *PreviewSuite) TestGetPreview_QueueError() {
+	status := releasedPreviewStatus()
+
+	s.sm.EXPECT().SongPreviewStatus(mock.Anything, status.SongID).Return(status, nil).Once()
+	s.om.EXPECT().GetPreviewObject(mock.Anything, "abc.mp3").Return(nil, objstore.ErrNotFound).Once()
+	s.jm.EXPECT().EnqueueJob(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(uuid.Nil, gofakeit.ErrorDatabase()).Once()
+
+	_, err := s.service(30*time.Second).GetPreview(s.ctx, raw.GetPreviewInput{SongId: status.SongID, Country: ""})
+	s.Error(err)
+	s.NotErrorIs(err, raw.ErrPreviewNotReady)
+}
+
+func (s *PreviewSuite) TestGetPreview_Unreleased() {
+	status := releasedPreviewStatus()
+	status.ReleasedAt = pgconv.NullTimestamptz()
+
+	s.sm.EXPECT().SongPreviewStatus(mock.Anything, status.SongID).Return(status, nil).Once()
+
+	_, err := s.service(30*time.Second).GetPreview(s.ctx, raw.GetPreviewInput{SongId: status.SongID, Country: ""})
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *PreviewSuite) TestGetPreview_TakenDown() {
+	status := releasedPreviewStatus()
+	status.ModerationStatus = postgres.ModerationStatusTakenDown
+
+	s.sm.EXPECT().SongPreviewStatus(mock.Anything, status.SongID).Return(status, nil).Once()
+
+	_, err := s.service(30*time.Second).GetPreview(s.ctx, raw.GetPreviewInput{SongId: status.SongID, Country: ""})
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *PreviewSuite) TestGetPreview_RegionRestricted() {
+	status := releasedPreviewStatus()
+	status.DeniedCountries = []string{"DE"}
+
+	s.sm.EXPECT().SongPreviewStatus(mock.Anything, status.SongID).Return(status, nil).Once()
+
+	_, err := s.service(30*time.Second).GetPreview(s.ctx, raw.GetPreviewInput{SongId: status.SongID, Country: "DE"})
+	s.ErrorIs(err, raw.ErrRegionRestricted)
+}
+
+func (s *PreviewSuite) TestGetPreview_EmptyResult() {
+	s.sm.EXPECT().SongPreviewStatus(mock.Anything, mock.Anything).
+		Return(postgres.SongPreviewStatusRow{}, repoerrs.ErrEmptyResult).Once()
+
+	_, err := s.service(30*time.Second).GetPreview(s.ctx, raw.GetPreviewInput{SongId: uuid.New(), Country: ""})
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *PreviewSuite) TestGetPreview_Disabled() {
+	_, err := s.service(0).GetPreview(s.ctx, raw.GetPreviewInput{SongId: uuid.New(), Country: ""})
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func TestPreview(t *testing.T) {
+	suite.Run(t, new(PreviewSuite))
+}
+
+func releasedPreviewStatus() postgres.SongPreviewStatusRow {
+	return postgres.SongPreviewStatusRow{
+		SongID:           uuid.New(),
+		S3ObjectName:     pgconv.Text("abc.mp3"),
+		Duration:         pgconv.Interval(200 * time.Second),
+		ModerationStatus: postgres.ModerationStatusActive,
+		AllowedCountries: nil,
+		DeniedCountries:  nil,
+		ReleasedAt:       pgconv.Timestamptz(time.Now().Add(-time.Hour)),
+	}
+}
@@ -0,0 +1,281 @@
+package raw
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ProcessNextUpload processes the song uploaded first and reports whether there was one.
+// A file failing the checks fails the song with the reason. If the file can't be read,
+// the error is returned and the song is processed again once its processing is stale.
+func (s *ServiceRaw) ProcessNextUpload(ctx context.Context) (bool, error) {
+	song, err := s.repo.StartProcessing(ctx, pgconv.Timestamptz(time.Now().Add(-s.c.StaleAfter)))
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return false, nil
+
+	case err != nil:
+		return false, e.NewFrom("starting processing", err)
+	}
+
+	// The reason of a failed check is kept with the song
+	_, err = s.processUpload(ctx, song)
+
+	var statusErr *erix.StatusErr
+	if err != nil && !errors.As(err, &statusErr) {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// processUpload processes the started song and finishes it as ready or failed.
+// The song is failed if the file fails the checks, the status error is returned then.
+func (s *ServiceRaw) processUpload(ctx context.Context, song postgres.Song) (postgres.Song, error) {
+	log := logger.FromContext(ctx).With().
+		Stringer("song_id", song.SongID).Str("object_id", song.S3ObjectName.String).Logger()
+	ctx = logger.WithLogger(ctx, log)
+
+	log.Debug().Msg("processing upload")
+
+	params, checkErr := s.process(ctx, song)
+
+	var statusErr *erix.StatusErr
+
+	switch {
+	case errors.As(checkErr, &statusErr):
+		log.Info().Err(checkErr).Msg("upload failed the checks")
+
+		params.AudioState = postgres.AudioStateFailed
+		params.AudioError = pgconv.Text(erix.LastReason(checkErr))
+
+	case checkErr != nil:
+		return song, e.NewFrom("processing upload", checkErr, fields.F("song_id", song.SongID))
+	}
+
+	params.SongID = song.SongID
+	params.StartedAt = song.ProcessingStartedAt
+
+	finished, err := s.repo.FinishProcessing(ctx, params)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		log.Info().Msg("song was uploaded again while processing")
+		return song, nil
+
+	case err != nil:
+		return song, e.NewFrom("finishing processing", err, fields.F("song_id", song.SongID))
+	}
+
+	log.Info().Str("state", string(finished.AudioState)).Msg("processed upload")
+
+	if finished.AudioState != postgres.AudioStateReady {
+		return finished, checkErr
+	}
+
+	// Events outlive signed links, so they carry the unsigned one
+	broker.SendOrLog(ctx, s.broker, broker.SongUploadedMessage{
+		SongId:     finished.SongID,
+		ArtistId:   finished.SingerFk,
+		SongUrl:    s.songUrlTpl + finished.S3ObjectName.String,
+		Duration:   pointer.Get(pgconv.FromInterval(finished.Duration)),
+		UploadedAt: time.Now(),
+	})
+
+	return finished, nil
+}
+
+// process reads the metadata of the file and checks it is a playable song,
+// then makes the waveform of the song and the thumbnail of its hosted image.
+// Files failing the checks are status errors.
+func (s *ServiceRaw) process(ctx context.Context, song postgres.Song) (postgres.FinishProcessingParams, error) {
+	log := logger.FromContext(ctx)
+
+	params := postgres.FinishProcessingParams{
+		AudioState:   postgres.AudioStateReady,
+		AudioError:   pgconv.NullText(),
+		Duration:     pgconv.NullInterval(),
+		Bitrate:      pgconv.NullInt4(),
+		BitrateMode:  postgres.NullBitrateMode{BitrateMode: "", Valid: false},
+		SampleRate:   pgconv.NullInt4(),
+		ChannelMode:  postgres.NullChannelMode{ChannelMode: "", Valid: false},
+		Encoder:      pgconv.NullText(),
+		Explicit:     false,
+		Waveform:     nil,
+		ThumbnailUrl: pgconv.NullText(),
+		SongID:       uuid.Nil,
+		StartedAt:    pgconv.NullTimestamptz(),
+	}
+
+	reader, err := s.storage.GetSongObject(ctx, song.S3ObjectName.String)
+	if err != nil {
+		return params, e.NewFrom("getting song object", err)
+	}
+
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	contentBuf := &bytes.Buffer{}
+
+	info, err := s.decoder.GetMp3Info(ctx, io.TeeReader(reader, contentBuf))
+
+	switch {
+	// The worker is stopping, the song is processed again later
+	case err != nil && ctx.Err() != nil:
+		return params, e.NewFrom("getting mp3 info", err)
+
+	case err != nil:
+		return params, ErrAudioUnreadable.Wrap(err)
+	}
+
+	log.Debug().
+		Dur("song_duration", info.Duration).Int("frames", info.Frames).
+		Int("bitrate", info.Bitrate).Str("bitrate_mode", string(info.BitrateMode)).
+		Int("sample_rate", info.SampleRate).Str("channel_mode", string(info.ChannelMode)).
+		Str("encoder", info.Encoder).Int("skipped_bytes", info.SkippedBytes).
+		Msg("got mp3 info")
+
+	err = s.checkAudio(info)
+	if err != nil {
+		return params, err
+	}
+
+	// A broken tag must not fail the upload, the artist can still mark the song explicit by hand
+	advisory, err := s.decoder.GetMp3Advisory(ctx, bytes.NewReader(contentBuf.Bytes()))
+	if err != nil {
+		log.Warn().Err(err).Msg("error reading mp3 advisory")
+	}
+
+	if advisory == audiodecoder.AdvisoryExplicit {
+		log.Debug().Msg("song is explicit by id3 tag")
+
+		params.Explicit = true
+	}
+
+	params.Duration = pgconv.Interval(info.Duration)
+	params.Bitrate = pgconv.Int4(info.Bitrate)
+	params.BitrateMode = postgres.NullBitrateMode{BitrateMode: postgres.BitrateMode(info.BitrateMode), Valid: true}
+	params.SampleRate = pgconv.Int4(info.SampleRate)
+	params.ChannelMode = postgres.NullChannelMode{ChannelMode: postgres.ChannelMode(info.ChannelMode), Valid: true}
+	params.Encoder = pgconv.Text(info.Encoder)
+
+	// The song is ready without the waveform and the thumbnail, the next upload makes them again
+	params.Waveform = s.waveform(ctx, contentBuf.Bytes())
+	params.ThumbnailUrl = s.thumbnail(ctx, song)
+
+	return params, nil
+}
+
+// waveform returns the levels of the song, nil if waveforms are disabled or the file has none.
+func (s *ServiceRaw) waveform(ctx context.Context, content []byte) []int16 {
+	if s.c.WaveformPoints == 0 {
+		return nil
+	}
+
+	log := logger.FromContext(ctx)
+
+	levels, err := s.decoder.GetMp3Waveform(ctx, bytes.NewReader(content), s.c.WaveformPoints)
+	if err != nil {
+		log.Warn().Err(err).Msg("error making waveform")
+		return nil
+	}
+
+	waveform := make([]int16, len(levels))
+	for i, level := range levels {
+		waveform[i] = int16(level) //nolint:gosec // levels are at most audiodecoder.WaveformMax
+	}
+
+	log.Debug().Int("points", len(waveform)).Msg("made waveform")
+
+	return waveform
+}
+
+// thumbnail makes the thumbnail of the hosted image of the song unless it has one,
+// images set before thumbnails were made get theirs here. Other images get them when uploaded.
+func (s *ServiceRaw) thumbnail(ctx context.Context, song postgres.Song) pgtype.Text {
+	imageId, ok := s.ImageObjectId(song.ImageUrl.String)
+	if !ok || !song.ImageUrl.Valid || song.ThumbnailUrl.String == s.ThumbnailUrl(imageId) {
+		return pgconv.NullText()
+	}
+
+	log := logger.FromContext(ctx)
+
+	thumbnailUrl, err := s.MakeThumbnail(ctx, imageId)
+
+	switch {
+	case err != nil:
+		log.Warn().Err(err).Str("image_id", imageId).Msg("error making thumbnail")
+		return pgconv.NullText()
+
+	case thumbnailUrl == "":
+		return pgconv.NullText()
+	}
+
+	return pgconv.Text(thumbnailUrl)
+}
+
+// FailStaleUploads fails the uploads whose requests were gone before the file was stored.
+func (s *ServiceRaw) FailStaleUploads(ctx context.Context) (int, error) {
+	ids, err := s.repo.FailStaleUploads(ctx, postgres.FailStaleUploadsParams{
+		AudioError:  pgconv.Text(erix.LastReason(ErrUploadInterrupted)),
+		StaleBefore: time.Now().Add(-s.c.StaleAfter),
+	})
+	if err != nil && !errors.Is(err, repoerrs.ErrEmptyResult) {
+		return 0, e.NewFrom("failing stale uploads", err)
+	}
+
+	return len(ids), nil
+}
+
+type GetUploadStateInput struct {
+	ArtistId uuid.UUID
+	SongId   uuid.UUID
+}
+
+type UploadState struct {
+	State postgres.AudioState
+	// Reason of the failure, empty unless the state is failed
+	Error     string
+	ChangedAt time.Time
+}
+
+// GetUploadState returns the audio state of the song of the artist.
+func (s *ServiceRaw) GetUploadState(ctx context.Context, input GetUploadStateInput) (UploadState, error) {
+	row, err := s.repo.MySong(ctx, postgres.MySongParams{
+		SingerID: input.ArtistId,
+		SongID:   input.SongId,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return UploadState{}, ErrSongNotExists
+
+	case err != nil:
+		return UploadState{}, e.NewFrom("getting song", err, fields.F("song_id", input.SongId))
+	}
+
+	return UploadState{
+		State:     row.Song.AudioState,
+		Error:     row.Song.AudioError.String,
+		ChangedAt: row.Song.AudioStateAt,
+	}, nil
+}
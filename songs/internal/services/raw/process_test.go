@@ -0,0 +1,317 @@
+package raw_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	rawmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProcessUploadSuite struct {
+	suite.Suite
+
+	om *rawmocks.ObjectStorage
+	sm *rawmocks.SongRepo
+	bm *rawmocks.Broker
+	dm *rawmocks.SoundDecoder
+
+	s    *raw.ServiceRaw
+	ctx  context.Context
+	song postgres.Song
+}
+
+func (s *ProcessUploadSuite) SetupTest() {
+	s.om = rawmocks.NewObjectStorage(s.T())
+	s.sm = rawmocks.NewSongRepo(s.T())
+	s.bm = rawmocks.NewBroker(s.T())
+	s.dm = rawmocks.NewSoundDecoder(s.T())
+
+	s.s = raw.NewWithConfig(raw.Config{
+		Dependencies: raw.Dependencies{
+			ObjectStorage: s.om,
+			SongRepo:      s.sm,
+			Broker:        s.bm,
+			SoundDecoder:  s.dm,
+			UrlSigner:     newSigner(),
+		},
+		HostUsesTls:     true,
+		Host:            gofakeit.DomainName(),
+		MinDuration:     time.Second,
+		MaxSkippedRatio: 0.1,
+		StaleAfter:      time.Minute,
+	})
+
+	s.ctx = context.Background()
+
+	s.song = validMySongRow(uuid.New()).Song
+	s.song.AudioState = postgres.AudioStateProcessing
+	s.song.ProcessingStartedAt = pgconv.Timestamptz(time.Now())
+}
+
+// expectProbe expects the song to be taken and its file to be read.
+func (s *ProcessUploadSuite) expectProbe(info audiodecoder.Mp3Info) {
+	s.sm.EXPECT().StartProcessing(mock.Anything, mock.Anything).Return(s.song, nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, s.song.S3ObjectName.String).
+		Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+	s.dm.EXPECT().GetMp3Info(mock.Anything, mock.Anything).Return(info, nil).Once()
+}
+
+// expectFinish expects the processing to finish with the state and returns the song in it.
+func (s *ProcessUploadSuite) expectFinish(state postgres.AudioState, match func(postgres.FinishProcessingParams) bool) {
+	finished := s.song
+	finished.AudioState = state
+
+	s.sm.EXPECT().FinishProcessing(mock.Anything, mock.MatchedBy(func(p postgres.FinishProcessingParams) bool {
+		return p.AudioState == state && p.SongID == s.song.SongID && p.StartedAt == s.song.ProcessingStartedAt &&
+			match(p)
+	})).Return(finished, nil).Once()
+}
+
+func (s *ProcessUploadSuite) TestHappyPath() {
+	s.expectProbe(validMp3Info())
+	s.dm.EXPECT().GetMp3Advisory(mock.Anything, mock.Anything).Return(audiodecoder.AdvisoryUnknown, nil).Once()
+	s.expectFinish(postgres.AudioStateReady, func(p postgres.FinishProcessingParams) bool {
+		return !p.AudioError.Valid &&
+			p.Bitrate.Int32 == 128000 &&
+			p.BitrateMode.BitrateMode == postgres.BitrateModeCbr &&
+			p.SampleRate.Int32 == 44100 &&
+			p.ChannelMode.ChannelMode == postgres.ChannelModeJointStereo &&
+			p.Encoder.String == "LAME3.100" &&
+			!p.Explicit
+	})
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	processed, err := s.s.ProcessNextUpload(s.ctx)
+	s.NoError(err)
+	s.True(processed)
+}
+
+func (s *ProcessUploadSuite) TestExplicitAdvisory() {
+	s.expectProbe(validMp3Info())
+	s.dm.EXPECT().GetMp3Advisory(mock.Anything, mock.Anything).Return(audiodecoder.AdvisoryExplicit, nil).Once()
+	s.expectFinish(postgres.AudioStateReady, func(p postgres.FinishProcessingParams) bool {
+		return p.Explicit
+	})
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.ProcessNextUpload(s.ctx)
+	s.NoError(err)
+}
+
+func (s *ProcessUploadSuite) TestAdvisoryError() {
+	// A broken tag does not fail the upload
+	s.expectProbe(validMp3Info())
+	s.dm.EXPECT().GetMp3Advisory(mock.Anything, mock.Anything).Return(audiodecoder.AdvisoryUnknown, gofakeit.Error()).Once()
+	s.expectFinish(postgres.AudioStateReady, func(p postgres.FinishProcessingParams) bool {
+		return !p.Explicit
+	})
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.ProcessNextUpload(s.ctx)
+	s.NoError(err)
+}
+
+// withExtras makes the waveform and the thumbnail of the hosted image of the song.
+func (s *ProcessUploadSuite) withExtras() *rawmocks.Thumbnailer {
+	tm := rawmocks.NewThumbnailer(s.T())
+
+	s.s = raw.NewWithConfig(raw.Config{
+		Dependencies: raw.Dependencies{
+			ObjectStorage: s.om,
+			SongRepo:      s.sm,
+			Broker:        s.bm,
+			SoundDecoder:  s.dm,
+			UrlSigner:     newSigner(),
+			Thumbnailer:   tm,
+		},
+		HostUsesTls:    true,
+		Host:           gofakeit.DomainName(),
+		StaleAfter:     time.Minute,
+		WaveformPoints: 100,
+		ThumbnailSize:  256,
+	})
+
+	s.song.ImageUrl = pgconv.Text(s.s.ImageUrl("abc.png"))
+
+	return tm
+}
+
+func (s *ProcessUploadSuite) TestWaveformAndThumbnail() {
+	tm := s.withExtras()
+
+	s.expectProbe(validMp3Info())
+	s.dm.EXPECT().GetMp3Advisory(mock.Anything, mock.Anything).Return(audiodecoder.AdvisoryUnknown, nil).Once()
+	s.dm.EXPECT().GetMp3Waveform(mock.Anything, mock.Anything, 100).Return([]int{100, 50, 0}, nil).Once()
+	s.om.EXPECT().GetImageObject(mock.Anything, "abc.png").Return(strings.NewReader("image"), nil).Once()
+	tm.EXPECT().MakeThumbnail(mock.Anything, mock.Anything, mock.Anything, 256).Return(nil).Once()
+	s.om.EXPECT().PutThumbnailObject(mock.Anything, "abc.png", mock.Anything, mock.Anything).Return(nil).Once()
+	s.expectFinish(postgres.AudioStateReady, func(p postgres.FinishProcessingParams) bool {
+		return slices.Equal(p.Waveform, []int16{100, 50, 0}) &&
+			p.ThumbnailUrl.String == s.s.ThumbnailUrl("abc.png")
+	})
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.ProcessNextUpload(s.ctx)
+	s.NoError(err)
+}
+
+func (s *ProcessUploadSuite) TestWaveformAndThumbnailErrors() {
+	// The song is ready without them
+	s.withExtras()
+
+	s.expectProbe(validMp3Info())
+	s.dm.EXPECT().GetMp3Advisory(mock.Anything, mock.Anything).Return(audiodecoder.AdvisoryUnknown, nil).Once()
+	s.dm.EXPECT().GetMp3Waveform(mock.Anything, mock.Anything, 100).Return(nil, gofakeit.Error()).Once()
+	s.om.EXPECT().GetImageObject(mock.Anything, "abc.png").Return(nil, gofakeit.Error()).Once()
+	s.expectFinish(postgres.AudioStateReady, func(p postgres.FinishProcessingParams) bool {
+		return p.Waveform == nil && !p.ThumbnailUrl.Valid
+	})
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.ProcessNextUpload(s.ctx)
+	s.NoError(err)
+}
+
+func (s *ProcessUploadSuite) TestThumbnailAlreadyMade() {
+	s.withExtras()
+	s.song.ThumbnailUrl = pgconv.Text(s.s.ThumbnailUrl("abc.png"))
+
+	s.expectProbe(validMp3Info())
+	s.dm.EXPECT().GetMp3Advisory(mock.Anything, mock.Anything).Return(audiodecoder.AdvisoryUnknown, nil).Once()
+	s.dm.EXPECT().GetMp3Waveform(mock.Anything, mock.Anything, 100).Return([]int{100}, nil).Once()
+	s.expectFinish(postgres.AudioStateReady, func(p postgres.FinishProcessingParams) bool {
+		return !p.ThumbnailUrl.Valid
+	})
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.ProcessNextUpload(s.ctx)
+	s.NoError(err)
+}
+
+func (s *ProcessUploadSuite) TestFailedChecks() {
+	noFrames := validMp3Info()
+	noFrames.Frames, noFrames.Duration, noFrames.AudioBytes = 0, 0, 0
+
+	corrupted := validMp3Info()
+	corrupted.SkippedBytes = corrupted.AudioBytes / 5
+
+	short := validMp3Info()
+	short.Duration = 500 * time.Millisecond
+
+	cases := []struct {
+		name string
+		info audiodecoder.Mp3Info
+		err  error
+	}{
+		{name: "no frames", info: noFrames, err: raw.ErrNoAudioFrames},
+		{name: "corrupted", info: corrupted, err: raw.ErrAudioCorrupted},
+		{name: "too short", info: short, err: raw.ErrSongTooShort},
+	}
+
+	for _, c := range cases {
+		s.Run(c.name, func() {
+			s.SetupTest()
+			s.expectProbe(c.info)
+			s.expectFinish(postgres.AudioStateFailed, func(p postgres.FinishProcessingParams) bool {
+				return p.AudioError.String == erix.LastReason(c.err)
+			})
+
+			processed, err := s.s.ProcessNextUpload(s.ctx)
+			s.NoError(err)
+			s.True(processed)
+		})
+	}
+}
+
+func (s *ProcessUploadSuite) TestUnreadable() {
+	s.sm.EXPECT().StartProcessing(mock.Anything, mock.Anything).Return(s.song, nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).
+		Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+	s.dm.EXPECT().GetMp3Info(mock.Anything, mock.Anything).Return(audiodecoder.Mp3Info{}, gofakeit.Error()).Once()
+	s.expectFinish(postgres.AudioStateFailed, func(p postgres.FinishProcessingParams) bool {
+		return p.AudioError.String == erix.LastReason(raw.ErrAudioUnreadable)
+	})
+
+	_, err := s.s.ProcessNextUpload(s.ctx)
+	s.NoError(err)
+}
+
+func (s *ProcessUploadSuite) TestGetSongObjectError() {
+	// The song is processed again once its processing is stale
+	s.sm.EXPECT().StartProcessing(mock.Anything, mock.Anything).Return(s.song, nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(nil, gofakeit.Error()).Once()
+
+	processed, err := s.s.ProcessNextUpload(s.ctx)
+	s.Error(err)
+	s.True(processed)
+}
+
+func (s *ProcessUploadSuite) TestUploadedAgain() {
+	s.expectProbe(validMp3Info())
+	s.dm.EXPECT().GetMp3Advisory(mock.Anything, mock.Anything).Return(audiodecoder.AdvisoryUnknown, nil).Once()
+	s.sm.EXPECT().FinishProcessing(mock.Anything, mock.Anything).Return(postgres.Song{}, repoerrs.ErrEmptyResult).Once()
+
+	processed, err := s.s.ProcessNextUpload(s.ctx)
+	s.NoError(err)
+	s.True(processed)
+}
+
+func (s *ProcessUploadSuite) TestNothingToProcess() {
+	s.sm.EXPECT().StartProcessing(mock.Anything, mock.Anything).Return(postgres.Song{}, repoerrs.ErrEmptyResult).Once()
+
+	processed, err := s.s.ProcessNextUpload(s.ctx)
+	s.NoError(err)
+	s.False(processed)
+}
+
+func (s *ProcessUploadSuite) TestFailStaleUploads() {
+	s.sm.EXPECT().FailStaleUploads(mock.Anything, mock.MatchedBy(func(p postgres.FailStaleUploadsParams) bool {
+		return p.AudioError.String == erix.LastReason(raw.ErrUploadInterrupted) &&
+			time.Since(p.StaleBefore) >= time.Minute
+	})).Return([]uuid.UUID{uuid.New(), uuid.New()}, nil).Once()
+
+	failed, err := s.s.FailStaleUploads(s.ctx)
+	s.NoError(err)
+	s.Equal(2, failed)
+}
+
+func (s *ProcessUploadSuite) TestGetUploadState() {
+	row := validMySongRow(s.song.SongID)
+	row.Song.AudioState = postgres.AudioStateFailed
+	row.Song.AudioError = pgconv.Text(erix.LastReason(raw.ErrSongTooShort))
+	row.Song.AudioStateAt = time.Now()
+
+	s.sm.EXPECT().MySong(mock.Anything, postgres.MySongParams{SingerID: row.Song.SingerFk, SongID: row.Song.SongID}).
+		Return(row, nil).Once()
+
+	state, err := s.s.GetUploadState(s.ctx, raw.GetUploadStateInput{ArtistId: row.Song.SingerFk, SongId: row.Song.SongID})
+	s.Require().NoError(err)
+	s.Equal(postgres.AudioStateFailed, state.State)
+	s.Equal(erix.LastReason(raw.ErrSongTooShort), state.Error)
+	s.Equal(row.Song.AudioStateAt, state.ChangedAt)
+}
+
+func (s *ProcessUploadSuite) TestGetUploadState_NotExists() {
+	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(postgres.MySongRow{}, repoerrs.ErrEmptyResult).Once()
+
+	_, err := s.s.GetUploadState(s.ctx, raw.GetUploadStateInput{ArtistId: uuid.New(), SongId: uuid.New()})
+	s.ErrorIs(err, raw.ErrSongNotExists)
+}
+
+func TestProcessUpload(t *testing.T) {
+	suite.Run(t, new(ProcessUploadSuite))
+}
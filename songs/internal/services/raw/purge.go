@@ -0,0 +1,109 @@
+package raw
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+// PurgeTrash hard-deletes at most limit songs trashed before deletedBefore along with their objects
+// and returns the number of purged songs. The songs are locked, so purges of other instances skip them.
+func (s *ServiceRaw) PurgeTrash(ctx context.Context, deletedBefore time.Time, limit int32) (int, error) {
+	log := logger.FromContext(ctx)
+
+	txRepo, err := s.repo.Begin(ctx)
+	if err != nil {
+		return 0, e.NewFrom("begin transaction", err)
+	}
+	defer txRepo.Rollback(ctx) //nolint:errcheck
+
+	songs, err := txRepo.ExpiredTrashedSongs(ctx, postgres.ExpiredTrashedSongsParams{
+		DeletedBefore: pgconv.Timestamptz(deletedBefore),
+		Limitv:        limit,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult) || (len(songs) == 0 && err == nil):
+		return 0, nil
+
+	case err != nil:
+		return 0, e.NewFrom("getting expired trashed songs", err)
+	}
+
+	ids := make([]uuid.UUID, len(songs))
+	for i, song := range songs {
+		ids[i] = song.SongID
+	}
+
+	deletedImages := make(map[string]bool)
+
+	// Objects are removed first, if it fails, the songs stay in the trash until the next purge
+	for _, song := range songs {
+		if song.S3ObjectName.Valid {
+			err = s.storage.DeleteSongObject(ctx, song.S3ObjectName.String)
+			if err != nil {
+				return 0, e.NewFrom("deleting song object", err, fields.F("song_id", song.SongID))
+			}
+
+			err = s.storage.DeletePreviewObject(ctx, song.S3ObjectName.String)
+			if err != nil {
+				return 0, e.NewFrom("deleting preview object", err, fields.F("song_id", song.SongID))
+			}
+		}
+
+		// Images hosted elsewhere are not ours to delete
+		imageId, ok := s.ImageObjectId(song.ImageUrl.String)
+		if !ok || !song.ImageUrl.Valid || deletedImages[imageId] {
+			continue
+		}
+
+		// Any hosted image may be set to a song, it stays while songs out of this batch have it
+		used, err := txRepo.ImageUsedByOthers(ctx, postgres.ImageUsedByOthersParams{
+			ImageUrl:    song.ImageUrl,
+			ExcludedIds: ids,
+		})
+		if err != nil {
+			return 0, e.NewFrom("checking image references", err, fields.F("song_id", song.SongID))
+		}
+
+		if used {
+			log.Debug().Str("image_id", imageId).Stringer("song_id", song.SongID).Msg("keeping shared image")
+			continue
+		}
+
+		err = s.storage.DeleteImageObject(ctx, imageId)
+		if err != nil {
+			return 0, e.NewFrom("deleting image object", err, fields.F("song_id", song.SongID))
+		}
+
+		err = s.storage.DeleteThumbnailObject(ctx, imageId)
+		if err != nil {
+			return 0, e.NewFrom("deleting thumbnail object", err, fields.F("song_id", song.SongID))
+		}
+
+		deletedImages[imageId] = true
+	}
+
+	log.Debug().Array("songs_ids", logger.Stringers[uuid.UUID](ids)).Msg("deleting songs")
+
+	err = txRepo.DeleteSongs(ctx, ids)
+	if err != nil {
+		return 0, e.NewFrom("deleting songs", err)
+	}
+
+	err = txRepo.Commit(ctx)
+	if err != nil {
+		return 0, e.NewFrom("commit transaction", err)
+	}
+
+	return len(ids), nil
+}
@@ -0,0 +1,134 @@
+package raw_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rawmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PurgeTrashSuite struct {
+	suite.Suite
+
+	om *rawmocks.ObjectStorage
+	sm *rawmocks.SongRepo
+
+	s   *raw.ServiceRaw
+	ctx context.Context
+}
+
+func (s *PurgeTrashSuite) SetupTest() {
+	s.om = rawmocks.NewObjectStorage(s.T())
+	s.sm = rawmocks.NewSongRepo(s.T())
+
+	s.s = raw.NewWithConfig(raw.Config{
+		Dependencies: raw.Dependencies{
+			ObjectStorage: s.om,
+			SongRepo:      s.sm,
+		},
+		HostUsesTls: true,
+		Host:        "songs.example.com",
+	})
+
+	s.ctx = context.Background()
+}
+
+func (s *PurgeTrashSuite) TestHappyPath() {
+	hosted := validMySongRow(uuid.New()).Song
+	hosted.S3ObjectName = pgconv.Text("abc.mp3")
+	hosted.ImageUrl = pgconv.Text(s.s.ImageUrl("abc.png"))
+
+	external := validMySongRow(uuid.New()).Song
+	external.S3ObjectName = pgconv.NullText()
+	external.ImageUrl = pgconv.Text("https://images.example.com/abc.png")
+
+	s.sm.EXPECT().Begin(mock.Anything).Return(s.sm, nil).Once()
+	s.sm.EXPECT().ExpiredTrashedSongs(mock.Anything, mock.MatchedBy(func(p postgres.ExpiredTrashedSongsParams) bool {
+		return p.Limitv == 10
+	})).Return([]postgres.Song{hosted, external}, nil).Once()
+	s.om.EXPECT().DeleteSongObject(mock.Anything, "abc.mp3").Return(nil).Once()
+	s.om.EXPECT().DeletePreviewObject(mock.Anything, "abc.mp3").Return(nil).Once()
+	s.sm.EXPECT().ImageUsedByOthers(mock.Anything, mock.MatchedBy(func(p postgres.ImageUsedByOthersParams) bool {
+		return p.ImageUrl == hosted.ImageUrl && len(p.ExcludedIds) == 2
+	})).Return(false, nil).Once()
+	s.om.EXPECT().DeleteImageObject(mock.Anything, "abc.png").Return(nil).Once()
+	s.om.EXPECT().DeleteThumbnailObject(mock.Anything, "abc.png").Return(nil).Once()
+	s.sm.EXPECT().DeleteSongs(mock.Anything, []uuid.UUID{hosted.SongID, external.SongID}).Return(nil).Once()
+	s.sm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	purged, err := s.s.PurgeTrash(s.ctx, time.Now(), 10)
+	s.NoError(err)
+	s.Equal(2, purged)
+}
+
+func (s *PurgeTrashSuite) TestSharedImageKept() {
+	shared := s.s.ImageUrl("shared.png")
+
+	first := validMySongRow(uuid.New()).Song
+	first.S3ObjectName = pgconv.NullText()
+	first.ImageUrl = pgconv.Text(shared)
+
+	second := validMySongRow(uuid.New()).Song
+	second.S3ObjectName = pgconv.NullText()
+	second.ImageUrl = pgconv.Text(shared)
+
+	kept := validMySongRow(uuid.New()).Song
+	kept.S3ObjectName = pgconv.NullText()
+	kept.ImageUrl = pgconv.Text(s.s.ImageUrl("kept.png"))
+
+	s.sm.EXPECT().Begin(mock.Anything).Return(s.sm, nil).Once()
+	s.sm.EXPECT().ExpiredTrashedSongs(mock.Anything, mock.Anything).
+		Return([]postgres.Song{first, second, kept}, nil).Once()
+	// The shared image is deleted once, the other one is used by a live song
+	s.sm.EXPECT().ImageUsedByOthers(mock.Anything, mock.MatchedBy(func(p postgres.ImageUsedByOthersParams) bool {
+		return p.ImageUrl.String == shared
+	})).Return(false, nil).Once()
+	s.sm.EXPECT().ImageUsedByOthers(mock.Anything, mock.MatchedBy(func(p postgres.ImageUsedByOthersParams) bool {
+		return p.ImageUrl == kept.ImageUrl
+	})).Return(true, nil).Once()
+	s.om.EXPECT().DeleteImageObject(mock.Anything, "shared.png").Return(nil).Once()
+	s.om.EXPECT().DeleteThumbnailObject(mock.Anything, "shared.png").Return(nil).Once()
+	s.sm.EXPECT().DeleteSongs(mock.Anything, mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	purged, err := s.s.PurgeTrash(s.ctx, time.Now(), 10)
+	s.NoError(err)
+	s.Equal(3, purged)
+}
+
+func (s *PurgeTrashSuite) TestNothingToPurge() {
+	s.sm.EXPECT().Begin(mock.Anything).Return(s.sm, nil).Once()
+	s.sm.EXPECT().ExpiredTrashedSongs(mock.Anything, mock.Anything).Return([]postgres.Song{}, nil).Once()
+	s.sm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	purged, err := s.s.PurgeTrash(s.ctx, time.Now(), 10)
+	s.NoError(err)
+	s.Zero(purged)
+}
+
+func (s *PurgeTrashSuite) TestDeleteObjectError() {
+	song := validMySongRow(uuid.New()).Song
+
+	s.sm.EXPECT().Begin(mock.Anything).Return(s.sm, nil).Once()
+	s.sm.EXPECT().ExpiredTrashedSongs(mock.Anything, mock.Anything).Return([]postgres.Song{song}, nil).Once()
+	s.om.EXPECT().DeleteSongObject(mock.Anything, mock.Anything).Return(gofakeit.Error()).Once()
+	s.sm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	_, err := s.s.PurgeTrash(s.ctx, time.Now(), 10)
+	s.Error(err)
+}
+
+func TestPurgeTrash(t *testing.T) {
+	suite.Run(t, new(PurgeTrashSuite))
+}
@@ -0,0 +1,112 @@
+package raw
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrStorageQuotaExceeded = erix.NewStatus("storage quota exceeded", erix.CodePreconditionFailed)
+	ErrUploadRateExceeded   = erix.NewStatus("too many uploads, try again later", erix.CodeTooManyRequests)
+	ErrTooManyUploads       = erix.NewStatus("too many uploads in progress", erix.CodeTooManyRequests)
+)
+
+const (
+	uploadRateWindow = time.Hour
+	// Only a guard against slots of crashed instances, no upload takes that long
+	uploadSlotTtl = 15 * time.Minute
+)
+
+// acquireUpload applies the upload rate and concurrency limits of the artist.
+// Uploads of imports are counted against the import upload rate.
+// The returned func releases the upload slot. The limiter being down doesn't block uploads.
+func (s *ServiceRaw) acquireUpload(ctx context.Context, artistId uuid.UUID, imported bool) (func(), error) {
+	log := logger.FromContext(ctx)
+
+	limit := s.c.UploadsPerHour
+	if imported {
+		limit = s.c.ImportUploadsPerHour
+	}
+
+	if limit > 0 {
+		count, err := s.countUpload(ctx, artistId, imported)
+
+		switch {
+		case err != nil:
+			log.Warn().Err(err).Msg("error counting upload")
+
+		case count > int64(limit):
+			log.Debug().Int64("uploads", count).Bool("imported", imported).Msg("upload rate exceeded")
+			return nil, ErrUploadRateExceeded
+		}
+	}
+
+	if s.c.ConcurrentUploads <= 0 {
+		return func() {}, nil
+	}
+
+	uploadId := uuid.NewString()
+
+	running, err := s.limiter.AcquireUploadSlot(ctx, artistId, uploadId, uploadSlotTtl)
+	if err != nil {
+		log.Warn().Err(err).Msg("error acquiring upload slot")
+		return func() {}, nil
+	}
+
+	release := func() {
+		// The request may be cancelled already, the slot must be released anyway
+		err := s.limiter.ReleaseUploadSlot(context.WithoutCancel(ctx), artistId, uploadId)
+		if err != nil {
+			log.Warn().Err(err).Msg("error releasing upload slot")
+		}
+	}
+
+	if running > int64(s.c.ConcurrentUploads) {
+		release()
+
+		log.Debug().Int64("running_uploads", running).Msg("too many uploads in progress")
+
+		return nil, ErrTooManyUploads
+	}
+
+	return release, nil
+}
+
+func (s *ServiceRaw) countUpload(ctx context.Context, artistId uuid.UUID, imported bool) (int64, error) {
+	if imported {
+		return s.limiter.CountImportUpload(ctx, artistId, uploadRateWindow) //nolint:wrapcheck
+	}
+
+	return s.limiter.CountUpload(ctx, artistId, uploadRateWindow) //nolint:wrapcheck
+}
+
+// checkStorageQuota makes sure the new song object fits into the artist's quota.
+// The object of the song being replaced is not counted.
+func (s *ServiceRaw) checkStorageQuota(ctx context.Context, song postgres.Song, weightBytes int32) error {
+	if s.c.MaxBytes <= 0 {
+		return nil
+	}
+
+	log := logger.FromContext(ctx)
+
+	usage, err := s.repo.ArtistUsage(ctx, song.SingerFk)
+	if err != nil {
+		return e.NewFrom("getting artist usage", err, fields.F("artist_id", song.SingerFk))
+	}
+
+	used := usage.WeightBytes - int64(song.WeightBytes.Int32) + int64(weightBytes)
+	if used > s.c.MaxBytes {
+		log.Debug().Int64("used_bytes", used).Msg("storage quota exceeded")
+		return ErrStorageQuotaExceeded
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package raw_test
+
+import (
+	"context"
+	"testing"
+
+	rawmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UploadQuotasSuite struct {
+	suite.Suite
+
+	sm *rawmocks.SongRepo
+	dm *rawmocks.SoundDecoder
+	lm *rawmocks.UploadLimiter
+
+	s     *raw.ServiceRaw
+	ctx   context.Context
+	input raw.UploadRawSongInput
+}
+
+func (s *UploadQuotasSuite) SetupTest() {
+	s.sm = rawmocks.NewSongRepo(s.T())
+	s.dm = rawmocks.NewSoundDecoder(s.T())
+	s.lm = rawmocks.NewUploadLimiter(s.T())
+
+	s.s = raw.NewWithConfig(raw.Config{
+		Dependencies: raw.Dependencies{
+			SongRepo:      s.sm,
+			SoundDecoder:  s.dm,
+			UploadLimiter: s.lm,
+		},
+		HostUsesTls:       true,
+		Host:              gofakeit.DomainName(),
+		MaxBytes:          10 * 1024,
+		UploadsPerHour:    2,
+		ConcurrentUploads: 1,
+		// Imports are allowed more uploads than the artist by hand
+		ImportUploadsPerHour: 5,
+	})
+
+	s.ctx = context.Background()
+	s.input = validUploadRawSongInput()
+	s.input.WeightBytes = 2048
+}
+
+func (s *UploadQuotasSuite) TestUploadRateExceeded() {
+	s.lm.EXPECT().CountUpload(mock.Anything, s.input.ArtistId, mock.Anything).Return(3, nil).Once()
+
+	_, err := s.s.UploadRawSong(s.ctx, s.input)
+	s.ErrorIs(err, raw.ErrUploadRateExceeded)
+}
+
+func (s *UploadQuotasSuite) TestTooManyUploads() {
+	s.lm.EXPECT().CountUpload(mock.Anything, s.input.ArtistId, mock.Anything).Return(1, nil).Once()
+	s.lm.EXPECT().AcquireUploadSlot(mock.Anything, s.input.ArtistId, mock.Anything, mock.Anything).Return(2, nil).Once()
+	s.lm.EXPECT().ReleaseUploadSlot(mock.Anything, s.input.ArtistId, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.UploadRawSong(s.ctx, s.input)
+	s.ErrorIs(err, raw.ErrTooManyUploads)
+}
+
+func (s *UploadQuotasSuite) TestStorageQuotaExceeded() {
+	row := validMySongRow(s.input.SongId)
+
+	s.lm.EXPECT().CountUpload(mock.Anything, s.input.ArtistId, mock.Anything).Return(1, nil).Once()
+	s.lm.EXPECT().AcquireUploadSlot(mock.Anything, s.input.ArtistId, mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.lm.EXPECT().ReleaseUploadSlot(mock.Anything, s.input.ArtistId, mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(row, nil).Once()
+	s.sm.EXPECT().ArtistUsage(mock.Anything, row.Song.SingerFk).Return(postgres.ArtistUsageRow{
+		SongsCount:  3,
+		WeightBytes: 10 * 1024,
+	}, nil).Once()
+
+	_, err := s.s.UploadRawSong(s.ctx, s.input)
+	s.ErrorIs(err, raw.ErrStorageQuotaExceeded)
+}
+
+func (s *UploadQuotasSuite) TestReplacedObjectNotCounted() {
+	row := validMySongRow(s.input.SongId)
+	s.input.WeightBytes = row.Song.WeightBytes.Int32
+
+	s.lm.EXPECT().CountUpload(mock.Anything, s.input.ArtistId, mock.Anything).Return(1, nil).Once()
+	s.lm.EXPECT().AcquireUploadSlot(mock.Anything, s.input.ArtistId, mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.lm.EXPECT().ReleaseUploadSlot(mock.Anything, s.input.ArtistId, mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(row, nil).Once()
+	s.sm.EXPECT().ArtistUsage(mock.Anything, row.Song.SingerFk).Return(postgres.ArtistUsageRow{
+		SongsCount:  3,
+		WeightBytes: 10 * 1024,
+	}, nil).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.Anything).Return(postgres.Song{}, gofakeit.ErrorDatabase()).Once()
+
+	_, err := s.s.UploadRawSong(s.ctx, s.input)
+	s.Error(err)
+	s.NotErrorIs(err, raw.ErrStorageQuotaExceeded)
+}
+
+func (s *UploadQuotasSuite) TestImportedCountedApart() {
+	s.input.Imported = true
+
+	// No CountUpload call, the import budget is not exceeded
+	s.lm.EXPECT().CountImportUpload(mock.Anything, s.input.ArtistId, mock.Anything).Return(3, nil).Once()
+	s.lm.EXPECT().AcquireUploadSlot(mock.Anything, s.input.ArtistId, mock.Anything, mock.Anything).Return(2, nil).Once()
+	s.lm.EXPECT().ReleaseUploadSlot(mock.Anything, s.input.ArtistId, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.UploadRawSong(s.ctx, s.input)
+	s.ErrorIs(err, raw.ErrTooManyUploads)
+}
+
+func (s *UploadQuotasSuite) TestImportRateExceeded() {
+	s.input.Imported = true
+
+	s.lm.EXPECT().CountImportUpload(mock.Anything, s.input.ArtistId, mock.Anything).Return(6, nil).Once()
+
+	_, err := s.s.UploadRawSong(s.ctx, s.input)
+	s.ErrorIs(err, raw.ErrUploadRateExceeded)
+}
+
+func (s *UploadQuotasSuite) TestLimiterError() {
+	s.lm.EXPECT().CountUpload(mock.Anything, s.input.ArtistId, mock.Anything).Return(0, gofakeit.Error()).Once()
+	s.lm.EXPECT().AcquireUploadSlot(mock.Anything, s.input.ArtistId, mock.Anything, mock.Anything).
+		Return(0, gofakeit.Error()).Once()
+	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(postgres.MySongRow{}, repoerrs.ErrEmptyResult).Once()
+
+	_, err := s.s.UploadRawSong(s.ctx, s.input)
+	s.ErrorIs(err, raw.ErrSongNotExists)
+}
+
+func TestUploadQuotas(t *testing.T) {
+	suite.Run(t, new(UploadQuotasSuite))
+}
@@ -4,61 +4,177 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/url"
 	"time"
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/objects"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
-	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/s3minio"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type ServiceRaw struct {
-	c       Config
-	storage ObjectStorage
-	repo    SongRepo
-	decoder SoundDecoder
+	c           Config
+	storage     ObjectStorage
+	repo        SongRepo
+	decoder     SoundDecoder
+	broker      Broker
+	limiter     UploadLimiter
+	plays       PlayCounter
+	signer      UrlSigner
+	jobs        JobQueue
+	thumbnailer Thumbnailer
 
-	songUrlTpl  string
-	imageUrlTpl string
+	songUrlTpl      string
+	imageUrlTpl     string
+	previewUrlTpl   string
+	thumbnailUrlTpl string
 }
 
 type ObjectStorage interface {
-	PutSongObject(context.Context, s3minio.SongObject) error
+	PutSongObject(context.Context, objects.SongObject) error
 	GetSongObject(ctx context.Context, id string) (io.Reader, error)
-	PutImageObject(ctx context.Context, image s3minio.ImageObject) error
+	PutImageObject(ctx context.Context, image objects.ImageObject) error
 	GetImageObject(ctx context.Context, id string) (io.Reader, error)
+	DeleteSongObject(ctx context.Context, id string) error
+	DeleteImageObject(ctx context.Context, id string) error
+	PutPreviewObject(ctx context.Context, id string, content io.Reader, size int64) error
+	GetPreviewObject(ctx context.Context, id string) (io.Reader, error)
+	DeletePreviewObject(ctx context.Context, id string) error
+	PutThumbnailObject(ctx context.Context, id string, content io.Reader, size int64) error
+	GetThumbnailObject(ctx context.Context, id string) (io.Reader, error)
+	DeleteThumbnailObject(ctx context.Context, id string) error
 }
 
 type SongRepo interface {
 	MySong(context.Context, postgres.MySongParams) (postgres.MySongRow, error)
 	PatchSong(context.Context, postgres.PatchSongParams) (postgres.Song, error)
+	SetAudioState(context.Context, postgres.SetAudioStateParams) (postgres.Song, error)
+	StartProcessing(ctx context.Context, staleBefore pgtype.Timestamptz) (postgres.Song, error)
+	FinishProcessing(context.Context, postgres.FinishProcessingParams) (postgres.Song, error)
+	SetImageThumbnail(context.Context, postgres.SetImageThumbnailParams) error
+	FailStaleUploads(context.Context, postgres.FailStaleUploadsParams) ([]uuid.UUID, error)
+	SongObjectStatus(context.Context, pgtype.Text) (postgres.SongObjectStatusRow, error)
+	ImageObjectStatus(context.Context, pgtype.Text) (postgres.ImageObjectStatusRow, error)
+	ImageUsedByOthers(context.Context, postgres.ImageUsedByOthersParams) (bool, error)
+	SongPreviewStatus(context.Context, uuid.UUID) (postgres.SongPreviewStatusRow, error)
+	ArtistUsage(context.Context, uuid.UUID) (postgres.ArtistUsageRow, error)
+	ExpiredTrashedSongs(context.Context, postgres.ExpiredTrashedSongsParams) ([]postgres.Song, error)
+	DeleteSongs(context.Context, []uuid.UUID) error
 	Begin(context.Context) (SongRepo, error)
 	Commit(context.Context) error
 	Rollback(context.Context) error
 }
 
 type SoundDecoder interface {
-	GetMp3Duration(context.Context, io.Reader) (time.Duration, error)
+	GetMp3Info(context.Context, io.Reader) (audiodecoder.Mp3Info, error)
+	GetMp3Advisory(context.Context, io.Reader) (audiodecoder.Advisory, error)
+	GetMp3Waveform(ctx context.Context, r io.Reader, n int) ([]int, error)
+	CutMp3(ctx context.Context, r io.Reader, w io.Writer, clip audiodecoder.Clip) (time.Duration, error)
+}
+
+type Broker interface {
+	SendSongMessages(context.Context, []broker.SongMessage) error
+	SendPlayedMessages(context.Context, []broker.SongPlayedMessage) error
+}
+
+type UploadLimiter interface {
+	CountUpload(ctx context.Context, artistId uuid.UUID, window time.Duration) (int64, error)
+	CountImportUpload(ctx context.Context, artistId uuid.UUID, window time.Duration) (int64, error)
+	AcquireUploadSlot(ctx context.Context, artistId uuid.UUID, uploadId string, ttl time.Duration) (int64, error)
+	ReleaseUploadSlot(ctx context.Context, artistId uuid.UUID, uploadId string) error
+}
+
+// PlayCounter tells the first fetch of a song by a listener in a window apart from the repeated ones,
+// players fetch a song again when seeking or reconnecting.
+type PlayCounter interface {
+	CountPlay(ctx context.Context, listenerId string, songId uuid.UUID, window time.Duration) (bool, error)
+}
+
+// UrlSigner signs the links to objects for a listener, it is urlsign.Signer.
+type UrlSigner interface {
+	Sign(kind, objectId, listenerId string) string
+	Verify(kind, objectId string, query url.Values) (string, error)
+}
+
+// Thumbnailer makes the thumbnails of the hosted images, it is thumbnail.Maker.
+type Thumbnailer interface {
+	MakeThumbnail(ctx context.Context, r io.Reader, w io.Writer, size int) error
+}
+
+// JobQueue queues background jobs, it is jobs.Service.
+type JobQueue interface {
+	EnqueueJob(ctx context.Context, kind string, payload []byte, opts jobs.Options) (uuid.UUID, error)
 }
 
 type Dependencies struct {
 	ObjectStorage ObjectStorage
 	SongRepo      SongRepo
 	SoundDecoder  SoundDecoder
+	Broker        Broker
+	UploadLimiter UploadLimiter
+	PlayCounter   PlayCounter
+	UrlSigner     UrlSigner
+	JobQueue      JobQueue
+	Thumbnailer   Thumbnailer
 }
 
 type Config struct {
 	Dependencies
 	HostUsesTls bool
 	Host        string
+
+	// Limits are per artist, zero means unlimited
+	MaxBytes          int64
+	UploadsPerHour    int32
+	ConcurrentUploads int32
+	// Uploads of imports are counted apart, an import uploads the whole catalog at once
+	ImportUploadsPerHour int32
+
+	// Checks of the uploaded files, zero disables a check
+	MinDuration     time.Duration
+	MaxSkippedRatio float64
+
+	// Window of the preview, the start is a share of the song. Zero length disables previews.
+	PreviewStart  float64
+	PreviewLength time.Duration
+	PreviewFade   time.Duration
+
+	// Fetches of a song by a listener count as one play in this time, zero counts every fetch
+	PlayWindow time.Duration
+
+	// Processing not finished in this time is taken over, uploads not stored in this time fail
+	StaleAfter time.Duration
+	// Levels in the waveform of a song and the longer side of thumbnails, zero disables them
+	WaveformPoints int
+	ThumbnailSize  int
 }
 
 func New(deps Dependencies) *ServiceRaw {
-	conf := config.Get().Servers
+	conf := config.Get()
 
 	return NewWithConfig(Config{
-		Dependencies: deps,
-		HostUsesTls:  conf.Http.UseTls,
-		Host:         conf.Host,
+		Dependencies:         deps,
+		HostUsesTls:          conf.Servers.Http.UseTls,
+		Host:                 conf.Servers.Host,
+		MaxBytes:             conf.Features.Quotas.MaxBytes,
+		UploadsPerHour:       conf.Features.Quotas.UploadsPerHour,
+		ConcurrentUploads:    conf.Features.Quotas.ConcurrentUploads,
+		ImportUploadsPerHour: conf.Features.Quotas.ImportUploadsPerHour,
+		MinDuration:          conf.Features.Audio.MinDuration,
+		MaxSkippedRatio:      conf.Features.Audio.MaxSkippedRatio,
+		PreviewStart:         conf.Features.Previews.Start,
+		PreviewLength:        conf.Features.Previews.Length,
+		PreviewFade:          conf.Features.Previews.Fade,
+		PlayWindow:           conf.Features.Streams.PlayWindow,
+		StaleAfter:           conf.Features.Uploads.StaleAfter,
+		WaveformPoints:       conf.Features.Uploads.WaveformPoints,
+		ThumbnailSize:        conf.Features.Uploads.ThumbnailSize,
 	})
 }
 
@@ -69,11 +185,19 @@ func NewWithConfig(conf Config) *ServiceRaw {
 	}
 
 	return &ServiceRaw{
-		c:           conf,
-		storage:     conf.ObjectStorage,
-		repo:        conf.SongRepo,
-		decoder:     conf.SoundDecoder,
-		songUrlTpl:  fmt.Sprintf("%s://%s/songs/api/v1/song/raw/", schema, conf.Host),
-		imageUrlTpl: fmt.Sprintf("%s://%s/songs/api/v1/song/image/raw/", schema, conf.Host),
+		c:               conf,
+		storage:         conf.ObjectStorage,
+		repo:            conf.SongRepo,
+		decoder:         conf.SoundDecoder,
+		broker:          conf.Broker,
+		limiter:         conf.UploadLimiter,
+		plays:           conf.PlayCounter,
+		signer:          conf.UrlSigner,
+		jobs:            conf.JobQueue,
+		thumbnailer:     conf.Thumbnailer,
+		songUrlTpl:      fmt.Sprintf("%s://%s/songs/api/v1/song/raw/", schema, conf.Host),
+		imageUrlTpl:     fmt.Sprintf("%s://%s/songs/api/v1/song/image/raw/", schema, conf.Host),
+		previewUrlTpl:   fmt.Sprintf("%s://%s/songs/api/v1/song/%%s/preview", schema, conf.Host),
+		thumbnailUrlTpl: fmt.Sprintf("%s://%s/songs/api/v1/song/image/thumbnail/", schema, conf.Host),
 	}
 }
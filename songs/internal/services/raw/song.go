@@ -1,16 +1,22 @@
 package raw
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"io"
+	"net/url"
+	"time"
 
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/objects"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
-	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/s3minio"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/metrics"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/regions"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
 
 	"dev.gaijin.team/go/golib/e"
@@ -23,6 +29,15 @@ var (
 	ErrSongNotExists       = erix.NewStatus("song not exists", erix.CodeNotFound)
 	ErrSongAlreadyReleased = erix.NewStatus("not able to upload song, it is released", erix.CodePreconditionFailed)
 	ErrFileNotFound        = erix.NewStatus("file not found", erix.CodeNotFound)
+	ErrRegionRestricted    = erix.NewStatus("song is not available in your region", erix.CodeForbidden)
+	ErrNoAudioFrames       = erix.NewStatus("file has no mp3 frames", erix.CodeBadRequest)
+	ErrAudioCorrupted      = erix.NewStatus("file is corrupted, too much of it is not mp3 frames", erix.CodeBadRequest)
+	ErrSongTooShort        = erix.NewStatus("song is shorter than the minimum duration", erix.CodeBadRequest)
+	ErrAudioUnreadable     = erix.NewStatus("file could not be read as mp3", erix.CodeBadRequest)
+	ErrUploadFailed        = erix.NewStatus("upload failed, upload the file again", erix.CodeInternalServerError)
+	ErrUploadInterrupted   = erix.NewStatus("upload was interrupted, upload the file again", erix.CodeInternalServerError)
+	ErrSignInRequired      = erix.NewStatus("sign in to listen to the song, anonymous listeners get the preview",
+		erix.CodeUnauthorized)
 )
 
 type UploadRawSongInput struct {
@@ -31,10 +46,15 @@ type UploadRawSongInput struct {
 	Extension   string
 	WeightBytes int32
 	Content     io.Reader
+	// Imported songs count towards the hourly import upload rate instead of the upload one
+	Imported bool
 }
 
 type UploadRawSongOutput struct {
 	SongUrl string
+	// The file is processed in the background, see [ServiceRaw.ProcessNextUpload],
+	// files of imports are processed before the output
+	State postgres.AudioState
 }
 
 func (s *ServiceRaw) UploadRawSong(ctx context.Context, input UploadRawSongInput) (UploadRawSongOutput, error) {
@@ -47,6 +67,12 @@ func (s *ServiceRaw) UploadRawSong(ctx context.Context, input UploadRawSongInput
 		return null, ErrInvalidExtension
 	}
 
+	release, err := s.acquireUpload(ctx, input.ArtistId, input.Imported)
+	if err != nil {
+		return null, err
+	}
+	defer release()
+
 	log.Debug().
 		Stringer("song_id", input.SongId).Stringer("artist_id", input.ArtistId).
 		Msg("getting info about song")
@@ -67,70 +93,211 @@ func (s *ServiceRaw) UploadRawSong(ctx context.Context, input UploadRawSongInput
 		return null, e.NewFrom("getting song", err, fields.F("song_id", input.SongId))
 	}
 
-	log.Debug().Msg("teeing content into buffer")
-
-	contentBuf := &bytes.Buffer{}
-	content := io.TeeReader(input.Content, contentBuf)
-
-	dur, err := s.decoder.GetMp3Duration(ctx, content)
+	err = s.checkStorageQuota(ctx, songRow.Song, input.WeightBytes)
 	if err != nil {
-		return null, e.NewFrom("getting mp3 duration", err)
+		return null, err
 	}
 
-	log.Debug().Dur("song_duration", dur).Msg("got mp3 duration")
-
-	objectId := getObjectId(input.ArtistId, songRow.Song.Name, input.Extension)
+	objectId := getObjectId(input.ArtistId, songRow.Song.SongID, input.Extension)
 
 	log.Debug().Str("object_id", objectId).Msg("calculated object id")
 
-	txRepo, err := s.repo.Begin(ctx)
-	if err != nil {
-		return null, e.NewFrom("begin transaction", err)
-	}
-	defer txRepo.Rollback(ctx) //nolint:errcheck
-
-	song := postgres.PatchSongParams{ //nolint:exhaustruct
-		ID:           input.SongId,
-		S3ObjectName: pgconv.Text(objectId),
-		Duration:     pgconv.Interval(dur),
-		WeightBytes:  pgconv.Int4(input.WeightBytes),
-	}
-
-	patchedSong, err := txRepo.PatchSong(ctx, song)
+	_, err = s.repo.SetAudioState(ctx, postgres.SetAudioStateParams{
+		AudioState:          postgres.AudioStateUploading,
+		AudioError:          pgconv.NullText(),
+		ProcessingStartedAt: pgconv.NullTimestamptz(),
+		S3ObjectName:        pgconv.NullText(),
+		WeightBytes:         pgconv.NullInt4(),
+		SongID:              input.SongId,
+	})
 	if err != nil {
-		return null, e.NewFrom("patching song", err, fields.F("song_id", input.SongId))
+		return null, e.NewFrom("setting audio state", err, fields.F("song_id", input.SongId))
 	}
 
-	log.Debug().Object("songs_diff", songsDiff(songRow.Song, patchedSong)).Msg("patched song")
-
 	log.Debug().Msg("putting song object")
 
-	err = s.storage.PutSongObject(ctx, s3minio.SongObject{
+	putStart := time.Now()
+
+	err = s.storage.PutSongObject(ctx, objects.SongObject{
 		Id:          objectId,
-		Duration:    dur,
 		Extension:   input.Extension,
 		WeightBytes: input.WeightBytes,
-		Content:     contentBuf,
+		Content:     input.Content,
 	})
 	if err != nil {
+		s.failUpload(ctx, input.SongId, ErrUploadFailed)
 		return null, e.NewFrom("putting song object", err, fields.F("song_id", input.SongId))
 	}
 
-	err = txRepo.Commit(ctx)
+	metrics.ObserveUpload(int64(input.WeightBytes), time.Since(putStart))
+
+	// Imports release the songs right after the upload, so their files are processed in the request
+	startedAt := pgconv.NullTimestamptz()
+	if input.Imported {
+		startedAt = pgconv.Timestamptz(time.Now())
+	}
+
+	// The checks and the metadata of the file are up to the processing worker
+	song, err := s.repo.SetAudioState(ctx, postgres.SetAudioStateParams{
+		AudioState:          postgres.AudioStateProcessing,
+		AudioError:          pgconv.NullText(),
+		ProcessingStartedAt: startedAt,
+		S3ObjectName:        pgconv.Text(objectId),
+		WeightBytes:         pgconv.Int4(input.WeightBytes),
+		SongID:              input.SongId,
+	})
 	if err != nil {
-		return null, e.NewFrom("commit transaction", err)
+		s.failUpload(ctx, input.SongId, ErrUploadFailed)
+		return null, e.NewFrom("setting audio state", err, fields.F("song_id", input.SongId))
+	}
+
+	if input.Imported {
+		song, err = s.processUpload(ctx, song)
+		if err != nil {
+			return null, err
+		}
 	}
 
 	return UploadRawSongOutput{
-		SongUrl: s.SongUrl(objectId),
+		SongUrl: s.SongUrl(objectId, input.ArtistId.String()),
+		State:   song.AudioState,
 	}, nil
 }
 
-func (s *ServiceRaw) GetRawSong(ctx context.Context, songId string) (io.Reader, error) {
-	reader, err := s.storage.GetSongObject(ctx, songId)
+// failUpload fails the song with the reason, even if the request is gone.
+// If it fails too, the upload fails as stale later.
+func (s *ServiceRaw) failUpload(ctx context.Context, songId uuid.UUID, reason error) {
+	log := logger.FromContext(ctx)
+
+	_, err := s.repo.SetAudioState(context.WithoutCancel(ctx), postgres.SetAudioStateParams{
+		AudioState:          postgres.AudioStateFailed,
+		AudioError:          pgconv.Text(erix.LastReason(reason)),
+		ProcessingStartedAt: pgconv.NullTimestamptz(),
+		S3ObjectName:        pgconv.NullText(),
+		WeightBytes:         pgconv.NullInt4(),
+		SongID:              songId,
+	})
+	if err != nil {
+		log.Warn().Err(err).Stringer("song_id", songId).Msg("error failing upload")
+	}
+}
+
+// checkAudio rejects files that are not playable songs: without frames, mostly garbage or too short.
+func (s *ServiceRaw) checkAudio(info audiodecoder.Mp3Info) error {
+	fileBytes := info.AudioBytes + info.SkippedBytes
+
+	switch {
+	case info.Frames == 0:
+		return ErrNoAudioFrames
+
+	case s.c.MaxSkippedRatio > 0 && float64(info.SkippedBytes) > s.c.MaxSkippedRatio*float64(fileBytes):
+		return ErrAudioCorrupted
+
+	case info.Duration < s.c.MinDuration:
+		return ErrSongTooShort
+	}
+
+	return nil
+}
+
+type GetRawSongInput struct {
+	ObjectId string
+	// Query of the signed link, see [ServiceRaw.SongUrl]
+	Signature url.Values
+	// Country of the listener, see [regions.Available]
+	Country string
+	// User id or a hash of the address of an anonymous listener,
+	// the listener the link was given to takes precedence
+	ListenerId string
+	// The request carries a token, anonymous links need it
+	SignedIn bool
+}
+
+// GetRawSong returns the song object if the link is signed and not expired. Full songs need a token:
+// the link must be given to a signed in listener or the request must carry one.
+// Taken down songs are not found, neither are unreleased songs unless the link was given to their singer.
+// Songs restricted in the country are refused, see [regions.Available].
+// A returned object counts as a play of the song unless the listener played it within the play window.
+func (s *ServiceRaw) GetRawSong(ctx context.Context, in GetRawSongInput) (io.Reader, error) {
+	log := logger.FromContext(ctx)
+
+	linkListenerId, err := s.verifyLink(linkKindSong, in.ObjectId, in.Signature)
 	if err != nil {
-		return nil, e.NewFrom("getting song object", err, fields.F("song_id", songId))
+		return nil, err
+	}
+
+	switch {
+	case linkListenerId != "":
+		in.ListenerId = linkListenerId
+
+	case !in.SignedIn:
+		return nil, ErrSignInRequired
+	}
+
+	status, err := s.repo.SongObjectStatus(ctx, pgconv.Text(in.ObjectId))
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return nil, ErrFileNotFound
+
+	case err != nil:
+		return nil, e.NewFrom("getting song status", err, fields.F("song_id", in.ObjectId))
+
+	case status.ModerationStatus == postgres.ModerationStatusTakenDown:
+		log.Debug().Str("song_id", in.ObjectId).Msg("song is taken down")
+		return nil, ErrFileNotFound
+
+	case hiddenFrom(linkListenerId, status.SingerFk, status.ReleasedAt):
+		log.Debug().Str("song_id", in.ObjectId).Msg("song is not released")
+		return nil, ErrFileNotFound
+
+	case !regions.Available(status.AllowedCountries, status.DeniedCountries, in.Country):
+		log.Debug().Str("song_id", in.ObjectId).Str("country", in.Country).Msg("song is restricted in the region")
+		return nil, ErrRegionRestricted
+	}
+
+	reader, err := s.storage.GetSongObject(ctx, in.ObjectId)
+
+	switch {
+	case errors.Is(err, objstore.ErrNotFound):
+		return nil, ErrFileNotFound.Wrap(err, fields.F("song_id", in.ObjectId))
+
+	case err != nil:
+		return nil, e.NewFrom("getting song object", err, fields.F("song_id", in.ObjectId))
+	}
+
+	if !s.firstPlay(ctx, in.ListenerId, status.SongID) {
+		log.Debug().Stringer("song_id", status.SongID).Msg("song was played within the window")
+		return reader, nil
+	}
+
+	err = s.broker.SendPlayedMessages(ctx, []broker.SongPlayedMessage{{
+		SongId:     status.SongID,
+		ArtistId:   status.SingerFk,
+		ListenerId: in.ListenerId,
+		PlayedAt:   time.Now(),
+	}})
+	if err != nil {
+		log.Warn().Err(err).Stringer("song_id", status.SongID).Msg("error sending played message")
 	}
 
 	return reader, nil
 }
+
+// firstPlay tells whether the fetch is the first one of the song by the listener in the play window.
+// Plays are counted if the counter fails, a lost play is worse than a repeated one.
+func (s *ServiceRaw) firstPlay(ctx context.Context, listenerId string, songId uuid.UUID) bool {
+	if s.c.PlayWindow == 0 || listenerId == "" {
+		return true
+	}
+
+	log := logger.FromContext(ctx)
+
+	first, err := s.plays.CountPlay(ctx, listenerId, songId, s.c.PlayWindow)
+	if err != nil {
+		log.Warn().Err(err).Stringer("song_id", songId).Msg("error counting play")
+		return true
+	}
+
+	return first
+}
@@ -2,16 +2,23 @@ package raw_test
 
 import (
 	"context"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
 
 	rawmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/raw"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/urlsign"
 
+	"github.com/brianvoe/gofakeit/v7"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -22,6 +29,7 @@ type UploadRawSongSuite struct {
 
 	om *rawmocks.ObjectStorage
 	sm *rawmocks.SongRepo
+	bm *rawmocks.Broker
 	dm *rawmocks.SoundDecoder
 
 	s     *raw.ServiceRaw
@@ -32,16 +40,21 @@ type UploadRawSongSuite struct {
 func (s *UploadRawSongSuite) SetupTest() {
 	s.om = rawmocks.NewObjectStorage(s.T())
 	s.sm = rawmocks.NewSongRepo(s.T())
+	s.bm = rawmocks.NewBroker(s.T())
 	s.dm = rawmocks.NewSoundDecoder(s.T())
 
 	s.s = raw.NewWithConfig(raw.Config{
 		Dependencies: raw.Dependencies{
 			ObjectStorage: s.om,
 			SongRepo:      s.sm,
+			Broker:        s.bm,
 			SoundDecoder:  s.dm,
+			UrlSigner:     newSigner(),
 		},
-		HostUsesTls: true,
-		Host:        gofakeit.DomainName(),
+		HostUsesTls:     true,
+		Host:            gofakeit.DomainName(),
+		MinDuration:     time.Second,
+		MaxSkippedRatio: 0.1,
 	})
 
 	s.ctx = context.Background()
@@ -50,15 +63,75 @@ func (s *UploadRawSongSuite) SetupTest() {
 
 func (s *UploadRawSongSuite) TestHappyPath() {
 	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(validMySongRow(s.input.SongId), nil).Once()
-	s.dm.EXPECT().GetMp3Duration(mock.Anything, mock.Anything).Return(time.Minute, nil).Once()
-	s.sm.EXPECT().Begin(mock.Anything).Return(s.sm, nil).Once()
-	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(postgres.Song(validMySongRow(s.input.SongId).Song), nil).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.MatchedBy(func(p postgres.SetAudioStateParams) bool {
+		return p.AudioState == postgres.AudioStateUploading && p.SongID == s.input.SongId && !p.S3ObjectName.Valid
+	})).Return(postgres.Song{}, nil).Once()
+	s.om.EXPECT().PutSongObject(mock.Anything, mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.MatchedBy(func(p postgres.SetAudioStateParams) bool {
+		return p.AudioState == postgres.AudioStateProcessing && p.S3ObjectName.Valid &&
+			p.WeightBytes.Int32 == s.input.WeightBytes && !p.ProcessingStartedAt.Valid
+	})).Return(postgres.Song{AudioState: postgres.AudioStateProcessing}, nil).Once()
+
+	out, err := s.s.UploadRawSong(s.ctx, s.input)
+	s.Require().NoError(err)
+	s.Equal(postgres.AudioStateProcessing, out.State)
+	s.NotEmpty(out.SongUrl)
+}
+
+func (s *UploadRawSongSuite) TestImportedProcessedRightAway() {
+	s.input.Imported = true
+
+	processing := validMySongRow(s.input.SongId).Song
+	processing.AudioState = postgres.AudioStateProcessing
+	processing.ProcessingStartedAt = pgconv.Timestamptz(time.Now())
+
+	ready := processing
+	ready.AudioState = postgres.AudioStateReady
+
+	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(validMySongRow(s.input.SongId), nil).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.MatchedBy(func(p postgres.SetAudioStateParams) bool {
+		return p.AudioState == postgres.AudioStateUploading
+	})).Return(postgres.Song{}, nil).Once()
+	s.om.EXPECT().PutSongObject(mock.Anything, mock.Anything).Return(nil).Once()
+	// Workers don't take started songs
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.MatchedBy(func(p postgres.SetAudioStateParams) bool {
+		return p.AudioState == postgres.AudioStateProcessing && p.ProcessingStartedAt.Valid
+	})).Return(processing, nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).
+		Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+	s.dm.EXPECT().GetMp3Info(mock.Anything, mock.Anything).Return(validMp3Info(), nil).Once()
+	s.dm.EXPECT().GetMp3Advisory(mock.Anything, mock.Anything).Return(audiodecoder.AdvisoryUnknown, nil).Once()
+	s.sm.EXPECT().FinishProcessing(mock.Anything, mock.MatchedBy(func(p postgres.FinishProcessingParams) bool {
+		return p.StartedAt == processing.ProcessingStartedAt
+	})).Return(ready, nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	out, err := s.s.UploadRawSong(s.ctx, s.input)
+	s.Require().NoError(err)
+	s.Equal(postgres.AudioStateReady, out.State)
+}
+
+func (s *UploadRawSongSuite) TestImportedFailedChecks() {
+	s.input.Imported = true
+
+	info := validMp3Info()
+	info.Duration = 500 * time.Millisecond
+
+	failed := validMySongRow(s.input.SongId).Song
+	failed.AudioState = postgres.AudioStateFailed
+
+	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(validMySongRow(s.input.SongId), nil).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.Anything).Return(validMySongRow(s.input.SongId).Song, nil).Twice()
 	s.om.EXPECT().PutSongObject(mock.Anything, mock.Anything).Return(nil).Once()
-	s.sm.EXPECT().Commit(mock.Anything).Return(nil).Once()
-	s.sm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).
+		Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+	s.dm.EXPECT().GetMp3Info(mock.Anything, mock.Anything).Return(info, nil).Once()
+	s.sm.EXPECT().FinishProcessing(mock.Anything, mock.MatchedBy(func(p postgres.FinishProcessingParams) bool {
+		return p.AudioState == postgres.AudioStateFailed
+	})).Return(failed, nil).Once()
 
 	_, err := s.s.UploadRawSong(s.ctx, s.input)
-	s.NoError(err)
+	s.ErrorIs(err, raw.ErrSongTooShort)
 }
 
 func (s *UploadRawSongSuite) TestExtensionNotMp3() {
@@ -94,56 +167,40 @@ func (s *UploadRawSongSuite) TestReleasedSong() {
 	s.ErrorIs(err, raw.ErrSongAlreadyReleased)
 }
 
-func (s *UploadRawSongSuite) TestMp3DurationError() {
-	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(validMySongRow(s.input.SongId), nil).Once()
-	s.dm.EXPECT().GetMp3Duration(mock.Anything, mock.Anything).Return(0, gofakeit.Error()).Once()
-
-	_, err := s.s.UploadRawSong(s.ctx, s.input)
-	s.Error(err)
-}
-
-func (s *UploadRawSongSuite) TestBeginError() {
-	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(validMySongRow(s.input.SongId), nil).Once()
-	s.dm.EXPECT().GetMp3Duration(mock.Anything, mock.Anything).Return(time.Minute, nil).Once()
-	s.sm.EXPECT().Begin(mock.Anything).Return(nil, gofakeit.Error()).Once()
-
-	_, err := s.s.UploadRawSong(s.ctx, s.input)
-	s.Error(err)
-}
-
-func (s *UploadRawSongSuite) TestPatchSongError() {
+func (s *UploadRawSongSuite) TestSetAudioStateError() {
 	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(validMySongRow(s.input.SongId), nil).Once()
-	s.dm.EXPECT().GetMp3Duration(mock.Anything, mock.Anything).Return(time.Minute, nil).Once()
-	s.sm.EXPECT().Begin(mock.Anything).Return(s.sm, nil).Once()
-	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(postgres.Song{}, gofakeit.ErrorDatabase()).Once()
-	s.sm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.Anything).Return(postgres.Song{}, gofakeit.ErrorDatabase()).Once()
 
 	_, err := s.s.UploadRawSong(s.ctx, s.input)
 	s.Error(err)
 }
 
 func (s *UploadRawSongSuite) TestPutSongObjectError() {
-	row := validMySongRow(s.input.SongId)
-	row.Song.ReleasedAt = pgconv.NullTimestamptz()
-	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(row, nil).Once()
-	s.dm.EXPECT().GetMp3Duration(mock.Anything, mock.Anything).Return(time.Minute, nil).Once()
-	s.sm.EXPECT().Begin(mock.Anything).Return(s.sm, nil).Once()
-	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(postgres.Song(validMySongRow(s.input.SongId).Song), nil).Once()
+	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(validMySongRow(s.input.SongId), nil).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.MatchedBy(func(p postgres.SetAudioStateParams) bool {
+		return p.AudioState == postgres.AudioStateUploading
+	})).Return(postgres.Song{}, nil).Once()
 	s.om.EXPECT().PutSongObject(mock.Anything, mock.Anything).Return(gofakeit.ErrorDatabase()).Once()
-	s.sm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.MatchedBy(func(p postgres.SetAudioStateParams) bool {
+		return p.AudioState == postgres.AudioStateFailed && p.AudioError.String == erix.LastReason(raw.ErrUploadFailed)
+	})).Return(postgres.Song{}, nil).Once()
 
 	_, err := s.s.UploadRawSong(s.ctx, s.input)
 	s.Error(err)
 }
 
-func (s *UploadRawSongSuite) TestCommitError() {
+func (s *UploadRawSongSuite) TestProcessingStateError() {
 	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(validMySongRow(s.input.SongId), nil).Once()
-	s.dm.EXPECT().GetMp3Duration(mock.Anything, mock.Anything).Return(time.Minute, nil).Once()
-	s.sm.EXPECT().Begin(mock.Anything).Return(s.sm, nil).Once()
-	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(postgres.Song(validMySongRow(s.input.SongId).Song), nil).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.MatchedBy(func(p postgres.SetAudioStateParams) bool {
+		return p.AudioState == postgres.AudioStateUploading
+	})).Return(postgres.Song{}, nil).Once()
 	s.om.EXPECT().PutSongObject(mock.Anything, mock.Anything).Return(nil).Once()
-	s.sm.EXPECT().Commit(mock.Anything).Return(gofakeit.Error()).Once()
-	s.sm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.MatchedBy(func(p postgres.SetAudioStateParams) bool {
+		return p.AudioState == postgres.AudioStateProcessing
+	})).Return(postgres.Song{}, gofakeit.ErrorDatabase()).Once()
+	s.sm.EXPECT().SetAudioState(mock.Anything, mock.MatchedBy(func(p postgres.SetAudioStateParams) bool {
+		return p.AudioState == postgres.AudioStateFailed
+	})).Return(postgres.Song{}, nil).Once()
 
 	_, err := s.s.UploadRawSong(s.ctx, s.input)
 	s.Error(err)
@@ -160,6 +217,21 @@ func validUploadRawSongInput() raw.UploadRawSongInput {
 		Extension:   "mp3",
 		WeightBytes: int32(gofakeit.IntRange(1024, 1024*1024*1024)),
 		Content:     strings.NewReader(gofakeit.LoremIpsumSentence(10)),
+		Imported:    false,
+	}
+}
+
+func validMp3Info() audiodecoder.Mp3Info {
+	return audiodecoder.Mp3Info{
+		Duration:     time.Minute,
+		Frames:       2297,
+		Bitrate:      128000,
+		BitrateMode:  audiodecoder.BitrateConstant,
+		SampleRate:   44100,
+		ChannelMode:  audiodecoder.ChannelJointStereo,
+		Encoder:      "LAME3.100",
+		AudioBytes:   960000,
+		SkippedBytes: 128,
 	}
 }
 
@@ -182,16 +254,25 @@ func validMySongRow(id uuid.UUID) postgres.MySongRow {
 type GetRawSongSuite struct {
 	suite.Suite
 
-	om  *rawmocks.ObjectStorage
-	s   *raw.ServiceRaw
-	ctx context.Context
+	om     *rawmocks.ObjectStorage
+	sm     *rawmocks.SongRepo
+	bm     *rawmocks.Broker
+	signer *urlsign.Signer
+	s      *raw.ServiceRaw
+	ctx    context.Context
 }
 
 func (s *GetRawSongSuite) SetupTest() {
 	s.om = rawmocks.NewObjectStorage(s.T())
+	s.sm = rawmocks.NewSongRepo(s.T())
+	s.bm = rawmocks.NewBroker(s.T())
+	s.signer = newSigner()
 	s.s = raw.NewWithConfig(raw.Config{
 		Dependencies: raw.Dependencies{
 			ObjectStorage: s.om,
+			SongRepo:      s.sm,
+			Broker:        s.bm,
+			UrlSigner:     s.signer,
 		},
 		HostUsesTls: true,
 		Host:        gofakeit.DomainName(),
@@ -200,28 +281,292 @@ func (s *GetRawSongSuite) SetupTest() {
 }
 
 func (s *GetRawSongSuite) TestHappyPath() {
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(activeStatus(), nil).Once()
 	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+	s.bm.EXPECT().SendPlayedMessages(mock.Anything, mock.Anything).Return(nil).Once()
 
-	reader, err := s.s.GetRawSong(s.ctx, gofakeit.UUID())
+	reader, err := s.s.GetRawSong(s.ctx, s.input("", ""))
 	s.NoError(err)
 	s.NotNil(reader)
 }
 
 func (s *GetRawSongSuite) TestError() {
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(activeStatus(), nil).Once()
 	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(nil, gofakeit.Error()).Once()
 
-	_, err := s.s.GetRawSong(s.ctx, uuid.NewString())
+	_, err := s.s.GetRawSong(s.ctx, s.input("", ""))
 	s.Error(err)
 }
 
+func (s *GetRawSongSuite) TestObjectNotFound() {
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(activeStatus(), nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(nil, objstore.ErrNotFound).Once()
+
+	_, err := s.s.GetRawSong(s.ctx, s.input("", ""))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
 func (s *GetRawSongSuite) TestNilReader() {
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(activeStatus(), nil).Once()
 	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.bm.EXPECT().SendPlayedMessages(mock.Anything, mock.Anything).Return(nil).Once()
 
-	reader, err := s.s.GetRawSong(s.ctx, uuid.NewString())
+	reader, err := s.s.GetRawSong(s.ctx, s.input("", ""))
 	s.NoError(err)
 	s.Nil(reader)
 }
 
+func (s *GetRawSongSuite) TestTakenDown() {
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(postgres.SongObjectStatusRow{ModerationStatus: postgres.ModerationStatusTakenDown}, nil).Once()
+
+	_, err := s.s.GetRawSong(s.ctx, s.input("", ""))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *GetRawSongSuite) TestSongObjectStatus_EmptyResult() {
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(postgres.SongObjectStatusRow{}, repoerrs.ErrEmptyResult).Once()
+
+	_, err := s.s.GetRawSong(s.ctx, s.input("", ""))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *GetRawSongSuite) TestRegionRestricted() {
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(postgres.SongObjectStatusRow{
+		ModerationStatus: postgres.ModerationStatusActive,
+		ReleasedAt:       pgconv.Timestamptz(time.Now()),
+		AllowedCountries: []string{"DE"},
+		DeniedCountries:  []string{},
+	}, nil).Once()
+
+	_, err := s.s.GetRawSong(s.ctx, s.input("US", ""))
+	s.ErrorIs(err, raw.ErrRegionRestricted)
+}
+
+func (s *GetRawSongSuite) TestRegionAllowed() {
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(postgres.SongObjectStatusRow{
+		ModerationStatus: postgres.ModerationStatusActive,
+		ReleasedAt:       pgconv.Timestamptz(time.Now()),
+		AllowedCountries: []string{"DE"},
+		DeniedCountries:  []string{"US"},
+	}, nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+	s.bm.EXPECT().SendPlayedMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	reader, err := s.s.GetRawSong(s.ctx, s.input("DE", ""))
+	s.NoError(err)
+	s.NotNil(reader)
+}
+
+func (s *GetRawSongSuite) TestPlayedMessage() {
+	songId, artistId := uuid.New(), uuid.New()
+
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(postgres.SongObjectStatusRow{
+		SongID:           songId,
+		SingerFk:         artistId,
+		ModerationStatus: postgres.ModerationStatusActive,
+		ReleasedAt:       pgconv.Timestamptz(time.Now()),
+	}, nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+	s.bm.EXPECT().SendPlayedMessages(mock.Anything, mock.MatchedBy(func(messages []broker.SongPlayedMessage) bool {
+		return len(messages) == 1 &&
+			messages[0].SongId == songId &&
+			messages[0].ArtistId == artistId &&
+			messages[0].ListenerId == "listener"
+	})).Return(nil).Once()
+
+	_, err := s.s.GetRawSong(s.ctx, s.input("", "listener"))
+	s.NoError(err)
+}
+
+func (s *GetRawSongSuite) TestPlayedMessageError() {
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(activeStatus(), nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+	s.bm.EXPECT().SendPlayedMessages(mock.Anything, mock.Anything).Return(gofakeit.Error()).Once()
+
+	reader, err := s.s.GetRawSong(s.ctx, s.input("", ""))
+	s.NoError(err)
+	s.NotNil(reader)
+}
+
+// withPlayWindow counts the fetches of a song by a listener in 30 minutes as one play.
+func (s *GetRawSongSuite) withPlayWindow() *rawmocks.PlayCounter {
+	pm := rawmocks.NewPlayCounter(s.T())
+
+	s.s = raw.NewWithConfig(raw.Config{
+		Dependencies: raw.Dependencies{
+			ObjectStorage: s.om,
+			SongRepo:      s.sm,
+			Broker:        s.bm,
+			UrlSigner:     s.signer,
+			PlayCounter:   pm,
+		},
+		HostUsesTls: true,
+		Host:        gofakeit.DomainName(),
+		PlayWindow:  30 * time.Minute,
+	})
+
+	return pm
+}
+
+func (s *GetRawSongSuite) TestPlayedOncePerWindow() {
+	pm := s.withPlayWindow()
+	status := activeStatus()
+	status.SongID = uuid.New()
+	played := make(map[string]bool)
+
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(status, nil).Times(3)
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Times(3)
+	pm.EXPECT().CountPlay(mock.Anything, mock.Anything, status.SongID, 30*time.Minute).RunAndReturn(
+		func(_ context.Context, listenerId string, songId uuid.UUID, _ time.Duration) (bool, error) {
+			key := listenerId + ":" + songId.String()
+			first := !played[key]
+			played[key] = true
+
+			return first, nil
+		}).Times(3)
+	s.bm.EXPECT().SendPlayedMessages(mock.Anything, mock.MatchedBy(func(messages []broker.SongPlayedMessage) bool {
+		return len(messages) == 1 && messages[0].ListenerId == "listener"
+	})).Return(nil).Once()
+	s.bm.EXPECT().SendPlayedMessages(mock.Anything, mock.MatchedBy(func(messages []broker.SongPlayedMessage) bool {
+		return len(messages) == 1 && messages[0].ListenerId == "other"
+	})).Return(nil).Once()
+
+	// The player fetches the song again, another listener plays it too
+	for _, listenerId := range []string{"listener", "listener", "other"} {
+		reader, err := s.s.GetRawSong(s.ctx, s.input("", listenerId))
+		s.NoError(err)
+		s.NotNil(reader)
+	}
+}
+
+func (s *GetRawSongSuite) TestPlayCounterError() {
+	// The play is counted, a lost one is worse than a repeated one
+	pm := s.withPlayWindow()
+
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(activeStatus(), nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+	pm.EXPECT().CountPlay(mock.Anything, "listener", mock.Anything, mock.Anything).Return(false, gofakeit.Error()).Once()
+	s.bm.EXPECT().SendPlayedMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.GetRawSong(s.ctx, s.input("", "listener"))
+	s.NoError(err)
+}
+
+func (s *GetRawSongSuite) TestInvalidLink() {
+	in := s.input("", "")
+	in.ObjectId = uuid.NewString()
+
+	_, err := s.s.GetRawSong(s.ctx, in)
+	s.ErrorIs(err, raw.ErrInvalidLink)
+
+	_, err = s.s.GetRawSong(s.ctx, raw.GetRawSongInput{ObjectId: uuid.NewString(), Country: "", ListenerId: ""})
+	s.ErrorIs(err, raw.ErrInvalidLink)
+}
+
+func (s *GetRawSongSuite) TestExpiredLink() {
+	objectId := uuid.NewString()
+	query := s.signer.SignUntil("song", objectId, "", time.Now().Add(-time.Minute))
+
+	_, err := s.s.GetRawSong(s.ctx, raw.GetRawSongInput{
+		ObjectId:   objectId,
+		Signature:  parseQuery(s.T(), query),
+		Country:    "",
+		ListenerId: "",
+	})
+	s.ErrorIs(err, raw.ErrLinkExpired)
+}
+
+func (s *GetRawSongSuite) TestSignInRequired() {
+	in := s.input("", "anon:hash")
+	in.SignedIn = false
+
+	_, err := s.s.GetRawSong(s.ctx, in)
+	s.ErrorIs(err, raw.ErrSignInRequired)
+}
+
+func (s *GetRawSongSuite) TestUnreleasedHidden() {
+	singerId := uuid.New()
+
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(postgres.SongObjectStatusRow{
+		SingerFk:         singerId,
+		ModerationStatus: postgres.ModerationStatusActive,
+		ReleasedAt:       pgconv.NullTimestamptz(),
+	}, nil).Twice()
+
+	_, err := s.s.GetRawSong(s.ctx, s.input("", ""))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+
+	// Only the signed listener counts, not the one given by the caller
+	_, err = s.s.GetRawSong(s.ctx, s.input("", singerId.String()))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *GetRawSongSuite) TestUnreleasedOwner() {
+	singerId := uuid.New()
+
+	s.sm.EXPECT().SongObjectStatus(mock.Anything, mock.Anything).Return(postgres.SongObjectStatusRow{
+		SingerFk:         singerId,
+		ModerationStatus: postgres.ModerationStatusActive,
+		ReleasedAt:       pgconv.NullTimestamptz(),
+	}, nil).Once()
+	s.om.EXPECT().GetSongObject(mock.Anything, mock.Anything).Return(strings.NewReader(gofakeit.LoremIpsumSentence(10)), nil).Once()
+	s.bm.EXPECT().SendPlayedMessages(mock.Anything, mock.MatchedBy(func(messages []broker.SongPlayedMessage) bool {
+		return len(messages) == 1 && messages[0].ListenerId == singerId.String()
+	})).Return(nil).Once()
+
+	objectId := uuid.NewString()
+	query := s.signer.Sign("song", objectId, singerId.String())
+
+	reader, err := s.s.GetRawSong(s.ctx, raw.GetRawSongInput{
+		ObjectId:   objectId,
+		Signature:  parseQuery(s.T(), query),
+		Country:    "",
+		ListenerId: "anon:hash",
+	})
+	s.NoError(err)
+	s.NotNil(reader)
+}
+
+// input returns the input of a signed in listener with an anonymous link to a new object.
+func (s *GetRawSongSuite) input(country, listenerId string) raw.GetRawSongInput {
+	objectId := uuid.NewString()
+
+	return raw.GetRawSongInput{
+		ObjectId:   objectId,
+		Signature:  parseQuery(s.T(), s.signer.Sign("song", objectId, "")),
+		Country:    country,
+		ListenerId: listenerId,
+		SignedIn:   true,
+	}
+}
+
 func TestGetRawSong(t *testing.T) {
 	suite.Run(t, new(GetRawSongSuite))
 }
+
+func activeStatus() postgres.SongObjectStatusRow {
+	return postgres.SongObjectStatusRow{
+		ModerationStatus: postgres.ModerationStatusActive,
+		ReleasedAt:       pgconv.Timestamptz(time.Now()),
+	}
+}
+
+func newSigner() *urlsign.Signer {
+	signer, err := urlsign.New("0123456789abcdef0123456789abcdef", time.Hour)
+	if err != nil {
+		panic(err)
+	}
+
+	return signer
+}
+
+func parseQuery(t *testing.T, query string) url.Values {
+	t.Helper()
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return values
+}
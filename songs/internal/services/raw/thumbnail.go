@@ -0,0 +1,157 @@
+package raw
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"io"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+)
+
+// ThumbnailUrl is the unsigned url of the thumbnail of the hosted image, it is stored with the songs having the image.
+func (s *ServiceRaw) ThumbnailUrl(rawImageId string) string {
+	return s.thumbnailUrlTpl + rawImageId
+}
+
+// SignThumbnailUrl returns the link to the thumbnail of the image of the song for the listener.
+// It is empty if the thumbnail was made for an image the song no longer has.
+func (s *ServiceRaw) SignThumbnailUrl(thumbnailUrl, imageUrl, listenerId string) string {
+	imageId, ok := s.ImageObjectId(imageUrl)
+	if !ok || thumbnailUrl != s.ThumbnailUrl(imageId) {
+		return ""
+	}
+
+	return thumbnailUrl + "?" + s.signer.Sign(linkKindThumbnail, imageId, listenerId)
+}
+
+// ThumbnailJob makes the thumbnail of an uploaded image, see [ServiceRaw.HandleThumbnailJob].
+const ThumbnailJob jobs.Kind[ThumbnailJobArgs] = "make_thumbnail"
+
+type ThumbnailJobArgs struct {
+	ImageId string `json:"imageId"`
+}
+
+// EnqueueThumbnail queues the thumbnail of the image object. Images are uploaded again under the same id,
+// so every upload queues its own job and the last one leaves the thumbnail of the last image.
+func EnqueueThumbnail(ctx context.Context, q jobs.Queue, imageId string) error {
+	_, err := jobs.Enqueue(ctx, q, ThumbnailJob, ThumbnailJobArgs{
+		ImageId: imageId,
+	}, jobs.Options{}) //nolint:exhaustruct
+	if err != nil {
+		return e.NewFrom("queueing thumbnail", err, fields.F("image_id", imageId))
+	}
+
+	return nil
+}
+
+// HandleThumbnailJob makes the thumbnail of the job and sets it on the songs having the image.
+// Images that are gone or are not images are not retried.
+func (s *ServiceRaw) HandleThumbnailJob(ctx context.Context, args ThumbnailJobArgs) error {
+	thumbnailUrl, err := s.MakeThumbnail(ctx, args.ImageId)
+
+	switch {
+	case errors.Is(err, objstore.ErrNotFound), errors.Is(err, image.ErrFormat):
+		return jobs.ErrPermanent.Wrap(err)
+
+	case err != nil:
+		return err
+	}
+
+	if thumbnailUrl == "" {
+		return nil
+	}
+
+	err = s.repo.SetImageThumbnail(ctx, postgres.SetImageThumbnailParams{
+		ThumbnailUrl: pgconv.Text(thumbnailUrl),
+		ImageUrl:     pgconv.Text(s.ImageUrl(args.ImageId)),
+	})
+	if err != nil {
+		return e.NewFrom("setting thumbnail", err, fields.F("image_id", args.ImageId))
+	}
+
+	return nil
+}
+
+// MakeThumbnail makes the thumbnail of the image object and stores it with the same id.
+// It returns the unsigned url of the thumbnail, empty if thumbnails are disabled.
+func (s *ServiceRaw) MakeThumbnail(ctx context.Context, imageId string) (string, error) {
+	if s.c.ThumbnailSize == 0 {
+		return "", nil
+	}
+
+	log := logger.FromContext(ctx)
+
+	reader, err := s.storage.GetImageObject(ctx, imageId)
+	if err != nil {
+		return "", e.NewFrom("getting image object", err, fields.F("image_id", imageId))
+	}
+
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	thumbnail := &bytes.Buffer{}
+
+	err = s.thumbnailer.MakeThumbnail(ctx, reader, thumbnail, s.c.ThumbnailSize)
+	if err != nil {
+		return "", e.NewFrom("making thumbnail", err, fields.F("image_id", imageId))
+	}
+
+	log.Debug().
+		Str("image_id", imageId).Int("size", thumbnail.Len()).
+		Msg("made thumbnail")
+
+	err = s.storage.PutThumbnailObject(ctx, imageId, thumbnail, int64(thumbnail.Len()))
+	if err != nil {
+		return "", e.NewFrom("putting thumbnail object", err, fields.F("image_id", imageId))
+	}
+
+	return s.ThumbnailUrl(imageId), nil
+}
+
+// GetRawSongThumbnail returns the thumbnail of the image if the link is signed and not expired.
+// It is hidden like the image, see [ServiceRaw.GetRawSongImage].
+func (s *ServiceRaw) GetRawSongThumbnail(ctx context.Context, in GetRawSongImageInput) (io.Reader, error) {
+	log := logger.FromContext(ctx)
+
+	listenerId, err := s.verifyLink(linkKindThumbnail, in.ObjectId, in.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.repo.ImageObjectStatus(ctx, pgconv.Text(s.ImageUrl(in.ObjectId)))
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return nil, ErrFileNotFound
+
+	case err != nil:
+		return nil, e.NewFrom("getting image status", err, fields.F("image_id", in.ObjectId))
+
+	case imageHiddenFrom(listenerId, status):
+		log.Debug().Str("image_id", in.ObjectId).Msg("songs of the image are not released")
+		return nil, ErrFileNotFound
+	}
+
+	reader, err := s.storage.GetThumbnailObject(ctx, in.ObjectId)
+
+	switch {
+	case errors.Is(err, objstore.ErrNotFound):
+		return nil, ErrFileNotFound.Wrap(err, fields.F("image_id", in.ObjectId))
+
+	case err != nil:
+		return nil, e.NewFrom("getting thumbnail object", err, fields.F("image_id", in.ObjectId))
+	}
+
+	return reader, nil
+}
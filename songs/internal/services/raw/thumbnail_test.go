@@ -0,0 +1,188 @@
+package raw_test
+
+import (
+	"context"
+	"image"
+	"io"
+	"strings"
+	"testing"
+
+	rawmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/urlsign"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ThumbnailSuite struct {
+	suite.Suite
+
+	om     *rawmocks.ObjectStorage
+	sm     *rawmocks.SongRepo
+	bm     *rawmocks.Broker
+	jm     *rawmocks.JobQueue
+	tm     *rawmocks.Thumbnailer
+	signer *urlsign.Signer
+
+	ctx context.Context
+}
+
+func (s *ThumbnailSuite) SetupTest() {
+	s.om = rawmocks.NewObjectStorage(s.T())
+	s.sm = rawmocks.NewSongRepo(s.T())
+	s.bm = rawmocks.NewBroker(s.T())
+	s.jm = rawmocks.NewJobQueue(s.T())
+	s.tm = rawmocks.NewThumbnailer(s.T())
+	s.signer = newSigner()
+	s.ctx = context.Background()
+}
+
+func (s *ThumbnailSuite) service(size int) *raw.ServiceRaw {
+	return raw.NewWithConfig(raw.Config{
+		Dependencies: raw.Dependencies{
+			ObjectStorage: s.om,
+			SongRepo:      s.sm,
+			Broker:        s.bm,
+			UrlSigner:     s.signer,
+			JobQueue:      s.jm,
+			Thumbnailer:   s.tm,
+		},
+		HostUsesTls:   true,
+		Host:          "songs.example.com",
+		ThumbnailSize: size,
+	})
+}
+
+func (s *ThumbnailSuite) TestSignThumbnailUrl() {
+	svc := s.service(256)
+	thumbnailUrl := svc.ThumbnailUrl("abc.png")
+
+	s.Equal("https://songs.example.com/songs/api/v1/song/image/thumbnail/abc.png", thumbnailUrl)
+	s.True(strings.HasPrefix(svc.SignThumbnailUrl(thumbnailUrl, svc.ImageUrl("abc.png"), "listener"), thumbnailUrl+"?"))
+
+	// The image was replaced or is hosted elsewhere
+	s.Empty(svc.SignThumbnailUrl(thumbnailUrl, svc.ImageUrl("def.png"), "listener"))
+	s.Empty(svc.SignThumbnailUrl(thumbnailUrl, gofakeit.URL(), "listener"))
+}
+
+func (s *ThumbnailSuite) TestHandleThumbnailJob() {
+	svc := s.service(256)
+
+	s.om.EXPECT().GetImageObject(mock.Anything, "abc.png").Return(strings.NewReader("image"), nil).Once()
+	s.tm.EXPECT().MakeThumbnail(mock.Anything, mock.Anything, mock.Anything, 256).RunAndReturn(
+		func(_ context.Context, _ io.Reader, w io.Writer, _ int) error {
+			_, err := w.Write([]byte("thumbnail"))
+			return err
+		}).Once()
+	s.om.EXPECT().PutThumbnailObject(mock.Anything, "abc.png", mock.Anything, int64(len("thumbnail"))).
+		Return(nil).Once()
+	s.sm.EXPECT().SetImageThumbnail(mock.Anything, postgres.SetImageThumbnailParams{
+		ThumbnailUrl: pgconv.Text(svc.ThumbnailUrl("abc.png")),
+		ImageUrl:     pgconv.Text(svc.ImageUrl("abc.png")),
+	}).Return(nil).Once()
+
+	err := svc.HandleThumbnailJob(s.ctx, raw.ThumbnailJobArgs{ImageId: "abc.png"})
+	s.NoError(err)
+}
+
+func (s *ThumbnailSuite) TestHandleThumbnailJob_ImageGone() {
+	s.om.EXPECT().GetImageObject(mock.Anything, "abc.png").Return(nil, objstore.ErrNotFound).Once()
+
+	err := s.service(256).HandleThumbnailJob(s.ctx, raw.ThumbnailJobArgs{ImageId: "abc.png"})
+	s.ErrorIs(err, jobs.ErrPermanent)
+}
+
+func (s *ThumbnailSuite) TestHandleThumbnailJob_NotAnImage() {
+	s.om.EXPECT().GetImageObject(mock.Anything, "abc.png").Return(strings.NewReader("text"), nil).Once()
+	s.tm.EXPECT().MakeThumbnail(mock.Anything, mock.Anything, mock.Anything, 256).Return(image.ErrFormat).Once()
+
+	err := s.service(256).HandleThumbnailJob(s.ctx, raw.ThumbnailJobArgs{ImageId: "abc.png"})
+	s.ErrorIs(err, jobs.ErrPermanent)
+}
+
+func (s *ThumbnailSuite) TestHandleThumbnailJob_PutError() {
+	s.om.EXPECT().GetImageObject(mock.Anything, "abc.png").Return(strings.NewReader("image"), nil).Once()
+	s.tm.EXPECT().MakeThumbnail(mock.Anything, mock.Anything, mock.Anything, 256).Return(nil).Once()
+	s.om.EXPECT().PutThumbnailObject(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(gofakeit.Error()).Once()
+
+	err := s.service(256).HandleThumbnailJob(s.ctx, raw.ThumbnailJobArgs{ImageId: "abc.png"})
+	s.Error(err)
+	s.NotErrorIs(err, jobs.ErrPermanent)
+}
+
+func (s *ThumbnailSuite) TestHandleThumbnailJob_Disabled() {
+	err := s.service(0).HandleThumbnailJob(s.ctx, raw.ThumbnailJobArgs{ImageId: "abc.png"})
+	s.NoError(err)
+}
+
+func (s *ThumbnailSuite) TestUploadQueuesThumbnail() {
+	input := validUploadRawSongImageInput()
+
+	s.sm.EXPECT().MySong(mock.Anything, mock.Anything).Return(validMySongRow(input.SongId), nil).Once()
+	s.sm.EXPECT().Begin(mock.Anything).Return(s.sm, nil).Once()
+	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(validMySongRow(input.SongId).Song, nil).Once()
+	s.om.EXPECT().PutImageObject(mock.Anything, mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+	s.jm.EXPECT().EnqueueJob(mock.Anything, string(raw.ThumbnailJob), mock.Anything, mock.Anything).
+		Return(uuid.New(), nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.service(256).UploadRawSongImage(s.ctx, input)
+	s.NoError(err)
+}
+
+func (s *ThumbnailSuite) TestGetRawSongThumbnail() {
+	s.sm.EXPECT().ImageObjectStatus(mock.Anything, mock.Anything).Return(releasedImageStatus(), nil).Once()
+	s.om.EXPECT().GetThumbnailObject(mock.Anything, "abc.png").Return(strings.NewReader("thumbnail"), nil).Once()
+
+	reader, err := s.service(256).GetRawSongThumbnail(s.ctx, s.input("abc.png", ""))
+	s.NoError(err)
+	s.NotNil(reader)
+}
+
+func (s *ThumbnailSuite) TestGetRawSongThumbnail_Unreleased() {
+	status := postgres.ImageObjectStatusRow{Released: false, SingerIds: []uuid.UUID{uuid.New()}}
+
+	s.sm.EXPECT().ImageObjectStatus(mock.Anything, mock.Anything).Return(status, nil).Once()
+
+	_, err := s.service(256).GetRawSongThumbnail(s.ctx, s.input("abc.png", uuid.NewString()))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *ThumbnailSuite) TestGetRawSongThumbnail_NotMade() {
+	s.sm.EXPECT().ImageObjectStatus(mock.Anything, mock.Anything).Return(releasedImageStatus(), nil).Once()
+	s.om.EXPECT().GetThumbnailObject(mock.Anything, "abc.png").Return(nil, objstore.ErrNotFound).Once()
+
+	_, err := s.service(256).GetRawSongThumbnail(s.ctx, s.input("abc.png", ""))
+	s.ErrorIs(err, raw.ErrFileNotFound)
+}
+
+func (s *ThumbnailSuite) TestGetRawSongThumbnail_ImageLink() {
+	// Links to images don't open their thumbnails
+	_, err := s.service(256).GetRawSongThumbnail(s.ctx, raw.GetRawSongImageInput{
+		ObjectId:  "abc.png",
+		Signature: parseQuery(s.T(), s.signer.Sign("image", "abc.png", "")),
+	})
+	s.ErrorIs(err, raw.ErrInvalidLink)
+}
+
+// input returns the input with a link to the thumbnail given to the listener.
+func (s *ThumbnailSuite) input(imageId, listenerId string) raw.GetRawSongImageInput {
+	return raw.GetRawSongImageInput{
+		ObjectId:  imageId,
+		Signature: parseQuery(s.T(), s.signer.Sign("thumbnail", imageId, listenerId)),
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	suite.Run(t, new(ThumbnailSuite))
+}
@@ -0,0 +1,261 @@
+// Package reconcile compares the objects of songs and images with the songs referencing them.
+//
+// Orphans are objects no song references: uploads that failed after the object was stored
+// and objects left behind by re-uploads that changed the object id. Dangling references are
+// songs whose objects are missing. Orphans older than the grace period may be deleted,
+// younger ones may belong to uploads still in progress.
+package reconcile
+
+import (
+	"context"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+// refsBatchSize is how many songs are read at once.
+const refsBatchSize = 1000
+
+type Service struct {
+	c       Config
+	repo    SongRepo
+	storage ObjectStorage
+	images  ImageObjects
+}
+
+type SongRepo interface {
+	SongObjectRefs(context.Context, postgres.SongObjectRefsParams) ([]postgres.SongObjectRefsRow, error)
+}
+
+type ObjectStorage interface {
+	ListSongObjects(ctx context.Context, fn func(objstore.ObjectInfo) error) error
+	ListImageObjects(ctx context.Context, fn func(objstore.ObjectInfo) error) error
+	DeleteSongObject(ctx context.Context, id string) error
+	DeleteImageObject(ctx context.Context, id string) error
+}
+
+// ImageObjects tells the objects of images hosted by us from images hosted elsewhere.
+type ImageObjects interface {
+	ImageObjectId(imageUrl string) (string, bool)
+}
+
+type Dependencies struct {
+	SongRepo      SongRepo
+	ObjectStorage ObjectStorage
+	ImageObjects  ImageObjects
+}
+
+type Config struct {
+	Dependencies
+	// Orphans modified in this time are reported only
+	GracePeriod   time.Duration
+	DeleteOrphans bool
+}
+
+func New(deps Dependencies) *Service {
+	conf := config.Get()
+
+	return NewWithConfig(Config{
+		Dependencies:  deps,
+		GracePeriod:   conf.Features.Reconcile.GracePeriod,
+		DeleteOrphans: conf.Features.Reconcile.DeleteOrphans,
+	})
+}
+
+func NewWithConfig(conf Config) *Service {
+	return &Service{
+		c:       conf,
+		repo:    conf.SongRepo,
+		storage: conf.ObjectStorage,
+		images:  conf.ImageObjects,
+	}
+}
+
+type Kind string
+
+const (
+	KindSong  Kind = "song"
+	KindImage Kind = "image"
+)
+
+type Orphan struct {
+	Kind       Kind
+	ObjectId   string
+	Size       int64
+	ModifiedAt time.Time
+	Deleted    bool
+}
+
+type Dangling struct {
+	Kind     Kind
+	SongId   uuid.UUID
+	ObjectId string
+}
+
+type Report struct {
+	// Objects is how many objects were compared
+	Objects  int
+	Orphans  []Orphan
+	Dangling []Dangling
+}
+
+// OrphanedBytes is the size of the orphans that were not deleted.
+func (r Report) OrphanedBytes() int64 {
+	var size int64
+
+	for _, orphan := range r.Orphans {
+		if !orphan.Deleted {
+			size += orphan.Size
+		}
+	}
+
+	return size
+}
+
+type ref struct {
+	songId uuid.UUID
+	seen   bool
+}
+
+// Reconcile compares the objects with the songs and deletes the orphans if it is configured to.
+// Orphans that failed to be deleted are kept in the report and the first error is returned with it.
+func (s *Service) Reconcile(ctx context.Context) (Report, error) {
+	var report Report
+
+	songs, images, err := s.refs(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	// Objects are listed after the references, so an object stored in between is an orphan
+	// younger than the grace period instead of a dangling reference
+	now := time.Now()
+
+	err = s.storage.ListSongObjects(ctx, s.collect(&report, KindSong, songs))
+	if err != nil {
+		return report, e.NewFrom("listing song objects", err)
+	}
+
+	err = s.storage.ListImageObjects(ctx, s.collect(&report, KindImage, images))
+	if err != nil {
+		return report, e.NewFrom("listing image objects", err)
+	}
+
+	report.Dangling = append(dangling(KindSong, songs), dangling(KindImage, images)...)
+
+	if s.c.DeleteOrphans {
+		err = s.deleteOrphans(ctx, report.Orphans, now.Add(-s.c.GracePeriod))
+	}
+
+	return report, err
+}
+
+// refs returns the objects of songs and of images referenced by songs.
+func (s *Service) refs(ctx context.Context) (map[string]*ref, map[string]*ref, error) {
+	songs := map[string]*ref{}
+	images := map[string]*ref{}
+	after := uuid.Nil
+
+	for {
+		rows, err := s.repo.SongObjectRefs(ctx, postgres.SongObjectRefsParams{
+			AfterID: after,
+			Limitv:  refsBatchSize,
+		})
+		if err != nil {
+			return nil, nil, e.NewFrom("getting object references", err, fields.F("after_id", after))
+		}
+
+		for _, row := range rows {
+			if row.S3ObjectName.Valid {
+				songs[row.S3ObjectName.String] = &ref{songId: row.SongID, seen: false}
+			}
+
+			if imageId, ok := s.images.ImageObjectId(row.ImageUrl.String); ok && row.ImageUrl.Valid {
+				images[imageId] = &ref{songId: row.SongID, seen: false}
+			}
+
+			after = row.SongID
+		}
+
+		if len(rows) < refsBatchSize {
+			return songs, images, nil
+		}
+	}
+}
+
+func (s *Service) collect(report *Report, kind Kind, refs map[string]*ref) func(objstore.ObjectInfo) error {
+	return func(info objstore.ObjectInfo) error {
+		report.Objects++
+
+		if r, ok := refs[info.Key]; ok {
+			r.seen = true
+			return nil
+		}
+
+		report.Orphans = append(report.Orphans, Orphan{
+			Kind:       kind,
+			ObjectId:   info.Key,
+			Size:       info.Size,
+			ModifiedAt: info.ModifiedAt,
+			Deleted:    false,
+		})
+
+		return nil
+	}
+}
+
+func dangling(kind Kind, refs map[string]*ref) []Dangling {
+	var out []Dangling
+
+	for objectId, r := range refs {
+		if !r.seen {
+			out = append(out, Dangling{Kind: kind, SongId: r.songId, ObjectId: objectId})
+		}
+	}
+
+	return out
+}
+
+// deleteOrphans deletes the orphans modified before the time, it goes on after failures.
+func (s *Service) deleteOrphans(ctx context.Context, orphans []Orphan, modifiedBefore time.Time) error {
+	log := logger.FromContext(ctx)
+
+	var firstErr error
+
+	for i, orphan := range orphans {
+		if !orphan.ModifiedAt.Before(modifiedBefore) {
+			continue
+		}
+
+		var err error
+
+		switch orphan.Kind {
+		case KindSong:
+			err = s.storage.DeleteSongObject(ctx, orphan.ObjectId)
+		case KindImage:
+			err = s.storage.DeleteImageObject(ctx, orphan.ObjectId)
+		}
+
+		if err != nil {
+			log.Warn().Err(err).Str("kind", string(orphan.Kind)).Str("object_id", orphan.ObjectId).
+				Msg("error deleting orphaned object")
+
+			if firstErr == nil {
+				firstErr = e.NewFrom("deleting orphaned object", err, fields.F("object_id", orphan.ObjectId))
+			}
+
+			continue
+		}
+
+		orphans[i].Deleted = true
+	}
+
+	return firstErr
+}
@@ -0,0 +1,177 @@
+package reconcile_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	reconcilemocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/reconcile"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/reconcile"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const imageUrlTpl = "http://localhost/songs/api/v1/image/raw/"
+
+type ReconcileSuite struct {
+	suite.Suite
+
+	rm *reconcilemocks.SongRepo
+	om *reconcilemocks.ObjectStorage
+
+	ctx context.Context
+}
+
+func (s *ReconcileSuite) SetupTest() {
+	s.rm = reconcilemocks.NewSongRepo(s.T())
+	s.om = reconcilemocks.NewObjectStorage(s.T())
+	s.ctx = context.Background()
+}
+
+func (s *ReconcileSuite) service(deleteOrphans bool) *reconcile.Service {
+	return reconcile.NewWithConfig(reconcile.Config{
+		Dependencies: reconcile.Dependencies{
+			SongRepo:      s.rm,
+			ObjectStorage: s.om,
+			ImageObjects:  imageObjects{},
+		},
+		GracePeriod:   time.Hour,
+		DeleteOrphans: deleteOrphans,
+	})
+}
+
+func (s *ReconcileSuite) expectObjects(songs, images []objstore.ObjectInfo) {
+	s.om.EXPECT().ListSongObjects(mock.Anything, mock.Anything).RunAndReturn(list(songs)).Once()
+	s.om.EXPECT().ListImageObjects(mock.Anything, mock.Anything).RunAndReturn(list(images)).Once()
+}
+
+func (s *ReconcileSuite) TestReport() {
+	song, image := uuid.New(), uuid.New()
+	old := time.Now().Add(-2 * time.Hour)
+
+	s.rm.EXPECT().SongObjectRefs(mock.Anything, postgres.SongObjectRefsParams{AfterID: uuid.Nil, Limitv: 1000}).
+		Return([]postgres.SongObjectRefsRow{
+			{SongID: song, S3ObjectName: pgconv.Text("song.mp3"), ImageUrl: pgconv.Text(imageUrlTpl + "song.png")},
+			// Images hosted elsewhere have no objects
+			{SongID: image, S3ObjectName: pgconv.Text("missing.mp3"), ImageUrl: pgconv.Text("https://cdn.example.com/a.png")},
+		}, nil).Once()
+	s.expectObjects(
+		[]objstore.ObjectInfo{{Key: "song.mp3", Size: 10, ModifiedAt: old}, {Key: "orphan.mp3", Size: 20, ModifiedAt: old}},
+		[]objstore.ObjectInfo{{Key: "song.png", Size: 1, ModifiedAt: old}, {Key: "orphan.png", Size: 2, ModifiedAt: old}},
+	)
+
+	report, err := s.service(false).Reconcile(s.ctx)
+
+	s.Require().NoError(err)
+	s.Equal(4, report.Objects)
+	s.Equal([]reconcile.Orphan{
+		{Kind: reconcile.KindSong, ObjectId: "orphan.mp3", Size: 20, ModifiedAt: old, Deleted: false},
+		{Kind: reconcile.KindImage, ObjectId: "orphan.png", Size: 2, ModifiedAt: old, Deleted: false},
+	}, report.Orphans)
+	s.Equal([]reconcile.Dangling{
+		{Kind: reconcile.KindSong, SongId: image, ObjectId: "missing.mp3"},
+	}, report.Dangling)
+	s.Equal(int64(22), report.OrphanedBytes())
+}
+
+func (s *ReconcileSuite) TestRefsInBatches() {
+	first := make([]postgres.SongObjectRefsRow, 1000)
+	for i := range first {
+		first[i] = postgres.SongObjectRefsRow{SongID: uuid.New(), S3ObjectName: pgconv.Text(uuid.NewString()), ImageUrl: pgconv.NullText()}
+	}
+
+	last := first[len(first)-1].SongID
+
+	s.rm.EXPECT().SongObjectRefs(mock.Anything, postgres.SongObjectRefsParams{AfterID: uuid.Nil, Limitv: 1000}).
+		Return(first, nil).Once()
+	s.rm.EXPECT().SongObjectRefs(mock.Anything, postgres.SongObjectRefsParams{AfterID: last, Limitv: 1000}).
+		Return(nil, nil).Once()
+	s.expectObjects(nil, nil)
+
+	report, err := s.service(false).Reconcile(s.ctx)
+
+	s.Require().NoError(err)
+	s.Len(report.Dangling, 1000)
+}
+
+func (s *ReconcileSuite) TestDeleteOrphans() {
+	old := time.Now().Add(-2 * time.Hour)
+	recent := time.Now().Add(-time.Minute)
+
+	s.rm.EXPECT().SongObjectRefs(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.expectObjects(
+		[]objstore.ObjectInfo{{Key: "old.mp3", Size: 10, ModifiedAt: old}, {Key: "uploading.mp3", Size: 20, ModifiedAt: recent}},
+		[]objstore.ObjectInfo{{Key: "old.png", Size: 1, ModifiedAt: old}},
+	)
+	s.om.EXPECT().DeleteSongObject(mock.Anything, "old.mp3").Return(nil).Once()
+	s.om.EXPECT().DeleteImageObject(mock.Anything, "old.png").Return(nil).Once()
+
+	report, err := s.service(true).Reconcile(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(report.Orphans, 3)
+	s.True(report.Orphans[0].Deleted)
+	s.False(report.Orphans[1].Deleted)
+	s.True(report.Orphans[2].Deleted)
+	s.Equal(int64(20), report.OrphanedBytes())
+}
+
+func (s *ReconcileSuite) TestDeleteOrphansError() {
+	old := time.Now().Add(-2 * time.Hour)
+	errStorage := errors.New("storage is down")
+
+	s.rm.EXPECT().SongObjectRefs(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.expectObjects(
+		[]objstore.ObjectInfo{{Key: "a.mp3", Size: 10, ModifiedAt: old}, {Key: "b.mp3", Size: 20, ModifiedAt: old}},
+		nil,
+	)
+	s.om.EXPECT().DeleteSongObject(mock.Anything, "a.mp3").Return(errStorage).Once()
+	s.om.EXPECT().DeleteSongObject(mock.Anything, "b.mp3").Return(nil).Once()
+
+	report, err := s.service(true).Reconcile(s.ctx)
+
+	s.Require().ErrorIs(err, errStorage)
+	s.False(report.Orphans[0].Deleted)
+	s.True(report.Orphans[1].Deleted)
+}
+
+func (s *ReconcileSuite) TestListError() {
+	errStorage := errors.New("storage is down")
+
+	s.rm.EXPECT().SongObjectRefs(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.om.EXPECT().ListSongObjects(mock.Anything, mock.Anything).Return(errStorage).Once()
+
+	_, err := s.service(true).Reconcile(s.ctx)
+
+	s.Require().ErrorIs(err, errStorage)
+}
+
+func TestReconcile(t *testing.T) {
+	suite.Run(t, new(ReconcileSuite))
+}
+
+type imageObjects struct{}
+
+func (imageObjects) ImageObjectId(imageUrl string) (string, bool) {
+	return strings.CutPrefix(imageUrl, imageUrlTpl)
+}
+
+func list(objects []objstore.ObjectInfo) func(context.Context, func(objstore.ObjectInfo) error) error {
+	return func(_ context.Context, fn func(objstore.ObjectInfo) error) error {
+		for _, object := range objects {
+			err := fn(object)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,166 @@
+package songs
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+var ErrCreditRequestNotFound = erix.NewStatus("credit request not found", erix.CodeNotFound)
+
+type GetCreditRequestsInput struct {
+	UserId uuid.UUID // must be an artist
+	// pagination
+	Page     int32
+	PageSize int32
+}
+
+// CreditRequest is a pending credit of the user on a song of another artist.
+type CreditRequest struct {
+	Id       int64
+	SongId   uuid.UUID
+	SongName string
+	Singer   users.Artist
+	Role     postgres.CreditRole
+}
+
+type GetCreditRequestsOutput struct {
+	Requests []CreditRequest
+	LastPage int32
+}
+
+// GetCreditRequests returns the credits waiting for the user to approve or decline them, newest first.
+func (s *Service) GetCreditRequests(ctx context.Context, input GetCreditRequestsInput,
+) (GetCreditRequestsOutput, error) {
+	var (
+		null = GetCreditRequestsOutput{Requests: []CreditRequest{}, LastPage: 0}
+		log  = logger.FromContext(ctx)
+	)
+
+	log.Debug().Msg("getting credit requests")
+
+	rows, err := s.songRepo.CreditRequests(ctx, postgres.CreditRequestsParams{
+		ArtistID: input.UserId,
+		Limitv:   input.PageSize,
+		Offsetv:  (input.Page - 1) * input.PageSize,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult) || (len(rows) == 0 && err == nil):
+		log.Debug().Stringer("user_id", input.UserId).Msg("no credit requests found")
+		return null, nil
+
+	case err != nil:
+		return null, e.NewFrom("getting credit requests", err)
+	}
+
+	count, err := s.songRepo.CountCreditRequests(ctx, input.UserId)
+	if err != nil {
+		return null, e.NewFrom("getting credit requests count", err)
+	}
+
+	singersIds := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		if !slices.Contains(singersIds, row.Song.SingerFk) {
+			singersIds = append(singersIds, row.Song.SingerFk)
+		}
+	}
+
+	singers, err := s.userRepo.ArtistsByIds(ctx, singersIds)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return null, ErrArtistsNotFound.Wrap(err)
+
+	case err != nil:
+		return null, e.NewFrom("getting singers", err)
+	}
+
+	requests := make([]CreditRequest, 0, len(rows))
+
+	for _, row := range rows {
+		idx := slices.IndexFunc(singers, func(a users.Artist) bool {
+			return a.Id == row.Song.SingerFk
+		})
+		if idx == -1 {
+			log.Warn().Stringer("singer_id", row.Song.SingerFk).Msg("singer of credited song not found")
+			continue
+		}
+
+		requests = append(requests, CreditRequest{
+			Id:       row.CreditID,
+			SongId:   row.Song.SongID,
+			SongName: row.Song.Name,
+			Singer:   singers[idx],
+			Role:     row.Role,
+		})
+	}
+
+	return GetCreditRequestsOutput{
+		Requests: requests,
+		LastPage: (count-1)/input.PageSize + 1,
+	}, nil
+}
+
+type ResolveCreditRequestInput struct {
+	UserId   uuid.UUID // must be the credited artist
+	CreditId int64
+	Approve  bool
+}
+
+type ResolveCreditRequestOutput struct {
+}
+
+// ResolveCreditRequest approves or declines a pending credit of the user.
+// Declined credits stay hidden from listeners, the song can be released once none are pending.
+func (s *Service) ResolveCreditRequest(ctx context.Context, in ResolveCreditRequestInput,
+) (ResolveCreditRequestOutput, error) {
+	var (
+		null   = ResolveCreditRequestOutput{}
+		log    = logger.FromContext(ctx)
+		status = postgres.CreditStatusDeclined
+	)
+
+	if in.Approve {
+		status = postgres.CreditStatusApproved
+	}
+
+	log.Debug().Int64("credit_id", in.CreditId).Str("status", string(status)).Msg("resolving credit request")
+
+	row, err := s.songRepo.ResolveCreditRequest(ctx, postgres.ResolveCreditRequestParams{
+		Status:   status,
+		CreditID: in.CreditId,
+		ArtistID: in.UserId,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return null, ErrCreditRequestNotFound.Wrap(err, fields.F("credit_id", in.CreditId))
+
+	case err != nil:
+		return null, e.NewFrom("resolving credit request", err, fields.F("credit_id", in.CreditId))
+	}
+
+	broker.SendOrLog(ctx, s.messageBroker, broker.CreditMessage{
+		SongId:           row.SongFk,
+		ArtistId:         row.SingerFk,
+		CreditedArtistId: in.UserId,
+		Role:             row.Role,
+		Status:           status,
+		OccurredAt:       time.Now(),
+	})
+
+	return null, nil
+}
@@ -0,0 +1,132 @@
+package songs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
+	songsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/events"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConsentSuite struct {
+	suite.Suite
+
+	sm *songsmocks.SongRepo
+	um *songsmocks.UserRepo
+	bm *songsmocks.Broker
+
+	s   *songs.Service
+	ctx context.Context
+}
+
+func (s *ConsentSuite) SetupTest() {
+	s.sm = songsmocks.NewSongRepo(s.T())
+	s.um = songsmocks.NewUserRepo(s.T())
+	s.bm = songsmocks.NewBroker(s.T())
+
+	s.s = songs.NewWithConfig(songs.Config{
+		Dependencies: songs.Dependencies{
+			SongRepo: s.sm,
+			UserRepo: s.um,
+			Broker:   s.bm,
+		},
+	})
+
+	s.ctx = context.Background()
+}
+
+func (s *ConsentSuite) TestGetCreditRequests() {
+	singer := validArtist()
+	rows := []postgres.CreditRequestsRow{
+		{CreditID: 2, Role: postgres.CreditRoleFeatured, Song: validSongRow().Song},
+		{CreditID: 1, Role: postgres.CreditRoleProducer, Song: validSongRow().Song},
+	}
+	rows[0].Song.SingerFk = singer.Id
+	rows[1].Song.SingerFk = singer.Id
+
+	s.sm.EXPECT().CreditRequests(mock.Anything, mock.Anything).Return(rows, nil).Once()
+	s.sm.EXPECT().CountCreditRequests(mock.Anything, mock.Anything).Return(12, nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, []uuid.UUID{singer.Id}).Return([]users.Artist{singer}, nil).Once()
+
+	output, err := s.s.GetCreditRequests(s.ctx, songs.GetCreditRequestsInput{
+		UserId:   uuid.New(),
+		Page:     1,
+		PageSize: 10,
+	})
+	s.Require().NoError(err)
+	s.Len(output.Requests, 2)
+	s.Equal(singer, output.Requests[1].Singer)
+	s.Equal(postgres.CreditRoleProducer, output.Requests[1].Role)
+	s.Equal(int32(2), output.LastPage)
+}
+
+func (s *ConsentSuite) TestGetCreditRequests_Empty() {
+	s.sm.EXPECT().CreditRequests(mock.Anything, mock.Anything).Return(nil, repoerrs.ErrEmptyResult).Once()
+
+	output, err := s.s.GetCreditRequests(s.ctx, songs.GetCreditRequestsInput{
+		UserId:   uuid.New(),
+		Page:     1,
+		PageSize: 10,
+	})
+	s.NoError(err)
+	s.Empty(output.Requests)
+}
+
+func (s *ConsentSuite) TestResolveCreditRequest() {
+	input := songs.ResolveCreditRequestInput{UserId: uuid.New(), CreditId: 7, Approve: true}
+	row := postgres.ResolveCreditRequestRow{
+		SongFk:   uuid.New(),
+		Role:     postgres.CreditRoleFeatured,
+		SingerFk: uuid.New(),
+	}
+
+	s.sm.EXPECT().ResolveCreditRequest(mock.Anything, postgres.ResolveCreditRequestParams{
+		Status:   postgres.CreditStatusApproved,
+		CreditID: input.CreditId,
+		ArtistID: input.UserId,
+	}).Return(row, nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(msgs []broker.SongMessage) bool {
+		return len(msgs) == 1 && msgs[0].Type() == events.TypeCreditResolved && msgs[0].Artist() == row.SingerFk
+	})).Return(nil).Once()
+
+	_, err := s.s.ResolveCreditRequest(s.ctx, input)
+	s.NoError(err)
+}
+
+func (s *ConsentSuite) TestResolveCreditRequest_NotFound() {
+	s.sm.EXPECT().ResolveCreditRequest(mock.Anything, mock.Anything).
+		Return(postgres.ResolveCreditRequestRow{}, repoerrs.ErrEmptyResult).Once()
+
+	_, err := s.s.ResolveCreditRequest(s.ctx, songs.ResolveCreditRequestInput{
+		UserId:   uuid.New(),
+		CreditId: 7,
+		Approve:  false,
+	})
+	s.ErrorIs(err, songs.ErrCreditRequestNotFound)
+}
+
+func (s *ConsentSuite) TestResolveCreditRequestError() {
+	s.sm.EXPECT().ResolveCreditRequest(mock.Anything, mock.Anything).
+		Return(postgres.ResolveCreditRequestRow{}, gofakeit.ErrorDatabase()).Once()
+
+	_, err := s.s.ResolveCreditRequest(s.ctx, songs.ResolveCreditRequestInput{
+		UserId:   uuid.New(),
+		CreditId: 7,
+		Approve:  true,
+	})
+	s.Error(err)
+}
+
+func TestConsent(t *testing.T) {
+	suite.Run(t, new(ConsentSuite))
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
@@ -18,8 +19,9 @@ import (
 )
 
 var (
-	ErrSongExists      = erix.NewStatus("artist already has song with this name", erix.CodeConflict)
-	ErrArtistsNotFound = erix.NewStatus("artists not found", erix.CodeNotFound)
+	ErrSongExists         = erix.NewStatus("artist already has song with this name", erix.CodeConflict)
+	ErrArtistsNotFound    = erix.NewStatus("artists not found", erix.CodeNotFound)
+	ErrSongsQuotaExceeded = erix.NewStatus("songs quota exceeded", erix.CodePreconditionFailed)
 )
 
 type CreateSongInput struct {
@@ -27,6 +29,9 @@ type CreateSongInput struct {
 	SingerId    uuid.UUID
 	ImageUrl    *string
 	FeatArtists []uuid.UUID
+	Explicit    bool
+	// Feat artists are credited as featured before these
+	Credits []Credit
 }
 
 type CreateSongOutput struct {
@@ -36,6 +41,7 @@ type CreateSongOutput struct {
 	Name       string
 	UploadedAt time.Time
 	ImageUrl   *string
+	Explicit   bool
 }
 
 func (s *Service) CreateSong(ctx context.Context, in CreateSongInput) (CreateSongOutput, error) {
@@ -44,6 +50,29 @@ func (s *Service) CreateSong(ctx context.Context, in CreateSongInput) (CreateSon
 		log  = logger.FromContext(ctx)
 	)
 
+	if s.c.MaxSongs > 0 {
+		log.Debug().Msg("checking songs quota")
+
+		usage, err := s.songRepo.ArtistUsage(ctx, in.SingerId)
+		if err != nil {
+			return null, e.NewFrom("getting artist usage", err, fields.F("singer_id", in.SingerId))
+		}
+
+		if usage.SongsCount >= s.c.MaxSongs {
+			return null, ErrSongsQuotaExceeded
+		}
+	}
+
+	log.Debug().Msg("resolving credits")
+
+	credits, err := s.resolveCredits(ctx, in.SingerId, in.FeatArtists, in.Credits)
+	if err != nil {
+		return null, err
+	}
+
+	arrays := creditsParams(credits)
+	featArtists := featuredArtists(credits)
+
 	log.Debug().Msg("saving song")
 
 	songParams := postgres.SaveSongParams{
@@ -51,15 +80,20 @@ func (s *Service) CreateSong(ctx context.Context, in CreateSongInput) (CreateSon
 		SingerFk:     in.SingerId,
 		Name:         in.Name,
 		UploadedAt:   time.Now(),
-		ArtistsIds:   in.FeatArtists,
 		S3ObjectName: pgconv.NullText(),
 		ImageUrl:     pgconv.TextPtr(in.ImageUrl),
 		Duration:     pgconv.NullInterval(),
 		WeightBytes:  pgconv.NullInt4(),
 		ReleasedAt:   pgconv.NullTimestamptz(),
+		Explicit:     in.Explicit,
+
+		CreditsArtistsIds: arrays.ids,
+		CreditsNames:      arrays.names,
+		CreditsRoles:      arrays.roles,
+		CreditsStatuses:   arrays.statuses,
 	}
 
-	err := s.songRepo.SaveSong(ctx, songParams)
+	err = s.songRepo.SaveSong(ctx, songParams)
 
 	switch {
 	case errors.Is(err, repoerrs.ErrUnique):
@@ -69,9 +103,22 @@ func (s *Service) CreateSong(ctx context.Context, in CreateSongInput) (CreateSon
 		return null, e.NewFrom("saving song", err)
 	}
 
+	messages := []broker.SongMessage{broker.SongCreatedMessage{
+		SongId:        songParams.SongID,
+		ArtistId:      songParams.SingerFk,
+		Name:          songParams.Name,
+		FeatArtistIds: featArtists,
+		CreatedAt:     songParams.UploadedAt,
+		Explicit:      songParams.Explicit,
+	}}
+
+	messages = append(messages, creditMessages(songParams.SongID, songParams.SingerFk, credits, nil)...)
+
+	broker.SendOrLog(ctx, s.messageBroker, messages...)
+
 	log.Debug().Msg("getting artists by id")
 
-	artists, err := s.artists(ctx, in.SingerId, in.FeatArtists)
+	artists, err := s.artists(ctx, in.SingerId, featArtists)
 	if err != nil {
 		return null, err
 	}
@@ -83,5 +130,6 @@ func (s *Service) CreateSong(ctx context.Context, in CreateSongInput) (CreateSon
 		Name:       songParams.Name,
 		UploadedAt: songParams.UploadedAt,
 		ImageUrl:   in.ImageUrl,
+		Explicit:   in.Explicit,
 	}, nil
 }
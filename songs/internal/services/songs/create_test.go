@@ -7,8 +7,10 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
 	songsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/songs"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
 
+	"github.com/brianvoe/gofakeit/v7"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -19,6 +21,7 @@ type CreateSongSuite struct {
 
 	sm *songsmocks.SongRepo
 	su *songsmocks.UserRepo
+	bm *songsmocks.Broker
 
 	s     *songs.Service
 	ctx   context.Context
@@ -28,11 +31,13 @@ type CreateSongSuite struct {
 func (s *CreateSongSuite) SetupTest() {
 	s.sm = songsmocks.NewSongRepo(s.T())
 	s.su = songsmocks.NewUserRepo(s.T())
+	s.bm = songsmocks.NewBroker(s.T())
 
 	s.s = songs.NewWithConfig(songs.Config{
 		Dependencies: songs.Dependencies{
 			SongRepo: s.sm,
 			UserRepo: s.su,
+			Broker:   s.bm,
 		},
 	})
 
@@ -42,6 +47,7 @@ func (s *CreateSongSuite) SetupTest() {
 
 func (s *CreateSongSuite) TestHappyPath() {
 	s.sm.EXPECT().SaveSong(mock.Anything, mock.Anything).Return(nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
 	s.su.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return(validArtists(2), nil).Once()
 
 	output, err := s.s.CreateSong(s.ctx, s.input)
@@ -68,6 +74,7 @@ func (s *CreateSongSuite) TestSongRepo_UniqueError() {
 // This case may happen when users service is not available
 func (s *CreateSongSuite) TestUserRepoError() {
 	s.sm.EXPECT().SaveSong(mock.Anything, mock.Anything).Return(nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
 	s.su.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return(nil, gofakeit.ErrorDatabase()).Once()
 
 	output, err := s.s.CreateSong(s.ctx, s.input)
@@ -75,6 +82,35 @@ func (s *CreateSongSuite) TestUserRepoError() {
 	s.Empty(output)
 }
 
+// The song is already saved, so the request must not fail
+func (s *CreateSongSuite) TestBrokerError() {
+	s.sm.EXPECT().SaveSong(mock.Anything, mock.Anything).Return(nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(gofakeit.Error()).Once()
+	s.su.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return(validArtists(2), nil).Once()
+
+	output, err := s.s.CreateSong(s.ctx, s.input)
+	s.NoError(err)
+	s.NotEmpty(output)
+}
+
+func (s *CreateSongSuite) TestSongsQuotaExceeded() {
+	s.s = songs.NewWithConfig(songs.Config{
+		Dependencies: songs.Dependencies{
+			SongRepo: s.sm,
+			UserRepo: s.su,
+			Broker:   s.bm,
+		},
+		MaxSongs: 3,
+	})
+
+	s.sm.EXPECT().ArtistUsage(mock.Anything, s.input.SingerId).
+		Return(postgres.ArtistUsageRow{SongsCount: 3, WeightBytes: 0}, nil).Once()
+
+	output, err := s.s.CreateSong(s.ctx, s.input)
+	s.ErrorIs(err, songs.ErrSongsQuotaExceeded)
+	s.Empty(output)
+}
+
 func TestCreateSong(t *testing.T) {
 	suite.Run(t, new(CreateSongSuite))
 }
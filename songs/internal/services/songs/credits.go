@@ -0,0 +1,269 @@
+package songs
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCredit = erix.NewStatus("credit must have an artist or a name", erix.CodeBadRequest)
+
+// Credit is a person credited for a song, either an artist with an account or anyone by name.
+type Credit struct {
+	// Nil for people without accounts
+	ArtistId *uuid.UUID
+	Name     string
+	Role     postgres.CreditRole
+	// Credits of other artists are pending until they approve or decline them
+	Status postgres.CreditStatus
+}
+
+// CreditFilter matches songs crediting an artist, or a person by name if ArtistId is nil.
+type CreditFilter struct {
+	ArtistId  *uuid.UUID
+	MatchName *string
+	// Any role if nil
+	Role *postgres.CreditRole
+}
+
+func (f *CreditFilter) valid() bool {
+	return f != nil && (f.ArtistId != nil || (f.MatchName != nil && len(*f.MatchName) > 0))
+}
+
+// resolveCredits turns feat artists into featured credits, removes duplicates
+// and takes the names of artists credited in credits from their accounts.
+// Feat artists keep no name, it is taken from their accounts when songs are read.
+// The singer is always the primary artist, so such a credit is skipped.
+// Credits of other artists with accounts are pending, the rest are approved.
+func (s *Service) resolveCredits(ctx context.Context,
+	singer uuid.UUID,
+	feats []uuid.UUID,
+	credits []Credit,
+) ([]Credit, error) {
+	all := make([]Credit, 0, len(feats)+len(credits))
+	for i := range feats {
+		all = append(all, Credit{
+			ArtistId: &feats[i],
+			Name:     "",
+			Role:     postgres.CreditRoleFeatured,
+			Status:   "",
+		})
+	}
+
+	all = append(all, credits...)
+
+	type key struct {
+		artist uuid.UUID
+		name   string
+		role   postgres.CreditRole
+	}
+
+	var (
+		out       = make([]Credit, 0, len(all))
+		seen      = make(map[key]struct{}, len(all))
+		artistIds = make([]uuid.UUID, 0, len(all))
+	)
+
+	for i, credit := range all {
+		credit.Name = strings.TrimSpace(credit.Name)
+		credit.Status = postgres.CreditStatusApproved
+
+		k := key{artist: uuid.Nil, name: "", role: credit.Role}
+
+		switch {
+		case credit.ArtistId != nil:
+			if *credit.ArtistId == singer && credit.Role == postgres.CreditRolePrimary {
+				continue
+			}
+
+			k.artist = *credit.ArtistId
+
+			if k.artist != singer {
+				credit.Status = postgres.CreditStatusPending
+			}
+
+		case credit.Name != "":
+			k.name = strings.ToLower(credit.Name)
+
+		default:
+			return nil, ErrInvalidCredit
+		}
+
+		if _, ok := seen[k]; ok {
+			continue
+		}
+
+		seen[k] = struct{}{}
+		out = append(out, credit)
+
+		if i >= len(feats) && credit.ArtistId != nil && !slices.Contains(artistIds, *credit.ArtistId) {
+			artistIds = append(artistIds, *credit.ArtistId)
+		}
+	}
+
+	if len(artistIds) == 0 {
+		return out, nil
+	}
+
+	usersArtists, err := s.userRepo.ArtistsByIds(ctx, artistIds)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return nil, ErrArtistsNotFound.Wrap(err)
+
+	case err != nil:
+		return nil, e.NewFrom("getting credited artists", err)
+
+	case len(usersArtists) != len(artistIds):
+		return nil, ErrArtistsNotFound
+	}
+
+	for i := range out {
+		if out[i].ArtistId == nil || !slices.Contains(artistIds, *out[i].ArtistId) {
+			continue
+		}
+
+		idx := slices.IndexFunc(usersArtists, func(a users.Artist) bool {
+			return a.Id == *out[i].ArtistId
+		})
+		if idx != -1 {
+			out[i].Name = usersArtists[idx].Name
+		}
+	}
+
+	return out, nil
+}
+
+// keepStatuses keeps the statuses of the credits the song already has,
+// so replacing credits doesn't ask artists again.
+func keepStatuses(credits []Credit, previous []postgres.SongCredit) {
+	for i := range credits {
+		if credits[i].ArtistId == nil {
+			continue
+		}
+
+		idx := slices.IndexFunc(previous, func(credit postgres.SongCredit) bool {
+			return credit.ArtistId == *credits[i].ArtistId && credit.Role == credits[i].Role
+		})
+		if idx != -1 {
+			credits[i].Status = previous[idx].Status
+		}
+	}
+}
+
+type creditsArrays struct {
+	ids      []uuid.UUID
+	names    []string
+	roles    []string
+	statuses []string
+}
+
+// creditsParams splits credits into the parallel arrays the queries take.
+func creditsParams(credits []Credit) creditsArrays {
+	out := creditsArrays{
+		ids:      make([]uuid.UUID, len(credits)),
+		names:    make([]string, len(credits)),
+		roles:    make([]string, len(credits)),
+		statuses: make([]string, len(credits)),
+	}
+
+	for i, credit := range credits {
+		if credit.ArtistId != nil {
+			out.ids[i] = *credit.ArtistId
+		}
+
+		out.names[i] = credit.Name
+		out.roles[i] = string(credit.Role)
+		out.statuses[i] = string(credit.Status)
+	}
+
+	return out
+}
+
+// creditMessages asks the artists of the new pending credits to approve them.
+func creditMessages(songId, singerId uuid.UUID, credits []Credit, previous []postgres.SongCredit) []broker.SongMessage {
+	now := time.Now()
+	messages := make([]broker.SongMessage, 0, len(credits))
+
+	for _, credit := range credits {
+		if credit.Status != postgres.CreditStatusPending {
+			continue
+		}
+
+		asked := slices.ContainsFunc(previous, func(prev postgres.SongCredit) bool {
+			return prev.ArtistId == *credit.ArtistId && prev.Role == credit.Role
+		})
+		if asked {
+			continue
+		}
+
+		messages = append(messages, broker.CreditMessage{
+			SongId:           songId,
+			ArtistId:         singerId,
+			CreditedArtistId: *credit.ArtistId,
+			Role:             credit.Role,
+			Status:           credit.Status,
+			OccurredAt:       now,
+		})
+	}
+
+	return messages
+}
+
+func featuredArtists(credits []Credit) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(credits))
+
+	for _, credit := range credits {
+		if credit.Role == postgres.CreditRoleFeatured && credit.ArtistId != nil {
+			ids = append(ids, *credit.ArtistId)
+		}
+	}
+
+	return ids
+}
+
+// toCredits lists the singer as the first primary artist, then the stored credits.
+// Credits stored without a name take it from the artists.
+func toCredits(stored []postgres.SongCredit, a artists) []Credit {
+	singer := a.Singer()
+	credits := make([]Credit, 0, len(stored)+1)
+	credits = append(credits, Credit{
+		ArtistId: &singer.Id,
+		Name:     singer.Name,
+		Role:     postgres.CreditRolePrimary,
+		Status:   postgres.CreditStatusApproved,
+	})
+
+	for _, credit := range stored {
+		out := Credit{ArtistId: nil, Name: credit.Name, Role: credit.Role, Status: credit.Status}
+
+		if credit.ArtistId != uuid.Nil {
+			id := credit.ArtistId
+			out.ArtistId = &id
+
+			if out.Name == "" {
+				idx := slices.IndexFunc(a.Artists(), func(artist users.Artist) bool {
+					return artist.Id == id
+				})
+				if idx != -1 {
+					out.Name = a.Artists()[idx].Name
+				}
+			}
+		}
+
+		credits = append(credits, out)
+	}
+
+	return credits
+}
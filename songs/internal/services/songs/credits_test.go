@@ -0,0 +1,208 @@
+package songs_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
+	songsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/events"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreditsSuite struct {
+	suite.Suite
+
+	sm *songsmocks.SongRepo
+	um *songsmocks.UserRepo
+	bm *songsmocks.Broker
+
+	s   *songs.Service
+	ctx context.Context
+}
+
+func (s *CreditsSuite) SetupTest() {
+	s.sm = songsmocks.NewSongRepo(s.T())
+	s.um = songsmocks.NewUserRepo(s.T())
+	s.bm = songsmocks.NewBroker(s.T())
+
+	s.s = songs.NewWithConfig(songs.Config{
+		Dependencies: songs.Dependencies{
+			SongRepo:   s.sm,
+			UserRepo:   s.um,
+			RawService: newFakeRawService(),
+			Broker:     s.bm,
+		},
+	})
+
+	s.ctx = context.Background()
+}
+
+func (s *CreditsSuite) TestCreateSong() {
+	producer := validArtist()
+	input := validCreateSongInput()
+	input.Credits = []songs.Credit{
+		{ArtistId: &producer.Id, Name: "", Role: postgres.CreditRoleProducer},
+		{ArtistId: nil, Name: " Jane Doe ", Role: postgres.CreditRoleComposer},
+		// Duplicates
+		{ArtistId: nil, Name: "jane doe", Role: postgres.CreditRoleComposer},
+		{ArtistId: &input.FeatArtists[0], Name: "", Role: postgres.CreditRoleFeatured},
+		// The singer is always the primary artist
+		{ArtistId: &input.SingerId, Name: "", Role: postgres.CreditRolePrimary},
+	}
+
+	s.um.EXPECT().ArtistsByIds(mock.Anything, []uuid.UUID{producer.Id}).
+		Return([]users.Artist{producer}, nil).Once()
+	s.sm.EXPECT().SaveSong(mock.Anything, mock.MatchedBy(func(params postgres.SaveSongParams) bool {
+		return slices.Equal(params.CreditsRoles, []string{"featured", "featured", "producer", "composer"}) &&
+			slices.Equal(params.CreditsNames, []string{"", "", producer.Name, "Jane Doe"}) &&
+			slices.Equal(params.CreditsStatuses, []string{"pending", "pending", "pending", "approved"}) &&
+			slices.Equal(params.CreditsArtistsIds,
+				[]uuid.UUID{input.FeatArtists[0], input.FeatArtists[1], producer.Id, uuid.Nil})
+	})).Return(nil).Once()
+	// The song is created and three artists are asked to approve their credits
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(msgs []broker.SongMessage) bool {
+		return len(msgs) == 4
+	})).Return(nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return(validArtists(3), nil).Once()
+
+	output, err := s.s.CreateSong(s.ctx, input)
+	s.NoError(err)
+	s.Len(output.Artists, 2)
+}
+
+func (s *CreditsSuite) TestCreateSong_InvalidCredit() {
+	input := validCreateSongInput()
+	input.Credits = []songs.Credit{{ArtistId: nil, Name: " ", Role: postgres.CreditRoleLyricist}}
+
+	_, err := s.s.CreateSong(s.ctx, input)
+	s.ErrorIs(err, songs.ErrInvalidCredit)
+}
+
+func (s *CreditsSuite) TestCreateSong_ArtistNotFound() {
+	input := validCreateSongInput()
+	input.Credits = []songs.Credit{
+		{ArtistId: ptr(uuid.New()), Name: "", Role: postgres.CreditRoleMixingEngineer},
+	}
+
+	s.um.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return([]users.Artist{}, nil).Once()
+
+	_, err := s.s.CreateSong(s.ctx, input)
+	s.ErrorIs(err, songs.ErrArtistsNotFound)
+}
+
+func (s *CreditsSuite) TestUpdateSong_ReplaceCredits() {
+	rows := validMySongsRows(1)
+	input := validUpdateSongInput()
+	input.Name = rows[0].Song.Name
+	input.ImageUrl = nil
+	input.Credits = &[]songs.Credit{{ArtistId: nil, Name: "Jane Doe", Role: postgres.CreditRoleLyricist}}
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+	s.sm.EXPECT().ReplaceCredits(mock.Anything, postgres.ReplaceCreditsParams{
+		SongID:            input.SongId,
+		CreditsArtistsIds: []uuid.UUID{uuid.Nil},
+		CreditsNames:      []string{"Jane Doe"},
+		CreditsRoles:      []string{"lyricist"},
+		CreditsStatuses:   []string{"approved"},
+	}).Return(nil).Once()
+	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(rows[0].Song, nil).Once()
+
+	_, err := s.s.UpdateSong(s.ctx, input)
+	s.NoError(err)
+}
+
+func (s *CreditsSuite) TestUpdateSong_KeepStatuses() {
+	rows := validMySongsRows(1)
+	approved, declined, added := uuid.New(), uuid.New(), validArtist()
+	rows[0].CreditsArtistsIds = []uuid.UUID{approved, declined}
+	rows[0].CreditsNames = []string{"", ""}
+	rows[0].CreditsRoles = []string{"featured", "producer"}
+	rows[0].CreditsStatuses = []string{"approved", "declined"}
+
+	input := validUpdateSongInput()
+	input.Name = rows[0].Song.Name
+	input.ImageUrl = nil
+	input.Credits = &[]songs.Credit{
+		{ArtistId: &approved, Name: "", Role: postgres.CreditRoleFeatured},
+		{ArtistId: &declined, Name: "", Role: postgres.CreditRoleProducer},
+		{ArtistId: &added.Id, Name: "", Role: postgres.CreditRoleComposer},
+	}
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).
+		Return([]users.Artist{validArtist(), validArtist(), added}, nil).Once()
+	s.sm.EXPECT().ReplaceCredits(mock.Anything, mock.MatchedBy(func(params postgres.ReplaceCreditsParams) bool {
+		return slices.Equal(params.CreditsStatuses, []string{"approved", "declined", "pending"})
+	})).Return(nil).Once()
+	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(rows[0].Song, nil).Once()
+	// Only the new artist is asked
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(msgs []broker.SongMessage) bool {
+		return len(msgs) == 1 && msgs[0].Type() == events.TypeCreditRequested
+	})).Return(nil).Once()
+
+	_, err := s.s.UpdateSong(s.ctx, input)
+	s.NoError(err)
+}
+
+func (s *CreditsSuite) TestGetSong() {
+	row := validSongRow()
+	featured, singer := validArtist(), validArtist()
+	row.ArtistsIds = []uuid.UUID{featured.Id}
+	row.CreditsArtistsIds = []uuid.UUID{featured.Id, uuid.Nil}
+	row.CreditsNames = []string{"", "Jane Doe"}
+	row.CreditsRoles = []string{"featured", "producer"}
+	row.CreditsStatuses = []string{"approved", "approved"}
+
+	s.sm.EXPECT().Song(mock.Anything, mock.Anything).Return(row, nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return([]users.Artist{featured, singer}, nil).Once()
+
+	output, err := s.s.GetSong(s.ctx, validGetSongInput())
+	s.Require().NoError(err)
+	s.Equal([]songs.Credit{
+		{ArtistId: &singer.Id, Name: singer.Name, Role: postgres.CreditRolePrimary, Status: postgres.CreditStatusApproved},
+		{ArtistId: &featured.Id, Name: featured.Name, Role: postgres.CreditRoleFeatured, Status: postgres.CreditStatusApproved},
+		{ArtistId: nil, Name: "Jane Doe", Role: postgres.CreditRoleProducer, Status: postgres.CreditStatusApproved},
+	}, output.Credits)
+}
+
+func (s *CreditsSuite) TestGetSongs_CreditFilter() {
+	input := validGetSongsInput()
+	input.Ids = nil
+	input.Credit = &songs.CreditFilter{
+		ArtistId:  nil,
+		MatchName: ptr("jane"),
+		Role:      ptr(postgres.CreditRoleProducer),
+	}
+
+	s.sm.EXPECT().ReleasedSongs(mock.Anything, mock.MatchedBy(func(params postgres.ReleasedSongsParams) bool {
+		return params.ByCredit && params.CreditByName && params.CreditName == "jane" &&
+			params.CreditByRole && params.CreditRole == "producer"
+	})).Return(validReleasedSongsRows(1), nil).Once()
+	s.sm.EXPECT().CountSongsWithCredit(mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return(validArtists(3), nil).Once()
+
+	output, err := s.s.GetSongs(s.ctx, input)
+	s.NoError(err)
+	s.Len(output.Songs, 1)
+	s.Equal(int32(1), output.LastPage)
+}
+
+func (s *CreditsSuite) TestGetSongs_MultipleFilters() {
+	input := validGetSongsInput()
+	input.Credit = &songs.CreditFilter{ArtistId: ptr(uuid.New()), MatchName: nil, Role: nil}
+
+	_, err := s.s.GetSongs(s.ctx, input)
+	s.ErrorIs(err, songs.ErrMultipleFilters)
+}
+
+func TestCredits(t *testing.T) {
+	suite.Run(t, new(CreditsSuite))
+}
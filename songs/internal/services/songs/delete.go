@@ -0,0 +1,81 @@
+package songs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"github.com/google/uuid"
+)
+
+type DeleteSongsInput struct {
+	UserId   uuid.UUID
+	SongsIds []uuid.UUID
+}
+
+type DeleteSongsOutput struct {
+}
+
+// DeleteSongs moves the songs of the user to the trash, they can be restored during the restore window.
+// Songs of other artists are ignored, if the user has none of the songs, ErrSongNotFound is returned.
+func (s *Service) DeleteSongs(ctx context.Context, in DeleteSongsInput) (DeleteSongsOutput, error) {
+	var (
+		null = DeleteSongsOutput{}
+		log  = logger.FromContext(ctx)
+	)
+
+	log.Debug().Array("songs_ids", logger.Stringers[uuid.UUID](in.SongsIds)).Msg("getting my songs")
+
+	songs, err := s.songRepo.MySongs(ctx, postgres.MySongsParams{
+		SingerID: in.UserId,
+		ByIds:    true,
+		Ids:      in.SongsIds,
+		Limitv:   int32(len(in.SongsIds)), //nolint:gosec
+		Offsetv:  0,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult) || (len(songs) == 0 && err == nil):
+		return null, ErrSongNotFound
+
+	case err != nil:
+		return null, e.NewFrom("getting my songs", err)
+	}
+
+	ids := make([]uuid.UUID, len(songs))
+	for i := range songs {
+		ids[i] = songs[i].Song.SongID
+	}
+
+	log.Debug().Array("songs_ids", logger.Stringers[uuid.UUID](ids)).Msg("trashing songs")
+
+	now := time.Now()
+
+	err = s.songRepo.TrashSongs(ctx, postgres.TrashSongsParams{
+		DeletedAt: pgconv.Timestamptz(now),
+		Ids:       ids,
+	})
+	if err != nil {
+		return null, e.NewFrom("trashing songs", err)
+	}
+
+	messages := make([]broker.SongMessage, len(songs))
+	for i := range songs {
+		messages[i] = broker.SongDeletedMessage{
+			SongId:    songs[i].Song.SongID,
+			ArtistId:  songs[i].Song.SingerFk,
+			DeletedAt: now,
+		}
+	}
+
+	broker.SendOrLog(ctx, s.messageBroker, messages...)
+
+	return null, nil
+}
@@ -0,0 +1,94 @@
+package songs_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	songsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeleteSongsSuite struct {
+	suite.Suite
+
+	sm *songsmocks.SongRepo
+	bm *songsmocks.Broker
+
+	s     *songs.Service
+	ctx   context.Context
+	input songs.DeleteSongsInput
+}
+
+func (s *DeleteSongsSuite) SetupTest() {
+	s.sm = songsmocks.NewSongRepo(s.T())
+	s.bm = songsmocks.NewBroker(s.T())
+
+	s.s = songs.NewWithConfig(songs.Config{
+		Dependencies: songs.Dependencies{
+			SongRepo: s.sm,
+			Broker:   s.bm,
+		},
+	})
+
+	s.ctx = context.Background()
+	s.input = validDeleteSongsInput()
+}
+
+func (s *DeleteSongsSuite) TestHappyPath() {
+	rows := validMySongsRows(2)
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+	s.sm.EXPECT().TrashSongs(mock.Anything, mock.MatchedBy(func(p postgres.TrashSongsParams) bool {
+		return slices.Equal(p.Ids, []uuid.UUID{rows[0].Song.SongID, rows[1].Song.SongID}) && p.DeletedAt.Valid
+	})).Return(nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(msgs []broker.SongMessage) bool {
+		return len(msgs) == 2
+	})).Return(nil).Once()
+
+	_, err := s.s.DeleteSongs(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *DeleteSongsSuite) TestMySongs_EmptyResultError() {
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(nil, repoerrs.ErrEmptyResult).Once()
+
+	_, err := s.s.DeleteSongs(s.ctx, s.input)
+	s.ErrorIs(err, songs.ErrSongNotFound)
+}
+
+func (s *DeleteSongsSuite) TestTrashSongsError() {
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(validMySongsRows(2), nil).Once()
+	s.sm.EXPECT().TrashSongs(mock.Anything, mock.Anything).Return(gofakeit.ErrorDatabase()).Once()
+
+	_, err := s.s.DeleteSongs(s.ctx, s.input)
+	s.Error(err)
+}
+
+func (s *DeleteSongsSuite) TestBrokerError() {
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(validMySongsRows(2), nil).Once()
+	s.sm.EXPECT().TrashSongs(mock.Anything, mock.Anything).Return(nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(gofakeit.Error()).Once()
+
+	_, err := s.s.DeleteSongs(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func validDeleteSongsInput() songs.DeleteSongsInput {
+	return songs.DeleteSongsInput{
+		UserId:   uuid.New(),
+		SongsIds: []uuid.UUID{uuid.New(), uuid.New()},
+	}
+}
+
+func TestDeleteSongs(t *testing.T) {
+	suite.Run(t, new(DeleteSongsSuite))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/regions"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
 
 	"dev.gaijin.team/go/golib/e"
@@ -25,6 +26,10 @@ var (
 
 type GetSongInput struct {
 	Id uuid.UUID
+	// Caller's country, empty if unknown
+	Country string
+	// Caller's id the links are signed for, empty for anonymous callers
+	ListenerId string
 }
 
 type GetSongOutput struct {
@@ -33,11 +38,18 @@ type GetSongOutput struct {
 	Artists     []users.Artist
 	Name        string
 	SongUrl     string
+	PreviewUrl  string
 	ImageUrl    *string
 	Duration    time.Duration
 	WeightBytes int32
 	UploadedAt  time.Time
 	ReleasedAt  *time.Time
+	Explicit    bool
+	// Restricted in the caller's country, SongUrl and PreviewUrl are empty then.
+	// SongUrl is empty for anonymous callers too, they get the preview.
+	Unavailable bool
+	// The singer is the first primary artist
+	Credits []Credit
 }
 
 func (s *Service) GetSong(ctx context.Context, input GetSongInput) (GetSongOutput, error) {
@@ -66,17 +78,25 @@ func (s *Service) GetSong(ctx context.Context, input GetSongInput) (GetSongOutpu
 		return null, err
 	}
 
+	available := regions.Available(song.Song.AllowedCountries, song.Song.DeniedCountries, input.Country)
+
+	songUrl, previewUrl := s.streamUrls(song.Song, available, input.ListenerId)
+
 	return GetSongOutput{
 		Id:          song.Song.SongID,
 		Singer:      artists.Singer(),
 		Artists:     artists.Artists(),
 		Name:        song.Song.Name,
-		SongUrl:     song.Song.S3ObjectName.String,
-		ImageUrl:    pgconv.FromText(song.Song.ImageUrl),
+		SongUrl:     songUrl,
+		PreviewUrl:  previewUrl,
+		ImageUrl:    s.signImageUrl(song.Song.ImageUrl, input.ListenerId),
 		Duration:    *pgconv.FromInterval(song.Song.Duration),
 		WeightBytes: *pgconv.FromInt4(song.Song.WeightBytes),
 		UploadedAt:  song.Song.UploadedAt,
 		ReleasedAt:  pgconv.FromTimestamptz(song.Song.ReleasedAt),
+		Explicit:    song.Song.Explicit,
+		Unavailable: !available,
+		Credits:     toCredits(song.GetCredits(), artists),
 	}, nil
 }
 
@@ -85,6 +105,13 @@ type GetSongsInput struct {
 	MatchArtist *string
 	MatchName   *string
 	Ids         []uuid.UUID
+	Credit      *CreditFilter
+	// Explicit songs are skipped for listeners who hide them
+	HideExplicit bool
+	// Caller's country, empty if unknown
+	Country string
+	// Caller's id the links are signed for, empty for anonymous callers
+	ListenerId string
 	// pagination
 	Page     int32
 	PageSize int32
@@ -96,11 +123,18 @@ type Song struct {
 	Artists     []users.Artist
 	Name        string
 	SongUrl     string
+	PreviewUrl  string
 	ImageUrl    *string
 	Duration    time.Duration
 	WeightBytes int32
 	UploadedAt  time.Time
 	ReleasedAt  time.Time
+	Explicit    bool
+	// Restricted in the caller's country, SongUrl and PreviewUrl are empty then.
+	// SongUrl is empty for anonymous callers too, they get the preview.
+	Unavailable bool
+	// The singer is the first primary artist
+	Credits []Credit
 }
 
 type GetSongsOutput struct {
@@ -130,17 +164,19 @@ func (s *Service) GetSongs(ctx context.Context, input GetSongsInput) (GetSongsOu
 
 	switch {
 	case len(input.Ids) > 0:
-		rows, err = s.getSongsWithIds(ctx, input.Ids)
+		rows, err = s.getSongsWithIds(ctx, input.Ids, input.HideExplicit)
 
 	case input.MatchName != nil && len(*input.MatchName) > 0:
-		rows, err = s.getSongsMatchName(ctx, *input.MatchName, input.Page, input.PageSize)
+		rows, err = s.getSongsMatchName(ctx, *input.MatchName, input.HideExplicit, input.Page, input.PageSize)
 
 	case input.ArtistId != nil:
-		rows, err = s.getSongsWithArtistId(ctx, *input.ArtistId, input.Page, input.PageSize)
+		rows, err = s.getSongsWithArtistId(ctx, *input.ArtistId, input.HideExplicit, input.Page, input.PageSize)
 
 	case input.MatchArtist != nil:
-		rows, err = s.getSongsMatchArtist(ctx, *input.MatchArtist, input.Page, input.PageSize)
+		rows, err = s.getSongsMatchArtist(ctx, *input.MatchArtist, input.HideExplicit, input.Page, input.PageSize)
 
+	case input.Credit.valid():
+		rows, err = s.getSongsWithCredit(ctx, *input.Credit, input.HideExplicit, input.Page, input.PageSize)
 	}
 
 	if err != nil {
@@ -149,17 +185,25 @@ func (s *Service) GetSongs(ctx context.Context, input GetSongsInput) (GetSongsOu
 
 	songsCh := artistsOrderedFanOut(ctx, rows.Rows, s,
 		func(row postgres.ReleasedSongsRow, a artists) Song {
+			available := regions.Available(row.Song.AllowedCountries, row.Song.DeniedCountries, input.Country)
+
+			songUrl, previewUrl := s.streamUrls(row.Song, available, input.ListenerId)
+
 			return Song{
 				Id:          row.Song.SongID,
 				Singer:      a.Singer(),
 				Artists:     a.Artists(),
 				Name:        row.Song.Name,
-				SongUrl:     s.rawService.SongUrl(row.Song.S3ObjectName.String),
-				ImageUrl:    pgconv.FromText(row.Song.ImageUrl),
+				SongUrl:     songUrl,
+				PreviewUrl:  previewUrl,
+				ImageUrl:    s.signImageUrl(row.Song.ImageUrl, input.ListenerId),
 				Duration:    pointer.Get(pgconv.FromInterval(row.Song.Duration)),
 				WeightBytes: row.Song.WeightBytes.Int32,
 				UploadedAt:  row.Song.UploadedAt,
 				ReleasedAt:  row.Song.ReleasedAt.Time,
+				Explicit:    row.Song.Explicit,
+				Unavailable: !available,
+				Credits:     toCredits(row.GetCredits(), a),
 			}
 		},
 	)
@@ -182,7 +226,8 @@ func checkFiltersCount(input GetSongsInput) error {
 	filtersSum := bit(input.ArtistId != nil) +
 		bit(input.MatchArtist != nil) +
 		bit(input.MatchName != nil && len(*input.MatchName) > 0) +
-		bit(len(input.Ids) > 0)
+		bit(len(input.Ids) > 0) +
+		bit(input.Credit.valid())
 	if filtersSum == 0 {
 		return ErrNoFilters
 	} else if filtersSum > 1 {
@@ -200,6 +245,7 @@ type paginatedRows[T any] struct {
 
 func (s *Service) getSongsWithArtistId(ctx context.Context,
 	artistId uuid.UUID,
+	hideExplicit bool,
 	page, pageSize int32,
 ) (paginatedRows[postgres.ReleasedSongsRow], error) {
 	var (
@@ -208,11 +254,12 @@ func (s *Service) getSongsWithArtistId(ctx context.Context,
 	)
 
 	params := postgres.ReleasedSongsParams{ //nolint:exhaustruct
-		BySinger:   false,
-		WithArtist: true,
-		SingersIds: []uuid.UUID{artistId},
-		Limitv:     pageSize,
-		Offsetv:    (page - 1) * pageSize,
+		BySinger:     false,
+		WithArtist:   true,
+		SingersIds:   []uuid.UUID{artistId},
+		HideExplicit: hideExplicit,
+		Limitv:       pageSize,
+		Offsetv:      (page - 1) * pageSize,
 	}
 
 	log.Debug().
@@ -237,6 +284,7 @@ func (s *Service) getSongsWithArtistId(ctx context.Context,
 
 func (s *Service) getSongsMatchArtist(ctx context.Context,
 	name string,
+	hideExplicit bool,
 	page, pageSize int32,
 ) (paginatedRows[postgres.ReleasedSongsRow], error) {
 	var (
@@ -269,10 +317,11 @@ func (s *Service) getSongsMatchArtist(ctx context.Context,
 		Msg("got artists by name")
 
 	params := postgres.ReleasedSongsParams{ //nolint:exhaustruct
-		WithArtist: true,
-		SingersIds: artistsIds,
-		Limitv:     pageSize,
-		Offsetv:    (page - 1) * pageSize,
+		WithArtist:   true,
+		SingersIds:   artistsIds,
+		HideExplicit: hideExplicit,
+		Limitv:       pageSize,
+		Offsetv:      (page - 1) * pageSize,
 	}
 
 	log.Debug().Msg("getting songs by artist ids")
@@ -295,6 +344,7 @@ func (s *Service) getSongsMatchArtist(ctx context.Context,
 
 func (s *Service) getSongsMatchName(ctx context.Context,
 	name string,
+	hideExplicit bool,
 	page, pageSize int32,
 ) (paginatedRows[postgres.ReleasedSongsRow], error) {
 	var (
@@ -303,10 +353,11 @@ func (s *Service) getSongsMatchName(ctx context.Context,
 	)
 
 	params := postgres.ReleasedSongsParams{ //nolint:exhaustruct
-		ByName:    true,
-		MatchName: name,
-		Limitv:    pageSize,
-		Offsetv:   (page - 1) * pageSize,
+		ByName:       true,
+		MatchName:    name,
+		HideExplicit: hideExplicit,
+		Limitv:       pageSize,
+		Offsetv:      (page - 1) * pageSize,
 	}
 
 	log.Debug().
@@ -329,8 +380,65 @@ func (s *Service) getSongsMatchName(ctx context.Context,
 	}, nil
 }
 
+func (s *Service) getSongsWithCredit(ctx context.Context,
+	filter CreditFilter,
+	hideExplicit bool,
+	page, pageSize int32,
+) (paginatedRows[postgres.ReleasedSongsRow], error) {
+	var (
+		null = paginatedRows[postgres.ReleasedSongsRow]{Rows: []postgres.ReleasedSongsRow{}, LastPage: 0}
+		log  = logger.FromContext(ctx)
+	)
+
+	countParams := postgres.CountSongsWithCreditParams{ //nolint:exhaustruct
+		CreditByName: filter.ArtistId == nil,
+		CreditByRole: filter.Role != nil,
+	}
+	if filter.ArtistId != nil {
+		countParams.CreditArtistID = *filter.ArtistId
+	} else {
+		countParams.CreditName = *filter.MatchName
+	}
+
+	if filter.Role != nil {
+		countParams.CreditRole = string(*filter.Role)
+	}
+
+	params := postgres.ReleasedSongsParams{ //nolint:exhaustruct
+		ByCredit:       true,
+		CreditByName:   countParams.CreditByName,
+		CreditArtistID: countParams.CreditArtistID,
+		CreditName:     countParams.CreditName,
+		CreditByRole:   countParams.CreditByRole,
+		CreditRole:     countParams.CreditRole,
+		HideExplicit:   hideExplicit,
+		Limitv:         pageSize,
+		Offsetv:        (page - 1) * pageSize,
+	}
+
+	log.Debug().
+		Interface("filter", filter).
+		Msg("getting songs by credit")
+
+	rows, err := s.releasedSongsFromRepo(ctx, params)
+	if err != nil {
+		return null, err
+	}
+
+	songsCount, err := s.songRepo.CountSongsWithCredit(ctx, countParams)
+	if err != nil {
+		return null, e.NewFrom("getting songs count", err)
+	}
+
+	return paginatedRows[postgres.ReleasedSongsRow]{
+		Rows:     rows,
+		LastPage: (songsCount-1)/pageSize + 1,
+	}, nil
+}
+
 func (s *Service) getSongsWithIds(ctx context.Context,
 	ids []uuid.UUID,
+	hideExplicit bool,
 ) (paginatedRows[postgres.ReleasedSongsRow], error) {
 	var (
 		null = paginatedRows[postgres.ReleasedSongsRow]{Rows: []postgres.ReleasedSongsRow{}, LastPage: 0}
@@ -338,10 +446,11 @@ func (s *Service) getSongsWithIds(ctx context.Context,
 	)
 
 	params := postgres.ReleasedSongsParams{ //nolint:exhaustruct
-		ByIds:   true,
-		Ids:     ids,
-		Limitv:  int32(len(ids)), //nolint:gosec
-		Offsetv: 0,
+		ByIds:        true,
+		Ids:          ids,
+		HideExplicit: hideExplicit,
+		Limitv:       int32(len(ids)), //nolint:gosec
+		Offsetv:      0,
 	}
 
 	log.Debug().
@@ -369,17 +478,47 @@ type GetMySongsInput struct {
 }
 
 type MySong struct {
-	Id          uuid.UUID
-	Singer      users.Artist
-	Artists     []users.Artist
-	Name        string
-	SongUrl     *string
-	ImageUrl    *string
-	Duration    *time.Duration
-	WeightBytes *int32
-	UploadedAt  time.Time
-	ReleasedAt  *time.Time
+	Id       uuid.UUID
+	Singer   users.Artist
+	Artists  []users.Artist
+	Name     string
+	SongUrl  *string
+	ImageUrl *string
+	// Nil until the thumbnail of the hosted image is made
+	ThumbnailUrl *string
+	Duration     *time.Duration
+	WeightBytes  *int32
+	UploadedAt   time.Time
+	ReleasedAt   *time.Time
+
+	ModerationStatus postgres.ModerationStatus
+	ModerationReason *string
+	Explicit         bool
+	AllowedCountries []string
+	DeniedCountries  []string
+	// The singer is the first primary artist
+	Credits []Credit
+	// Nil until the file is uploaded
+	Audio *AudioInfo
+	// The song has a link once its audio is ready
+	AudioState postgres.AudioState
+	// Why the last upload failed
+	AudioError *string
 }
+
+// AudioInfo is the technical metadata of the uploaded file.
+type AudioInfo struct {
+	// Bits per second, the average one for VBR
+	Bitrate     int32
+	BitrateMode postgres.BitrateMode
+	SampleRate  int32
+	ChannelMode postgres.ChannelMode
+	// Nil if the file has no LAME tag
+	Encoder *string
+	// Levels of equal parts of the song from 0 to audiodecoder.WaveformMax, empty if not made
+	Waveform []int32
+}
+
 type GetMySongsOutput struct {
 	Songs    []MySong
 	LastPage int32
@@ -431,24 +570,7 @@ func (s *Service) GetMySongs(ctx context.Context, input GetMySongsInput) (GetMyS
 
 	songsCh := artistsOrderedFanOut(ctx, songs, s,
 		func(row postgres.MySongsRow, a artists) MySong {
-			songUrl := pgconv.FromText(row.Song.S3ObjectName)
-			if songUrl != nil {
-				songUrlAboba := s.rawService.SongUrl(*songUrl)
-				songUrl = &songUrlAboba
-			}
-
-			return MySong{
-				Id:          row.Song.SongID,
-				Singer:      a.Singer(),
-				Artists:     a.Artists(),
-				Name:        row.Song.Name,
-				SongUrl:     songUrl,
-				ImageUrl:    pgconv.FromText(row.Song.ImageUrl),
-				Duration:    pgconv.FromInterval(row.Song.Duration),
-				WeightBytes: pgconv.FromInt4(row.Song.WeightBytes),
-				UploadedAt:  row.Song.UploadedAt,
-				ReleasedAt:  pgconv.FromTimestamptz(row.Song.ReleasedAt),
-			}
+			return s.toMySong(row.Song, row.GetCredits(), a)
 		},
 	)
 
@@ -465,3 +587,63 @@ func (s *Service) GetMySongs(ctx context.Context, input GetMySongsInput) (GetMyS
 		LastPage: (songsCount-1)/input.PageSize + 1,
 	}, nil
 }
+
+// toMySong signs the links for the singer, they are the only one seeing their unreleased songs.
+func (s *Service) toMySong(song postgres.Song, credits []postgres.SongCredit, a artists) MySong {
+	singerId := song.SingerFk.String()
+
+	var songUrl *string
+	if song.AudioState == postgres.AudioStateReady {
+		songUrl = pointer.To(s.rawService.SongUrl(song.S3ObjectName.String, singerId))
+	}
+
+	return MySong{
+		Id:           song.SongID,
+		Singer:       a.Singer(),
+		Artists:      a.Artists(),
+		Name:         song.Name,
+		SongUrl:      songUrl,
+		ImageUrl:     s.signImageUrl(song.ImageUrl, singerId),
+		ThumbnailUrl: s.signThumbnailUrl(song, singerId),
+		Duration:     pgconv.FromInterval(song.Duration),
+		WeightBytes:  pgconv.FromInt4(song.WeightBytes),
+		UploadedAt:   song.UploadedAt,
+		ReleasedAt:   pgconv.FromTimestamptz(song.ReleasedAt),
+
+		ModerationStatus: song.ModerationStatus,
+		ModerationReason: pgconv.FromText(song.ModerationReason),
+		Explicit:         song.Explicit,
+		AllowedCountries: song.AllowedCountries,
+		DeniedCountries:  song.DeniedCountries,
+		Credits:          toCredits(credits, a),
+		Audio:            toAudioInfo(song),
+		AudioState:       song.AudioState,
+		AudioError:       pgconv.FromText(song.AudioError),
+	}
+}
+
+// toAudioInfo returns nil for songs uploaded before the technical metadata was stored.
+func toAudioInfo(song postgres.Song) *AudioInfo {
+	if !song.Bitrate.Valid || !song.BitrateMode.Valid || !song.ChannelMode.Valid {
+		return nil
+	}
+
+	var encoder *string
+	if song.Encoder.String != "" {
+		encoder = &song.Encoder.String
+	}
+
+	waveform := make([]int32, len(song.Waveform))
+	for i, level := range song.Waveform {
+		waveform[i] = int32(level)
+	}
+
+	return &AudioInfo{
+		Bitrate:     song.Bitrate.Int32,
+		BitrateMode: song.BitrateMode.BitrateMode,
+		SampleRate:  song.SampleRate.Int32,
+		ChannelMode: song.ChannelMode.ChannelMode,
+		Encoder:     encoder,
+		Waveform:    waveform,
+	}
+}
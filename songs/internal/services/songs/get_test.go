@@ -53,6 +53,32 @@ func (s *GetSongSuite) TestHappyPath() {
 	s.NoError(err)
 }
 
+func (s *GetSongSuite) TestLinksSignedForListener() {
+	row := validSongRow()
+	s.input.ListenerId = uuid.NewString()
+
+	s.sm.EXPECT().Song(mock.Anything, s.input.Id).Return(row, nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return(validArtists(2), nil).Once()
+
+	out, err := s.s.GetSong(s.ctx, s.input)
+	s.Require().NoError(err)
+	s.Equal("https://songs/"+row.Song.S3ObjectName.String+"?listener="+s.input.ListenerId, out.SongUrl)
+	s.Equal(row.Song.ImageUrl.String+"?listener="+s.input.ListenerId, *out.ImageUrl)
+	s.Equal("https://songs/"+row.Song.SongID.String()+"/preview", out.PreviewUrl)
+}
+
+func (s *GetSongSuite) TestAnonymousGetsPreview() {
+	row := validSongRow()
+
+	s.sm.EXPECT().Song(mock.Anything, s.input.Id).Return(row, nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return(validArtists(2), nil).Once()
+
+	out, err := s.s.GetSong(s.ctx, s.input)
+	s.Require().NoError(err)
+	s.Empty(out.SongUrl)
+	s.Equal("https://songs/"+row.Song.SongID.String()+"/preview", out.PreviewUrl)
+}
+
 func (s *GetSongSuite) TestSongRepoError() {
 	s.sm.EXPECT().Song(mock.Anything, s.input.Id).Return(postgres.SongRow{}, gofakeit.ErrorDatabase()).Once()
 
@@ -93,6 +119,7 @@ func validSongRow() postgres.SongRow {
 			WeightBytes:  pgconv.Int4(1024),
 			UploadedAt:   gofakeit.Date(),
 			ReleasedAt:   pgconv.Timestamptz(gofakeit.Date()),
+			AudioState:   postgres.AudioStateReady,
 		},
 		ArtistsIds: []uuid.UUID{
 			uuid.New(),
@@ -336,6 +363,55 @@ func (s *GetMySongsSuite) TestHappyPath_ByIds() {
 	s.NotEmpty(output)
 }
 
+func (s *GetMySongsSuite) TestAudioInfo() {
+	rows := validSongRows(2)
+	rows[0].Song.Bitrate = pgconv.Int4(192000)
+	rows[0].Song.BitrateMode = postgres.NullBitrateMode{BitrateMode: postgres.BitrateModeVbr, Valid: true}
+	rows[0].Song.SampleRate = pgconv.Int4(48000)
+	rows[0].Song.ChannelMode = postgres.NullChannelMode{ChannelMode: postgres.ChannelModeMono, Valid: true}
+	rows[0].Song.Encoder = pgconv.Text("")
+	rows[0].Song.Waveform = []int16{100, 50, 0}
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+	s.sm.EXPECT().CountMySongs(mock.Anything, s.input.UserId).Return(int32(2), nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return(validArtists(2), nil).Times(2)
+
+	output, err := s.s.GetMySongs(s.ctx, s.input)
+	s.Require().NoError(err)
+	s.Require().Len(output.Songs, 2)
+	s.Equal(&songs.AudioInfo{
+		Bitrate:     192000,
+		BitrateMode: postgres.BitrateModeVbr,
+		SampleRate:  48000,
+		ChannelMode: postgres.ChannelModeMono,
+		Encoder:     nil,
+		Waveform:    []int32{100, 50, 0},
+	}, output.Songs[0].Audio)
+	s.Nil(output.Songs[1].Audio)
+}
+
+func (s *GetMySongsSuite) TestThumbnailUrl() {
+	rows := validSongRows(3)
+	rows[0].Song.ImageUrl = pgconv.Text("https://images/a")
+	rows[0].Song.ThumbnailUrl = pgconv.Text("https://images/a/thumbnail")
+	// The image was replaced after the thumbnail was made
+	rows[1].Song.ImageUrl = pgconv.Text("https://images/b")
+	rows[1].Song.ThumbnailUrl = pgconv.Text("https://images/a/thumbnail")
+	rows[2].Song.ImageUrl = pgconv.Text("https://images/c")
+	rows[2].Song.ThumbnailUrl = pgconv.NullText()
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+	s.sm.EXPECT().CountMySongs(mock.Anything, s.input.UserId).Return(int32(3), nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return(validArtists(2), nil).Times(3)
+
+	output, err := s.s.GetMySongs(s.ctx, s.input)
+	s.Require().NoError(err)
+	s.Require().Len(output.Songs, 3)
+	s.Equal(ptr("https://images/a/thumbnail?listener="+rows[0].Song.SingerFk.String()), output.Songs[0].ThumbnailUrl)
+	s.Nil(output.Songs[1].ThumbnailUrl)
+	s.Nil(output.Songs[2].ThumbnailUrl)
+}
+
 func (s *GetMySongsSuite) TestSongRepo_EmptyResultError() {
 	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(nil, repoerrs.ErrEmptyResult).Once()
 
@@ -416,6 +492,23 @@ func newFakeRawService() fakeRawService {
 	return fakeRawService{}
 }
 
-func (fakeRawService) SongUrl(rawSongId string) string {
-	return gofakeit.URL()
+func (fakeRawService) SongUrl(rawSongId, listenerId string) string {
+	return "https://songs/" + rawSongId + "?listener=" + listenerId
+}
+
+func (fakeRawService) SignImageUrl(imageUrl, listenerId string) string {
+	return imageUrl + "?listener=" + listenerId
+}
+
+// Thumbnails of the images are at their urls with a suffix
+func (fakeRawService) SignThumbnailUrl(thumbnailUrl, imageUrl, listenerId string) string {
+	if thumbnailUrl != imageUrl+"/thumbnail" {
+		return ""
+	}
+
+	return thumbnailUrl + "?listener=" + listenerId
+}
+
+func (fakeRawService) PreviewUrl(songId uuid.UUID) string {
+	return "https://songs/" + songId.String() + "/preview"
 }
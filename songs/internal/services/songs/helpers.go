@@ -13,7 +13,9 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
 
 	"dev.gaijin.team/go/golib/e"
+	"github.com/AlekSi/pointer"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type artists []users.Artist
@@ -58,6 +60,47 @@ func (s *Service) artistsMatchingName(ctx context.Context, name string) ([]users
 	return artists, nil
 }
 
+// streamUrls returns the links to the song and to the preview of the released song,
+// anonymous listeners get the preview only.
+func (s *Service) streamUrls(song postgres.Song, available bool, listenerId string) (string, string) {
+	if !available || song.AudioState != postgres.AudioStateReady {
+		return "", ""
+	}
+
+	previewUrl := ""
+	if song.ReleasedAt.Valid {
+		previewUrl = s.rawService.PreviewUrl(song.SongID)
+	}
+
+	if listenerId == "" {
+		return "", previewUrl
+	}
+
+	return s.rawService.SongUrl(song.S3ObjectName.String, listenerId), previewUrl
+}
+
+func (s *Service) signImageUrl(imageUrl pgtype.Text, listenerId string) *string {
+	if !imageUrl.Valid {
+		return nil
+	}
+
+	return pointer.To(s.rawService.SignImageUrl(imageUrl.String, listenerId))
+}
+
+// signThumbnailUrl returns nil if the song has no thumbnail of its current image.
+func (s *Service) signThumbnailUrl(song postgres.Song, listenerId string) *string {
+	if !song.ThumbnailUrl.Valid || !song.ImageUrl.Valid {
+		return nil
+	}
+
+	thumbnailUrl := s.rawService.SignThumbnailUrl(song.ThumbnailUrl.String, song.ImageUrl.String, listenerId)
+	if thumbnailUrl == "" {
+		return nil
+	}
+
+	return &thumbnailUrl
+}
+
 func (s *Service) releasedSongsFromRepo(ctx context.Context,
 	params postgres.ReleasedSongsParams,
 ) ([]postgres.ReleasedSongsRow, error) {
@@ -0,0 +1,69 @@
+package songs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+type ModerateSongInput struct {
+	ModeratorId uuid.UUID
+	SongId      uuid.UUID
+	Status      postgres.ModerationStatus
+	Reason      *string
+}
+
+type ModerateSongOutput struct {
+}
+
+// ModerateSong changes the moderation status of any song.
+// Taken down songs are hidden from everyone but their artist.
+func (s *Service) ModerateSong(ctx context.Context, in ModerateSongInput) (ModerateSongOutput, error) {
+	var (
+		null = ModerateSongOutput{}
+		log  = logger.FromContext(ctx)
+	)
+
+	log.Info().
+		Stringer("song_id", in.SongId).Stringer("moderator_id", in.ModeratorId).
+		Str("status", string(in.Status)).
+		Msg("moderating song")
+
+	moderatedAt := time.Now()
+
+	song, err := s.songRepo.ModerateSong(ctx, postgres.ModerateSongParams{
+		ModerationStatus: in.Status,
+		ModerationReason: pgconv.TextPtr(in.Reason),
+		ModeratorID:      pgconv.UUID(in.ModeratorId),
+		ModeratedAt:      pgconv.Timestamptz(moderatedAt),
+		SongID:           in.SongId,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return null, ErrSongNotFound.Wrap(err, fields.F("song_id", in.SongId))
+
+	case err != nil:
+		return null, e.NewFrom("moderating song", err, fields.F("song_id", in.SongId))
+	}
+
+	broker.SendOrLog(ctx, s.messageBroker, broker.SongModeratedMessage{
+		SongId:      song.SongID,
+		ArtistId:    song.SingerFk,
+		Status:      song.ModerationStatus,
+		Reason:      in.Reason,
+		ModeratedAt: moderatedAt,
+	})
+
+	return null, nil
+}
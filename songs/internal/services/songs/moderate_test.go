@@ -0,0 +1,85 @@
+package songs_test
+
+import (
+	"context"
+	"testing"
+
+	songsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModerateSongSuite struct {
+	suite.Suite
+
+	sm *songsmocks.SongRepo
+	bm *songsmocks.Broker
+
+	s     *songs.Service
+	ctx   context.Context
+	input songs.ModerateSongInput
+}
+
+func (s *ModerateSongSuite) SetupTest() {
+	s.sm = songsmocks.NewSongRepo(s.T())
+	s.bm = songsmocks.NewBroker(s.T())
+
+	s.s = songs.NewWithConfig(songs.Config{
+		Dependencies: songs.Dependencies{
+			SongRepo: s.sm,
+			Broker:   s.bm,
+		},
+	})
+
+	s.ctx = context.Background()
+	s.input = validModerateSongInput()
+}
+
+func (s *ModerateSongSuite) TestHappyPath() {
+	song := validSongsRow().Song
+	song.ModerationStatus = s.input.Status
+
+	s.sm.EXPECT().ModerateSong(mock.Anything, mock.MatchedBy(func(p postgres.ModerateSongParams) bool {
+		return p.SongID == s.input.SongId && p.ModerationStatus == s.input.Status &&
+			p.ModeratorID.Bytes == s.input.ModeratorId
+	})).Return(song, nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.ModerateSong(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *ModerateSongSuite) TestModerateSong_EmptyResultError() {
+	s.sm.EXPECT().ModerateSong(mock.Anything, mock.Anything).Return(postgres.Song{}, repoerrs.ErrEmptyResult).Once()
+
+	_, err := s.s.ModerateSong(s.ctx, s.input)
+	s.ErrorIs(err, songs.ErrSongNotFound)
+}
+
+func (s *ModerateSongSuite) TestModerateSongError() {
+	s.sm.EXPECT().ModerateSong(mock.Anything, mock.Anything).Return(postgres.Song{}, gofakeit.ErrorDatabase()).Once()
+
+	_, err := s.s.ModerateSong(s.ctx, s.input)
+	s.Error(err)
+}
+
+func validModerateSongInput() songs.ModerateSongInput {
+	reason := gofakeit.Sentence(5)
+
+	return songs.ModerateSongInput{
+		ModeratorId: uuid.New(),
+		SongId:      uuid.New(),
+		Status:      postgres.ModerationStatusTakenDown,
+		Reason:      &reason,
+	}
+}
+
+func TestModerateSong(t *testing.T) {
+	suite.Run(t, new(ModerateSongSuite))
+}
@@ -3,9 +3,11 @@ package songs
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
@@ -13,6 +15,7 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
 
 	"dev.gaijin.team/go/golib/e"
+	"github.com/AlekSi/pointer"
 	"github.com/google/uuid"
 )
 
@@ -20,6 +23,8 @@ type ReleaseSongsInput struct {
 	UserId   uuid.UUID
 	SongsIds []uuid.UUID
 	Notify   bool
+	// Imported catalogs keep their original release date, zero means now
+	ReleasedAt time.Time
 }
 
 type ReleaseSongsOutput struct {
@@ -55,7 +60,11 @@ func (s *Service) ReleaseSongs(ctx context.Context, in ReleaseSongsInput) (Relea
 		return null, err
 	}
 
-	releaseTime := time.Now()
+	releaseTime := in.ReleasedAt
+	if releaseTime.IsZero() {
+		releaseTime = time.Now()
+	}
+
 	log.Debug().Time("release_time", releaseTime).Msg("patching songs")
 
 	err = s.songRepo.PatchSongs(ctx, postgres.PatchSongsParams{ //nolint:exhaustruct
@@ -66,6 +75,8 @@ func (s *Service) ReleaseSongs(ctx context.Context, in ReleaseSongsInput) (Relea
 		return null, e.NewFrom("patching songs", err)
 	}
 
+	s.makePreviews(ctx, songs)
+
 	if !in.Notify {
 		log.Debug().Msg("not sending messages")
 		return null, nil
@@ -80,6 +91,21 @@ func (s *Service) ReleaseSongs(ctx context.Context, in ReleaseSongsInput) (Relea
 	return null, nil
 }
 
+// makePreviews queues the previews of the released songs. A failed one is only logged,
+// it is queued again on the first request then.
+func (s *Service) makePreviews(ctx context.Context, songs []postgres.MySongsRow) {
+	log := logger.FromContext(ctx)
+
+	for _, song := range songs {
+		objectId := song.Song.S3ObjectName.String
+
+		err := raw.EnqueuePreview(ctx, s.jobs, objectId, pointer.Get(pgconv.FromInterval(song.Song.Duration)))
+		if err != nil {
+			log.Warn().Err(err).Stringer("song_id", song.Song.SongID).Msg("error queueing preview")
+		}
+	}
+}
+
 func validateReleasingSongs(songs []postgres.MySongsRow) error {
 	var errs []error
 
@@ -88,9 +114,16 @@ func validateReleasingSongs(songs []postgres.MySongsRow) error {
 			errs = append(errs, newReleaseErr("already released", song))
 		}
 
-		if !song.Song.S3ObjectName.Valid {
+		if song.Song.AudioState != postgres.AudioStateReady {
 			errs = append(errs, newReleaseErr("has not been loaded", song))
 		}
+
+		pending := slices.ContainsFunc(song.GetCredits(), func(credit postgres.SongCredit) bool {
+			return credit.Status == postgres.CreditStatusPending
+		})
+		if pending {
+			errs = append(errs, newReleaseErr("has pending credits", song))
+		}
 	}
 
 	if len(errs) > 0 {
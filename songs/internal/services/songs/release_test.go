@@ -2,9 +2,13 @@ package songs_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	songsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/raw"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
@@ -21,6 +25,8 @@ type ReleaseSongsSuite struct {
 
 	sm *songsmocks.SongRepo
 	bm *songsmocks.Broker
+	rm *songsmocks.RawService
+	jm *songsmocks.JobQueue
 
 	s     *songs.Service
 	ctx   context.Context
@@ -30,11 +36,15 @@ type ReleaseSongsSuite struct {
 func (s *ReleaseSongsSuite) SetupTest() {
 	s.sm = songsmocks.NewSongRepo(s.T())
 	s.bm = songsmocks.NewBroker(s.T())
+	s.rm = songsmocks.NewRawService(s.T())
+	s.jm = songsmocks.NewJobQueue(s.T())
 
 	s.s = songs.NewWithConfig(songs.Config{
 		Dependencies: songs.Dependencies{
-			SongRepo: s.sm,
-			Broker:   s.bm,
+			SongRepo:   s.sm,
+			Broker:     s.bm,
+			RawService: s.rm,
+			JobQueue:   s.jm,
 		},
 	})
 
@@ -45,6 +55,45 @@ func (s *ReleaseSongsSuite) SetupTest() {
 func (s *ReleaseSongsSuite) TestHappyPath() {
 	s.sm.EXPECT().PatchSongs(mock.Anything, mock.Anything).Return(nil).Once()
 	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(validMySongsRows(2), nil).Once()
+	s.jm.EXPECT().EnqueueJob(mock.Anything, string(raw.PreviewJob), mock.Anything, mock.Anything).
+		Return(uuid.New(), nil).Twice()
+	s.bm.EXPECT().SendReleasedMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.ReleaseSongs(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *ReleaseSongsSuite) TestPreviewJobs() {
+	rows := validMySongsRows(2)
+
+	s.sm.EXPECT().PatchSongs(mock.Anything, mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+
+	for _, row := range rows {
+		objectId := row.Song.S3ObjectName.String
+
+		s.jm.EXPECT().EnqueueJob(mock.Anything, string(raw.PreviewJob),
+			mock.MatchedBy(func(payload []byte) bool {
+				var args raw.PreviewJobArgs
+				return json.Unmarshal(payload, &args) == nil && args.ObjectId == objectId
+			}),
+			jobs.Options{UniqueKey: "preview:" + objectId, RunAt: time.Time{}, MaxAttempts: 0}).
+			Return(uuid.New(), nil).Once()
+	}
+
+	s.bm.EXPECT().SendReleasedMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.s.ReleaseSongs(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *ReleaseSongsSuite) TestPreviewErrorIgnored() {
+	s.sm.EXPECT().PatchSongs(mock.Anything, mock.Anything).Return(nil).Once()
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(validMySongsRows(2), nil).Once()
+	s.jm.EXPECT().EnqueueJob(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(uuid.Nil, gofakeit.ErrorDatabase()).Once()
+	s.jm.EXPECT().EnqueueJob(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(uuid.New(), nil).Once()
 	s.bm.EXPECT().SendReleasedMessages(mock.Anything, mock.Anything).Return(nil).Once()
 
 	_, err := s.s.ReleaseSongs(s.ctx, s.input)
@@ -56,6 +105,8 @@ func (s *ReleaseSongsSuite) TestHappyPathWithoutNotify() {
 
 	s.sm.EXPECT().PatchSongs(mock.Anything, mock.Anything).Return(nil).Once()
 	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(validMySongsRows(2), nil).Once()
+	s.jm.EXPECT().EnqueueJob(mock.Anything, string(raw.PreviewJob), mock.Anything, mock.Anything).
+		Return(uuid.New(), nil).Twice()
 
 	_, err := s.s.ReleaseSongs(s.ctx, s.input)
 	s.NoError(err)
@@ -88,6 +139,7 @@ func (s *ReleaseSongsSuite) TestAlreadyReleased() {
 func (s *ReleaseSongsSuite) TestNotLoaded() {
 	rows := validMySongsRows(2)
 	rows[0].Song.S3ObjectName = pgconv.NullText()
+	rows[0].Song.AudioState = postgres.AudioStateDraft
 
 	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
 
@@ -95,10 +147,38 @@ func (s *ReleaseSongsSuite) TestNotLoaded() {
 	s.Error(err)
 }
 
+func (s *ReleaseSongsSuite) TestStillProcessing() {
+	rows := validMySongsRows(2)
+	rows[1].Song.AudioState = postgres.AudioStateProcessing
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+
+	_, err := s.s.ReleaseSongs(s.ctx, s.input)
+	if s.Error(err) {
+		s.Equal(rows[1].Song.Name+" ("+rows[1].Song.SongID.String()+") has not been loaded | ", err.Error())
+	}
+}
+
+func (s *ReleaseSongsSuite) TestPendingCredits() {
+	rows := validMySongsRows(2)
+	rows[1].CreditsArtistsIds = []uuid.UUID{uuid.New(), uuid.New()}
+	rows[1].CreditsNames = []string{"", ""}
+	rows[1].CreditsRoles = []string{"featured", "producer"}
+	rows[1].CreditsStatuses = []string{"declined", "pending"}
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+
+	_, err := s.s.ReleaseSongs(s.ctx, s.input)
+	if s.Error(err) {
+		s.Equal(rows[1].Song.Name+" ("+rows[1].Song.SongID.String()+") has pending credits | ", err.Error())
+	}
+}
+
 func (s *ReleaseSongsSuite) TestPreconditionFailedError() {
 	rows := validMySongsRows(3)
 	rows[0].Song.ReleasedAt = pgconv.Timestamptz(gofakeit.Date())
 	rows[0].Song.S3ObjectName = pgconv.NullText()
+	rows[0].Song.AudioState = postgres.AudioStateDraft
 	rows[1].Song.ReleasedAt = pgconv.Timestamptz(gofakeit.Date())
 
 	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
@@ -124,6 +204,7 @@ func (s *ReleaseSongsSuite) TestPatchSongsError() {
 func (s *ReleaseSongsSuite) TestBrokerError() {
 	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(validMySongsRows(2), nil).Once()
 	s.sm.EXPECT().PatchSongs(mock.Anything, mock.Anything).Return(nil).Once()
+	s.jm.EXPECT().EnqueueJob(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(uuid.New(), nil)
 	s.bm.EXPECT().SendReleasedMessages(mock.Anything, mock.Anything).Return(gofakeit.Error()).Once()
 
 	_, err := s.s.ReleaseSongs(s.ctx, s.input)
@@ -2,8 +2,11 @@ package songs
 
 import (
 	"context"
+	"time"
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/clients/users"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
 
@@ -16,6 +19,7 @@ type Service struct {
 	userRepo      UserRepo
 	rawService    RawService
 	messageBroker Broker
+	jobs          JobQueue
 }
 
 type SongRepo interface {
@@ -24,9 +28,21 @@ type SongRepo interface {
 	ReleasedSongs(context.Context, postgres.ReleasedSongsParams) ([]postgres.ReleasedSongsRow, error)
 	MySongs(context.Context, postgres.MySongsParams) ([]postgres.MySongsRow, error)
 	CountMySongs(context.Context, uuid.UUID) (int32, error)
+	ArtistUsage(context.Context, uuid.UUID) (postgres.ArtistUsageRow, error)
 	CountSongsWithArtistsIds(context.Context, []uuid.UUID) (int32, error)
 	CountSongsMatchName(context.Context, string) (int32, error)
 	PatchSongs(context.Context, postgres.PatchSongsParams) error
+	PatchSong(context.Context, postgres.PatchSongParams) (postgres.Song, error)
+	ReplaceCredits(context.Context, postgres.ReplaceCreditsParams) error
+	CountSongsWithCredit(context.Context, postgres.CountSongsWithCreditParams) (int32, error)
+	CreditRequests(context.Context, postgres.CreditRequestsParams) ([]postgres.CreditRequestsRow, error)
+	CountCreditRequests(context.Context, uuid.UUID) (int32, error)
+	ResolveCreditRequest(context.Context, postgres.ResolveCreditRequestParams) (postgres.ResolveCreditRequestRow, error)
+	TrashSongs(context.Context, postgres.TrashSongsParams) error
+	TrashedSongs(context.Context, postgres.TrashedSongsParams) ([]postgres.TrashedSongsRow, error)
+	CountTrashedSongs(context.Context, postgres.CountTrashedSongsParams) (int32, error)
+	RestoreSongs(context.Context, postgres.RestoreSongsParams) ([]postgres.Song, error)
+	ModerateSong(context.Context, postgres.ModerateSongParams) (postgres.Song, error)
 }
 
 type UserRepo interface {
@@ -36,10 +52,20 @@ type UserRepo interface {
 
 type Broker interface {
 	SendReleasedMessages(context.Context, []broker.SongReleasedMessage) error
+	SendSongMessages(context.Context, []broker.SongMessage) error
 }
 
+// RawService signs the links to objects of songs for listeners, empty listener ids are anonymous listeners.
 type RawService interface {
-	SongUrl(rawSongId string) string
+	SongUrl(rawSongId, listenerId string) string
+	SignImageUrl(imageUrl, listenerId string) string
+	SignThumbnailUrl(thumbnailUrl, imageUrl, listenerId string) string
+	PreviewUrl(songId uuid.UUID) string
+}
+
+// JobQueue queues background jobs, it is jobs.Service.
+type JobQueue interface {
+	EnqueueJob(ctx context.Context, kind string, payload []byte, opts jobs.Options) (uuid.UUID, error)
 }
 
 type Dependencies struct {
@@ -47,15 +73,32 @@ type Dependencies struct {
 	UserRepo   UserRepo
 	RawService RawService
 	Broker     Broker
+	JobQueue   JobQueue
 }
 
 type Config struct {
 	Dependencies
+
+	// Limits are per artist, zero means unlimited
+	MaxSongs          int32
+	MaxBytes          int64
+	UploadsPerHour    int32
+	ConcurrentUploads int32
+	// How long deleted songs can be restored
+	RestoreWindow time.Duration
 }
 
 func New(deps Dependencies) *Service {
+	features := config.Get().Features
+	quotas := features.Quotas
+
 	return NewWithConfig(Config{
-		Dependencies: deps,
+		Dependencies:      deps,
+		MaxSongs:          quotas.MaxSongs,
+		MaxBytes:          quotas.MaxBytes,
+		UploadsPerHour:    quotas.UploadsPerHour,
+		ConcurrentUploads: quotas.ConcurrentUploads,
+		RestoreWindow:     features.Trash.RestoreWindow,
 	})
 }
 
@@ -65,6 +108,7 @@ func NewWithConfig(conf Config) *Service {
 		userRepo:      conf.UserRepo,
 		rawService:    conf.RawService,
 		messageBroker: conf.Broker,
+		jobs:          conf.JobQueue,
 		c:             conf,
 	}
 }
@@ -0,0 +1,143 @@
+package songs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+type GetTrashedSongsInput struct {
+	UserId uuid.UUID // must be an artist
+	// pagination
+	Page     int32
+	PageSize int32
+}
+
+type TrashedSong struct {
+	MySong
+	DeletedAt time.Time
+	// The song is purged with its files after that
+	RestorableUntil time.Time
+}
+
+type GetTrashedSongsOutput struct {
+	Songs    []TrashedSong
+	LastPage int32
+}
+
+// GetTrashedSongs returns the songs of the user that can still be restored, recently deleted first.
+func (s *Service) GetTrashedSongs(ctx context.Context, input GetTrashedSongsInput) (GetTrashedSongsOutput, error) {
+	var (
+		null         = GetTrashedSongsOutput{Songs: []TrashedSong{}, LastPage: 0}
+		log          = logger.FromContext(ctx)
+		deletedAfter = pgconv.Timestamptz(time.Now().Add(-s.c.RestoreWindow))
+	)
+
+	log.Debug().Msg("getting trashed songs")
+
+	songs, err := s.songRepo.TrashedSongs(ctx, postgres.TrashedSongsParams{
+		SingerID:     input.UserId,
+		DeletedAfter: deletedAfter,
+		Limitv:       input.PageSize,
+		Offsetv:      (input.Page - 1) * input.PageSize,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		log.Debug().Stringer("user_id", input.UserId).Msg("no trashed songs found")
+		return null, nil
+
+	case err != nil:
+		return null, e.NewFrom("getting trashed songs", err)
+	}
+
+	log.Debug().Int("count", len(songs)).Msg("got trashed songs")
+
+	songsCount, err := s.songRepo.CountTrashedSongs(ctx, postgres.CountTrashedSongsParams{
+		SingerID:     input.UserId,
+		DeletedAfter: deletedAfter,
+	})
+	if err != nil {
+		return null, e.NewFrom("getting trashed songs count", err)
+	}
+
+	songsCh := artistsOrderedFanOut(ctx, songs, s,
+		func(row postgres.TrashedSongsRow, a artists) TrashedSong {
+			return TrashedSong{
+				MySong:          s.toMySong(row.Song, row.GetCredits(), a),
+				DeletedAt:       row.Song.DeletedAt.Time,
+				RestorableUntil: row.Song.DeletedAt.Time.Add(s.c.RestoreWindow),
+			}
+		},
+	)
+
+	outSongs := orderedFanIn(songsCh, len(songs))
+
+	return GetTrashedSongsOutput{
+		Songs:    outSongs,
+		LastPage: (songsCount-1)/input.PageSize + 1,
+	}, nil
+}
+
+type RestoreTrashedSongsInput struct {
+	UserId   uuid.UUID
+	SongsIds []uuid.UUID
+}
+
+type RestoreTrashedSongsOutput struct {
+}
+
+// RestoreTrashedSongs brings the songs of the user back from the trash.
+// Songs of other artists and songs out of the restore window are ignored,
+// if none of the songs can be restored, ErrSongNotFound is returned.
+// If a live song has taken the name of a restored one meanwhile, nothing is restored and ErrSongExists is returned.
+func (s *Service) RestoreTrashedSongs(ctx context.Context, in RestoreTrashedSongsInput,
+) (RestoreTrashedSongsOutput, error) {
+	var (
+		null = RestoreTrashedSongsOutput{}
+		log  = logger.FromContext(ctx)
+		now  = time.Now()
+	)
+
+	log.Debug().Array("songs_ids", logger.Stringers[uuid.UUID](in.SongsIds)).Msg("restoring songs")
+
+	songs, err := s.songRepo.RestoreSongs(ctx, postgres.RestoreSongsParams{
+		SingerID:     in.UserId,
+		Ids:          in.SongsIds,
+		DeletedAfter: pgconv.Timestamptz(now.Add(-s.c.RestoreWindow)),
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult) || (len(songs) == 0 && err == nil):
+		return null, ErrSongNotFound
+
+	case errors.Is(err, repoerrs.ErrUnique):
+		return null, ErrSongExists.Wrap(err, fields.F("singer_id", in.UserId))
+
+	case err != nil:
+		return null, e.NewFrom("restoring songs", err)
+	}
+
+	messages := make([]broker.SongMessage, len(songs))
+	for i := range songs {
+		messages[i] = broker.SongRestoredMessage{
+			SongId:     songs[i].SongID,
+			ArtistId:   songs[i].SingerFk,
+			RestoredAt: now,
+		}
+	}
+
+	broker.SendOrLog(ctx, s.messageBroker, messages...)
+
+	return null, nil
+}
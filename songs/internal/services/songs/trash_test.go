@@ -0,0 +1,138 @@
+package songs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	songsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const restoreWindow = 24 * time.Hour
+
+type TrashSuite struct {
+	suite.Suite
+
+	sm *songsmocks.SongRepo
+	um *songsmocks.UserRepo
+	bm *songsmocks.Broker
+
+	s      *songs.Service
+	ctx    context.Context
+	userId uuid.UUID
+}
+
+func (s *TrashSuite) SetupTest() {
+	s.sm = songsmocks.NewSongRepo(s.T())
+	s.um = songsmocks.NewUserRepo(s.T())
+	s.bm = songsmocks.NewBroker(s.T())
+
+	s.s = songs.NewWithConfig(songs.Config{
+		Dependencies: songs.Dependencies{
+			SongRepo:   s.sm,
+			UserRepo:   s.um,
+			RawService: newFakeRawService(),
+			Broker:     s.bm,
+		},
+		RestoreWindow: restoreWindow,
+	})
+
+	s.ctx = context.Background()
+	s.userId = uuid.New()
+}
+
+func (s *TrashSuite) TestGetTrashedSongs() {
+	deletedAt := time.Now().Add(-time.Hour)
+	rows := validTrashedSongsRows(3, deletedAt)
+
+	s.sm.EXPECT().TrashedSongs(mock.Anything, mock.MatchedBy(func(p postgres.TrashedSongsParams) bool {
+		return p.SingerID == s.userId && p.Limitv == 10 && p.Offsetv == 10 &&
+			time.Since(p.DeletedAfter.Time) >= restoreWindow
+	})).Return(rows, nil).Once()
+	s.sm.EXPECT().CountTrashedSongs(mock.Anything, mock.Anything).Return(int32(23), nil).Once()
+	s.um.EXPECT().ArtistsByIds(mock.Anything, mock.Anything).Return(validArtists(2), nil).Times(3)
+
+	output, err := s.s.GetTrashedSongs(s.ctx, songs.GetTrashedSongsInput{
+		UserId:   s.userId,
+		Page:     2,
+		PageSize: 10,
+	})
+	s.Require().NoError(err)
+	s.Len(output.Songs, 3)
+	s.Equal(int32(3), output.LastPage)
+	s.Equal(deletedAt.Add(restoreWindow), output.Songs[0].RestorableUntil)
+}
+
+func (s *TrashSuite) TestGetTrashedSongs_Error() {
+	s.sm.EXPECT().TrashedSongs(mock.Anything, mock.Anything).Return(nil, gofakeit.ErrorDatabase()).Once()
+
+	_, err := s.s.GetTrashedSongs(s.ctx, songs.GetTrashedSongsInput{
+		UserId:   s.userId,
+		Page:     1,
+		PageSize: 10,
+	})
+	s.Error(err)
+}
+
+func (s *TrashSuite) TestRestoreTrashedSongs() {
+	rows := validTrashedSongsRows(2, time.Now())
+	ids := []uuid.UUID{rows[0].Song.SongID, rows[1].Song.SongID}
+
+	s.sm.EXPECT().RestoreSongs(mock.Anything, mock.MatchedBy(func(p postgres.RestoreSongsParams) bool {
+		return p.SingerID == s.userId && len(p.Ids) == 2
+	})).Return([]postgres.Song{rows[0].Song, rows[1].Song}, nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(msgs []broker.SongMessage) bool {
+		_, ok := msgs[0].(broker.SongRestoredMessage)
+		return len(msgs) == 2 && ok
+	})).Return(nil).Once()
+
+	_, err := s.s.RestoreTrashedSongs(s.ctx, songs.RestoreTrashedSongsInput{
+		UserId:   s.userId,
+		SongsIds: ids,
+	})
+	s.NoError(err)
+}
+
+func (s *TrashSuite) TestRestoreTrashedSongs_NotFound() {
+	s.sm.EXPECT().RestoreSongs(mock.Anything, mock.Anything).Return([]postgres.Song{}, nil).Once()
+
+	_, err := s.s.RestoreTrashedSongs(s.ctx, songs.RestoreTrashedSongsInput{
+		UserId:   s.userId,
+		SongsIds: []uuid.UUID{uuid.New()},
+	})
+	s.ErrorIs(err, songs.ErrSongNotFound)
+}
+
+func (s *TrashSuite) TestRestoreTrashedSongs_NameTaken() {
+	s.sm.EXPECT().RestoreSongs(mock.Anything, mock.Anything).Return(nil, repoerrs.ErrUnique).Once()
+
+	_, err := s.s.RestoreTrashedSongs(s.ctx, songs.RestoreTrashedSongsInput{
+		UserId:   s.userId,
+		SongsIds: []uuid.UUID{uuid.New()},
+	})
+	s.ErrorIs(err, songs.ErrSongExists)
+}
+
+func TestTrash(t *testing.T) {
+	suite.Run(t, new(TrashSuite))
+}
+
+func validTrashedSongsRows(count int, deletedAt time.Time) []postgres.TrashedSongsRow {
+	rows := make([]postgres.TrashedSongsRow, count)
+	for i := range rows {
+		rows[i] = postgres.TrashedSongsRow(validSongRow())
+		rows[i].Song.DeletedAt = pgconv.Timestamptz(deletedAt)
+	}
+
+	return rows
+}
@@ -0,0 +1,159 @@
+package songs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/regions"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+type UpdateSongInput struct {
+	UserId   uuid.UUID
+	SongId   uuid.UUID
+	Name     string
+	ImageUrl *string
+	Explicit *bool
+	// Replaces the song's restrictions if not nil
+	Regions *Regions
+	// Replaces all the song's credits, featured artists included, if not nil
+	Credits *[]Credit
+}
+
+// Regions are ISO 3166-1 alpha-2 codes of the countries where a song can or can't be played.
+type Regions struct {
+	AllowedCountries []string
+	DeniedCountries  []string
+}
+
+type UpdateSongOutput struct {
+}
+
+func (s *Service) UpdateSong(ctx context.Context, in UpdateSongInput) (UpdateSongOutput, error) {
+	var (
+		null = UpdateSongOutput{}
+		log  = logger.FromContext(ctx)
+	)
+
+	log.Debug().Stringer("song_id", in.SongId).Msg("getting my song")
+
+	row, err := s.mySong(ctx, in.UserId, in.SongId)
+	if err != nil {
+		return null, err
+	}
+
+	song := row.Song
+	messages := make([]broker.SongMessage, 0, 2) //nolint:mnd
+
+	if in.Credits != nil {
+		log.Debug().Msg("replacing credits")
+
+		credits, err := s.resolveCredits(ctx, in.UserId, nil, *in.Credits)
+		if err != nil {
+			return null, err
+		}
+
+		keepStatuses(credits, row.GetCredits())
+		arrays := creditsParams(credits)
+
+		err = s.songRepo.ReplaceCredits(ctx, postgres.ReplaceCreditsParams{
+			SongID:            in.SongId,
+			CreditsArtistsIds: arrays.ids,
+			CreditsNames:      arrays.names,
+			CreditsRoles:      arrays.roles,
+			CreditsStatuses:   arrays.statuses,
+		})
+		if err != nil {
+			return null, e.NewFrom("replacing credits", err, fields.F("song_id", in.SongId))
+		}
+
+		messages = append(messages, creditMessages(song.SongID, song.SingerFk, credits, row.GetCredits())...)
+	}
+
+	log.Debug().Msg("patching song")
+
+	params := postgres.PatchSongParams{ //nolint:exhaustruct
+		ID:       in.SongId,
+		Name:     pgconv.Text(in.Name),
+		ImageUrl: pgconv.TextPtr(in.ImageUrl),
+		Explicit: pgconv.BoolPtr(in.Explicit),
+	}
+
+	if in.Regions != nil {
+		// Normalize never returns nil, so empty lists clear the restrictions
+		params.AllowedCountries = regions.Normalize(in.Regions.AllowedCountries)
+		params.DeniedCountries = regions.Normalize(in.Regions.DeniedCountries)
+	}
+
+	patched, err := s.songRepo.PatchSong(ctx, params)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrUnique):
+		return null, ErrSongExists.Wrap(err, fields.F("name", in.Name), fields.F("singer_id", in.UserId))
+
+	case err != nil:
+		return null, e.NewFrom("patching song", err, fields.F("song_id", in.SongId))
+	}
+
+	now := time.Now()
+
+	if patched.Name != song.Name || patched.Explicit != song.Explicit {
+		updated := broker.SongUpdatedMessage{ //nolint:exhaustruct
+			SongId:    patched.SongID,
+			ArtistId:  patched.SingerFk,
+			UpdatedAt: now,
+		}
+
+		if patched.Name != song.Name {
+			updated.Name = &patched.Name
+		}
+
+		if patched.Explicit != song.Explicit {
+			updated.Explicit = &patched.Explicit
+		}
+
+		messages = append(messages, updated)
+	}
+
+	if patched.ImageUrl.String != song.ImageUrl.String {
+		messages = append(messages, broker.SongImageChangedMessage{
+			SongId:    patched.SongID,
+			ArtistId:  patched.SingerFk,
+			ImageUrl:  patched.ImageUrl.String,
+			ChangedAt: now,
+		})
+	}
+
+	broker.SendOrLog(ctx, s.messageBroker, messages...)
+
+	return null, nil
+}
+
+func (s *Service) mySong(ctx context.Context, userId, songId uuid.UUID) (postgres.MySongsRow, error) {
+	songs, err := s.songRepo.MySongs(ctx, postgres.MySongsParams{
+		SingerID: userId,
+		ByIds:    true,
+		Ids:      []uuid.UUID{songId},
+		Limitv:   1,
+		Offsetv:  0,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult) || (len(songs) == 0 && err == nil):
+		return postgres.MySongsRow{}, ErrSongNotFound
+
+	case err != nil:
+		return postgres.MySongsRow{}, e.NewFrom("getting my song", err, fields.F("song_id", songId))
+	}
+
+	return songs[0], nil
+}
@@ -0,0 +1,131 @@
+package songs_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	songsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateSongSuite struct {
+	suite.Suite
+
+	sm *songsmocks.SongRepo
+	bm *songsmocks.Broker
+
+	s     *songs.Service
+	ctx   context.Context
+	input songs.UpdateSongInput
+}
+
+func (s *UpdateSongSuite) SetupTest() {
+	s.sm = songsmocks.NewSongRepo(s.T())
+	s.bm = songsmocks.NewBroker(s.T())
+
+	s.s = songs.NewWithConfig(songs.Config{
+		Dependencies: songs.Dependencies{
+			SongRepo: s.sm,
+			Broker:   s.bm,
+		},
+	})
+
+	s.ctx = context.Background()
+	s.input = validUpdateSongInput()
+}
+
+func (s *UpdateSongSuite) TestHappyPath() {
+	rows := validMySongsRows(1)
+	patched := rows[0].Song
+	patched.Name = s.input.Name
+	patched.ImageUrl = pgconv.TextPtr(s.input.ImageUrl)
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(patched, nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.MatchedBy(func(msgs []broker.SongMessage) bool {
+		return len(msgs) == 2
+	})).Return(nil).Once()
+
+	_, err := s.s.UpdateSong(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *UpdateSongSuite) TestNothingChanged() {
+	rows := validMySongsRows(1)
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(rows[0].Song, nil).Once()
+
+	_, err := s.s.UpdateSong(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *UpdateSongSuite) TestRegions() {
+	rows := validMySongsRows(1)
+	s.input.Regions = &songs.Regions{
+		AllowedCountries: []string{"us", "DE", "us"},
+		DeniedCountries:  nil,
+	}
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+	s.sm.EXPECT().PatchSong(mock.Anything, mock.MatchedBy(func(p postgres.PatchSongParams) bool {
+		return slices.Equal(p.AllowedCountries, []string{"DE", "US"}) &&
+			p.DeniedCountries != nil && len(p.DeniedCountries) == 0
+	})).Return(rows[0].Song, nil).Once()
+
+	_, err := s.s.UpdateSong(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func (s *UpdateSongSuite) TestMySongs_EmptyResultError() {
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(nil, repoerrs.ErrEmptyResult).Once()
+
+	_, err := s.s.UpdateSong(s.ctx, s.input)
+	s.ErrorIs(err, songs.ErrSongNotFound)
+}
+
+func (s *UpdateSongSuite) TestPatchSong_UniqueError() {
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(validMySongsRows(1), nil).Once()
+	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(postgres.Song{}, repoerrs.ErrUnique).Once()
+
+	_, err := s.s.UpdateSong(s.ctx, s.input)
+	s.ErrorIs(err, songs.ErrSongExists)
+}
+
+func (s *UpdateSongSuite) TestBrokerError() {
+	rows := validMySongsRows(1)
+	patched := rows[0].Song
+	patched.Name = s.input.Name
+
+	s.sm.EXPECT().MySongs(mock.Anything, mock.Anything).Return(rows, nil).Once()
+	s.sm.EXPECT().PatchSong(mock.Anything, mock.Anything).Return(patched, nil).Once()
+	s.bm.EXPECT().SendSongMessages(mock.Anything, mock.Anything).Return(gofakeit.Error()).Once()
+
+	_, err := s.s.UpdateSong(s.ctx, s.input)
+	s.NoError(err)
+}
+
+func validUpdateSongInput() songs.UpdateSongInput {
+	imageUrl := gofakeit.URL()
+
+	return songs.UpdateSongInput{
+		UserId:   uuid.New(),
+		SongId:   uuid.New(),
+		Name:     gofakeit.Sentence(3),
+		ImageUrl: &imageUrl,
+	}
+}
+
+func TestUpdateSong(t *testing.T) {
+	suite.Run(t, new(UpdateSongSuite))
+}
@@ -0,0 +1,46 @@
+package songs
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+type GetMyUsageInput struct {
+	ArtistId uuid.UUID
+}
+
+// GetMyUsageOutput holds what the artist has used along with the limits,
+// zero limits mean unlimited.
+type GetMyUsageOutput struct {
+	SongsCount        int32
+	MaxSongs          int32
+	WeightBytes       int64
+	MaxBytes          int64
+	UploadsPerHour    int32
+	ConcurrentUploads int32
+}
+
+func (s *Service) GetMyUsage(ctx context.Context, in GetMyUsageInput) (GetMyUsageOutput, error) {
+	log := logger.FromContext(ctx)
+
+	log.Debug().Stringer("artist_id", in.ArtistId).Msg("getting artist usage")
+
+	usage, err := s.songRepo.ArtistUsage(ctx, in.ArtistId)
+	if err != nil {
+		return GetMyUsageOutput{}, e.NewFrom("getting artist usage", err, fields.F("artist_id", in.ArtistId))
+	}
+
+	return GetMyUsageOutput{
+		SongsCount:        usage.SongsCount,
+		MaxSongs:          s.c.MaxSongs,
+		WeightBytes:       usage.WeightBytes,
+		MaxBytes:          s.c.MaxBytes,
+		UploadsPerHour:    s.c.UploadsPerHour,
+		ConcurrentUploads: s.c.ConcurrentUploads,
+	}, nil
+}
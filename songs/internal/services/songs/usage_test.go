@@ -0,0 +1,70 @@
+package songs_test
+
+import (
+	"context"
+	"testing"
+
+	songsmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/songs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetMyUsageSuite struct {
+	suite.Suite
+
+	sm *songsmocks.SongRepo
+
+	s     *songs.Service
+	ctx   context.Context
+	input songs.GetMyUsageInput
+}
+
+func (s *GetMyUsageSuite) SetupTest() {
+	s.sm = songsmocks.NewSongRepo(s.T())
+
+	s.s = songs.NewWithConfig(songs.Config{
+		Dependencies: songs.Dependencies{
+			SongRepo: s.sm,
+		},
+		MaxSongs:          500,
+		MaxBytes:          1024 * 1024,
+		UploadsPerHour:    30,
+		ConcurrentUploads: 0,
+	})
+
+	s.ctx = context.Background()
+	s.input = songs.GetMyUsageInput{ArtistId: uuid.New()}
+}
+
+func (s *GetMyUsageSuite) TestHappyPath() {
+	s.sm.EXPECT().ArtistUsage(mock.Anything, s.input.ArtistId).
+		Return(postgres.ArtistUsageRow{SongsCount: 12, WeightBytes: 4096}, nil).Once()
+
+	out, err := s.s.GetMyUsage(s.ctx, s.input)
+	s.Require().NoError(err)
+	s.Equal(songs.GetMyUsageOutput{
+		SongsCount:        12,
+		MaxSongs:          500,
+		WeightBytes:       4096,
+		MaxBytes:          1024 * 1024,
+		UploadsPerHour:    30,
+		ConcurrentUploads: 0,
+	}, out)
+}
+
+func (s *GetMyUsageSuite) TestSongRepoError() {
+	s.sm.EXPECT().ArtistUsage(mock.Anything, s.input.ArtistId).
+		Return(postgres.ArtistUsageRow{}, gofakeit.ErrorDatabase()).Once()
+
+	_, err := s.s.GetMyUsage(s.ctx, s.input)
+	s.Error(err)
+}
+
+func TestGetMyUsage(t *testing.T) {
+	suite.Run(t, new(GetMyUsageSuite))
+}
@@ -0,0 +1,61 @@
+package webhooks
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+var ErrForbiddenAddress = e.New("webhook endpoint resolves to a forbidden address")
+
+// NewClient returns the client sending the deliveries with the timeout.
+// Unless private addresses are allowed, the endpoints may only be on public addresses,
+// so they can't reach the internal network. Redirects are not followed.
+func NewClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+
+	if !allowPrivate {
+		// The address is checked after it is resolved, so the hosts can't be rebound to private ones
+		dialer.Control = refusePrivate
+	}
+
+	transport := &http.Transport{
+		Proxy:               nil,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: timeout,
+		MaxIdleConnsPerHost: 2,
+		IdleConnTimeout:     time.Minute,
+	}
+
+	return &http.Client{
+		// The deliveries are traced, but the trace context is not sent to the endpoints of the artists
+		Transport: otelhttp.NewTransport(transport,
+			otelhttp.WithPropagators(propagation.NewCompositeTextMapPropagator())),
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Timeout: timeout,
+	}
+}
+
+func refusePrivate(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return ErrForbiddenAddress.Wrap(err, fields.F("address", address))
+	}
+
+	addr := addrPort.Addr().Unmap()
+
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return ErrForbiddenAddress
+	}
+
+	return nil
+}
@@ -0,0 +1,318 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/events"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Headers of the deliveries.
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+const (
+	userAgent = "audio-hosting-webhooks/1.0"
+	// Only the status of the response matters, the rest of the body is not read
+	maxResponseBytes = 64 << 10
+)
+
+// HandleLifecycle registers the handlers of the lifecycle topic.
+func (s *Service) HandleLifecycle(c *events.Consumer) {
+	events.Handle(c, events.TypeSongReleased,
+		func(ctx context.Context, env events.Envelope, event *api.SongReleasedEvent) error {
+			return s.notifyEvent(ctx, env, EventSongReleased, event.GetArtistId(), event)
+		})
+
+	events.Handle(c, events.TypeSongUploaded,
+		func(ctx context.Context, env events.Envelope, event *api.SongUploadedEvent) error {
+			return s.notifyEvent(ctx, env, EventSongUploaded, event.GetArtistId(), event)
+		})
+
+	events.Handle(c, events.TypeClaimSubmitted,
+		func(ctx context.Context, env events.Envelope, event *api.SongClaimEvent) error {
+			return s.notifyEvent(ctx, env, EventClaimSubmitted, event.GetArtistId(), event)
+		})
+}
+
+// notifyEvent skips events with malformed artist ids, they can't be delivered later either.
+func (s *Service) notifyEvent(ctx context.Context, env events.Envelope, event, artistId string,
+	data proto.Message,
+) error {
+	log := logger.FromContext(ctx)
+
+	id, err := uuid.Parse(artistId)
+	if err != nil {
+		log.Warn().Err(err).Str("event_id", env.Id).Str("type", env.Type).Msg("skipping malformed artist id")
+
+		return nil
+	}
+
+	return s.Notify(ctx, NotifyInput{
+		EventId:  env.Source + "/" + env.Id,
+		Event:    event,
+		ArtistId: id,
+		Data:     data,
+	})
+}
+
+type NotifyInput struct {
+	// Unique across the services, the event is delivered to a webhook once
+	EventId  string
+	Event    string
+	ArtistId uuid.UUID
+	Data     proto.Message
+}
+
+// body is the JSON sent to the endpoints, data is the payload of the event in the proto JSON mapping.
+type body struct {
+	Id        uuid.UUID       `json:"id"`
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Notify queues the deliveries of the event to the webhooks of the artist subscribed to it.
+func (s *Service) Notify(ctx context.Context, in NotifyInput) error {
+	webhooks, err := s.repo.SubscribedWebhooks(ctx, postgres.SubscribedWebhooksParams{
+		ArtistID: in.ArtistId,
+		Event:    in.Event,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult) || (len(webhooks) == 0 && err == nil):
+		return nil
+
+	case err != nil:
+		return e.NewFrom("getting subscribed webhooks", err, fields.F("artist_id", in.ArtistId))
+	}
+
+	data, err := protojson.Marshal(in.Data)
+	if err != nil {
+		return e.NewFrom("marshaling event data", err, fields.F("event_id", in.EventId))
+	}
+
+	for _, webhook := range webhooks {
+		err = s.saveDelivery(ctx, webhook.WebhookID, in, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) saveDelivery(ctx context.Context, webhookId uuid.UUID, in NotifyInput, data []byte) error {
+	deliveryId := uuid.New()
+
+	payload, err := json.Marshal(body{
+		Id:        deliveryId,
+		Event:     in.Event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return e.NewFrom("marshaling delivery", err, fields.F("event_id", in.EventId))
+	}
+
+	txRepo, err := s.repo.Begin(ctx)
+	if err != nil {
+		return e.NewFrom("begin transaction", err)
+	}
+	defer txRepo.Rollback(ctx) //nolint:errcheck
+
+	delivery, err := txRepo.SaveWebhookDelivery(ctx, postgres.SaveWebhookDeliveryParams{
+		DeliveryID: deliveryId,
+		WebhookID:  webhookId,
+		EventID:    in.EventId,
+		Event:      in.Event,
+		Payload:    payload,
+	})
+
+	switch {
+	// The event is redelivered by the broker, its deliveries are queued already.
+	// A delivery is saved with its job, so a saved one is never left without it.
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return nil
+
+	case err != nil:
+		return e.NewFrom("saving delivery", err, fields.F("webhook_id", webhookId), fields.F("event_id", in.EventId))
+	}
+
+	err = s.enqueueDelivery(ctx, s.jobs.InTx(txRepo), delivery.DeliveryID)
+	if err != nil {
+		return err
+	}
+
+	err = txRepo.Commit(ctx)
+	if err != nil {
+		return e.NewFrom("commit transaction", err)
+	}
+
+	return nil
+}
+
+// DeliveryJob sends a delivery, see [Service.HandleDeliveryJob].
+const DeliveryJob jobs.Kind[DeliveryJobArgs] = "deliver_webhook"
+
+type DeliveryJobArgs struct {
+	DeliveryId uuid.UUID `json:"deliveryId"`
+}
+
+func (s *Service) enqueueDelivery(ctx context.Context, queue jobs.Queue, deliveryId uuid.UUID) error {
+	_, err := jobs.Enqueue(ctx, queue, DeliveryJob, DeliveryJobArgs{DeliveryId: deliveryId}, jobs.Options{
+		UniqueKey:   "webhook:" + deliveryId.String(),
+		MaxAttempts: s.c.MaxAttempts,
+	})
+	if err != nil {
+		return e.NewFrom("queueing delivery", err, fields.F("delivery_id", deliveryId))
+	}
+
+	return nil
+}
+
+// HandleDeliveryJob sends the delivery and records the attempt, the job is retried while it fails.
+// The delivery is failed when it is out of attempts until the artist redelivers it,
+// deliveries of deleted webhooks are dropped.
+func (s *Service) HandleDeliveryJob(ctx context.Context, args DeliveryJobArgs) error {
+	row, err := s.repo.WebhookDelivery(ctx, args.DeliveryId)
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		log := logger.FromContext(ctx)
+		log.Debug().Stringer("delivery_id", args.DeliveryId).Msg("dropping delivery of deleted webhook")
+
+		return nil
+
+	case err != nil:
+		return e.NewFrom("getting delivery", err, fields.F("delivery_id", args.DeliveryId))
+
+	case row.WebhookDelivery.Status != postgres.WebhookDeliveryStatusPending:
+		return nil
+	}
+
+	code, sendErr := s.send(ctx, row)
+
+	params := postgres.RecordWebhookAttemptParams{
+		Status:       postgres.WebhookDeliveryStatusDelivered,
+		ResponseCode: pgconv.NullInt4(),
+		Error:        pgconv.NullText(),
+		DeliveryID:   args.DeliveryId,
+	}
+
+	if code > 0 {
+		params.ResponseCode = pgconv.Int4(code)
+	}
+
+	// The failed delivery is in the log, the job is not kept dead for it
+	outOfAttempts := row.WebhookDelivery.Attempts+1 >= s.c.MaxAttempts
+
+	if sendErr != nil {
+		params.Status = postgres.WebhookDeliveryStatusPending
+		params.Error = pgconv.Text(sendErr.Error())
+
+		if outOfAttempts {
+			params.Status = postgres.WebhookDeliveryStatusFailed
+		}
+	}
+
+	_, err = s.repo.RecordWebhookAttempt(ctx, params)
+	if err != nil {
+		return e.NewFrom("recording delivery attempt", err, fields.F("delivery_id", args.DeliveryId))
+	}
+
+	if sendErr != nil && outOfAttempts {
+		log := logger.FromContext(ctx)
+		log.Warn().Err(sendErr).Stringer("delivery_id", args.DeliveryId).Msg("webhook delivery failed")
+
+		return nil
+	}
+
+	return sendErr
+}
+
+// send posts the body of the delivery to the endpoint and returns the status of the response.
+// The delivery fails if the endpoint doesn't respond with 2xx, redirects are not followed.
+func (s *Service) send(ctx context.Context, row postgres.WebhookDeliveryRow) (int32, error) {
+	delivery := row.WebhookDelivery
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, row.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, e.NewFrom("creating request", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderEvent, delivery.Event)
+	req.Header.Set(HeaderDelivery, delivery.DeliveryID.String())
+	req.Header.Set(HeaderSignature, Sign(row.Secret, time.Now().Unix(), delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, e.NewFrom("sending request", err)
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	code := int32(resp.StatusCode) //nolint:gosec
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return code, e.New("endpoint responded with " + resp.Status)
+	}
+
+	return code, nil
+}
+
+// Sign returns the signature header of the body sent at the unix time: t=<time>,v1=<signature>.
+// The signature is the hex HMAC-SHA256 of "<time>.<body>" with the secret of the webhook,
+// receivers compute it the same way and reject old times to stop replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	ts := strconv.FormatInt(timestamp, 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// PurgeDeliveries removes the deliveries older than the retention, zero keeps them.
+func (s *Service) PurgeDeliveries(ctx context.Context) (int64, error) {
+	if s.c.DeliveriesRetention == 0 {
+		return 0, nil
+	}
+
+	purged, err := s.repo.PurgeWebhookDeliveries(ctx, time.Now().Add(-s.c.DeliveriesRetention))
+	if err != nil {
+		return 0, e.NewFrom("purging webhook deliveries", err)
+	}
+
+	return purged, nil
+}
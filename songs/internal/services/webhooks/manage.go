@@ -0,0 +1,274 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"slices"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+)
+
+const (
+	maxUrlLength = 2048
+	secretBytes  = 32
+	secretPrefix = "whsec_"
+)
+
+type CreateWebhookInput struct {
+	ArtistId uuid.UUID
+	Url      string
+	Events   []string
+}
+
+type CreateWebhookOutput struct {
+	Webhook Webhook
+	// Signs the deliveries, it is not shown again
+	Secret string
+}
+
+// CreateWebhook registers the endpoint of the artist for the events.
+func (s *Service) CreateWebhook(ctx context.Context, in CreateWebhookInput) (CreateWebhookOutput, error) {
+	err := validateUrl(in.Url)
+	if err != nil {
+		return CreateWebhookOutput{}, err
+	}
+
+	events, err := normalizeEvents(in.Events)
+	if err != nil {
+		return CreateWebhookOutput{}, err
+	}
+
+	if s.c.MaxPerArtist > 0 {
+		count, err := s.repo.CountArtistWebhooks(ctx, in.ArtistId)
+		if err != nil {
+			return CreateWebhookOutput{}, e.NewFrom("counting webhooks", err, fields.F("artist_id", in.ArtistId))
+		}
+
+		if count >= s.c.MaxPerArtist {
+			return CreateWebhookOutput{}, ErrTooManyWebhooks
+		}
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return CreateWebhookOutput{}, err
+	}
+
+	webhook, err := s.repo.SaveWebhook(ctx, postgres.SaveWebhookParams{
+		WebhookID: uuid.New(),
+		ArtistID:  in.ArtistId,
+		Url:       in.Url,
+		Secret:    secret,
+		Events:    events,
+	})
+	if err != nil {
+		return CreateWebhookOutput{}, e.NewFrom("saving webhook", err, fields.F("artist_id", in.ArtistId))
+	}
+
+	log := logger.FromContext(ctx)
+	log.Info().Stringer("webhook_id", webhook.WebhookID).Strs("events", events).Msg("webhook created")
+
+	return CreateWebhookOutput{
+		Webhook: mapWebhook(webhook),
+		Secret:  secret,
+	}, nil
+}
+
+func validateUrl(raw string) error {
+	if len(raw) > maxUrlLength {
+		return ErrInvalidUrl
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme != "https" || parsed.Hostname() == "" {
+		return ErrInvalidUrl
+	}
+
+	return nil
+}
+
+// normalizeEvents sorts the events and drops the duplicates.
+func normalizeEvents(events []string) ([]string, error) {
+	if len(events) == 0 {
+		return nil, ErrNoEvents
+	}
+
+	for _, event := range events {
+		if !isKnownEvent(event) {
+			return nil, ErrUnknownEvent
+		}
+	}
+
+	events = slices.Clone(events)
+	slices.Sort(events)
+
+	return slices.Compact(events), nil
+}
+
+func newSecret() (string, error) {
+	secret := make([]byte, secretBytes)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", e.NewFrom("generating secret", err)
+	}
+
+	return secretPrefix + hex.EncodeToString(secret), nil
+}
+
+type GetWebhooksInput struct {
+	ArtistId uuid.UUID
+}
+
+type GetWebhooksOutput struct {
+	Webhooks []Webhook
+}
+
+// GetWebhooks returns the webhooks of the artist, oldest first.
+func (s *Service) GetWebhooks(ctx context.Context, in GetWebhooksInput) (GetWebhooksOutput, error) {
+	webhooks, err := s.repo.ArtistWebhooks(ctx, in.ArtistId)
+	if err != nil && !errors.Is(err, repoerrs.ErrEmptyResult) {
+		return GetWebhooksOutput{}, e.NewFrom("getting webhooks", err, fields.F("artist_id", in.ArtistId))
+	}
+
+	out := GetWebhooksOutput{Webhooks: make([]Webhook, 0, len(webhooks))}
+	for _, webhook := range webhooks {
+		out.Webhooks = append(out.Webhooks, mapWebhook(webhook))
+	}
+
+	return out, nil
+}
+
+type DeleteWebhookInput struct {
+	ArtistId  uuid.UUID
+	WebhookId uuid.UUID
+}
+
+// DeleteWebhook removes the webhook with its deliveries, the pending ones are not sent.
+func (s *Service) DeleteWebhook(ctx context.Context, in DeleteWebhookInput) error {
+	_, err := s.repo.DeleteWebhook(ctx, postgres.DeleteWebhookParams{
+		WebhookID: in.WebhookId,
+		ArtistID:  in.ArtistId,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return ErrWebhookNotFound
+
+	case err != nil:
+		return e.NewFrom("deleting webhook", err, fields.F("webhook_id", in.WebhookId))
+	}
+
+	log := logger.FromContext(ctx)
+	log.Info().Stringer("webhook_id", in.WebhookId).Msg("webhook deleted")
+
+	return nil
+}
+
+type GetWebhookDeliveriesInput struct {
+	ArtistId  uuid.UUID
+	WebhookId uuid.UUID
+	// pagination
+	Page     int32
+	PageSize int32
+}
+
+type GetWebhookDeliveriesOutput struct {
+	Deliveries []Delivery
+	LastPage   int32
+}
+
+// GetWebhookDeliveries returns the delivery log of the webhook, newest first.
+func (s *Service) GetWebhookDeliveries(ctx context.Context, in GetWebhookDeliveriesInput,
+) (GetWebhookDeliveriesOutput, error) {
+	null := GetWebhookDeliveriesOutput{Deliveries: []Delivery{}, LastPage: 0}
+
+	deliveries, err := s.repo.WebhookDeliveries(ctx, postgres.WebhookDeliveriesParams{
+		WebhookID: in.WebhookId,
+		ArtistID:  in.ArtistId,
+		Offsetv:   (in.Page - 1) * in.PageSize,
+		Limitv:    in.PageSize,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult) || (len(deliveries) == 0 && err == nil):
+		return null, nil
+
+	case err != nil:
+		return null, e.NewFrom("getting webhook deliveries", err, fields.F("webhook_id", in.WebhookId))
+	}
+
+	count, err := s.repo.CountWebhookDeliveries(ctx, postgres.CountWebhookDeliveriesParams{
+		WebhookID: in.WebhookId,
+		ArtistID:  in.ArtistId,
+	})
+	if err != nil {
+		return null, e.NewFrom("getting webhook deliveries count", err, fields.F("webhook_id", in.WebhookId))
+	}
+
+	out := GetWebhookDeliveriesOutput{
+		Deliveries: make([]Delivery, len(deliveries)),
+		LastPage:   (count-1)/in.PageSize + 1,
+	}
+
+	for i, delivery := range deliveries {
+		out.Deliveries[i] = mapDelivery(delivery)
+	}
+
+	return out, nil
+}
+
+type RedeliverWebhookInput struct {
+	ArtistId   uuid.UUID
+	DeliveryId uuid.UUID
+}
+
+type RedeliverWebhookOutput struct {
+	Delivery Delivery
+}
+
+// RedeliverWebhook sends the delivery again with all its attempts, the body is the same.
+func (s *Service) RedeliverWebhook(ctx context.Context, in RedeliverWebhookInput) (RedeliverWebhookOutput, error) {
+	txRepo, err := s.repo.Begin(ctx)
+	if err != nil {
+		return RedeliverWebhookOutput{}, e.NewFrom("begin transaction", err)
+	}
+	defer txRepo.Rollback(ctx) //nolint:errcheck
+
+	delivery, err := txRepo.RedeliverWebhook(ctx, postgres.RedeliverWebhookParams{
+		DeliveryID: in.DeliveryId,
+		ArtistID:   in.ArtistId,
+	})
+
+	switch {
+	case errors.Is(err, repoerrs.ErrEmptyResult):
+		return RedeliverWebhookOutput{}, ErrDeliveryNotFound
+
+	case err != nil:
+		return RedeliverWebhookOutput{}, e.NewFrom("redelivering webhook", err, fields.F("delivery_id", in.DeliveryId))
+	}
+
+	err = s.enqueueDelivery(ctx, s.jobs.InTx(txRepo), delivery.DeliveryID)
+	if err != nil {
+		return RedeliverWebhookOutput{}, err
+	}
+
+	err = txRepo.Commit(ctx)
+	if err != nil {
+		return RedeliverWebhookOutput{}, e.NewFrom("commit transaction", err)
+	}
+
+	log := logger.FromContext(ctx)
+	log.Info().Stringer("delivery_id", delivery.DeliveryID).Msg("redelivering webhook")
+
+	return RedeliverWebhookOutput{Delivery: mapDelivery(delivery)}, nil
+}
@@ -0,0 +1,164 @@
+// Package webhooks delivers the events of the songs of artists to their HTTPS endpoints.
+//
+// Artists register endpoints subscribed to some of the events. The events are consumed from the
+// lifecycle topic and saved as deliveries, which are sent by the jobs as JSON signed with the secret
+// of the webhook. Failed deliveries are retried with the backoff of the jobs until they are out of
+// attempts. Artists see the log of the deliveries and may redeliver any of them.
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWebhookNotFound  = erix.NewStatus("webhook not found", erix.CodeNotFound)
+	ErrDeliveryNotFound = erix.NewStatus("webhook delivery not found", erix.CodeNotFound)
+	ErrInvalidUrl       = erix.NewStatus("webhook url must be an absolute https url", erix.CodeBadRequest)
+	ErrUnknownEvent     = erix.NewStatus("unknown webhook event", erix.CodeBadRequest)
+	ErrNoEvents         = erix.NewStatus("webhook must be subscribed to an event", erix.CodeBadRequest)
+	ErrTooManyWebhooks  = erix.NewStatus("webhooks limit reached", erix.CodePreconditionFailed)
+)
+
+// Events the webhooks may be subscribed to.
+const (
+	EventSongReleased   = "song.released"
+	EventSongUploaded   = "song.uploaded"
+	EventClaimSubmitted = "claim.submitted"
+)
+
+var knownEvents = []string{EventSongReleased, EventSongUploaded, EventClaimSubmitted}
+
+type Service struct {
+	c      Config
+	repo   WebhookRepo
+	jobs   JobQueue
+	client HttpClient
+}
+
+type WebhookRepo interface {
+	SaveWebhook(context.Context, postgres.SaveWebhookParams) (postgres.Webhook, error)
+	CountArtistWebhooks(context.Context, uuid.UUID) (int32, error)
+	ArtistWebhooks(context.Context, uuid.UUID) ([]postgres.Webhook, error)
+	SubscribedWebhooks(context.Context, postgres.SubscribedWebhooksParams) ([]postgres.Webhook, error)
+	DeleteWebhook(context.Context, postgres.DeleteWebhookParams) (uuid.UUID, error)
+	SaveWebhookDelivery(context.Context, postgres.SaveWebhookDeliveryParams) (postgres.WebhookDelivery, error)
+	WebhookDelivery(context.Context, uuid.UUID) (postgres.WebhookDeliveryRow, error)
+	RecordWebhookAttempt(context.Context, postgres.RecordWebhookAttemptParams) (postgres.WebhookDelivery, error)
+	WebhookDeliveries(context.Context, postgres.WebhookDeliveriesParams) ([]postgres.WebhookDelivery, error)
+	CountWebhookDeliveries(context.Context, postgres.CountWebhookDeliveriesParams) (int32, error)
+	RedeliverWebhook(context.Context, postgres.RedeliverWebhookParams) (postgres.WebhookDelivery, error)
+	PurgeWebhookDeliveries(context.Context, time.Time) (int64, error)
+	// EnqueueJob saves the delivery jobs in the transaction, see [jobs.Service.InTx]
+	EnqueueJob(context.Context, postgres.EnqueueJobParams) (postgres.Job, error)
+	Begin(context.Context) (WebhookRepo, error)
+	Commit(context.Context) error
+	Rollback(context.Context) error
+}
+
+// JobQueue queues background jobs, it is jobs.Service.
+type JobQueue interface {
+	EnqueueJob(ctx context.Context, kind string, payload []byte, opts jobs.Options) (uuid.UUID, error)
+	InTx(jobs.JobSaver) jobs.Queue
+}
+
+// HttpClient sends the deliveries, see [NewClient].
+type HttpClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+type Dependencies struct {
+	WebhookRepo WebhookRepo
+	JobQueue    JobQueue
+	HttpClient  HttpClient
+}
+
+type Config struct {
+	Dependencies
+	// Zero means unlimited
+	MaxPerArtist int32
+	// Deliveries failing this many times are failed until the artist redelivers them
+	MaxAttempts         int32
+	DeliveriesRetention time.Duration
+}
+
+func New(deps Dependencies) *Service {
+	conf := config.Get().Features.Webhooks
+
+	return NewWithConfig(Config{
+		Dependencies:        deps,
+		MaxPerArtist:        conf.MaxPerArtist,
+		MaxAttempts:         conf.MaxAttempts,
+		DeliveriesRetention: conf.DeliveriesRetention,
+	})
+}
+
+func NewWithConfig(conf Config) *Service {
+	return &Service{
+		c:      conf,
+		repo:   conf.WebhookRepo,
+		jobs:   conf.JobQueue,
+		client: conf.HttpClient,
+	}
+}
+
+type Webhook struct {
+	Id        uuid.UUID
+	Url       string
+	Events    []string
+	CreatedAt time.Time
+}
+
+func mapWebhook(webhook postgres.Webhook) Webhook {
+	return Webhook{
+		Id:        webhook.WebhookID,
+		Url:       webhook.Url,
+		Events:    webhook.Events,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+type Delivery struct {
+	Id        uuid.UUID
+	WebhookId uuid.UUID
+	Event     string
+	// The JSON body sent to the endpoint
+	Payload  []byte
+	Status   postgres.WebhookDeliveryStatus
+	Attempts int32
+	// Set if the endpoint responded to the last attempt
+	ResponseCode *int32
+	// Set if the last attempt failed
+	Error       *string
+	CreatedAt   time.Time
+	AttemptedAt *time.Time
+}
+
+func mapDelivery(delivery postgres.WebhookDelivery) Delivery {
+	return Delivery{
+		Id:           delivery.DeliveryID,
+		WebhookId:    delivery.WebhookFk,
+		Event:        delivery.Event,
+		Payload:      delivery.Payload,
+		Status:       delivery.Status,
+		Attempts:     delivery.Attempts,
+		ResponseCode: pgconv.FromInt4(delivery.ResponseCode),
+		Error:        pgconv.FromText(delivery.Error),
+		CreatedAt:    delivery.CreatedAt,
+		AttemptedAt:  pgconv.FromTimestamptz(delivery.AttemptedAt),
+	}
+}
+
+func isKnownEvent(event string) bool {
+	return slices.Contains(knownEvents, event)
+}
@@ -0,0 +1,374 @@
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	webhooksmocks "github.com/Benzogang-Tape/audio-hosting/songs/internal/mocks/webhooks"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/jobs"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/services/webhooks"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/pgconv"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const maxAttempts = 3
+
+type WebhooksSuite struct {
+	suite.Suite
+
+	rm  *webhooksmocks.WebhookRepo
+	tm  *webhooksmocks.WebhookRepo
+	jqm *webhooksmocks.JobQueue
+	srv *httptest.Server
+	// Handles the requests of srv
+	handler http.HandlerFunc
+
+	s   *webhooks.Service
+	ctx context.Context
+}
+
+func (s *WebhooksSuite) SetupTest() {
+	s.rm = webhooksmocks.NewWebhookRepo(s.T())
+	s.tm = webhooksmocks.NewWebhookRepo(s.T())
+	s.jqm = webhooksmocks.NewJobQueue(s.T())
+
+	s.handler = func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+	s.srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handler(w, r)
+	}))
+
+	s.s = webhooks.NewWithConfig(webhooks.Config{
+		Dependencies: webhooks.Dependencies{
+			WebhookRepo: s.rm,
+			JobQueue:    s.jqm,
+			HttpClient:  s.srv.Client(),
+		},
+		MaxPerArtist:        2,
+		MaxAttempts:         maxAttempts,
+		DeliveriesRetention: time.Hour,
+	})
+
+	s.ctx = context.Background()
+}
+
+func (s *WebhooksSuite) TearDownTest() {
+	s.srv.Close()
+}
+
+func (s *WebhooksSuite) TestCreateWebhook() {
+	artistId := uuid.New()
+
+	s.rm.EXPECT().CountArtistWebhooks(mock.Anything, artistId).Return(1, nil).Once()
+	s.rm.EXPECT().SaveWebhook(mock.Anything, mock.MatchedBy(func(p postgres.SaveWebhookParams) bool {
+		return p.ArtistID == artistId && p.Url == "https://label.example/hooks" &&
+			strings.Join(p.Events, ",") == "claim.submitted,song.released" &&
+			strings.HasPrefix(p.Secret, "whsec_")
+	})).RunAndReturn(func(_ context.Context, p postgres.SaveWebhookParams) (postgres.Webhook, error) {
+		return postgres.Webhook{
+			WebhookID: p.WebhookID,
+			ArtistFk:  p.ArtistID,
+			Url:       p.Url,
+			Secret:    p.Secret,
+			Events:    p.Events,
+			CreatedAt: time.Now(),
+		}, nil
+	}).Once()
+
+	out, err := s.s.CreateWebhook(s.ctx, webhooks.CreateWebhookInput{
+		ArtistId: artistId,
+		Url:      "https://label.example/hooks",
+		Events:   []string{webhooks.EventSongReleased, webhooks.EventClaimSubmitted, webhooks.EventSongReleased},
+	})
+	s.Require().NoError(err)
+	s.Equal([]string{webhooks.EventClaimSubmitted, webhooks.EventSongReleased}, out.Webhook.Events)
+	s.Len(out.Secret, len("whsec_")+64)
+}
+
+func (s *WebhooksSuite) TestCreateWebhookInvalid() {
+	tests := []struct {
+		url    string
+		events []string
+		err    error
+	}{
+		{url: "http://label.example/hooks", events: []string{webhooks.EventSongReleased}, err: webhooks.ErrInvalidUrl},
+		{url: "label.example/hooks", events: []string{webhooks.EventSongReleased}, err: webhooks.ErrInvalidUrl},
+		{url: "https://label.example/hooks", events: nil, err: webhooks.ErrNoEvents},
+		{url: "https://label.example/hooks", events: []string{"song.played"}, err: webhooks.ErrUnknownEvent},
+	}
+
+	for i, tt := range tests {
+		s.Run(strconv.Itoa(i), func() {
+			_, err := s.s.CreateWebhook(s.ctx, webhooks.CreateWebhookInput{
+				ArtistId: uuid.New(),
+				Url:      tt.url,
+				Events:   tt.events,
+			})
+			s.ErrorIs(err, tt.err)
+		})
+	}
+}
+
+func (s *WebhooksSuite) TestCreateWebhookLimit() {
+	artistId := uuid.New()
+
+	s.rm.EXPECT().CountArtistWebhooks(mock.Anything, artistId).Return(2, nil).Once()
+
+	_, err := s.s.CreateWebhook(s.ctx, webhooks.CreateWebhookInput{
+		ArtistId: artistId,
+		Url:      "https://label.example/hooks",
+		Events:   []string{webhooks.EventSongUploaded},
+	})
+	s.ErrorIs(err, webhooks.ErrTooManyWebhooks)
+}
+
+func (s *WebhooksSuite) TestNotify() {
+	artistId := uuid.New()
+	first, second := uuid.New(), uuid.New()
+
+	s.rm.EXPECT().SubscribedWebhooks(mock.Anything, postgres.SubscribedWebhooksParams{
+		ArtistID: artistId,
+		Event:    webhooks.EventSongReleased,
+	}).Return([]postgres.Webhook{{WebhookID: first}, {WebhookID: second}}, nil).Once()
+
+	var deliveryId uuid.UUID
+
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Twice()
+	s.tm.EXPECT().SaveWebhookDelivery(mock.Anything, mock.MatchedBy(func(p postgres.SaveWebhookDeliveryParams) bool {
+		return p.WebhookID == first
+	})).RunAndReturn(func(_ context.Context, p postgres.SaveWebhookDeliveryParams) (postgres.WebhookDelivery, error) {
+		var body struct {
+			Id    uuid.UUID `json:"id"`
+			Event string    `json:"event"`
+			Data  struct {
+				SongId string `json:"songId"`
+			} `json:"data"`
+		}
+
+		s.Require().NoError(json.Unmarshal(p.Payload, &body))
+		s.Equal(p.DeliveryID, body.Id)
+		s.Equal(webhooks.EventSongReleased, body.Event)
+		s.Equal("song", body.Data.SongId)
+
+		deliveryId = p.DeliveryID
+
+		return postgres.WebhookDelivery{DeliveryID: p.DeliveryID}, nil
+	}).Once()
+
+	// The event was saved for the second webhook before the broker redelivered it
+	s.tm.EXPECT().SaveWebhookDelivery(mock.Anything, mock.MatchedBy(func(p postgres.SaveWebhookDeliveryParams) bool {
+		return p.WebhookID == second
+	})).Return(postgres.WebhookDelivery{}, repoerrs.ErrEmptyResult).Once()
+
+	s.jqm.EXPECT().InTx(s.tm).Return(s.jqm).Once()
+	s.jqm.EXPECT().EnqueueJob(mock.Anything, string(webhooks.DeliveryJob), mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, payload []byte, opts jobs.Options) (uuid.UUID, error) {
+			var args webhooks.DeliveryJobArgs
+
+			s.Require().NoError(json.Unmarshal(payload, &args))
+			s.Equal(deliveryId, args.DeliveryId)
+			s.Equal("webhook:"+deliveryId.String(), opts.UniqueKey)
+			s.EqualValues(maxAttempts, opts.MaxAttempts)
+
+			return uuid.New(), nil
+		}).Once()
+	s.tm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.tm.EXPECT().Rollback(mock.Anything).Return(nil).Twice()
+
+	err := s.s.Notify(s.ctx, webhooks.NotifyInput{
+		EventId:  "/audio-hosting/songs/1",
+		Event:    webhooks.EventSongReleased,
+		ArtistId: artistId,
+		Data:     &api.SongReleasedEvent{SongId: "song", ArtistId: artistId.String()},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *WebhooksSuite) TestNotifyEnqueueError() {
+	artistId := uuid.New()
+
+	s.rm.EXPECT().SubscribedWebhooks(mock.Anything, mock.Anything).
+		Return([]postgres.Webhook{{WebhookID: uuid.New()}}, nil).Once()
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().SaveWebhookDelivery(mock.Anything, mock.Anything).
+		Return(postgres.WebhookDelivery{DeliveryID: uuid.New()}, nil).Once()
+	s.jqm.EXPECT().InTx(s.tm).Return(s.jqm).Once()
+	s.jqm.EXPECT().EnqueueJob(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(uuid.Nil, gofakeit.ErrorDatabase()).Once()
+	// The delivery is rolled back with its job, the broker redelivers the event
+	s.tm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	err := s.s.Notify(s.ctx, webhooks.NotifyInput{
+		EventId:  "/audio-hosting/songs/1",
+		Event:    webhooks.EventSongReleased,
+		ArtistId: artistId,
+		Data:     &api.SongReleasedEvent{SongId: "song", ArtistId: artistId.String()},
+	})
+	s.Error(err)
+}
+
+func (s *WebhooksSuite) TestNotifyNoWebhooks() {
+	s.rm.EXPECT().SubscribedWebhooks(mock.Anything, mock.Anything).Return(nil, repoerrs.ErrEmptyResult).Once()
+
+	err := s.s.Notify(s.ctx, webhooks.NotifyInput{
+		EventId:  "/audio-hosting/songs/1",
+		Event:    webhooks.EventSongUploaded,
+		ArtistId: uuid.New(),
+		Data:     &api.SongUploadedEvent{},
+	})
+	s.Require().NoError(err)
+}
+
+// delivery returns the pending delivery to the test server after the attempts.
+func (s *WebhooksSuite) delivery(attempts int32) postgres.WebhookDeliveryRow {
+	return postgres.WebhookDeliveryRow{
+		WebhookDelivery: postgres.WebhookDelivery{
+			DeliveryID:   uuid.New(),
+			WebhookFk:    uuid.New(),
+			EventID:      "/audio-hosting/songs/1",
+			Event:        webhooks.EventSongReleased,
+			Payload:      []byte(`{"event": "song.released"}`),
+			Status:       postgres.WebhookDeliveryStatusPending,
+			Attempts:     attempts,
+			ResponseCode: pgconv.NullInt4(),
+			Error:        pgconv.NullText(),
+			CreatedAt:    time.Now(),
+			AttemptedAt:  pgconv.NullTimestamptz(),
+		},
+		Url:    s.srv.URL + "/hooks",
+		Secret: "whsec_secret",
+	}
+}
+
+func (s *WebhooksSuite) expectAttempt(deliveryId uuid.UUID, status postgres.WebhookDeliveryStatus, code int32) {
+	s.rm.EXPECT().RecordWebhookAttempt(mock.Anything, mock.MatchedBy(func(p postgres.RecordWebhookAttemptParams) bool {
+		return p.DeliveryID == deliveryId && p.Status == status && p.ResponseCode.Int32 == code &&
+			p.Error.Valid == (status != postgres.WebhookDeliveryStatusDelivered)
+	})).Return(postgres.WebhookDelivery{}, nil).Once()
+}
+
+func (s *WebhooksSuite) TestDeliver() {
+	row := s.delivery(0)
+
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		s.NoError(err)
+
+		signature := r.Header.Get(webhooks.HeaderSignature)
+		ts, _, _ := strings.Cut(strings.TrimPrefix(signature, "t="), ",")
+		unix, err := strconv.ParseInt(ts, 10, 64)
+		s.NoError(err)
+
+		s.Equal(webhooks.Sign("whsec_secret", unix, body), signature)
+		s.Equal(webhooks.EventSongReleased, r.Header.Get(webhooks.HeaderEvent))
+		s.Equal(row.WebhookDelivery.DeliveryID.String(), r.Header.Get(webhooks.HeaderDelivery))
+		s.Equal("application/json", r.Header.Get("Content-Type"))
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	s.rm.EXPECT().WebhookDelivery(mock.Anything, row.WebhookDelivery.DeliveryID).Return(row, nil).Once()
+	s.expectAttempt(row.WebhookDelivery.DeliveryID, postgres.WebhookDeliveryStatusDelivered, http.StatusNoContent)
+
+	err := s.s.HandleDeliveryJob(s.ctx, webhooks.DeliveryJobArgs{DeliveryId: row.WebhookDelivery.DeliveryID})
+	s.Require().NoError(err)
+}
+
+func (s *WebhooksSuite) TestDeliverFails() {
+	s.handler = func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusBadGateway) }
+
+	s.Run("retried", func() {
+		row := s.delivery(0)
+
+		s.rm.EXPECT().WebhookDelivery(mock.Anything, row.WebhookDelivery.DeliveryID).Return(row, nil).Once()
+		s.expectAttempt(row.WebhookDelivery.DeliveryID, postgres.WebhookDeliveryStatusPending, http.StatusBadGateway)
+
+		err := s.s.HandleDeliveryJob(s.ctx, webhooks.DeliveryJobArgs{DeliveryId: row.WebhookDelivery.DeliveryID})
+		s.Require().Error(err)
+	})
+
+	s.Run("out of attempts", func() {
+		row := s.delivery(maxAttempts - 1)
+
+		s.rm.EXPECT().WebhookDelivery(mock.Anything, row.WebhookDelivery.DeliveryID).Return(row, nil).Once()
+		s.expectAttempt(row.WebhookDelivery.DeliveryID, postgres.WebhookDeliveryStatusFailed, http.StatusBadGateway)
+
+		err := s.s.HandleDeliveryJob(s.ctx, webhooks.DeliveryJobArgs{DeliveryId: row.WebhookDelivery.DeliveryID})
+		s.Require().NoError(err)
+	})
+}
+
+func (s *WebhooksSuite) TestDeliverDeletedWebhook() {
+	deliveryId := uuid.New()
+
+	s.rm.EXPECT().WebhookDelivery(mock.Anything, deliveryId).
+		Return(postgres.WebhookDeliveryRow{}, repoerrs.ErrEmptyResult).Once()
+
+	err := s.s.HandleDeliveryJob(s.ctx, webhooks.DeliveryJobArgs{DeliveryId: deliveryId})
+	s.Require().NoError(err)
+}
+
+func (s *WebhooksSuite) TestRedeliverWebhook() {
+	artistId, deliveryId := uuid.New(), uuid.New()
+
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().RedeliverWebhook(mock.Anything, postgres.RedeliverWebhookParams{
+		DeliveryID: deliveryId,
+		ArtistID:   artistId,
+	}).Return(postgres.WebhookDelivery{DeliveryID: deliveryId, Status: postgres.WebhookDeliveryStatusPending}, nil).Once()
+
+	s.jqm.EXPECT().InTx(s.tm).Return(s.jqm).Once()
+	s.jqm.EXPECT().EnqueueJob(mock.Anything, string(webhooks.DeliveryJob), mock.Anything, mock.MatchedBy(
+		func(opts jobs.Options) bool { return opts.UniqueKey == "webhook:"+deliveryId.String() },
+	)).Return(uuid.New(), nil).Once()
+	s.tm.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	s.tm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	out, err := s.s.RedeliverWebhook(s.ctx, webhooks.RedeliverWebhookInput{
+		ArtistId:   artistId,
+		DeliveryId: deliveryId,
+	})
+	s.Require().NoError(err)
+	s.Equal(postgres.WebhookDeliveryStatusPending, out.Delivery.Status)
+}
+
+func (s *WebhooksSuite) TestRedeliverNotFound() {
+	s.rm.EXPECT().Begin(mock.Anything).Return(s.tm, nil).Once()
+	s.tm.EXPECT().RedeliverWebhook(mock.Anything, mock.Anything).
+		Return(postgres.WebhookDelivery{}, repoerrs.ErrEmptyResult).Once()
+	s.tm.EXPECT().Rollback(mock.Anything).Return(nil).Once()
+
+	_, err := s.s.RedeliverWebhook(s.ctx, webhooks.RedeliverWebhookInput{
+		ArtistId:   uuid.New(),
+		DeliveryId: uuid.New(),
+	})
+	s.ErrorIs(err, webhooks.ErrDeliveryNotFound)
+}
+
+func (s *WebhooksSuite) TestClientRefusesPrivateAddresses() {
+	client := webhooks.NewClient(time.Second, false)
+
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, s.srv.URL, nil)
+	s.Require().NoError(err)
+
+	_, err = client.Do(req) //nolint:bodyclose
+	s.ErrorIs(err, webhooks.ErrForbiddenAddress)
+}
+
+func TestWebhooksSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(WebhooksSuite))
+}
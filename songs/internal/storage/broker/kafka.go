@@ -4,6 +4,11 @@ import (
 	"context"
 	"net"
 	"strconv"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/events"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/metrics"
 
 	"dev.gaijin.team/go/golib/e"
 	"dev.gaijin.team/go/golib/fields"
@@ -11,27 +16,91 @@ import (
 )
 
 type KafkaProducer struct {
-	w *kafka.Writer
+	w     *kafka.Writer
+	plays *kafka.Writer
+	conf  Config
+}
+
+type Config struct {
+	Brokers []string
+	// ReleasedTopic receives only song.released events.
+	ReleasedTopic string
+	// LifecycleTopic receives all the song events including song.released.
+	LifecycleTopic string
+	// PlaysTopic receives song.played events, they are too many for the lifecycle topic.
+	PlaysTopic        string
+	Partitions        int
+	ReplicationFactor int
 }
 
-func Connect(topic string, brokers []string) (*KafkaProducer, error) {
-	err := createTopic(topic, brokers)
+func Connect(conf Config) (*KafkaProducer, error) {
+	err := createTopics(conf)
 	if err != nil {
-		return nil, e.NewFrom("creating topic", err)
+		return nil, e.NewFrom("creating topics", err)
+	}
+
+	// The topic is set per message, so one writer serves both topics.
+	writer := &kafka.Writer{ //nolint:exhaustruct
+		Addr:     kafka.TCP(conf.Brokers...),
+		Balancer: &kafka.Hash{}, //nolint:exhaustruct
 	}
 
-	writer := kafka.NewWriter(kafka.WriterConfig{ //nolint:exhaustruct
-		Brokers: brokers,
-		Topic:   topic,
-	})
+	// Plays are written in the background, so streams don't wait for Kafka.
+	// A lost play costs less than a slow stream.
+	plays := &kafka.Writer{ //nolint:exhaustruct
+		Addr:     kafka.TCP(conf.Brokers...),
+		Topic:    conf.PlaysTopic,
+		Balancer: &kafka.Hash{}, //nolint:exhaustruct
+		Async:    true,
+		Completion: func(_ []kafka.Message, err error) {
+			if err != nil {
+				metrics.ProduceFailed(conf.PlaysTopic)
+			}
+		},
+	}
 
 	return &KafkaProducer{
-		w: writer,
+		w:     writer,
+		plays: plays,
+		conf:  conf,
 	}, nil
 }
 
-func createTopic(topic string, brokers []string) error {
-	conn, err := kafka.Dial("tcp", brokers[0])
+// Ping checks that one of the brokers answers with the metadata of the cluster.
+func (k *KafkaProducer) Ping(ctx context.Context) error {
+	var err error
+
+	for _, broker := range k.conf.Brokers {
+		err = pingBroker(ctx, broker)
+		if err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+func pingBroker(ctx context.Context, broker string) error {
+	conn, err := kafka.DialContext(ctx, "tcp", broker)
+	if err != nil {
+		return e.NewFrom("connecting to kafka", err, fields.F("broker", broker))
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	_, err = conn.Brokers()
+	if err != nil {
+		return e.NewFrom("getting brokers", err, fields.F("broker", broker))
+	}
+
+	return nil
+}
+
+func createTopics(conf Config) error {
+	conn, err := kafka.Dial("tcp", conf.Brokers[0])
 	if err != nil {
 		return e.NewFrom("connecting to kafka", err)
 	}
@@ -48,29 +117,96 @@ func createTopic(topic string, brokers []string) error {
 	}
 	defer controllerConn.Close()
 
-	topicConfigs := []kafka.TopicConfig{{ //nolint:exhaustruct
-		Topic:             topic,
-		NumPartitions:     1,
-		ReplicationFactor: 1,
-	}}
+	topicConfigs := make([]kafka.TopicConfig, 0, 3) //nolint:mnd
+	for _, topic := range []string{conf.ReleasedTopic, conf.LifecycleTopic, conf.PlaysTopic} {
+		topicConfigs = append(topicConfigs, kafka.TopicConfig{ //nolint:exhaustruct
+			Topic:             topic,
+			NumPartitions:     conf.Partitions,
+			ReplicationFactor: conf.ReplicationFactor,
+		})
+	}
 
+	// Existing topics are left as is.
 	err = controllerConn.CreateTopics(topicConfigs...)
 	if err != nil {
-		return e.NewFrom("creating topic", err, fields.F("topic", topic))
+		return e.NewFrom("creating topics", err,
+			fields.F("released_topic", conf.ReleasedTopic), fields.F("lifecycle_topic", conf.LifecycleTopic),
+			fields.F("plays_topic", conf.PlaysTopic))
 	}
 
 	return nil
 }
 
-func (k *KafkaProducer) SendReleasedMessages(ctx context.Context, messages []SongReleasedMessage) error {
-	msgs := make([]kafka.Message, len(messages))
+// SendReleasedMessages publishes song.released events
+// both to the released and to the lifecycle topics.
+// Messages are keyed by the artist id, so the events of one artist keep their order.
+func (k *KafkaProducer) SendReleasedMessages(ctx context.Context, messages []SongReleasedMessage) (err error) {
+	songMessages := make([]SongMessage, len(messages))
 	for i := range messages {
-		msgs[i] = kafka.Message{ //nolint:exhaustruct
-			Value: messages[i].Bytes(),
-		}
+		songMessages[i] = messages[i]
+	}
+
+	releasedCtx, releasedSpan := events.StartPublish(ctx, k.conf.ReleasedTopic, len(messages))
+	defer func() { events.EndSpan(releasedSpan, err) }()
+
+	lifecycleCtx, lifecycleSpan := events.StartPublish(ctx, k.conf.LifecycleTopic, len(messages))
+	defer func() { events.EndSpan(lifecycleSpan, err) }()
+
+	msgs, err := k.kafkaMessages(releasedCtx, k.conf.ReleasedTopic, songMessages)
+	if err != nil {
+		return err
+	}
+
+	lifecycleMsgs, err := k.kafkaMessages(lifecycleCtx, k.conf.LifecycleTopic, songMessages)
+	if err != nil {
+		return err
+	}
+
+	// The lifecycle copies are written in the same batch, the write is recorded for the released topic
+	err = k.write(ctx, k.w, k.conf.ReleasedTopic, append(msgs, lifecycleMsgs...))
+	if err != nil {
+		return e.NewFrom("sending messages to kafka", err)
+	}
+
+	return nil
+}
+
+// SendSongMessages publishes song lifecycle events to the lifecycle topic.
+func (k *KafkaProducer) SendSongMessages(ctx context.Context, messages []SongMessage) (err error) {
+	ctx, span := events.StartPublish(ctx, k.conf.LifecycleTopic, len(messages))
+	defer func() { events.EndSpan(span, err) }()
+
+	msgs, err := k.kafkaMessages(ctx, k.conf.LifecycleTopic, messages)
+	if err != nil {
+		return err
+	}
+
+	err = k.write(ctx, k.w, k.conf.LifecycleTopic, msgs)
+	if err != nil {
+		return e.NewFrom("sending messages to kafka", err)
+	}
+
+	return nil
+}
+
+// SendPlayedMessages publishes song.played events to the plays topic.
+// It doesn't wait for the messages to be written, failed writes are lost.
+func (k *KafkaProducer) SendPlayedMessages(ctx context.Context, messages []SongPlayedMessage) (err error) {
+	songMessages := make([]SongMessage, len(messages))
+	for i := range messages {
+		songMessages[i] = messages[i]
+	}
+
+	ctx, span := events.StartPublish(ctx, k.conf.PlaysTopic, len(messages))
+	defer func() { events.EndSpan(span, err) }()
+
+	// The topic is set by the writer
+	msgs, err := k.kafkaMessages(ctx, "", songMessages)
+	if err != nil {
+		return err
 	}
 
-	err := k.w.WriteMessages(ctx, msgs...)
+	err = k.plays.WriteMessages(ctx, msgs...)
 	if err != nil {
 		return e.NewFrom("sending messages to kafka", err)
 	}
@@ -78,11 +214,45 @@ func (k *KafkaProducer) SendReleasedMessages(ctx context.Context, messages []Son
 	return nil
 }
 
+func (k *KafkaProducer) write(ctx context.Context, w *kafka.Writer, topic string, msgs []kafka.Message) error {
+	start := time.Now()
+	err := w.WriteMessages(ctx, msgs...)
+
+	metrics.ObserveProduce(topic, time.Since(start), err)
+
+	return err //nolint:wrapcheck
+}
+
+func (k *KafkaProducer) kafkaMessages(
+	ctx context.Context, topic string, messages []SongMessage,
+) ([]kafka.Message, error) {
+	traceId := logger.TraceIdFromContext(ctx)
+
+	msgs := make([]kafka.Message, len(messages))
+	for i := range messages {
+		env, err := events.New(messages[i].Type(),
+			messages[i].Artist().String(), traceId, messages[i].Payload())
+		if err != nil {
+			return nil, e.NewFrom("creating event", err, fields.F("type", messages[i].Type()))
+		}
+
+		msgs[i] = env.WithTrace(ctx).Message()
+		msgs[i].Topic = topic
+	}
+
+	return msgs, nil
+}
+
 func (k *KafkaProducer) Close() error {
 	err := k.w.Close()
 	if err != nil {
 		return e.NewFrom("disconnecting from kafka", err)
 	}
 
+	err = k.plays.Close()
+	if err != nil {
+		return e.NewFrom("disconnecting plays writer from kafka", err)
+	}
+
 	return nil
 }
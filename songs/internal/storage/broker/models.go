@@ -1,24 +1,325 @@
 package broker
 
 import (
-	"encoding/json"
 	"time"
 
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/events"
+
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// SongMessage is an event of a song lifecycle.
+// Every song message goes to the lifecycle topic keyed by the artist id.
+type SongMessage interface {
+	Type() string
+	Artist() uuid.UUID
+	Payload() proto.Message
+}
+
 type SongReleasedMessage struct {
-	SongId     uuid.UUID `json:"song_id"`
-	ArtistId   uuid.UUID `json:"artist_id"`
-	Name       string    `json:"name"`
-	ReleasedAt time.Time `json:"released_at"`
+	SongId     uuid.UUID
+	ArtistId   uuid.UUID
+	Name       string
+	ReleasedAt time.Time
+}
+
+func (m SongReleasedMessage) Event() *api.SongReleasedEvent {
+	return &api.SongReleasedEvent{
+		SongId:     m.SongId.String(),
+		ArtistId:   m.ArtistId.String(),
+		Name:       m.Name,
+		ReleasedAt: timestamppb.New(m.ReleasedAt),
+	}
+}
+
+func (m SongReleasedMessage) Type() string           { return events.TypeSongReleased }
+func (m SongReleasedMessage) Artist() uuid.UUID      { return m.ArtistId }
+func (m SongReleasedMessage) Payload() proto.Message { return m.Event() }
+
+type SongCreatedMessage struct {
+	SongId        uuid.UUID
+	ArtistId      uuid.UUID
+	Name          string
+	FeatArtistIds []uuid.UUID
+	CreatedAt     time.Time
+	Explicit      bool
+}
+
+func (m SongCreatedMessage) Type() string      { return events.TypeSongCreated }
+func (m SongCreatedMessage) Artist() uuid.UUID { return m.ArtistId }
+
+func (m SongCreatedMessage) Payload() proto.Message {
+	feats := make([]string, len(m.FeatArtistIds))
+	for i := range m.FeatArtistIds {
+		feats[i] = m.FeatArtistIds[i].String()
+	}
+
+	return &api.SongCreatedEvent{
+		SongId:        m.SongId.String(),
+		ArtistId:      m.ArtistId.String(),
+		Name:          m.Name,
+		FeatArtistIds: feats,
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+		Explicit:      m.Explicit,
+	}
+}
+
+type SongUploadedMessage struct {
+	SongId     uuid.UUID
+	ArtistId   uuid.UUID
+	SongUrl    string
+	Duration   time.Duration
+	UploadedAt time.Time
+}
+
+func (m SongUploadedMessage) Type() string      { return events.TypeSongUploaded }
+func (m SongUploadedMessage) Artist() uuid.UUID { return m.ArtistId }
+
+func (m SongUploadedMessage) Payload() proto.Message {
+	return &api.SongUploadedEvent{
+		SongId:     m.SongId.String(),
+		ArtistId:   m.ArtistId.String(),
+		SongUrl:    m.SongUrl,
+		Duration:   durationpb.New(m.Duration),
+		UploadedAt: timestamppb.New(m.UploadedAt),
+	}
+}
+
+type SongUpdatedMessage struct {
+	SongId    uuid.UUID
+	ArtistId  uuid.UUID
+	Name      *string
+	Explicit  *bool
+	UpdatedAt time.Time
+}
+
+func (m SongUpdatedMessage) Type() string      { return events.TypeSongUpdated }
+func (m SongUpdatedMessage) Artist() uuid.UUID { return m.ArtistId }
+
+func (m SongUpdatedMessage) Payload() proto.Message {
+	return &api.SongUpdatedEvent{
+		SongId:    m.SongId.String(),
+		ArtistId:  m.ArtistId.String(),
+		Name:      m.Name,
+		Explicit:  m.Explicit,
+		UpdatedAt: timestamppb.New(m.UpdatedAt),
+	}
+}
+
+type SongImageChangedMessage struct {
+	SongId    uuid.UUID
+	ArtistId  uuid.UUID
+	ImageUrl  string
+	ChangedAt time.Time
+}
+
+func (m SongImageChangedMessage) Type() string      { return events.TypeSongImageChanged }
+func (m SongImageChangedMessage) Artist() uuid.UUID { return m.ArtistId }
+
+func (m SongImageChangedMessage) Payload() proto.Message {
+	return &api.SongImageChangedEvent{
+		SongId:    m.SongId.String(),
+		ArtistId:  m.ArtistId.String(),
+		ImageUrl:  m.ImageUrl,
+		ChangedAt: timestamppb.New(m.ChangedAt),
+	}
+}
+
+type SongDeletedMessage struct {
+	SongId    uuid.UUID
+	ArtistId  uuid.UUID
+	DeletedAt time.Time
+}
+
+func (m SongDeletedMessage) Type() string      { return events.TypeSongDeleted }
+func (m SongDeletedMessage) Artist() uuid.UUID { return m.ArtistId }
+
+func (m SongDeletedMessage) Payload() proto.Message {
+	return &api.SongDeletedEvent{
+		SongId:    m.SongId.String(),
+		ArtistId:  m.ArtistId.String(),
+		DeletedAt: timestamppb.New(m.DeletedAt),
+	}
+}
+
+type SongRestoredMessage struct {
+	SongId     uuid.UUID
+	ArtistId   uuid.UUID
+	RestoredAt time.Time
+}
+
+func (m SongRestoredMessage) Type() string      { return events.TypeSongRestored }
+func (m SongRestoredMessage) Artist() uuid.UUID { return m.ArtistId }
+
+func (m SongRestoredMessage) Payload() proto.Message {
+	return &api.SongRestoredEvent{
+		SongId:     m.SongId.String(),
+		ArtistId:   m.ArtistId.String(),
+		RestoredAt: timestamppb.New(m.RestoredAt),
+	}
+}
+
+// SongPlayedMessage goes to the plays topic only, see [KafkaProducer.SendPlayedMessages].
+type SongPlayedMessage struct {
+	SongId     uuid.UUID
+	ArtistId   uuid.UUID
+	ListenerId string
+	PlayedAt   time.Time
+}
+
+func (m SongPlayedMessage) Type() string      { return events.TypeSongPlayed }
+func (m SongPlayedMessage) Artist() uuid.UUID { return m.ArtistId }
+
+func (m SongPlayedMessage) Payload() proto.Message {
+	return &api.SongPlayedEvent{
+		SongId:     m.SongId.String(),
+		ArtistId:   m.ArtistId.String(),
+		ListenerId: m.ListenerId,
+		PlayedAt:   timestamppb.New(m.PlayedAt),
+	}
+}
+
+type SongModeratedMessage struct {
+	SongId      uuid.UUID
+	ArtistId    uuid.UUID
+	Status      postgres.ModerationStatus
+	Reason      *string
+	ModeratedAt time.Time
+}
+
+func (m SongModeratedMessage) Type() string      { return events.TypeSongModerated }
+func (m SongModeratedMessage) Artist() uuid.UUID { return m.ArtistId }
+
+func (m SongModeratedMessage) Payload() proto.Message {
+	return &api.SongModeratedEvent{
+		SongId:      m.SongId.String(),
+		ArtistId:    m.ArtistId.String(),
+		Status:      moderationStatus(m.Status),
+		Reason:      m.Reason,
+		ModeratedAt: timestamppb.New(m.ModeratedAt),
+	}
+}
+
+// ClaimMessage is sent on every claim status change,
+// the artist is the one whose song is claimed.
+type ClaimMessage struct {
+	ClaimId    uuid.UUID
+	SongId     uuid.UUID
+	ArtistId   uuid.UUID
+	ClaimantId uuid.UUID
+	Status     postgres.ClaimStatus
+	OccurredAt time.Time
+}
+
+func (m ClaimMessage) Type() string {
+	switch m.Status {
+	case postgres.ClaimStatusCounterNoticed:
+		return events.TypeClaimCounterNoticed
+	case postgres.ClaimStatusUpheld, postgres.ClaimStatusRejected:
+		return events.TypeClaimResolved
+	default:
+		return events.TypeClaimSubmitted
+	}
+}
+
+func (m ClaimMessage) Artist() uuid.UUID { return m.ArtistId }
+
+func (m ClaimMessage) Payload() proto.Message {
+	return &api.SongClaimEvent{
+		ClaimId:    m.ClaimId.String(),
+		SongId:     m.SongId.String(),
+		ArtistId:   m.ArtistId.String(),
+		ClaimantId: m.ClaimantId.String(),
+		Status:     claimStatus(m.Status),
+		OccurredAt: timestamppb.New(m.OccurredAt),
+	}
 }
 
-func (m SongReleasedMessage) Bytes() []byte {
-	bytes, err := json.Marshal(m)
-	if err != nil {
-		return []byte{}
+// CreditMessage is sent when an artist is credited on a song of another artist
+// and when the credited artist resolves it, the artist is the one whose song it is.
+type CreditMessage struct {
+	SongId           uuid.UUID
+	ArtistId         uuid.UUID
+	CreditedArtistId uuid.UUID
+	Role             postgres.CreditRole
+	Status           postgres.CreditStatus
+	OccurredAt       time.Time
+}
+
+func (m CreditMessage) Type() string {
+	if m.Status == postgres.CreditStatusPending {
+		return events.TypeCreditRequested
 	}
 
-	return bytes
+	return events.TypeCreditResolved
+}
+
+func (m CreditMessage) Artist() uuid.UUID { return m.ArtistId }
+
+func (m CreditMessage) Payload() proto.Message {
+	return &api.SongCreditEvent{
+		SongId:           m.SongId.String(),
+		ArtistId:         m.ArtistId.String(),
+		CreditedArtistId: m.CreditedArtistId.String(),
+		Role:             creditRole(m.Role),
+		Status:           creditStatus(m.Status),
+		OccurredAt:       timestamppb.New(m.OccurredAt),
+	}
+}
+
+func creditRole(role postgres.CreditRole) api.CreditRole {
+	switch role {
+	case postgres.CreditRolePrimary:
+		return api.CreditRole_PRIMARY
+	case postgres.CreditRoleProducer:
+		return api.CreditRole_PRODUCER
+	case postgres.CreditRoleComposer:
+		return api.CreditRole_COMPOSER
+	case postgres.CreditRoleLyricist:
+		return api.CreditRole_LYRICIST
+	case postgres.CreditRoleMixingEngineer:
+		return api.CreditRole_MIXING_ENGINEER
+	default:
+		return api.CreditRole_FEATURED
+	}
+}
+
+func creditStatus(status postgres.CreditStatus) api.CreditStatus {
+	switch status {
+	case postgres.CreditStatusPending:
+		return api.CreditStatus_CREDIT_PENDING
+	case postgres.CreditStatusDeclined:
+		return api.CreditStatus_CREDIT_DECLINED
+	default:
+		return api.CreditStatus_CREDIT_APPROVED
+	}
+}
+
+func claimStatus(status postgres.ClaimStatus) api.ClaimStatus {
+	switch status {
+	case postgres.ClaimStatusCounterNoticed:
+		return api.ClaimStatus_COUNTER_NOTICED
+	case postgres.ClaimStatusUpheld:
+		return api.ClaimStatus_UPHELD
+	case postgres.ClaimStatusRejected:
+		return api.ClaimStatus_REJECTED
+	default:
+		return api.ClaimStatus_PENDING
+	}
+}
+
+func moderationStatus(status postgres.ModerationStatus) api.ModerationStatus {
+	switch status {
+	case postgres.ModerationStatusUnderReview:
+		return api.ModerationStatus_UNDER_REVIEW
+	case postgres.ModerationStatusTakenDown:
+		return api.ModerationStatus_TAKEN_DOWN
+	default:
+		return api.ModerationStatus_ACTIVE
+	}
 }
@@ -0,0 +1,29 @@
+package broker
+
+import (
+	"context"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+)
+
+// SongSender publishes lifecycle events, it is [KafkaProducer].
+type SongSender interface {
+	SendSongMessages(context.Context, []SongMessage) error
+}
+
+// SendOrLog publishes the lifecycle events of a change the services have already stored.
+// The change can't be undone then, so a failure is only logged and does not fail the request.
+func SendOrLog(ctx context.Context, sender SongSender, messages ...SongMessage) {
+	if len(messages) == 0 {
+		return
+	}
+
+	log := logger.FromContext(ctx)
+
+	// TODO: add outbox
+	err := sender.SendSongMessages(ctx, messages)
+	if err != nil {
+		log.Warn().Err(err).Int("count", len(messages)).Str("type", messages[0].Type()).
+			Msg("error sending song messages")
+	}
+}
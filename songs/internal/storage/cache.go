@@ -7,6 +7,7 @@ import (
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/metrics"
 
 	"dev.gaijin.team/go/golib/e"
 	"dev.gaijin.team/go/golib/fields"
@@ -45,9 +46,15 @@ func (s *Storage) ReleasedSongs(ctx context.Context, params postgres.ReleasedSon
 			continue
 		}
 
+		if params.HideExplicit && song.Song.Explicit {
+			continue
+		}
+
 		result = append(result, song)
 	}
 
+	metrics.ObserveCache("released_songs", len(params.Ids)-len(restIds), len(restIds))
+
 	if len(restIds) == 0 {
 		log.Debug().Msg("all songs found in cache")
 		return result, nil
@@ -260,6 +267,64 @@ func (s *Storage) PatchSongs(ctx context.Context, params postgres.PatchSongsPara
 	return s.PgStorage.PatchSongs(ctx, params) //nolint:wrapcheck
 }
 
+// InvalidateSongs removes the songs from the caches.
+// Use it after changing songs in a transaction.
+func (s *Storage) InvalidateSongs(ctx context.Context, ids []uuid.UUID) {
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		log := logger.FromContext(ctx)
+
+		log.Trace().Array("ids", logger.Stringers[uuid.UUID](ids)).Msg("invalidating cache")
+
+		cache1 := s.RedStorage.With("released")
+		cache2 := s.RedStorage.With("my")
+
+		for _, id := range ids {
+			err := cache1.Del(cacheCtx, id.String()) //nolint:contextcheck
+			if err != nil {
+				log.Warn().Err(err).Str("ns", "released").Stringer("song_id", id).
+					Msg("error deleting song from cache")
+			}
+
+			err = cache2.Del(cacheCtx, id.String()) //nolint:contextcheck
+			if err != nil {
+				log.Warn().Err(err).Str("ns", "my").Stringer("song_id", id).
+					Msg("error deleting song from cache")
+			}
+		}
+	}()
+}
+
+func (s *Storage) ModerateSong(ctx context.Context, params postgres.ModerateSongParams) (postgres.Song, error) {
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		log := logger.FromContext(ctx)
+
+		log.Trace().Stringer("song_id", params.SongID).Msg("song moderated, invalidating cache")
+
+		cache1 := s.RedStorage.With("released")
+		cache2 := s.RedStorage.With("my")
+
+		err := cache1.Del(cacheCtx, params.SongID.String()) //nolint:contextcheck
+		if err != nil {
+			log.Warn().Err(err).Str("ns", "released").Stringer("song_id", params.SongID).
+				Msg("error deleting song from cache")
+		}
+
+		err = cache2.Del(cacheCtx, params.SongID.String()) //nolint:contextcheck
+		if err != nil {
+			log.Warn().Err(err).Str("ns", "my").Stringer("song_id", params.SongID).
+				Msg("error deleting song from cache")
+		}
+	}()
+
+	return s.PgStorage.ModerateSong(ctx, params) //nolint:wrapcheck
+}
+
 func (s *Storage) UpdateSong(ctx context.Context, params postgres.UpdateSongParams) (postgres.Song, error) {
 	go func() {
 		cacheCtx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -281,19 +346,19 @@ func (s *Storage) UpdateSong(ctx context.Context, params postgres.UpdateSongPara
 	return s.PgStorage.UpdateSong(ctx, params) //nolint:wrapcheck
 }
 
-func (s *Storage) DeleteSong(ctx context.Context, ids []uuid.UUID) error {
+func (s *Storage) TrashSongs(ctx context.Context, params postgres.TrashSongsParams) error {
 	go func() {
 		cacheCtx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
 
 		log := logger.FromContext(ctx)
 
-		log.Trace().Array("ids", logger.Stringers[uuid.UUID](ids)).Msg("songs deleted, invalidating cache")
+		log.Trace().Array("ids", logger.Stringers[uuid.UUID](params.Ids)).Msg("songs trashed, invalidating cache")
 
 		cache1 := s.RedStorage.With("my")
 		cache2 := s.RedStorage.With("released")
 
-		for _, id := range ids {
+		for _, id := range params.Ids {
 			err := cache1.Del(cacheCtx, id.String()) //nolint:contextcheck
 			if err != nil {
 				log.Warn().Err(err).Stringer("song_id", id).Str("ns", "my").
@@ -308,6 +373,63 @@ func (s *Storage) DeleteSong(ctx context.Context, ids []uuid.UUID) error {
 		}
 	}()
 
-	return s.PgStorage.DeleteSongs(ctx, ids) //nolint:wrapcheck
+	return s.PgStorage.TrashSongs(ctx, params) //nolint:wrapcheck
+}
+
+func (s *Storage) ReplaceCredits(ctx context.Context, params postgres.ReplaceCreditsParams) error {
+	err := s.PgStorage.ReplaceCredits(ctx, params)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	s.InvalidateSongs(ctx, []uuid.UUID{params.SongID})
+
+	return nil
+}
+
+func (s *Storage) ResolveCreditRequest(ctx context.Context, params postgres.ResolveCreditRequestParams,
+) (postgres.ResolveCreditRequestRow, error) {
+	row, err := s.PgStorage.ResolveCreditRequest(ctx, params)
+	if err != nil {
+		return row, err //nolint:wrapcheck
+	}
+
+	s.InvalidateSongs(ctx, []uuid.UUID{row.SongFk})
+
+	return row, nil
+}
+
+func (s *Storage) SetAudioState(ctx context.Context, params postgres.SetAudioStateParams) (postgres.Song, error) {
+	song, err := s.PgStorage.SetAudioState(ctx, params)
+	if err != nil {
+		return song, err //nolint:wrapcheck
+	}
+
+	s.InvalidateSongs(ctx, []uuid.UUID{params.SongID})
+
+	return song, nil
+}
+
+func (s *Storage) FinishProcessing(ctx context.Context, params postgres.FinishProcessingParams) (postgres.Song, error) {
+	song, err := s.PgStorage.FinishProcessing(ctx, params)
+	if err != nil {
+		return song, err //nolint:wrapcheck
+	}
+
+	s.InvalidateSongs(ctx, []uuid.UUID{params.SongID})
+
+	return song, nil
+}
+
+func (s *Storage) FailStaleUploads(ctx context.Context, params postgres.FailStaleUploadsParams) ([]uuid.UUID, error) {
+	ids, err := s.PgStorage.FailStaleUploads(ctx, params)
+	if err != nil {
+		return ids, err //nolint:wrapcheck
+	}
+
+	if len(ids) > 0 {
+		s.InvalidateSongs(ctx, ids)
+	}
 
+	return ids, nil
 }
@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CountUpload counts an upload of the artist in the current fixed window
+// and returns the number of uploads in the window including this one.
+func (s *Storage) CountUpload(ctx context.Context, artistId uuid.UUID, window time.Duration) (int64, error) {
+	windowStart := time.Now().Truncate(window).Unix()
+
+	return s.RedStorage.With("uploads").Incr( //nolint:wrapcheck
+		ctx, artistId.String()+":"+strconv.FormatInt(windowStart, 10), window)
+}
+
+// CountImportUpload counts an upload of an import of the artist in the current fixed window,
+// imports have a budget of their own, see [Storage.CountUpload].
+func (s *Storage) CountImportUpload(ctx context.Context, artistId uuid.UUID, window time.Duration) (int64, error) {
+	windowStart := time.Now().Truncate(window).Unix()
+
+	return s.RedStorage.With("import-uploads").Incr( //nolint:wrapcheck
+		ctx, artistId.String()+":"+strconv.FormatInt(windowStart, 10), window)
+}
+
+// AcquireUploadSlot marks the upload of the artist as running
+// and returns the number of running uploads including this one.
+// The slot expires after ttl, so slots of crashed instances are not leaked.
+func (s *Storage) AcquireUploadSlot(ctx context.Context, artistId uuid.UUID, uploadId string, ttl time.Duration,
+) (int64, error) {
+	return s.RedStorage.With("running-uploads").AddExpiring(ctx, artistId.String(), uploadId, ttl) //nolint:wrapcheck
+}
+
+// ReleaseUploadSlot marks the upload of the artist as finished.
+func (s *Storage) ReleaseUploadSlot(ctx context.Context, artistId uuid.UUID, uploadId string) error {
+	return s.RedStorage.With("running-uploads").RemoveExpiring(ctx, artistId.String(), uploadId) //nolint:wrapcheck
+}
+
+// CountPlay marks the song as played by the listener for the window
+// and reports whether it is the first play of the listener since the window started.
+func (s *Storage) CountPlay(ctx context.Context, listenerId string, songId uuid.UUID, window time.Duration,
+) (bool, error) {
+	return s.RedStorage.With("plays").SetNX(ctx, listenerId+":"+songId.String(), window) //nolint:wrapcheck
+}
@@ -1,14 +1,12 @@
-package s3minio
+package objects
 
 import (
 	"io"
-	"time"
 )
 
 type SongObject struct {
 	Id          string
 	Extension   string
-	Duration    time.Duration
 	WeightBytes int32
 	Content     io.Reader
 }
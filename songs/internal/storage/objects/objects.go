@@ -0,0 +1,294 @@
+package objects
+
+import (
+	"context"
+	"io"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/objstore"
+)
+
+type ObjStorage struct {
+	store            objstore.Store
+	songsBucket      string
+	imagesBucket     string
+	exportsBucket    string
+	previewsBucket   string
+	thumbnailsBucket string
+}
+
+type Config struct {
+	// Backend is objstore.BackendMinio or objstore.BackendLocal
+	Backend          string
+	Endpoint         string
+	AccessKey        string
+	SecretKey        string
+	UseSsl           bool
+	LocalDir         string
+	SongsBucket      string
+	ImagesBucket     string
+	ExportsBucket    string
+	PreviewsBucket   string
+	ThumbnailsBucket string
+}
+
+func Connect(ctx context.Context, conf Config) (*ObjStorage, error) {
+	store, err := newStore(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &ObjStorage{
+		store:            store,
+		songsBucket:      conf.SongsBucket,
+		imagesBucket:     conf.ImagesBucket,
+		exportsBucket:    conf.ExportsBucket,
+		previewsBucket:   conf.PreviewsBucket,
+		thumbnailsBucket: conf.ThumbnailsBucket,
+	}
+
+	buckets := []string{
+		conf.SongsBucket, conf.ImagesBucket, conf.ExportsBucket, conf.PreviewsBucket, conf.ThumbnailsBucket,
+	}
+
+	for _, bucket := range buckets {
+		err = store.MakeBucket(ctx, bucket)
+		if err != nil {
+			return nil, e.NewFrom("creating buckets", err)
+		}
+	}
+
+	return s, nil
+}
+
+func newStore(conf Config) (objstore.Store, error) {
+	switch conf.Backend {
+	case objstore.BackendMinio:
+		transport, err := minio.DefaultTransport(conf.UseSsl)
+		if err != nil {
+			return nil, e.NewFrom("creating minio transport", err)
+		}
+
+		client, err := minio.New(conf.Endpoint, &minio.Options{ //nolint:exhaustruct
+			Creds:     credentials.NewStaticV4(conf.AccessKey, conf.SecretKey, ""),
+			Secure:    conf.UseSsl,
+			Transport: otelhttp.NewTransport(transport),
+		})
+		if err != nil {
+			return nil, e.NewFrom("connecting to minio", err)
+		}
+
+		return objstore.NewMinio(client), nil
+
+	case objstore.BackendLocal:
+		store, err := objstore.NewLocal(conf.LocalDir)
+		if err != nil {
+			return nil, e.NewFrom("opening local object storage", err, fields.F("dir", conf.LocalDir))
+		}
+
+		return store, nil
+	}
+
+	return nil, e.New("unknown object storage backend", fields.F("backend", conf.Backend))
+}
+
+// Ping checks that the storage is reachable and has the songs bucket.
+func (s *ObjStorage) Ping(ctx context.Context) error {
+	ok, err := s.store.BucketExists(ctx, s.songsBucket)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if !ok {
+		return e.New("bucket is missing", fields.F("bucket", s.songsBucket))
+	}
+
+	return nil
+}
+
+func (s *ObjStorage) PutSongObject(ctx context.Context, song SongObject) error {
+	_, err := s.store.Put(ctx, s.songsBucket, song.Id,
+		song.Content, int64(song.WeightBytes), objstore.PutOptions{}) //nolint:exhaustruct
+	if err != nil {
+		return e.NewFrom("saving song object", err,
+			fields.F("song_id", song.Id), fields.F("weight", song.WeightBytes))
+	}
+
+	return nil
+}
+
+// GetSongObject returns the song, the caller closes it. A missing one is objstore.ErrNotFound.
+func (s *ObjStorage) GetSongObject(ctx context.Context, id string) (io.Reader, error) {
+	object, err := s.store.Get(ctx, s.songsBucket, id)
+	if err != nil {
+		return nil, e.NewFrom("getting song object", err, fields.F("song_id", id))
+	}
+
+	return object, nil
+}
+
+func (s *ObjStorage) PutImageObject(ctx context.Context, image ImageObject) error {
+	_, err := s.store.Put(ctx, s.imagesBucket, image.Id,
+		image.Content, int64(image.WeightBytes), objstore.PutOptions{}) //nolint:exhaustruct
+	if err != nil {
+		return e.NewFrom("saving song image object", err,
+			fields.F("image_id", image.Id), fields.F("weight", image.WeightBytes))
+	}
+
+	return nil
+}
+
+// GetImageObject returns the image, the caller closes it. A missing one is objstore.ErrNotFound.
+func (s *ObjStorage) GetImageObject(ctx context.Context, id string) (io.Reader, error) {
+	object, err := s.store.Get(ctx, s.imagesBucket, id)
+	if err != nil {
+		return nil, e.NewFrom("getting song image object", err, fields.F("image_id", id))
+	}
+
+	return object, nil
+}
+
+// DeleteSongObject removes the song object, removing a missing object is not an error.
+func (s *ObjStorage) DeleteSongObject(ctx context.Context, id string) error {
+	err := s.store.Delete(ctx, s.songsBucket, id)
+	if err != nil {
+		return e.NewFrom("removing song object", err, fields.F("song_id", id))
+	}
+
+	return nil
+}
+
+// DeleteImageObject removes the image object, removing a missing object is not an error.
+func (s *ObjStorage) DeleteImageObject(ctx context.Context, id string) error {
+	err := s.store.Delete(ctx, s.imagesBucket, id)
+	if err != nil {
+		return e.NewFrom("removing song image object", err, fields.F("image_id", id))
+	}
+
+	return nil
+}
+
+// ListSongObjects calls fn for every song object until fn returns an error.
+func (s *ObjStorage) ListSongObjects(ctx context.Context, fn func(objstore.ObjectInfo) error) error {
+	err := s.store.List(ctx, s.songsBucket, "", fn)
+	if err != nil {
+		return e.NewFrom("listing song objects", err)
+	}
+
+	return nil
+}
+
+// ListImageObjects calls fn for every song image object until fn returns an error.
+func (s *ObjStorage) ListImageObjects(ctx context.Context, fn func(objstore.ObjectInfo) error) error {
+	err := s.store.List(ctx, s.imagesBucket, "", fn)
+	if err != nil {
+		return e.NewFrom("listing song image objects", err)
+	}
+
+	return nil
+}
+
+// exportPartSize keeps the buffers of streamed exports small, their size is not known in advance.
+const exportPartSize = 16 << 20
+
+// PutExportObject streams the export until content ends and returns its size.
+func (s *ObjStorage) PutExportObject(ctx context.Context, id string, content io.Reader) (int64, error) {
+	size, err := s.store.Put(ctx, s.exportsBucket, id, content, -1, objstore.PutOptions{
+		ContentType: "application/zip",
+		PartSize:    exportPartSize,
+	})
+	if err != nil {
+		return 0, e.NewFrom("saving export object", err, fields.F("export_id", id))
+	}
+
+	return size, nil
+}
+
+// GetExportObject returns the export, the caller closes it. A missing one is objstore.ErrNotFound.
+func (s *ObjStorage) GetExportObject(ctx context.Context, id string) (io.Reader, error) {
+	object, err := s.store.Get(ctx, s.exportsBucket, id)
+	if err != nil {
+		return nil, e.NewFrom("getting export object", err, fields.F("export_id", id))
+	}
+
+	return object, nil
+}
+
+// DeleteExportObject removes the export object, removing a missing object is not an error.
+func (s *ObjStorage) DeleteExportObject(ctx context.Context, id string) error {
+	err := s.store.Delete(ctx, s.exportsBucket, id)
+	if err != nil {
+		return e.NewFrom("removing export object", err, fields.F("export_id", id))
+	}
+
+	return nil
+}
+
+// PutPreviewObject stores the preview clip of the song, it has the id of the song object.
+func (s *ObjStorage) PutPreviewObject(ctx context.Context, id string, content io.Reader, size int64) error {
+	_, err := s.store.Put(ctx, s.previewsBucket, id, content, size, objstore.PutOptions{ //nolint:exhaustruct
+		ContentType: "audio/mpeg",
+	})
+	if err != nil {
+		return e.NewFrom("saving preview object", err, fields.F("preview_id", id))
+	}
+
+	return nil
+}
+
+// GetPreviewObject returns the preview, the caller closes it. A missing one is objstore.ErrNotFound.
+func (s *ObjStorage) GetPreviewObject(ctx context.Context, id string) (io.Reader, error) {
+	object, err := s.store.Get(ctx, s.previewsBucket, id)
+	if err != nil {
+		return nil, e.NewFrom("getting preview object", err, fields.F("preview_id", id))
+	}
+
+	return object, nil
+}
+
+// DeletePreviewObject removes the preview object, removing a missing object is not an error.
+func (s *ObjStorage) DeletePreviewObject(ctx context.Context, id string) error {
+	err := s.store.Delete(ctx, s.previewsBucket, id)
+	if err != nil {
+		return e.NewFrom("removing preview object", err, fields.F("preview_id", id))
+	}
+
+	return nil
+}
+
+// PutThumbnailObject stores the jpeg thumbnail of the image, it has the id of the image object.
+func (s *ObjStorage) PutThumbnailObject(ctx context.Context, id string, content io.Reader, size int64) error {
+	_, err := s.store.Put(ctx, s.thumbnailsBucket, id, content, size, objstore.PutOptions{ //nolint:exhaustruct
+		ContentType: "image/jpeg",
+	})
+	if err != nil {
+		return e.NewFrom("saving thumbnail object", err, fields.F("thumbnail_id", id))
+	}
+
+	return nil
+}
+
+// GetThumbnailObject returns the thumbnail, the caller closes it. A missing one is objstore.ErrNotFound.
+func (s *ObjStorage) GetThumbnailObject(ctx context.Context, id string) (io.Reader, error) {
+	object, err := s.store.Get(ctx, s.thumbnailsBucket, id)
+	if err != nil {
+		return nil, e.NewFrom("getting thumbnail object", err, fields.F("thumbnail_id", id))
+	}
+
+	return object, nil
+}
+
+// DeleteThumbnailObject removes the thumbnail object, removing a missing object is not an error.
+func (s *ObjStorage) DeleteThumbnailObject(ctx context.Context, id string) error {
+	err := s.store.Delete(ctx, s.thumbnailsBucket, id)
+	if err != nil {
+		return e.NewFrom("removing thumbnail object", err, fields.F("thumbnail_id", id))
+	}
+
+	return nil
+}
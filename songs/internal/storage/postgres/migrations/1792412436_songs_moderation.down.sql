@@ -0,0 +1,7 @@
+ALTER TABLE songs
+DROP COLUMN moderation_status,
+DROP COLUMN moderation_reason,
+DROP COLUMN moderator_id,
+DROP COLUMN moderated_at;
+
+DROP TYPE moderation_status;
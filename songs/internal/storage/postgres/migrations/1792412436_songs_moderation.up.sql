@@ -0,0 +1,7 @@
+CREATE TYPE moderation_status AS ENUM ('active', 'under_review', 'taken_down');
+
+ALTER TABLE songs
+ADD COLUMN moderation_status moderation_status NOT NULL DEFAULT 'active',
+ADD COLUMN moderation_reason TEXT,
+ADD COLUMN moderator_id UUID,
+ADD COLUMN moderated_at TIMESTAMPTZ;
@@ -0,0 +1,36 @@
+CREATE TYPE claim_status AS ENUM ('pending', 'counter_noticed', 'upheld', 'rejected');
+
+CREATE TABLE claims
+(
+  claim_id        UUID         NOT NULL,
+  song_fk         UUID         NOT NULL REFERENCES songs(song_id) ON DELETE CASCADE,
+  claimant_id     UUID         NOT NULL,
+  claimant_name   VARCHAR(256) NOT NULL,
+  claimant_email  VARCHAR(256) NOT NULL,
+  description     TEXT         NOT NULL,
+  evidence_urls   TEXT[]       NOT NULL,
+  status          claim_status NOT NULL DEFAULT 'pending',
+  counter_notice  TEXT,
+  resolution_note TEXT,
+  created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+  updated_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (claim_id)
+);
+
+CREATE INDEX claims_song_fk_idx ON claims (song_fk);
+CREATE INDEX claims_claimant_id_idx ON claims (claimant_id);
+
+-- Every status transition of a claim, claims are a legal matter.
+CREATE TABLE claim_events
+(
+  event_id    BIGSERIAL    NOT NULL,
+  claim_fk    UUID         NOT NULL REFERENCES claims(claim_id) ON DELETE CASCADE,
+  from_status claim_status,
+  to_status   claim_status NOT NULL,
+  actor_id    UUID         NOT NULL,
+  note        TEXT,
+  created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (event_id)
+);
+
+CREATE INDEX claim_events_claim_fk_idx ON claim_events (claim_fk);
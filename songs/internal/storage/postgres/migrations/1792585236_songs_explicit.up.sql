@@ -0,0 +1,2 @@
+ALTER TABLE songs
+ADD COLUMN explicit BOOLEAN NOT NULL DEFAULT FALSE;
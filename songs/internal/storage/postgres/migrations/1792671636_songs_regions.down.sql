@@ -0,0 +1,3 @@
+ALTER TABLE songs
+DROP COLUMN allowed_countries,
+DROP COLUMN denied_countries;
@@ -0,0 +1,3 @@
+ALTER TABLE songs
+ADD COLUMN allowed_countries TEXT[] NOT NULL DEFAULT '{}',
+ADD COLUMN denied_countries TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,4 @@
+DROP INDEX songs_deleted_at_idx;
+
+ALTER TABLE songs
+DROP COLUMN deleted_at;
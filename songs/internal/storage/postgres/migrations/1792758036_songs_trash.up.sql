@@ -0,0 +1,5 @@
+-- Deleted songs stay in the trash until the purge worker removes them with their objects.
+ALTER TABLE songs
+ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX songs_deleted_at_idx ON songs (deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,15 @@
+DROP INDEX feats_artist_fk_idx;
+DROP INDEX feats_song_fk_idx;
+
+DELETE FROM feats WHERE artist_fk IS NULL OR role <> 'featured';
+
+ALTER TABLE feats
+DROP CONSTRAINT feats_artist_or_name,
+DROP COLUMN name,
+DROP COLUMN role,
+DROP COLUMN credit_id,
+ALTER COLUMN artist_fk SET NOT NULL;
+
+ALTER TABLE feats ADD PRIMARY KEY (song_fk, artist_fk);
+
+DROP TYPE credit_role;
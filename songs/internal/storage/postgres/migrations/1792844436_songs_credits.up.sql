@@ -0,0 +1,14 @@
+-- Feats become song credits: a credit references an artist account or names a person without one.
+CREATE TYPE credit_role AS ENUM ('primary', 'featured', 'producer', 'composer', 'lyricist', 'mixing_engineer');
+
+ALTER TABLE feats DROP CONSTRAINT feats_pkey;
+
+ALTER TABLE feats
+ADD COLUMN credit_id BIGSERIAL PRIMARY KEY,
+ADD COLUMN role credit_role NOT NULL DEFAULT 'featured',
+ADD COLUMN name VARCHAR(128),
+ALTER COLUMN artist_fk DROP NOT NULL,
+ADD CONSTRAINT feats_artist_or_name CHECK (artist_fk IS NOT NULL OR name IS NOT NULL);
+
+CREATE INDEX feats_song_fk_idx ON feats (song_fk);
+CREATE INDEX feats_artist_fk_idx ON feats (artist_fk) WHERE artist_fk IS NOT NULL;
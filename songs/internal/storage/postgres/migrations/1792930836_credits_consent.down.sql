@@ -0,0 +1,6 @@
+DROP INDEX feats_pending_idx;
+
+ALTER TABLE feats
+DROP COLUMN status;
+
+DROP TYPE credit_status;
@@ -0,0 +1,7 @@
+-- Artists approve or decline the credits other artists give them, existing credits stay approved.
+CREATE TYPE credit_status AS ENUM ('pending', 'approved', 'declined');
+
+ALTER TABLE feats
+ADD COLUMN status credit_status NOT NULL DEFAULT 'approved';
+
+CREATE INDEX feats_pending_idx ON feats (artist_fk) WHERE status = 'pending';
@@ -0,0 +1,3 @@
+DROP TABLE exports;
+
+DROP TYPE export_status;
@@ -0,0 +1,19 @@
+-- Artists export their catalogs as zips built by the export worker, the zips expire after a while.
+CREATE TYPE export_status AS ENUM ('pending', 'running', 'ready', 'failed');
+
+CREATE TABLE exports (
+  export_id UUID PRIMARY KEY,
+  artist_fk UUID NOT NULL,
+  status export_status NOT NULL DEFAULT 'pending',
+  error TEXT,
+  weight_bytes BIGINT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  started_at TIMESTAMPTZ,
+  finished_at TIMESTAMPTZ,
+  expires_at TIMESTAMPTZ
+);
+
+CREATE INDEX exports_artist_fk_idx ON exports (artist_fk, created_at DESC);
+-- An artist has one unfinished export at most
+CREATE UNIQUE INDEX exports_unfinished_idx ON exports (artist_fk) WHERE status IN ('pending', 'running');
+CREATE INDEX exports_expires_at_idx ON exports (expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,7 @@
+DROP TABLE artist_followers;
+
+DROP TABLE song_listeners;
+
+DROP TABLE song_daily_stats;
+
+DROP TABLE analytics_events;
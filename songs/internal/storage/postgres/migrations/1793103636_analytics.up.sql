@@ -0,0 +1,36 @@
+-- Analytics of artists: counters are aggregated per song and day from the plays
+-- and from the events of the playlists service, followers are snapshotted daily.
+
+-- Events are delivered at least once, the processed ones are kept for a while
+-- so a redelivered event is not counted twice.
+CREATE TABLE analytics_events (
+  event_id     TEXT PRIMARY KEY,
+  processed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX analytics_events_processed_at_idx ON analytics_events (processed_at);
+
+CREATE TABLE song_daily_stats (
+  song_fk       UUID   NOT NULL REFERENCES songs(song_id) ON DELETE CASCADE,
+  day           DATE   NOT NULL,
+  plays         BIGINT NOT NULL DEFAULT 0,
+  likes         BIGINT NOT NULL DEFAULT 0,
+  unlikes       BIGINT NOT NULL DEFAULT 0,
+  playlist_adds BIGINT NOT NULL DEFAULT 0,
+  PRIMARY KEY (song_fk, day)
+);
+
+-- Listeners are user ids or hashes of addresses for anonymous ones.
+CREATE TABLE song_listeners (
+  song_fk  UUID NOT NULL REFERENCES songs(song_id) ON DELETE CASCADE,
+  day      DATE NOT NULL,
+  listener TEXT NOT NULL,
+  PRIMARY KEY (song_fk, day, listener)
+);
+
+CREATE TABLE artist_followers (
+  artist_fk UUID   NOT NULL,
+  day       DATE   NOT NULL,
+  followers BIGINT NOT NULL,
+  PRIMARY KEY (artist_fk, day)
+);
@@ -0,0 +1,9 @@
+ALTER TABLE songs
+DROP COLUMN bitrate,
+DROP COLUMN bitrate_mode,
+DROP COLUMN sample_rate,
+DROP COLUMN channel_mode,
+DROP COLUMN encoder;
+
+DROP TYPE channel_mode;
+DROP TYPE bitrate_mode;
@@ -0,0 +1,11 @@
+CREATE TYPE bitrate_mode AS ENUM ('cbr', 'vbr');
+CREATE TYPE channel_mode AS ENUM ('stereo', 'joint_stereo', 'dual_channel', 'mono');
+
+-- Technical metadata of the uploaded file, NULL until the file is uploaded.
+-- The encoder is empty for files without a LAME tag.
+ALTER TABLE songs
+ADD COLUMN bitrate INT,
+ADD COLUMN bitrate_mode bitrate_mode,
+ADD COLUMN sample_rate INT,
+ADD COLUMN channel_mode channel_mode,
+ADD COLUMN encoder TEXT;
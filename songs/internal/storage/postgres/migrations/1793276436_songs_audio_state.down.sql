@@ -0,0 +1,9 @@
+DROP INDEX songs_audio_state_idx;
+
+ALTER TABLE songs
+DROP COLUMN processing_started_at,
+DROP COLUMN audio_state_at,
+DROP COLUMN audio_error,
+DROP COLUMN audio_state;
+
+DROP TYPE audio_state;
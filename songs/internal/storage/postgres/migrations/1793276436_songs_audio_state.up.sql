@@ -0,0 +1,14 @@
+-- The audio of a song goes draft → uploading → processing → ready or failed.
+-- Uploaded files are processed by a worker, failed songs keep the reason until the next upload.
+CREATE TYPE audio_state AS ENUM ('draft', 'uploading', 'processing', 'ready', 'failed');
+
+ALTER TABLE songs
+ADD COLUMN audio_state audio_state NOT NULL DEFAULT 'draft',
+ADD COLUMN audio_error TEXT,
+ADD COLUMN audio_state_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+-- NULL while the song waits for a worker
+ADD COLUMN processing_started_at TIMESTAMPTZ;
+
+UPDATE songs SET audio_state = 'ready' WHERE s3_object_name IS NOT NULL;
+
+CREATE INDEX songs_audio_state_idx ON songs (audio_state, audio_state_at) WHERE audio_state IN ('uploading', 'processing');
@@ -0,0 +1,3 @@
+DROP TABLE jobs;
+
+DROP TYPE job_status;
@@ -0,0 +1,23 @@
+-- Background jobs of the workers. Finished jobs are deleted,
+-- jobs out of attempts stay dead until an admin retries them.
+CREATE TYPE job_status AS ENUM ('queued', 'running', 'dead');
+
+CREATE TABLE jobs (
+  job_id UUID PRIMARY KEY,
+  kind TEXT NOT NULL,
+  payload JSONB NOT NULL,
+  status job_status NOT NULL DEFAULT 'queued',
+  -- Jobs with the same key are queued once while one of them waits or runs
+  unique_key TEXT,
+  attempts INT NOT NULL DEFAULT 0,
+  max_attempts INT NOT NULL,
+  last_error TEXT,
+  run_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  locked_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  failed_at TIMESTAMPTZ
+);
+
+CREATE INDEX jobs_run_at_idx ON jobs (run_at) WHERE status IN ('queued', 'running');
+CREATE UNIQUE INDEX jobs_unique_key_idx ON jobs (unique_key) WHERE status IN ('queued', 'running');
+CREATE INDEX jobs_dead_idx ON jobs (failed_at DESC) WHERE status = 'dead';
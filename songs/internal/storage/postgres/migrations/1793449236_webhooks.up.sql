@@ -0,0 +1,34 @@
+-- HTTPS endpoints of artists notified about the events of their songs.
+CREATE TABLE webhooks (
+  webhook_id UUID PRIMARY KEY,
+  artist_fk UUID NOT NULL,
+  url TEXT NOT NULL,
+  -- Signs the deliveries, shown to the artist once
+  secret TEXT NOT NULL,
+  events TEXT[] NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX webhooks_artist_fk_idx ON webhooks (artist_fk);
+
+CREATE TYPE webhook_delivery_status AS ENUM ('pending', 'delivered', 'failed');
+
+-- The delivery log, a delivery is retried by the jobs until it is delivered or out of attempts.
+CREATE TABLE webhook_deliveries (
+  delivery_id UUID PRIMARY KEY,
+  webhook_fk UUID NOT NULL REFERENCES webhooks (webhook_id) ON DELETE CASCADE,
+  -- Id of the event the delivery is about, an event is delivered to a webhook once
+  event_id TEXT NOT NULL,
+  event TEXT NOT NULL,
+  payload JSONB NOT NULL,
+  status webhook_delivery_status NOT NULL DEFAULT 'pending',
+  attempts INT NOT NULL DEFAULT 0,
+  response_code INT,
+  error TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  attempted_at TIMESTAMPTZ,
+  UNIQUE (webhook_fk, event_id)
+);
+
+CREATE INDEX webhook_deliveries_webhook_fk_idx ON webhook_deliveries (webhook_fk, created_at DESC);
+CREATE INDEX webhook_deliveries_created_at_idx ON webhook_deliveries (created_at);
@@ -0,0 +1,4 @@
+DROP INDEX songs_singer_fk_name_live_idx;
+
+ALTER TABLE songs
+ADD CONSTRAINT songs_singer_fk_name_key UNIQUE (singer_fk, name);
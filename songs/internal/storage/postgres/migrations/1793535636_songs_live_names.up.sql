@@ -0,0 +1,5 @@
+-- Names are unique among the live songs only, a trashed song doesn't keep its name taken.
+ALTER TABLE songs
+DROP CONSTRAINT songs_singer_fk_name_key;
+
+CREATE UNIQUE INDEX songs_singer_fk_name_live_idx ON songs (singer_fk, name) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE claims
+DROP COLUMN prior_moderation_reason,
+DROP COLUMN prior_moderation_status;
@@ -0,0 +1,12 @@
+-- The moderation state a song had before a claim took it down, restored when the claims are rejected.
+-- Empty if the song was already taken down when the claim was submitted.
+ALTER TABLE claims
+ADD COLUMN prior_moderation_status moderation_status,
+ADD COLUMN prior_moderation_reason TEXT;
+
+UPDATE claims SET prior_moderation_status = 'active'
+FROM songs
+WHERE songs.song_id = claims.song_fk
+  AND songs.moderation_status = 'taken_down'
+  AND songs.moderator_id IS NULL
+  AND songs.moderation_reason = 'copyright claim ' || claims.claim_id::TEXT;
@@ -0,0 +1,15 @@
+ALTER TABLE claim_events
+DROP CONSTRAINT claim_events_claim_fk_fkey,
+ADD CONSTRAINT claim_events_claim_fk_fkey FOREIGN KEY (claim_fk) REFERENCES claims(claim_id) ON DELETE CASCADE;
+
+DELETE FROM claims WHERE song_fk IS NULL;
+
+DROP INDEX claims_artist_id_idx;
+
+ALTER TABLE claims
+DROP CONSTRAINT claims_song_fk_fkey,
+ADD CONSTRAINT claims_song_fk_fkey FOREIGN KEY (song_fk) REFERENCES songs(song_id) ON DELETE CASCADE,
+ALTER COLUMN song_fk SET NOT NULL,
+DROP COLUMN artist_id,
+DROP COLUMN song_name,
+DROP COLUMN song_id;
@@ -0,0 +1,27 @@
+-- Claims are a legal matter, they and their history outlive the purged songs.
+-- The claim keeps a copy of the song it was submitted against.
+ALTER TABLE claims
+ADD COLUMN song_id   UUID,
+ADD COLUMN song_name TEXT,
+ADD COLUMN artist_id UUID;
+
+UPDATE claims SET
+    song_id = songs.song_id,
+    song_name = songs.name,
+    artist_id = songs.singer_fk
+FROM songs
+WHERE songs.song_id = claims.song_fk;
+
+ALTER TABLE claims
+ALTER COLUMN song_id SET NOT NULL,
+ALTER COLUMN song_name SET NOT NULL,
+ALTER COLUMN artist_id SET NOT NULL,
+ALTER COLUMN song_fk DROP NOT NULL,
+DROP CONSTRAINT claims_song_fk_fkey,
+ADD CONSTRAINT claims_song_fk_fkey FOREIGN KEY (song_fk) REFERENCES songs(song_id) ON DELETE SET NULL;
+
+CREATE INDEX claims_artist_id_idx ON claims (artist_id);
+
+ALTER TABLE claim_events
+DROP CONSTRAINT claim_events_claim_fk_fkey,
+ADD CONSTRAINT claim_events_claim_fk_fkey FOREIGN KEY (claim_fk) REFERENCES claims(claim_id);
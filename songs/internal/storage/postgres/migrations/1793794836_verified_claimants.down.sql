@@ -0,0 +1 @@
+DROP TABLE verified_claimants;
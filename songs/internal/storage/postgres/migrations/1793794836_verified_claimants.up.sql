@@ -0,0 +1,9 @@
+-- Claims of verified claimants take the songs down at once,
+-- the songs of other claims are put under review until an admin resolves them.
+CREATE TABLE verified_claimants
+(
+  claimant_id UUID        NOT NULL,
+  verified_by UUID        NOT NULL,
+  verified_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (claimant_id)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE songs
+DROP COLUMN waveform,
+DROP COLUMN thumbnail_url;
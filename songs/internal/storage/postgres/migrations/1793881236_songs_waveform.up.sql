@@ -0,0 +1,5 @@
+-- Levels of the song from 0 to 100, NULL until the upload worker processed it or with waveforms disabled.
+-- The thumbnail is of the hosted image the song had when it was made, it is stale once the image changes.
+ALTER TABLE songs
+ADD COLUMN waveform SMALLINT[],
+ADD COLUMN thumbnail_url TEXT;
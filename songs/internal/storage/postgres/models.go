@@ -5,26 +5,600 @@
 package postgres
 
 import (
+	"database/sql/driver"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AudioState string
+
+const (
+	AudioStateDraft      AudioState = "draft"
+	AudioStateUploading  AudioState = "uploading"
+	AudioStateProcessing AudioState = "processing"
+	AudioStateReady      AudioState = "ready"
+	AudioStateFailed     AudioState = "failed"
+)
+
+func (e *AudioState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AudioState(s)
+	case string:
+		*e = AudioState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AudioState: %T", src)
+	}
+	return nil
+}
+
+type NullAudioState struct {
+	AudioState AudioState
+	Valid      bool // Valid is true if AudioState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAudioState) Scan(value interface{}) error {
+	if value == nil {
+		ns.AudioState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AudioState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAudioState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AudioState), nil
+}
+
+type BitrateMode string
+
+const (
+	BitrateModeCbr BitrateMode = "cbr"
+	BitrateModeVbr BitrateMode = "vbr"
+)
+
+func (e *BitrateMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = BitrateMode(s)
+	case string:
+		*e = BitrateMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for BitrateMode: %T", src)
+	}
+	return nil
+}
+
+type NullBitrateMode struct {
+	BitrateMode BitrateMode
+	Valid       bool // Valid is true if BitrateMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullBitrateMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.BitrateMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.BitrateMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullBitrateMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.BitrateMode), nil
+}
+
+type ChannelMode string
+
+const (
+	ChannelModeStereo      ChannelMode = "stereo"
+	ChannelModeJointStereo ChannelMode = "joint_stereo"
+	ChannelModeDualChannel ChannelMode = "dual_channel"
+	ChannelModeMono        ChannelMode = "mono"
+)
+
+func (e *ChannelMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ChannelMode(s)
+	case string:
+		*e = ChannelMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ChannelMode: %T", src)
+	}
+	return nil
+}
+
+type NullChannelMode struct {
+	ChannelMode ChannelMode
+	Valid       bool // Valid is true if ChannelMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullChannelMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.ChannelMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ChannelMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullChannelMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ChannelMode), nil
+}
+
+type ClaimStatus string
+
+const (
+	ClaimStatusPending        ClaimStatus = "pending"
+	ClaimStatusCounterNoticed ClaimStatus = "counter_noticed"
+	ClaimStatusUpheld         ClaimStatus = "upheld"
+	ClaimStatusRejected       ClaimStatus = "rejected"
+)
+
+func (e *ClaimStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ClaimStatus(s)
+	case string:
+		*e = ClaimStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ClaimStatus: %T", src)
+	}
+	return nil
+}
+
+type NullClaimStatus struct {
+	ClaimStatus ClaimStatus
+	Valid       bool // Valid is true if ClaimStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullClaimStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ClaimStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ClaimStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullClaimStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ClaimStatus), nil
+}
+
+type CreditRole string
+
+const (
+	CreditRolePrimary        CreditRole = "primary"
+	CreditRoleFeatured       CreditRole = "featured"
+	CreditRoleProducer       CreditRole = "producer"
+	CreditRoleComposer       CreditRole = "composer"
+	CreditRoleLyricist       CreditRole = "lyricist"
+	CreditRoleMixingEngineer CreditRole = "mixing_engineer"
+)
+
+func (e *CreditRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CreditRole(s)
+	case string:
+		*e = CreditRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CreditRole: %T", src)
+	}
+	return nil
+}
+
+type NullCreditRole struct {
+	CreditRole CreditRole
+	Valid      bool // Valid is true if CreditRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCreditRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.CreditRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CreditRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCreditRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CreditRole), nil
+}
+
+type CreditStatus string
+
+const (
+	CreditStatusPending  CreditStatus = "pending"
+	CreditStatusApproved CreditStatus = "approved"
+	CreditStatusDeclined CreditStatus = "declined"
+)
+
+func (e *CreditStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CreditStatus(s)
+	case string:
+		*e = CreditStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CreditStatus: %T", src)
+	}
+	return nil
+}
+
+type NullCreditStatus struct {
+	CreditStatus CreditStatus
+	Valid        bool // Valid is true if CreditStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCreditStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.CreditStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CreditStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCreditStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CreditStatus), nil
+}
+
+type ExportStatus string
+
+const (
+	ExportStatusPending ExportStatus = "pending"
+	ExportStatusRunning ExportStatus = "running"
+	ExportStatusReady   ExportStatus = "ready"
+	ExportStatusFailed  ExportStatus = "failed"
+)
+
+func (e *ExportStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ExportStatus(s)
+	case string:
+		*e = ExportStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ExportStatus: %T", src)
+	}
+	return nil
+}
+
+type NullExportStatus struct {
+	ExportStatus ExportStatus
+	Valid        bool // Valid is true if ExportStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullExportStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ExportStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ExportStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullExportStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ExportStatus), nil
+}
+
+type JobStatus string
+
+const (
+	JobStatusQueued  JobStatus = "queued"
+	JobStatusRunning JobStatus = "running"
+	JobStatusDead    JobStatus = "dead"
+)
+
+func (e *JobStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = JobStatus(s)
+	case string:
+		*e = JobStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for JobStatus: %T", src)
+	}
+	return nil
+}
+
+type NullJobStatus struct {
+	JobStatus JobStatus
+	Valid     bool // Valid is true if JobStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullJobStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.JobStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.JobStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullJobStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.JobStatus), nil
+}
+
+type ModerationStatus string
+
+const (
+	ModerationStatusActive      ModerationStatus = "active"
+	ModerationStatusUnderReview ModerationStatus = "under_review"
+	ModerationStatusTakenDown   ModerationStatus = "taken_down"
+)
+
+func (e *ModerationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ModerationStatus(s)
+	case string:
+		*e = ModerationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ModerationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullModerationStatus struct {
+	ModerationStatus ModerationStatus
+	Valid            bool // Valid is true if ModerationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullModerationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ModerationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ModerationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullModerationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ModerationStatus), nil
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus
+	Valid                 bool // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
+type AnalyticsEvent struct {
+	EventID     string
+	ProcessedAt time.Time
+}
+
+type ArtistFollower struct {
+	ArtistFk  uuid.UUID
+	Day       time.Time
+	Followers int64
+}
+
+type Claim struct {
+	ClaimID               uuid.UUID
+	SongFk                pgtype.UUID
+	ClaimantID            uuid.UUID
+	ClaimantName          string
+	ClaimantEmail         string
+	Description           string
+	EvidenceUrls          []string
+	Status                ClaimStatus
+	CounterNotice         pgtype.Text
+	ResolutionNote        pgtype.Text
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	PriorModerationStatus NullModerationStatus
+	PriorModerationReason pgtype.Text
+	SongID                uuid.UUID
+	SongName              string
+	ArtistID              uuid.UUID
+}
+
+type ClaimEvent struct {
+	EventID    int64
+	ClaimFk    uuid.UUID
+	FromStatus NullClaimStatus
+	ToStatus   ClaimStatus
+	ActorID    uuid.UUID
+	Note       pgtype.Text
+	CreatedAt  time.Time
+}
+
+type Export struct {
+	ExportID    uuid.UUID
+	ArtistFk    uuid.UUID
+	Status      ExportStatus
+	Error       pgtype.Text
+	WeightBytes pgtype.Int8
+	CreatedAt   time.Time
+	StartedAt   pgtype.Timestamptz
+	FinishedAt  pgtype.Timestamptz
+	ExpiresAt   pgtype.Timestamptz
+}
+
 type Feat struct {
 	SongFk   uuid.UUID
-	ArtistFk uuid.UUID
+	ArtistFk pgtype.UUID
 	OrderNum int32
+	CreditID int64
+	Role     CreditRole
+	Name     pgtype.Text
+	Status   CreditStatus
+}
+
+type Job struct {
+	JobID       uuid.UUID
+	Kind        string
+	Payload     []byte
+	Status      JobStatus
+	UniqueKey   pgtype.Text
+	Attempts    int32
+	MaxAttempts int32
+	LastError   pgtype.Text
+	RunAt       time.Time
+	LockedAt    pgtype.Timestamptz
+	CreatedAt   time.Time
+	FailedAt    pgtype.Timestamptz
 }
 
 type Song struct {
-	SongID       uuid.UUID
-	SingerFk     uuid.UUID
-	Name         string
-	S3ObjectName pgtype.Text
-	ImageUrl     pgtype.Text
-	Duration     pgtype.Interval
-	WeightBytes  pgtype.Int4
-	UploadedAt   time.Time
-	ReleasedAt   pgtype.Timestamptz
+	SongID              uuid.UUID
+	SingerFk            uuid.UUID
+	Name                string
+	S3ObjectName        pgtype.Text
+	ImageUrl            pgtype.Text
+	Duration            pgtype.Interval
+	WeightBytes         pgtype.Int4
+	UploadedAt          time.Time
+	ReleasedAt          pgtype.Timestamptz
+	ModerationStatus    ModerationStatus
+	ModerationReason    pgtype.Text
+	ModeratorID         pgtype.UUID
+	ModeratedAt         pgtype.Timestamptz
+	Explicit            bool
+	AllowedCountries    []string
+	DeniedCountries     []string
+	DeletedAt           pgtype.Timestamptz
+	Bitrate             pgtype.Int4
+	BitrateMode         NullBitrateMode
+	SampleRate          pgtype.Int4
+	ChannelMode         NullChannelMode
+	Encoder             pgtype.Text
+	AudioState          AudioState
+	AudioError          pgtype.Text
+	AudioStateAt        time.Time
+	ProcessingStartedAt pgtype.Timestamptz
+	Waveform            []int16
+	ThumbnailUrl        pgtype.Text
+}
+
+type SongDailyStat struct {
+	SongFk       uuid.UUID
+	Day          time.Time
+	Plays        int64
+	Likes        int64
+	Unlikes      int64
+	PlaylistAdds int64
+}
+
+type SongListener struct {
+	SongFk   uuid.UUID
+	Day      time.Time
+	Listener string
+}
+
+type VerifiedClaimant struct {
+	ClaimantID uuid.UUID
+	VerifiedBy uuid.UUID
+	VerifiedAt time.Time
+}
+
+type Webhook struct {
+	WebhookID uuid.UUID
+	ArtistFk  uuid.UUID
+	Url       string
+	Secret    string
+	Events    []string
+	CreatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	DeliveryID   uuid.UUID
+	WebhookFk    uuid.UUID
+	EventID      string
+	Event        string
+	Payload      []byte
+	Status       WebhookDeliveryStatus
+	Attempts     int32
+	ResponseCode pgtype.Int4
+	Error        pgtype.Text
+	CreatedAt    time.Time
+	AttemptedAt  pgtype.Timestamptz
 }
@@ -18,3 +18,56 @@ func (r MySongsRow) GetSingerFk() uuid.UUID {
 func (r MySongsRow) GetArtistsIds() []uuid.UUID {
 	return r.ArtistsIds
 }
+
+func (r TrashedSongsRow) GetSingerFk() uuid.UUID {
+	return r.Song.SingerFk
+}
+
+func (r TrashedSongsRow) GetArtistsIds() []uuid.UUID {
+	return r.ArtistsIds
+}
+
+// SongCredit is a credit aggregated by the songs queries.
+type SongCredit struct {
+	// uuid.Nil for people without accounts
+	ArtistId uuid.UUID
+	// Empty for credits saved before names were stored
+	Name   string
+	Role   CreditRole
+	Status CreditStatus
+}
+
+func songCredits(ids []uuid.UUID, names, roles, statuses []string) []SongCredit {
+	credits := make([]SongCredit, 0, len(roles))
+
+	for i := range roles {
+		if i >= len(ids) || i >= len(names) || i >= len(statuses) {
+			break
+		}
+
+		credits = append(credits, SongCredit{
+			ArtistId: ids[i],
+			Name:     names[i],
+			Role:     CreditRole(roles[i]),
+			Status:   CreditStatus(statuses[i]),
+		})
+	}
+
+	return credits
+}
+
+func (r SongRow) GetCredits() []SongCredit {
+	return songCredits(r.CreditsArtistsIds, r.CreditsNames, r.CreditsRoles, r.CreditsStatuses)
+}
+
+func (r ReleasedSongsRow) GetCredits() []SongCredit {
+	return songCredits(r.CreditsArtistsIds, r.CreditsNames, r.CreditsRoles, r.CreditsStatuses)
+}
+
+func (r MySongsRow) GetCredits() []SongCredit {
+	return songCredits(r.CreditsArtistsIds, r.CreditsNames, r.CreditsRoles, r.CreditsStatuses)
+}
+
+func (r TrashedSongsRow) GetCredits() []SongCredit {
+	return songCredits(r.CreditsArtistsIds, r.CreditsNames, r.CreditsRoles, r.CreditsStatuses)
+}
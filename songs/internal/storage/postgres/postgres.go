@@ -9,6 +9,7 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/database/postgres" // it is needed for migrations to work
 
 	"dev.gaijin.team/go/golib/e"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type PgStorage struct {
@@ -34,3 +35,16 @@ func Connect(ctx context.Context, conn string) (*PgStorage, error) {
 func (s *PgStorage) Close() error {
 	return s.db.Close() //nolint:wrapcheck
 }
+
+func (s *PgStorage) Ping(ctx context.Context) error {
+	err := s.db.Ping(ctx)
+	if err != nil {
+		return e.NewFrom("pinging pg database", err)
+	}
+
+	return nil
+}
+
+func (s *PgStorage) Stat() *pgxpool.Stat {
+	return s.db.Stat()
+}
@@ -9,23 +9,53 @@ WITH inserted_song AS (
     duration,
     weight_bytes,
     uploaded_at,
-    released_at) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    released_at,
+    explicit) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
     RETURNING song_id
 )
-INSERT INTO feats (song_fk, artist_fk, order_num)
+INSERT INTO feats (song_fk, artist_fk, name, role, status, order_num)
 SELECT
     (SELECT song_id FROM inserted_song) AS song_fk,
-    artist AS artist_fk,
-    ROW_NUMBER() OVER () AS order_num
-FROM UNNEST(@artists_ids::UUID[]) AS artist;
+    NULLIF((@credits_artists_ids::UUID[])[i], '00000000-0000-0000-0000-000000000000') AS artist_fk,
+    NULLIF((@credits_names::TEXT[])[i], '') AS name,
+    (@credits_roles::TEXT[])[i]::credit_role AS role,
+    (@credits_statuses::TEXT[])[i]::credit_status AS status,
+    i AS order_num
+FROM GENERATE_SUBSCRIPTS(@credits_roles::TEXT[], 1) AS i;
+
+-- The nil UUID and the empty name stand for a missing artist account and a missing name.
+-- name: ReplaceCredits :exec
+WITH deleted AS (
+    DELETE FROM feats WHERE song_fk = @song_id::UUID
+)
+INSERT INTO feats (song_fk, artist_fk, name, role, status, order_num)
+SELECT
+    @song_id::UUID AS song_fk,
+    NULLIF((@credits_artists_ids::UUID[])[i], '00000000-0000-0000-0000-000000000000') AS artist_fk,
+    NULLIF((@credits_names::TEXT[])[i], '') AS name,
+    (@credits_roles::TEXT[])[i]::credit_role AS role,
+    (@credits_statuses::TEXT[])[i]::credit_status AS status,
+    i AS order_num
+FROM GENERATE_SUBSCRIPTS(@credits_roles::TEXT[], 1) AS i;
 
 -- name: Song :one
 SELECT
     sqlc.embed(songs),
-    ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)::UUID[] AS artists_ids
+    COALESCE(ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)
+        FILTER (WHERE feats.role = 'featured' AND feats.artist_fk IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS artists_ids,
+    -- Credits are aggregated into parallel arrays, the nil UUID stands for a person without an account.
+    -- Listeners see only approved credits.
+    COALESCE(ARRAY_AGG(COALESCE(feats.artist_fk, '00000000-0000-0000-0000-000000000000') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS credits_artists_ids,
+    COALESCE(ARRAY_AGG(COALESCE(feats.name, '') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_names,
+    COALESCE(ARRAY_AGG(feats.role ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_roles,
+    COALESCE(ARRAY_AGG(feats.status ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_statuses
 FROM songs
 LEFT JOIN feats ON feats.song_fk = songs.song_id
-WHERE song_id = $1 AND released_at IS NOT NULL
+WHERE song_id = $1 AND released_at IS NOT NULL AND moderation_status <> 'taken_down' AND deleted_at IS NULL
 GROUP BY songs.song_id;
 
 -- name: UpdateSong :one
@@ -50,7 +80,15 @@ UPDATE songs SET
     duration = COALESCE(sqlc.narg('duration'), duration),
     weight_bytes = COALESCE(sqlc.narg('weight_bytes'), weight_bytes),
     released_at = COALESCE(sqlc.narg('released_at'), released_at),
-    uploaded_at = COALESCE(sqlc.narg('uploaded_at'), uploaded_at)
+    uploaded_at = COALESCE(sqlc.narg('uploaded_at'), uploaded_at),
+    explicit = COALESCE(sqlc.narg('explicit'), explicit),
+    allowed_countries = COALESCE(sqlc.narg('allowed_countries')::TEXT[], allowed_countries),
+    denied_countries = COALESCE(sqlc.narg('denied_countries')::TEXT[], denied_countries),
+    bitrate = COALESCE(sqlc.narg('bitrate'), bitrate),
+    bitrate_mode = COALESCE(sqlc.narg('bitrate_mode'), bitrate_mode),
+    sample_rate = COALESCE(sqlc.narg('sample_rate'), sample_rate),
+    channel_mode = COALESCE(sqlc.narg('channel_mode'), channel_mode),
+    encoder = COALESCE(sqlc.narg('encoder'), encoder)
 WHERE song_id = @id
 RETURNING *;
 
@@ -63,73 +101,730 @@ UPDATE songs SET
     duration = COALESCE(sqlc.narg('duration'), duration),
     weight_bytes = COALESCE(sqlc.narg('weight_bytes'), weight_bytes),
     released_at = COALESCE(sqlc.narg('released_at'), released_at),
-    uploaded_at = COALESCE(sqlc.narg('uploaded_at'), uploaded_at)
+    uploaded_at = COALESCE(sqlc.narg('uploaded_at'), uploaded_at),
+    explicit = COALESCE(sqlc.narg('explicit'), explicit)
 WHERE song_id = ANY(@ids::UUID[]);
 
 -- name: DeleteSongs :exec
 DELETE FROM songs WHERE song_id = ANY(@ids::UUID[]);
 
+-- name: TrashSongs :exec
+UPDATE songs SET deleted_at = @deleted_at
+WHERE song_id = ANY(@ids::UUID[]) AND deleted_at IS NULL;
+
+-- Restores only the songs trashed after deleted_after, older ones are waiting to be purged.
+-- name: RestoreSongs :many
+UPDATE songs SET deleted_at = NULL
+WHERE singer_fk = @singer_id::UUID AND song_id = ANY(@ids::UUID[]) AND deleted_at > @deleted_after
+RETURNING *;
+
+-- name: TrashedSongs :many
+SELECT
+    sqlc.embed(songs),
+    COALESCE(ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)
+        FILTER (WHERE feats.role = 'featured' AND feats.artist_fk IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS artists_ids,
+    -- Credits are aggregated into parallel arrays, the nil UUID stands for a person without an account
+    COALESCE(ARRAY_AGG(COALESCE(feats.artist_fk, '00000000-0000-0000-0000-000000000000') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::UUID[] AS credits_artists_ids,
+    COALESCE(ARRAY_AGG(COALESCE(feats.name, '') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_names,
+    COALESCE(ARRAY_AGG(feats.role ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_roles,
+    COALESCE(ARRAY_AGG(feats.status ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_statuses
+FROM songs
+LEFT JOIN feats ON feats.song_fk = songs.song_id
+WHERE singer_fk = @singer_id::UUID AND deleted_at > @deleted_after
+GROUP BY songs.song_id
+ORDER BY songs.deleted_at DESC, songs.name
+LIMIT @limitv
+OFFSET @offsetv;
+
+-- name: CountTrashedSongs :one
+SELECT COUNT(*)::INT
+FROM songs
+WHERE singer_fk = @singer_id::UUID AND deleted_at > @deleted_after;
+
+-- Locks the songs, so purge workers of other instances skip them.
+-- name: ExpiredTrashedSongs :many
+SELECT *
+FROM songs
+WHERE deleted_at <= @deleted_before
+ORDER BY deleted_at
+LIMIT @limitv
+FOR UPDATE SKIP LOCKED;
+
 -- name: ReleasedSongs :many
 SELECT
     sqlc.embed(songs),
-    ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)::UUID[] AS artists_ids
+    COALESCE(ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)
+        FILTER (WHERE feats.role = 'featured' AND feats.artist_fk IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS artists_ids,
+    -- Credits are aggregated into parallel arrays, the nil UUID stands for a person without an account.
+    -- Listeners see only approved credits.
+    COALESCE(ARRAY_AGG(COALESCE(feats.artist_fk, '00000000-0000-0000-0000-000000000000') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS credits_artists_ids,
+    COALESCE(ARRAY_AGG(COALESCE(feats.name, '') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_names,
+    COALESCE(ARRAY_AGG(feats.role ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_roles,
+    COALESCE(ARRAY_AGG(feats.status ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_statuses
 FROM songs
 LEFT JOIN feats ON feats.song_fk = songs.song_id
 WHERE
     -- Only released songs!
-    released_at IS NOT NULL AND (
+    released_at IS NOT NULL AND
+    -- Taken down songs are hidden from everyone but their artist
+    moderation_status <> 'taken_down' AND
+    -- Trashed songs are hidden from everyone
+    deleted_at IS NULL AND
+    -- Listeners may hide explicit songs
+    (NOT @hide_explicit::BOOLEAN OR NOT explicit) AND (
     -- All songs created by some artists
     ((@by_singer::BOOLEAN OR @with_artist::BOOLEAN) AND singer_fk = ANY(@singers_ids::UUID[])) OR
     -- All songs created by or featured some artists
     (@with_artist::BOOLEAN AND @singers_ids::UUID[] <@ (
         SELECT ARRAY_AGG(feats.artist_fk)
         FROM feats
-        WHERE song_fk = songs.song_id
+        WHERE song_fk = songs.song_id AND role = 'featured' AND status = 'approved'
         GROUP BY song_fk
     )) OR
+    -- All songs crediting an artist or a person matching name, the singer is the primary artist
+    (@by_credit::BOOLEAN AND (
+        (NOT @credit_by_name::BOOLEAN AND singer_fk = @credit_artist_id::UUID AND
+            (NOT @credit_by_role::BOOLEAN OR @credit_role::TEXT = 'primary')) OR
+        EXISTS (
+            SELECT 1
+            FROM feats credits
+            WHERE credits.song_fk = songs.song_id AND credits.status = 'approved' AND
+                (NOT @credit_by_role::BOOLEAN OR credits.role::TEXT = @credit_role::TEXT) AND (
+                (@credit_by_name::BOOLEAN AND credits.name ILIKE CONCAT('%', @credit_name::TEXT, '%')) OR
+                (NOT @credit_by_name::BOOLEAN AND credits.artist_fk = @credit_artist_id::UUID))
+        ))) OR
     -- All songs matching name
     (@by_name::BOOLEAN AND name ILIKE CONCAT('%', @match_name::TEXT, '%')) OR
     -- Songs by IDs
     (@by_ids::BOOLEAN AND song_id = ANY(@ids::UUID[]))
     )
 GROUP BY songs.song_id
-ORDER BY songs.uploaded_at DESC, name
+ORDER BY songs.uploaded_at DESC, songs.name
 LIMIT @limitv
 OFFSET @offsetv;
 
 -- name: MySongs :many
 SELECT
     sqlc.embed(songs),
-    ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)::UUID[] AS artists_ids
+    COALESCE(ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)
+        FILTER (WHERE feats.role = 'featured' AND feats.artist_fk IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS artists_ids,
+    -- Credits are aggregated into parallel arrays, the nil UUID stands for a person without an account
+    COALESCE(ARRAY_AGG(COALESCE(feats.artist_fk, '00000000-0000-0000-0000-000000000000') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::UUID[] AS credits_artists_ids,
+    COALESCE(ARRAY_AGG(COALESCE(feats.name, '') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_names,
+    COALESCE(ARRAY_AGG(feats.role ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_roles,
+    COALESCE(ARRAY_AGG(feats.status ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_statuses
 FROM songs
 LEFT JOIN feats ON feats.song_fk = songs.song_id
-WHERE singer_fk = @singer_id::UUID AND (NOT @by_ids::BOOLEAN OR song_id = ANY(@ids::UUID[]))
+WHERE singer_fk = @singer_id::UUID AND deleted_at IS NULL AND (NOT @by_ids::BOOLEAN OR song_id = ANY(@ids::UUID[]))
 GROUP BY songs.song_id
-ORDER BY songs.uploaded_at DESC, name
+ORDER BY songs.uploaded_at DESC, songs.name
 LIMIT @limitv
 OFFSET @offsetv;
 
+-- name: ModerateSong :one
+UPDATE songs SET
+    moderation_status = @moderation_status,
+    moderation_reason = sqlc.narg('moderation_reason'),
+    moderator_id = @moderator_id,
+    moderated_at = @moderated_at
+WHERE song_id = @song_id
+RETURNING *;
+
+-- name: SongObjectStatus :one
+SELECT song_id, singer_fk, moderation_status, allowed_countries, denied_countries, released_at
+FROM songs
+WHERE s3_object_name = @s3_object_name AND deleted_at IS NULL;
+
+-- name: SongPreviewStatus :one
+SELECT song_id, s3_object_name, duration, moderation_status, allowed_countries, denied_countries, released_at
+FROM songs
+WHERE song_id = @song_id AND deleted_at IS NULL;
+
+-- Songs may share a hosted image, it is released if one of them is released and not taken down.
+-- Singers of the songs that are not taken down see it before the release.
+-- name: ImageObjectStatus :one
+SELECT
+    BOOL_OR(released_at IS NOT NULL AND moderation_status <> 'taken_down')::BOOLEAN AS released,
+    ARRAY_AGG(singer_fk) FILTER (WHERE moderation_status <> 'taken_down')::UUID[] AS singer_ids
+FROM songs
+WHERE image_url = @image_url AND deleted_at IS NULL
+HAVING COUNT(*) > 0;
+
+-- Songs may share a hosted image, the purge keeps it while other songs, trashed ones too, have it.
+-- name: ImageUsedByOthers :one
+SELECT EXISTS (
+    SELECT 1
+    FROM songs
+    WHERE image_url = @image_url AND song_id <> ALL(@excluded_ids::UUID[])
+);
+
 -- name: MySong :one
 SELECT sqlc.embed(songs)
 FROM songs
-WHERE singer_fk = @singer_id::UUID AND song_id = @song_id::UUID;
+WHERE singer_fk = @singer_id::UUID AND song_id = @song_id::UUID AND deleted_at IS NULL;
+
+-- Imports match manifest rows to songs by name, it is unique per artist.
+-- name: MySongByName :one
+SELECT sqlc.embed(songs)
+FROM songs
+WHERE singer_fk = @singer_id::UUID AND name = @name AND deleted_at IS NULL;
 
 -- name: CountMySongs :one
 SELECT COUNT(*)::INT
 FROM songs
+WHERE singer_fk = @singer_id::UUID AND deleted_at IS NULL;
+
+-- Trashed songs are counted, they are stored until purged.
+-- name: ArtistUsage :one
+SELECT COUNT(*)::INT                          AS songs_count,
+       COALESCE(SUM(weight_bytes), 0)::BIGINT AS weight_bytes
+FROM songs
 WHERE singer_fk = @singer_id::UUID;
 
 -- name: CountSongsWithArtistsIds :one
 WITH cte AS (
     SELECT COUNT(*)
     FROM songs
-    WHERE singer_fk = ANY(@singers_ids::UUID[])
+    WHERE singer_fk = ANY(@singers_ids::UUID[]) AND deleted_at IS NULL
 )
 SELECT COUNT(*)::INT + (SELECT * FROM cte)
 FROM feats
-WHERE artist_fk = ANY(@singers_ids::UUID[]);
+JOIN songs ON songs.song_id = feats.song_fk
+WHERE artist_fk = ANY(@singers_ids::UUID[]) AND role = 'featured' AND status = 'approved' AND songs.deleted_at IS NULL;
+
+-- name: CountSongsWithCredit :one
+SELECT COUNT(*)::INT
+FROM songs
+WHERE released_at IS NOT NULL AND moderation_status <> 'taken_down' AND deleted_at IS NULL AND (
+    (NOT @credit_by_name::BOOLEAN AND singer_fk = @credit_artist_id::UUID AND
+        (NOT @credit_by_role::BOOLEAN OR @credit_role::TEXT = 'primary')) OR
+    EXISTS (
+        SELECT 1
+        FROM feats credits
+        WHERE credits.song_fk = songs.song_id AND credits.status = 'approved' AND
+            (NOT @credit_by_role::BOOLEAN OR credits.role::TEXT = @credit_role::TEXT) AND (
+            (@credit_by_name::BOOLEAN AND credits.name ILIKE CONCAT('%', @credit_name::TEXT, '%')) OR
+            (NOT @credit_by_name::BOOLEAN AND credits.artist_fk = @credit_artist_id::UUID))
+    ));
 
 -- name: CountSongsMatchName :one
 SELECT COUNT(*)::INT
 FROM songs
-WHERE name ILIKE CONCAT('%', @match_name::TEXT, '%');
\ No newline at end of file
+WHERE name ILIKE CONCAT('%', @match_name::TEXT, '%') AND deleted_at IS NULL;
+-- name: ClaimedSong :one
+SELECT singer_fk, name
+FROM songs
+WHERE song_id = @song_id::UUID AND deleted_at IS NULL;
+
+-- name: SaveClaim :one
+INSERT INTO claims (
+    claim_id,
+    song_fk,
+    song_id,
+    song_name,
+    artist_id,
+    claimant_id,
+    claimant_name,
+    claimant_email,
+    description,
+    evidence_urls,
+    status,
+    prior_moderation_status,
+    prior_moderation_reason,
+    created_at,
+    updated_at)
+VALUES (@claim_id, @song_id::UUID, @song_id::UUID, @song_name, @artist_id, @claimant_id, @claimant_name, @claimant_email, @description,
+        @evidence_urls::TEXT[], 'pending', sqlc.narg('prior_moderation_status'),
+        sqlc.narg('prior_moderation_reason'), @created_at, @created_at)
+RETURNING *;
+
+-- Takes the song down or puts it under review for a claim.
+-- Returns no rows if the song already has this or a stricter moderation status, it is kept as is.
+-- If the song is moderated by another claim, that claim is returned with the state it replaced.
+-- name: ModerateClaimedSong :one
+WITH prior AS (
+    SELECT
+        songs.song_id,
+        songs.moderation_status,
+        songs.moderation_reason,
+        claims.claim_id AS claim_id,
+        claims.prior_moderation_status AS claim_prior_status,
+        claims.prior_moderation_reason AS claim_prior_reason
+    FROM songs
+    LEFT JOIN claims ON claims.song_fk = songs.song_id
+        AND claims.prior_moderation_status IS NOT NULL
+        AND songs.moderator_id IS NULL
+        AND songs.moderation_reason = 'copyright claim ' || claims.claim_id::TEXT
+    WHERE songs.song_id = @song_id::UUID
+    FOR UPDATE OF songs
+)
+UPDATE songs SET
+    moderation_status = @moderation_status::moderation_status,
+    moderation_reason = @moderation_reason::TEXT,
+    moderator_id = NULL,
+    moderated_at = @moderated_at
+FROM prior
+WHERE songs.song_id = prior.song_id AND prior.moderation_status < @moderation_status::moderation_status
+RETURNING
+    prior.moderation_status AS prior_moderation_status,
+    prior.moderation_reason AS prior_moderation_reason,
+    prior.claim_id AS prior_claim_id,
+    prior.claim_prior_status,
+    prior.claim_prior_reason;
+
+-- Gives the song back the moderation state it had before a claim moderated it.
+-- Returns no rows if the song was not moderated by a claim or was moderated since.
+-- name: RestoreClaimedSong :one
+UPDATE songs SET
+    moderation_status = claims.prior_moderation_status,
+    moderation_reason = claims.prior_moderation_reason,
+    moderator_id = @moderator_id,
+    moderated_at = @moderated_at
+FROM claims
+WHERE songs.song_id = @song_id::UUID
+    AND claims.song_fk = songs.song_id
+    AND claims.prior_moderation_status IS NOT NULL
+    AND songs.moderation_status <> 'active'
+    AND songs.moderator_id IS NULL
+    AND songs.moderation_reason = 'copyright claim ' || claims.claim_id::TEXT
+RETURNING songs.moderation_status;
+
+-- name: Claim :one
+SELECT *
+FROM claims
+WHERE claim_id = @claim_id::UUID;
+
+-- name: Claims :many
+SELECT *
+FROM claims
+WHERE
+    -- Admins see all claims, others only their own or the ones against their songs
+    (@all_claims::BOOLEAN OR claimant_id = @user_id::UUID OR artist_id = @user_id::UUID) AND
+    (NOT @by_status::BOOLEAN OR status::TEXT = @status::TEXT)
+ORDER BY created_at DESC
+LIMIT @limitv
+OFFSET @offsetv;
+
+-- name: CountClaims :one
+SELECT COUNT(*)::INT
+FROM claims
+WHERE
+    (@all_claims::BOOLEAN OR claimant_id = @user_id::UUID OR artist_id = @user_id::UUID) AND
+    (NOT @by_status::BOOLEAN OR status::TEXT = @status::TEXT);
+
+-- Returns no rows if the claim is not in one of the from statuses.
+-- name: SetClaimStatus :one
+UPDATE claims SET
+    status = @status,
+    counter_notice = COALESCE(sqlc.narg('counter_notice'), counter_notice),
+    resolution_note = COALESCE(sqlc.narg('resolution_note'), resolution_note),
+    updated_at = @updated_at
+WHERE claim_id = @claim_id AND status::TEXT = ANY(@from_statuses::TEXT[])
+RETURNING *;
+
+-- name: SaveClaimEvent :exec
+INSERT INTO claim_events (claim_fk, from_status, to_status, actor_id, note, created_at)
+VALUES (@claim_fk, sqlc.narg('from_status'), @to_status, @actor_id, sqlc.narg('note'), @created_at);
+
+-- name: ClaimEvents :many
+SELECT *
+FROM claim_events
+WHERE claim_fk = @claim_id::UUID
+ORDER BY event_id;
+
+-- name: CountOpenClaims :one
+SELECT COUNT(*)::INT
+FROM claims
+WHERE song_id = @song_id::UUID AND claim_id <> @claim_id::UUID
+    AND status IN ('pending', 'counter_noticed', 'upheld');
+
+-- name: CountClaimantOpenClaims :one
+SELECT COUNT(*)::INT
+FROM claims
+WHERE claimant_id = @claimant_id::UUID AND status IN ('pending', 'counter_noticed');
+
+-- name: ClaimantVerified :one
+SELECT EXISTS (SELECT 1 FROM verified_claimants WHERE claimant_id = @claimant_id::UUID);
+
+-- name: VerifyClaimant :exec
+INSERT INTO verified_claimants (claimant_id, verified_by, verified_at)
+VALUES (@claimant_id, @verified_by, @verified_at)
+ON CONFLICT (claimant_id) DO NOTHING;
+
+-- name: UnverifyClaimant :exec
+DELETE FROM verified_claimants
+WHERE claimant_id = @claimant_id::UUID;
+
+-- name: CreditRequests :many
+SELECT
+    feats.credit_id,
+    feats.role,
+    sqlc.embed(songs)
+FROM feats
+JOIN songs ON songs.song_id = feats.song_fk
+WHERE feats.artist_fk = @artist_id::UUID AND feats.status = 'pending' AND songs.deleted_at IS NULL
+ORDER BY feats.credit_id DESC
+LIMIT @limitv
+OFFSET @offsetv;
+
+-- name: CountCreditRequests :one
+SELECT COUNT(*)::INT
+FROM feats
+JOIN songs ON songs.song_id = feats.song_fk
+WHERE feats.artist_fk = @artist_id::UUID AND feats.status = 'pending' AND songs.deleted_at IS NULL;
+
+-- name: ResolveCreditRequest :one
+UPDATE feats SET status = @status
+FROM songs
+WHERE feats.credit_id = @credit_id AND feats.artist_fk = @artist_id::UUID AND feats.status = 'pending' AND
+    songs.song_id = feats.song_fk AND songs.deleted_at IS NULL
+RETURNING feats.song_fk, feats.role, songs.singer_fk;
+
+-- Returns the unfinished export of the artist if there is one.
+-- name: SaveExport :one
+INSERT INTO exports (export_id, artist_fk)
+VALUES (@export_id::UUID, @artist_id::UUID)
+ON CONFLICT (artist_fk) WHERE status IN ('pending', 'running')
+DO UPDATE SET artist_fk = EXCLUDED.artist_fk
+RETURNING *;
+
+-- name: Export :one
+SELECT *
+FROM exports
+WHERE export_id = @export_id::UUID;
+
+-- name: ArtistExports :many
+SELECT *
+FROM exports
+WHERE artist_fk = @artist_id::UUID AND (expires_at IS NULL OR expires_at > NOW())
+ORDER BY created_at DESC
+LIMIT @limitv;
+
+-- Takes the oldest pending export, or a running one whose worker is gone.
+-- name: StartExport :one
+UPDATE exports SET status = 'running', started_at = NOW()
+WHERE export_id = (
+    SELECT waiting.export_id
+    FROM exports AS waiting
+    WHERE waiting.status = 'pending' OR (waiting.status = 'running' AND waiting.started_at <= @stale_before)
+    ORDER BY waiting.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: FinishExport :one
+UPDATE exports SET
+    status = @status,
+    error = sqlc.narg(error),
+    weight_bytes = sqlc.narg(weight_bytes),
+    finished_at = NOW(),
+    expires_at = @expires_at
+WHERE export_id = @export_id::UUID AND status = 'running'
+RETURNING *;
+
+-- name: ExpiredExports :many
+SELECT *
+FROM exports
+WHERE expires_at <= NOW()
+ORDER BY expires_at
+LIMIT @limitv;
+
+-- name: DeleteExports :exec
+DELETE FROM exports
+WHERE export_id = ANY(@ids::UUID[]);
+
+-- Returns 0 if the event is already processed.
+-- name: SaveAnalyticsEvent :execrows
+INSERT INTO analytics_events (event_id)
+VALUES (@event_id)
+ON CONFLICT DO NOTHING;
+
+-- name: PurgeAnalyticsEvents :execrows
+DELETE FROM analytics_events
+WHERE processed_at <= @processed_before;
+
+-- Unknown songs are skipped, the playlists may have tracks that are not ours anymore.
+-- name: AddSongStats :exec
+INSERT INTO song_daily_stats (song_fk, day, plays, likes, unlikes, playlist_adds)
+SELECT song_id, @day::DATE, @plays::BIGINT, @likes::BIGINT, @unlikes::BIGINT, @playlist_adds::BIGINT
+FROM songs
+WHERE song_id = ANY(@song_ids::UUID[])
+ON CONFLICT (song_fk, day) DO UPDATE SET
+    plays = song_daily_stats.plays + EXCLUDED.plays,
+    likes = song_daily_stats.likes + EXCLUDED.likes,
+    unlikes = song_daily_stats.unlikes + EXCLUDED.unlikes,
+    playlist_adds = song_daily_stats.playlist_adds + EXCLUDED.playlist_adds;
+
+-- name: SaveSongListener :exec
+INSERT INTO song_listeners (song_fk, day, listener)
+SELECT song_id, @day::DATE, @listener::TEXT
+FROM songs
+WHERE song_id = @song_id::UUID
+ON CONFLICT DO NOTHING;
+
+-- name: SaveArtistFollowers :exec
+INSERT INTO artist_followers (artist_fk, day, followers)
+VALUES (@artist_id::UUID, @day::DATE, @followers::BIGINT)
+ON CONFLICT (artist_fk, day) DO UPDATE SET followers = EXCLUDED.followers;
+
+-- Artists with songs ordered by id, the next page starts after the last id.
+-- name: ArtistsWithSongs :many
+SELECT DISTINCT singer_fk
+FROM songs
+WHERE singer_fk > @after_id::UUID AND deleted_at IS NULL
+ORDER BY singer_fk
+LIMIT @limitv;
+
+-- Bucket is one of date_trunc units, e.g. day or week.
+-- name: ArtistSongStats :many
+SELECT
+    songs.song_id,
+    songs.name,
+    DATE_TRUNC(@bucket::TEXT, stats.day)::DATE AS bucket_start,
+    SUM(stats.plays)::BIGINT AS plays,
+    SUM(stats.likes)::BIGINT AS likes,
+    SUM(stats.unlikes)::BIGINT AS unlikes,
+    SUM(stats.playlist_adds)::BIGINT AS playlist_adds
+FROM song_daily_stats AS stats
+JOIN songs ON songs.song_id = stats.song_fk
+WHERE songs.singer_fk = @artist_id::UUID AND stats.day BETWEEN @from_day::DATE AND @to_day::DATE
+GROUP BY songs.song_id, bucket_start
+ORDER BY songs.song_id, bucket_start;
+
+-- Unique listeners can't be summed up, so they are counted per song and bucket,
+-- per bucket of all songs, per song of the whole period and of all songs of the whole period.
+-- name: ArtistListeners :many
+SELECT
+    COALESCE(listeners.song_fk, '00000000-0000-0000-0000-000000000000')::UUID AS song_id,
+    COALESCE(listeners.bucket_start, @from_day::DATE)::DATE AS bucket_start,
+    (GROUPING(listeners.song_fk) = 1)::BOOLEAN AS all_songs,
+    (GROUPING(listeners.bucket_start) = 1)::BOOLEAN AS whole_period,
+    COUNT(DISTINCT listeners.listener)::BIGINT AS listeners
+FROM (
+    SELECT song_listeners.song_fk, DATE_TRUNC(@bucket::TEXT, song_listeners.day)::DATE AS bucket_start,
+        song_listeners.listener
+    FROM song_listeners
+    JOIN songs ON songs.song_id = song_listeners.song_fk
+    WHERE songs.singer_fk = @artist_id::UUID AND song_listeners.day BETWEEN @from_day::DATE AND @to_day::DATE
+) AS listeners
+GROUP BY GROUPING SETS (
+    (listeners.song_fk, listeners.bucket_start),
+    (listeners.bucket_start),
+    (listeners.song_fk),
+    ()
+);
+
+-- The last snapshot before the period is returned too, the growth is counted from it.
+-- name: ArtistFollowers :many
+SELECT day, followers
+FROM artist_followers
+WHERE artist_fk = @artist_id::UUID AND day <= @to_day::DATE AND day >= COALESCE((
+    SELECT MAX(earlier.day)
+    FROM artist_followers AS earlier
+    WHERE earlier.artist_fk = @artist_id::UUID AND earlier.day < @from_day::DATE
+), @from_day::DATE)
+ORDER BY day;
+
+-- name: SongObjectRefs :many
+SELECT song_id, s3_object_name, image_url
+FROM songs
+WHERE song_id > @after_id AND (s3_object_name IS NOT NULL OR image_url IS NOT NULL)
+ORDER BY song_id
+LIMIT @limitv;
+
+-- Moves the audio of the song to the state, the object is set by uploads.
+-- Songs processed right away are started, so workers don't take them.
+-- name: SetAudioState :one
+UPDATE songs SET
+    audio_state = @audio_state,
+    audio_error = sqlc.narg('audio_error'),
+    audio_state_at = NOW(),
+    processing_started_at = sqlc.narg('processing_started_at'),
+    s3_object_name = COALESCE(sqlc.narg('s3_object_name'), s3_object_name),
+    weight_bytes = COALESCE(sqlc.narg('weight_bytes'), weight_bytes)
+WHERE song_id = @song_id::UUID
+RETURNING *;
+
+-- Takes the song uploaded first, or one whose worker is gone.
+-- name: StartProcessing :one
+UPDATE songs SET processing_started_at = NOW()
+WHERE song_id = (
+    SELECT waiting.song_id
+    FROM songs AS waiting
+    WHERE waiting.audio_state = 'processing' AND waiting.deleted_at IS NULL AND
+        (waiting.processing_started_at IS NULL OR waiting.processing_started_at <= @stale_before)
+    ORDER BY waiting.audio_state_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- Finishes the processing unless the song was uploaded again in the meantime.
+-- name: FinishProcessing :one
+UPDATE songs SET
+    audio_state = @audio_state,
+    audio_error = sqlc.narg('audio_error'),
+    audio_state_at = NOW(),
+    processing_started_at = NULL,
+    duration = COALESCE(sqlc.narg('duration'), duration),
+    bitrate = COALESCE(sqlc.narg('bitrate'), bitrate),
+    bitrate_mode = COALESCE(sqlc.narg('bitrate_mode'), bitrate_mode),
+    sample_rate = COALESCE(sqlc.narg('sample_rate'), sample_rate),
+    channel_mode = COALESCE(sqlc.narg('channel_mode'), channel_mode),
+    encoder = COALESCE(sqlc.narg('encoder'), encoder),
+    explicit = explicit OR @explicit::BOOLEAN,
+    waveform = sqlc.narg('waveform')::SMALLINT[],
+    thumbnail_url = COALESCE(sqlc.narg('thumbnail_url'), thumbnail_url)
+WHERE song_id = @song_id::UUID AND audio_state = 'processing' AND processing_started_at = @started_at
+RETURNING *;
+
+-- Songs may share a hosted image, its thumbnail is set on all of them.
+-- name: SetImageThumbnail :exec
+UPDATE songs SET thumbnail_url = @thumbnail_url
+WHERE image_url = @image_url;
+
+-- Uploads whose requests are gone without a word fail, the artist may upload again.
+-- name: FailStaleUploads :many
+UPDATE songs SET
+    audio_state = 'failed',
+    audio_error = @audio_error,
+    audio_state_at = NOW()
+WHERE audio_state = 'uploading' AND audio_state_at <= @stale_before
+RETURNING song_id;
+
+-- Queues the job, a waiting or running job with the same unique key is returned instead.
+-- name: EnqueueJob :one
+INSERT INTO jobs (job_id, kind, payload, unique_key, max_attempts, run_at)
+VALUES (@job_id, @kind, @payload, sqlc.narg('unique_key'), @max_attempts, @run_at)
+ON CONFLICT (unique_key) WHERE status IN ('queued', 'running')
+DO UPDATE SET unique_key = EXCLUDED.unique_key
+RETURNING *;
+
+-- Takes the due job of the kinds waiting first, or one whose worker is gone.
+-- name: ClaimJob :one
+UPDATE jobs SET status = 'running', locked_at = NOW(), attempts = attempts + 1
+WHERE job_id = (
+    SELECT waiting.job_id
+    FROM jobs AS waiting
+    WHERE waiting.kind = ANY(@kinds::TEXT[]) AND (
+        (waiting.status = 'queued' AND waiting.run_at <= NOW()) OR
+        (waiting.status = 'running' AND waiting.locked_at <= @stale_before))
+    ORDER BY waiting.run_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- Deletes the finished job unless another worker took it over.
+-- name: CompleteJob :exec
+DELETE FROM jobs WHERE job_id = @job_id AND locked_at = @locked_at;
+
+-- Queues the failed job again or makes it dead unless another worker took it over.
+-- name: FailJob :exec
+UPDATE jobs SET
+    status = @status,
+    last_error = @last_error,
+    run_at = @run_at,
+    locked_at = NULL,
+    failed_at = NOW()
+WHERE job_id = @job_id AND locked_at = @locked_at;
+
+-- name: DeadJobs :many
+SELECT * FROM jobs
+WHERE status = 'dead'
+ORDER BY failed_at DESC
+LIMIT @limitv OFFSET @offsetv;
+
+-- name: CountDeadJobs :one
+SELECT COUNT(*)::INT FROM jobs WHERE status = 'dead';
+
+-- name: RetryDeadJob :one
+UPDATE jobs SET status = 'queued', attempts = 0, run_at = NOW(), locked_at = NULL
+WHERE job_id = @job_id AND status = 'dead'
+RETURNING *;
+
+-- name: SaveWebhook :one
+INSERT INTO webhooks (webhook_id, artist_fk, url, secret, events)
+VALUES (@webhook_id, @artist_id::UUID, @url, @secret, @events::TEXT[])
+RETURNING *;
+
+-- name: CountArtistWebhooks :one
+SELECT COUNT(*)::INT FROM webhooks WHERE artist_fk = @artist_id::UUID;
+
+-- name: ArtistWebhooks :many
+SELECT *
+FROM webhooks
+WHERE artist_fk = @artist_id::UUID
+ORDER BY created_at;
+
+-- name: SubscribedWebhooks :many
+SELECT *
+FROM webhooks
+WHERE artist_fk = @artist_id::UUID AND @event::TEXT = ANY(events);
+
+-- name: DeleteWebhook :one
+DELETE FROM webhooks
+WHERE webhook_id = @webhook_id AND artist_fk = @artist_id::UUID
+RETURNING webhook_id;
+
+-- An event is saved once per webhook, returns no rows if it is already saved.
+-- name: SaveWebhookDelivery :one
+INSERT INTO webhook_deliveries (delivery_id, webhook_fk, event_id, event, payload)
+VALUES (@delivery_id, @webhook_id::UUID, @event_id, @event, @payload)
+ON CONFLICT (webhook_fk, event_id) DO NOTHING
+RETURNING *;
+
+-- name: WebhookDelivery :one
+SELECT sqlc.embed(webhook_deliveries), webhooks.url, webhooks.secret
+FROM webhook_deliveries
+JOIN webhooks ON webhooks.webhook_id = webhook_deliveries.webhook_fk
+WHERE webhook_deliveries.delivery_id = @delivery_id;
+
+-- name: RecordWebhookAttempt :one
+UPDATE webhook_deliveries SET
+    status = @status,
+    attempts = attempts + 1,
+    response_code = sqlc.narg(response_code),
+    error = sqlc.narg(error),
+    attempted_at = NOW()
+WHERE delivery_id = @delivery_id
+RETURNING *;
+
+-- name: WebhookDeliveries :many
+SELECT webhook_deliveries.*
+FROM webhook_deliveries
+JOIN webhooks ON webhooks.webhook_id = webhook_deliveries.webhook_fk
+WHERE webhooks.webhook_id = @webhook_id AND webhooks.artist_fk = @artist_id::UUID
+ORDER BY webhook_deliveries.created_at DESC
+LIMIT @limitv OFFSET @offsetv;
+
+-- name: CountWebhookDeliveries :one
+SELECT COUNT(*)::INT
+FROM webhook_deliveries
+JOIN webhooks ON webhooks.webhook_id = webhook_deliveries.webhook_fk
+WHERE webhooks.webhook_id = @webhook_id AND webhooks.artist_fk = @artist_id::UUID;
+
+-- Makes the delivery pending again with all its attempts.
+-- name: RedeliverWebhook :one
+UPDATE webhook_deliveries SET status = 'pending', attempts = 0
+FROM webhooks
+WHERE webhook_deliveries.delivery_id = @delivery_id AND webhooks.webhook_id = webhook_deliveries.webhook_fk AND
+    webhooks.artist_fk = @artist_id::UUID
+RETURNING webhook_deliveries.*;
+
+-- name: PurgeWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries
+WHERE created_at <= @created_before;
@@ -13,122 +13,514 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const countMySongs = `-- name: CountMySongs :one
-SELECT COUNT(*)::INT
+const addSongStats = `-- name: AddSongStats :exec
+INSERT INTO song_daily_stats (song_fk, day, plays, likes, unlikes, playlist_adds)
+SELECT song_id, $1::DATE, $2::BIGINT, $3::BIGINT, $4::BIGINT, $5::BIGINT
 FROM songs
-WHERE singer_fk = $1::UUID
+WHERE song_id = ANY($6::UUID[])
+ON CONFLICT (song_fk, day) DO UPDATE SET
+    plays = song_daily_stats.plays + EXCLUDED.plays,
+    likes = song_daily_stats.likes + EXCLUDED.likes,
+    unlikes = song_daily_stats.unlikes + EXCLUDED.unlikes,
+    playlist_adds = song_daily_stats.playlist_adds + EXCLUDED.playlist_adds
 `
 
-func (q *Queries) CountMySongs(ctx context.Context, singerID uuid.UUID) (int32, error) {
-	row := q.db.QueryRow(ctx, countMySongs, singerID)
-	var column_1 int32
-	err := row.Scan(&column_1)
-	return column_1, err
+type AddSongStatsParams struct {
+	Day          time.Time
+	Plays        int64
+	Likes        int64
+	Unlikes      int64
+	PlaylistAdds int64
+	SongIds      []uuid.UUID
 }
 
-const countSongsMatchName = `-- name: CountSongsMatchName :one
-SELECT COUNT(*)::INT
-FROM songs
-WHERE name ILIKE CONCAT('%', $1::TEXT, '%')
+// Unknown songs are skipped, the playlists may have tracks that are not ours anymore.
+func (q *Queries) AddSongStats(ctx context.Context, arg AddSongStatsParams) error {
+	_, err := q.db.Exec(ctx, addSongStats,
+		arg.Day,
+		arg.Plays,
+		arg.Likes,
+		arg.Unlikes,
+		arg.PlaylistAdds,
+		arg.SongIds,
+	)
+	return err
+}
+
+const artistExports = `-- name: ArtistExports :many
+SELECT export_id, artist_fk, status, error, weight_bytes, created_at, started_at, finished_at, expires_at
+FROM exports
+WHERE artist_fk = $1::UUID AND (expires_at IS NULL OR expires_at > NOW())
+ORDER BY created_at DESC
+LIMIT $2
 `
 
-func (q *Queries) CountSongsMatchName(ctx context.Context, matchName string) (int32, error) {
-	row := q.db.QueryRow(ctx, countSongsMatchName, matchName)
-	var column_1 int32
-	err := row.Scan(&column_1)
-	return column_1, err
+type ArtistExportsParams struct {
+	ArtistID uuid.UUID
+	Limitv   int32
 }
 
-const countSongsWithArtistsIds = `-- name: CountSongsWithArtistsIds :one
-WITH cte AS (
-    SELECT COUNT(*)
-    FROM songs
-    WHERE singer_fk = ANY($1::UUID[])
+func (q *Queries) ArtistExports(ctx context.Context, arg ArtistExportsParams) ([]Export, error) {
+	rows, err := q.db.Query(ctx, artistExports, arg.ArtistID, arg.Limitv)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Export
+	for rows.Next() {
+		var i Export
+		if err := rows.Scan(
+			&i.ExportID,
+			&i.ArtistFk,
+			&i.Status,
+			&i.Error,
+			&i.WeightBytes,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const artistFollowers = `-- name: ArtistFollowers :many
+SELECT day, followers
+FROM artist_followers
+WHERE artist_fk = $1::UUID AND day <= $2::DATE AND day >= COALESCE((
+    SELECT MAX(earlier.day)
+    FROM artist_followers AS earlier
+    WHERE earlier.artist_fk = $1::UUID AND earlier.day < $3::DATE
+), $3::DATE)
+ORDER BY day
+`
+
+type ArtistFollowersParams struct {
+	ArtistID uuid.UUID
+	ToDay    time.Time
+	FromDay  time.Time
+}
+
+type ArtistFollowersRow struct {
+	Day       time.Time
+	Followers int64
+}
+
+// The last snapshot before the period is returned too, the growth is counted from it.
+func (q *Queries) ArtistFollowers(ctx context.Context, arg ArtistFollowersParams) ([]ArtistFollowersRow, error) {
+	rows, err := q.db.Query(ctx, artistFollowers, arg.ArtistID, arg.ToDay, arg.FromDay)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ArtistFollowersRow
+	for rows.Next() {
+		var i ArtistFollowersRow
+		if err := rows.Scan(&i.Day, &i.Followers); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const artistListeners = `-- name: ArtistListeners :many
+SELECT
+    COALESCE(listeners.song_fk, '00000000-0000-0000-0000-000000000000')::UUID AS song_id,
+    COALESCE(listeners.bucket_start, $1::DATE)::DATE AS bucket_start,
+    (GROUPING(listeners.song_fk) = 1)::BOOLEAN AS all_songs,
+    (GROUPING(listeners.bucket_start) = 1)::BOOLEAN AS whole_period,
+    COUNT(DISTINCT listeners.listener)::BIGINT AS listeners
+FROM (
+    SELECT song_listeners.song_fk, DATE_TRUNC($2::TEXT, song_listeners.day)::DATE AS bucket_start,
+        song_listeners.listener
+    FROM song_listeners
+    JOIN songs ON songs.song_id = song_listeners.song_fk
+    WHERE songs.singer_fk = $3::UUID AND song_listeners.day BETWEEN $1::DATE AND $4::DATE
+) AS listeners
+GROUP BY GROUPING SETS (
+    (listeners.song_fk, listeners.bucket_start),
+    (listeners.bucket_start),
+    (listeners.song_fk),
+    ()
 )
-SELECT COUNT(*)::INT + (SELECT count FROM cte)
-FROM feats
-WHERE artist_fk = ANY($1::UUID[])
 `
 
-func (q *Queries) CountSongsWithArtistsIds(ctx context.Context, singersIds []uuid.UUID) (int32, error) {
-	row := q.db.QueryRow(ctx, countSongsWithArtistsIds, singersIds)
-	var column_1 int32
-	err := row.Scan(&column_1)
-	return column_1, err
+type ArtistListenersParams struct {
+	FromDay  time.Time
+	Bucket   string
+	ArtistID uuid.UUID
+	ToDay    time.Time
 }
 
-const deleteSongs = `-- name: DeleteSongs :exec
-DELETE FROM songs WHERE song_id = ANY($1::UUID[])
+type ArtistListenersRow struct {
+	SongID      uuid.UUID
+	BucketStart time.Time
+	AllSongs    bool
+	WholePeriod bool
+	Listeners   int64
+}
+
+// Unique listeners can't be summed up, so they are counted per song and bucket,
+// per bucket of all songs, per song of the whole period and of all songs of the whole period.
+func (q *Queries) ArtistListeners(ctx context.Context, arg ArtistListenersParams) ([]ArtistListenersRow, error) {
+	rows, err := q.db.Query(ctx, artistListeners,
+		arg.FromDay,
+		arg.Bucket,
+		arg.ArtistID,
+		arg.ToDay,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ArtistListenersRow
+	for rows.Next() {
+		var i ArtistListenersRow
+		if err := rows.Scan(
+			&i.SongID,
+			&i.BucketStart,
+			&i.AllSongs,
+			&i.WholePeriod,
+			&i.Listeners,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const artistSongStats = `-- name: ArtistSongStats :many
+SELECT
+    songs.song_id,
+    songs.name,
+    DATE_TRUNC($1::TEXT, stats.day)::DATE AS bucket_start,
+    SUM(stats.plays)::BIGINT AS plays,
+    SUM(stats.likes)::BIGINT AS likes,
+    SUM(stats.unlikes)::BIGINT AS unlikes,
+    SUM(stats.playlist_adds)::BIGINT AS playlist_adds
+FROM song_daily_stats AS stats
+JOIN songs ON songs.song_id = stats.song_fk
+WHERE songs.singer_fk = $2::UUID AND stats.day BETWEEN $3::DATE AND $4::DATE
+GROUP BY songs.song_id, bucket_start
+ORDER BY songs.song_id, bucket_start
 `
 
-func (q *Queries) DeleteSongs(ctx context.Context, ids []uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteSongs, ids)
-	return err
+type ArtistSongStatsParams struct {
+	Bucket   string
+	ArtistID uuid.UUID
+	FromDay  time.Time
+	ToDay    time.Time
 }
 
-const mySong = `-- name: MySong :one
-SELECT songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at
+type ArtistSongStatsRow struct {
+	SongID       uuid.UUID
+	Name         string
+	BucketStart  time.Time
+	Plays        int64
+	Likes        int64
+	Unlikes      int64
+	PlaylistAdds int64
+}
+
+// Bucket is one of date_trunc units, e.g. day or week.
+func (q *Queries) ArtistSongStats(ctx context.Context, arg ArtistSongStatsParams) ([]ArtistSongStatsRow, error) {
+	rows, err := q.db.Query(ctx, artistSongStats,
+		arg.Bucket,
+		arg.ArtistID,
+		arg.FromDay,
+		arg.ToDay,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ArtistSongStatsRow
+	for rows.Next() {
+		var i ArtistSongStatsRow
+		if err := rows.Scan(
+			&i.SongID,
+			&i.Name,
+			&i.BucketStart,
+			&i.Plays,
+			&i.Likes,
+			&i.Unlikes,
+			&i.PlaylistAdds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const artistUsage = `-- name: ArtistUsage :one
+SELECT COUNT(*)::INT                          AS songs_count,
+       COALESCE(SUM(weight_bytes), 0)::BIGINT AS weight_bytes
 FROM songs
-WHERE singer_fk = $1::UUID AND song_id = $2::UUID
+WHERE singer_fk = $1::UUID
 `
 
-type MySongParams struct {
-	SingerID uuid.UUID
-	SongID   uuid.UUID
+type ArtistUsageRow struct {
+	SongsCount  int32
+	WeightBytes int64
 }
 
-type MySongRow struct {
-	Song Song
+// Trashed songs are counted, they are stored until purged.
+func (q *Queries) ArtistUsage(ctx context.Context, singerID uuid.UUID) (ArtistUsageRow, error) {
+	row := q.db.QueryRow(ctx, artistUsage, singerID)
+	var i ArtistUsageRow
+	err := row.Scan(&i.SongsCount, &i.WeightBytes)
+	return i, err
 }
 
-func (q *Queries) MySong(ctx context.Context, arg MySongParams) (MySongRow, error) {
-	row := q.db.QueryRow(ctx, mySong, arg.SingerID, arg.SongID)
-	var i MySongRow
+const artistWebhooks = `-- name: ArtistWebhooks :many
+SELECT webhook_id, artist_fk, url, secret, events, created_at
+FROM webhooks
+WHERE artist_fk = $1::UUID
+ORDER BY created_at
+`
+
+func (q *Queries) ArtistWebhooks(ctx context.Context, artistID uuid.UUID) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, artistWebhooks, artistID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.WebhookID,
+			&i.ArtistFk,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const artistsWithSongs = `-- name: ArtistsWithSongs :many
+SELECT DISTINCT singer_fk
+FROM songs
+WHERE singer_fk > $1::UUID AND deleted_at IS NULL
+ORDER BY singer_fk
+LIMIT $2
+`
+
+type ArtistsWithSongsParams struct {
+	AfterID uuid.UUID
+	Limitv  int32
+}
+
+// Artists with songs ordered by id, the next page starts after the last id.
+func (q *Queries) ArtistsWithSongs(ctx context.Context, arg ArtistsWithSongsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, artistsWithSongs, arg.AfterID, arg.Limitv)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var singer_fk uuid.UUID
+		if err := rows.Scan(&singer_fk); err != nil {
+			return nil, err
+		}
+		items = append(items, singer_fk)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claim = `-- name: Claim :one
+SELECT claim_id, song_fk, claimant_id, claimant_name, claimant_email, description, evidence_urls, status, counter_notice, resolution_note, created_at, updated_at, prior_moderation_status, prior_moderation_reason, song_id, song_name, artist_id
+FROM claims
+WHERE claim_id = $1::UUID
+`
+
+func (q *Queries) Claim(ctx context.Context, claimID uuid.UUID) (Claim, error) {
+	row := q.db.QueryRow(ctx, claim, claimID)
+	var i Claim
 	err := row.Scan(
-		&i.Song.SongID,
-		&i.Song.SingerFk,
-		&i.Song.Name,
-		&i.Song.S3ObjectName,
-		&i.Song.ImageUrl,
-		&i.Song.Duration,
-		&i.Song.WeightBytes,
-		&i.Song.UploadedAt,
-		&i.Song.ReleasedAt,
+		&i.ClaimID,
+		&i.SongFk,
+		&i.ClaimantID,
+		&i.ClaimantName,
+		&i.ClaimantEmail,
+		&i.Description,
+		&i.EvidenceUrls,
+		&i.Status,
+		&i.CounterNotice,
+		&i.ResolutionNote,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PriorModerationStatus,
+		&i.PriorModerationReason,
+		&i.SongID,
+		&i.SongName,
+		&i.ArtistID,
 	)
 	return i, err
 }
 
-const mySongs = `-- name: MySongs :many
-SELECT
-    songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at,
-    ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)::UUID[] AS artists_ids
+const claimEvents = `-- name: ClaimEvents :many
+SELECT event_id, claim_fk, from_status, to_status, actor_id, note, created_at
+FROM claim_events
+WHERE claim_fk = $1::UUID
+ORDER BY event_id
+`
+
+func (q *Queries) ClaimEvents(ctx context.Context, claimID uuid.UUID) ([]ClaimEvent, error) {
+	rows, err := q.db.Query(ctx, claimEvents, claimID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimEvent
+	for rows.Next() {
+		var i ClaimEvent
+		if err := rows.Scan(
+			&i.EventID,
+			&i.ClaimFk,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.ActorID,
+			&i.Note,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimJob = `-- name: ClaimJob :one
+UPDATE jobs SET status = 'running', locked_at = NOW(), attempts = attempts + 1
+WHERE job_id = (
+    SELECT waiting.job_id
+    FROM jobs AS waiting
+    WHERE waiting.kind = ANY($1::TEXT[]) AND (
+        (waiting.status = 'queued' AND waiting.run_at <= NOW()) OR
+        (waiting.status = 'running' AND waiting.locked_at <= $2))
+    ORDER BY waiting.run_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING job_id, kind, payload, status, unique_key, attempts, max_attempts, last_error, run_at, locked_at, created_at, failed_at
+`
+
+type ClaimJobParams struct {
+	Kinds       []string
+	StaleBefore pgtype.Timestamptz
+}
+
+// Takes the due job of the kinds waiting first, or one whose worker is gone.
+func (q *Queries) ClaimJob(ctx context.Context, arg ClaimJobParams) (Job, error) {
+	row := q.db.QueryRow(ctx, claimJob, arg.Kinds, arg.StaleBefore)
+	var i Job
+	err := row.Scan(
+		&i.JobID,
+		&i.Kind,
+		&i.Payload,
+		&i.Status,
+		&i.UniqueKey,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LastError,
+		&i.RunAt,
+		&i.LockedAt,
+		&i.CreatedAt,
+		&i.FailedAt,
+	)
+	return i, err
+}
+
+const claimantVerified = `-- name: ClaimantVerified :one
+SELECT EXISTS (SELECT 1 FROM verified_claimants WHERE claimant_id = $1::UUID)
+`
+
+func (q *Queries) ClaimantVerified(ctx context.Context, claimantID uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, claimantVerified, claimantID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const claimedSong = `-- name: ClaimedSong :one
+SELECT singer_fk, name
 FROM songs
-LEFT JOIN feats ON feats.song_fk = songs.song_id
-WHERE singer_fk = $1::UUID AND (NOT $2::BOOLEAN OR song_id = ANY($3::UUID[]))
-GROUP BY songs.song_id
-ORDER BY songs.uploaded_at DESC, name
-LIMIT $5
-OFFSET $4
+WHERE song_id = $1::UUID AND deleted_at IS NULL
 `
 
-type MySongsParams struct {
-	SingerID uuid.UUID
-	ByIds    bool
-	Ids      []uuid.UUID
-	Offsetv  int32
-	Limitv   int32
+type ClaimedSongRow struct {
+	SingerFk uuid.UUID
+	Name     string
 }
 
-type MySongsRow struct {
-	Song       Song
-	ArtistsIds []uuid.UUID
+func (q *Queries) ClaimedSong(ctx context.Context, songID uuid.UUID) (ClaimedSongRow, error) {
+	row := q.db.QueryRow(ctx, claimedSong, songID)
+	var i ClaimedSongRow
+	err := row.Scan(&i.SingerFk, &i.Name)
+	return i, err
 }
 
-func (q *Queries) MySongs(ctx context.Context, arg MySongsParams) ([]MySongsRow, error) {
-	rows, err := q.db.Query(ctx, mySongs,
-		arg.SingerID,
-		arg.ByIds,
-		arg.Ids,
+const claims = `-- name: Claims :many
+SELECT claim_id, song_fk, claimant_id, claimant_name, claimant_email, description, evidence_urls, status, counter_notice, resolution_note, created_at, updated_at, prior_moderation_status, prior_moderation_reason, song_id, song_name, artist_id
+FROM claims
+WHERE
+    -- Admins see all claims, others only their own or the ones against their songs
+    ($1::BOOLEAN OR claimant_id = $2::UUID OR artist_id = $2::UUID) AND
+    (NOT $3::BOOLEAN OR status::TEXT = $4::TEXT)
+ORDER BY created_at DESC
+LIMIT $6
+OFFSET $5
+`
+
+type ClaimsParams struct {
+	AllClaims bool
+	UserID    uuid.UUID
+	ByStatus  bool
+	Status    string
+	Offsetv   int32
+	Limitv    int32
+}
+
+func (q *Queries) Claims(ctx context.Context, arg ClaimsParams) ([]Claim, error) {
+	rows, err := q.db.Query(ctx, claims,
+		arg.AllClaims,
+		arg.UserID,
+		arg.ByStatus,
+		arg.Status,
 		arg.Offsetv,
 		arg.Limitv,
 	)
@@ -136,20 +528,27 @@ func (q *Queries) MySongs(ctx context.Context, arg MySongsParams) ([]MySongsRow,
 		return nil, err
 	}
 	defer rows.Close()
-	var items []MySongsRow
+	var items []Claim
 	for rows.Next() {
-		var i MySongsRow
+		var i Claim
 		if err := rows.Scan(
-			&i.Song.SongID,
-			&i.Song.SingerFk,
-			&i.Song.Name,
-			&i.Song.S3ObjectName,
-			&i.Song.ImageUrl,
-			&i.Song.Duration,
-			&i.Song.WeightBytes,
-			&i.Song.UploadedAt,
-			&i.Song.ReleasedAt,
-			&i.ArtistsIds,
+			&i.ClaimID,
+			&i.SongFk,
+			&i.ClaimantID,
+			&i.ClaimantName,
+			&i.ClaimantEmail,
+			&i.Description,
+			&i.EvidenceUrls,
+			&i.Status,
+			&i.CounterNotice,
+			&i.ResolutionNote,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PriorModerationStatus,
+			&i.PriorModerationReason,
+			&i.SongID,
+			&i.SongName,
+			&i.ArtistID,
 		); err != nil {
 			return nil, err
 		}
@@ -161,44 +560,2383 @@ func (q *Queries) MySongs(ctx context.Context, arg MySongsParams) ([]MySongsRow,
 	return items, nil
 }
 
-const patchSong = `-- name: PatchSong :one
-UPDATE songs SET
-    singer_fk = COALESCE($1, singer_fk),
-    name = COALESCE($2, name),
-    s3_object_name = COALESCE($3, s3_object_name),
-    image_url = COALESCE($4, image_url),
-    duration = COALESCE($5, duration),
-    weight_bytes = COALESCE($6, weight_bytes),
-    released_at = COALESCE($7, released_at),
-    uploaded_at = COALESCE($8, uploaded_at)
-WHERE song_id = $9
-RETURNING song_id, singer_fk, name, s3_object_name, image_url, duration, weight_bytes, uploaded_at, released_at
+const completeJob = `-- name: CompleteJob :exec
+DELETE FROM jobs WHERE job_id = $1 AND locked_at = $2
+`
+
+type CompleteJobParams struct {
+	JobID    uuid.UUID
+	LockedAt pgtype.Timestamptz
+}
+
+// Deletes the finished job unless another worker took it over.
+func (q *Queries) CompleteJob(ctx context.Context, arg CompleteJobParams) error {
+	_, err := q.db.Exec(ctx, completeJob, arg.JobID, arg.LockedAt)
+	return err
+}
+
+const countArtistWebhooks = `-- name: CountArtistWebhooks :one
+SELECT COUNT(*)::INT FROM webhooks WHERE artist_fk = $1::UUID
+`
+
+func (q *Queries) CountArtistWebhooks(ctx context.Context, artistID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countArtistWebhooks, artistID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countClaimantOpenClaims = `-- name: CountClaimantOpenClaims :one
+SELECT COUNT(*)::INT
+FROM claims
+WHERE claimant_id = $1::UUID AND status IN ('pending', 'counter_noticed')
+`
+
+func (q *Queries) CountClaimantOpenClaims(ctx context.Context, claimantID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countClaimantOpenClaims, claimantID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countClaims = `-- name: CountClaims :one
+SELECT COUNT(*)::INT
+FROM claims
+WHERE
+    ($1::BOOLEAN OR claimant_id = $2::UUID OR artist_id = $2::UUID) AND
+    (NOT $3::BOOLEAN OR status::TEXT = $4::TEXT)
+`
+
+type CountClaimsParams struct {
+	AllClaims bool
+	UserID    uuid.UUID
+	ByStatus  bool
+	Status    string
+}
+
+func (q *Queries) CountClaims(ctx context.Context, arg CountClaimsParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countClaims,
+		arg.AllClaims,
+		arg.UserID,
+		arg.ByStatus,
+		arg.Status,
+	)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countCreditRequests = `-- name: CountCreditRequests :one
+SELECT COUNT(*)::INT
+FROM feats
+JOIN songs ON songs.song_id = feats.song_fk
+WHERE feats.artist_fk = $1::UUID AND feats.status = 'pending' AND songs.deleted_at IS NULL
+`
+
+func (q *Queries) CountCreditRequests(ctx context.Context, artistID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countCreditRequests, artistID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countDeadJobs = `-- name: CountDeadJobs :one
+SELECT COUNT(*)::INT FROM jobs WHERE status = 'dead'
+`
+
+func (q *Queries) CountDeadJobs(ctx context.Context) (int32, error) {
+	row := q.db.QueryRow(ctx, countDeadJobs)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countMySongs = `-- name: CountMySongs :one
+SELECT COUNT(*)::INT
+FROM songs
+WHERE singer_fk = $1::UUID AND deleted_at IS NULL
+`
+
+func (q *Queries) CountMySongs(ctx context.Context, singerID uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countMySongs, singerID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countOpenClaims = `-- name: CountOpenClaims :one
+SELECT COUNT(*)::INT
+FROM claims
+WHERE song_id = $1::UUID AND claim_id <> $2::UUID
+    AND status IN ('pending', 'counter_noticed', 'upheld')
+`
+
+type CountOpenClaimsParams struct {
+	SongID  uuid.UUID
+	ClaimID uuid.UUID
+}
+
+func (q *Queries) CountOpenClaims(ctx context.Context, arg CountOpenClaimsParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countOpenClaims, arg.SongID, arg.ClaimID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countSongsMatchName = `-- name: CountSongsMatchName :one
+SELECT COUNT(*)::INT
+FROM songs
+WHERE name ILIKE CONCAT('%', $1::TEXT, '%') AND deleted_at IS NULL
+`
+
+func (q *Queries) CountSongsMatchName(ctx context.Context, matchName string) (int32, error) {
+	row := q.db.QueryRow(ctx, countSongsMatchName, matchName)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countSongsWithArtistsIds = `-- name: CountSongsWithArtistsIds :one
+WITH cte AS (
+    SELECT COUNT(*)
+    FROM songs
+    WHERE singer_fk = ANY($1::UUID[]) AND deleted_at IS NULL
+)
+SELECT COUNT(*)::INT + (SELECT count FROM cte)
+FROM feats
+JOIN songs ON songs.song_id = feats.song_fk
+WHERE artist_fk = ANY($1::UUID[]) AND role = 'featured' AND status = 'approved' AND songs.deleted_at IS NULL
+`
+
+func (q *Queries) CountSongsWithArtistsIds(ctx context.Context, singersIds []uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countSongsWithArtistsIds, singersIds)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countSongsWithCredit = `-- name: CountSongsWithCredit :one
+SELECT COUNT(*)::INT
+FROM songs
+WHERE released_at IS NOT NULL AND moderation_status <> 'taken_down' AND deleted_at IS NULL AND (
+    (NOT $1::BOOLEAN AND singer_fk = $2::UUID AND
+        (NOT $3::BOOLEAN OR $4::TEXT = 'primary')) OR
+    EXISTS (
+        SELECT 1
+        FROM feats credits
+        WHERE credits.song_fk = songs.song_id AND credits.status = 'approved' AND
+            (NOT $3::BOOLEAN OR credits.role::TEXT = $4::TEXT) AND (
+            ($1::BOOLEAN AND credits.name ILIKE CONCAT('%', $5::TEXT, '%')) OR
+            (NOT $1::BOOLEAN AND credits.artist_fk = $2::UUID))
+    ))
+`
+
+type CountSongsWithCreditParams struct {
+	CreditByName   bool
+	CreditArtistID uuid.UUID
+	CreditByRole   bool
+	CreditRole     string
+	CreditName     string
+}
+
+func (q *Queries) CountSongsWithCredit(ctx context.Context, arg CountSongsWithCreditParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countSongsWithCredit,
+		arg.CreditByName,
+		arg.CreditArtistID,
+		arg.CreditByRole,
+		arg.CreditRole,
+		arg.CreditName,
+	)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countTrashedSongs = `-- name: CountTrashedSongs :one
+SELECT COUNT(*)::INT
+FROM songs
+WHERE singer_fk = $1::UUID AND deleted_at > $2
+`
+
+type CountTrashedSongsParams struct {
+	SingerID     uuid.UUID
+	DeletedAfter pgtype.Timestamptz
+}
+
+func (q *Queries) CountTrashedSongs(ctx context.Context, arg CountTrashedSongsParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countTrashedSongs, arg.SingerID, arg.DeletedAfter)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countWebhookDeliveries = `-- name: CountWebhookDeliveries :one
+SELECT COUNT(*)::INT
+FROM webhook_deliveries
+JOIN webhooks ON webhooks.webhook_id = webhook_deliveries.webhook_fk
+WHERE webhooks.webhook_id = $1 AND webhooks.artist_fk = $2::UUID
+`
+
+type CountWebhookDeliveriesParams struct {
+	WebhookID uuid.UUID
+	ArtistID  uuid.UUID
+}
+
+func (q *Queries) CountWebhookDeliveries(ctx context.Context, arg CountWebhookDeliveriesParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countWebhookDeliveries, arg.WebhookID, arg.ArtistID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const creditRequests = `-- name: CreditRequests :many
+SELECT
+    feats.credit_id,
+    feats.role,
+    songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at, songs.moderation_status, songs.moderation_reason, songs.moderator_id, songs.moderated_at, songs.explicit, songs.allowed_countries, songs.denied_countries, songs.deleted_at, songs.bitrate, songs.bitrate_mode, songs.sample_rate, songs.channel_mode, songs.encoder, songs.audio_state, songs.audio_error, songs.audio_state_at, songs.processing_started_at, songs.waveform, songs.thumbnail_url
+FROM feats
+JOIN songs ON songs.song_id = feats.song_fk
+WHERE feats.artist_fk = $1::UUID AND feats.status = 'pending' AND songs.deleted_at IS NULL
+ORDER BY feats.credit_id DESC
+LIMIT $3
+OFFSET $2
+`
+
+type CreditRequestsParams struct {
+	ArtistID uuid.UUID
+	Offsetv  int32
+	Limitv   int32
+}
+
+type CreditRequestsRow struct {
+	CreditID int64
+	Role     CreditRole
+	Song     Song
+}
+
+func (q *Queries) CreditRequests(ctx context.Context, arg CreditRequestsParams) ([]CreditRequestsRow, error) {
+	rows, err := q.db.Query(ctx, creditRequests, arg.ArtistID, arg.Offsetv, arg.Limitv)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CreditRequestsRow
+	for rows.Next() {
+		var i CreditRequestsRow
+		if err := rows.Scan(
+			&i.CreditID,
+			&i.Role,
+			&i.Song.SongID,
+			&i.Song.SingerFk,
+			&i.Song.Name,
+			&i.Song.S3ObjectName,
+			&i.Song.ImageUrl,
+			&i.Song.Duration,
+			&i.Song.WeightBytes,
+			&i.Song.UploadedAt,
+			&i.Song.ReleasedAt,
+			&i.Song.ModerationStatus,
+			&i.Song.ModerationReason,
+			&i.Song.ModeratorID,
+			&i.Song.ModeratedAt,
+			&i.Song.Explicit,
+			&i.Song.AllowedCountries,
+			&i.Song.DeniedCountries,
+			&i.Song.DeletedAt,
+			&i.Song.Bitrate,
+			&i.Song.BitrateMode,
+			&i.Song.SampleRate,
+			&i.Song.ChannelMode,
+			&i.Song.Encoder,
+			&i.Song.AudioState,
+			&i.Song.AudioError,
+			&i.Song.AudioStateAt,
+			&i.Song.ProcessingStartedAt,
+			&i.Song.Waveform,
+			&i.Song.ThumbnailUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deadJobs = `-- name: DeadJobs :many
+SELECT job_id, kind, payload, status, unique_key, attempts, max_attempts, last_error, run_at, locked_at, created_at, failed_at FROM jobs
+WHERE status = 'dead'
+ORDER BY failed_at DESC
+LIMIT $2 OFFSET $1
+`
+
+type DeadJobsParams struct {
+	Offsetv int32
+	Limitv  int32
+}
+
+func (q *Queries) DeadJobs(ctx context.Context, arg DeadJobsParams) ([]Job, error) {
+	rows, err := q.db.Query(ctx, deadJobs, arg.Offsetv, arg.Limitv)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Job
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.JobID,
+			&i.Kind,
+			&i.Payload,
+			&i.Status,
+			&i.UniqueKey,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.LastError,
+			&i.RunAt,
+			&i.LockedAt,
+			&i.CreatedAt,
+			&i.FailedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteExports = `-- name: DeleteExports :exec
+DELETE FROM exports
+WHERE export_id = ANY($1::UUID[])
+`
+
+func (q *Queries) DeleteExports(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteExports, ids)
+	return err
+}
+
+const deleteSongs = `-- name: DeleteSongs :exec
+DELETE FROM songs WHERE song_id = ANY($1::UUID[])
+`
+
+func (q *Queries) DeleteSongs(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteSongs, ids)
+	return err
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :one
+DELETE FROM webhooks
+WHERE webhook_id = $1 AND artist_fk = $2::UUID
+RETURNING webhook_id
+`
+
+type DeleteWebhookParams struct {
+	WebhookID uuid.UUID
+	ArtistID  uuid.UUID
+}
+
+func (q *Queries) DeleteWebhook(ctx context.Context, arg DeleteWebhookParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, deleteWebhook, arg.WebhookID, arg.ArtistID)
+	var webhook_id uuid.UUID
+	err := row.Scan(&webhook_id)
+	return webhook_id, err
+}
+
+const enqueueJob = `-- name: EnqueueJob :one
+INSERT INTO jobs (job_id, kind, payload, unique_key, max_attempts, run_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (unique_key) WHERE status IN ('queued', 'running')
+DO UPDATE SET unique_key = EXCLUDED.unique_key
+RETURNING job_id, kind, payload, status, unique_key, attempts, max_attempts, last_error, run_at, locked_at, created_at, failed_at
+`
+
+type EnqueueJobParams struct {
+	JobID       uuid.UUID
+	Kind        string
+	Payload     []byte
+	UniqueKey   pgtype.Text
+	MaxAttempts int32
+	RunAt       time.Time
+}
+
+// Queues the job, a waiting or running job with the same unique key is returned instead.
+func (q *Queries) EnqueueJob(ctx context.Context, arg EnqueueJobParams) (Job, error) {
+	row := q.db.QueryRow(ctx, enqueueJob,
+		arg.JobID,
+		arg.Kind,
+		arg.Payload,
+		arg.UniqueKey,
+		arg.MaxAttempts,
+		arg.RunAt,
+	)
+	var i Job
+	err := row.Scan(
+		&i.JobID,
+		&i.Kind,
+		&i.Payload,
+		&i.Status,
+		&i.UniqueKey,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LastError,
+		&i.RunAt,
+		&i.LockedAt,
+		&i.CreatedAt,
+		&i.FailedAt,
+	)
+	return i, err
+}
+
+const expiredExports = `-- name: ExpiredExports :many
+SELECT export_id, artist_fk, status, error, weight_bytes, created_at, started_at, finished_at, expires_at
+FROM exports
+WHERE expires_at <= NOW()
+ORDER BY expires_at
+LIMIT $1
+`
+
+func (q *Queries) ExpiredExports(ctx context.Context, limitv int32) ([]Export, error) {
+	rows, err := q.db.Query(ctx, expiredExports, limitv)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Export
+	for rows.Next() {
+		var i Export
+		if err := rows.Scan(
+			&i.ExportID,
+			&i.ArtistFk,
+			&i.Status,
+			&i.Error,
+			&i.WeightBytes,
+			&i.CreatedAt,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const expiredTrashedSongs = `-- name: ExpiredTrashedSongs :many
+SELECT song_id, singer_fk, name, s3_object_name, image_url, duration, weight_bytes, uploaded_at, released_at, moderation_status, moderation_reason, moderator_id, moderated_at, explicit, allowed_countries, denied_countries, deleted_at, bitrate, bitrate_mode, sample_rate, channel_mode, encoder, audio_state, audio_error, audio_state_at, processing_started_at, waveform, thumbnail_url
+FROM songs
+WHERE deleted_at <= $1
+ORDER BY deleted_at
+LIMIT $2
+FOR UPDATE SKIP LOCKED
+`
+
+type ExpiredTrashedSongsParams struct {
+	DeletedBefore pgtype.Timestamptz
+	Limitv        int32
+}
+
+// Locks the songs, so purge workers of other instances skip them.
+func (q *Queries) ExpiredTrashedSongs(ctx context.Context, arg ExpiredTrashedSongsParams) ([]Song, error) {
+	rows, err := q.db.Query(ctx, expiredTrashedSongs, arg.DeletedBefore, arg.Limitv)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Song
+	for rows.Next() {
+		var i Song
+		if err := rows.Scan(
+			&i.SongID,
+			&i.SingerFk,
+			&i.Name,
+			&i.S3ObjectName,
+			&i.ImageUrl,
+			&i.Duration,
+			&i.WeightBytes,
+			&i.UploadedAt,
+			&i.ReleasedAt,
+			&i.ModerationStatus,
+			&i.ModerationReason,
+			&i.ModeratorID,
+			&i.ModeratedAt,
+			&i.Explicit,
+			&i.AllowedCountries,
+			&i.DeniedCountries,
+			&i.DeletedAt,
+			&i.Bitrate,
+			&i.BitrateMode,
+			&i.SampleRate,
+			&i.ChannelMode,
+			&i.Encoder,
+			&i.AudioState,
+			&i.AudioError,
+			&i.AudioStateAt,
+			&i.ProcessingStartedAt,
+			&i.Waveform,
+			&i.ThumbnailUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const export = `-- name: Export :one
+SELECT export_id, artist_fk, status, error, weight_bytes, created_at, started_at, finished_at, expires_at
+FROM exports
+WHERE export_id = $1::UUID
+`
+
+func (q *Queries) Export(ctx context.Context, exportID uuid.UUID) (Export, error) {
+	row := q.db.QueryRow(ctx, export, exportID)
+	var i Export
+	err := row.Scan(
+		&i.ExportID,
+		&i.ArtistFk,
+		&i.Status,
+		&i.Error,
+		&i.WeightBytes,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const failJob = `-- name: FailJob :exec
+UPDATE jobs SET
+    status = $1,
+    last_error = $2,
+    run_at = $3,
+    locked_at = NULL,
+    failed_at = NOW()
+WHERE job_id = $4 AND locked_at = $5
+`
+
+type FailJobParams struct {
+	Status    JobStatus
+	LastError pgtype.Text
+	RunAt     time.Time
+	JobID     uuid.UUID
+	LockedAt  pgtype.Timestamptz
+}
+
+// Queues the failed job again or makes it dead unless another worker took it over.
+func (q *Queries) FailJob(ctx context.Context, arg FailJobParams) error {
+	_, err := q.db.Exec(ctx, failJob,
+		arg.Status,
+		arg.LastError,
+		arg.RunAt,
+		arg.JobID,
+		arg.LockedAt,
+	)
+	return err
+}
+
+const failStaleUploads = `-- name: FailStaleUploads :many
+UPDATE songs SET
+    audio_state = 'failed',
+    audio_error = $1,
+    audio_state_at = NOW()
+WHERE audio_state = 'uploading' AND audio_state_at <= $2
+RETURNING song_id
+`
+
+type FailStaleUploadsParams struct {
+	AudioError  pgtype.Text
+	StaleBefore time.Time
+}
+
+// Uploads whose requests are gone without a word fail, the artist may upload again.
+func (q *Queries) FailStaleUploads(ctx context.Context, arg FailStaleUploadsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, failStaleUploads, arg.AudioError, arg.StaleBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var song_id uuid.UUID
+		if err := rows.Scan(&song_id); err != nil {
+			return nil, err
+		}
+		items = append(items, song_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const finishExport = `-- name: FinishExport :one
+UPDATE exports SET
+    status = $1,
+    error = $2,
+    weight_bytes = $3,
+    finished_at = NOW(),
+    expires_at = $4
+WHERE export_id = $5::UUID AND status = 'running'
+RETURNING export_id, artist_fk, status, error, weight_bytes, created_at, started_at, finished_at, expires_at
+`
+
+type FinishExportParams struct {
+	Status      ExportStatus
+	Error       pgtype.Text
+	WeightBytes pgtype.Int8
+	ExpiresAt   pgtype.Timestamptz
+	ExportID    uuid.UUID
+}
+
+func (q *Queries) FinishExport(ctx context.Context, arg FinishExportParams) (Export, error) {
+	row := q.db.QueryRow(ctx, finishExport,
+		arg.Status,
+		arg.Error,
+		arg.WeightBytes,
+		arg.ExpiresAt,
+		arg.ExportID,
+	)
+	var i Export
+	err := row.Scan(
+		&i.ExportID,
+		&i.ArtistFk,
+		&i.Status,
+		&i.Error,
+		&i.WeightBytes,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const finishProcessing = `-- name: FinishProcessing :one
+UPDATE songs SET
+    audio_state = $1,
+    audio_error = $2,
+    audio_state_at = NOW(),
+    processing_started_at = NULL,
+    duration = COALESCE($3, duration),
+    bitrate = COALESCE($4, bitrate),
+    bitrate_mode = COALESCE($5, bitrate_mode),
+    sample_rate = COALESCE($6, sample_rate),
+    channel_mode = COALESCE($7, channel_mode),
+    encoder = COALESCE($8, encoder),
+    explicit = explicit OR $9::BOOLEAN,
+    waveform = $10::SMALLINT[],
+    thumbnail_url = COALESCE($11, thumbnail_url)
+WHERE song_id = $12::UUID AND audio_state = 'processing' AND processing_started_at = $13
+RETURNING song_id, singer_fk, name, s3_object_name, image_url, duration, weight_bytes, uploaded_at, released_at, moderation_status, moderation_reason, moderator_id, moderated_at, explicit, allowed_countries, denied_countries, deleted_at, bitrate, bitrate_mode, sample_rate, channel_mode, encoder, audio_state, audio_error, audio_state_at, processing_started_at, waveform, thumbnail_url
+`
+
+type FinishProcessingParams struct {
+	AudioState   AudioState
+	AudioError   pgtype.Text
+	Duration     pgtype.Interval
+	Bitrate      pgtype.Int4
+	BitrateMode  NullBitrateMode
+	SampleRate   pgtype.Int4
+	ChannelMode  NullChannelMode
+	Encoder      pgtype.Text
+	Explicit     bool
+	Waveform     []int16
+	ThumbnailUrl pgtype.Text
+	SongID       uuid.UUID
+	StartedAt    pgtype.Timestamptz
+}
+
+// Finishes the processing unless the song was uploaded again in the meantime.
+func (q *Queries) FinishProcessing(ctx context.Context, arg FinishProcessingParams) (Song, error) {
+	row := q.db.QueryRow(ctx, finishProcessing,
+		arg.AudioState,
+		arg.AudioError,
+		arg.Duration,
+		arg.Bitrate,
+		arg.BitrateMode,
+		arg.SampleRate,
+		arg.ChannelMode,
+		arg.Encoder,
+		arg.Explicit,
+		arg.Waveform,
+		arg.ThumbnailUrl,
+		arg.SongID,
+		arg.StartedAt,
+	)
+	var i Song
+	err := row.Scan(
+		&i.SongID,
+		&i.SingerFk,
+		&i.Name,
+		&i.S3ObjectName,
+		&i.ImageUrl,
+		&i.Duration,
+		&i.WeightBytes,
+		&i.UploadedAt,
+		&i.ReleasedAt,
+		&i.ModerationStatus,
+		&i.ModerationReason,
+		&i.ModeratorID,
+		&i.ModeratedAt,
+		&i.Explicit,
+		&i.AllowedCountries,
+		&i.DeniedCountries,
+		&i.DeletedAt,
+		&i.Bitrate,
+		&i.BitrateMode,
+		&i.SampleRate,
+		&i.ChannelMode,
+		&i.Encoder,
+		&i.AudioState,
+		&i.AudioError,
+		&i.AudioStateAt,
+		&i.ProcessingStartedAt,
+		&i.Waveform,
+		&i.ThumbnailUrl,
+	)
+	return i, err
+}
+
+const imageObjectStatus = `-- name: ImageObjectStatus :one
+SELECT
+    BOOL_OR(released_at IS NOT NULL AND moderation_status <> 'taken_down')::BOOLEAN AS released,
+    ARRAY_AGG(singer_fk) FILTER (WHERE moderation_status <> 'taken_down')::UUID[] AS singer_ids
+FROM songs
+WHERE image_url = $1 AND deleted_at IS NULL
+HAVING COUNT(*) > 0
+`
+
+type ImageObjectStatusRow struct {
+	Released  bool
+	SingerIds []uuid.UUID
+}
+
+// Songs may share a hosted image, it is released if one of them is released and not taken down.
+// Singers of the songs that are not taken down see it before the release.
+func (q *Queries) ImageObjectStatus(ctx context.Context, imageUrl pgtype.Text) (ImageObjectStatusRow, error) {
+	row := q.db.QueryRow(ctx, imageObjectStatus, imageUrl)
+	var i ImageObjectStatusRow
+	err := row.Scan(&i.Released, &i.SingerIds)
+	return i, err
+}
+
+const imageUsedByOthers = `-- name: ImageUsedByOthers :one
+SELECT EXISTS (
+    SELECT 1
+    FROM songs
+    WHERE image_url = $1 AND song_id <> ALL($2::UUID[])
+)
+`
+
+type ImageUsedByOthersParams struct {
+	ImageUrl    pgtype.Text
+	ExcludedIds []uuid.UUID
+}
+
+// Songs may share a hosted image, the purge keeps it while other songs, trashed ones too, have it.
+func (q *Queries) ImageUsedByOthers(ctx context.Context, arg ImageUsedByOthersParams) (bool, error) {
+	row := q.db.QueryRow(ctx, imageUsedByOthers, arg.ImageUrl, arg.ExcludedIds)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const moderateClaimedSong = `-- name: ModerateClaimedSong :one
+WITH prior AS (
+    SELECT
+        songs.song_id,
+        songs.moderation_status,
+        songs.moderation_reason,
+        claims.claim_id AS claim_id,
+        claims.prior_moderation_status AS claim_prior_status,
+        claims.prior_moderation_reason AS claim_prior_reason
+    FROM songs
+    LEFT JOIN claims ON claims.song_fk = songs.song_id
+        AND claims.prior_moderation_status IS NOT NULL
+        AND songs.moderator_id IS NULL
+        AND songs.moderation_reason = 'copyright claim ' || claims.claim_id::TEXT
+    WHERE songs.song_id = $4::UUID
+    FOR UPDATE OF songs
+)
+UPDATE songs SET
+    moderation_status = $1::moderation_status,
+    moderation_reason = $2::TEXT,
+    moderator_id = NULL,
+    moderated_at = $3
+FROM prior
+WHERE songs.song_id = prior.song_id AND prior.moderation_status < $1::moderation_status
+RETURNING
+    prior.moderation_status AS prior_moderation_status,
+    prior.moderation_reason AS prior_moderation_reason,
+    prior.claim_id AS prior_claim_id,
+    prior.claim_prior_status,
+    prior.claim_prior_reason
+`
+
+type ModerateClaimedSongParams struct {
+	ModerationStatus ModerationStatus
+	ModerationReason string
+	ModeratedAt      pgtype.Timestamptz
+	SongID           uuid.UUID
+}
+
+type ModerateClaimedSongRow struct {
+	PriorModerationStatus ModerationStatus
+	PriorModerationReason pgtype.Text
+	PriorClaimID          pgtype.UUID
+	ClaimPriorStatus      NullModerationStatus
+	ClaimPriorReason      pgtype.Text
+}
+
+// Takes the song down or puts it under review for a claim.
+// Returns no rows if the song already has this or a stricter moderation status, it is kept as is.
+// If the song is moderated by another claim, that claim is returned with the state it replaced.
+func (q *Queries) ModerateClaimedSong(ctx context.Context, arg ModerateClaimedSongParams) (ModerateClaimedSongRow, error) {
+	row := q.db.QueryRow(ctx, moderateClaimedSong,
+		arg.ModerationStatus,
+		arg.ModerationReason,
+		arg.ModeratedAt,
+		arg.SongID,
+	)
+	var i ModerateClaimedSongRow
+	err := row.Scan(
+		&i.PriorModerationStatus,
+		&i.PriorModerationReason,
+		&i.PriorClaimID,
+		&i.ClaimPriorStatus,
+		&i.ClaimPriorReason,
+	)
+	return i, err
+}
+
+const moderateSong = `-- name: ModerateSong :one
+UPDATE songs SET
+    moderation_status = $1,
+    moderation_reason = $2,
+    moderator_id = $3,
+    moderated_at = $4
+WHERE song_id = $5
+RETURNING song_id, singer_fk, name, s3_object_name, image_url, duration, weight_bytes, uploaded_at, released_at, moderation_status, moderation_reason, moderator_id, moderated_at, explicit, allowed_countries, denied_countries, deleted_at, bitrate, bitrate_mode, sample_rate, channel_mode, encoder, audio_state, audio_error, audio_state_at, processing_started_at, waveform, thumbnail_url
+`
+
+type ModerateSongParams struct {
+	ModerationStatus ModerationStatus
+	ModerationReason pgtype.Text
+	ModeratorID      pgtype.UUID
+	ModeratedAt      pgtype.Timestamptz
+	SongID           uuid.UUID
+}
+
+func (q *Queries) ModerateSong(ctx context.Context, arg ModerateSongParams) (Song, error) {
+	row := q.db.QueryRow(ctx, moderateSong,
+		arg.ModerationStatus,
+		arg.ModerationReason,
+		arg.ModeratorID,
+		arg.ModeratedAt,
+		arg.SongID,
+	)
+	var i Song
+	err := row.Scan(
+		&i.SongID,
+		&i.SingerFk,
+		&i.Name,
+		&i.S3ObjectName,
+		&i.ImageUrl,
+		&i.Duration,
+		&i.WeightBytes,
+		&i.UploadedAt,
+		&i.ReleasedAt,
+		&i.ModerationStatus,
+		&i.ModerationReason,
+		&i.ModeratorID,
+		&i.ModeratedAt,
+		&i.Explicit,
+		&i.AllowedCountries,
+		&i.DeniedCountries,
+		&i.DeletedAt,
+		&i.Bitrate,
+		&i.BitrateMode,
+		&i.SampleRate,
+		&i.ChannelMode,
+		&i.Encoder,
+		&i.AudioState,
+		&i.AudioError,
+		&i.AudioStateAt,
+		&i.ProcessingStartedAt,
+		&i.Waveform,
+		&i.ThumbnailUrl,
+	)
+	return i, err
+}
+
+const mySong = `-- name: MySong :one
+SELECT songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at, songs.moderation_status, songs.moderation_reason, songs.moderator_id, songs.moderated_at, songs.explicit, songs.allowed_countries, songs.denied_countries, songs.deleted_at, songs.bitrate, songs.bitrate_mode, songs.sample_rate, songs.channel_mode, songs.encoder, songs.audio_state, songs.audio_error, songs.audio_state_at, songs.processing_started_at, songs.waveform, songs.thumbnail_url
+FROM songs
+WHERE singer_fk = $1::UUID AND song_id = $2::UUID AND deleted_at IS NULL
+`
+
+type MySongParams struct {
+	SingerID uuid.UUID
+	SongID   uuid.UUID
+}
+
+type MySongRow struct {
+	Song Song
+}
+
+func (q *Queries) MySong(ctx context.Context, arg MySongParams) (MySongRow, error) {
+	row := q.db.QueryRow(ctx, mySong, arg.SingerID, arg.SongID)
+	var i MySongRow
+	err := row.Scan(
+		&i.Song.SongID,
+		&i.Song.SingerFk,
+		&i.Song.Name,
+		&i.Song.S3ObjectName,
+		&i.Song.ImageUrl,
+		&i.Song.Duration,
+		&i.Song.WeightBytes,
+		&i.Song.UploadedAt,
+		&i.Song.ReleasedAt,
+		&i.Song.ModerationStatus,
+		&i.Song.ModerationReason,
+		&i.Song.ModeratorID,
+		&i.Song.ModeratedAt,
+		&i.Song.Explicit,
+		&i.Song.AllowedCountries,
+		&i.Song.DeniedCountries,
+		&i.Song.DeletedAt,
+		&i.Song.Bitrate,
+		&i.Song.BitrateMode,
+		&i.Song.SampleRate,
+		&i.Song.ChannelMode,
+		&i.Song.Encoder,
+		&i.Song.AudioState,
+		&i.Song.AudioError,
+		&i.Song.AudioStateAt,
+		&i.Song.ProcessingStartedAt,
+		&i.Song.Waveform,
+		&i.Song.ThumbnailUrl,
+	)
+	return i, err
+}
+
+const mySongByName = `-- name: MySongByName :one
+SELECT songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at, songs.moderation_status, songs.moderation_reason, songs.moderator_id, songs.moderated_at, songs.explicit, songs.allowed_countries, songs.denied_countries, songs.deleted_at, songs.bitrate, songs.bitrate_mode, songs.sample_rate, songs.channel_mode, songs.encoder, songs.audio_state, songs.audio_error, songs.audio_state_at, songs.processing_started_at, songs.waveform, songs.thumbnail_url
+FROM songs
+WHERE singer_fk = $1::UUID AND name = $2 AND deleted_at IS NULL
+`
+
+type MySongByNameParams struct {
+	SingerID uuid.UUID
+	Name     string
+}
+
+type MySongByNameRow struct {
+	Song Song
+}
+
+// Imports match manifest rows to songs by name, it is unique per artist.
+func (q *Queries) MySongByName(ctx context.Context, arg MySongByNameParams) (MySongByNameRow, error) {
+	row := q.db.QueryRow(ctx, mySongByName, arg.SingerID, arg.Name)
+	var i MySongByNameRow
+	err := row.Scan(
+		&i.Song.SongID,
+		&i.Song.SingerFk,
+		&i.Song.Name,
+		&i.Song.S3ObjectName,
+		&i.Song.ImageUrl,
+		&i.Song.Duration,
+		&i.Song.WeightBytes,
+		&i.Song.UploadedAt,
+		&i.Song.ReleasedAt,
+		&i.Song.ModerationStatus,
+		&i.Song.ModerationReason,
+		&i.Song.ModeratorID,
+		&i.Song.ModeratedAt,
+		&i.Song.Explicit,
+		&i.Song.AllowedCountries,
+		&i.Song.DeniedCountries,
+		&i.Song.DeletedAt,
+		&i.Song.Bitrate,
+		&i.Song.BitrateMode,
+		&i.Song.SampleRate,
+		&i.Song.ChannelMode,
+		&i.Song.Encoder,
+		&i.Song.AudioState,
+		&i.Song.AudioError,
+		&i.Song.AudioStateAt,
+		&i.Song.ProcessingStartedAt,
+		&i.Song.Waveform,
+		&i.Song.ThumbnailUrl,
+	)
+	return i, err
+}
+
+const mySongs = `-- name: MySongs :many
+SELECT
+    songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at, songs.moderation_status, songs.moderation_reason, songs.moderator_id, songs.moderated_at, songs.explicit, songs.allowed_countries, songs.denied_countries, songs.deleted_at, songs.bitrate, songs.bitrate_mode, songs.sample_rate, songs.channel_mode, songs.encoder, songs.audio_state, songs.audio_error, songs.audio_state_at, songs.processing_started_at, songs.waveform, songs.thumbnail_url,
+    COALESCE(ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)
+        FILTER (WHERE feats.role = 'featured' AND feats.artist_fk IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS artists_ids,
+    -- Credits are aggregated into parallel arrays, the nil UUID stands for a person without an account
+    COALESCE(ARRAY_AGG(COALESCE(feats.artist_fk, '00000000-0000-0000-0000-000000000000') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::UUID[] AS credits_artists_ids,
+    COALESCE(ARRAY_AGG(COALESCE(feats.name, '') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_names,
+    COALESCE(ARRAY_AGG(feats.role ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_roles,
+    COALESCE(ARRAY_AGG(feats.status ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_statuses
+FROM songs
+LEFT JOIN feats ON feats.song_fk = songs.song_id
+WHERE singer_fk = $1::UUID AND deleted_at IS NULL AND (NOT $2::BOOLEAN OR song_id = ANY($3::UUID[]))
+GROUP BY songs.song_id
+ORDER BY songs.uploaded_at DESC, songs.name
+LIMIT $5
+OFFSET $4
+`
+
+type MySongsParams struct {
+	SingerID uuid.UUID
+	ByIds    bool
+	Ids      []uuid.UUID
+	Offsetv  int32
+	Limitv   int32
+}
+
+type MySongsRow struct {
+	Song              Song
+	ArtistsIds        []uuid.UUID
+	CreditsArtistsIds []uuid.UUID
+	CreditsNames      []string
+	CreditsRoles      []string
+	CreditsStatuses   []string
+}
+
+func (q *Queries) MySongs(ctx context.Context, arg MySongsParams) ([]MySongsRow, error) {
+	rows, err := q.db.Query(ctx, mySongs,
+		arg.SingerID,
+		arg.ByIds,
+		arg.Ids,
+		arg.Offsetv,
+		arg.Limitv,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MySongsRow
+	for rows.Next() {
+		var i MySongsRow
+		if err := rows.Scan(
+			&i.Song.SongID,
+			&i.Song.SingerFk,
+			&i.Song.Name,
+			&i.Song.S3ObjectName,
+			&i.Song.ImageUrl,
+			&i.Song.Duration,
+			&i.Song.WeightBytes,
+			&i.Song.UploadedAt,
+			&i.Song.ReleasedAt,
+			&i.Song.ModerationStatus,
+			&i.Song.ModerationReason,
+			&i.Song.ModeratorID,
+			&i.Song.ModeratedAt,
+			&i.Song.Explicit,
+			&i.Song.AllowedCountries,
+			&i.Song.DeniedCountries,
+			&i.Song.DeletedAt,
+			&i.Song.Bitrate,
+			&i.Song.BitrateMode,
+			&i.Song.SampleRate,
+			&i.Song.ChannelMode,
+			&i.Song.Encoder,
+			&i.Song.AudioState,
+			&i.Song.AudioError,
+			&i.Song.AudioStateAt,
+			&i.Song.ProcessingStartedAt,
+			&i.Song.Waveform,
+			&i.Song.ThumbnailUrl,
+			&i.ArtistsIds,
+			&i.CreditsArtistsIds,
+			&i.CreditsNames,
+			&i.CreditsRoles,
+			&i.CreditsStatuses,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const patchSong = `-- name: PatchSong :one
+UPDATE songs SET
+    singer_fk = COALESCE($1, singer_fk),
+    name = COALESCE($2, name),
+    s3_object_name = COALESCE($3, s3_object_name),
+    image_url = COALESCE($4, image_url),
+    duration = COALESCE($5, duration),
+    weight_bytes = COALESCE($6, weight_bytes),
+    released_at = COALESCE($7, released_at),
+    uploaded_at = COALESCE($8, uploaded_at),
+    explicit = COALESCE($9, explicit),
+    allowed_countries = COALESCE($10::TEXT[], allowed_countries),
+    denied_countries = COALESCE($11::TEXT[], denied_countries),
+    bitrate = COALESCE($12, bitrate),
+    bitrate_mode = COALESCE($13, bitrate_mode),
+    sample_rate = COALESCE($14, sample_rate),
+    channel_mode = COALESCE($15, channel_mode),
+    encoder = COALESCE($16, encoder)
+WHERE song_id = $17
+RETURNING song_id, singer_fk, name, s3_object_name, image_url, duration, weight_bytes, uploaded_at, released_at, moderation_status, moderation_reason, moderator_id, moderated_at, explicit, allowed_countries, denied_countries, deleted_at, bitrate, bitrate_mode, sample_rate, channel_mode, encoder, audio_state, audio_error, audio_state_at, processing_started_at, waveform, thumbnail_url
+`
+
+type PatchSongParams struct {
+	SingerFk         pgtype.UUID
+	Name             pgtype.Text
+	S3ObjectName     pgtype.Text
+	ImageUrl         pgtype.Text
+	Duration         pgtype.Interval
+	WeightBytes      pgtype.Int4
+	ReleasedAt       pgtype.Timestamptz
+	UploadedAt       pgtype.Timestamptz
+	Explicit         pgtype.Bool
+	AllowedCountries []string
+	DeniedCountries  []string
+	Bitrate          pgtype.Int4
+	BitrateMode      NullBitrateMode
+	SampleRate       pgtype.Int4
+	ChannelMode      NullChannelMode
+	Encoder          pgtype.Text
+	ID               uuid.UUID
+}
+
+func (q *Queries) PatchSong(ctx context.Context, arg PatchSongParams) (Song, error) {
+	row := q.db.QueryRow(ctx, patchSong,
+		arg.SingerFk,
+		arg.Name,
+		arg.S3ObjectName,
+		arg.ImageUrl,
+		arg.Duration,
+		arg.WeightBytes,
+		arg.ReleasedAt,
+		arg.UploadedAt,
+		arg.Explicit,
+		arg.AllowedCountries,
+		arg.DeniedCountries,
+		arg.Bitrate,
+		arg.BitrateMode,
+		arg.SampleRate,
+		arg.ChannelMode,
+		arg.Encoder,
+		arg.ID,
+	)
+	var i Song
+	err := row.Scan(
+		&i.SongID,
+		&i.SingerFk,
+		&i.Name,
+		&i.S3ObjectName,
+		&i.ImageUrl,
+		&i.Duration,
+		&i.WeightBytes,
+		&i.UploadedAt,
+		&i.ReleasedAt,
+		&i.ModerationStatus,
+		&i.ModerationReason,
+		&i.ModeratorID,
+		&i.ModeratedAt,
+		&i.Explicit,
+		&i.AllowedCountries,
+		&i.DeniedCountries,
+		&i.DeletedAt,
+		&i.Bitrate,
+		&i.BitrateMode,
+		&i.SampleRate,
+		&i.ChannelMode,
+		&i.Encoder,
+		&i.AudioState,
+		&i.AudioError,
+		&i.AudioStateAt,
+		&i.ProcessingStartedAt,
+		&i.Waveform,
+		&i.ThumbnailUrl,
+	)
+	return i, err
+}
+
+const patchSongs = `-- name: PatchSongs :exec
+UPDATE songs SET
+    singer_fk = COALESCE($1, singer_fk),
+    name = COALESCE($2, name),
+    s3_object_name = COALESCE($3, s3_object_name),
+    image_url = COALESCE($4, image_url),
+    duration = COALESCE($5, duration),
+    weight_bytes = COALESCE($6, weight_bytes),
+    released_at = COALESCE($7, released_at),
+    uploaded_at = COALESCE($8, uploaded_at),
+    explicit = COALESCE($9, explicit)
+WHERE song_id = ANY($10::UUID[])
+`
+
+type PatchSongsParams struct {
+	SingerFk     pgtype.UUID
+	Name         pgtype.Text
+	S3ObjectName pgtype.Text
+	ImageUrl     pgtype.Text
+	Duration     pgtype.Interval
+	WeightBytes  pgtype.Int4
+	ReleasedAt   pgtype.Timestamptz
+	UploadedAt   pgtype.Timestamptz
+	Explicit     pgtype.Bool
+	Ids          []uuid.UUID
+}
+
+func (q *Queries) PatchSongs(ctx context.Context, arg PatchSongsParams) error {
+	_, err := q.db.Exec(ctx, patchSongs,
+		arg.SingerFk,
+		arg.Name,
+		arg.S3ObjectName,
+		arg.ImageUrl,
+		arg.Duration,
+		arg.WeightBytes,
+		arg.ReleasedAt,
+		arg.UploadedAt,
+		arg.Explicit,
+		arg.Ids,
+	)
+	return err
+}
+
+const purgeAnalyticsEvents = `-- name: PurgeAnalyticsEvents :execrows
+DELETE FROM analytics_events
+WHERE processed_at <= $1
+`
+
+func (q *Queries) PurgeAnalyticsEvents(ctx context.Context, processedBefore time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeAnalyticsEvents, processedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeWebhookDeliveries = `-- name: PurgeWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries
+WHERE created_at <= $1
+`
+
+func (q *Queries) PurgeWebhookDeliveries(ctx context.Context, createdBefore time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeWebhookDeliveries, createdBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const recordWebhookAttempt = `-- name: RecordWebhookAttempt :one
+UPDATE webhook_deliveries SET
+    status = $1,
+    attempts = attempts + 1,
+    response_code = $2,
+    error = $3,
+    attempted_at = NOW()
+WHERE delivery_id = $4
+RETURNING delivery_id, webhook_fk, event_id, event, payload, status, attempts, response_code, error, created_at, attempted_at
+`
+
+type RecordWebhookAttemptParams struct {
+	Status       WebhookDeliveryStatus
+	ResponseCode pgtype.Int4
+	Error        pgtype.Text
+	DeliveryID   uuid.UUID
+}
+
+func (q *Queries) RecordWebhookAttempt(ctx context.Context, arg RecordWebhookAttemptParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, recordWebhookAttempt,
+		arg.Status,
+		arg.ResponseCode,
+		arg.Error,
+		arg.DeliveryID,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.DeliveryID,
+		&i.WebhookFk,
+		&i.EventID,
+		&i.Event,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseCode,
+		&i.Error,
+		&i.CreatedAt,
+		&i.AttemptedAt,
+	)
+	return i, err
+}
+
+const redeliverWebhook = `-- name: RedeliverWebhook :one
+UPDATE webhook_deliveries SET status = 'pending', attempts = 0
+FROM webhooks
+WHERE webhook_deliveries.delivery_id = $1 AND webhooks.webhook_id = webhook_deliveries.webhook_fk AND
+    webhooks.artist_fk = $2::UUID
+RETURNING webhook_deliveries.delivery_id, webhook_deliveries.webhook_fk, webhook_deliveries.event_id, webhook_deliveries.event, webhook_deliveries.payload, webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.response_code, webhook_deliveries.error, webhook_deliveries.created_at, webhook_deliveries.attempted_at
+`
+
+type RedeliverWebhookParams struct {
+	DeliveryID uuid.UUID
+	ArtistID   uuid.UUID
+}
+
+// Makes the delivery pending again with all its attempts.
+func (q *Queries) RedeliverWebhook(ctx context.Context, arg RedeliverWebhookParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, redeliverWebhook, arg.DeliveryID, arg.ArtistID)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.DeliveryID,
+		&i.WebhookFk,
+		&i.EventID,
+		&i.Event,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseCode,
+		&i.Error,
+		&i.CreatedAt,
+		&i.AttemptedAt,
+	)
+	return i, err
+}
+
+const releasedSongs = `-- name: ReleasedSongs :many
+SELECT
+    songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at, songs.moderation_status, songs.moderation_reason, songs.moderator_id, songs.moderated_at, songs.explicit, songs.allowed_countries, songs.denied_countries, songs.deleted_at, songs.bitrate, songs.bitrate_mode, songs.sample_rate, songs.channel_mode, songs.encoder, songs.audio_state, songs.audio_error, songs.audio_state_at, songs.processing_started_at, songs.waveform, songs.thumbnail_url,
+    COALESCE(ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)
+        FILTER (WHERE feats.role = 'featured' AND feats.artist_fk IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS artists_ids,
+    -- Credits are aggregated into parallel arrays, the nil UUID stands for a person without an account.
+    -- Listeners see only approved credits.
+    COALESCE(ARRAY_AGG(COALESCE(feats.artist_fk, '00000000-0000-0000-0000-000000000000') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS credits_artists_ids,
+    COALESCE(ARRAY_AGG(COALESCE(feats.name, '') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_names,
+    COALESCE(ARRAY_AGG(feats.role ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_roles,
+    COALESCE(ARRAY_AGG(feats.status ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_statuses
+FROM songs
+LEFT JOIN feats ON feats.song_fk = songs.song_id
+WHERE
+    -- Only released songs!
+    released_at IS NOT NULL AND
+    -- Taken down songs are hidden from everyone but their artist
+    moderation_status <> 'taken_down' AND
+    -- Trashed songs are hidden from everyone
+    deleted_at IS NULL AND
+    -- Listeners may hide explicit songs
+    (NOT $1::BOOLEAN OR NOT explicit) AND (
+    -- All songs created by some artists
+    (($2::BOOLEAN OR $3::BOOLEAN) AND singer_fk = ANY($4::UUID[])) OR
+    -- All songs created by or featured some artists
+    ($3::BOOLEAN AND $4::UUID[] <@ (
+        SELECT ARRAY_AGG(feats.artist_fk)
+        FROM feats
+        WHERE song_fk = songs.song_id AND role = 'featured' AND status = 'approved'
+        GROUP BY song_fk
+    )) OR
+    -- All songs crediting an artist or a person matching name, the singer is the primary artist
+    ($5::BOOLEAN AND (
+        (NOT $6::BOOLEAN AND singer_fk = $7::UUID AND
+            (NOT $8::BOOLEAN OR $9::TEXT = 'primary')) OR
+        EXISTS (
+            SELECT 1
+            FROM feats credits
+            WHERE credits.song_fk = songs.song_id AND credits.status = 'approved' AND
+                (NOT $8::BOOLEAN OR credits.role::TEXT = $9::TEXT) AND (
+                ($6::BOOLEAN AND credits.name ILIKE CONCAT('%', $10::TEXT, '%')) OR
+                (NOT $6::BOOLEAN AND credits.artist_fk = $7::UUID))
+        ))) OR
+    -- All songs matching name
+    ($11::BOOLEAN AND name ILIKE CONCAT('%', $12::TEXT, '%')) OR
+    -- Songs by IDs
+    ($13::BOOLEAN AND song_id = ANY($14::UUID[]))
+    )
+GROUP BY songs.song_id
+ORDER BY songs.uploaded_at DESC, songs.name
+LIMIT $16
+OFFSET $15
+`
+
+type ReleasedSongsParams struct {
+	HideExplicit   bool
+	BySinger       bool
+	WithArtist     bool
+	SingersIds     []uuid.UUID
+	ByCredit       bool
+	CreditByName   bool
+	CreditArtistID uuid.UUID
+	CreditByRole   bool
+	CreditRole     string
+	CreditName     string
+	ByName         bool
+	MatchName      string
+	ByIds          bool
+	Ids            []uuid.UUID
+	Offsetv        int32
+	Limitv         int32
+}
+
+type ReleasedSongsRow struct {
+	Song              Song
+	ArtistsIds        []uuid.UUID
+	CreditsArtistsIds []uuid.UUID
+	CreditsNames      []string
+	CreditsRoles      []string
+	CreditsStatuses   []string
+}
+
+func (q *Queries) ReleasedSongs(ctx context.Context, arg ReleasedSongsParams) ([]ReleasedSongsRow, error) {
+	rows, err := q.db.Query(ctx, releasedSongs,
+		arg.HideExplicit,
+		arg.BySinger,
+		arg.WithArtist,
+		arg.SingersIds,
+		arg.ByCredit,
+		arg.CreditByName,
+		arg.CreditArtistID,
+		arg.CreditByRole,
+		arg.CreditRole,
+		arg.CreditName,
+		arg.ByName,
+		arg.MatchName,
+		arg.ByIds,
+		arg.Ids,
+		arg.Offsetv,
+		arg.Limitv,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReleasedSongsRow
+	for rows.Next() {
+		var i ReleasedSongsRow
+		if err := rows.Scan(
+			&i.Song.SongID,
+			&i.Song.SingerFk,
+			&i.Song.Name,
+			&i.Song.S3ObjectName,
+			&i.Song.ImageUrl,
+			&i.Song.Duration,
+			&i.Song.WeightBytes,
+			&i.Song.UploadedAt,
+			&i.Song.ReleasedAt,
+			&i.Song.ModerationStatus,
+			&i.Song.ModerationReason,
+			&i.Song.ModeratorID,
+			&i.Song.ModeratedAt,
+			&i.Song.Explicit,
+			&i.Song.AllowedCountries,
+			&i.Song.DeniedCountries,
+			&i.Song.DeletedAt,
+			&i.Song.Bitrate,
+			&i.Song.BitrateMode,
+			&i.Song.SampleRate,
+			&i.Song.ChannelMode,
+			&i.Song.Encoder,
+			&i.Song.AudioState,
+			&i.Song.AudioError,
+			&i.Song.AudioStateAt,
+			&i.Song.ProcessingStartedAt,
+			&i.Song.Waveform,
+			&i.Song.ThumbnailUrl,
+			&i.ArtistsIds,
+			&i.CreditsArtistsIds,
+			&i.CreditsNames,
+			&i.CreditsRoles,
+			&i.CreditsStatuses,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const replaceCredits = `-- name: ReplaceCredits :exec
+WITH deleted AS (
+    DELETE FROM feats WHERE song_fk = $1::UUID
+)
+INSERT INTO feats (song_fk, artist_fk, name, role, status, order_num)
+SELECT
+    $1::UUID AS song_fk,
+    NULLIF(($2::UUID[])[i], '00000000-0000-0000-0000-000000000000') AS artist_fk,
+    NULLIF(($3::TEXT[])[i], '') AS name,
+    ($4::TEXT[])[i]::credit_role AS role,
+    ($5::TEXT[])[i]::credit_status AS status,
+    i AS order_num
+FROM GENERATE_SUBSCRIPTS($4::TEXT[], 1) AS i
+`
+
+type ReplaceCreditsParams struct {
+	SongID            uuid.UUID
+	CreditsArtistsIds []uuid.UUID
+	CreditsNames      []string
+	CreditsRoles      []string
+	CreditsStatuses   []string
+}
+
+// The nil UUID and the empty name stand for a missing artist account and a missing name.
+func (q *Queries) ReplaceCredits(ctx context.Context, arg ReplaceCreditsParams) error {
+	_, err := q.db.Exec(ctx, replaceCredits,
+		arg.SongID,
+		arg.CreditsArtistsIds,
+		arg.CreditsNames,
+		arg.CreditsRoles,
+		arg.CreditsStatuses,
+	)
+	return err
+}
+
+const resolveCreditRequest = `-- name: ResolveCreditRequest :one
+UPDATE feats SET status = $1
+FROM songs
+WHERE feats.credit_id = $2 AND feats.artist_fk = $3::UUID AND feats.status = 'pending' AND
+    songs.song_id = feats.song_fk AND songs.deleted_at IS NULL
+RETURNING feats.song_fk, feats.role, songs.singer_fk
+`
+
+type ResolveCreditRequestParams struct {
+	Status   CreditStatus
+	CreditID int64
+	ArtistID uuid.UUID
+}
+
+type ResolveCreditRequestRow struct {
+	SongFk   uuid.UUID
+	Role     CreditRole
+	SingerFk uuid.UUID
+}
+
+func (q *Queries) ResolveCreditRequest(ctx context.Context, arg ResolveCreditRequestParams) (ResolveCreditRequestRow, error) {
+	row := q.db.QueryRow(ctx, resolveCreditRequest, arg.Status, arg.CreditID, arg.ArtistID)
+	var i ResolveCreditRequestRow
+	err := row.Scan(&i.SongFk, &i.Role, &i.SingerFk)
+	return i, err
+}
+
+const restoreClaimedSong = `-- name: RestoreClaimedSong :one
+UPDATE songs SET
+    moderation_status = claims.prior_moderation_status,
+    moderation_reason = claims.prior_moderation_reason,
+    moderator_id = $1,
+    moderated_at = $2
+FROM claims
+WHERE songs.song_id = $3::UUID
+    AND claims.song_fk = songs.song_id
+    AND claims.prior_moderation_status IS NOT NULL
+    AND songs.moderation_status <> 'active'
+    AND songs.moderator_id IS NULL
+    AND songs.moderation_reason = 'copyright claim ' || claims.claim_id::TEXT
+RETURNING songs.moderation_status
+`
+
+type RestoreClaimedSongParams struct {
+	ModeratorID pgtype.UUID
+	ModeratedAt pgtype.Timestamptz
+	SongID      uuid.UUID
+}
+
+// Gives the song back the moderation state it had before a claim moderated it.
+// Returns no rows if the song was not moderated by a claim or was moderated since.
+func (q *Queries) RestoreClaimedSong(ctx context.Context, arg RestoreClaimedSongParams) (ModerationStatus, error) {
+	row := q.db.QueryRow(ctx, restoreClaimedSong, arg.ModeratorID, arg.ModeratedAt, arg.SongID)
+	var moderation_status ModerationStatus
+	err := row.Scan(&moderation_status)
+	return moderation_status, err
+}
+
+const restoreSongs = `-- name: RestoreSongs :many
+UPDATE songs SET deleted_at = NULL
+WHERE singer_fk = $1::UUID AND song_id = ANY($2::UUID[]) AND deleted_at > $3
+RETURNING song_id, singer_fk, name, s3_object_name, image_url, duration, weight_bytes, uploaded_at, released_at, moderation_status, moderation_reason, moderator_id, moderated_at, explicit, allowed_countries, denied_countries, deleted_at, bitrate, bitrate_mode, sample_rate, channel_mode, encoder, audio_state, audio_error, audio_state_at, processing_started_at, waveform, thumbnail_url
+`
+
+type RestoreSongsParams struct {
+	SingerID     uuid.UUID
+	Ids          []uuid.UUID
+	DeletedAfter pgtype.Timestamptz
+}
+
+// Restores only the songs trashed after deleted_after, older ones are waiting to be purged.
+func (q *Queries) RestoreSongs(ctx context.Context, arg RestoreSongsParams) ([]Song, error) {
+	rows, err := q.db.Query(ctx, restoreSongs, arg.SingerID, arg.Ids, arg.DeletedAfter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Song
+	for rows.Next() {
+		var i Song
+		if err := rows.Scan(
+			&i.SongID,
+			&i.SingerFk,
+			&i.Name,
+			&i.S3ObjectName,
+			&i.ImageUrl,
+			&i.Duration,
+			&i.WeightBytes,
+			&i.UploadedAt,
+			&i.ReleasedAt,
+			&i.ModerationStatus,
+			&i.ModerationReason,
+			&i.ModeratorID,
+			&i.ModeratedAt,
+			&i.Explicit,
+			&i.AllowedCountries,
+			&i.DeniedCountries,
+			&i.DeletedAt,
+			&i.Bitrate,
+			&i.BitrateMode,
+			&i.SampleRate,
+			&i.ChannelMode,
+			&i.Encoder,
+			&i.AudioState,
+			&i.AudioError,
+			&i.AudioStateAt,
+			&i.ProcessingStartedAt,
+			&i.Waveform,
+			&i.ThumbnailUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const retryDeadJob = `-- name: RetryDeadJob :one
+UPDATE jobs SET status = 'queued', attempts = 0, run_at = NOW(), locked_at = NULL
+WHERE job_id = $1 AND status = 'dead'
+RETURNING job_id, kind, payload, status, unique_key, attempts, max_attempts, last_error, run_at, locked_at, created_at, failed_at
+`
+
+func (q *Queries) RetryDeadJob(ctx context.Context, jobID uuid.UUID) (Job, error) {
+	row := q.db.QueryRow(ctx, retryDeadJob, jobID)
+	var i Job
+	err := row.Scan(
+		&i.JobID,
+		&i.Kind,
+		&i.Payload,
+		&i.Status,
+		&i.UniqueKey,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LastError,
+		&i.RunAt,
+		&i.LockedAt,
+		&i.CreatedAt,
+		&i.FailedAt,
+	)
+	return i, err
+}
+
+const saveAnalyticsEvent = `-- name: SaveAnalyticsEvent :execrows
+INSERT INTO analytics_events (event_id)
+VALUES ($1)
+ON CONFLICT DO NOTHING
+`
+
+// Returns 0 if the event is already processed.
+func (q *Queries) SaveAnalyticsEvent(ctx context.Context, eventID string) (int64, error) {
+	result, err := q.db.Exec(ctx, saveAnalyticsEvent, eventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const saveArtistFollowers = `-- name: SaveArtistFollowers :exec
+INSERT INTO artist_followers (artist_fk, day, followers)
+VALUES ($1::UUID, $2::DATE, $3::BIGINT)
+ON CONFLICT (artist_fk, day) DO UPDATE SET followers = EXCLUDED.followers
+`
+
+type SaveArtistFollowersParams struct {
+	ArtistID  uuid.UUID
+	Day       time.Time
+	Followers int64
+}
+
+func (q *Queries) SaveArtistFollowers(ctx context.Context, arg SaveArtistFollowersParams) error {
+	_, err := q.db.Exec(ctx, saveArtistFollowers, arg.ArtistID, arg.Day, arg.Followers)
+	return err
+}
+
+const saveClaim = `-- name: SaveClaim :one
+INSERT INTO claims (
+    claim_id,
+    song_fk,
+    song_id,
+    song_name,
+    artist_id,
+    claimant_id,
+    claimant_name,
+    claimant_email,
+    description,
+    evidence_urls,
+    status,
+    prior_moderation_status,
+    prior_moderation_reason,
+    created_at,
+    updated_at)
+VALUES ($1, $2::UUID, $2::UUID, $3, $4, $5, $6, $7, $8,
+        $9::TEXT[], 'pending', $10,
+        $11, $12, $12)
+RETURNING claim_id, song_fk, claimant_id, claimant_name, claimant_email, description, evidence_urls, status, counter_notice, resolution_note, created_at, updated_at, prior_moderation_status, prior_moderation_reason, song_id, song_name, artist_id
+`
+
+type SaveClaimParams struct {
+	ClaimID               uuid.UUID
+	SongID                uuid.UUID
+	SongName              string
+	ArtistID              uuid.UUID
+	ClaimantID            uuid.UUID
+	ClaimantName          string
+	ClaimantEmail         string
+	Description           string
+	EvidenceUrls          []string
+	PriorModerationStatus NullModerationStatus
+	PriorModerationReason pgtype.Text
+	CreatedAt             time.Time
+}
+
+func (q *Queries) SaveClaim(ctx context.Context, arg SaveClaimParams) (Claim, error) {
+	row := q.db.QueryRow(ctx, saveClaim,
+		arg.ClaimID,
+		arg.SongID,
+		arg.SongName,
+		arg.ArtistID,
+		arg.ClaimantID,
+		arg.ClaimantName,
+		arg.ClaimantEmail,
+		arg.Description,
+		arg.EvidenceUrls,
+		arg.PriorModerationStatus,
+		arg.PriorModerationReason,
+		arg.CreatedAt,
+	)
+	var i Claim
+	err := row.Scan(
+		&i.ClaimID,
+		&i.SongFk,
+		&i.ClaimantID,
+		&i.ClaimantName,
+		&i.ClaimantEmail,
+		&i.Description,
+		&i.EvidenceUrls,
+		&i.Status,
+		&i.CounterNotice,
+		&i.ResolutionNote,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PriorModerationStatus,
+		&i.PriorModerationReason,
+		&i.SongID,
+		&i.SongName,
+		&i.ArtistID,
+	)
+	return i, err
+}
+
+const saveClaimEvent = `-- name: SaveClaimEvent :exec
+INSERT INTO claim_events (claim_fk, from_status, to_status, actor_id, note, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type SaveClaimEventParams struct {
+	ClaimFk    uuid.UUID
+	FromStatus NullClaimStatus
+	ToStatus   ClaimStatus
+	ActorID    uuid.UUID
+	Note       pgtype.Text
+	CreatedAt  time.Time
+}
+
+func (q *Queries) SaveClaimEvent(ctx context.Context, arg SaveClaimEventParams) error {
+	_, err := q.db.Exec(ctx, saveClaimEvent,
+		arg.ClaimFk,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.ActorID,
+		arg.Note,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const saveExport = `-- name: SaveExport :one
+INSERT INTO exports (export_id, artist_fk)
+VALUES ($1::UUID, $2::UUID)
+ON CONFLICT (artist_fk) WHERE status IN ('pending', 'running')
+DO UPDATE SET artist_fk = EXCLUDED.artist_fk
+RETURNING export_id, artist_fk, status, error, weight_bytes, created_at, started_at, finished_at, expires_at
+`
+
+type SaveExportParams struct {
+	ExportID uuid.UUID
+	ArtistID uuid.UUID
+}
+
+// Returns the unfinished export of the artist if there is one.
+func (q *Queries) SaveExport(ctx context.Context, arg SaveExportParams) (Export, error) {
+	row := q.db.QueryRow(ctx, saveExport, arg.ExportID, arg.ArtistID)
+	var i Export
+	err := row.Scan(
+		&i.ExportID,
+		&i.ArtistFk,
+		&i.Status,
+		&i.Error,
+		&i.WeightBytes,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const saveSong = `-- name: SaveSong :exec
+WITH inserted_song AS (
+    INSERT INTO songs (
+    song_id,
+    singer_fk,
+    name,
+    s3_object_name,
+    image_url,
+    duration,
+    weight_bytes,
+    uploaded_at,
+    released_at,
+    explicit) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    RETURNING song_id
+)
+INSERT INTO feats (song_fk, artist_fk, name, role, status, order_num)
+SELECT
+    (SELECT song_id FROM inserted_song) AS song_fk,
+    NULLIF(($11::UUID[])[i], '00000000-0000-0000-0000-000000000000') AS artist_fk,
+    NULLIF(($12::TEXT[])[i], '') AS name,
+    ($13::TEXT[])[i]::credit_role AS role,
+    ($14::TEXT[])[i]::credit_status AS status,
+    i AS order_num
+FROM GENERATE_SUBSCRIPTS($13::TEXT[], 1) AS i
+`
+
+type SaveSongParams struct {
+	SongID            uuid.UUID
+	SingerFk          uuid.UUID
+	Name              string
+	S3ObjectName      pgtype.Text
+	ImageUrl          pgtype.Text
+	Duration          pgtype.Interval
+	WeightBytes       pgtype.Int4
+	UploadedAt        time.Time
+	ReleasedAt        pgtype.Timestamptz
+	Explicit          bool
+	CreditsArtistsIds []uuid.UUID
+	CreditsNames      []string
+	CreditsRoles      []string
+	CreditsStatuses   []string
+}
+
+func (q *Queries) SaveSong(ctx context.Context, arg SaveSongParams) error {
+	_, err := q.db.Exec(ctx, saveSong,
+		arg.SongID,
+		arg.SingerFk,
+		arg.Name,
+		arg.S3ObjectName,
+		arg.ImageUrl,
+		arg.Duration,
+		arg.WeightBytes,
+		arg.UploadedAt,
+		arg.ReleasedAt,
+		arg.Explicit,
+		arg.CreditsArtistsIds,
+		arg.CreditsNames,
+		arg.CreditsRoles,
+		arg.CreditsStatuses,
+	)
+	return err
+}
+
+const saveSongListener = `-- name: SaveSongListener :exec
+INSERT INTO song_listeners (song_fk, day, listener)
+SELECT song_id, $1::DATE, $2::TEXT
+FROM songs
+WHERE song_id = $3::UUID
+ON CONFLICT DO NOTHING
+`
+
+type SaveSongListenerParams struct {
+	Day      time.Time
+	Listener string
+	SongID   uuid.UUID
+}
+
+func (q *Queries) SaveSongListener(ctx context.Context, arg SaveSongListenerParams) error {
+	_, err := q.db.Exec(ctx, saveSongListener, arg.Day, arg.Listener, arg.SongID)
+	return err
+}
+
+const saveWebhook = `-- name: SaveWebhook :one
+INSERT INTO webhooks (webhook_id, artist_fk, url, secret, events)
+VALUES ($1, $2::UUID, $3, $4, $5::TEXT[])
+RETURNING webhook_id, artist_fk, url, secret, events, created_at
+`
+
+type SaveWebhookParams struct {
+	WebhookID uuid.UUID
+	ArtistID  uuid.UUID
+	Url       string
+	Secret    string
+	Events    []string
+}
+
+func (q *Queries) SaveWebhook(ctx context.Context, arg SaveWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, saveWebhook,
+		arg.WebhookID,
+		arg.ArtistID,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.WebhookID,
+		&i.ArtistFk,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const saveWebhookDelivery = `-- name: SaveWebhookDelivery :one
+INSERT INTO webhook_deliveries (delivery_id, webhook_fk, event_id, event, payload)
+VALUES ($1, $2::UUID, $3, $4, $5)
+ON CONFLICT (webhook_fk, event_id) DO NOTHING
+RETURNING delivery_id, webhook_fk, event_id, event, payload, status, attempts, response_code, error, created_at, attempted_at
+`
+
+type SaveWebhookDeliveryParams struct {
+	DeliveryID uuid.UUID
+	WebhookID  uuid.UUID
+	EventID    string
+	Event      string
+	Payload    []byte
+}
+
+// An event is saved once per webhook, returns no rows if it is already saved.
+func (q *Queries) SaveWebhookDelivery(ctx context.Context, arg SaveWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, saveWebhookDelivery,
+		arg.DeliveryID,
+		arg.WebhookID,
+		arg.EventID,
+		arg.Event,
+		arg.Payload,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.DeliveryID,
+		&i.WebhookFk,
+		&i.EventID,
+		&i.Event,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseCode,
+		&i.Error,
+		&i.CreatedAt,
+		&i.AttemptedAt,
+	)
+	return i, err
+}
+
+const setAudioState = `-- name: SetAudioState :one
+UPDATE songs SET
+    audio_state = $1,
+    audio_error = $2,
+    audio_state_at = NOW(),
+    processing_started_at = $3,
+    s3_object_name = COALESCE($4, s3_object_name),
+    weight_bytes = COALESCE($5, weight_bytes)
+WHERE song_id = $6::UUID
+RETURNING song_id, singer_fk, name, s3_object_name, image_url, duration, weight_bytes, uploaded_at, released_at, moderation_status, moderation_reason, moderator_id, moderated_at, explicit, allowed_countries, denied_countries, deleted_at, bitrate, bitrate_mode, sample_rate, channel_mode, encoder, audio_state, audio_error, audio_state_at, processing_started_at, waveform, thumbnail_url
+`
+
+type SetAudioStateParams struct {
+	AudioState          AudioState
+	AudioError          pgtype.Text
+	ProcessingStartedAt pgtype.Timestamptz
+	S3ObjectName        pgtype.Text
+	WeightBytes         pgtype.Int4
+	SongID              uuid.UUID
+}
+
+// Moves the audio of the song to the state, the object is set by uploads.
+// Songs processed right away are started, so workers don't take them.
+func (q *Queries) SetAudioState(ctx context.Context, arg SetAudioStateParams) (Song, error) {
+	row := q.db.QueryRow(ctx, setAudioState,
+		arg.AudioState,
+		arg.AudioError,
+		arg.ProcessingStartedAt,
+		arg.S3ObjectName,
+		arg.WeightBytes,
+		arg.SongID,
+	)
+	var i Song
+	err := row.Scan(
+		&i.SongID,
+		&i.SingerFk,
+		&i.Name,
+		&i.S3ObjectName,
+		&i.ImageUrl,
+		&i.Duration,
+		&i.WeightBytes,
+		&i.UploadedAt,
+		&i.ReleasedAt,
+		&i.ModerationStatus,
+		&i.ModerationReason,
+		&i.ModeratorID,
+		&i.ModeratedAt,
+		&i.Explicit,
+		&i.AllowedCountries,
+		&i.DeniedCountries,
+		&i.DeletedAt,
+		&i.Bitrate,
+		&i.BitrateMode,
+		&i.SampleRate,
+		&i.ChannelMode,
+		&i.Encoder,
+		&i.AudioState,
+		&i.AudioError,
+		&i.AudioStateAt,
+		&i.ProcessingStartedAt,
+		&i.Waveform,
+		&i.ThumbnailUrl,
+	)
+	return i, err
+}
+
+const setClaimStatus = `-- name: SetClaimStatus :one
+UPDATE claims SET
+    status = $1,
+    counter_notice = COALESCE($2, counter_notice),
+    resolution_note = COALESCE($3, resolution_note),
+    updated_at = $4
+WHERE claim_id = $5 AND status::TEXT = ANY($6::TEXT[])
+RETURNING claim_id, song_fk, claimant_id, claimant_name, claimant_email, description, evidence_urls, status, counter_notice, resolution_note, created_at, updated_at, prior_moderation_status, prior_moderation_reason, song_id, song_name, artist_id
+`
+
+type SetClaimStatusParams struct {
+	Status         ClaimStatus
+	CounterNotice  pgtype.Text
+	ResolutionNote pgtype.Text
+	UpdatedAt      time.Time
+	ClaimID        uuid.UUID
+	FromStatuses   []string
+}
+
+// Returns no rows if the claim is not in one of the from statuses.
+func (q *Queries) SetClaimStatus(ctx context.Context, arg SetClaimStatusParams) (Claim, error) {
+	row := q.db.QueryRow(ctx, setClaimStatus,
+		arg.Status,
+		arg.CounterNotice,
+		arg.ResolutionNote,
+		arg.UpdatedAt,
+		arg.ClaimID,
+		arg.FromStatuses,
+	)
+	var i Claim
+	err := row.Scan(
+		&i.ClaimID,
+		&i.SongFk,
+		&i.ClaimantID,
+		&i.ClaimantName,
+		&i.ClaimantEmail,
+		&i.Description,
+		&i.EvidenceUrls,
+		&i.Status,
+		&i.CounterNotice,
+		&i.ResolutionNote,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PriorModerationStatus,
+		&i.PriorModerationReason,
+		&i.SongID,
+		&i.SongName,
+		&i.ArtistID,
+	)
+	return i, err
+}
+
+const setImageThumbnail = `-- name: SetImageThumbnail :exec
+UPDATE songs SET thumbnail_url = $1
+WHERE image_url = $2
+`
+
+type SetImageThumbnailParams struct {
+	ThumbnailUrl pgtype.Text
+	ImageUrl     pgtype.Text
+}
+
+// Songs may share a hosted image, its thumbnail is set on all of them.
+func (q *Queries) SetImageThumbnail(ctx context.Context, arg SetImageThumbnailParams) error {
+	_, err := q.db.Exec(ctx, setImageThumbnail, arg.ThumbnailUrl, arg.ImageUrl)
+	return err
+}
+
+const song = `-- name: Song :one
+SELECT
+    songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at, songs.moderation_status, songs.moderation_reason, songs.moderator_id, songs.moderated_at, songs.explicit, songs.allowed_countries, songs.denied_countries, songs.deleted_at, songs.bitrate, songs.bitrate_mode, songs.sample_rate, songs.channel_mode, songs.encoder, songs.audio_state, songs.audio_error, songs.audio_state_at, songs.processing_started_at, songs.waveform, songs.thumbnail_url,
+    COALESCE(ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)
+        FILTER (WHERE feats.role = 'featured' AND feats.artist_fk IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS artists_ids,
+    -- Credits are aggregated into parallel arrays, the nil UUID stands for a person without an account.
+    -- Listeners see only approved credits.
+    COALESCE(ARRAY_AGG(COALESCE(feats.artist_fk, '00000000-0000-0000-0000-000000000000') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS credits_artists_ids,
+    COALESCE(ARRAY_AGG(COALESCE(feats.name, '') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_names,
+    COALESCE(ARRAY_AGG(feats.role ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_roles,
+    COALESCE(ARRAY_AGG(feats.status ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL AND feats.status = 'approved'), '{}')::TEXT[] AS credits_statuses
+FROM songs
+LEFT JOIN feats ON feats.song_fk = songs.song_id
+WHERE song_id = $1 AND released_at IS NOT NULL AND moderation_status <> 'taken_down' AND deleted_at IS NULL
+GROUP BY songs.song_id
+`
+
+type SongRow struct {
+	Song              Song
+	ArtistsIds        []uuid.UUID
+	CreditsArtistsIds []uuid.UUID
+	CreditsNames      []string
+	CreditsRoles      []string
+	CreditsStatuses   []string
+}
+
+func (q *Queries) Song(ctx context.Context, songID uuid.UUID) (SongRow, error) {
+	row := q.db.QueryRow(ctx, song, songID)
+	var i SongRow
+	err := row.Scan(
+		&i.Song.SongID,
+		&i.Song.SingerFk,
+		&i.Song.Name,
+		&i.Song.S3ObjectName,
+		&i.Song.ImageUrl,
+		&i.Song.Duration,
+		&i.Song.WeightBytes,
+		&i.Song.UploadedAt,
+		&i.Song.ReleasedAt,
+		&i.Song.ModerationStatus,
+		&i.Song.ModerationReason,
+		&i.Song.ModeratorID,
+		&i.Song.ModeratedAt,
+		&i.Song.Explicit,
+		&i.Song.AllowedCountries,
+		&i.Song.DeniedCountries,
+		&i.Song.DeletedAt,
+		&i.Song.Bitrate,
+		&i.Song.BitrateMode,
+		&i.Song.SampleRate,
+		&i.Song.ChannelMode,
+		&i.Song.Encoder,
+		&i.Song.AudioState,
+		&i.Song.AudioError,
+		&i.Song.AudioStateAt,
+		&i.Song.ProcessingStartedAt,
+		&i.Song.Waveform,
+		&i.Song.ThumbnailUrl,
+		&i.ArtistsIds,
+		&i.CreditsArtistsIds,
+		&i.CreditsNames,
+		&i.CreditsRoles,
+		&i.CreditsStatuses,
+	)
+	return i, err
+}
+
+const songObjectRefs = `-- name: SongObjectRefs :many
+SELECT song_id, s3_object_name, image_url
+FROM songs
+WHERE song_id > $1 AND (s3_object_name IS NOT NULL OR image_url IS NOT NULL)
+ORDER BY song_id
+LIMIT $2
+`
+
+type SongObjectRefsParams struct {
+	AfterID uuid.UUID
+	Limitv  int32
+}
+
+type SongObjectRefsRow struct {
+	SongID       uuid.UUID
+	S3ObjectName pgtype.Text
+	ImageUrl     pgtype.Text
+}
+
+func (q *Queries) SongObjectRefs(ctx context.Context, arg SongObjectRefsParams) ([]SongObjectRefsRow, error) {
+	rows, err := q.db.Query(ctx, songObjectRefs, arg.AfterID, arg.Limitv)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SongObjectRefsRow
+	for rows.Next() {
+		var i SongObjectRefsRow
+		if err := rows.Scan(&i.SongID, &i.S3ObjectName, &i.ImageUrl); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const songObjectStatus = `-- name: SongObjectStatus :one
+SELECT song_id, singer_fk, moderation_status, allowed_countries, denied_countries, released_at
+FROM songs
+WHERE s3_object_name = $1 AND deleted_at IS NULL
 `
 
-type PatchSongParams struct {
-	SingerFk     pgtype.UUID
-	Name         pgtype.Text
-	S3ObjectName pgtype.Text
-	ImageUrl     pgtype.Text
-	Duration     pgtype.Interval
-	WeightBytes  pgtype.Int4
-	ReleasedAt   pgtype.Timestamptz
-	UploadedAt   pgtype.Timestamptz
-	ID           uuid.UUID
+type SongObjectStatusRow struct {
+	SongID           uuid.UUID
+	SingerFk         uuid.UUID
+	ModerationStatus ModerationStatus
+	AllowedCountries []string
+	DeniedCountries  []string
+	ReleasedAt       pgtype.Timestamptz
 }
 
-func (q *Queries) PatchSong(ctx context.Context, arg PatchSongParams) (Song, error) {
-	row := q.db.QueryRow(ctx, patchSong,
-		arg.SingerFk,
-		arg.Name,
-		arg.S3ObjectName,
-		arg.ImageUrl,
-		arg.Duration,
-		arg.WeightBytes,
-		arg.ReleasedAt,
-		arg.UploadedAt,
-		arg.ID,
+func (q *Queries) SongObjectStatus(ctx context.Context, s3ObjectName pgtype.Text) (SongObjectStatusRow, error) {
+	row := q.db.QueryRow(ctx, songObjectStatus, s3ObjectName)
+	var i SongObjectStatusRow
+	err := row.Scan(
+		&i.SongID,
+		&i.SingerFk,
+		&i.ModerationStatus,
+		&i.AllowedCountries,
+		&i.DeniedCountries,
+		&i.ReleasedAt,
+	)
+	return i, err
+}
+
+const songPreviewStatus = `-- name: SongPreviewStatus :one
+SELECT song_id, s3_object_name, duration, moderation_status, allowed_countries, denied_countries, released_at
+FROM songs
+WHERE song_id = $1 AND deleted_at IS NULL
+`
+
+type SongPreviewStatusRow struct {
+	SongID           uuid.UUID
+	S3ObjectName     pgtype.Text
+	Duration         pgtype.Interval
+	ModerationStatus ModerationStatus
+	AllowedCountries []string
+	DeniedCountries  []string
+	ReleasedAt       pgtype.Timestamptz
+}
+
+func (q *Queries) SongPreviewStatus(ctx context.Context, songID uuid.UUID) (SongPreviewStatusRow, error) {
+	row := q.db.QueryRow(ctx, songPreviewStatus, songID)
+	var i SongPreviewStatusRow
+	err := row.Scan(
+		&i.SongID,
+		&i.S3ObjectName,
+		&i.Duration,
+		&i.ModerationStatus,
+		&i.AllowedCountries,
+		&i.DeniedCountries,
+		&i.ReleasedAt,
+	)
+	return i, err
+}
+
+const startExport = `-- name: StartExport :one
+UPDATE exports SET status = 'running', started_at = NOW()
+WHERE export_id = (
+    SELECT waiting.export_id
+    FROM exports AS waiting
+    WHERE waiting.status = 'pending' OR (waiting.status = 'running' AND waiting.started_at <= $1)
+    ORDER BY waiting.created_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING export_id, artist_fk, status, error, weight_bytes, created_at, started_at, finished_at, expires_at
+`
+
+// Takes the oldest pending export, or a running one whose worker is gone.
+func (q *Queries) StartExport(ctx context.Context, staleBefore pgtype.Timestamptz) (Export, error) {
+	row := q.db.QueryRow(ctx, startExport, staleBefore)
+	var i Export
+	err := row.Scan(
+		&i.ExportID,
+		&i.ArtistFk,
+		&i.Status,
+		&i.Error,
+		&i.WeightBytes,
+		&i.CreatedAt,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.ExpiresAt,
 	)
+	return i, err
+}
+
+const startProcessing = `-- name: StartProcessing :one
+UPDATE songs SET processing_started_at = NOW()
+WHERE song_id = (
+    SELECT waiting.song_id
+    FROM songs AS waiting
+    WHERE waiting.audio_state = 'processing' AND waiting.deleted_at IS NULL AND
+        (waiting.processing_started_at IS NULL OR waiting.processing_started_at <= $1)
+    ORDER BY waiting.audio_state_at
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING song_id, singer_fk, name, s3_object_name, image_url, duration, weight_bytes, uploaded_at, released_at, moderation_status, moderation_reason, moderator_id, moderated_at, explicit, allowed_countries, denied_countries, deleted_at, bitrate, bitrate_mode, sample_rate, channel_mode, encoder, audio_state, audio_error, audio_state_at, processing_started_at, waveform, thumbnail_url
+`
+
+// Takes the song uploaded first, or one whose worker is gone.
+func (q *Queries) StartProcessing(ctx context.Context, staleBefore pgtype.Timestamptz) (Song, error) {
+	row := q.db.QueryRow(ctx, startProcessing, staleBefore)
 	var i Song
 	err := row.Scan(
 		&i.SongID,
@@ -210,105 +2948,125 @@ func (q *Queries) PatchSong(ctx context.Context, arg PatchSongParams) (Song, err
 		&i.WeightBytes,
 		&i.UploadedAt,
 		&i.ReleasedAt,
+		&i.ModerationStatus,
+		&i.ModerationReason,
+		&i.ModeratorID,
+		&i.ModeratedAt,
+		&i.Explicit,
+		&i.AllowedCountries,
+		&i.DeniedCountries,
+		&i.DeletedAt,
+		&i.Bitrate,
+		&i.BitrateMode,
+		&i.SampleRate,
+		&i.ChannelMode,
+		&i.Encoder,
+		&i.AudioState,
+		&i.AudioError,
+		&i.AudioStateAt,
+		&i.ProcessingStartedAt,
+		&i.Waveform,
+		&i.ThumbnailUrl,
 	)
 	return i, err
 }
 
-const patchSongs = `-- name: PatchSongs :exec
-UPDATE songs SET
-    singer_fk = COALESCE($1, singer_fk),
-    name = COALESCE($2, name),
-    s3_object_name = COALESCE($3, s3_object_name),
-    image_url = COALESCE($4, image_url),
-    duration = COALESCE($5, duration),
-    weight_bytes = COALESCE($6, weight_bytes),
-    released_at = COALESCE($7, released_at),
-    uploaded_at = COALESCE($8, uploaded_at)
-WHERE song_id = ANY($9::UUID[])
+const subscribedWebhooks = `-- name: SubscribedWebhooks :many
+SELECT webhook_id, artist_fk, url, secret, events, created_at
+FROM webhooks
+WHERE artist_fk = $1::UUID AND $2::TEXT = ANY(events)
 `
 
-type PatchSongsParams struct {
-	SingerFk     pgtype.UUID
-	Name         pgtype.Text
-	S3ObjectName pgtype.Text
-	ImageUrl     pgtype.Text
-	Duration     pgtype.Interval
-	WeightBytes  pgtype.Int4
-	ReleasedAt   pgtype.Timestamptz
-	UploadedAt   pgtype.Timestamptz
-	Ids          []uuid.UUID
+type SubscribedWebhooksParams struct {
+	ArtistID uuid.UUID
+	Event    string
 }
 
-func (q *Queries) PatchSongs(ctx context.Context, arg PatchSongsParams) error {
-	_, err := q.db.Exec(ctx, patchSongs,
-		arg.SingerFk,
-		arg.Name,
-		arg.S3ObjectName,
-		arg.ImageUrl,
-		arg.Duration,
-		arg.WeightBytes,
-		arg.ReleasedAt,
-		arg.UploadedAt,
-		arg.Ids,
-	)
+func (q *Queries) SubscribedWebhooks(ctx context.Context, arg SubscribedWebhooksParams) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, subscribedWebhooks, arg.ArtistID, arg.Event)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.WebhookID,
+			&i.ArtistFk,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trashSongs = `-- name: TrashSongs :exec
+UPDATE songs SET deleted_at = $1
+WHERE song_id = ANY($2::UUID[]) AND deleted_at IS NULL
+`
+
+type TrashSongsParams struct {
+	DeletedAt pgtype.Timestamptz
+	Ids       []uuid.UUID
+}
+
+func (q *Queries) TrashSongs(ctx context.Context, arg TrashSongsParams) error {
+	_, err := q.db.Exec(ctx, trashSongs, arg.DeletedAt, arg.Ids)
 	return err
 }
 
-const releasedSongs = `-- name: ReleasedSongs :many
+const trashedSongs = `-- name: TrashedSongs :many
 SELECT
-    songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at,
-    ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)::UUID[] AS artists_ids
+    songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at, songs.moderation_status, songs.moderation_reason, songs.moderator_id, songs.moderated_at, songs.explicit, songs.allowed_countries, songs.denied_countries, songs.deleted_at, songs.bitrate, songs.bitrate_mode, songs.sample_rate, songs.channel_mode, songs.encoder, songs.audio_state, songs.audio_error, songs.audio_state_at, songs.processing_started_at, songs.waveform, songs.thumbnail_url,
+    COALESCE(ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)
+        FILTER (WHERE feats.role = 'featured' AND feats.artist_fk IS NOT NULL AND feats.status = 'approved'), '{}')::UUID[] AS artists_ids,
+    -- Credits are aggregated into parallel arrays, the nil UUID stands for a person without an account
+    COALESCE(ARRAY_AGG(COALESCE(feats.artist_fk, '00000000-0000-0000-0000-000000000000') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::UUID[] AS credits_artists_ids,
+    COALESCE(ARRAY_AGG(COALESCE(feats.name, '') ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_names,
+    COALESCE(ARRAY_AGG(feats.role ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_roles,
+    COALESCE(ARRAY_AGG(feats.status ORDER BY feats.order_num)
+        FILTER (WHERE feats.credit_id IS NOT NULL), '{}')::TEXT[] AS credits_statuses
 FROM songs
 LEFT JOIN feats ON feats.song_fk = songs.song_id
-WHERE
-    -- Only released songs!
-    released_at IS NOT NULL AND (
-    -- All songs created by some artists
-    (($1::BOOLEAN OR $2::BOOLEAN) AND singer_fk = ANY($3::UUID[])) OR
-    -- All songs created by or featured some artists
-    ($2::BOOLEAN AND $3::UUID[] <@ (
-        SELECT ARRAY_AGG(feats.artist_fk)
-        FROM feats
-        WHERE song_fk = songs.song_id
-        GROUP BY song_fk
-    )) OR
-    -- All songs matching name
-    ($4::BOOLEAN AND name ILIKE CONCAT('%', $5::TEXT, '%')) OR
-    -- Songs by IDs
-    ($6::BOOLEAN AND song_id = ANY($7::UUID[]))
-    )
+WHERE singer_fk = $1::UUID AND deleted_at > $2
 GROUP BY songs.song_id
-ORDER BY songs.uploaded_at DESC, name
-LIMIT $9
-OFFSET $8
+ORDER BY songs.deleted_at DESC, songs.name
+LIMIT $4
+OFFSET $3
 `
 
-type ReleasedSongsParams struct {
-	BySinger   bool
-	WithArtist bool
-	SingersIds []uuid.UUID
-	ByName     bool
-	MatchName  string
-	ByIds      bool
-	Ids        []uuid.UUID
-	Offsetv    int32
-	Limitv     int32
+type TrashedSongsParams struct {
+	SingerID     uuid.UUID
+	DeletedAfter pgtype.Timestamptz
+	Offsetv      int32
+	Limitv       int32
 }
 
-type ReleasedSongsRow struct {
-	Song       Song
-	ArtistsIds []uuid.UUID
+type TrashedSongsRow struct {
+	Song              Song
+	ArtistsIds        []uuid.UUID
+	CreditsArtistsIds []uuid.UUID
+	CreditsNames      []string
+	CreditsRoles      []string
+	CreditsStatuses   []string
 }
 
-func (q *Queries) ReleasedSongs(ctx context.Context, arg ReleasedSongsParams) ([]ReleasedSongsRow, error) {
-	rows, err := q.db.Query(ctx, releasedSongs,
-		arg.BySinger,
-		arg.WithArtist,
-		arg.SingersIds,
-		arg.ByName,
-		arg.MatchName,
-		arg.ByIds,
-		arg.Ids,
+func (q *Queries) TrashedSongs(ctx context.Context, arg TrashedSongsParams) ([]TrashedSongsRow, error) {
+	rows, err := q.db.Query(ctx, trashedSongs,
+		arg.SingerID,
+		arg.DeletedAfter,
 		arg.Offsetv,
 		arg.Limitv,
 	)
@@ -316,9 +3074,9 @@ func (q *Queries) ReleasedSongs(ctx context.Context, arg ReleasedSongsParams) ([
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ReleasedSongsRow
+	var items []TrashedSongsRow
 	for rows.Next() {
-		var i ReleasedSongsRow
+		var i TrashedSongsRow
 		if err := rows.Scan(
 			&i.Song.SongID,
 			&i.Song.SingerFk,
@@ -329,7 +3087,30 @@ func (q *Queries) ReleasedSongs(ctx context.Context, arg ReleasedSongsParams) ([
 			&i.Song.WeightBytes,
 			&i.Song.UploadedAt,
 			&i.Song.ReleasedAt,
+			&i.Song.ModerationStatus,
+			&i.Song.ModerationReason,
+			&i.Song.ModeratorID,
+			&i.Song.ModeratedAt,
+			&i.Song.Explicit,
+			&i.Song.AllowedCountries,
+			&i.Song.DeniedCountries,
+			&i.Song.DeletedAt,
+			&i.Song.Bitrate,
+			&i.Song.BitrateMode,
+			&i.Song.SampleRate,
+			&i.Song.ChannelMode,
+			&i.Song.Encoder,
+			&i.Song.AudioState,
+			&i.Song.AudioError,
+			&i.Song.AudioStateAt,
+			&i.Song.ProcessingStartedAt,
+			&i.Song.Waveform,
+			&i.Song.ThumbnailUrl,
 			&i.ArtistsIds,
+			&i.CreditsArtistsIds,
+			&i.CreditsNames,
+			&i.CreditsRoles,
+			&i.CreditsStatuses,
 		); err != nil {
 			return nil, err
 		}
@@ -341,90 +3122,16 @@ func (q *Queries) ReleasedSongs(ctx context.Context, arg ReleasedSongsParams) ([
 	return items, nil
 }
 
-const saveSong = `-- name: SaveSong :exec
-WITH inserted_song AS (
-    INSERT INTO songs (
-    song_id,
-    singer_fk,
-    name,
-    s3_object_name,
-    image_url,
-    duration,
-    weight_bytes,
-    uploaded_at,
-    released_at) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
-    RETURNING song_id
-)
-INSERT INTO feats (song_fk, artist_fk, order_num)
-SELECT
-    (SELECT song_id FROM inserted_song) AS song_fk,
-    artist AS artist_fk,
-    ROW_NUMBER() OVER () AS order_num
-FROM UNNEST($10::UUID[]) AS artist
+const unverifyClaimant = `-- name: UnverifyClaimant :exec
+DELETE FROM verified_claimants
+WHERE claimant_id = $1::UUID
 `
 
-type SaveSongParams struct {
-	SongID       uuid.UUID
-	SingerFk     uuid.UUID
-	Name         string
-	S3ObjectName pgtype.Text
-	ImageUrl     pgtype.Text
-	Duration     pgtype.Interval
-	WeightBytes  pgtype.Int4
-	UploadedAt   time.Time
-	ReleasedAt   pgtype.Timestamptz
-	ArtistsIds   []uuid.UUID
-}
-
-func (q *Queries) SaveSong(ctx context.Context, arg SaveSongParams) error {
-	_, err := q.db.Exec(ctx, saveSong,
-		arg.SongID,
-		arg.SingerFk,
-		arg.Name,
-		arg.S3ObjectName,
-		arg.ImageUrl,
-		arg.Duration,
-		arg.WeightBytes,
-		arg.UploadedAt,
-		arg.ReleasedAt,
-		arg.ArtistsIds,
-	)
+func (q *Queries) UnverifyClaimant(ctx context.Context, claimantID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, unverifyClaimant, claimantID)
 	return err
 }
 
-const song = `-- name: Song :one
-SELECT
-    songs.song_id, songs.singer_fk, songs.name, songs.s3_object_name, songs.image_url, songs.duration, songs.weight_bytes, songs.uploaded_at, songs.released_at,
-    ARRAY_AGG(feats.artist_fk ORDER BY feats.order_num)::UUID[] AS artists_ids
-FROM songs
-LEFT JOIN feats ON feats.song_fk = songs.song_id
-WHERE song_id = $1 AND released_at IS NOT NULL
-GROUP BY songs.song_id
-`
-
-type SongRow struct {
-	Song       Song
-	ArtistsIds []uuid.UUID
-}
-
-func (q *Queries) Song(ctx context.Context, songID uuid.UUID) (SongRow, error) {
-	row := q.db.QueryRow(ctx, song, songID)
-	var i SongRow
-	err := row.Scan(
-		&i.Song.SongID,
-		&i.Song.SingerFk,
-		&i.Song.Name,
-		&i.Song.S3ObjectName,
-		&i.Song.ImageUrl,
-		&i.Song.Duration,
-		&i.Song.WeightBytes,
-		&i.Song.UploadedAt,
-		&i.Song.ReleasedAt,
-		&i.ArtistsIds,
-	)
-	return i, err
-}
-
 const updateSong = `-- name: UpdateSong :one
 UPDATE songs SET
     singer_fk = $2,
@@ -436,7 +3143,7 @@ UPDATE songs SET
     released_at = $8,
     uploaded_at = $9
 WHERE song_id = $1
-RETURNING song_id, singer_fk, name, s3_object_name, image_url, duration, weight_bytes, uploaded_at, released_at
+RETURNING song_id, singer_fk, name, s3_object_name, image_url, duration, weight_bytes, uploaded_at, released_at, moderation_status, moderation_reason, moderator_id, moderated_at, explicit, allowed_countries, denied_countries, deleted_at, bitrate, bitrate_mode, sample_rate, channel_mode, encoder, audio_state, audio_error, audio_state_at, processing_started_at, waveform, thumbnail_url
 `
 
 type UpdateSongParams struct {
@@ -474,6 +3181,129 @@ func (q *Queries) UpdateSong(ctx context.Context, arg UpdateSongParams) (Song, e
 		&i.WeightBytes,
 		&i.UploadedAt,
 		&i.ReleasedAt,
+		&i.ModerationStatus,
+		&i.ModerationReason,
+		&i.ModeratorID,
+		&i.ModeratedAt,
+		&i.Explicit,
+		&i.AllowedCountries,
+		&i.DeniedCountries,
+		&i.DeletedAt,
+		&i.Bitrate,
+		&i.BitrateMode,
+		&i.SampleRate,
+		&i.ChannelMode,
+		&i.Encoder,
+		&i.AudioState,
+		&i.AudioError,
+		&i.AudioStateAt,
+		&i.ProcessingStartedAt,
+		&i.Waveform,
+		&i.ThumbnailUrl,
+	)
+	return i, err
+}
+
+const verifyClaimant = `-- name: VerifyClaimant :exec
+INSERT INTO verified_claimants (claimant_id, verified_by, verified_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (claimant_id) DO NOTHING
+`
+
+type VerifyClaimantParams struct {
+	ClaimantID uuid.UUID
+	VerifiedBy uuid.UUID
+	VerifiedAt time.Time
+}
+
+func (q *Queries) VerifyClaimant(ctx context.Context, arg VerifyClaimantParams) error {
+	_, err := q.db.Exec(ctx, verifyClaimant, arg.ClaimantID, arg.VerifiedBy, arg.VerifiedAt)
+	return err
+}
+
+const webhookDeliveries = `-- name: WebhookDeliveries :many
+SELECT webhook_deliveries.delivery_id, webhook_deliveries.webhook_fk, webhook_deliveries.event_id, webhook_deliveries.event, webhook_deliveries.payload, webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.response_code, webhook_deliveries.error, webhook_deliveries.created_at, webhook_deliveries.attempted_at
+FROM webhook_deliveries
+JOIN webhooks ON webhooks.webhook_id = webhook_deliveries.webhook_fk
+WHERE webhooks.webhook_id = $1 AND webhooks.artist_fk = $2::UUID
+ORDER BY webhook_deliveries.created_at DESC
+LIMIT $4 OFFSET $3
+`
+
+type WebhookDeliveriesParams struct {
+	WebhookID uuid.UUID
+	ArtistID  uuid.UUID
+	Offsetv   int32
+	Limitv    int32
+}
+
+func (q *Queries) WebhookDeliveries(ctx context.Context, arg WebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, webhookDeliveries,
+		arg.WebhookID,
+		arg.ArtistID,
+		arg.Offsetv,
+		arg.Limitv,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.DeliveryID,
+			&i.WebhookFk,
+			&i.EventID,
+			&i.Event,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseCode,
+			&i.Error,
+			&i.CreatedAt,
+			&i.AttemptedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const webhookDelivery = `-- name: WebhookDelivery :one
+SELECT webhook_deliveries.delivery_id, webhook_deliveries.webhook_fk, webhook_deliveries.event_id, webhook_deliveries.event, webhook_deliveries.payload, webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.response_code, webhook_deliveries.error, webhook_deliveries.created_at, webhook_deliveries.attempted_at, webhooks.url, webhooks.secret
+FROM webhook_deliveries
+JOIN webhooks ON webhooks.webhook_id = webhook_deliveries.webhook_fk
+WHERE webhook_deliveries.delivery_id = $1
+`
+
+type WebhookDeliveryRow struct {
+	WebhookDelivery WebhookDelivery
+	Url             string
+	Secret          string
+}
+
+func (q *Queries) WebhookDelivery(ctx context.Context, deliveryID uuid.UUID) (WebhookDeliveryRow, error) {
+	row := q.db.QueryRow(ctx, webhookDelivery, deliveryID)
+	var i WebhookDeliveryRow
+	err := row.Scan(
+		&i.WebhookDelivery.DeliveryID,
+		&i.WebhookDelivery.WebhookFk,
+		&i.WebhookDelivery.EventID,
+		&i.WebhookDelivery.Event,
+		&i.WebhookDelivery.Payload,
+		&i.WebhookDelivery.Status,
+		&i.WebhookDelivery.Attempts,
+		&i.WebhookDelivery.ResponseCode,
+		&i.WebhookDelivery.Error,
+		&i.WebhookDelivery.CreatedAt,
+		&i.WebhookDelivery.AttemptedAt,
+		&i.Url,
+		&i.Secret,
 	)
 	return i, err
 }
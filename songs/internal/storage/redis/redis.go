@@ -3,12 +3,14 @@ package redis
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/repoerrs"
 
 	"dev.gaijin.team/go/golib/e"
 	"dev.gaijin.team/go/golib/fields"
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -33,7 +35,12 @@ func Connect(ctx context.Context, cfg Config) (*RedStorage, error) {
 		DB:       cfg.Db,
 	})
 
-	err := rdb.Ping(ctx).Err()
+	err := redisotel.InstrumentTracing(rdb)
+	if err != nil {
+		return nil, e.NewFrom("instrumenting redis tracing", err)
+	}
+
+	err = rdb.Ping(ctx).Err()
 	if err != nil {
 		return nil, e.NewFrom("redis ping", err)
 	}
@@ -43,6 +50,15 @@ func Connect(ctx context.Context, cfg Config) (*RedStorage, error) {
 	}, nil
 }
 
+func (rs *RedStorage) Ping(ctx context.Context) error {
+	err := rs.db.Ping(ctx).Err()
+	if err != nil {
+		return e.NewFrom("redis ping", err)
+	}
+
+	return nil
+}
+
 func (rs *RedStorage) Close() error {
 	return rs.db.Close() //nolint:wrapcheck
 }
@@ -93,6 +109,65 @@ func (r RedNs) Del(ctx context.Context, key string) error {
 	return nil
 }
 
+// SetNX stores an empty value with the expiration unless the key exists and reports whether it was stored.
+func (r RedNs) SetNX(ctx context.Context, key string, exp time.Duration) (bool, error) {
+	stored, err := r.db.SetNX(ctx, r.namespaced(key), "", exp).Result()
+	if err != nil {
+		return false, e.NewFrom("setting key if not exists", err, fields.F("key", key))
+	}
+
+	return stored, nil
+}
+
+// Incr increments a counter in the sub-storage and returns its new value.
+// The expiration is set when the counter is created, so it is not prolonged by later increments.
+func (r RedNs) Incr(ctx context.Context, key string, exp time.Duration) (int64, error) {
+	nsk := r.namespaced(key)
+
+	pipe := r.db.TxPipeline()
+	incr := pipe.Incr(ctx, nsk)
+	pipe.ExpireNX(ctx, nsk, exp)
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return 0, e.NewFrom("incrementing key", err, fields.F("key", key))
+	}
+
+	return incr.Val(), nil
+}
+
+// AddExpiring adds a member to a set where every member expires after ttl
+// and returns the number of members still alive.
+func (r RedNs) AddExpiring(ctx context.Context, key, member string, ttl time.Duration) (int64, error) {
+	var (
+		now = time.Now()
+		nsk = r.namespaced(key)
+	)
+
+	pipe := r.db.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, nsk, "-inf", strconv.FormatInt(now.UnixMilli(), 10))
+	pipe.ZAdd(ctx, nsk, redis.Z{Score: float64(now.Add(ttl).UnixMilli()), Member: member})
+	card := pipe.ZCard(ctx, nsk)
+	pipe.Expire(ctx, nsk, ttl)
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return 0, e.NewFrom("adding expiring member", err, fields.F("key", key))
+	}
+
+	return card.Val(), nil
+}
+
+// RemoveExpiring removes a member added with [RedNs.AddExpiring].
+func (r RedNs) RemoveExpiring(ctx context.Context, key, member string) error {
+	err := r.db.ZRem(ctx, r.namespaced(key), member).Err()
+	if err != nil {
+		return e.NewFrom("removing expiring member", err, fields.F("key", key))
+	}
+
+	return nil
+}
+
 func (r RedNs) namespaced(s string) string {
 	return r.ns + nsSep + s
 }
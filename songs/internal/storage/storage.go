@@ -7,15 +7,15 @@ import (
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/config"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/broker"
+	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/objects"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/postgres"
 	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/redis"
-	"github.com/Benzogang-Tape/audio-hosting/songs/internal/storage/s3minio"
 )
 
 type Storage struct {
 	*postgres.PgStorage
 	*redis.RedStorage
-	*s3minio.S3Storage
+	*objects.ObjStorage
 	*broker.KafkaProducer
 
 	c Config
@@ -39,17 +39,26 @@ type RedisConfig struct {
 }
 
 type MinioConfig struct {
-	Endpoint     string
-	AccessKey    string
-	SecretKey    string
-	UseSsl       bool
-	SongsBucket  string
-	ImagesBucket string
+	Backend          string
+	Endpoint         string
+	AccessKey        string
+	SecretKey        string
+	UseSsl           bool
+	LocalDir         string
+	SongsBucket      string
+	ImagesBucket     string
+	ExportsBucket    string
+	PreviewsBucket   string
+	ThumbnailsBucket string
 }
 
 type KafkaConfig struct {
-	Brokers []string
-	Topic   string
+	Brokers           []string
+	ReleasedTopic     string
+	LifecycleTopic    string
+	PlaysTopic        string
+	Partitions        int
+	ReplicationFactor int
 }
 
 type Config struct {
@@ -77,15 +86,24 @@ func New(ctx context.Context) (*Storage, error) {
 		Password: rdconf.Password,
 		Db:       rdconf.Db,
 	}, MinioConfig{
-		Endpoint:     s3conf.Endpoint,
-		AccessKey:    s3conf.AccessKey,
-		SecretKey:    s3conf.SecretKey,
-		UseSsl:       s3conf.UseSsl,
-		SongsBucket:  s3conf.SongsBucket,
-		ImagesBucket: s3conf.ImagesBucket,
+		Backend:          s3conf.Backend,
+		Endpoint:         s3conf.Endpoint,
+		AccessKey:        s3conf.AccessKey,
+		SecretKey:        s3conf.SecretKey,
+		UseSsl:           s3conf.UseSsl,
+		LocalDir:         s3conf.LocalDir,
+		SongsBucket:      s3conf.SongsBucket,
+		ImagesBucket:     s3conf.ImagesBucket,
+		ExportsBucket:    s3conf.ExportsBucket,
+		PreviewsBucket:   s3conf.PreviewsBucket,
+		ThumbnailsBucket: s3conf.ThumbnailsBucket,
 	}, KafkaConfig{
-		Brokers: cfg.Connections.Kafka.Brokers,
-		Topic:   cfg.Connections.Kafka.Topic,
+		Brokers:           cfg.Connections.Kafka.Brokers,
+		ReleasedTopic:     cfg.Connections.Kafka.ReleasedTopic,
+		LifecycleTopic:    cfg.Connections.Kafka.LifecycleTopic,
+		PlaysTopic:        cfg.Connections.Kafka.PlaysTopic,
+		Partitions:        cfg.Connections.Kafka.Partitions,
+		ReplicationFactor: cfg.Connections.Kafka.ReplicationFactor,
 	},
 		Config{
 			MySongsTtl: cfg.Features.Cache.MySongsTtl,
@@ -119,12 +137,12 @@ func NewWithConfig(ctx context.Context,
 		return nil, fmt.Errorf("redis connect: %w", err)
 	}
 
-	s3Database, err := s3minio.Connect(ctx, s3minio.Config(s3conf))
+	s3Database, err := objects.Connect(ctx, objects.Config(s3conf))
 	if err != nil {
 		return nil, fmt.Errorf("s3 connect: %w", err)
 	}
 
-	kBroker, err := broker.Connect(kconf.Topic, kconf.Brokers)
+	kBroker, err := broker.Connect(broker.Config(kconf))
 	if err != nil {
 		return nil, fmt.Errorf("kafka connect: %w", err)
 	}
@@ -132,7 +150,7 @@ func NewWithConfig(ctx context.Context,
 	return &Storage{
 		PgStorage:     postgresDatabase,
 		RedStorage:    redisDatabase,
-		S3Storage:     s3Database,
+		ObjStorage:    s3Database,
 		KafkaProducer: kBroker,
 		c:             cfg,
 	}, nil
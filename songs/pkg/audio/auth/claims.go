@@ -10,7 +10,11 @@ import (
 type Token struct {
 	Subject  uuid.UUID `json:"sub"`
 	IsArtist bool      `json:"is_artist"`
-	Exp      int64     `json:"exp"`
+	// IsAdmin grants access to the moderation endpoints.
+	IsAdmin bool `json:"is_admin,omitempty"`
+	// HideExplicit is the listener's preference to not see explicit songs.
+	HideExplicit bool  `json:"hide_explicit,omitempty"`
+	Exp          int64 `json:"exp"`
 }
 
 func (*Token) GetAudience() (jwt.ClaimStrings, error) {
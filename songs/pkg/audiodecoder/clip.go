@@ -0,0 +1,248 @@
+package audiodecoder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math"
+	"time"
+
+	"dev.gaijin.team/go/golib/e"
+	"github.com/tcolgate/mp3"
+)
+
+// Clip is the part of a song to cut, it is cut on frame boundaries.
+type Clip struct {
+	Start  time.Duration
+	Length time.Duration
+	// Length of the fade in and of the fade out, zero disables them
+	Fade time.Duration
+}
+
+const (
+	// Bit offset of global_gain in the side info of a granule of a channel
+	globalGainOffset = 21
+	globalGainBits   = 8
+
+	// A step of global_gain is 1.5 dB, 4 steps halve the amplitude
+	globalGainStepsPerHalving = 4
+
+	crcPolynomial = 0x8005
+)
+
+// CutMp3 writes the frames of the clip to w and returns the duration of the written frames.
+// The clip ends early with the song, the Xing/Info frame and the ID3 tags are dropped.
+//
+// Fades lower the global gain of the frames, the audio is not reencoded. They also hide the frames
+// at the start of the clip that reference the bit reservoir of the frames that were cut off.
+func (Decoder) CutMp3(ctx context.Context, r io.Reader, w io.Writer, clip Clip) (time.Duration, error) {
+	var (
+		frame   mp3.Frame
+		skipped int
+		at      time.Duration
+		length  time.Duration
+		clipped [][]byte
+		first   = true
+	)
+
+	br := bufio.NewReader(r)
+
+	err := skipId3(br)
+	if err != nil {
+		return 0, err
+	}
+
+	d := mp3.NewDecoder(br)
+
+	for length < clip.Length {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err() //nolint:wrapcheck
+
+		default:
+		}
+
+		err := d.Decode(&frame, &skipped)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return 0, e.NewFrom("decoding mp3", err)
+		}
+
+		if first {
+			first = false
+
+			if _, _, ok := readInfoTag(&frame); ok {
+				continue
+			}
+		}
+
+		dur := frame.Duration()
+		at += dur
+
+		if at <= clip.Start {
+			continue
+		}
+
+		buf := &bytes.Buffer{}
+		_, _ = buf.ReadFrom(frame.Reader())
+
+		clipped = append(clipped, buf.Bytes())
+		length += dur
+	}
+
+	if clip.Fade > 0 {
+		fade(clipped, length, clip.Fade)
+	}
+
+	for _, b := range clipped {
+		_, err = w.Write(b)
+		if err != nil {
+			return 0, e.NewFrom("writing clip", err)
+		}
+	}
+
+	return length, nil
+}
+
+// fade lowers the gain of the frames at both ends of the clip, linearly in amplitude.
+func fade(frames [][]byte, length, fadeLength time.Duration) {
+	if len(frames) == 0 {
+		return
+	}
+
+	frameLength := length / time.Duration(len(frames))
+
+	for i, b := range frames {
+		// The middle of the frame, so the first and the last frames are not silenced completely
+		at := frameLength*time.Duration(i) + frameLength/2
+		factor := min(1, float64(at)/float64(fadeLength), float64(length-at)/float64(fadeLength))
+
+		if factor < 1 {
+			lowerGain(b, factor)
+		}
+	}
+}
+
+// lowerGain multiplies the amplitude of the Layer III frame by the factor, the CRC is updated.
+// Frames of other layers have no global gain and are left as they are.
+func lowerGain(b []byte, factor float64) {
+	header := mp3.FrameHeader(b[:mp3HeaderSize])
+	if header.Layer() != mp3.Layer3 {
+		return
+	}
+
+	steps := math.MaxUint8
+	if factor > 0 {
+		steps = min(math.MaxUint8, int(math.Ceil(-globalGainStepsPerHalving*math.Log2(factor))))
+	}
+
+	sideInfo := b[mp3HeaderSize:]
+	if header.Protection() {
+		sideInfo = b[mp3HeaderSize+mp3CrcSize:]
+	}
+
+	for _, offset := range globalGainOffsets(header) {
+		gain := max(0, int(readBits(sideInfo, offset, globalGainBits))-steps)
+		writeBits(sideInfo, offset, globalGainBits, uint32(gain)) //nolint:gosec
+	}
+
+	if header.Protection() {
+		sideInfoLength := sideInfoLength(header)
+		sum := crc16(append([]byte{b[2], b[3]}, sideInfo[:sideInfoLength]...))
+		b[mp3HeaderSize], b[mp3HeaderSize+1] = byte(sum>>8), byte(sum) //nolint:mnd
+	}
+}
+
+// globalGainOffsets returns the bit offsets of global_gain in the side info, one per granule and channel.
+func globalGainOffsets(header mp3.FrameHeader) []int {
+	channels := 2
+	if header.ChannelMode() == mp3.SingleChannel {
+		channels = 1
+	}
+
+	var pos, granules, granuleBits int
+
+	if header.Version() == mp3.MPEG1 {
+		// main_data_begin, private bits and scfsi
+		pos = 9 + 3 + 4*channels
+		if channels == 1 {
+			pos = 9 + 5 + 4
+		}
+
+		granules, granuleBits = 2, 59
+	} else {
+		// main_data_begin and private bits
+		pos = 8 + channels
+		granules, granuleBits = 1, 63
+	}
+
+	offsets := make([]int, 0, granules*channels)
+
+	for range granules * channels {
+		offsets = append(offsets, pos+globalGainOffset)
+		pos += granuleBits
+	}
+
+	return offsets
+}
+
+func sideInfoLength(header mp3.FrameHeader) int {
+	mono := header.ChannelMode() == mp3.SingleChannel
+
+	switch {
+	case header.Version() == mp3.MPEG1 && mono:
+		return 17
+	case header.Version() == mp3.MPEG1:
+		return 32
+	case mono:
+		return 9
+	default:
+		return 17
+	}
+}
+
+func readBits(b []byte, offset, n int) uint32 {
+	var v uint32
+
+	for i := offset; i < offset+n; i++ {
+		v = v<<1 | uint32(b[i/8]>>(7-i%8)&1)
+	}
+
+	return v
+}
+
+func writeBits(b []byte, offset, n int, v uint32) {
+	for i := offset + n - 1; i >= offset; i-- {
+		mask := byte(1) << (7 - i%8)
+		if v&1 == 1 {
+			b[i/8] |= mask
+		} else {
+			b[i/8] &^= mask
+		}
+
+		v >>= 1
+	}
+}
+
+// crc16 is the CRC of protected frames, over the last two bytes of the header and the side info.
+func crc16(b []byte) uint16 {
+	sum := uint16(0xFFFF)
+
+	for _, c := range b {
+		for i := 7; i >= 0; i-- {
+			bit := (sum>>15)&1 != uint16(c>>i)&1
+			sum <<= 1
+
+			if bit {
+				sum ^= crcPolynomial
+			}
+		}
+	}
+
+	return sum
+}
@@ -0,0 +1,96 @@
+package audiodecoder_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Bit offset of global_gain of the first granule of the first channel, joint stereo MPEG 1
+const firstGainOffset = 9 + 3 + 8 + 21
+
+func TestCutMp3(t *testing.T) {
+	song := append(infoFrame("Info", "LAME3.100"), frames(100, kbps128, joinStereo)...)
+
+	tests := []struct {
+		name       string
+		clip       audiodecoder.Clip
+		wantFrames int
+	}{
+		{name: "window", clip: audiodecoder.Clip{Start: 10 * frameDuration, Length: 20 * frameDuration}, wantFrames: 20},
+		{name: "from the start", clip: audiodecoder.Clip{Start: 0, Length: 5 * frameDuration}, wantFrames: 5},
+		{name: "ends with the song", clip: audiodecoder.Clip{Start: 90 * frameDuration, Length: time.Minute}, wantFrames: 10},
+		{name: "past the end", clip: audiodecoder.Clip{Start: time.Hour, Length: time.Minute}, wantFrames: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+
+			length, err := audiodecoder.Decoder{}.CutMp3(context.Background(), bytes.NewReader(song), out, tt.clip)
+			require.NoError(t, err)
+			assert.Equal(t, time.Duration(tt.wantFrames)*frameDuration, length)
+			assert.Equal(t, frames(tt.wantFrames, kbps128, joinStereo), out.Bytes())
+		})
+	}
+}
+
+func TestCutMp3_Fade(t *testing.T) {
+	song := frames(20, kbps128, joinStereo)
+	for i := range 20 {
+		setBits(song[i*417+4:], firstGainOffset, 200)
+	}
+
+	out := &bytes.Buffer{}
+
+	_, err := audiodecoder.Decoder{}.CutMp3(context.Background(), bytes.NewReader(song), out, audiodecoder.Clip{
+		Start:  0,
+		Length: 20 * frameDuration,
+		Fade:   4 * frameDuration,
+	})
+	require.NoError(t, err)
+
+	gains := make([]int, 20)
+	for i := range gains {
+		gains[i] = getBits(out.Bytes()[i*417+4:], firstGainOffset)
+	}
+
+	// -4 * log2 of 1/8, 3/8, 5/8 and 7/8 of the amplitude, rounded up
+	assert.Equal(t, []int{188, 194, 197, 199}, gains[:4])
+	assert.Equal(t, []int{200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200}, gains[4:16])
+	assert.Equal(t, []int{199, 197, 194, 188}, gains[16:])
+}
+
+func TestCutMp3_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := audiodecoder.Decoder{}.CutMp3(ctx, bytes.NewReader(frames(1, kbps128, 0)), &bytes.Buffer{},
+		audiodecoder.Clip{Start: 0, Length: time.Second, Fade: 0})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func getBits(b []byte, offset int) int {
+	v := 0
+	for i := offset; i < offset+8; i++ {
+		v = v<<1 | int(b[i/8]>>(7-i%8)&1)
+	}
+
+	return v
+}
+
+func setBits(b []byte, offset, v int) {
+	for i := offset + 7; i >= offset; i-- {
+		if v&1 == 1 {
+			b[i/8] |= 1 << (7 - i%8)
+		}
+
+		v >>= 1
+	}
+}
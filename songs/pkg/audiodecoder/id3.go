@@ -0,0 +1,229 @@
+package audiodecoder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf16"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+)
+
+// Advisory is the content advisory of a track, as iTunes writes it
+// to the ITUNESADVISORY user text frame.
+type Advisory uint8
+
+const (
+	AdvisoryUnknown Advisory = iota
+	AdvisoryExplicit
+	AdvisoryClean
+)
+
+const (
+	id3HeaderSize      = 10
+	id3FrameHeaderSize = 10
+
+	id3FlagUnsync         = 0x80
+	id3FlagExtendedHeader = 0x40
+
+	advisoryDescription = "ITUNESADVISORY"
+)
+
+var ErrMalformedId3 = errors.New("malformed id3 tag")
+
+// GetMp3Advisory reads the ID3v2 tag at the beginning of the file and returns its content advisory.
+// Files without a tag or without the advisory frame are AdvisoryUnknown.
+// Only ID3v2.3 and ID3v2.4 tags are supported.
+func (Decoder) GetMp3Advisory(ctx context.Context, r io.Reader) (Advisory, error) {
+	br := bufio.NewReader(r)
+
+	header, err := br.Peek(id3HeaderSize)
+	if err != nil || !bytes.HasPrefix(header, []byte("ID3")) {
+		return AdvisoryUnknown, nil
+	}
+
+	version := header[3]
+	flags := header[5]
+
+	if (version != 3 && version != 4) || flags&id3FlagUnsync != 0 {
+		return AdvisoryUnknown, nil
+	}
+
+	size, ok := syncsafe(header[6:10])
+	if !ok {
+		return AdvisoryUnknown, e.NewFrom("reading tag size", ErrMalformedId3)
+	}
+
+	tag := make([]byte, id3HeaderSize+size)
+
+	_, err = io.ReadFull(br, tag)
+	if err != nil {
+		return AdvisoryUnknown, e.NewFrom("reading id3 tag", err, fields.F("size", size))
+	}
+
+	frames := tag[id3HeaderSize:]
+
+	if flags&id3FlagExtendedHeader != 0 {
+		frames, err = skipExtendedHeader(frames, version)
+		if err != nil {
+			return AdvisoryUnknown, err
+		}
+	}
+
+	for len(frames) >= id3FrameHeaderSize && frames[0] != 0 {
+		select {
+		case <-ctx.Done():
+			return AdvisoryUnknown, ctx.Err() //nolint:wrapcheck
+
+		default:
+		}
+
+		id := string(frames[:4])
+
+		frameSize, ok := frameSize(frames[4:8], version)
+		if !ok || id3FrameHeaderSize+frameSize > len(frames) {
+			return AdvisoryUnknown, e.NewFrom("reading frame", ErrMalformedId3, fields.F("frame", id))
+		}
+
+		body := frames[id3FrameHeaderSize : id3FrameHeaderSize+frameSize]
+		frames = frames[id3FrameHeaderSize+frameSize:]
+
+		if id != "TXXX" {
+			continue
+		}
+
+		description, value := userText(body)
+		if !strings.EqualFold(description, advisoryDescription) {
+			continue
+		}
+
+		switch strings.TrimSpace(value) {
+		case "1", "4":
+			return AdvisoryExplicit, nil
+		case "2":
+			return AdvisoryClean, nil
+		default:
+			return AdvisoryUnknown, nil
+		}
+	}
+
+	return AdvisoryUnknown, nil
+}
+
+func skipExtendedHeader(frames []byte, version byte) ([]byte, error) {
+	const sizeLen = 4
+
+	if len(frames) < sizeLen {
+		return nil, e.NewFrom("reading extended header", ErrMalformedId3)
+	}
+
+	var (
+		size int
+		ok   = true
+	)
+
+	// v2.4 counts the size bytes too, v2.3 does not.
+	if version == 4 { //nolint:mnd
+		size, ok = syncsafe(frames[:sizeLen])
+	} else {
+		size = int(binary.BigEndian.Uint32(frames[:sizeLen])) + sizeLen
+	}
+
+	if !ok || size > len(frames) {
+		return nil, e.NewFrom("reading extended header", ErrMalformedId3)
+	}
+
+	return frames[size:], nil
+}
+
+func frameSize(b []byte, version byte) (int, bool) {
+	if version == 4 { //nolint:mnd
+		return syncsafe(b)
+	}
+
+	return int(binary.BigEndian.Uint32(b)), true
+}
+
+// syncsafe decodes a 28 bit integer stored in 4 bytes with the high bit of each byte unset.
+func syncsafe(b []byte) (int, bool) {
+	size := 0
+
+	for _, v := range b {
+		if v&0x80 != 0 {
+			return 0, false
+		}
+
+		size = size<<7 | int(v)
+	}
+
+	return size, true
+}
+
+// userText splits the TXXX frame body into the description and the value.
+func userText(body []byte) (string, string) {
+	if len(body) == 0 {
+		return "", ""
+	}
+
+	encoding, text := body[0], body[1:]
+
+	terminator := []byte{0}
+	if encoding == 1 || encoding == 2 {
+		terminator = []byte{0, 0}
+	}
+
+	idx := -1
+
+	for i := 0; i+len(terminator) <= len(text); i += len(terminator) {
+		if bytes.Equal(text[i:i+len(terminator)], terminator) {
+			idx = i
+			break
+		}
+	}
+
+	if idx < 0 {
+		return decodeText(encoding, text), ""
+	}
+
+	return decodeText(encoding, text[:idx]), decodeText(encoding, text[idx+len(terminator):])
+}
+
+func decodeText(encoding byte, b []byte) string {
+	switch encoding {
+	case 0: // ISO-8859-1
+		runes := make([]rune, len(b))
+		for i := range b {
+			runes[i] = rune(b[i])
+		}
+
+		return strings.TrimRight(string(runes), "\x00")
+
+	case 1, 2: // UTF-16 with BOM, UTF-16BE
+		var order binary.ByteOrder = binary.BigEndian
+
+		if encoding == 1 && len(b) >= 2 {
+			if b[0] == 0xFF && b[1] == 0xFE {
+				order = binary.LittleEndian
+			}
+
+			if b[0] == 0xFF && b[1] == 0xFE || b[0] == 0xFE && b[1] == 0xFF {
+				b = b[2:]
+			}
+		}
+
+		units := make([]uint16, len(b)/2) //nolint:mnd
+		for i := range units {
+			units[i] = order.Uint16(b[i*2:])
+		}
+
+		return strings.TrimRight(string(utf16.Decode(units)), "\x00")
+
+	default: // UTF-8
+		return strings.TrimRight(string(b), "\x00")
+	}
+}
@@ -0,0 +1,100 @@
+package audiodecoder_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMp3Advisory(t *testing.T) {
+	tests := []struct {
+		name string
+		file []byte
+		want audiodecoder.Advisory
+	}{
+		{
+			name: "explicit v2.3",
+			file: id3Tag(3, frame(3, "TIT2", "\x00song"), frame(3, "TXXX", "\x00ITUNESADVISORY\x001")),
+			want: audiodecoder.AdvisoryExplicit,
+		},
+		{
+			name: "clean v2.4",
+			file: id3Tag(4, frame(4, "TXXX", "\x03ITUNESADVISORY\x002")),
+			want: audiodecoder.AdvisoryClean,
+		},
+		{
+			name: "explicit utf-16",
+			file: id3Tag(3, frame(3, "TXXX", "\x01"+utf16le("ITUNESADVISORY")+"\x00\x00"+utf16le("1"))),
+			want: audiodecoder.AdvisoryExplicit,
+		},
+		{
+			name: "other user text",
+			file: id3Tag(3, frame(3, "TXXX", "\x00MOOD\x001")),
+			want: audiodecoder.AdvisoryUnknown,
+		},
+		{
+			name: "no tag",
+			file: []byte{0xFF, 0xFB, 0x90, 0x64, 0x00},
+			want: audiodecoder.AdvisoryUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := audiodecoder.Decoder{}.GetMp3Advisory(context.Background(), bytes.NewReader(tt.file))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetMp3Advisory_Malformed(t *testing.T) {
+	tag := id3Tag(3, frame(3, "TXXX", "\x00ITUNESADVISORY\x001"))
+	// frame size bigger than the tag
+	binary.BigEndian.PutUint32(tag[14:18], 1000)
+
+	_, err := audiodecoder.Decoder{}.GetMp3Advisory(context.Background(), bytes.NewReader(tag))
+	assert.ErrorIs(t, err, audiodecoder.ErrMalformedId3)
+}
+
+func id3Tag(version byte, frames ...[]byte) []byte {
+	body := bytes.Join(frames, nil)
+	header := []byte{'I', 'D', '3', version, 0, 0}
+	header = append(header, syncsafe(len(body))...)
+
+	// audio goes after the tag
+	return append(append(header, body...), 0xFF, 0xFB, 0x90, 0x64)
+}
+
+func frame(version byte, id string, body string) []byte {
+	size := make([]byte, 4)
+	if version == 4 {
+		size = syncsafe(len(body))
+	} else {
+		binary.BigEndian.PutUint32(size, uint32(len(body)))
+	}
+
+	f := append([]byte(id), size...)
+	f = append(f, 0, 0)
+
+	return append(f, body...)
+}
+
+func syncsafe(n int) []byte {
+	return []byte{byte(n >> 21 & 0x7F), byte(n >> 14 & 0x7F), byte(n >> 7 & 0x7F), byte(n & 0x7F)}
+}
+
+func utf16le(s string) string {
+	b := []byte{0xFF, 0xFE}
+	for _, r := range s {
+		b = append(b, byte(r), 0)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,260 @@
+package audiodecoder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"dev.gaijin.team/go/golib/e"
+	"github.com/tcolgate/mp3"
+)
+
+type Decoder struct{}
+
+// BitrateMode tells whether every frame of the file has the same bitrate.
+type BitrateMode string
+
+const (
+	BitrateConstant BitrateMode = "cbr"
+	BitrateVariable BitrateMode = "vbr"
+)
+
+type ChannelMode string
+
+const (
+	ChannelStereo      ChannelMode = "stereo"
+	ChannelJointStereo ChannelMode = "joint_stereo"
+	ChannelDual        ChannelMode = "dual_channel"
+	ChannelMono        ChannelMode = "mono"
+)
+
+// Mp3Info is the technical metadata of an mp3 file.
+type Mp3Info struct {
+	Duration time.Duration
+	// Audio frames, the Xing/Info frame is not counted
+	Frames int
+	// Bits per second, the average one for VBR
+	Bitrate     int
+	BitrateMode BitrateMode
+	// Of the first frame, in Hz
+	SampleRate  int
+	ChannelMode ChannelMode
+	// Encoder version from the LAME tag, e.g. LAME3.100. Empty if the file has no tag.
+	Encoder string
+	// Bytes of the audio frames
+	AudioBytes int
+	// Bytes that are not mp3 frames, garbage between the frames or a trailing tag.
+	// The leading ID3v2 tag and the last bytes shorter than a frame header are not counted.
+	SkippedBytes int
+}
+
+const (
+	mp3HeaderSize = 4
+	mp3CrcSize    = 2
+
+	// VBRI tag of the Fraunhofer encoder always follows 32 bytes of the side info
+	vbriOffset = mp3HeaderSize + 32
+
+	xingFlagFrames  = 0x1
+	xingFlagBytes   = 0x2
+	xingFlagToc     = 0x4
+	xingFlagQuality = 0x8
+	xingTocSize     = 100
+	lameEncoderSize = 9
+
+	id3FlagFooter = 0x10
+)
+
+var channelModes = map[mp3.FrameChannelMode]ChannelMode{
+	mp3.Stereo:        ChannelStereo,
+	mp3.JointStereo:   ChannelJointStereo,
+	mp3.DualChannel:   ChannelDual,
+	mp3.SingleChannel: ChannelMono,
+}
+
+// GetMp3Info decodes every frame of the file and returns its technical metadata.
+// Files without frames are not an error, Frames is zero then. A truncated last frame is ignored.
+func (Decoder) GetMp3Info(ctx context.Context, r io.Reader) (Mp3Info, error) {
+	var (
+		info    Mp3Info
+		frame   mp3.Frame
+		skipped int
+		bitrate mp3.FrameBitRate
+		mixed   bool
+		first   = true
+	)
+
+	br := bufio.NewReader(r)
+
+	err := skipId3(br)
+	if err != nil {
+		return Mp3Info{}, err
+	}
+
+	d := mp3.NewDecoder(br)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return Mp3Info{}, ctx.Err() //nolint:wrapcheck
+
+		default:
+		}
+
+		err := d.Decode(&frame, &skipped)
+		info.SkippedBytes += skipped
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return Mp3Info{}, e.NewFrom("decoding mp3", err)
+		}
+
+		// The tag frame is silent, it only describes the file
+		if first {
+			first = false
+
+			mode, encoder, ok := readInfoTag(&frame)
+			if ok {
+				info.BitrateMode, info.Encoder = mode, encoder
+				continue
+			}
+		}
+
+		header := frame.Header()
+
+		if info.Frames == 0 {
+			info.SampleRate = int(header.SampleRate())
+			info.ChannelMode = channelModes[header.ChannelMode()]
+			bitrate = header.BitRate()
+		} else if header.BitRate() != bitrate {
+			mixed = true
+		}
+
+		info.Frames++
+		info.Duration += frame.Duration()
+		info.AudioBytes += frame.Size()
+	}
+
+	if info.Frames == 0 {
+		return info, nil
+	}
+
+	switch {
+	case mixed:
+		info.BitrateMode = BitrateVariable
+	case info.BitrateMode == "":
+		info.BitrateMode = BitrateConstant
+	}
+
+	info.Bitrate = int(bitrate)
+	if info.BitrateMode == BitrateVariable {
+		info.Bitrate = int(float64(info.AudioBytes*8) / info.Duration.Seconds()) //nolint:mnd
+	}
+
+	return info, nil
+}
+
+// skipId3 skips the ID3v2 tag at the beginning of the file, it is not garbage.
+func skipId3(br *bufio.Reader) error {
+	header, err := br.Peek(id3HeaderSize)
+	if err != nil || !bytes.HasPrefix(header, []byte("ID3")) {
+		return nil
+	}
+
+	size, ok := syncsafe(header[6:10])
+	if !ok {
+		return nil
+	}
+
+	size += id3HeaderSize
+	if header[5]&id3FlagFooter != 0 {
+		size += id3HeaderSize
+	}
+
+	_, err = br.Discard(size)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return e.NewFrom("skipping id3 tag", err)
+	}
+
+	return nil
+}
+
+// readInfoTag reads the Xing/Info tag LAME puts into the first frame, or the VBRI tag of Fraunhofer.
+// LAME writes Xing for VBR files and Info for CBR ones.
+func readInfoTag(frame *mp3.Frame) (BitrateMode, string, bool) {
+	buf := &bytes.Buffer{}
+	_, _ = buf.ReadFrom(frame.Reader())
+	b := buf.Bytes()
+
+	if len(b) >= vbriOffset+4 && string(b[vbriOffset:vbriOffset+4]) == "VBRI" {
+		return BitrateVariable, "", true
+	}
+
+	sideInfoSize, err := frame.SideInfoLength()
+	if err != nil {
+		return "", "", false
+	}
+
+	offset := mp3HeaderSize + sideInfoSize
+	if frame.Header().Protection() {
+		offset += mp3CrcSize
+	}
+
+	if len(b) < offset+8 {
+		return "", "", false
+	}
+
+	var mode BitrateMode
+
+	switch string(b[offset : offset+4]) {
+	case "Xing":
+		mode = BitrateVariable
+	case "Info":
+		mode = BitrateConstant
+	default:
+		return "", "", false
+	}
+
+	flags := binary.BigEndian.Uint32(b[offset+4:])
+	pos := offset + 8
+
+	if flags&xingFlagFrames != 0 {
+		pos += 4
+	}
+
+	if flags&xingFlagBytes != 0 {
+		pos += 4
+	}
+
+	if flags&xingFlagToc != 0 {
+		pos += xingTocSize
+	}
+
+	if flags&xingFlagQuality != 0 {
+		pos += 4
+	}
+
+	if len(b) < pos+lameEncoderSize {
+		return mode, "", true
+	}
+
+	return mode, encoderName(b[pos : pos+lameEncoderSize]), true
+}
+
+// encoderName keeps the printable prefix, short versions are followed by other fields of the tag.
+func encoderName(b []byte) string {
+	end := 0
+	for end < len(b) && b[end] >= ' ' && b[end] <= '~' {
+		end++
+	}
+
+	return strings.TrimSpace(string(b[:end]))
+}
@@ -0,0 +1,191 @@
+package audiodecoder_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MPEG 1 Layer III, 44100 Hz
+const (
+	kbps128 = 0x90
+	kbps160 = 0xA0
+
+	joinStereo = 0x40
+	mono       = 0xC0
+
+	// 1152 samples at 44100 Hz
+	frameDuration = 26122448 * time.Nanosecond
+)
+
+func TestGetMp3Info(t *testing.T) {
+	tests := []struct {
+		name string
+		file []byte
+		want audiodecoder.Mp3Info
+	}{
+		{
+			name: "cbr without tag",
+			file: frames(3, kbps128, joinStereo),
+			want: audiodecoder.Mp3Info{
+				Duration:     3 * frameDuration,
+				Frames:       3,
+				Bitrate:      128000,
+				BitrateMode:  audiodecoder.BitrateConstant,
+				SampleRate:   44100,
+				ChannelMode:  audiodecoder.ChannelJointStereo,
+				Encoder:      "",
+				AudioBytes:   3 * 417,
+				SkippedBytes: 0,
+			},
+		},
+		{
+			name: "info tag",
+			file: append(infoFrame("Info", "LAME3.100"), frames(2, kbps128, mono)...),
+			want: audiodecoder.Mp3Info{
+				Duration:     2 * frameDuration,
+				Frames:       2,
+				Bitrate:      128000,
+				BitrateMode:  audiodecoder.BitrateConstant,
+				SampleRate:   44100,
+				ChannelMode:  audiodecoder.ChannelMono,
+				Encoder:      "LAME3.100",
+				AudioBytes:   2 * 417,
+				SkippedBytes: 0,
+			},
+		},
+		{
+			name: "xing tag",
+			file: append(infoFrame("Xing", "LAME3.99r"), frames(1, kbps160, joinStereo)...),
+			want: audiodecoder.Mp3Info{
+				Duration:     frameDuration,
+				Frames:       1,
+				Bitrate:      159862,
+				BitrateMode:  audiodecoder.BitrateVariable,
+				SampleRate:   44100,
+				ChannelMode:  audiodecoder.ChannelJointStereo,
+				Encoder:      "LAME3.99r",
+				AudioBytes:   522,
+				SkippedBytes: 0,
+			},
+		},
+		{
+			name: "mixed bitrates",
+			file: append(frames(1, kbps128, 0), frames(1, kbps160, 0)...),
+			want: audiodecoder.Mp3Info{
+				Duration:     2 * frameDuration,
+				Frames:       2,
+				Bitrate:      143784,
+				BitrateMode:  audiodecoder.BitrateVariable,
+				SampleRate:   44100,
+				ChannelMode:  audiodecoder.ChannelStereo,
+				Encoder:      "",
+				AudioBytes:   417 + 522,
+				SkippedBytes: 0,
+			},
+		},
+		{
+			name: "id3 tag and garbage",
+			file: bytes.Join([][]byte{
+				id3Only(id3Tag(3, frame(3, "TIT2", "\x00song"))),
+				frames(1, kbps128, 0),
+				make([]byte, 10),
+				frames(1, kbps128, 0),
+				// the last bytes shorter than a frame header are not searched
+				[]byte("TAG trailing"),
+			}, nil),
+			want: audiodecoder.Mp3Info{
+				Duration:     2 * frameDuration,
+				Frames:       2,
+				Bitrate:      128000,
+				BitrateMode:  audiodecoder.BitrateConstant,
+				SampleRate:   44100,
+				ChannelMode:  audiodecoder.ChannelStereo,
+				Encoder:      "",
+				AudioBytes:   2 * 417,
+				SkippedBytes: 10 + 10,
+			},
+		},
+		{
+			name: "truncated last frame",
+			file: frames(2, kbps128, 0)[:417+100],
+			want: audiodecoder.Mp3Info{
+				Duration:     frameDuration,
+				Frames:       1,
+				Bitrate:      128000,
+				BitrateMode:  audiodecoder.BitrateConstant,
+				SampleRate:   44100,
+				ChannelMode:  audiodecoder.ChannelStereo,
+				Encoder:      "",
+				AudioBytes:   417,
+				SkippedBytes: 0,
+			},
+		},
+		{
+			name: "no frames",
+			file: []byte("definitely not an mp3"),
+			want: audiodecoder.Mp3Info{ //nolint:exhaustruct
+				SkippedBytes: 18,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := audiodecoder.Decoder{}.GetMp3Info(context.Background(), bytes.NewReader(tt.file))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetMp3Info_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := audiodecoder.Decoder{}.GetMp3Info(ctx, bytes.NewReader(frames(1, kbps128, 0)))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// id3Only cuts the audio id3Tag puts after the tag.
+func id3Only(tag []byte) []byte {
+	return tag[:len(tag)-4]
+}
+
+// frames makes silent frames, the size is 1152 / 8 * bitrate / 44100 bytes.
+func frames(n int, bitrate, channelMode byte) []byte {
+	size := 1152 / 8 * int(bitrateKbps[bitrate]) * 1000 / 44100
+
+	var out []byte
+
+	for range n {
+		f := make([]byte, size)
+		copy(f, []byte{0xFF, 0xFB, bitrate, channelMode})
+		out = append(out, f...)
+	}
+
+	return out
+}
+
+var bitrateKbps = map[byte]int{kbps128: 128, kbps160: 160}
+
+// infoFrame makes the first frame with a LAME tag: all the optional fields and the encoder version.
+func infoFrame(id, encoder string) []byte {
+	f := frames(1, kbps128, 0)
+
+	// header and the stereo side info
+	pos := 4 + 32
+	pos += copy(f[pos:], id)
+	binary.BigEndian.PutUint32(f[pos:], 0x0F)
+	pos += 4 + 4 + 4 + 100 + 4
+	copy(f[pos:], encoder)
+
+	return f
+}
@@ -0,0 +1,123 @@
+package audiodecoder
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"math"
+
+	"dev.gaijin.team/go/golib/e"
+	"github.com/tcolgate/mp3"
+)
+
+// WaveformMax is the level of the loudest part of the song in a waveform, silence is 0.
+const WaveformMax = 100
+
+// GetMp3Waveform returns the levels of the song split in n parts of the same length,
+// songs shorter than n frames have a part per frame.
+//
+// The frames are not decoded, the level of a frame is its largest global gain,
+// the scale of the quantized audio, which follows the loudness of the frame.
+// A part has the level of its loudest frame, linear in amplitude to the loudest part of the song.
+func (Decoder) GetMp3Waveform(ctx context.Context, r io.Reader, n int) ([]int, error) {
+	var (
+		frame   mp3.Frame
+		skipped int
+		gains   []int
+		first   = true
+	)
+
+	br := bufio.NewReader(r)
+
+	err := skipId3(br)
+	if err != nil {
+		return nil, err
+	}
+
+	d := mp3.NewDecoder(br)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err() //nolint:wrapcheck
+
+		default:
+		}
+
+		err := d.Decode(&frame, &skipped)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, e.NewFrom("decoding mp3", err)
+		}
+
+		if first {
+			first = false
+
+			if _, _, ok := readInfoTag(&frame); ok {
+				continue
+			}
+		}
+
+		gains = append(gains, frameGain(&frame))
+	}
+
+	return waveform(gains, n), nil
+}
+
+// frameGain returns the largest global gain of the granules and channels of the Layer III frame,
+// frames of other layers have none and are 0.
+func frameGain(frame *mp3.Frame) int {
+	header := frame.Header()
+	if header.Layer() != mp3.Layer3 {
+		return 0
+	}
+
+	sideInfo := []byte(frame.SideInfo())
+	gain := 0
+
+	for _, offset := range globalGainOffsets(header) {
+		gain = max(gain, int(readBits(sideInfo, offset, globalGainBits)))
+	}
+
+	return gain
+}
+
+func waveform(gains []int, n int) []int {
+	n = min(n, len(gains))
+	if n == 0 {
+		return []int{}
+	}
+
+	peaks := make([]int, n)
+	loudest := 0
+
+	for i := range peaks {
+		for _, gain := range gains[i*len(gains)/n : (i+1)*len(gains)/n] {
+			peaks[i] = max(peaks[i], gain)
+		}
+
+		loudest = max(loudest, peaks[i])
+	}
+
+	levels := make([]int, n)
+
+	// Silent songs have no gain at all
+	if loudest == 0 {
+		return levels
+	}
+
+	for i, peak := range peaks {
+		if peak == 0 {
+			continue
+		}
+
+		amplitude := math.Exp2(float64(peak-loudest) / globalGainStepsPerHalving)
+		levels[i] = int(math.Round(amplitude * WaveformMax))
+	}
+
+	return levels
+}
@@ -0,0 +1,61 @@
+package audiodecoder_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/audiodecoder"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMp3Waveform(t *testing.T) {
+	// Each quarter is 4 gain steps, half the amplitude, quieter than the one before
+	song := frames(40, kbps128, joinStereo)
+	for i := range 40 {
+		setBits(song[i*417+4:], firstGainOffset, 200-i/10*4)
+	}
+
+	song = append(infoFrame("Info", "LAME3.100"), song...)
+
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{name: "quarters", n: 4, want: []int{100, 50, 25, 13}},
+		{name: "halves take the loudest frame", n: 2, want: []int{100, 25}},
+		{name: "more parts than frames", n: 100, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			levels, err := audiodecoder.Decoder{}.GetMp3Waveform(context.Background(), bytes.NewReader(song), tt.n)
+			require.NoError(t, err)
+
+			if tt.want == nil {
+				assert.Len(t, levels, 40)
+				return
+			}
+
+			assert.Equal(t, tt.want, levels)
+		})
+	}
+}
+
+func TestGetMp3Waveform_Silence(t *testing.T) {
+	levels, err := audiodecoder.Decoder{}.GetMp3Waveform(context.Background(),
+		bytes.NewReader(frames(10, kbps128, joinStereo)), 5)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 0, 0, 0, 0}, levels)
+}
+
+func TestGetMp3Waveform_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := audiodecoder.Decoder{}.GetMp3Waveform(ctx, bytes.NewReader(frames(1, kbps128, 0)), 10)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,157 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/tracing"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/transport"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/segmentio/kafka-go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// HandlerFunc handles a decoded event payload.
+type HandlerFunc[T proto.Message] func(ctx context.Context, env Envelope, payload T) error
+
+type rawHandler func(ctx context.Context, env Envelope) error
+
+// Consumer reads events from a Kafka topic and dispatches them
+// to the handlers registered with [Handle] by the event type.
+//
+// Events of unknown types and malformed messages are logged and skipped,
+// so a consumer keeps working when a producer starts to send new events.
+type Consumer struct {
+	r        *kafka.Reader
+	conf     ConsumerConfig
+	handlers map[string]rawHandler
+}
+
+type ConsumerConfig struct {
+	Brokers []string
+	Topic   string
+	GroupId string
+}
+
+func NewConsumer(conf ConsumerConfig) *Consumer {
+	reader := kafka.NewReader(kafka.ReaderConfig{ //nolint:exhaustruct
+		Brokers: conf.Brokers,
+		Topic:   conf.Topic,
+		GroupID: conf.GroupId,
+	})
+
+	return &Consumer{
+		r:        reader,
+		conf:     conf,
+		handlers: make(map[string]rawHandler),
+	}
+}
+
+// Handle registers a typed handler for the event type.
+// It must be called before [Consumer.Run].
+func Handle[T proto.Message](c *Consumer, eventType string, handler HandlerFunc[T]) {
+	c.handlers[eventType] = func(ctx context.Context, env Envelope) error {
+		var zero T
+
+		payload, ok := zero.ProtoReflect().New().Interface().(T)
+		if !ok {
+			return e.New("unexpected payload type", fields.F("type", eventType))
+		}
+
+		err := env.Decode(payload)
+		if err != nil {
+			return err
+		}
+
+		return handler(ctx, env, payload)
+	}
+}
+
+// Run consumes the events until the context is done.
+// A message is committed only after its handler succeeds,
+// a handler error stops the consumer and is returned.
+func (c *Consumer) Run(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+
+	for {
+		msg, err := c.r.FetchMessage(ctx)
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil
+		}
+
+		if err != nil {
+			return e.NewFrom("fetching message", err)
+		}
+
+		err = c.dispatch(ctx, msg)
+		if err != nil {
+			return e.NewFrom("handling message", err,
+				fields.F("partition", msg.Partition), fields.F("offset", msg.Offset))
+		}
+
+		err = c.r.CommitMessages(ctx, msg)
+		if err != nil {
+			return e.NewFrom("committing message", err)
+		}
+
+		log.Trace().Int("partition", msg.Partition).Int64("offset", msg.Offset).Msg("message committed")
+	}
+}
+
+func (c *Consumer) dispatch(ctx context.Context, msg kafka.Message) (err error) {
+	log := logger.FromContext(ctx)
+
+	env, err := Parse(msg)
+	if err != nil {
+		log.Warn().Err(err).Int("partition", msg.Partition).Int64("offset", msg.Offset).
+			Msg("skipping malformed event")
+
+		return nil
+	}
+
+	handler, ok := c.handlers[env.Type]
+	if !ok {
+		log.Debug().Str("type", env.Type).Str("id", env.Id).Msg("skipping event of unknown type")
+		return nil
+	}
+
+	// The span continues the trace of the producer
+	ctx, span := tracer.Start(env.ContextWithTrace(ctx), "process "+c.conf.Topic,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeDeliver,
+			semconv.MessagingDestinationName(c.conf.Topic),
+			semconv.MessagingKafkaConsumerGroup(c.conf.GroupId),
+			semconv.MessagingDestinationPartitionID(strconv.Itoa(msg.Partition)),
+			semconv.MessagingKafkaMessageOffset(int(msg.Offset)),
+			semconv.MessagingMessageID(env.Id),
+		))
+	defer func() { EndSpan(span, err) }()
+
+	// Producers without the trace context only send the trace id
+	traceId := tracing.TraceId(ctx)
+	if env.TraceParent == "" && env.TraceId != "" {
+		traceId = env.TraceId
+	}
+
+	ctx = logger.WithLoggerAndTraceId(ctx,
+		log.With().Str(transport.TraceIdLogKey, traceId).Logger(), traceId)
+
+	return handler(ctx, env)
+}
+
+func (c *Consumer) Close() error {
+	err := c.r.Close()
+	if err != nil {
+		return e.NewFrom("closing kafka reader", err)
+	}
+
+	return nil
+}
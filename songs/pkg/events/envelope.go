@@ -0,0 +1,194 @@
+// Package events describes the events the songs service publishes to Kafka.
+//
+// Events follow the CloudEvents 1.0 spec in the Kafka binary content mode:
+// the attributes are stored in the `ce_*` headers and the message value
+// is a protobuf payload from the songs api package.
+// Other services may import this package to consume the events, see [Consumer].
+package events
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/transport"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	SpecVersion     = "1.0"
+	ContentType     = "application/protobuf"
+	Source          = "/audio-hosting/songs"
+	dataSchemaTpl   = "type.googleapis.com/"
+	headerPrefix    = "ce_"
+	headerContentTp = "content-type"
+)
+
+// Event types. The version suffix is bumped on every breaking change of a payload.
+const (
+	TypeSongCreated      = "com.audio-hosting.songs.song.created.v1"
+	TypeSongUploaded     = "com.audio-hosting.songs.song.uploaded.v1"
+	TypeSongUpdated      = "com.audio-hosting.songs.song.updated.v1"
+	TypeSongImageChanged = "com.audio-hosting.songs.song.image_changed.v1"
+	TypeSongReleased     = "com.audio-hosting.songs.song.released.v1"
+	TypeSongModerated    = "com.audio-hosting.songs.song.moderated.v1"
+	TypeSongDeleted      = "com.audio-hosting.songs.song.deleted.v1"
+	TypeSongRestored     = "com.audio-hosting.songs.song.restored.v1"
+	TypeSongPlayed       = "com.audio-hosting.songs.song.played.v1"
+
+	TypeClaimSubmitted      = "com.audio-hosting.songs.claim.submitted.v1"
+	TypeClaimCounterNoticed = "com.audio-hosting.songs.claim.counter_noticed.v1"
+	TypeClaimResolved       = "com.audio-hosting.songs.claim.resolved.v1"
+
+	TypeCreditRequested = "com.audio-hosting.songs.credit.requested.v1"
+	TypeCreditResolved  = "com.audio-hosting.songs.credit.resolved.v1"
+)
+
+var (
+	ErrMissingAttribute = e.New("missing required cloudevents attribute")
+	ErrUnsupportedSpec  = e.New("unsupported cloudevents spec version")
+	ErrContentType      = e.New("unsupported content type")
+	ErrDecoding         = e.New("decoding event payload")
+)
+
+// Envelope is a CloudEvent carrying a protobuf payload.
+type Envelope struct {
+	Id         string
+	Source     string
+	Type       string
+	Subject    string
+	Time       time.Time
+	DataSchema string
+	// TraceId is propagated through the [transport.TraceIdKey] header,
+	// it is left for the consumers that don't support the trace context.
+	TraceId string
+	// W3C trace context of the producer, see [Envelope.WithTrace].
+	TraceParent string
+	TraceState  string
+	// Key is the Kafka message key, it defines the partition
+	// and so the ordering of the events.
+	Key  string
+	Data []byte
+}
+
+// New wraps the payload into an envelope of the given type.
+func New(eventType, key, traceId string, payload proto.Message) (Envelope, error) {
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return Envelope{}, e.NewFrom("marshalling event payload", err, fields.F("type", eventType))
+	}
+
+	return Envelope{
+		Id:          uuid.NewString(),
+		Source:      Source,
+		Type:        eventType,
+		Subject:     key,
+		Time:        time.Now().UTC(),
+		DataSchema:  dataSchemaTpl + string(proto.MessageName(payload)),
+		TraceId:     traceId,
+		TraceParent: "",
+		TraceState:  "",
+		Key:         key,
+		Data:        data,
+	}, nil
+}
+
+// Message converts the envelope into a Kafka message.
+func (env Envelope) Message() kafka.Message {
+	headers := []kafka.Header{
+		{Key: headerPrefix + "specversion", Value: []byte(SpecVersion)},
+		{Key: headerPrefix + "id", Value: []byte(env.Id)},
+		{Key: headerPrefix + "source", Value: []byte(env.Source)},
+		{Key: headerPrefix + "type", Value: []byte(env.Type)},
+		{Key: headerPrefix + "time", Value: []byte(env.Time.Format(time.RFC3339Nano))},
+		{Key: headerContentTp, Value: []byte(ContentType)},
+	}
+
+	if env.Subject != "" {
+		headers = append(headers, kafka.Header{Key: headerPrefix + "subject", Value: []byte(env.Subject)})
+	}
+
+	if env.DataSchema != "" {
+		headers = append(headers, kafka.Header{Key: headerPrefix + "dataschema", Value: []byte(env.DataSchema)})
+	}
+
+	if env.TraceId != "" {
+		headers = append(headers, kafka.Header{Key: transport.TraceIdKey, Value: []byte(env.TraceId)})
+	}
+
+	if env.TraceParent != "" {
+		headers = append(headers, kafka.Header{Key: headerPrefix + attrTraceParent, Value: []byte(env.TraceParent)})
+	}
+
+	if env.TraceState != "" {
+		headers = append(headers, kafka.Header{Key: headerPrefix + attrTraceState, Value: []byte(env.TraceState)})
+	}
+
+	return kafka.Message{ //nolint:exhaustruct
+		Key:     []byte(env.Key),
+		Value:   env.Data,
+		Headers: headers,
+	}
+}
+
+// Parse reads the envelope from a Kafka message.
+// It does not decode the payload, use [Envelope.Decode] for that.
+func Parse(msg kafka.Message) (Envelope, error) {
+	attrs := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		attrs[strings.ToLower(h.Key)] = string(h.Value)
+	}
+
+	if v := attrs[headerPrefix+"specversion"]; v != SpecVersion {
+		return Envelope{}, ErrUnsupportedSpec.WithFields(fields.F("specversion", v))
+	}
+
+	if ct := attrs[headerContentTp]; ct != "" && ct != ContentType {
+		return Envelope{}, ErrContentType.WithFields(fields.F("content_type", ct))
+	}
+
+	for _, required := range []string{"id", "source", "type"} {
+		if attrs[headerPrefix+required] == "" {
+			return Envelope{}, ErrMissingAttribute.WithFields(fields.F("attribute", required))
+		}
+	}
+
+	env := Envelope{
+		Id:          attrs[headerPrefix+"id"],
+		Source:      attrs[headerPrefix+"source"],
+		Type:        attrs[headerPrefix+"type"],
+		Subject:     attrs[headerPrefix+"subject"],
+		Time:        time.Time{},
+		DataSchema:  attrs[headerPrefix+"dataschema"],
+		TraceId:     attrs[strings.ToLower(transport.TraceIdKey)],
+		TraceParent: attrs[headerPrefix+attrTraceParent],
+		TraceState:  attrs[headerPrefix+attrTraceState],
+		Key:         string(msg.Key),
+		Data:        msg.Value,
+	}
+
+	if t := attrs[headerPrefix+"time"]; t != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, t)
+		if err != nil {
+			return Envelope{}, e.NewFrom("parsing time attribute", err, fields.F("time", t))
+		}
+
+		env.Time = parsed
+	}
+
+	return env, nil
+}
+
+// Decode unmarshals the payload into dst.
+func (env Envelope) Decode(dst proto.Message) error {
+	err := proto.Unmarshal(env.Data, dst)
+	if err != nil {
+		return ErrDecoding.Wrap(err, fields.F("type", env.Type), fields.F("id", env.Id))
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/api/protogen/api"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/events"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	artistId := uuid.NewString()
+	payload := &api.SongReleasedEvent{
+		SongId:     uuid.NewString(),
+		ArtistId:   artistId,
+		Name:       "song",
+		ReleasedAt: timestamppb.New(time.Now()),
+	}
+
+	env, err := events.New(events.TypeSongReleased, artistId, "trace", payload)
+	require.NoError(t, err)
+
+	msg := env.Message()
+	assert.Equal(t, []byte(artistId), msg.Key)
+
+	parsed, err := events.Parse(msg)
+	require.NoError(t, err)
+
+	assert.Equal(t, env.Id, parsed.Id)
+	assert.Equal(t, events.Source, parsed.Source)
+	assert.Equal(t, events.TypeSongReleased, parsed.Type)
+	assert.Equal(t, artistId, parsed.Subject)
+	assert.Equal(t, "trace", parsed.TraceId)
+	assert.Equal(t, "type.googleapis.com/api.SongReleasedEvent", parsed.DataSchema)
+	assert.True(t, env.Time.Equal(parsed.Time))
+
+	var decoded api.SongReleasedEvent
+
+	require.NoError(t, parsed.Decode(&decoded))
+	assert.True(t, proto.Equal(payload, &decoded))
+}
+
+func TestEnvelopeTrace(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	provider := sdktrace.NewTracerProvider()
+	defer provider.Shutdown(context.Background()) //nolint:errcheck
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "publish")
+	defer span.End()
+
+	env, err := events.New(events.TypeSongReleased, "key", "", &api.SongReleasedEvent{}) //nolint:exhaustruct
+	require.NoError(t, err)
+
+	parsed, err := events.Parse(env.WithTrace(ctx).Message())
+	require.NoError(t, err)
+	assert.NotEmpty(t, parsed.TraceParent)
+
+	remote := trace.SpanContextFromContext(parsed.ContextWithTrace(context.Background()))
+	assert.True(t, remote.IsRemote())
+	assert.Equal(t, span.SpanContext().TraceID(), remote.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), remote.SpanID())
+
+	untraced, err := events.Parse(env.Message())
+	require.NoError(t, err)
+	assert.False(t, trace.SpanContextFromContext(untraced.ContextWithTrace(context.Background())).IsValid())
+}
+
+func TestParseErrors(t *testing.T) {
+	tt := []struct {
+		name    string
+		headers []kafka.Header
+		want    error
+	}{
+		{
+			name:    "no spec version",
+			headers: []kafka.Header{{Key: "ce_id", Value: []byte("1")}},
+			want:    events.ErrUnsupportedSpec,
+		},
+		{
+			name: "wrong content type",
+			headers: []kafka.Header{
+				{Key: "ce_specversion", Value: []byte("1.0")},
+				{Key: "content-type", Value: []byte("application/json")},
+			},
+			want: events.ErrContentType,
+		},
+		{
+			name: "missing type",
+			headers: []kafka.Header{
+				{Key: "ce_specversion", Value: []byte("1.0")},
+				{Key: "ce_id", Value: []byte("1")},
+				{Key: "ce_source", Value: []byte("/test")},
+			},
+			want: events.ErrMissingAttribute,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := events.Parse(kafka.Message{Headers: tc.headers}) //nolint:exhaustruct
+
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
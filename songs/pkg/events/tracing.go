@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The trace context follows the CloudEvents distributed tracing extension,
+// the traceparent and tracestate attributes.
+const (
+	attrTraceParent = "traceparent"
+	attrTraceState  = "tracestate"
+)
+
+var tracer = otel.Tracer("github.com/Benzogang-Tape/audio-hosting/songs/pkg/events")
+
+// StartPublish starts the span of publishing a batch of events to the topic.
+// The envelopes traced with the returned context continue the span, see [Envelope.WithTrace].
+func StartPublish(ctx context.Context, topic string, count int) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "publish "+topic,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypePublish,
+			semconv.MessagingDestinationName(topic),
+			semconv.MessagingBatchMessageCount(count),
+		))
+}
+
+// EndSpan records the error of the span, if any, and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// WithTrace sets the trace context of ctx to the envelope,
+// so the consumers continue the trace.
+func (env Envelope) WithTrace(ctx context.Context) Envelope {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	env.TraceParent = carrier[attrTraceParent]
+	env.TraceState = carrier[attrTraceState]
+
+	return env
+}
+
+// ContextWithTrace returns ctx with the remote trace context of the envelope, if it has one.
+func (env Envelope) ContextWithTrace(ctx context.Context) context.Context {
+	if env.TraceParent == "" {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier{
+		attrTraceParent: env.TraceParent,
+		attrTraceState:  env.TraceState,
+	})
+}
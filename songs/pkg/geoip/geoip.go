@@ -0,0 +1,51 @@
+package geoip
+
+import (
+	"net"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// DB resolves countries of IP addresses from a local MaxMind compatible database,
+// e.g. GeoLite2-Country.mmdb or the free DB-IP country lite file.
+type DB struct {
+	reader *maxminddb.Reader
+}
+
+type record struct {
+	Country struct {
+		IsoCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+}
+
+func Open(path string) (*DB, error) {
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, e.NewFrom("opening geoip database", err, fields.F("path", path))
+	}
+
+	return &DB{reader: reader}, nil
+}
+
+// Country returns ISO 3166-1 alpha-2 code of the country or empty string if it is unknown.
+func (db *DB) Country(ip net.IP) (string, error) {
+	var rec record
+
+	err := db.reader.Lookup(ip, &rec)
+	if err != nil {
+		return "", e.NewFrom("looking up ip", err, fields.F("ip", ip.String()))
+	}
+
+	return rec.Country.IsoCode, nil
+}
+
+func (db *DB) Close() error {
+	err := db.reader.Close()
+	if err != nil {
+		return e.NewFrom("closing geoip database", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/health"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func ok(context.Context) error { return nil }
+
+func failing(context.Context) error { return errors.New("connection refused") }
+
+func hanging(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestCheck(t *testing.T) {
+	checker := health.NewChecker(50 * time.Millisecond)
+	checker.Add("postgres", ok)
+	checker.Add("redis", failing)
+	checker.Add("kafka", hanging)
+
+	report := checker.Check(context.Background())
+
+	assert.False(t, report.Ready)
+	require.Len(t, report.Dependencies, 3)
+	assert.Equal(t, "postgres", report.Dependencies[0].Name)
+	assert.True(t, report.Dependencies[0].Ok)
+	assert.Equal(t, "connection refused", report.Dependencies[1].Error)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Dependencies[2].Error)
+	assert.Equal(t, []string{"redis", "kafka"}, report.Failed())
+}
+
+func TestCheckReady(t *testing.T) {
+	checker := health.NewChecker(time.Second)
+	checker.Add("postgres", ok)
+
+	report := checker.Check(context.Background())
+
+	assert.True(t, report.Ready)
+	assert.Empty(t, report.Failed())
+}
+
+func TestCheckOne(t *testing.T) {
+	checker := health.NewChecker(time.Second)
+	checker.Add("redis", failing)
+
+	dep, err := checker.CheckOne(context.Background(), "redis")
+	require.NoError(t, err)
+	assert.False(t, dep.Ok)
+
+	_, err = checker.CheckOne(context.Background(), "minio")
+	require.ErrorIs(t, err, health.ErrUnknownDependency)
+}
+
+func TestReady(t *testing.T) {
+	checker := health.NewChecker(time.Second)
+	checker.Add("postgres", ok)
+	checker.Add("redis", failing)
+
+	rec := httptest.NewRecorder()
+	checker.Ready(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var report health.Report
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	assert.Equal(t, []string{"redis"}, report.Failed())
+
+	rec = httptest.NewRecorder()
+	checker.Live(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestServerCheck(t *testing.T) {
+	checker := health.NewChecker(time.Second)
+	checker.Add("postgres", ok)
+	checker.Add("redis", failing)
+
+	server := health.NewServer(checker, "api.SongsService")
+
+	tests := []struct {
+		service string
+		want    healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{service: "", want: healthpb.HealthCheckResponse_NOT_SERVING},
+		{service: "api.SongsService", want: healthpb.HealthCheckResponse_NOT_SERVING},
+		{service: "postgres", want: healthpb.HealthCheckResponse_SERVING},
+		{service: "redis", want: healthpb.HealthCheckResponse_NOT_SERVING},
+	}
+
+	for _, tt := range tests {
+		resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: tt.service})
+		require.NoError(t, err, tt.service)
+		assert.Equal(t, tt.want, resp.GetStatus(), tt.service)
+	}
+
+	_, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,103 @@
+// Package metrics holds the Prometheus metrics of the service, they are served by [Handler].
+//
+// The metrics are registered in the default registry, together with the Go runtime and process ones.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Transports of the requests.
+const (
+	TransportGrpc = "grpc"
+	TransportHttp = "http"
+)
+
+// Results of the cache lookups.
+const (
+	cacheHit  = "hit"
+	cacheMiss = "miss"
+)
+
+var (
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{ //nolint:exhaustruct
+		Name:    "request_duration_seconds",
+		Help:    "Duration of the handled requests by the method and the code of the error, OK for the successful ones.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"transport", "method", "code"})
+
+	cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{ //nolint:exhaustruct
+		Name: "cache_lookups_total",
+		Help: "Lookups of the cached entries by the result, hits divided by all of them is the hit ratio.",
+	}, []string{"cache", "result"})
+
+	uploadSize = promauto.NewHistogram(prometheus.HistogramOpts{ //nolint:exhaustruct
+		Name: "upload_size_bytes",
+		Help: "Size of the uploaded song files.",
+		// 256KiB to 256MiB
+		Buckets: prometheus.ExponentialBuckets(256<<10, 2, 11), //nolint:mnd
+	})
+
+	uploadDuration = promauto.NewHistogram(prometheus.HistogramOpts{ //nolint:exhaustruct
+		Name:    "upload_duration_seconds",
+		Help:    "Time taken to store the uploaded song files.",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 11), //nolint:mnd
+	})
+
+	produceDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{ //nolint:exhaustruct
+		Name:    "kafka_produce_duration_seconds",
+		Help:    "Duration of the writes of the events to Kafka by the topic.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"topic"})
+
+	produceErrors = promauto.NewCounterVec(prometheus.CounterOpts{ //nolint:exhaustruct
+		Name: "kafka_produce_errors_total",
+		Help: "Failed writes of the events to Kafka by the topic.",
+	}, []string{"topic"})
+)
+
+// Handler serves the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveRequest records the request of the method, the error is labeled by its erix code.
+func ObserveRequest(transport, method string, elapsed time.Duration, err error) {
+	requestDuration.
+		WithLabelValues(transport, method, erix.GrpcCode(err).String()).
+		Observe(elapsed.Seconds())
+}
+
+// ObserveCache records the lookups of the entries of the cache.
+func ObserveCache(cache string, hits, misses int) {
+	cacheLookups.WithLabelValues(cache, cacheHit).Add(float64(hits))
+	cacheLookups.WithLabelValues(cache, cacheMiss).Add(float64(misses))
+}
+
+// ObserveUpload records the stored song file.
+func ObserveUpload(sizeBytes int64, elapsed time.Duration) {
+	uploadSize.Observe(float64(sizeBytes))
+	uploadDuration.Observe(elapsed.Seconds())
+}
+
+// ObserveProduce records the write of the events to the topic.
+func ObserveProduce(topic string, elapsed time.Duration, err error) {
+	produceDuration.WithLabelValues(topic).Observe(elapsed.Seconds())
+
+	if err != nil {
+		ProduceFailed(topic)
+	}
+}
+
+// ProduceFailed records the failed write of the events to the topic,
+// it is for the async writes whose duration is unknown.
+func ProduceFailed(topic string) {
+	produceErrors.WithLabelValues(topic).Inc()
+}
@@ -0,0 +1,37 @@
+package metrics //nolint:testpackage
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObserveRequest(t *testing.T) {
+	ObserveRequest(TransportGrpc, "GetSong", time.Millisecond, nil)
+	ObserveRequest(TransportGrpc, "GetSong", time.Millisecond, erix.NewStatus("not found", erix.CodeNotFound))
+	ObserveRequest(TransportGrpc, "GetSong", time.Millisecond, errors.New("unexpected"))
+
+	// One series per code
+	assert.Equal(t, 3, testutil.CollectAndCount(requestDuration))
+}
+
+func TestObserveCache(t *testing.T) {
+	ObserveCache("test", 3, 1)
+	ObserveCache("test", 0, 2)
+
+	assert.InDelta(t, 3, testutil.ToFloat64(cacheLookups.WithLabelValues("test", cacheHit)), 0)
+	assert.InDelta(t, 3, testutil.ToFloat64(cacheLookups.WithLabelValues("test", cacheMiss)), 0)
+}
+
+func TestObserveProduce(t *testing.T) {
+	ObserveProduce("topic", time.Millisecond, nil)
+	ObserveProduce("topic", time.Millisecond, errors.New("broker is down"))
+	ProduceFailed("topic")
+
+	assert.InDelta(t, 2, testutil.ToFloat64(produceErrors.WithLabelValues("topic")), 0)
+}
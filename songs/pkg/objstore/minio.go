@@ -0,0 +1,155 @@
+package objstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"dev.gaijin.team/go/golib/e"
+	"dev.gaijin.team/go/golib/fields"
+	"github.com/minio/minio-go/v7"
+)
+
+// Minio keeps objects in an S3 compatible storage.
+type Minio struct {
+	m *minio.Client
+}
+
+func NewMinio(client *minio.Client) *Minio {
+	return &Minio{m: client}
+}
+
+func (s *Minio) MakeBucket(ctx context.Context, bucket string) error {
+	ok, err := s.m.BucketExists(ctx, bucket)
+	if err != nil {
+		return e.NewFrom("checking if bucket exists", err, fields.F("bucket", bucket))
+	}
+
+	if ok {
+		return nil
+	}
+
+	err = s.m.MakeBucket(ctx, bucket, minio.MakeBucketOptions{}) //nolint:exhaustruct
+	if err != nil {
+		return e.NewFrom("creating bucket", err, fields.F("bucket", bucket))
+	}
+
+	return nil
+}
+
+func (s *Minio) BucketExists(ctx context.Context, bucket string) (bool, error) {
+	ok, err := s.m.BucketExists(ctx, bucket)
+	if err != nil {
+		return false, e.NewFrom("checking if bucket exists", err, fields.F("bucket", bucket))
+	}
+
+	return ok, nil
+}
+
+func (s *Minio) Put(
+	ctx context.Context, bucket, key string, content io.Reader, size int64, opts PutOptions,
+) (int64, error) {
+	info, err := s.m.PutObject(ctx, bucket, key, content, size, minio.PutObjectOptions{ //nolint:exhaustruct
+		ContentType: opts.ContentType,
+		PartSize:    opts.PartSize,
+	})
+	if err != nil {
+		return 0, e.NewFrom("putting object", err, fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	return info.Size, nil
+}
+
+func (s *Minio) Get(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	return s.get(ctx, bucket, key, minio.GetObjectOptions{}) //nolint:exhaustruct
+}
+
+func (s *Minio) GetRange(ctx context.Context, bucket, key string, offset, length int64) (io.ReadCloser, error) {
+	opts := minio.GetObjectOptions{} //nolint:exhaustruct
+
+	end := int64(0)
+	if length > 0 {
+		end = offset + length - 1
+	}
+
+	// The whole object is not a range for minio
+	if offset > 0 || length > 0 {
+		err := opts.SetRange(offset, end)
+		if err != nil {
+			return nil, e.NewFrom("setting range", ErrInvalidRange, fields.F("offset", offset), fields.F("length", length))
+		}
+	}
+
+	return s.get(ctx, bucket, key, opts)
+}
+
+// get sends the request right away, the object is not requested until it is read otherwise.
+func (s *Minio) get(ctx context.Context, bucket, key string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+	object, err := s.m.GetObject(ctx, bucket, key, opts)
+	if err == nil {
+		_, err = object.Stat()
+	}
+
+	if err != nil {
+		return nil, e.NewFrom("getting object", mapError(err), fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	return object, nil
+}
+
+func (s *Minio) Stat(ctx context.Context, bucket, key string) (ObjectInfo, error) {
+	info, err := s.m.StatObject(ctx, bucket, key, minio.StatObjectOptions{}) //nolint:exhaustruct
+	if err != nil {
+		return ObjectInfo{}, e.NewFrom("getting object info", mapError(err),
+			fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	return ObjectInfo{Key: info.Key, Size: info.Size, ModifiedAt: info.LastModified}, nil
+}
+
+func (s *Minio) Delete(ctx context.Context, bucket, key string) error {
+	err := s.m.RemoveObject(ctx, bucket, key, minio.RemoveObjectOptions{}) //nolint:exhaustruct
+	if err != nil {
+		return e.NewFrom("removing object", err, fields.F("bucket", bucket), fields.F("key", key))
+	}
+
+	return nil
+}
+
+func (s *Minio) List(ctx context.Context, bucket, prefix string, fn func(ObjectInfo) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	// Stops the listing when fn fails
+	defer cancel()
+
+	objects := s.m.ListObjects(ctx, bucket, minio.ListObjectsOptions{ //nolint:exhaustruct
+		Prefix:    prefix,
+		Recursive: true,
+	})
+
+	for object := range objects {
+		if object.Err != nil {
+			return e.NewFrom("listing objects", object.Err, fields.F("bucket", bucket), fields.F("prefix", prefix))
+		}
+
+		err := fn(ObjectInfo{Key: object.Key, Size: object.Size, ModifiedAt: object.LastModified})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func mapError(err error) error {
+	resp := minio.ToErrorResponse(err)
+
+	switch {
+	case resp.Code == "NoSuchKey" || resp.StatusCode == http.StatusNotFound:
+		return errors.Join(ErrNotFound, err)
+	case resp.Code == "InvalidRange" || resp.StatusCode == http.StatusRequestedRangeNotSatisfiable:
+		return errors.Join(ErrInvalidRange, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,55 @@
+// Package objstore keeps objects in buckets of an S3 compatible storage or of a local directory.
+//
+// Both backends have the same semantics: an object appears only when it is stored completely,
+// missing objects are ErrNotFound and deleting them is not an error. The local one needs
+// no running services, it is meant for development and tests.
+package objstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+const (
+	BackendMinio = "minio"
+	BackendLocal = "local"
+)
+
+var (
+	ErrNotFound     = errors.New("object not found")
+	ErrInvalidRange = errors.New("range is not satisfiable")
+	ErrInvalidKey   = errors.New("invalid object key")
+)
+
+type ObjectInfo struct {
+	Key        string
+	Size       int64
+	ModifiedAt time.Time
+}
+
+type PutOptions struct {
+	ContentType string
+	// Size of the parts of objects with unknown size, the backend default if zero
+	PartSize uint64
+}
+
+type Store interface {
+	// MakeBucket creates the bucket unless it exists.
+	MakeBucket(ctx context.Context, bucket string) error
+	BucketExists(ctx context.Context, bucket string) (bool, error)
+	// Put stores the object until content ends and returns its size.
+	// The size is -1 if it is unknown, otherwise content must have exactly that many bytes.
+	Put(ctx context.Context, bucket, key string, content io.Reader, size int64, opts PutOptions) (int64, error)
+	// Get returns the whole object.
+	Get(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+	// GetRange returns length bytes of the object from offset, the rest of the object if length is not positive.
+	// The offset must be within the object.
+	GetRange(ctx context.Context, bucket, key string, offset, length int64) (io.ReadCloser, error)
+	Stat(ctx context.Context, bucket, key string) (ObjectInfo, error)
+	// Delete removes the object, removing a missing object is not an error.
+	Delete(ctx context.Context, bucket, key string) error
+	// List calls fn for every object with the key prefix until fn returns an error.
+	List(ctx context.Context, bucket, prefix string, fn func(ObjectInfo) error) error
+}
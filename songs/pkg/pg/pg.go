@@ -8,6 +8,7 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/database/postgres" // it is needed for migrations to work
 
 	"dev.gaijin.team/go/golib/e"
+	"github.com/exaring/otelpgx"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/jackc/pgx/v5"
@@ -20,7 +21,15 @@ type Database struct {
 }
 
 func Connect(ctx context.Context, conn string, migrations fs.FS) (Database, error) {
-	db, err := pgxpool.New(ctx, conn)
+	conf, err := pgxpool.ParseConfig(conn)
+	if err != nil {
+		return Database{}, e.NewFrom("parsing pg connection string", err)
+	}
+
+	// Every query gets a span, the arguments are left out
+	conf.ConnConfig.Tracer = otelpgx.NewTracer(otelpgx.WithDisableQuerySpanNamePrefix())
+
+	db, err := pgxpool.NewWithConfig(ctx, conf)
 	if err != nil {
 		return Database{}, e.NewFrom("connecting to pg database", err)
 	}
@@ -49,6 +58,16 @@ func (d Database) Close() error {
 	return nil
 }
 
+// Ping checks that a connection of the pool is alive.
+func (d Database) Ping(ctx context.Context) error {
+	return d.db.Ping(ctx) //nolint:wrapcheck
+}
+
+// Stat returns the stats of the connection pool.
+func (d Database) Stat() *pgxpool.Stat {
+	return d.db.Stat()
+}
+
 func (d Database) Begin(ctx context.Context) (Tx, error) {
 	tx, err := d.db.Begin(ctx)
 	if err != nil {
@@ -27,6 +27,14 @@ func FromInt4(i pgtype.Int4) *int32 {
 	return &i.Int32
 }
 
+func FromInt8(i pgtype.Int8) *int64 {
+	if !i.Valid {
+		return nil
+	}
+
+	return &i.Int64
+}
+
 func FromInterval(i pgtype.Interval) *time.Duration {
 	if !i.Valid {
 		return nil
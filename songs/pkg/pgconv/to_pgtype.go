@@ -26,6 +26,13 @@ func Int4[T interface{ int | int32 }](i T) pgtype.Int4 {
 	}
 }
 
+func Int8(i int64) pgtype.Int8 {
+	return pgtype.Int8{
+		Int64: i,
+		Valid: true,
+	}
+}
+
 func Interval(dur time.Duration) pgtype.Interval {
 	return pgtype.Interval{
 		Microseconds: dur.Microseconds(),
@@ -50,6 +57,14 @@ func Bool(b bool) pgtype.Bool {
 	}
 }
 
+func BoolPtr(b *bool) pgtype.Bool {
+	if b == nil {
+		return NullBool()
+	}
+
+	return pgtype.Bool{Bool: *b, Valid: true}
+}
+
 func Float8(f float64) pgtype.Float8 {
 	return pgtype.Float8{
 		Float64: f,
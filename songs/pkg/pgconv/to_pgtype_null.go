@@ -15,6 +15,13 @@ func NullInt4() pgtype.Int4 {
 	}
 }
 
+func NullInt8() pgtype.Int8 {
+	return pgtype.Int8{
+		Int64: 0,
+		Valid: false,
+	}
+}
+
 func NullInterval() pgtype.Interval {
 	return pgtype.Interval{
 		Microseconds: 0,
@@ -0,0 +1,31 @@
+package regions
+
+import (
+	"slices"
+	"strings"
+)
+
+// Available reports whether a song with the given restrictions can be played in the country.
+// An empty allowed list means every country is allowed. When the allowed list is set,
+// callers with an unknown country are refused, as nobody can prove where they are.
+func Available(allowed, denied []string, country string) bool {
+	country = strings.ToUpper(country)
+
+	if len(allowed) > 0 && !slices.Contains(allowed, country) {
+		return false
+	}
+
+	return country == "" || !slices.Contains(denied, country)
+}
+
+// Normalize uppercases the codes, sorts them and drops duplicates.
+func Normalize(codes []string) []string {
+	out := make([]string, 0, len(codes))
+	for _, code := range codes {
+		out = append(out, strings.ToUpper(strings.TrimSpace(code)))
+	}
+
+	slices.Sort(out)
+
+	return slices.Compact(out)
+}
@@ -0,0 +1,46 @@
+package regions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/regions"
+)
+
+func TestAvailable(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		allowed []string
+		denied  []string
+		country string
+		want    bool
+	}{
+		{name: "no restrictions", country: "DE", want: true},
+		{name: "no restrictions unknown country", country: "", want: true},
+		{name: "allowed", allowed: []string{"DE", "FR"}, country: "FR", want: true},
+		{name: "lowercase country", allowed: []string{"DE"}, country: "de", want: true},
+		{name: "not allowed", allowed: []string{"DE", "FR"}, country: "US", want: false},
+		{name: "allowed list unknown country", allowed: []string{"DE"}, country: "", want: false},
+		{name: "denied", denied: []string{"US"}, country: "US", want: false},
+		{name: "denied list unknown country", denied: []string{"US"}, country: "", want: true},
+		{name: "allowed but denied", allowed: []string{"US"}, denied: []string{"US"}, country: "US", want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.want, regions.Available(c.allowed, c.denied, c.country))
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"DE", "US"}, regions.Normalize([]string{"us", "DE", " de"}))
+	assert.Empty(t, regions.Normalize(nil))
+}
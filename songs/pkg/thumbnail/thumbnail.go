@@ -0,0 +1,93 @@
+// Package thumbnail makes small jpeg copies of jpeg and png images.
+//
+// Images are scaled down by averaging the pixels each thumbnail pixel covers,
+// transparent parts are laid over white.
+package thumbnail
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png" // png decoder
+	"io"
+
+	"dev.gaijin.team/go/golib/e"
+)
+
+// Quality of the jpeg thumbnails.
+const Quality = 85
+
+// Maker makes thumbnails, see [Maker.MakeThumbnail].
+type Maker struct{}
+
+// MakeThumbnail writes the jpeg thumbnail of the image whose longer side is size pixels to w.
+// Images smaller than that keep their size.
+func (Maker) MakeThumbnail(ctx context.Context, r io.Reader, w io.Writer, size int) error {
+	src, _, err := image.Decode(r)
+	if err != nil {
+		return e.NewFrom("decoding image", err)
+	}
+
+	bounds := src.Bounds()
+	width, height := scaled(bounds.Dx(), bounds.Dy(), size)
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := range height {
+		select {
+		case <-ctx.Done():
+			return ctx.Err() //nolint:wrapcheck
+
+		default:
+		}
+
+		for x := range width {
+			area := image.Rect(
+				bounds.Min.X+x*bounds.Dx()/width, bounds.Min.Y+y*bounds.Dy()/height,
+				bounds.Min.X+(x+1)*bounds.Dx()/width, bounds.Min.Y+(y+1)*bounds.Dy()/height,
+			)
+
+			dst.Set(x, y, average(src, area))
+		}
+	}
+
+	err = jpeg.Encode(w, dst, &jpeg.Options{Quality: Quality})
+	if err != nil {
+		return e.NewFrom("encoding thumbnail", err)
+	}
+
+	return nil
+}
+
+// scaled returns the size of the thumbnail, the longer side is size at most and the sides are 1 at least.
+func scaled(width, height, size int) (int, int) {
+	longer := max(width, height)
+	if longer <= size {
+		return width, height
+	}
+
+	return max(1, width*size/longer), max(1, height*size/longer)
+}
+
+// average returns the mean color of the area over white.
+func average(src image.Image, area image.Rectangle) color.RGBA {
+	var r, g, b, a, n uint64
+
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			pr, pg, pb, pa := src.At(x, y).RGBA()
+			r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+			n++
+		}
+	}
+
+	// The colors are alpha premultiplied, white shows through the transparent rest
+	white := n*0xFFFF - a
+
+	return color.RGBA{
+		R: uint8((r + white) / n >> 8), //nolint:gosec
+		G: uint8((g + white) / n >> 8), //nolint:gosec
+		B: uint8((b + white) / n >> 8), //nolint:gosec
+		A: 0xFF,
+	}
+}
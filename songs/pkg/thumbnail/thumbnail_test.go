@@ -0,0 +1,94 @@
+package thumbnail_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/thumbnail"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeThumbnail(t *testing.T) {
+	tests := []struct {
+		name                string
+		width, height, size int
+		wantW, wantH        int
+	}{
+		{name: "square", width: 1000, height: 1000, size: 256, wantW: 256, wantH: 256},
+		{name: "landscape", width: 800, height: 400, size: 200, wantW: 200, wantH: 100},
+		{name: "portrait", width: 300, height: 900, size: 300, wantW: 100, wantH: 300},
+		{name: "smaller than size", width: 100, height: 50, size: 256, wantW: 100, wantH: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+
+			err := thumbnail.Maker{}.MakeThumbnail(context.Background(),
+				pngImage(t, tt.width, tt.height, color.RGBA{R: 200, G: 10, B: 10, A: 0xFF}), out, tt.size)
+			require.NoError(t, err)
+
+			thumb, err := jpeg.Decode(out)
+			require.NoError(t, err)
+			assert.Equal(t, image.Rect(0, 0, tt.wantW, tt.wantH), thumb.Bounds())
+
+			r, g, b, _ := thumb.At(tt.wantW/2, tt.wantH/2).RGBA()
+			assert.InDelta(t, 200, r>>8, 8)
+			assert.InDelta(t, 10, g>>8, 8)
+			assert.InDelta(t, 10, b>>8, 8)
+		})
+	}
+}
+
+func TestMakeThumbnail_Transparent(t *testing.T) {
+	out := &bytes.Buffer{}
+
+	err := thumbnail.Maker{}.MakeThumbnail(context.Background(),
+		pngImage(t, 64, 64, color.RGBA{}), out, 16)
+	require.NoError(t, err)
+
+	thumb, err := jpeg.Decode(out)
+	require.NoError(t, err)
+
+	r, g, b, _ := thumb.At(8, 8).RGBA()
+	assert.InDelta(t, 0xFF, r>>8, 2)
+	assert.InDelta(t, 0xFF, g>>8, 2)
+	assert.InDelta(t, 0xFF, b>>8, 2)
+}
+
+func TestMakeThumbnail_NotImage(t *testing.T) {
+	err := thumbnail.Maker{}.MakeThumbnail(context.Background(), strings.NewReader("not an image"), &bytes.Buffer{}, 16)
+	assert.Error(t, err)
+}
+
+func TestMakeThumbnail_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := thumbnail.Maker{}.MakeThumbnail(ctx, pngImage(t, 64, 64, color.RGBA{}), &bytes.Buffer{}, 16)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func pngImage(t *testing.T, width, height int, c color.RGBA) *bytes.Buffer {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.SetRGBA(x, y, c)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, png.Encode(buf, img))
+
+	return buf
+}
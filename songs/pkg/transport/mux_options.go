@@ -1,8 +1,13 @@
 package transport
 
-import gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+import (
+	"net/http"
 
-func MuxWithAuthAndTraceHeaders() gateway.ServeMuxOption {
+	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func MuxWithForwardedHeaders() gateway.ServeMuxOption {
 	return gateway.WithIncomingHeaderMatcher(func(key string) (string, bool) {
 		switch key {
 		case AuthKey:
@@ -10,8 +15,25 @@ func MuxWithAuthAndTraceHeaders() gateway.ServeMuxOption {
 
 		case TraceIdKey:
 			return key, true
+
+		case CountryKey:
+			return key, true
 		}
 
 		return key, false
 	})
 }
+
+// MuxWithSpanNames names the spans of the otel http handler by the route,
+// so the requests of the same route are grouped no matter the path params.
+func MuxWithSpanNames() gateway.ServeMuxOption {
+	return gateway.WithMiddlewares(func(next gateway.HandlerFunc) gateway.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			if pattern, ok := gateway.HTTPPattern(r.Context()); ok {
+				trace.SpanFromContext(r.Context()).SetName(r.Method + " " + pattern.String())
+			}
+
+			next(w, r, pathParams)
+		}
+	})
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/erix"
 	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/metrics"
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/tracing"
 
 	"dev.gaijin.team/go/golib/stacktrace"
 	"github.com/google/uuid"
@@ -20,18 +22,23 @@ var (
 	TraceIdKey    = "X-Trace-Id"
 	TraceIdLogKey = "trace_id"
 	AuthKey       = "Authorization"
+	// CountryKey carries ISO 3166-1 alpha-2 code of the caller's country, resolved by the gateway.
+	CountryKey = "X-Country"
 )
 
 func ContextWithLogger[T any, T2 any](base zerolog.Logger) Uniceptor[T, T2] {
 	return func(next GrpcHandler[T, T2]) GrpcHandler[T, T2] {
 		return func(ctx context.Context, req T) (resp T2, err error) {
-			var traceId string
-
-			md, ok := metadata.FromIncomingContext(ctx)
-			if ok && len(md.Get(TraceIdKey)) > 0 {
-				traceId = md.Get(TraceIdKey)[0]
-			} else {
-				traceId = uuid.NewString()
+			// The trace of the span started by the otel handler, the header is left for callers without tracing
+			traceId := tracing.TraceId(ctx)
+
+			if traceId == "" {
+				md, ok := metadata.FromIncomingContext(ctx)
+				if ok && len(md.Get(TraceIdKey)) > 0 {
+					traceId = md.Get(TraceIdKey)[0]
+				} else {
+					traceId = uuid.NewString()
+				}
 			}
 
 			log := base.With().
@@ -63,6 +70,8 @@ func Logging[T any, T2 any](method string) Uniceptor[T, T2] {
 				err = status.Error(code, erix.LastReason(handlerErr))
 			}
 
+			metrics.ObserveRequest(metrics.TransportGrpc, method, elapsed, handlerErr)
+
 			log.WithLevel(logLevel).
 				Err(handlerErr).
 				Dur("elapsed", elapsed).
@@ -0,0 +1,107 @@
+// Package urlsign signs links to objects with HMAC, so a link works only until it expires
+// and only for the listener it was given to.
+//
+// The signature covers the kind and the id of the object, the expiry and the listener id,
+// so none of them can be changed without the key.
+package urlsign
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	ParamExpires   = "expires"
+	ParamListener  = "listener"
+	ParamSignature = "signature"
+
+	// MinKeyLen keeps keys long enough for HMAC-SHA256
+	MinKeyLen = 32
+)
+
+var (
+	ErrKeyTooShort      = errors.New("signing key is too short")
+	ErrInvalidTtl       = errors.New("ttl must be positive")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrExpired          = errors.New("link expired")
+)
+
+type Signer struct {
+	key []byte
+	ttl time.Duration
+}
+
+func New(key string, ttl time.Duration) (*Signer, error) {
+	if len(key) < MinKeyLen {
+		return nil, ErrKeyTooShort
+	}
+
+	if ttl <= 0 {
+		return nil, ErrInvalidTtl
+	}
+
+	return &Signer{key: []byte(key), ttl: ttl}, nil
+}
+
+// Sign returns the query of the link to the object, the listener id is empty for anonymous listeners.
+func (s *Signer) Sign(kind, objectId, listenerId string) string {
+	return s.SignUntil(kind, objectId, listenerId, time.Now().Add(s.ttl))
+}
+
+// SignUntil returns the query of the link to the object that expires at the time.
+func (s *Signer) SignUntil(kind, objectId, listenerId string, expires time.Time) string {
+	exp := expires.Unix()
+
+	query := url.Values{}
+	query.Set(ParamExpires, strconv.FormatInt(exp, 10))
+
+	if listenerId != "" {
+		query.Set(ParamListener, listenerId)
+	}
+
+	query.Set(ParamSignature, base64.RawURLEncoding.EncodeToString(s.mac(kind, objectId, listenerId, exp)))
+
+	return query.Encode()
+}
+
+// Verify checks the query of the link to the object and returns the listener id it was given to.
+func (s *Signer) Verify(kind, objectId string, query url.Values) (string, error) {
+	exp, err := strconv.ParseInt(query.Get(ParamExpires), 10, 64)
+	if err != nil {
+		return "", ErrInvalidSignature
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(query.Get(ParamSignature))
+	if err != nil {
+		return "", ErrInvalidSignature
+	}
+
+	listenerId := query.Get(ParamListener)
+
+	if !hmac.Equal(sig, s.mac(kind, objectId, listenerId, exp)) {
+		return "", ErrInvalidSignature
+	}
+
+	if time.Now().Unix() > exp {
+		return "", ErrExpired
+	}
+
+	return listenerId, nil
+}
+
+func (s *Signer) mac(kind, objectId, listenerId string, exp int64) []byte {
+	h := hmac.New(sha256.New, s.key)
+
+	// The separator can't appear in the parts, so different parts never give the same message
+	for _, part := range []string{kind, objectId, listenerId, strconv.FormatInt(exp, 10)} {
+		_, _ = h.Write([]byte(part))
+		_, _ = h.Write([]byte{0})
+	}
+
+	return h.Sum(nil)
+}
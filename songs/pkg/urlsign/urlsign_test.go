@@ -0,0 +1,102 @@
+package urlsign_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/songs/pkg/urlsign"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const key = "0123456789abcdef0123456789abcdef"
+
+func newSigner(t *testing.T) *urlsign.Signer {
+	t.Helper()
+
+	signer, err := urlsign.New(key, time.Hour)
+	require.NoError(t, err)
+
+	return signer
+}
+
+func parse(t *testing.T, query string) url.Values {
+	t.Helper()
+
+	values, err := url.ParseQuery(query)
+	require.NoError(t, err)
+
+	return values
+}
+
+func TestNew(t *testing.T) {
+	_, err := urlsign.New("short", time.Hour)
+	require.ErrorIs(t, err, urlsign.ErrKeyTooShort)
+
+	_, err = urlsign.New(key, 0)
+	require.ErrorIs(t, err, urlsign.ErrInvalidTtl)
+}
+
+func TestSignVerify(t *testing.T) {
+	signer := newSigner(t)
+
+	listener, err := signer.Verify("song", "a.mp3", parse(t, signer.Sign("song", "a.mp3", "user-1")))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", listener)
+
+	listener, err = signer.Verify("song", "a.mp3", parse(t, signer.Sign("song", "a.mp3", "")))
+	require.NoError(t, err)
+	assert.Empty(t, listener)
+}
+
+func TestVerifyTampered(t *testing.T) {
+	signer := newSigner(t)
+	query := signer.Sign("song", "a.mp3", "user-1")
+
+	tests := []struct {
+		name     string
+		kind, id string
+		change   func(url.Values)
+	}{
+		{name: "other object", kind: "song", id: "b.mp3", change: func(url.Values) {}},
+		{name: "other kind", kind: "image", id: "a.mp3", change: func(url.Values) {}},
+		{name: "other listener", kind: "song", id: "a.mp3", change: func(v url.Values) { v.Set(urlsign.ParamListener, "user-2") }},
+		{name: "no listener", kind: "song", id: "a.mp3", change: func(v url.Values) { v.Del(urlsign.ParamListener) }},
+		{name: "later expiry", kind: "song", id: "a.mp3", change: func(v url.Values) {
+			v.Set(urlsign.ParamExpires, "99999999999")
+		}},
+		{name: "no signature", kind: "song", id: "a.mp3", change: func(v url.Values) { v.Del(urlsign.ParamSignature) }},
+		{name: "bad signature", kind: "song", id: "a.mp3", change: func(v url.Values) {
+			v.Set(urlsign.ParamSignature, strings.Repeat("A", 43))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := parse(t, query)
+			tt.change(values)
+
+			_, err := signer.Verify(tt.kind, tt.id, values)
+			require.ErrorIs(t, err, urlsign.ErrInvalidSignature)
+		})
+	}
+}
+
+func TestVerifyOtherKey(t *testing.T) {
+	other, err := urlsign.New(strings.Repeat("k", urlsign.MinKeyLen), time.Hour)
+	require.NoError(t, err)
+
+	_, err = newSigner(t).Verify("song", "a.mp3", parse(t, other.Sign("song", "a.mp3", "user-1")))
+	require.ErrorIs(t, err, urlsign.ErrInvalidSignature)
+}
+
+func TestVerifyExpired(t *testing.T) {
+	signer := newSigner(t)
+	query := signer.SignUntil("song", "a.mp3", "user-1", time.Now().Add(-time.Minute))
+
+	_, err := signer.Verify("song", "a.mp3", parse(t, query))
+	require.ErrorIs(t, err, urlsign.ErrExpired)
+}
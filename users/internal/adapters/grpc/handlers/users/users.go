@@ -7,11 +7,13 @@ import (
 
 	"github.com/Benzogang-Tape/audio-hosting/users/api/protogen"
 	"github.com/Benzogang-Tape/audio-hosting/users/internal/adapters/grpc/handlers"
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/adapters/grpc/interceptors"
 	"github.com/Benzogang-Tape/audio-hosting/users/internal/repo/options"
 	"github.com/Benzogang-Tape/audio-hosting/users/internal/service"
 	"github.com/Benzogang-Tape/audio-hosting/users/internal/service/artists"
 	"github.com/Benzogang-Tape/audio-hosting/users/internal/service/listeners"
 	"github.com/Benzogang-Tape/audio-hosting/users/internal/service/users"
+	"github.com/Benzogang-Tape/audio-hosting/users/pkg/auth"
 	"github.com/Benzogang-Tape/audio-hosting/users/pkg/logger"
 	"github.com/google/uuid"
 )
@@ -30,6 +32,7 @@ type (
 			users.DTOGetFollowersInput,
 		) (users.DTOGetFollowersOutput, error)
 		MakeArtist(context.Context, users.DTOMakeArtistInput) error
+		UpdateMe(context.Context, users.DTOUpdateMeInput) error
 	}
 	ListenersService interface {
 		GetListener(
@@ -198,10 +201,37 @@ func (us *UsersHandler) GetMe(
 }
 
 func (us *UsersHandler) UpdateMe(
-	_ context.Context,
-	_ *protogen.UpdateMeRequest,
+	ctx context.Context,
+	req *protogen.UpdateMeRequest,
 ) (*protogen.UpdateMeResponse, error) {
-	panic("not implemented") // TODO: Implement
+	claims, ok := ctx.Value(interceptors.ClaimsCtx{}).(auth.Token)
+	if !ok {
+		return nil, interceptors.ErrUnauthorized
+	}
+
+	err := us.usersService.UpdateMe(ctx, users.DTOUpdateMeInput{
+		UserID:       claims.Subject,
+		Name:         req.Name,
+		HideExplicit: req.HideExplicit,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			logger.GetLoggerFromCtx(ctx).
+				Info("user not found", slog.String("id", claims.Subject.String()))
+
+			return nil, errors.New("user not found")
+		}
+
+		logger.GetLoggerFromCtx(ctx).
+			Error("users.UsersHandler.UpdateMe - update me", slog.String("error", err.Error()))
+
+		return nil, errors.New("internal server error")
+	}
+
+	logger.GetLoggerFromCtx(ctx).Info("user updated", slog.String("id", claims.Subject.String()))
+
+	return &protogen.UpdateMeResponse{}, nil
 }
 
 func (us *UsersHandler) ChangePassword(
@@ -212,24 +242,119 @@ func (us *UsersHandler) ChangePassword(
 }
 
 func (us *UsersHandler) Follow(
-	_ context.Context,
-	_ *protogen.FollowRequest,
+	ctx context.Context,
+	req *protogen.FollowRequest,
 ) (*protogen.FollowResponse, error) {
-	panic("not implemented") // TODO: Implement
+	claims, ok := ctx.Value(interceptors.ClaimsCtx{}).(auth.Token)
+	if !ok {
+		return nil, interceptors.ErrUnauthorized
+	}
+
+	followedID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, errors.New("invalid user id")
+	}
+
+	err = us.usersService.Follow(ctx, users.DTOFollowInput{
+		FollowerID: claims.Subject,
+		FollowedID: followedID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			logger.GetLoggerFromCtx(ctx).Info("user not found", slog.String("id", req.UserId))
+
+			return nil, errors.New("user not found")
+
+		case errors.Is(err, service.ErrSelfFollow):
+			return nil, service.ErrSelfFollow
+		}
+
+		logger.GetLoggerFromCtx(ctx).
+			Error("users.UsersHandler.Follow - follow", slog.String("error", err.Error()))
+
+		return nil, errors.New("internal server error")
+	}
+
+	logger.GetLoggerFromCtx(ctx).Info("user followed",
+		slog.String("follower_id", claims.Subject.String()), slog.String("followed_id", req.UserId))
+
+	return &protogen.FollowResponse{}, nil
 }
 
 func (us *UsersHandler) Unfollow(
-	_ context.Context,
-	_ *protogen.UnfollowRequest,
+	ctx context.Context,
+	req *protogen.UnfollowRequest,
 ) (*protogen.UnfollowResponse, error) {
-	panic("not implemented") // TODO: Implement
+	claims, ok := ctx.Value(interceptors.ClaimsCtx{}).(auth.Token)
+	if !ok {
+		return nil, interceptors.ErrUnauthorized
+	}
+
+	followedID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, errors.New("invalid user id")
+	}
+
+	err = us.usersService.Unfollow(ctx, users.DTOUnfollowInput{
+		FollowerID: claims.Subject,
+		FollowedID: followedID,
+	})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).
+			Error("users.UsersHandler.Unfollow - unfollow", slog.String("error", err.Error()))
+
+		return nil, errors.New("internal server error")
+	}
+
+	logger.GetLoggerFromCtx(ctx).Info("user unfollowed",
+		slog.String("follower_id", claims.Subject.String()), slog.String("followed_id", req.UserId))
+
+	return &protogen.UnfollowResponse{}, nil
 }
 
 func (us *UsersHandler) GetFollowers(
-	_ context.Context,
-	_ *protogen.GetFollowersRequest,
+	ctx context.Context,
+	req *protogen.GetFollowersRequest,
 ) (*protogen.GetFollowersResponse, error) {
-	panic("not implemented") // TODO: Implement
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, errors.New("invalid user id")
+	}
+
+	pagination, err := handlers.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := us.usersService.GetFollowers(ctx, users.DTOGetFollowersInput{
+		UserID:     userID,
+		Pagination: pagination,
+	})
+	if err != nil {
+		logger.GetLoggerFromCtx(ctx).
+			Error("users.UsersHandler.GetFollowers - get followers", slog.String("error", err.Error()))
+
+		return nil, errors.New("internal server error")
+	}
+
+	followers := make([]*protogen.Listener, 0, len(output.Followers))
+	for _, follower := range output.Followers {
+		followers = append(followers, &protogen.Listener{
+			Id:        follower.ID.String(),
+			Name:      follower.Name,
+			AvatarUrl: follower.AvatarURL,
+		})
+	}
+
+	return &protogen.GetFollowersResponse{
+		Users: followers,
+		Pagination: &protogen.PaginationResponse{
+			Total:    int64(output.Pagination.Total),
+			HasNext:  output.Pagination.HasNext,
+			LastPage: int64(output.Pagination.LastPage),
+		},
+	}, nil
 }
 
 func (us *UsersHandler) GetFollowed(
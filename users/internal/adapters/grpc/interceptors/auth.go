@@ -2,7 +2,9 @@ package interceptors
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
+	"slices"
 	"strings"
 
 	"github.com/Benzogang-Tape/audio-hosting/users/pkg/auth"
@@ -11,19 +13,31 @@ import (
 )
 
 type AuthInterceptor struct {
-	parser       auth.Parser
-	publicRoutes []string
+	parser        auth.Parser
+	publicRoutes  []string
+	serviceRoutes []string
+	serviceToken  string
 }
 
-func NewAuthInterceptor(parser auth.Parser, publicRoutes []string) *AuthInterceptor {
+// NewAuthInterceptor lets the service routes be called with the service token as well as with a user's one,
+// an empty service token disables it.
+func NewAuthInterceptor(
+	parser auth.Parser,
+	publicRoutes []string,
+	serviceRoutes []string,
+	serviceToken string,
+) *AuthInterceptor {
 	return &AuthInterceptor{
-		parser:       parser,
-		publicRoutes: publicRoutes,
+		parser:        parser,
+		publicRoutes:  publicRoutes,
+		serviceRoutes: serviceRoutes,
+		serviceToken:  serviceToken,
 	}
 }
 
 const (
 	AuthorizationMetadataName = "authorization"
+	ServiceTokenMetadataName  = "x-service-token"
 )
 
 type ClaimsCtx struct{}
@@ -50,6 +64,10 @@ func (ai *AuthInterceptor) JWT(
 		return nil, ErrInvalidMetadata
 	}
 
+	if ai.isServiceCall(info.FullMethod, md) {
+		return handler(ctx, req)
+	}
+
 	header := md.Get(AuthorizationMetadataName)
 	if len(header) == 0 {
 		return nil, ErrInvalidMetadata
@@ -67,3 +85,16 @@ func (ai *AuthInterceptor) JWT(
 
 	return handler(context.WithValue(ctx, ClaimsCtx{}, claims), req)
 }
+
+func (ai *AuthInterceptor) isServiceCall(method string, md metadata.MD) bool {
+	if ai.serviceToken == "" || !slices.Contains(ai.serviceRoutes, method) {
+		return false
+	}
+
+	token := md.Get(ServiceTokenMetadataName)
+	if len(token) == 0 {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token[0]), []byte(ai.serviceToken)) == 1
+}
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/Benzogang-Tape/audio-hosting/users/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/users/pkg/tracing"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -20,20 +21,10 @@ func RequestIDInterceptor(
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
-	var requestID string
-
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		requestID = uuid.New().String()
-	} else {
-		requestIDHeader := md.Get(requestIDMetadataName)
-		if len(requestIDHeader) == 0 {
-			requestID = uuid.New().String()
-		} else if requestIDHeader[0] == "" {
-			requestID = uuid.New().String()
-		} else {
-			requestID = requestIDHeader[0]
-		}
+	// The trace of the span started by the otel handler, the header is left for callers without tracing
+	requestID := tracing.TraceID(ctx)
+	if requestID == "" {
+		requestID = requestIDFromMetadata(ctx)
 	}
 
 	log := logger.GetLoggerFromCtx(ctx).With(
@@ -44,3 +35,17 @@ func RequestIDInterceptor(
 
 	return handler(ctx, req)
 }
+
+func requestIDFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return uuid.New().String()
+	}
+
+	requestIDHeader := md.Get(requestIDMetadataName)
+	if len(requestIDHeader) == 0 || requestIDHeader[0] == "" {
+		return uuid.New().String()
+	}
+
+	return requestIDHeader[0]
+}
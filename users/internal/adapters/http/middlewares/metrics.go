@@ -0,0 +1,38 @@
+package middlewares
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/users/pkg/metrics"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func MetricsInterceptor(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		start := time.Now()
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next(rec, r, pathParams)
+
+		// The route, the path has the params
+		method := r.Method
+		if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+			method += " " + pattern.String()
+		}
+
+		metrics.ObserveRequest(metrics.TransportHTTP, method, strconv.Itoa(rec.status), time.Since(start))
+	}
+}
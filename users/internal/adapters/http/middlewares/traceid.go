@@ -5,8 +5,10 @@ import (
 	"net/http"
 
 	"github.com/Benzogang-Tape/audio-hosting/users/pkg/logger"
+	"github.com/Benzogang-Tape/audio-hosting/users/pkg/tracing"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -15,9 +17,13 @@ const (
 
 func RequestIDInterceptor(next runtime.HandlerFunc) runtime.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
-		var requestID string
+		// The trace of the span started by the otel handler, the header is left for callers without tracing
+		requestID := tracing.TraceID(r.Context())
+
+		if requestID == "" {
+			requestID = r.Header.Get(requestIDMetadataName)
+		}
 
-		requestID = r.Header.Get(requestIDMetadataName)
 		if requestID == "" {
 			requestID = uuid.New().String()
 		}
@@ -31,3 +37,15 @@ func RequestIDInterceptor(next runtime.HandlerFunc) runtime.HandlerFunc {
 		next(w, r.WithContext(ctx), pathParams)
 	}
 }
+
+// SpanNameInterceptor names the spans of the otel http handler by the route,
+// so the requests of the same route are grouped no matter the path params.
+func SpanNameInterceptor(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+			trace.SpanFromContext(r.Context()).SetName(r.Method + " " + pattern.String())
+		}
+
+		next(w, r, pathParams)
+	}
+}
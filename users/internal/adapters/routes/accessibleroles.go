@@ -8,6 +8,8 @@ const (
 
 	grpcPath = "/users_api.UsersService/"
 	httpPath = "/users/api/v1/"
+
+	grpcHealthPath = "/grpc.health.v1.Health/"
 )
 
 func PublicRoutes(adapterType AdapterType) []string {
@@ -20,6 +22,7 @@ func PublicRoutes(adapterType AdapterType) []string {
 			grpcPath + "Logout",
 			grpcPath + "GetUser",
 			grpcPath + "GetArtists",
+			grpcHealthPath + "Check",
 		}
 	case HTTP:
 		return []string{
@@ -27,8 +30,18 @@ func PublicRoutes(adapterType AdapterType) []string {
 			httpPath + "users/register",
 			httpPath + "users/refresh",
 			httpPath + "users/logout",
+			"/healthz",
+			"/readyz",
 		}
 	default:
 		return []string{}
 	}
 }
+
+// ServiceRoutes are called by the other services with the service token instead of a user's one.
+// They are gRPC only, the HTTP gateway still asks for the user's token.
+func ServiceRoutes() []string {
+	return []string{
+		grpcPath + "GetFollowers",
+	}
+}
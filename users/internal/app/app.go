@@ -13,19 +13,31 @@ import (
 	"github.com/Benzogang-Tape/audio-hosting/users/internal/adapters/http/middlewares"
 	"github.com/Benzogang-Tape/audio-hosting/users/internal/adapters/routes"
 	"github.com/Benzogang-Tape/audio-hosting/users/migrations"
+	"github.com/Benzogang-Tape/audio-hosting/users/pkg/health"
+	"github.com/Benzogang-Tape/audio-hosting/users/pkg/metrics"
+	"github.com/Benzogang-Tape/audio-hosting/users/pkg/tracing"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+const serviceName = "users"
+
 type App struct {
 	provider *Provider
 
-	grpcServer *grpc.Server
-	httpServer *http.Server
+	health *health.Checker
+
+	grpcServer    *grpc.Server
+	httpServer    *http.Server
+	metricsServer *http.Server
 }
 
 func NewApp(ctx context.Context) (*App, error) {
@@ -50,6 +62,12 @@ func (a *App) Run(ctx context.Context) error {
 		return a.runHTTPServer(ctx)
 	})
 
+	if a.metricsServer != nil {
+		eg.Go(func() error {
+			return a.runMetricsServer(ctx)
+		})
+	}
+
 	return eg.Wait()
 }
 
@@ -67,9 +85,17 @@ func (a *App) GracefulShutdown(ctx context.Context) error {
 
 func (a *App) initDeps(ctx context.Context) error {
 	a.initProvider(ctx)
+
+	err := a.initTracing(ctx)
+	if err != nil {
+		return err
+	}
+
 	a.initDB(ctx)
+	a.initHealth(ctx)
 	a.initGrpcServer(ctx)
 	a.initHttpServer(ctx)
+	a.initMetricsServer(ctx)
 
 	return nil
 }
@@ -78,14 +104,41 @@ func (a *App) initProvider(_ context.Context) {
 	a.provider = NewProvider()
 }
 
+func (a *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Setup(ctx, serviceName, a.provider.Cfg().Tracing)
+	if err != nil {
+		return fmt.Errorf("app.initTracing: %w", err)
+	}
+
+	a.provider.Closer().Add(func() error {
+		if err := shutdown(ctx); err != nil {
+			return err
+		}
+
+		a.provider.Logger().Info("tracing stopped")
+
+		return nil
+	})
+
+	return nil
+}
+
 func (a *App) initDB(_ context.Context) {
 	migrations.Run(a.provider.Cfg().Postgres)
 }
 
+func (a *App) initHealth(ctx context.Context) {
+	a.health = health.NewChecker(a.provider.Cfg().Health.Timeout)
+
+	a.health.Add("postgres", a.provider.DB(ctx).Ping)
+}
+
 func (a *App) initGrpcServer(ctx context.Context) {
 	authInterceptor := interceptors.NewAuthInterceptor(
 		a.provider.Parser(),
 		routes.PublicRoutes(routes.GRPC),
+		routes.ServiceRoutes(),
+		a.provider.Cfg().Auth.ServiceToken,
 	)
 
 	opts := []grpc.ServerOption{
@@ -93,9 +146,11 @@ func (a *App) initGrpcServer(ctx context.Context) {
 			interceptors.LoggerToCtxInterceptor(a.provider.Logger()),
 			interceptors.RequestIDInterceptor,
 			interceptors.LoggerInterceptor,
+			interceptors.MetricsInterceptor,
 			authInterceptor.JWT,
 		),
 		grpc.Creds(insecure.NewCredentials()),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	}
 
 	a.grpcServer = grpc.NewServer(opts...)
@@ -103,6 +158,7 @@ func (a *App) initGrpcServer(ctx context.Context) {
 	reflection.Register(a.grpcServer)
 
 	protogen.RegisterUsersServiceServer(a.grpcServer, a.provider.UsersHandler(ctx))
+	healthpb.RegisterHealthServer(a.grpcServer, health.NewServer(a.health, protogen.UsersService_ServiceDesc.ServiceName))
 
 	a.provider.Closer().Add(func() error {
 		a.grpcServer.GracefulStop()
@@ -117,9 +173,11 @@ func (a *App) initHttpServer(ctx context.Context) {
 
 	restServer := runtime.NewServeMux(
 		runtime.WithMiddlewares(
+			middlewares.SpanNameInterceptor,
 			middlewares.LoggerToCtxInterceptor(a.provider.Logger()),
 			middlewares.RequestIDInterceptor,
 			middlewares.LoggerInterceptor,
+			middlewares.MetricsInterceptor,
 			auth.JWT(),
 		),
 	)
@@ -128,9 +186,18 @@ func (a *App) initHttpServer(ctx context.Context) {
 		panic(err)
 	}
 
+	// The probes of the orchestrator, they are out of the /users/api prefix proxied to the gateway
+	if err := restServer.HandlePath(http.MethodGet, "/healthz", withoutParams(a.health.Live)); err != nil {
+		panic(err)
+	}
+
+	if err := restServer.HandlePath(http.MethodGet, "/readyz", withoutParams(a.health.Ready)); err != nil {
+		panic(err)
+	}
+
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf("%s:%d", a.provider.Cfg().HTTP.Host, a.provider.Cfg().HTTP.Port),
-		Handler: restServer,
+		Handler: otelhttp.NewHandler(restServer, "users-gateway"),
 	}
 
 	a.httpServer = httpServer
@@ -146,6 +213,32 @@ func (a *App) initHttpServer(ctx context.Context) {
 	})
 }
 
+func (a *App) initMetricsServer(ctx context.Context) {
+	prometheus.MustRegister(metrics.NewPoolCollector(a.provider.DB(ctx).Pool))
+
+	if a.provider.Cfg().Metrics.Port == 0 {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	a.metricsServer = &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", a.provider.Cfg().Metrics.Host, a.provider.Cfg().Metrics.Port),
+		Handler: mux,
+	}
+
+	a.provider.Closer().Add(func() error {
+		if err := a.metricsServer.Shutdown(ctx); err != nil {
+			return err
+		}
+
+		a.provider.Logger().Info("metrics server stopped")
+
+		return nil
+	})
+}
+
 func (a *App) runGRPCServer(_ context.Context) error {
 	a.provider.Logger().
 		Info("starting gRPC server", slog.Int("port", a.provider.Cfg().GRPC.Port), slog.String("host", a.provider.Cfg().GRPC.Host))
@@ -175,3 +268,24 @@ func (a *App) runHTTPServer(_ context.Context) error {
 
 	return nil
 }
+
+func (a *App) runMetricsServer(_ context.Context) error {
+	a.provider.Logger().
+		Info("starting metrics server", slog.Int("port", a.provider.Cfg().Metrics.Port), slog.String("host", a.provider.Cfg().Metrics.Host))
+
+	if err := a.metricsServer.ListenAndServe(); err != nil {
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+
+		return fmt.Errorf("app.runMetricsServer: %w", err)
+	}
+
+	return nil
+}
+
+func withoutParams(handler http.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		handler(w, r)
+	}
+}
@@ -22,6 +22,8 @@ type (
 			userID uuid.UUID,
 		) (model.NotificationSettings, error)
 		GetUserByEmail(ctx context.Context, email string) (model.User, error)
+		GetUser(ctx context.Context, id uuid.UUID) (model.User, error)
+		PatchUser(ctx context.Context, id uuid.UUID, name *string, hideExplicit *bool) error
 		MakeArtist(ctx context.Context, userID uuid.UUID) error
 	}
 	ListenersRepository interface {
@@ -22,6 +22,7 @@ type (
 			users.DTOGetFollowersInput,
 		) (users.DTOGetFollowersOutput, error)
 		MakeArtist(context.Context, users.DTOMakeArtistInput) error
+		UpdateMe(context.Context, users.DTOUpdateMeInput) error
 	}
 	ListenersService interface {
 		GetListener(
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/Benzogang-Tape/audio-hosting/users/pkg/database/postgres"
+	"github.com/Benzogang-Tape/audio-hosting/users/pkg/tracing"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -12,8 +13,11 @@ type Config struct {
 	Env      string `env:"ENV" env-default:"dev"`
 	GRPC     GRPCConfig
 	HTTP     HTTPConfig
+	Metrics  MetricsConfig
+	Health   HealthConfig
 	Postgres postgres.Config
 	Auth     Auth
+	Tracing  tracing.Config
 }
 
 type Auth struct {
@@ -23,6 +27,8 @@ type Auth struct {
 
 	PrivateKey string `env:"AUTH_PRIVATE_KEY" env-required:"true"`
 	PublicKey  string `env:"AUTH_PUBLIC_KEY"  env-required:"true"`
+	// Shared with the services calling the service routes, e.g. songs counting the followers
+	ServiceToken string `env:"AUTH_SERVICE_TOKEN"`
 }
 
 type GRPCConfig struct {
@@ -35,6 +41,17 @@ type HTTPConfig struct {
 	Port int    `env:"HTTP_PORT" env-default:"8080"`
 }
 
+// MetricsConfig is the internal server of /metrics, zero port disables it.
+type MetricsConfig struct {
+	Host string `env:"METRICS_HOST" env-default:"localhost"`
+	Port int    `env:"METRICS_PORT" env-default:"2112"`
+}
+
+// HealthConfig limits every probe of a dependency in the readiness checks.
+type HealthConfig struct {
+	Timeout time.Duration `env:"HEALTH_TIMEOUT" env-default:"2s"`
+}
+
 func New() (*Config, error) {
 	var cfg Config
 
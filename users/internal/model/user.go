@@ -8,4 +8,7 @@ type User struct {
 	Email        string    `json:"email"`
 	AvatarURL    string    `json:"avatar_url"`
 	PasswordHash string    `json:"password_hash"`
+	HideExplicit bool      `json:"hide_explicit"`
+	// IsAdmin grants the moderation endpoints of songs, it is only set in the database
+	IsAdmin bool `json:"is_admin"`
 }
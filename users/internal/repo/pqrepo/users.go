@@ -22,7 +22,7 @@ type Users struct {
 func (us *Users) GetUserByEmail(ctx context.Context, email string) (model.User, error) {
 	conn := us.DB.GetConnection(ctx)
 
-	sql, args, err := squirrel.Select("id, name, email, avatar_url, password_hash").
+	sql, args, err := squirrel.Select("id, name, email, avatar_url, password_hash, hide_explicit, is_admin").
 		From("users").
 		Where(squirrel.Eq{"email": email}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -41,6 +41,8 @@ func (us *Users) GetUserByEmail(ctx context.Context, email string) (model.User,
 		&user.Email,
 		&user.AvatarURL,
 		&user.PasswordHash,
+		&user.HideExplicit,
+		&user.IsAdmin,
 	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return model.User{}, pqerrs.ErrNotFound
@@ -55,6 +57,93 @@ func (us *Users) GetUserByEmail(ctx context.Context, email string) (model.User,
 	return user, nil
 }
 
+func (us *Users) GetUser(ctx context.Context, id uuid.UUID) (model.User, error) {
+	conn := us.DB.GetConnection(ctx)
+
+	sql, args, err := squirrel.Select("id, name, email, avatar_url, password_hash, hide_explicit, is_admin").
+		From("users").
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return model.User{}, fmt.Errorf(
+			"pqrepo.Users.GetUser - failed to generate SQL: %w",
+			err,
+		)
+	}
+
+	var user model.User
+	if err = conn.QueryRow(ctx, sql, args...).Scan(
+		&user.ID,
+		&user.Name,
+		&user.Email,
+		&user.AvatarURL,
+		&user.PasswordHash,
+		&user.HideExplicit,
+		&user.IsAdmin,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.User{}, pqerrs.ErrNotFound
+		}
+
+		return model.User{}, fmt.Errorf(
+			"pqrepo.Users.GetUser - failed to execute SQL: %w",
+			err,
+		)
+	}
+
+	return user, nil
+}
+
+// PatchUser updates only the fields which are set.
+func (us *Users) PatchUser(
+	ctx context.Context,
+	id uuid.UUID,
+	name *string,
+	hideExplicit *bool,
+) error {
+	conn := us.DB.GetConnection(ctx)
+
+	set := map[string]any{}
+	if name != nil {
+		set["name"] = *name
+	}
+
+	if hideExplicit != nil {
+		set["hide_explicit"] = *hideExplicit
+	}
+
+	if len(set) == 0 {
+		return nil
+	}
+
+	sql, args, err := squirrel.Update("users").
+		SetMap(set).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf(
+			"pqrepo.Users.PatchUser - failed to generate SQL: %w",
+			err,
+		)
+	}
+
+	tag, err := conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf(
+			"pqrepo.Users.PatchUser - failed to execute SQL: %w",
+			err,
+		)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pqerrs.ErrNotFound
+	}
+
+	return nil
+}
+
 //nolint:dupl
 func (us *Users) GetFollowers(ctx context.Context, userID uuid.UUID) ([]model.User, error) {
 	conn := us.DB.GetConnection(ctx)
@@ -181,6 +270,7 @@ func (us *Users) Follow(ctx context.Context, followerID uuid.UUID, followedID uu
 	sql, args, err := squirrel.Insert("users_users").
 		Columns("follower_id", "followed_id").
 		Values(followerID, followedID).
+		Suffix("ON CONFLICT (follower_id, followed_id) DO NOTHING").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -6,6 +6,7 @@ var (
 	ErrUserNotFound      = errors.New("user not found")
 	ErrWrongPassword     = errors.New("wrong password")
 	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrSelfFollow        = errors.New("user can't follow themselves")
 
 	ErrSessionExpired = errors.New("session expired")
 
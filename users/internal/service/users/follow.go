@@ -1,9 +1,41 @@
 package users
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
 
-type DTOFollowInput struct{}
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/repo/pqerrs"
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/service"
 
-func (*Service) Follow(_ context.Context, _ DTOFollowInput) error {
-	panic("not implemented") // TODO: Implement
+	"github.com/google/uuid"
+)
+
+type DTOFollowInput struct {
+	FollowerID uuid.UUID
+	FollowedID uuid.UUID
+}
+
+// Follow is idempotent, following the same user twice keeps one follow,
+// the unique follower and followed pair makes the repeated insert a no-op.
+func (se *Service) Follow(ctx context.Context, input DTOFollowInput) error {
+	if input.FollowerID == input.FollowedID {
+		return service.ErrSelfFollow
+	}
+
+	_, err := se.usersRepository.GetUser(ctx, input.FollowedID)
+	if err != nil {
+		if errors.Is(err, pqerrs.ErrNotFound) {
+			return service.ErrUserNotFound
+		}
+
+		return fmt.Errorf("users.Service.Follow - get followed user: %w", err)
+	}
+
+	err = se.usersRepository.Follow(ctx, input.FollowerID, input.FollowedID)
+	if err != nil {
+		return fmt.Errorf("users.Service.Follow - follow: %w", err)
+	}
+
+	return nil
 }
@@ -1,14 +1,60 @@
 package users
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
 
-type DTOGetFollowersInput struct{}
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/model"
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/repo/options"
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/repo/pqerrs"
 
-type DTOGetFollowersOutput struct{}
+	"github.com/google/uuid"
+)
 
-func (*Service) GetFollowers(
-	_ context.Context,
-	_ DTOGetFollowersInput,
+type DTOGetFollowersInput struct {
+	UserID     uuid.UUID
+	Pagination options.Pagination
+}
+
+type DTOGetFollowersOutput struct {
+	Followers  []model.User
+	Pagination options.Pagination
+}
+
+func (se *Service) GetFollowers(
+	ctx context.Context,
+	input DTOGetFollowersInput,
 ) (DTOGetFollowersOutput, error) {
-	panic("not implemented") // TODO: Implement
+	followers, err := se.usersRepository.GetFollowers(ctx, input.UserID)
+	if err != nil && !errors.Is(err, pqerrs.ErrNotFound) {
+		return DTOGetFollowersOutput{}, fmt.Errorf(
+			"users.Service.GetFollowers - get followers: %w",
+			err,
+		)
+	}
+
+	total := len(followers)
+
+	lastPage := 1
+	if input.Pagination.Limit > 0 {
+		lastPage = (total-1)/input.Pagination.Limit + 1
+	}
+
+	// The repository returns all the followers, so they are paged here.
+	start := min(input.Pagination.Offset, total)
+	end := total
+
+	if input.Pagination.Limit > 0 {
+		end = min(start+input.Pagination.Limit, total)
+	}
+
+	return DTOGetFollowersOutput{
+		Followers: followers[start:end],
+		Pagination: options.Pagination{
+			HasNext:  end < total,
+			Total:    total,
+			LastPage: lastPage,
+		},
+	}, nil
 }
@@ -56,7 +56,7 @@ func (se *Service) Login(ctx context.Context, input DTOLoginInput) (DTOLoginOutp
 
 	var accessToken, refreshToken string
 	err = se.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
-		accessToken, refreshToken, err = se.createTokensPair(ctx, user.ID, isArtist)
+		accessToken, refreshToken, err = se.createTokensPair(ctx, user, isArtist)
 		if err != nil {
 			return fmt.Errorf(
 				"users.Service.Login - create tokens pair: %w",
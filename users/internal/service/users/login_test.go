@@ -0,0 +1,134 @@
+package users_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/model"
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/repo/pqerrs"
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/service/users"
+	"github.com/Benzogang-Tape/audio-hosting/users/pkg/auth"
+	"github.com/google/uuid"
+)
+
+type usersRepo struct {
+	user model.User
+}
+
+func (r usersRepo) GetUserByEmail(_ context.Context, email string) (model.User, error) {
+	if email != r.user.Email {
+		return model.User{}, pqerrs.ErrNotFound
+	}
+
+	return r.user, nil
+}
+
+func (r usersRepo) GetUser(context.Context, uuid.UUID) (model.User, error) { return r.user, nil }
+
+func (usersRepo) PatchUser(context.Context, uuid.UUID, *string, *bool) error { return nil }
+
+func (usersRepo) MakeArtist(context.Context, uuid.UUID) error { return nil }
+
+func (usersRepo) GetFollowers(context.Context, uuid.UUID) ([]model.User, error) { return nil, nil }
+
+func (usersRepo) GetFollowed(context.Context, uuid.UUID) ([]model.User, error) { return nil, nil }
+
+func (usersRepo) Follow(context.Context, uuid.UUID, uuid.UUID) error { return nil }
+
+func (usersRepo) Unfollow(context.Context, uuid.UUID, uuid.UUID) error { return nil }
+
+type artistsRepo struct{}
+
+func (artistsRepo) GetArtist(context.Context, uuid.UUID) (model.Artist, error) {
+	return model.Artist{}, pqerrs.ErrNotFound
+}
+
+type listenersRepo struct{}
+
+func (listenersRepo) CreateListener(context.Context, model.Listener) error { return nil }
+
+type sessionsRepo struct{}
+
+func (sessionsRepo) CreateRefreshSession(context.Context, model.RefreshSession) error { return nil }
+
+func (sessionsRepo) GetUserRefreshSession(context.Context, uuid.UUID) ([]model.RefreshSession, error) {
+	return nil, pqerrs.ErrNotFound
+}
+
+func (sessionsRepo) DeleteRefreshSession(context.Context, string) error { return nil }
+
+func (sessionsRepo) GetRefreshSession(context.Context, string) (model.RefreshSession, error) {
+	return model.RefreshSession{}, pqerrs.ErrNotFound
+}
+
+type plainHasher struct{}
+
+func (plainHasher) HashPassword(password string) (string, error) { return password, nil }
+
+func (plainHasher) CheckPasswordHash(password, hash string) error {
+	if password != hash {
+		return errors.New("wrong password")
+	}
+
+	return nil
+}
+
+type noTx struct{}
+
+func (noTx) WithinTransaction(ctx context.Context, tFunc func(ctx context.Context) error) error {
+	return tFunc(ctx)
+}
+
+func TestLoginAdminClaim(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generating keys: %v", err)
+	}
+
+	signer, err := auth.NewSigner(base64.StdEncoding.EncodeToString(priv), time.Minute)
+	if err != nil {
+		t.Fatalf("NewSigner failed: %v", err)
+	}
+
+	parser, err := auth.NewParser(base64.StdEncoding.EncodeToString(pub))
+	if err != nil {
+		t.Fatalf("NewParser failed: %v", err)
+	}
+
+	for _, isAdmin := range []bool{true, false} {
+		user := model.User{
+			ID:           uuid.New(),
+			Email:        "admin@example.com",
+			PasswordHash: "password",
+			IsAdmin:      isAdmin,
+		}
+
+		service := users.New(usersRepo{user: user}, artistsRepo{}, listenersRepo{}, sessionsRepo{},
+			plainHasher{}, signer, noTx{}, time.Minute, time.Hour, 5)
+
+		output, err := service.Login(context.Background(), users.DTOLoginInput{
+			Email:    user.Email,
+			Password: "password",
+		})
+		if err != nil {
+			t.Fatalf("Login failed: %v", err)
+		}
+
+		claims, err := parser.Parse(output.AccessToken)
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+
+		if claims.IsAdmin != isAdmin {
+			t.Errorf("IsAdmin = %v, want %v", claims.IsAdmin, isAdmin)
+		}
+
+		if claims.Subject != user.ID {
+			t.Errorf("Subject = %v, want %v", claims.Subject, user.ID)
+		}
+	}
+}
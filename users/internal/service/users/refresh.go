@@ -26,6 +26,14 @@ func (se *Service) Refresh(ctx context.Context, input DTORefreshInput) (DTORefre
 		)
 	}
 
+	user, err := se.usersRepository.GetUser(ctx, session.UserID)
+	if err != nil {
+		return DTORefreshOutput{}, fmt.Errorf(
+			"users.Service.Refresh - get user: %w",
+			err,
+		)
+	}
+
 	var accessToken, refreshToken string
 	err = se.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
 		err = se.refreshSessionsRepository.DeleteRefreshSession(ctx, input.RefreshToken)
@@ -40,7 +48,7 @@ func (se *Service) Refresh(ctx context.Context, input DTORefreshInput) (DTORefre
 			return service.ErrSessionExpired
 		}
 
-		accessToken, refreshToken, err = se.createTokensPair(ctx, session.UserID, false)
+		accessToken, refreshToken, err = se.createTokensPair(ctx, user, false)
 		if err != nil {
 			return fmt.Errorf(
 				"users.Service.Refresh - create tokens pair: %w",
@@ -58,7 +58,7 @@ func (se *Service) Register(
 			)
 		}
 
-		accessToken, refreshToken, err = se.createTokensPair(ctx, user.ID, false)
+		accessToken, refreshToken, err = se.createTokensPair(ctx, user.User, false)
 		if err != nil {
 			return fmt.Errorf(
 				"users.Service.Register - create tokens pair: %w",
@@ -1,9 +1,22 @@
 package users
 
-import "context"
+import (
+	"context"
+	"fmt"
 
-type DTOUnfollowInput struct{}
+	"github.com/google/uuid"
+)
 
-func (*Service) Unfollow(_ context.Context, _ DTOUnfollowInput) error {
-	panic("not implemented") // TODO: Implement
+type DTOUnfollowInput struct {
+	FollowerID uuid.UUID
+	FollowedID uuid.UUID
+}
+
+func (se *Service) Unfollow(ctx context.Context, input DTOUnfollowInput) error {
+	err := se.usersRepository.Unfollow(ctx, input.FollowerID, input.FollowedID)
+	if err != nil {
+		return fmt.Errorf("users.Service.Unfollow - unfollow: %w", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,31 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/repo/pqerrs"
+	"github.com/Benzogang-Tape/audio-hosting/users/internal/service"
+
+	"github.com/google/uuid"
+)
+
+type DTOUpdateMeInput struct {
+	UserID       uuid.UUID
+	Name         *string
+	HideExplicit *bool
+}
+
+func (se *Service) UpdateMe(ctx context.Context, input DTOUpdateMeInput) error {
+	err := se.usersRepository.PatchUser(ctx, input.UserID, input.Name, input.HideExplicit)
+	if err != nil {
+		if errors.Is(err, pqerrs.ErrNotFound) {
+			return service.ErrUserNotFound
+		}
+
+		return fmt.Errorf("users.Service.UpdateMe - patch user: %w", err)
+	}
+
+	return nil
+}
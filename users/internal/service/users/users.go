@@ -36,7 +36,13 @@ type refreshSessionsRepository interface {
 
 type usersRepository interface {
 	GetUserByEmail(ctx context.Context, email string) (model.User, error)
+	GetUser(ctx context.Context, id uuid.UUID) (model.User, error)
+	PatchUser(ctx context.Context, id uuid.UUID, name *string, hideExplicit *bool) error
 	MakeArtist(ctx context.Context, userID uuid.UUID) error
+	GetFollowers(ctx context.Context, userID uuid.UUID) ([]model.User, error)
+	GetFollowed(ctx context.Context, userID uuid.UUID) ([]model.User, error)
+	Follow(ctx context.Context, followerID uuid.UUID, followedID uuid.UUID) error
+	Unfollow(ctx context.Context, followerID uuid.UUID, followedID uuid.UUID) error
 }
 
 type hasher interface {
@@ -95,13 +101,15 @@ func New(
 
 func (se *Service) createTokensPair(
 	ctx context.Context,
-	userID uuid.UUID,
+	user model.User,
 	isArtist bool,
 ) (access string, refresh string, err error) {
 	accessToken, err := se.signer.Sign(auth.Token{
-		Subject:  userID,
-		IsArtist: isArtist,
-		Exp:      time.Now().Add(se.accessTokenTTL).Unix(),
+		Subject:      user.ID,
+		IsArtist:     isArtist,
+		IsAdmin:      user.IsAdmin,
+		HideExplicit: user.HideExplicit,
+		Exp:          time.Now().Add(se.accessTokenTTL).Unix(),
 	})
 	if err != nil {
 		return "", "", fmt.Errorf(
@@ -110,7 +118,7 @@ func (se *Service) createTokensPair(
 		)
 	}
 
-	refreshSession, err := se.createRefreshSessionInLimit(ctx, userID)
+	refreshSession, err := se.createRefreshSessionInLimit(ctx, user.ID)
 	if err != nil {
 		return "", "", fmt.Errorf(
 			"users.Service.createTokensPair - create refresh session: %w",
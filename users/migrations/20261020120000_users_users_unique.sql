@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+DELETE FROM users_users a
+  USING users_users b
+  WHERE a.follower_id = b.follower_id
+    AND a.followed_id = b.followed_id
+    AND a.id > b.id;
+
+ALTER TABLE users_users
+  ADD CONSTRAINT UQ_users_users_follower_followed UNIQUE (follower_id, followed_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users_users
+  DROP CONSTRAINT UQ_users_users_follower_followed;
+-- +goose StatementEnd
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/exaring/otelpgx"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -41,7 +42,15 @@ func New(ctx context.Context, cfg Config) (*DB, error) {
 		cfg.SSLMode,
 	)
 
-	connPool, err := pgxpool.New(ctx, dsn)
+	poolConfig, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database config: %w", err)
+	}
+
+	// Every query gets a span, the arguments are left out
+	poolConfig.ConnConfig.Tracer = otelpgx.NewTracer(otelpgx.WithDisableQuerySpanNamePrefix())
+
+	connPool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -62,6 +71,15 @@ func New(ctx context.Context, cfg Config) (*DB, error) {
 	}, nil
 }
 
+func (db *DB) Ping(ctx context.Context) error {
+	err := db.Pool.Ping(ctx)
+	if err != nil {
+		return fmt.Errorf("can't ping database: %w", err)
+	}
+
+	return nil
+}
+
 func (db *DB) Close() {
 	db.Pool.Close()
 }
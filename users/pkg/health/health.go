@@ -0,0 +1,157 @@
+// Package health probes the dependencies of the service for the liveness and readiness checks.
+//
+// The checks are served as the grpc.health.v1 service by Server and as the /healthz and /readyz
+// HTTP endpoints by Checker.Live and Checker.Ready.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Probe checks a dependency, it fails with an error.
+type Probe func(ctx context.Context) error
+
+// Status is the result of the probe of a dependency.
+type Status struct {
+	Name      string `json:"name"`
+	OK        bool   `json:"ok"`
+	Error     string `json:"error,omitempty"`
+	ElapsedMs int64  `json:"elapsedMs"`
+}
+
+// Report is the result of all the probes, the service is ready if every dependency is ok.
+type Report struct {
+	Ready        bool     `json:"ready"`
+	Dependencies []Status `json:"dependencies"`
+}
+
+// Failed returns the names of the failed dependencies.
+func (r Report) Failed() []string {
+	var names []string
+
+	for _, status := range r.Dependencies {
+		if !status.OK {
+			names = append(names, status.Name)
+		}
+	}
+
+	return names
+}
+
+var ErrUnknownDependency = errors.New("unknown dependency")
+
+type dependency struct {
+	name  string
+	probe Probe
+}
+
+type Checker struct {
+	timeout      time.Duration
+	dependencies []dependency
+}
+
+// NewChecker returns the checker whose probes are canceled after the timeout, zero means no timeout.
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout:      timeout,
+		dependencies: nil,
+	}
+}
+
+// Add adds the probe of the dependency, it is not safe to call during the checks.
+func (c *Checker) Add(name string, probe Probe) {
+	c.dependencies = append(c.dependencies, dependency{name: name, probe: probe})
+}
+
+// Check probes all the dependencies at once, the statuses are in the order of Checker.Add.
+func (c *Checker) Check(ctx context.Context) Report {
+	report := Report{
+		Ready:        true,
+		Dependencies: make([]Status, len(c.dependencies)),
+	}
+
+	var wg sync.WaitGroup
+
+	for i, dep := range c.dependencies {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			report.Dependencies[i] = c.probe(ctx, dep)
+		}()
+	}
+
+	wg.Wait()
+
+	for _, status := range report.Dependencies {
+		report.Ready = report.Ready && status.OK
+	}
+
+	return report
+}
+
+// CheckOne probes the dependency with the name.
+func (c *Checker) CheckOne(ctx context.Context, name string) (Status, error) {
+	for _, dep := range c.dependencies {
+		if dep.name == name {
+			return c.probe(ctx, dep), nil
+		}
+	}
+
+	return Status{}, ErrUnknownDependency
+}
+
+func (c *Checker) probe(ctx context.Context, dep dependency) Status {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := dep.probe(ctx)
+
+	status := Status{
+		Name:      dep.name,
+		OK:        err == nil,
+		Error:     "",
+		ElapsedMs: time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		status.Error = err.Error()
+	}
+
+	return status
+}
+
+// Live answers the liveness checks, the process serving them is alive whatever the state of the dependencies.
+func (c *Checker) Live(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// Ready answers the readiness checks with the report, the status is 503 if a dependency has failed.
+func (c *Checker) Ready(w http.ResponseWriter, r *http.Request) {
+	report := c.Check(r.Context())
+
+	code := http.StatusOK
+	if !report.Ready {
+		code = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, code, report)
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
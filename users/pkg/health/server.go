@@ -0,0 +1,100 @@
+package health
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// How often Watch probes the dependencies.
+const watchInterval = 5 * time.Second
+
+// Server is the grpc.health.v1 service.
+//
+// The empty service and the services the server was created with are serving if every dependency is ok,
+// a name of a dependency checks only that dependency.
+type Server struct {
+	healthpb.UnimplementedHealthServer
+
+	checker  *Checker
+	services []string
+}
+
+func NewServer(checker *Checker, services ...string) *Server {
+	return &Server{
+		UnimplementedHealthServer: healthpb.UnimplementedHealthServer{},
+		checker:                   checker,
+		services:                  services,
+	}
+}
+
+func (s *Server) Check(ctx context.Context, req *healthpb.HealthCheckRequest,
+) (*healthpb.HealthCheckResponse, error) {
+	serving, err := s.serving(ctx, req.GetService())
+	if err != nil {
+		return nil, err
+	}
+
+	return &healthpb.HealthCheckResponse{Status: serving}, nil
+}
+
+// Watch sends the status when it changes, the dependencies are probed every watchInterval.
+func (s *Server) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ctx := stream.Context()
+	last := healthpb.HealthCheckResponse_UNKNOWN
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		serving, err := s.serving(ctx, req.GetService())
+		if status.Code(err) == codes.NotFound {
+			// The spec asks to keep the stream of an unknown service
+			serving, err = healthpb.HealthCheckResponse_SERVICE_UNKNOWN, nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if serving != last {
+			err = stream.Send(&healthpb.HealthCheckResponse{Status: serving})
+			if err != nil {
+				return err
+			}
+
+			last = serving
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) serving(ctx context.Context, service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	if service == "" || slices.Contains(s.services, service) {
+		return servingStatus(s.checker.Check(ctx).Ready), nil
+	}
+
+	dep, err := s.checker.CheckOne(ctx, service)
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, status.Error(codes.NotFound, "unknown service")
+	}
+
+	return servingStatus(dep.OK), nil
+}
+
+func servingStatus(ok bool) healthpb.HealthCheckResponse_ServingStatus {
+	if ok {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
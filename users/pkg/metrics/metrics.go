@@ -0,0 +1,32 @@
+// Package metrics holds the Prometheus metrics of the service, they are served by Handler.
+//
+// The metrics are registered in the default registry, together with the Go runtime and process ones.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	TransportGRPC = "grpc"
+	TransportHTTP = "http"
+)
+
+var requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "request_duration_seconds",
+	Help:    "Duration of the handled requests by the method and the gRPC code or the HTTP status.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"transport", "method", "code"})
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+func ObserveRequest(transport, method, code string, elapsed time.Duration) {
+	requestDuration.WithLabelValues(transport, method, code).Observe(elapsed.Seconds())
+}
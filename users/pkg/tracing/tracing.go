@@ -0,0 +1,68 @@
+// Package tracing sets up OpenTelemetry tracing with the W3C trace context propagation.
+//
+// The trace context travels in the traceparent header of gRPC metadata and HTTP requests.
+// Spans are exported to an OTLP collector, without one they are only used for the trace ids in the logs.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Config struct {
+	// OTLP gRPC endpoint of the collector, empty disables the export
+	Endpoint string `env:"TRACING_ENDPOINT"     env-default:""`
+	Insecure bool   `env:"TRACING_INSECURE"     env-default:"true"`
+	// Share of the traces started here that are sampled, the parent decides for the others
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+}
+
+// Setup installs the global tracer provider and propagator.
+// The returned func flushes the spans left and stops the export.
+func Setup(ctx context.Context, serviceName string, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+
+	if cfg.Endpoint != "" {
+		exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+		}
+
+		// The exporter connects lazily, a collector that is down doesn't stop the service
+		exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("can't create otlp exporter: %w", err)
+		}
+
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// TraceID returns the id of the trace of the span in ctx, it is empty without a span.
+func TraceID(ctx context.Context) string {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.HasTraceID() {
+		return ""
+	}
+
+	return spanCtx.TraceID().String()
+}